@@ -21,6 +21,20 @@ import (
 	"go.opentelemetry.io/otel/label"
 )
 
+// Property is metadata attached to a baggage member. It is propagated as
+// part of the member in the W3C Baggage header.
+type Property = baggage.Property
+
+// NewProperty returns a Property with a key and value.
+func NewProperty(key, value string) Property {
+	return Property{Key: key, Value: value, HasValue: true}
+}
+
+// NewKeyProperty returns a Property that is only a key.
+func NewKeyProperty(key string) Property {
+	return Property{Key: key}
+}
+
 // Set returns a copy of the set of baggage key-values in ctx.
 func Set(ctx context.Context) label.Set {
 	// TODO (MrAlias, #1222): The underlying storage, the Map, shares many of
@@ -52,6 +66,23 @@ func ContextWithValues(parent context.Context, pairs ...label.KeyValue) context.
 	return baggage.ContextWithMap(parent, m)
 }
 
+// ContextWithMember returns a copy of parent with kv updated in the baggage
+// and props set as its properties, replacing any it had before.
+func ContextWithMember(parent context.Context, kv label.KeyValue, props ...Property) context.Context {
+	update := baggage.MapUpdate{SingleKV: kv}
+	if len(props) > 0 {
+		update.Properties = map[label.Key][]Property{kv.Key: props}
+	}
+	m := baggage.MapFromContext(parent).Apply(update)
+	return baggage.ContextWithMap(parent, m)
+}
+
+// Properties returns the properties of the baggage member related to key
+// in ctx, or nil if it has none.
+func Properties(ctx context.Context, key label.Key) []Property {
+	return baggage.MapFromContext(ctx).Properties(key)
+}
+
 // ContextWithoutValues returns a copy of parent in which the values related
 // to keys have been removed from the baggage.
 func ContextWithoutValues(parent context.Context, keys ...label.Key) context.Context {
@@ -84,3 +84,30 @@ func TestBaggage(t *testing.T) {
 		t.Fatal("WithoutBaggage failed to clear baggage")
 	}
 }
+
+func TestBaggageMemberProperties(t *testing.T) {
+	key := label.Key("member")
+	ctx := ContextWithMember(context.Background(), key.String("v"),
+		NewKeyProperty("flag"),
+		NewProperty("ttl", "30"),
+	)
+
+	if got := Value(ctx, key).AsString(); got != "v" {
+		t.Fatalf("ContextWithMember set value %q, want %q", got, "v")
+	}
+	props := Properties(ctx, key)
+	if len(props) != 2 {
+		t.Fatalf("Properties returned %d properties, want 2", len(props))
+	}
+	if props[0] != (Property{Key: "flag"}) {
+		t.Errorf("first property = %+v", props[0])
+	}
+	if props[1] != (Property{Key: "ttl", Value: "30", HasValue: true}) {
+		t.Errorf("second property = %+v", props[1])
+	}
+
+	ctx = ContextWithValues(ctx, key.String("w"))
+	if got := Properties(ctx, key); got != nil {
+		t.Errorf("overwriting a member kept its properties: %v", got)
+	}
+}
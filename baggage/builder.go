@@ -0,0 +1,123 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package baggage // import "go.opentelemetry.io/otel/baggage"
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+
+	"go.opentelemetry.io/otel/internal/baggage"
+	"go.opentelemetry.io/otel/label"
+)
+
+// ErrInvalidKey is returned by Builder.Build if a member or property key
+// is not a valid W3C Baggage key.
+var ErrInvalidKey = errors.New("invalid baggage key")
+
+// Builder accumulates changes to the baggage of a context and applies them
+// at once. Unlike calling ContextWithValues or ContextWithMember for each
+// member, which copies the baggage every time, Build copies it once, and
+// validates all the changed members once.
+//
+// Changes to the same key replace each other, so the last Set, SetMember
+// or Delete of a key wins. A Builder is not safe for concurrent use.
+type Builder struct {
+	parent  context.Context
+	members map[label.Key]builderMember
+}
+
+type builderMember struct {
+	value   label.Value
+	props   []Property
+	deleted bool
+}
+
+// NewBuilder returns a Builder that changes the baggage of parent.
+func NewBuilder(parent context.Context) *Builder {
+	return &Builder{
+		parent:  parent,
+		members: make(map[label.Key]builderMember),
+	}
+}
+
+// Set sets kvs as members of the baggage, without properties.
+func (b *Builder) Set(kvs ...label.KeyValue) *Builder {
+	for _, kv := range kvs {
+		b.members[kv.Key] = builderMember{value: kv.Value}
+	}
+	return b
+}
+
+// SetMember sets kv as a member of the baggage with props as its
+// properties.
+func (b *Builder) SetMember(kv label.KeyValue, props ...Property) *Builder {
+	b.members[kv.Key] = builderMember{
+		value: kv.Value,
+		props: append([]Property(nil), props...),
+	}
+	return b
+}
+
+// Delete removes the members related to keys from the baggage.
+func (b *Builder) Delete(keys ...label.Key) *Builder {
+	for _, k := range keys {
+		b.members[k] = builderMember{deleted: true}
+	}
+	return b
+}
+
+// Build returns a copy of the parent context of b with the changes applied
+// to its baggage. If a set member or one of its properties has a key that
+// is not valid according to the W3C Baggage specification, the parent
+// context is returned along with an error wrapping ErrInvalidKey that lists
+// the invalid keys in order.
+func (b *Builder) Build() (context.Context, error) {
+	var (
+		update  baggage.MapUpdate
+		invalid []string
+	)
+	for k, m := range b.members {
+		if m.deleted {
+			update.DropMultiK = append(update.DropMultiK, k)
+			continue
+		}
+		if !baggage.ValidKey(string(k)) {
+			invalid = append(invalid, string(k))
+		}
+		for _, p := range m.props {
+			if !baggage.ValidKey(p.Key) {
+				invalid = append(invalid, fmt.Sprintf("%s;%s", k, p.Key))
+			}
+		}
+		update.MultiKV = append(update.MultiKV, label.KeyValue{Key: k, Value: m.value})
+		if len(m.props) > 0 {
+			if update.Properties == nil {
+				update.Properties = make(map[label.Key][]Property)
+			}
+			update.Properties[k] = m.props
+		}
+	}
+	if len(invalid) > 0 {
+		sort.Strings(invalid)
+		return b.parent, fmt.Errorf("%w: %q", ErrInvalidKey, invalid)
+	}
+	if len(b.members) == 0 {
+		return b.parent, nil
+	}
+	m := baggage.MapFromContext(b.parent).Apply(update)
+	return baggage.ContextWithMap(b.parent, m), nil
+}
@@ -0,0 +1,93 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package baggage
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.opentelemetry.io/otel/label"
+)
+
+func TestBuilder(t *testing.T) {
+	ctx := ContextWithValues(context.Background(),
+		label.String("keep", "1"),
+		label.String("drop", "2"),
+		label.String("replace", "3"),
+	)
+
+	props := []Property{NewProperty("p", "v")}
+	ctx, err := NewBuilder(ctx).
+		Set(label.String("a", "1"), label.Int("b", 2)).
+		SetMember(label.String("replace", "4"), props...).
+		Delete("drop", "b").
+		Set(label.String("drop", "5")).
+		Build()
+	require.NoError(t, err)
+
+	set := Set(ctx)
+	got := map[label.Key]label.Value{}
+	for iter := set.Iter(); iter.Next(); {
+		kv := iter.Label()
+		got[kv.Key] = kv.Value
+	}
+	assert.Equal(t, map[label.Key]label.Value{
+		"keep":    label.StringValue("1"),
+		"a":       label.StringValue("1"),
+		"drop":    label.StringValue("5"),
+		"replace": label.StringValue("4"),
+	}, got)
+	assert.Equal(t, props, Properties(ctx, "replace"))
+	assert.Nil(t, Properties(ctx, "a"))
+}
+
+func TestBuilderCopiesProperties(t *testing.T) {
+	props := []Property{NewKeyProperty("p")}
+	b := NewBuilder(context.Background()).SetMember(label.String("a", "1"), props...)
+	props[0] = NewKeyProperty("q")
+
+	ctx, err := b.Build()
+	require.NoError(t, err)
+	assert.Equal(t, []Property{NewKeyProperty("p")}, Properties(ctx, "a"))
+}
+
+func TestBuilderInvalidKeys(t *testing.T) {
+	parent := ContextWithValues(context.Background(), label.String("a", "1"))
+
+	ctx, err := NewBuilder(parent).
+		Set(label.String("b", "2"), label.String("in valid", "3")).
+		SetMember(label.String("c", "4"), NewKeyProperty("bad,prop")).
+		Delete("a").
+		Build()
+	require.Error(t, err)
+	assert.True(t, errors.Is(err, ErrInvalidKey))
+	assert.Equal(t, `invalid baggage key: ["c;bad,prop" "in valid"]`, err.Error())
+
+	// Nothing is applied when a key is invalid.
+	assert.Equal(t, parent, ctx)
+	set := Set(ctx)
+	assert.Equal(t, 1, set.Len())
+}
+
+func TestBuilderEmpty(t *testing.T) {
+	parent := context.Background()
+	ctx, err := NewBuilder(parent).Build()
+	require.NoError(t, err)
+	assert.Equal(t, parent, ctx)
+}
@@ -0,0 +1,148 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package opencensus
+
+import (
+	"fmt"
+	"time"
+
+	"go.opencensus.io/metric/metricdata"
+
+	"go.opentelemetry.io/otel/metric/number"
+	"go.opentelemetry.io/otel/sdk/export/metric/aggregation"
+)
+
+// ocAggregation is an aggregation of the points of an OpenCensus time
+// series.
+type ocAggregation interface {
+	aggregation.Aggregation
+	// end returns the time of the last point of the time series.
+	end() time.Time
+}
+
+var (
+	_ aggregation.LastValue = &ocLastValue{}
+	_ aggregation.Sum       = &ocSum{}
+	_ aggregation.Histogram = &ocHistogram{}
+)
+
+// newAggregationFromPoints returns the aggregation of points, which must
+// not be empty, according to the type of their metric. OpenCensus points
+// are either gauges or cumulative, so only the last point is used.
+func newAggregationFromPoints(typ metricdata.Type, points []metricdata.Point) (ocAggregation, error) {
+	last := points[len(points)-1]
+	switch typ {
+	case metricdata.TypeGaugeInt64, metricdata.TypeGaugeFloat64:
+		n, err := convertNumber(last.Value)
+		if err != nil {
+			return nil, err
+		}
+		return &ocLastValue{value: n, time: last.Time}, nil
+	case metricdata.TypeCumulativeInt64, metricdata.TypeCumulativeFloat64:
+		n, err := convertNumber(last.Value)
+		if err != nil {
+			return nil, err
+		}
+		return &ocSum{sum: n, time: last.Time}, nil
+	case metricdata.TypeGaugeDistribution, metricdata.TypeCumulativeDistribution:
+		dist, ok := last.Value.(*metricdata.Distribution)
+		if !ok || dist == nil {
+			return nil, fmt.Errorf("%w: distribution point of type %T", errConversion, last.Value)
+		}
+		return newHistogram(dist, last.Time)
+	default:
+		return nil, fmt.Errorf("%w: points of type %v", errConversion, typ)
+	}
+}
+
+// convertNumber converts the value of an OpenCensus int64 or float64
+// point to a number.
+func convertNumber(v interface{}) (number.Number, error) {
+	switch n := v.(type) {
+	case int64:
+		return number.NewInt64Number(n), nil
+	case float64:
+		return number.NewFloat64Number(n), nil
+	default:
+		return 0, fmt.Errorf("%w: point of type %T", errConversion, v)
+	}
+}
+
+// ocLastValue is the LastValue aggregation of an OpenCensus gauge.
+type ocLastValue struct {
+	value number.Number
+	time  time.Time
+}
+
+func (a *ocLastValue) Kind() aggregation.Kind { return aggregation.LastValueKind }
+
+func (a *ocLastValue) LastValue() (number.Number, time.Time, error) {
+	return a.value, a.time, nil
+}
+
+func (a *ocLastValue) end() time.Time { return a.time }
+
+// ocSum is the Sum aggregation of an OpenCensus cumulative value.
+type ocSum struct {
+	sum  number.Number
+	time time.Time
+}
+
+func (a *ocSum) Kind() aggregation.Kind { return aggregation.SumKind }
+
+func (a *ocSum) Sum() (number.Number, error) { return a.sum, nil }
+
+func (a *ocSum) end() time.Time { return a.time }
+
+// ocHistogram is the Histogram aggregation of an OpenCensus distribution.
+type ocHistogram struct {
+	count   uint64
+	sum     number.Number
+	buckets aggregation.Buckets
+	time    time.Time
+}
+
+func newHistogram(dist *metricdata.Distribution, t time.Time) (*ocHistogram, error) {
+	var bounds []float64
+	if dist.BucketOptions != nil {
+		bounds = dist.BucketOptions.Bounds
+	}
+	if len(dist.Buckets) != len(bounds)+1 {
+		return nil, fmt.Errorf("%w: %d buckets for %d bounds", errConversion, len(dist.Buckets), len(bounds))
+	}
+	counts := make([]uint64, len(dist.Buckets))
+	for i, b := range dist.Buckets {
+		counts[i] = uint64(b.Count)
+	}
+	return &ocHistogram{
+		count: uint64(dist.Count),
+		sum:   number.NewFloat64Number(dist.Sum),
+		buckets: aggregation.Buckets{
+			Boundaries: append([]float64(nil), bounds...),
+			Counts:     counts,
+		},
+		time: t,
+	}, nil
+}
+
+func (a *ocHistogram) Kind() aggregation.Kind { return aggregation.HistogramKind }
+
+func (a *ocHistogram) Count() (uint64, error) { return a.count, nil }
+
+func (a *ocHistogram) Sum() (number.Number, error) { return a.sum, nil }
+
+func (a *ocHistogram) Histogram() (aggregation.Buckets, error) { return a.buckets, nil }
+
+func (a *ocHistogram) end() time.Time { return a.time }
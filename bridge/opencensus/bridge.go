@@ -41,7 +41,11 @@ type otelTracer struct {
 var _ octrace.Tracer = (*otelTracer)(nil)
 
 func (o *otelTracer) StartSpan(ctx context.Context, name string, s ...octrace.StartOption) (context.Context, *octrace.Span) {
-	ctx, sp := o.tracer.Start(ctx, name, convertStartOptions(s, name)...)
+	return o.startSpan(ctx, name, s)
+}
+
+func (o *otelTracer) startSpan(ctx context.Context, name string, s []octrace.StartOption, opts ...trace.SpanOption) (context.Context, *octrace.Span) {
+	ctx, sp := o.tracer.Start(ctx, name, append(convertStartOptions(s, name), opts...)...)
 	return ctx, octrace.NewSpan(&span{otSpan: sp})
 }
 
@@ -61,29 +65,53 @@ func convertStartOptions(optFns []octrace.StartOption, name string) []trace.Span
 	}
 
 	if ocOpts.Sampler != nil {
-		otel.Handle(fmt.Errorf("ignoring custom sampler for span %q created by OpenCensus because OpenTelemetry does not support creating a span with a custom sampler", name))
+		otel.HandleSeverity("bridge/opencensus", otel.SeverityWarning, fmt.Errorf("ignoring custom sampler for span %q created by OpenCensus because OpenTelemetry does not support creating a span with a custom sampler", name))
 	}
 	return otOpts
 }
 
+// localParentLinkKey is the attribute of the link from a span started with a
+// remote parent to the current span of its context.
+var localParentLinkKey = label.Key("opencensus.link.local_parent")
+
 func (o *otelTracer) StartSpanWithRemoteParent(ctx context.Context, name string, parent octrace.SpanContext, s ...octrace.StartOption) (context.Context, *octrace.Span) {
+	remote := utils.OCSpanContextToOTel(parent)
+	// The remote parent replaces the current span of ctx as the parent. If
+	// the current span is in another trace, link to it so the trace it
+	// belongs to is not lost.
+	var opts []trace.SpanOption
+	if local := trace.SpanContextFromContext(ctx); local.IsValid() && local.TraceID != remote.TraceID {
+		opts = append(opts, trace.WithLinks(trace.Link{
+			SpanContext: local,
+			Attributes:  []label.KeyValue{localParentLinkKey.Bool(true)},
+		}))
+	}
 	// make sure span context is zero'd out so we use the remote parent
 	ctx = trace.ContextWithSpan(ctx, nil)
-	ctx = trace.ContextWithRemoteSpanContext(ctx, utils.OCSpanContextToOTel(parent))
-	return o.StartSpan(ctx, name, s...)
+	ctx = trace.ContextWithRemoteSpanContext(ctx, remote)
+	return o.startSpan(ctx, name, s, opts...)
 }
 
 func (o *otelTracer) FromContext(ctx context.Context) *octrace.Span {
 	otSpan := trace.SpanFromContext(ctx)
+	if fs, ok := otSpan.(*foreignSpan); ok {
+		return fs.ocSpan
+	}
 	return octrace.NewSpan(&span{otSpan: otSpan})
 }
 
+// NewContext returns a copy of parent with s as its current span. If s was
+// created by a different OpenCensus tracer, it is wrapped so that spans
+// started from the returned context with OpenTelemetry are its children.
 func (o *otelTracer) NewContext(parent context.Context, s *octrace.Span) context.Context {
 	if otSpan, ok := s.Internal().(*span); ok {
 		return trace.ContextWithSpan(parent, otSpan.otSpan)
 	}
-	otel.Handle(fmt.Errorf("unable to create context with span %q, since it was created using a different tracer", s.String()))
-	return parent
+	if !utils.OCSpanContextToOTel(s.SpanContext()).IsValid() {
+		otel.Handle(fmt.Errorf("unable to create context with span %q, since it was created using a different tracer and has an invalid span context", s.String()))
+		return parent
+	}
+	return trace.ContextWithSpan(parent, &foreignSpan{ocSpan: s, tracer: o})
 }
 
 type span struct {
@@ -182,9 +210,98 @@ func (s *span) AddMessageReceiveEvent(messageID, uncompressedByteSize, compresse
 }
 
 func (s *span) AddLink(l octrace.Link) {
-	otel.Handle(fmt.Errorf("ignoring OpenCensus link %+v for span %q because OpenTelemetry doesn't support setting links after creation", l, s.String()))
+	otel.HandleSeverity("bridge/opencensus", otel.SeverityWarning, fmt.Errorf("ignoring OpenCensus link %+v for span %q because OpenTelemetry doesn't support setting links after creation", l, s.String()))
 }
 
 func (s *span) String() string {
 	return fmt.Sprintf("span %s", s.otSpan.SpanContext().SpanID.String())
 }
+
+// foreignSpan is an OpenTelemetry Span wrapping an OpenCensus span created by
+// a different OpenCensus tracer than the bridge. Spans started from a
+// context with a foreignSpan are its children, and its operations are
+// forwarded to the OpenCensus span.
+type foreignSpan struct {
+	ocSpan *octrace.Span
+	tracer *otelTracer
+}
+
+var _ trace.Span = (*foreignSpan)(nil)
+
+func (s *foreignSpan) Tracer() trace.Tracer {
+	return s.tracer.tracer
+}
+
+func (s *foreignSpan) End(...trace.SpanOption) {
+	s.ocSpan.End()
+}
+
+func (s *foreignSpan) AddEvent(name string, options ...trace.EventOption) {
+	if !s.ocSpan.IsRecordingEvents() {
+		return
+	}
+	s.ocSpan.Annotate(convertLabelsToAttributes(eventAttributes(options)), name)
+}
+
+func (s *foreignSpan) IsRecording() bool {
+	return s.ocSpan.IsRecordingEvents()
+}
+
+func (s *foreignSpan) RecordError(err error, options ...trace.EventOption) {
+	if err == nil || !s.ocSpan.IsRecordingEvents() {
+		return
+	}
+	attrs := append(convertLabelsToAttributes(eventAttributes(options)), octrace.StringAttribute("error", err.Error()))
+	s.ocSpan.Annotate(attrs, "error")
+}
+
+// eventAttributes returns the attributes of an event with options,
+// including those of its attribute functions.
+func eventAttributes(options []trace.EventOption) []label.KeyValue {
+	c := trace.NewEventConfig(options...)
+	attrs := c.Attributes
+	for _, f := range c.AttributesFuncs {
+		attrs = append(attrs, f()...)
+	}
+	return attrs
+}
+
+func (s *foreignSpan) SpanContext() trace.SpanContext {
+	return utils.OCSpanContextToOTel(s.ocSpan.SpanContext())
+}
+
+func (s *foreignSpan) SetStatus(code codes.Code, msg string) {
+	s.ocSpan.SetStatus(octrace.Status{Code: int32(code), Message: msg})
+}
+
+func (s *foreignSpan) SetName(name string) {
+	s.ocSpan.SetName(name)
+}
+
+func (s *foreignSpan) SetAttributes(kv ...label.KeyValue) {
+	s.ocSpan.AddAttributes(convertLabelsToAttributes(kv)...)
+}
+
+func convertLabelsToAttributes(kvs []label.KeyValue) []octrace.Attribute {
+	attributes := make([]octrace.Attribute, len(kvs))
+	for i, kv := range kvs {
+		key := string(kv.Key)
+		switch kv.Value.Type() {
+		case label.BOOL:
+			attributes[i] = octrace.BoolAttribute(key, kv.Value.AsBool())
+		case label.INT32:
+			attributes[i] = octrace.Int64Attribute(key, int64(kv.Value.AsInt32()))
+		case label.INT64:
+			attributes[i] = octrace.Int64Attribute(key, kv.Value.AsInt64())
+		case label.UINT32:
+			attributes[i] = octrace.Int64Attribute(key, int64(kv.Value.AsUint32()))
+		case label.FLOAT32:
+			attributes[i] = octrace.Float64Attribute(key, float64(kv.Value.AsFloat32()))
+		case label.FLOAT64:
+			attributes[i] = octrace.Float64Attribute(key, kv.Value.AsFloat64())
+		default:
+			attributes[i] = octrace.StringAttribute(key, kv.Value.Emit())
+		}
+	}
+	return attributes
+}
@@ -27,6 +27,10 @@ import (
 	"go.opentelemetry.io/otel/trace"
 )
 
+// nativeTracer is the OpenCensus tracer, before the tests replace it with
+// the bridge.
+var nativeTracer = octrace.DefaultTracer
+
 func TestMixedAPIs(t *testing.T) {
 	sr := new(oteltest.StandardSpanRecorder)
 	tp := oteltest.NewTracerProvider(oteltest.WithSpanRecorder(sr))
@@ -116,6 +120,79 @@ func TestStartSpanWithRemoteParent(t *testing.T) {
 	}
 }
 
+func TestStartSpanWithRemoteParentLinksLocalSpan(t *testing.T) {
+	sr := new(oteltest.StandardSpanRecorder)
+	tp := oteltest.NewTracerProvider(oteltest.WithSpanRecorder(sr))
+	tracer := tp.Tracer("remoteparentlink")
+	octrace.DefaultTracer = NewTracer(tracer)
+
+	ctx, local := tracer.Start(context.Background(), "OpenTelemetrySpan")
+	_, remote := tracer.Start(context.Background(), "RemoteSpan")
+
+	_, span := octrace.StartSpanWithRemoteParent(ctx, "OpenCensusSpan", utils.OTelSpanContextToOC(remote.SpanContext()))
+	span.End()
+	// A remote parent in the same trace as the local span is not linked.
+	_, span = octrace.StartSpanWithRemoteParent(ctx, "OpenCensusSpan", utils.OTelSpanContextToOC(local.SpanContext()))
+	span.End()
+
+	spans := sr.Completed()
+	if len(spans) != 2 {
+		t.Fatalf("Got %d spans, expected %d", len(spans), 2)
+	}
+
+	if spans[0].ParentSpanID() != remote.SpanContext().SpanID {
+		t.Errorf("Span %v had parent %v. Expected %v", spans[0].Name(), spans[0].ParentSpanID(), remote.SpanContext().SpanID)
+	}
+	links := spans[0].Links()
+	if len(links) != 1 {
+		t.Fatalf("Got %d links, expected %d", len(links), 1)
+	}
+	if links[0].SpanContext.SpanID != local.SpanContext().SpanID {
+		t.Errorf("Got link to %v, expected %v", links[0].SpanContext.SpanID, local.SpanContext().SpanID)
+	}
+	if len(links[0].Attributes) != 1 || links[0].Attributes[0] != localParentLinkKey.Bool(true) {
+		t.Errorf("Got link attributes %v", links[0].Attributes)
+	}
+
+	if got := len(spans[1].Links()); got != 0 {
+		t.Errorf("Got %d links to a span of the same trace, expected 0", got)
+	}
+}
+
+func TestNewContextForeignSpan(t *testing.T) {
+	sr := new(oteltest.StandardSpanRecorder)
+	tp := oteltest.NewTracerProvider(oteltest.WithSpanRecorder(sr))
+	tracer := tp.Tracer("foreignspan")
+	bridge := NewTracer(tracer)
+
+	_, ocSpan := nativeTracer.StartSpan(context.Background(), "NativeSpan", octrace.WithSampler(octrace.AlwaysSample()))
+	defer ocSpan.End()
+
+	ctx := bridge.NewContext(context.Background(), ocSpan)
+	if got := bridge.FromContext(ctx); got != ocSpan {
+		t.Errorf("FromContext returned %v, expected the native span %v", got, ocSpan)
+	}
+	if !trace.SpanFromContext(ctx).IsRecording() {
+		t.Error("Native span is not recording")
+	}
+	trace.SpanFromContext(ctx).SetAttributes(label.String("key", "value"), label.Int32("int32", 1))
+
+	_, child := tracer.Start(ctx, "OpenTelemetrySpan")
+	child.End()
+
+	spans := sr.Completed()
+	if len(spans) != 1 {
+		t.Fatalf("Got %d spans, expected %d", len(spans), 1)
+	}
+	want := utils.OCSpanContextToOTel(ocSpan.SpanContext())
+	if got := spans[0].SpanContext().TraceID; got != want.TraceID {
+		t.Errorf("Span %v has trace ID %v. Expected %v", spans[0].Name(), got, want.TraceID)
+	}
+	if got := spans[0].ParentSpanID(); got != want.SpanID {
+		t.Errorf("Span %v has parent %v. Expected %v", spans[0].Name(), got, want.SpanID)
+	}
+}
+
 func TestToFromContext(t *testing.T) {
 	sr := new(oteltest.StandardSpanRecorder)
 	tp := oteltest.NewTracerProvider(oteltest.WithSpanRecorder(sr))
@@ -0,0 +1,178 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package opencensus
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+
+	"go.opencensus.io/metric/metricdata"
+	"go.opencensus.io/metric/metricexport"
+	ocresource "go.opencensus.io/resource"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/label"
+	"go.opentelemetry.io/otel/metric"
+	"go.opentelemetry.io/otel/metric/number"
+	export "go.opentelemetry.io/otel/sdk/export/metric"
+	"go.opentelemetry.io/otel/sdk/export/metric/aggregation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	"go.opentelemetry.io/otel/unit"
+)
+
+// instrumentationName is the instrumentation name of the metrics exported
+// through the bridge.
+const instrumentationName = "go.opentelemetry.io/otel/bridge/opencensus"
+
+var errConversion = errors.New("unable to convert from OpenCensus to OpenTelemetry")
+
+// NewMetricExporter returns an OpenCensus metric exporter that converts the
+// OpenCensus metrics it is given to OpenTelemetry records and exports them
+// with base, usually the exporter of the OpenTelemetry metric controller.
+//
+// Register it with an OpenCensus metric reader, for example:
+//
+//	reader, err := metricexport.NewIntervalReader(metricexport.NewReader(), opencensus.NewMetricExporter(exporter))
+//	if err != nil {
+//		...
+//	}
+//	reader.Start()
+//	defer reader.Stop()
+//
+// OpenCensus views export cumulative values, so base should accept
+// cumulative records. Summary metrics are not supported and are dropped.
+func NewMetricExporter(base export.Exporter) metricexport.Exporter {
+	return &exporter{base: base}
+}
+
+type exporter struct {
+	base export.Exporter
+}
+
+// ExportMetrics implements the OpenCensus metricexport.Exporter interface.
+func (e *exporter) ExportMetrics(ctx context.Context, metrics []*metricdata.Metric) error {
+	if len(metrics) == 0 {
+		return nil
+	}
+	return e.base.Export(ctx, &checkpointSet{metrics: metrics})
+}
+
+// checkpointSet is an export.CheckpointSet of OpenCensus metrics.
+type checkpointSet struct {
+	// RWMutex implements locking for the CheckpointSet interface.
+	sync.RWMutex
+	metrics []*metricdata.Metric
+}
+
+var _ export.CheckpointSet = &checkpointSet{}
+
+// ForEach calls recordFunc with a record for each time series of the
+// OpenCensus metrics. The metrics are cumulative whatever the kindSelector.
+// Time series that cannot be converted are passed to otel.Handle and
+// skipped.
+func (c *checkpointSet) ForEach(kindSelector export.ExportKindSelector, recordFunc func(export.Record) error) error {
+	for _, m := range c.metrics {
+		descriptor, err := convertDescriptor(m.Descriptor)
+		if err != nil {
+			otel.Handle(err)
+			continue
+		}
+		res := convertResource(m.Resource)
+		for _, ts := range m.TimeSeries {
+			if len(ts.Points) == 0 {
+				continue
+			}
+			labels, err := convertLabels(m.Descriptor.LabelKeys, ts.LabelValues)
+			if err != nil {
+				otel.Handle(err)
+				continue
+			}
+			agg, err := newAggregationFromPoints(m.Descriptor.Type, ts.Points)
+			if err != nil {
+				otel.Handle(err)
+				continue
+			}
+			record := export.NewRecord(&descriptor, &labels, res, agg, ts.StartTime, agg.end())
+			if err := recordFunc(record); err != nil && !errors.Is(err, aggregation.ErrNoData) {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// convertDescriptor converts an OpenCensus descriptor to an OpenTelemetry
+// one. Gauges become ValueObservers, cumulative values SumObservers, and
+// distributions ValueRecorders.
+func convertDescriptor(desc metricdata.Descriptor) (metric.Descriptor, error) {
+	var (
+		ikind metric.InstrumentKind
+		nkind number.Kind
+	)
+	switch desc.Type {
+	case metricdata.TypeGaugeInt64:
+		ikind, nkind = metric.ValueObserverInstrumentKind, number.Int64Kind
+	case metricdata.TypeGaugeFloat64:
+		ikind, nkind = metric.ValueObserverInstrumentKind, number.Float64Kind
+	case metricdata.TypeCumulativeInt64:
+		ikind, nkind = metric.SumObserverInstrumentKind, number.Int64Kind
+	case metricdata.TypeCumulativeFloat64:
+		ikind, nkind = metric.SumObserverInstrumentKind, number.Float64Kind
+	case metricdata.TypeGaugeDistribution, metricdata.TypeCumulativeDistribution:
+		ikind, nkind = metric.ValueRecorderInstrumentKind, number.Float64Kind
+	default:
+		// TypeSummary has no OpenTelemetry equivalent.
+		return metric.Descriptor{}, fmt.Errorf("%w: metric %q of type %v", errConversion, desc.Name, desc.Type)
+	}
+	opts := []metric.InstrumentOption{
+		metric.WithDescription(desc.Description),
+		metric.WithInstrumentationName(instrumentationName),
+	}
+	if desc.Unit != "" {
+		opts = append(opts, metric.WithUnit(unit.Unit(desc.Unit)))
+	}
+	return metric.NewDescriptor(desc.Name, ikind, nkind, opts...), nil
+}
+
+// convertLabels converts the OpenCensus label keys and values of a time
+// series to a label set. Labels without a value are omitted.
+func convertLabels(keys []metricdata.LabelKey, values []metricdata.LabelValue) (label.Set, error) {
+	if len(keys) != len(values) {
+		return label.NewSet(), fmt.Errorf("%w: %d label keys for %d label values", errConversion, len(keys), len(values))
+	}
+	kvs := make([]label.KeyValue, 0, len(keys))
+	for i, k := range keys {
+		if !values[i].Present {
+			continue
+		}
+		kvs = append(kvs, label.String(k.Key, values[i].Value))
+	}
+	return label.NewSet(kvs...), nil
+}
+
+// convertResource converts an OpenCensus resource to an OpenTelemetry one,
+// or returns nil if there is none.
+func convertResource(res *ocresource.Resource) *resource.Resource {
+	if res == nil {
+		return nil
+	}
+	kvs := make([]label.KeyValue, 0, len(res.Labels))
+	for k, v := range res.Labels {
+		kvs = append(kvs, label.String(k, v))
+	}
+	return resource.NewWithAttributes(kvs...)
+}
@@ -0,0 +1,195 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package opencensus
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"go.opencensus.io/metric/metricdata"
+	ocresource "go.opencensus.io/resource"
+
+	"go.opentelemetry.io/otel/label"
+	"go.opentelemetry.io/otel/metric"
+	"go.opentelemetry.io/otel/metric/number"
+	export "go.opentelemetry.io/otel/sdk/export/metric"
+	"go.opentelemetry.io/otel/sdk/export/metric/aggregation"
+)
+
+type recordingExporter struct {
+	export.ExportKindSelector
+	records []export.Record
+}
+
+func (e *recordingExporter) Export(_ context.Context, cps export.CheckpointSet) error {
+	return cps.ForEach(e, func(r export.Record) error {
+		e.records = append(e.records, r)
+		return nil
+	})
+}
+
+func TestMetricExporter(t *testing.T) {
+	start := time.Unix(100, 0)
+	end := start.Add(time.Minute)
+	res := &ocresource.Resource{Labels: map[string]string{"service": "svc"}}
+
+	metrics := []*metricdata.Metric{
+		{
+			Descriptor: metricdata.Descriptor{
+				Name:        "gauge",
+				Description: "a gauge",
+				Unit:        metricdata.UnitBytes,
+				Type:        metricdata.TypeGaugeInt64,
+				LabelKeys:   []metricdata.LabelKey{{Key: "a"}, {Key: "b"}},
+			},
+			Resource: res,
+			TimeSeries: []*metricdata.TimeSeries{{
+				LabelValues: []metricdata.LabelValue{{Value: "1", Present: true}, {}},
+				Points:      []metricdata.Point{metricdata.NewInt64Point(start, 1), metricdata.NewInt64Point(end, 2)},
+			}},
+		},
+		{
+			Descriptor: metricdata.Descriptor{Name: "cumulative", Type: metricdata.TypeCumulativeFloat64},
+			TimeSeries: []*metricdata.TimeSeries{
+				{Points: []metricdata.Point{metricdata.NewFloat64Point(end, 1.5)}, StartTime: start},
+				// Time series without points are skipped.
+				{},
+			},
+		},
+		{
+			Descriptor: metricdata.Descriptor{Name: "distribution", Type: metricdata.TypeCumulativeDistribution},
+			TimeSeries: []*metricdata.TimeSeries{{
+				Points: []metricdata.Point{metricdata.NewDistributionPoint(end, &metricdata.Distribution{
+					Count:         3,
+					Sum:           12,
+					BucketOptions: &metricdata.BucketOptions{Bounds: []float64{5}},
+					Buckets:       []metricdata.Bucket{{Count: 1}, {Count: 2}},
+				})},
+				StartTime: start,
+			}},
+		},
+		{
+			// Summaries are not supported and dropped.
+			Descriptor: metricdata.Descriptor{Name: "summary", Type: metricdata.TypeSummary},
+			TimeSeries: []*metricdata.TimeSeries{{
+				Points: []metricdata.Point{{Time: end, Value: &metricdata.Summary{}}},
+			}},
+		},
+	}
+
+	base := &recordingExporter{ExportKindSelector: export.CumulativeExportKindSelector()}
+	if err := NewMetricExporter(base).ExportMetrics(context.Background(), metrics); err != nil {
+		t.Fatal(err)
+	}
+	if len(base.records) != 3 {
+		t.Fatalf("exported %d records, want 3", len(base.records))
+	}
+
+	gauge := base.records[0]
+	if got := gauge.Descriptor(); got.Name() != "gauge" || got.InstrumentKind() != metric.ValueObserverInstrumentKind ||
+		got.NumberKind() != number.Int64Kind || got.Description() != "a gauge" || got.Unit() != "By" {
+		t.Errorf("unexpected gauge descriptor %+v", got)
+	}
+	wantLabels := label.NewSet(label.String("a", "1"))
+	if !gauge.Labels().Equals(&wantLabels) {
+		t.Errorf("gauge labels: got %v, want %v", gauge.Labels(), &wantLabels)
+	}
+	if v, _ := gauge.Resource().LabelSet().Value("service"); v.AsString() != "svc" {
+		t.Errorf("gauge resource: got %v", gauge.Resource())
+	}
+	lv, ts, err := gauge.Aggregation().(aggregation.LastValue).LastValue()
+	if err != nil || lv.AsInt64() != 2 || !ts.Equal(end) {
+		t.Errorf("gauge last value: got %v at %v (%v), want 2 at %v", lv.AsInt64(), ts, err, end)
+	}
+
+	cumulative := base.records[1]
+	if got := cumulative.Descriptor(); got.InstrumentKind() != metric.SumObserverInstrumentKind || got.NumberKind() != number.Float64Kind {
+		t.Errorf("unexpected cumulative descriptor %+v", got)
+	}
+	if !cumulative.StartTime().Equal(start) || !cumulative.EndTime().Equal(end) {
+		t.Errorf("cumulative interval: got [%v, %v], want [%v, %v]", cumulative.StartTime(), cumulative.EndTime(), start, end)
+	}
+	if sum, err := cumulative.Aggregation().(aggregation.Sum).Sum(); err != nil || sum.AsFloat64() != 1.5 {
+		t.Errorf("cumulative sum: got %v (%v), want 1.5", sum.AsFloat64(), err)
+	}
+
+	histogram := base.records[2].Aggregation().(aggregation.Histogram)
+	count, _ := histogram.Count()
+	sum, _ := histogram.Sum()
+	buckets, _ := histogram.Histogram()
+	if count != 3 || sum.AsFloat64() != 12 || len(buckets.Boundaries) != 1 || buckets.Boundaries[0] != 5 ||
+		len(buckets.Counts) != 2 || buckets.Counts[0] != 1 || buckets.Counts[1] != 2 {
+		t.Errorf("unexpected histogram: count %d, sum %v, buckets %+v", count, sum.AsFloat64(), buckets)
+	}
+}
+
+func TestMetricExporterInvalidTimeSeries(t *testing.T) {
+	metrics := []*metricdata.Metric{
+		{
+			Descriptor: metricdata.Descriptor{
+				Name:      "mismatched labels",
+				Type:      metricdata.TypeGaugeInt64,
+				LabelKeys: []metricdata.LabelKey{{Key: "a"}},
+			},
+			TimeSeries: []*metricdata.TimeSeries{{Points: []metricdata.Point{metricdata.NewInt64Point(time.Now(), 1)}}},
+		},
+		{
+			Descriptor: metricdata.Descriptor{Name: "mismatched buckets", Type: metricdata.TypeGaugeDistribution},
+			TimeSeries: []*metricdata.TimeSeries{{
+				Points: []metricdata.Point{metricdata.NewDistributionPoint(time.Now(), &metricdata.Distribution{
+					BucketOptions: &metricdata.BucketOptions{Bounds: []float64{1, 2}},
+					Buckets:       []metricdata.Bucket{{Count: 1}},
+				})},
+			}},
+		},
+	}
+
+	base := &recordingExporter{ExportKindSelector: export.CumulativeExportKindSelector()}
+	if err := NewMetricExporter(base).ExportMetrics(context.Background(), metrics); err != nil {
+		t.Fatal(err)
+	}
+	if len(base.records) != 0 {
+		t.Errorf("exported %d invalid records, want 0", len(base.records))
+	}
+}
+
+type errorExporter struct {
+	export.ExportKindSelector
+	err error
+}
+
+func (e errorExporter) Export(_ context.Context, cps export.CheckpointSet) error {
+	return cps.ForEach(e, func(export.Record) error {
+		return e.err
+	})
+}
+
+func TestMetricExporterError(t *testing.T) {
+	metrics := []*metricdata.Metric{{
+		Descriptor: metricdata.Descriptor{Name: "gauge", Type: metricdata.TypeGaugeFloat64},
+		TimeSeries: []*metricdata.TimeSeries{{Points: []metricdata.Point{metricdata.NewFloat64Point(time.Now(), 1)}}},
+	}}
+
+	want := errors.New("export failed")
+	if err := NewMetricExporter(errorExporter{ExportKindSelector: export.CumulativeExportKindSelector(), err: want}).ExportMetrics(context.Background(), metrics); !errors.Is(err, want) {
+		t.Errorf("got error %v, want %v", err, want)
+	}
+	// ErrNoData is not an export error.
+	if err := NewMetricExporter(errorExporter{ExportKindSelector: export.CumulativeExportKindSelector(), err: aggregation.ErrNoData}).ExportMetrics(context.Background(), metrics); err != nil {
+		t.Errorf("got error %v, want nil", err)
+	}
+}
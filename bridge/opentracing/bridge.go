@@ -18,6 +18,7 @@ import (
 	"context"
 	"fmt"
 	"net/http"
+	"reflect"
 	"strings"
 	"sync"
 
@@ -63,17 +64,47 @@ func (c *bridgeSpanContext) ForeachBaggageItem(handler func(k, v string) bool) {
 	})
 }
 
+// setBaggageItem sets the baggage item with restrictedKey, replacing an
+// item whose key differs only in case. Keys are stored as given so they
+// are propagated unchanged as OpenTelemetry baggage.
 func (c *bridgeSpanContext) setBaggageItem(restrictedKey, value string) {
-	crk := http.CanonicalHeaderKey(restrictedKey)
-	c.baggageItems = c.baggageItems.Apply(baggage.MapUpdate{SingleKV: label.String(crk, value)})
+	update := baggage.MapUpdate{SingleKV: label.String(restrictedKey, value)}
+	if key, ok := c.baggageKey(restrictedKey); ok && string(key) != restrictedKey {
+		update.DropSingleK = key
+	}
+	c.baggageItems = c.baggageItems.Apply(update)
 }
 
 func (c *bridgeSpanContext) baggageItem(restrictedKey string) string {
-	crk := http.CanonicalHeaderKey(restrictedKey)
-	val, _ := c.baggageItems.Value(label.Key(crk))
+	key, ok := c.baggageKey(restrictedKey)
+	if !ok {
+		return ""
+	}
+	val, _ := c.baggageItems.Value(key)
 	return val.Emit()
 }
 
+// baggageKey returns the key of the baggage item matching restrictedKey.
+// OpenTracing baggage keys are case-insensitive, so an exact match is
+// preferred over one that differs only in case.
+func (c *bridgeSpanContext) baggageKey(restrictedKey string) (label.Key, bool) {
+	if c.baggageItems.HasValue(label.Key(restrictedKey)) {
+		return label.Key(restrictedKey), true
+	}
+	var (
+		key   label.Key
+		found bool
+	)
+	c.baggageItems.Foreach(func(kv label.KeyValue) bool {
+		if strings.EqualFold(string(kv.Key), restrictedKey) {
+			key, found = kv.Key, true
+			return false
+		}
+		return true
+	})
+	return key, found
+}
+
 type bridgeSpan struct {
 	otelSpan          trace.Span
 	ctx               *bridgeSpanContext
@@ -235,6 +266,11 @@ func (s *bridgeSpan) updateOTelContext(restrictedKey, value string) {
 	if s.extraBaggageItems == nil {
 		s.extraBaggageItems = make(map[string]string)
 	}
+	for k := range s.extraBaggageItems {
+		if k != restrictedKey && strings.EqualFold(k, restrictedKey) {
+			delete(s.extraBaggageItems, k)
+		}
+	}
 	s.extraBaggageItems[restrictedKey] = value
 }
 
@@ -441,6 +477,13 @@ func (t *BridgeTracer) ContextWithBridgeSpan(ctx context.Context, span trace.Spa
 		otSpanContext = parentSpan.Context()
 	}
 	bCtx := newBridgeSpanContext(span.SpanContext(), otSpanContext)
+	// Make the OpenTelemetry baggage of ctx available as baggage items of
+	// the span. The hooks are cleared so they are not called.
+	clearCtx, _, _ := baggage.ContextWithNoHooks(ctx)
+	baggage.MapFromContext(clearCtx).Foreach(func(kv label.KeyValue) bool {
+		bCtx.setBaggageItem(string(kv.Key), kv.Value.Emit())
+		return true
+	})
 	bSpan := newBridgeSpan(span, bCtx, t)
 	bSpan.skipDeferHook = true
 	return ot.ContextWithSpan(ctx, bSpan)
@@ -516,9 +559,25 @@ func otTagToOTelLabel(k string, v interface{}) label.KeyValue {
 		return key.Int(val)
 	case uint:
 		return key.Uint(val)
+	case int8:
+		return key.Int32(int32(val))
+	case int16:
+		return key.Int32(int32(val))
+	case uint8:
+		return key.Uint32(uint32(val))
+	case uint16:
+		return key.Uint32(uint32(val))
 	case string:
 		return key.String(val)
 	default:
+		if v != nil {
+			switch reflect.TypeOf(v).Kind() {
+			case reflect.Array, reflect.Slice:
+				if arr := label.ArrayValue(v); arr.Type() == label.ARRAY {
+					return label.KeyValue{Key: key, Value: arr}
+				}
+			}
+		}
 		return key.String(fmt.Sprint(v))
 	}
 }
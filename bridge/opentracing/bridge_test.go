@@ -0,0 +1,136 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package opentracing
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"reflect"
+	"testing"
+
+	ot "github.com/opentracing/opentracing-go"
+
+	otelbaggage "go.opentelemetry.io/otel/internal/baggage"
+	"go.opentelemetry.io/otel/label"
+	"go.opentelemetry.io/otel/propagation"
+
+	"go.opentelemetry.io/otel/bridge/opentracing/internal"
+)
+
+func TestSetTagTypes(t *testing.T) {
+	tracer := internal.NewMockTracer()
+	bridge := NewBridgeTracer()
+	bridge.SetOpenTelemetryTracer(tracer)
+
+	span := bridge.StartSpan("span")
+	span.SetTag("bool", true).
+		SetTag("int", 1).
+		SetTag("int8", int8(-8)).
+		SetTag("int16", int16(-16)).
+		SetTag("uint8", uint8(8)).
+		SetTag("uint16", uint16(16)).
+		SetTag("float64", 1.5).
+		SetTag("strings", []string{"a", "b"}).
+		SetTag("error-value", errors.New("failed"))
+	span.Finish()
+
+	attrs := tracer.FinishedSpans[0].Attributes
+	for _, want := range []label.KeyValue{
+		label.Bool("bool", true),
+		label.Int("int", 1),
+		label.Int32("int8", -8),
+		label.Int32("int16", -16),
+		label.Uint32("uint8", 8),
+		label.Uint32("uint16", 16),
+		label.Float64("float64", 1.5),
+		label.Array("strings", []string{"a", "b"}),
+		label.String("error-value", "failed"),
+	} {
+		got, ok := attrs.Value(want.Key)
+		if !ok {
+			t.Errorf("missing attribute %q", want.Key)
+			continue
+		}
+		if got.Type() != want.Value.Type() || !reflect.DeepEqual(got.AsInterface(), want.Value.AsInterface()) {
+			t.Errorf("attribute %q: got %s (%v), want %s (%v)", want.Key, got.Type(), got.AsInterface(), want.Value.Type(), want.Value.AsInterface())
+		}
+	}
+}
+
+func TestBaggageItemKeysCaseInsensitive(t *testing.T) {
+	bridge := NewBridgeTracer()
+	bridge.SetOpenTelemetryTracer(internal.NewMockTracer())
+
+	span := bridge.StartSpan("span")
+	span.SetBaggageItem("Key", "one")
+	if got := span.BaggageItem("key"); got != "one" {
+		t.Errorf("BaggageItem(\"key\") = %q, want %q", got, "one")
+	}
+
+	// Setting a key that differs only in case replaces the item.
+	span.SetBaggageItem("KEY", "two")
+	items := map[string]string{}
+	span.Context().ForeachBaggageItem(func(k, v string) bool {
+		items[k] = v
+		return true
+	})
+	if want := map[string]string{"KEY": "two"}; !reflect.DeepEqual(items, want) {
+		t.Errorf("baggage items: got %v, want %v", items, want)
+	}
+}
+
+func TestExtractedBaggageKeysUnchanged(t *testing.T) {
+	bridge := NewBridgeTracer()
+	bridge.SetOpenTelemetryTracer(internal.NewMockTracer())
+	bridge.SetTextMapPropagator(propagation.NewCompositeTextMapPropagator(propagation.TraceContext{}, propagation.Baggage{}))
+
+	header := http.Header{}
+	header.Set("traceparent", "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01")
+	header.Set("baggage", "lower=1")
+	sc, err := bridge.Extract(ot.HTTPHeaders, ot.HTTPHeadersCarrier(header))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	span := bridge.StartSpan("span", ot.ChildOf(sc))
+	if got := span.BaggageItem("lower"); got != "1" {
+		t.Errorf("BaggageItem(\"lower\") = %q, want %q", got, "1")
+	}
+
+	out := http.Header{}
+	if err := bridge.Inject(span.Context(), ot.HTTPHeaders, ot.HTTPHeadersCarrier(out)); err != nil {
+		t.Fatal(err)
+	}
+	if got := out.Get("baggage"); got != "lower=1" {
+		t.Errorf("injected baggage %q, want %q", got, "lower=1")
+	}
+}
+
+func TestContextWithBridgeSpanBaggage(t *testing.T) {
+	tracer := internal.NewMockTracer()
+	bridge := NewBridgeTracer()
+	bridge.SetOpenTelemetryTracer(tracer)
+
+	ctx := otelbaggage.ContextWithMap(context.Background(), otelbaggage.NewMap(otelbaggage.MapUpdate{
+		SingleKV: label.String("otel", "value"),
+	}))
+	_, otelSpan := tracer.Start(ctx, "span")
+	ctx = bridge.ContextWithBridgeSpan(ctx, otelSpan)
+
+	if got := ot.SpanFromContext(ctx).BaggageItem("otel"); got != "value" {
+		t.Errorf("BaggageItem(\"otel\") = %q, want %q", got, "value")
+	}
+}
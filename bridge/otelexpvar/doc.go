@@ -0,0 +1,33 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package otelexpvar bridges OpenTelemetry metrics and the expvar
+// package, for services with existing /debug/vars tooling.
+//
+// Exporter is a metric exporter keeping the last checkpoint set it
+// exported. It is an expvar.Var, so it can be published to show the latest
+// values of the OpenTelemetry metrics in /debug/vars:
+//
+//	exporter := otelexpvar.NewExporter()
+//	expvar.Publish("otel", exporter)
+//	pusher := controller.New(
+//		processor.New(simple.NewWithInexpensiveDistribution(), exporter),
+//		controller.WithPusher(exporter),
+//	)
+//
+// In the other direction, ObserveVars observes the values of published
+// expvar.Int and expvar.Float variables with ValueObservers:
+//
+//	err := otelexpvar.ObserveVars(meter, "requests", "load")
+package otelexpvar // import "go.opentelemetry.io/otel/bridge/otelexpvar"
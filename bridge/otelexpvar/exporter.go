@@ -0,0 +1,188 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package otelexpvar // import "go.opentelemetry.io/otel/bridge/otelexpvar"
+
+import (
+	"context"
+	"encoding/json"
+	"expvar"
+	"strings"
+	"sync"
+
+	"go.opentelemetry.io/otel/label"
+	"go.opentelemetry.io/otel/metric"
+	export "go.opentelemetry.io/otel/sdk/export/metric"
+	"go.opentelemetry.io/otel/sdk/export/metric/aggregation"
+)
+
+// config contains the options of an Exporter.
+type config struct {
+	exportKindSelector export.ExportKindSelector
+	labelEncoder       label.Encoder
+}
+
+// Option sets an option of an Exporter.
+type Option func(*config)
+
+// WithExportKindSelector sets the export kinds of the records. The
+// records are cumulative by default.
+func WithExportKindSelector(selector export.ExportKindSelector) Option {
+	return func(c *config) {
+		c.exportKindSelector = selector
+	}
+}
+
+// WithLabelEncoder sets the encoder of the labels in the names of the
+// values. It defaults to label.DefaultEncoder().
+func WithLabelEncoder(enc label.Encoder) Option {
+	return func(c *config) {
+		c.labelEncoder = enc
+	}
+}
+
+// Exporter is a metric exporter keeping the values of the last checkpoint
+// set it exported. It implements expvar.Var.
+type Exporter struct {
+	cfg config
+
+	mu     sync.RWMutex
+	values map[string]value
+}
+
+var (
+	_ export.Exporter = (*Exporter)(nil)
+	_ expvar.Var      = (*Exporter)(nil)
+)
+
+// value holds the values of a record, according to its aggregation.
+type value struct {
+	Sum       interface{} `json:"Sum,omitempty"`
+	Count     interface{} `json:"Count,omitempty"`
+	Min       interface{} `json:"Min,omitempty"`
+	Max       interface{} `json:"Max,omitempty"`
+	LastValue interface{} `json:"Last,omitempty"`
+}
+
+// NewExporter returns an Exporter configured with opts.
+func NewExporter(opts ...Option) *Exporter {
+	cfg := config{
+		exportKindSelector: export.CumulativeExportKindSelector(),
+		labelEncoder:       label.DefaultEncoder(),
+	}
+	for _, opt := range opts {
+		opt(&cfg)
+	}
+	return &Exporter{
+		cfg:    cfg,
+		values: map[string]value{},
+	}
+}
+
+// ExportKindFor implements export.ExportKindSelector.
+func (e *Exporter) ExportKindFor(desc *metric.Descriptor, kind aggregation.Kind) export.ExportKind {
+	return e.cfg.exportKindSelector.ExportKindFor(desc, kind)
+}
+
+// Export replaces the values of the Exporter with those of the records of
+// cps. The values are left unchanged if any record fails.
+func (e *Exporter) Export(_ context.Context, cps export.CheckpointSet) error {
+	values := make(map[string]value)
+	err := cps.ForEach(e, func(record export.Record) error {
+		v, err := recordValue(record)
+		if err != nil {
+			return err
+		}
+		values[e.name(record)] = v
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	e.values = values
+	return nil
+}
+
+// String returns the values of the Exporter as a JSON object, keyed by the
+// name of the instruments followed by their encoded labels, if any.
+func (e *Exporter) String() string {
+	e.mu.RLock()
+	defer e.mu.RUnlock()
+	data, err := json.Marshal(e.values)
+	if err != nil {
+		return "{}"
+	}
+	return string(data)
+}
+
+// name returns the name of the value of a record.
+func (e *Exporter) name(record export.Record) string {
+	iter := record.Labels().Iter()
+	if iter.Len() == 0 {
+		return record.Descriptor().Name()
+	}
+	var sb strings.Builder
+	sb.WriteString(record.Descriptor().Name())
+	sb.WriteRune('{')
+	sb.WriteString(record.Labels().Encoded(e.cfg.labelEncoder))
+	sb.WriteRune('}')
+	return sb.String()
+}
+
+// recordValue returns the values of the aggregation of a record.
+func recordValue(record export.Record) (value, error) {
+	var v value
+	agg := record.Aggregation()
+	kind := record.Descriptor().NumberKind()
+
+	if sum, ok := agg.(aggregation.Sum); ok {
+		s, err := sum.Sum()
+		if err != nil {
+			return v, err
+		}
+		v.Sum = s.AsInterface(kind)
+	}
+	if count, ok := agg.(aggregation.Count); ok {
+		c, err := count.Count()
+		if err != nil {
+			return v, err
+		}
+		v.Count = c
+	}
+	if min, ok := agg.(aggregation.Min); ok {
+		m, err := min.Min()
+		if err != nil {
+			return v, err
+		}
+		v.Min = m.AsInterface(kind)
+	}
+	if max, ok := agg.(aggregation.Max); ok {
+		m, err := max.Max()
+		if err != nil {
+			return v, err
+		}
+		v.Max = m.AsInterface(kind)
+	}
+	if lv, ok := agg.(aggregation.LastValue); ok {
+		l, _, err := lv.LastValue()
+		if err != nil {
+			return v, err
+		}
+		v.LastValue = l.AsInterface(kind)
+	}
+	return v, nil
+}
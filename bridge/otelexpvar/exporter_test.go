@@ -0,0 +1,89 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package otelexpvar_test
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.opentelemetry.io/otel/bridge/otelexpvar"
+	"go.opentelemetry.io/otel/label"
+	"go.opentelemetry.io/otel/metric"
+	export "go.opentelemetry.io/otel/sdk/export/metric"
+	controller "go.opentelemetry.io/otel/sdk/metric/controller/basic"
+	processor "go.opentelemetry.io/otel/sdk/metric/processor/basic"
+	"go.opentelemetry.io/otel/sdk/metric/selector/simple"
+)
+
+func newPipeline(exporter *otelexpvar.Exporter) (*controller.Controller, metric.Meter) {
+	cont := controller.New(
+		processor.New(simple.NewWithInexpensiveDistribution(), exporter),
+		controller.WithPusher(exporter),
+	)
+	return cont, cont.MeterProvider().Meter("test")
+}
+
+func TestExporter(t *testing.T) {
+	exporter := otelexpvar.NewExporter()
+	assert.Equal(t, "{}", exporter.String())
+
+	ctx := context.Background()
+	cont, meter := newPipeline(exporter)
+	require.NoError(t, cont.Start(ctx))
+
+	m := metric.Must(meter)
+	counter := m.NewInt64Counter("requests")
+	counter.Add(ctx, 2, label.String("code", "200"))
+	counter.Add(ctx, 1, label.String("code", "200"))
+	counter.Add(ctx, 1, label.String("code", "500"))
+	recorder := m.NewFloat64ValueRecorder("latency")
+	recorder.Record(ctx, 1.5)
+	recorder.Record(ctx, 0.5)
+	m.NewInt64ValueObserver("load", func(_ context.Context, result metric.Int64ObserverResult) {
+		result.Observe(7)
+	})
+	require.NoError(t, cont.Stop(ctx))
+
+	var got map[string]map[string]interface{}
+	require.NoError(t, json.Unmarshal([]byte(exporter.String()), &got))
+	assert.Equal(t, map[string]map[string]interface{}{
+		"requests{code=200}": {"Sum": 3.0},
+		"requests{code=500}": {"Sum": 1.0},
+		"latency":            {"Sum": 2.0, "Count": 2.0, "Min": 0.5, "Max": 1.5},
+		"load":               {"Last": 7.0},
+	}, got)
+}
+
+func TestExporterReplacesValues(t *testing.T) {
+	exporter := otelexpvar.NewExporter(otelexpvar.WithExportKindSelector(export.DeltaExportKindSelector()))
+	ctx := context.Background()
+	cont, meter := newPipeline(exporter)
+	counter := metric.Must(meter).NewInt64Counter("requests")
+
+	require.NoError(t, cont.Start(ctx))
+	counter.Add(ctx, 2)
+	require.NoError(t, cont.Stop(ctx))
+	assert.JSONEq(t, `{"requests":{"Sum":2}}`, exporter.String())
+
+	// Instruments that were not updated are not exported again with
+	// delta export kinds.
+	require.NoError(t, cont.Start(ctx))
+	require.NoError(t, cont.Stop(ctx))
+	assert.JSONEq(t, `{}`, exporter.String())
+}
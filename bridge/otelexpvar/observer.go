@@ -0,0 +1,64 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package otelexpvar // import "go.opentelemetry.io/otel/bridge/otelexpvar"
+
+import (
+	"context"
+	"errors"
+	"expvar"
+	"fmt"
+
+	"go.opentelemetry.io/otel/metric"
+)
+
+// ErrUnsupportedVar is returned by ObserveVars for a variable that is not
+// published or is neither an expvar.Int nor an expvar.Float.
+var ErrUnsupportedVar = errors.New("unsupported expvar variable")
+
+// ObserveVars registers with meter a ValueObserver named after each of the
+// published expvar variables of names, observing its value. expvar.Int
+// variables are observed with an Int64ValueObserver and expvar.Float ones
+// with a Float64ValueObserver. No observer is registered if any variable
+// is not supported.
+func ObserveVars(meter metric.Meter, names ...string) error {
+	vars := make([]expvar.Var, len(names))
+	for i, name := range names {
+		v := expvar.Get(name)
+		switch v.(type) {
+		case *expvar.Int, *expvar.Float:
+		default:
+			return fmt.Errorf("%w: %q of type %T", ErrUnsupportedVar, name, v)
+		}
+		vars[i] = v
+	}
+
+	for i, name := range names {
+		var err error
+		switch v := vars[i].(type) {
+		case *expvar.Int:
+			_, err = meter.NewInt64ValueObserver(name, func(_ context.Context, result metric.Int64ObserverResult) {
+				result.Observe(v.Value())
+			})
+		case *expvar.Float:
+			_, err = meter.NewFloat64ValueObserver(name, func(_ context.Context, result metric.Float64ObserverResult) {
+				result.Observe(v.Value())
+			})
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
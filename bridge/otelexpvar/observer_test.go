@@ -0,0 +1,61 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package otelexpvar_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"expvar"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.opentelemetry.io/otel/bridge/otelexpvar"
+)
+
+func TestObserveVars(t *testing.T) {
+	requests := expvar.NewInt("test.requests")
+	requests.Add(3)
+	load := expvar.NewFloat("test.load")
+	load.Set(0.75)
+
+	exporter := otelexpvar.NewExporter()
+	ctx := context.Background()
+	cont, meter := newPipeline(exporter)
+	require.NoError(t, otelexpvar.ObserveVars(meter, "test.requests", "test.load"))
+
+	require.NoError(t, cont.Start(ctx))
+	require.NoError(t, cont.Stop(ctx))
+
+	var got map[string]map[string]interface{}
+	require.NoError(t, json.Unmarshal([]byte(exporter.String()), &got))
+	assert.Equal(t, map[string]map[string]interface{}{
+		"test.requests": {"Last": 3.0},
+		"test.load":     {"Last": 0.75},
+	}, got)
+}
+
+func TestObserveVarsUnsupported(t *testing.T) {
+	expvar.NewString("test.string").Set("value")
+	expvar.NewInt("test.supported")
+
+	_, meter := newPipeline(otelexpvar.NewExporter())
+	for _, name := range []string{"test.string", "test.missing"} {
+		err := otelexpvar.ObserveVars(meter, "test.supported", name)
+		assert.True(t, errors.Is(err, otelexpvar.ErrUnsupportedVar), "%s: %v", name, err)
+	}
+}
@@ -0,0 +1,33 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package otelslog provides a log/slog Handler that emits the records it
+// handles to an OpenTelemetry LoggerProvider.
+//
+// The Handler emits the records with the context passed to the slog
+// methods, e.g. slog.InfoContext, so an SDK LoggerProvider associates them
+// with the span of that context:
+//
+//	logger := slog.New(otelslog.NewHandler(provider))
+//	logger.InfoContext(ctx, "hello", "user", "gopher")
+//
+// slog levels are mapped to OpenTelemetry severities by offset:
+// slog.LevelDebug is log.SeverityDebug, slog.LevelInfo is
+// log.SeverityInfo, and so on. Attributes are converted to labels, groups
+// becoming MAP values.
+//
+// This package is currently in a pre-GA phase. Backwards incompatible
+// changes may be introduced in subsequent minor version releases as we work
+// to track the evolving OpenTelemetry specification and user feedback.
+package otelslog // import "go.opentelemetry.io/otel/bridge/otelslog"
@@ -0,0 +1,22 @@
+module go.opentelemetry.io/otel/bridge/otelslog
+
+go 1.21
+
+replace (
+	go.opentelemetry.io/otel => ../..
+	go.opentelemetry.io/otel/sdk => ../../sdk
+)
+
+require (
+	github.com/stretchr/testify v1.7.0
+	go.opentelemetry.io/otel v0.16.0
+	go.opentelemetry.io/otel/sdk v0.16.0
+)
+
+require (
+	github.com/davecgh/go-spew v1.1.0 // indirect
+	github.com/go-logr/logr v1.2.3 // indirect
+	github.com/go-logr/stdr v1.2.2 // indirect
+	github.com/pmezard/go-difflib v1.0.0 // indirect
+	gopkg.in/yaml.v3 v3.0.1 // indirect
+)
@@ -0,0 +1,185 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package otelslog // import "go.opentelemetry.io/otel/bridge/otelslog"
+
+import (
+	"context"
+	"log/slog"
+
+	"go.opentelemetry.io/otel/label"
+	"go.opentelemetry.io/otel/log"
+)
+
+// instrumentationName is the name of the Logger the Handler emits with.
+const instrumentationName = "go.opentelemetry.io/otel/bridge/otelslog"
+
+// Handler is a slog.Handler that emits the records it handles with an
+// OpenTelemetry Logger.
+type Handler struct {
+	logger log.Logger
+
+	// attrs are the attributes added with WithAttrs outside of any
+	// group.
+	attrs []label.KeyValue
+	// groups are the groups opened with WithGroup, outermost first.
+	groups []group
+}
+
+// group is a group opened with WithGroup and the attributes added to it.
+type group struct {
+	name  string
+	attrs []label.KeyValue
+}
+
+var _ slog.Handler = (*Handler)(nil)
+
+// NewHandler returns a Handler emitting records with the Logger of
+// provider named "go.opentelemetry.io/otel/bridge/otelslog", configured
+// with opts.
+func NewHandler(provider log.LoggerProvider, opts ...log.LoggerOption) *Handler {
+	return &Handler{logger: provider.Logger(instrumentationName, opts...)}
+}
+
+// Enabled reports whether the Logger emits records of level.
+func (h *Handler) Enabled(ctx context.Context, level slog.Level) bool {
+	return h.logger.Enabled(ctx, severity(level))
+}
+
+// Handle emits r with ctx. The message of r is the body of the emitted
+// record.
+func (h *Handler) Handle(ctx context.Context, r slog.Record) error {
+	var kvs []label.KeyValue
+	r.Attrs(func(a slog.Attr) bool {
+		kvs = appendAttr(kvs, a)
+		return true
+	})
+	// Nest the attributes in the open groups, innermost first. Empty
+	// groups are omitted.
+	for i := len(h.groups) - 1; i >= 0; i-- {
+		g := h.groups[i]
+		inner := append(g.attrs[:len(g.attrs):len(g.attrs)], kvs...)
+		kvs = nil
+		if len(inner) > 0 {
+			kvs = []label.KeyValue{label.Map(g.name, inner...)}
+		}
+	}
+	attrs := append(h.attrs[:len(h.attrs):len(h.attrs)], kvs...)
+
+	h.logger.Emit(ctx, log.Record{
+		Timestamp:    r.Time,
+		Severity:     severity(r.Level),
+		SeverityText: r.Level.String(),
+		Body:         label.StringValue(r.Message),
+		Attributes:   attrs,
+	})
+	return nil
+}
+
+// WithAttrs returns a Handler adding attrs to the records it handles, in
+// the innermost open group if any.
+func (h *Handler) WithAttrs(attrs []slog.Attr) slog.Handler {
+	var kvs []label.KeyValue
+	for _, a := range attrs {
+		kvs = appendAttr(kvs, a)
+	}
+	if len(kvs) == 0 {
+		return h
+	}
+
+	h2 := *h
+	if len(h.groups) == 0 {
+		h2.attrs = append(h.attrs[:len(h.attrs):len(h.attrs)], kvs...)
+		return &h2
+	}
+	h2.groups = append([]group(nil), h.groups...)
+	last := &h2.groups[len(h2.groups)-1]
+	last.attrs = append(last.attrs[:len(last.attrs):len(last.attrs)], kvs...)
+	return &h2
+}
+
+// WithGroup returns a Handler nesting the attributes added afterwards in
+// a group called name.
+func (h *Handler) WithGroup(name string) slog.Handler {
+	if name == "" {
+		return h
+	}
+	h2 := *h
+	h2.groups = append(h.groups[:len(h.groups):len(h.groups)], group{name: name})
+	return &h2
+}
+
+// severity returns the OpenTelemetry severity of a slog level. The slog
+// levels are 4 apart, as are the OpenTelemetry severity ranges, and
+// slog.LevelInfo (0) is log.SeverityInfo (9).
+func severity(level slog.Level) log.Severity {
+	s := log.Severity(level + 9)
+	if s < log.SeverityTrace {
+		return log.SeverityTrace
+	}
+	if s > log.SeverityFatal+3 {
+		return log.SeverityFatal + 3
+	}
+	return s
+}
+
+// appendAttr appends the labels of a to kvs, following the rules of
+// slog.Handler: empty attributes and groups are ignored and the attributes
+// of groups without a key are inlined.
+func appendAttr(kvs []label.KeyValue, a slog.Attr) []label.KeyValue {
+	a.Value = a.Value.Resolve()
+	if a.Equal(slog.Attr{}) {
+		return kvs
+	}
+	if a.Value.Kind() != slog.KindGroup {
+		return append(kvs, label.KeyValue{Key: label.Key(a.Key), Value: value(a.Value)})
+	}
+
+	var members []label.KeyValue
+	for _, m := range a.Value.Group() {
+		members = appendAttr(members, m)
+	}
+	if len(members) == 0 {
+		return kvs
+	}
+	if a.Key == "" {
+		return append(kvs, members...)
+	}
+	return append(kvs, label.Map(a.Key, members...))
+}
+
+// value converts a resolved slog value that is not a group. Durations are
+// converted to nanoseconds and times to nanoseconds since the Unix epoch.
+func value(v slog.Value) label.Value {
+	switch v.Kind() {
+	case slog.KindString:
+		return label.StringValue(v.String())
+	case slog.KindInt64:
+		return label.Int64Value(v.Int64())
+	case slog.KindUint64:
+		return label.Uint64Value(v.Uint64())
+	case slog.KindFloat64:
+		return label.Float64Value(v.Float64())
+	case slog.KindBool:
+		return label.BoolValue(v.Bool())
+	case slog.KindDuration:
+		return label.Int64Value(v.Duration().Nanoseconds())
+	case slog.KindTime:
+		return label.Int64Value(v.Time().UnixNano())
+	}
+	if err, ok := v.Any().(error); ok {
+		return label.StringValue(err.Error())
+	}
+	return label.Any("", v.Any()).Value
+}
@@ -0,0 +1,203 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package otelslog
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"sync"
+	"testing"
+	"testing/slogtest"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.opentelemetry.io/otel/label"
+	"go.opentelemetry.io/otel/log"
+	export "go.opentelemetry.io/otel/sdk/export/log"
+	sdklog "go.opentelemetry.io/otel/sdk/log"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+)
+
+type testExporter struct {
+	mu      sync.Mutex
+	records []*export.Record
+}
+
+func (e *testExporter) ExportLogs(_ context.Context, records []*export.Record) error {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	e.records = append(e.records, records...)
+	return nil
+}
+
+func (e *testExporter) Shutdown(context.Context) error { return nil }
+
+func (e *testExporter) reset() []*export.Record {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	records := e.records
+	e.records = nil
+	return records
+}
+
+func newTestHandler() (*Handler, *testExporter) {
+	exp := new(testExporter)
+	return NewHandler(sdklog.NewLoggerProvider(sdklog.WithSyncer(exp))), exp
+}
+
+func TestHandlerEmit(t *testing.T) {
+	h, exp := newTestHandler()
+	logger := slog.New(h)
+
+	ctx, span := sdktrace.NewTracerProvider().Tracer("test").Start(context.Background(), "span")
+	defer span.End()
+	logger.WarnContext(ctx, "hello", "user", "gopher", "count", 3)
+
+	records := exp.reset()
+	require.Len(t, records, 1)
+	r := records[0]
+	assert.False(t, r.Timestamp.IsZero())
+	assert.Equal(t, log.SeverityWarn, r.Severity)
+	assert.Equal(t, "WARN", r.SeverityText)
+	assert.Equal(t, "hello", r.Body.AsString())
+	assert.Equal(t, []label.KeyValue{
+		label.String("user", "gopher"),
+		label.Int64("count", 3),
+	}, r.Attributes)
+	assert.Equal(t, span.SpanContext(), r.SpanContext)
+	assert.Equal(t, instrumentationName, r.InstrumentationLibrary.Name)
+}
+
+func TestHandlerGroups(t *testing.T) {
+	h, exp := newTestHandler()
+	logger := slog.New(h).With("a", 1).WithGroup("g").With("b", 2).WithGroup("h")
+
+	logger.Info("with attrs", "c", 3)
+	logger.Info("without attrs")
+	slog.New(h).Info("inline", slog.Group("", "d", 4), slog.Group("empty"), slog.Group("i", "e", 5))
+
+	records := exp.reset()
+	require.Len(t, records, 3)
+	assert.Equal(t, []label.KeyValue{
+		label.Int64("a", 1),
+		label.Map("g", label.Int64("b", 2), label.Map("h", label.Int64("c", 3))),
+	}, records[0].Attributes)
+	assert.Equal(t, []label.KeyValue{
+		label.Int64("a", 1),
+		label.Map("g", label.Int64("b", 2)),
+	}, records[1].Attributes)
+	assert.Equal(t, []label.KeyValue{
+		label.Int64("d", 4),
+		label.Map("i", label.Int64("e", 5)),
+	}, records[2].Attributes)
+}
+
+type logValuer struct{}
+
+func (logValuer) LogValue() slog.Value { return slog.StringValue("resolved") }
+
+func TestHandlerValues(t *testing.T) {
+	h, exp := newTestHandler()
+	now := time.Unix(0, 42)
+	slog.New(h).Info("values",
+		"uint", uint64(1),
+		"float", 1.5,
+		"bool", true,
+		"duration", time.Second,
+		"time", now,
+		"err", errors.New("boom"),
+		"valuer", logValuer{},
+		"struct", struct{ A int }{A: 1},
+	)
+
+	records := exp.reset()
+	require.Len(t, records, 1)
+	assert.Equal(t, []label.KeyValue{
+		label.Uint64("uint", 1),
+		label.Float64("float", 1.5),
+		label.Bool("bool", true),
+		label.Int64("duration", int64(time.Second)),
+		label.Int64("time", 42),
+		label.String("err", "boom"),
+		label.String("valuer", "resolved"),
+		label.String("struct", `{"A":1}`),
+	}, records[0].Attributes)
+}
+
+func TestSeverity(t *testing.T) {
+	for _, test := range []struct {
+		level slog.Level
+		want  log.Severity
+	}{
+		{slog.LevelDebug - 8, log.SeverityTrace},
+		{slog.LevelDebug - 4, log.SeverityTrace},
+		{slog.LevelDebug, log.SeverityDebug},
+		{slog.LevelInfo, log.SeverityInfo},
+		{slog.LevelInfo + 1, log.SeverityInfo + 1},
+		{slog.LevelWarn, log.SeverityWarn},
+		{slog.LevelError, log.SeverityError},
+		{slog.LevelError + 4, log.SeverityFatal},
+		{slog.LevelError + 100, log.SeverityFatal + 3},
+	} {
+		assert.Equal(t, test.want, severity(test.level), test.level)
+	}
+}
+
+func TestHandlerEnabled(t *testing.T) {
+	provider := sdklog.NewLoggerProvider(sdklog.WithSyncer(new(testExporter)))
+	h := NewHandler(provider)
+	assert.True(t, h.Enabled(context.Background(), slog.LevelDebug))
+	require.NoError(t, provider.Shutdown(context.Background()))
+	assert.False(t, h.Enabled(context.Background(), slog.LevelError))
+
+	h = NewHandler(log.NewNoopLoggerProvider())
+	assert.False(t, h.Enabled(context.Background(), slog.LevelError))
+}
+
+func TestSlogtest(t *testing.T) {
+	h, exp := newTestHandler()
+	results := func() []map[string]any {
+		var ms []map[string]any
+		for _, r := range exp.reset() {
+			m := map[string]any{
+				slog.LevelKey:   r.SeverityText,
+				slog.MessageKey: r.Body.AsString(),
+			}
+			if !r.Timestamp.IsZero() && r.Timestamp != r.ObservedTimestamp {
+				m[slog.TimeKey] = r.Timestamp
+			}
+			for _, kv := range r.Attributes {
+				m[string(kv.Key)] = toAny(kv.Value)
+			}
+			ms = append(ms, m)
+		}
+		return ms
+	}
+	require.NoError(t, slogtest.TestHandler(h, results))
+}
+
+func toAny(v label.Value) any {
+	if v.Type() != label.MAP {
+		return v.AsInterface()
+	}
+	m := make(map[string]any)
+	for _, kv := range v.AsMap() {
+		m[string(kv.Key)] = toAny(kv.Value)
+	}
+	return m
+}
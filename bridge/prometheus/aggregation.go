@@ -0,0 +1,133 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package prometheus // import "go.opentelemetry.io/otel/bridge/prometheus"
+
+import (
+	"fmt"
+	"math"
+	"time"
+
+	dto "github.com/prometheus/client_model/go"
+
+	"go.opentelemetry.io/otel/metric/number"
+	"go.opentelemetry.io/otel/sdk/export/metric/aggregation"
+)
+
+// newAggregation returns the aggregation of a Prometheus metric according
+// to the type of its family. t is the time of the metric.
+func newAggregation(typ dto.MetricType, m *dto.Metric, t time.Time) (aggregation.Aggregation, error) {
+	switch typ {
+	case dto.MetricType_COUNTER:
+		if m.Counter == nil {
+			return nil, fmt.Errorf("%w: counter without value", errConversion)
+		}
+		return &promSum{sum: number.NewFloat64Number(m.Counter.GetValue())}, nil
+	case dto.MetricType_GAUGE:
+		if m.Gauge == nil {
+			return nil, fmt.Errorf("%w: gauge without value", errConversion)
+		}
+		return &promLastValue{value: number.NewFloat64Number(m.Gauge.GetValue()), time: t}, nil
+	case dto.MetricType_UNTYPED:
+		if m.Untyped == nil {
+			return nil, fmt.Errorf("%w: untyped metric without value", errConversion)
+		}
+		return &promLastValue{value: number.NewFloat64Number(m.Untyped.GetValue()), time: t}, nil
+	case dto.MetricType_HISTOGRAM:
+		if m.Histogram == nil {
+			return nil, fmt.Errorf("%w: histogram without value", errConversion)
+		}
+		return newHistogram(m.Histogram)
+	default:
+		return nil, fmt.Errorf("%w: metric of type %v", errConversion, typ)
+	}
+}
+
+var (
+	_ aggregation.LastValue = &promLastValue{}
+	_ aggregation.Sum       = &promSum{}
+	_ aggregation.Histogram = &promHistogram{}
+)
+
+// promLastValue is the LastValue aggregation of a Prometheus gauge or
+// untyped metric.
+type promLastValue struct {
+	value number.Number
+	time  time.Time
+}
+
+func (a *promLastValue) Kind() aggregation.Kind { return aggregation.LastValueKind }
+
+func (a *promLastValue) LastValue() (number.Number, time.Time, error) {
+	return a.value, a.time, nil
+}
+
+// promSum is the Sum aggregation of a Prometheus counter.
+type promSum struct {
+	sum number.Number
+}
+
+func (a *promSum) Kind() aggregation.Kind { return aggregation.SumKind }
+
+func (a *promSum) Sum() (number.Number, error) { return a.sum, nil }
+
+// promHistogram is the Histogram aggregation of a Prometheus histogram.
+type promHistogram struct {
+	count   uint64
+	sum     number.Number
+	buckets aggregation.Buckets
+}
+
+// newHistogram converts the cumulative buckets of a Prometheus histogram,
+// sorted by upper bound, to OpenTelemetry buckets. The +Inf bucket, if
+// any, is implied by the sample count.
+func newHistogram(h *dto.Histogram) (*promHistogram, error) {
+	bounds := make([]float64, 0, len(h.GetBucket()))
+	counts := make([]uint64, 0, len(h.GetBucket())+1)
+	var cumulative uint64
+	for _, b := range h.GetBucket() {
+		if math.IsInf(b.GetUpperBound(), +1) {
+			break
+		}
+		if n := len(bounds); n > 0 && b.GetUpperBound() <= bounds[n-1] {
+			return nil, fmt.Errorf("%w: unsorted histogram bucket bounds", errConversion)
+		}
+		if b.GetCumulativeCount() < cumulative {
+			return nil, fmt.Errorf("%w: decreasing cumulative histogram bucket counts", errConversion)
+		}
+		bounds = append(bounds, b.GetUpperBound())
+		counts = append(counts, b.GetCumulativeCount()-cumulative)
+		cumulative = b.GetCumulativeCount()
+	}
+	if h.GetSampleCount() < cumulative {
+		return nil, fmt.Errorf("%w: histogram sample count lower than its bucket counts", errConversion)
+	}
+	counts = append(counts, h.GetSampleCount()-cumulative)
+	return &promHistogram{
+		count: h.GetSampleCount(),
+		sum:   number.NewFloat64Number(h.GetSampleSum()),
+		buckets: aggregation.Buckets{
+			Boundaries: bounds,
+			Counts:     counts,
+		},
+	}, nil
+}
+
+func (a *promHistogram) Kind() aggregation.Kind { return aggregation.HistogramKind }
+
+func (a *promHistogram) Count() (uint64, error) { return a.count, nil }
+
+func (a *promHistogram) Sum() (number.Number, error) { return a.sum, nil }
+
+func (a *promHistogram) Histogram() (aggregation.Buckets, error) { return a.buckets, nil }
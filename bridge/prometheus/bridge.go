@@ -0,0 +1,187 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package prometheus // import "go.opentelemetry.io/otel/bridge/prometheus"
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	promclient "github.com/prometheus/client_golang/prometheus"
+	dto "github.com/prometheus/client_model/go"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/label"
+	"go.opentelemetry.io/otel/metric"
+	"go.opentelemetry.io/otel/metric/number"
+	export "go.opentelemetry.io/otel/sdk/export/metric"
+	"go.opentelemetry.io/otel/sdk/export/metric/aggregation"
+	"go.opentelemetry.io/otel/sdk/resource"
+)
+
+// instrumentationName is the instrumentation name of the metrics exported
+// through the bridge.
+const instrumentationName = "go.opentelemetry.io/otel/bridge/prometheus"
+
+var errConversion = errors.New("unable to convert from Prometheus to OpenTelemetry")
+
+// config contains the options of the bridge.
+type config struct {
+	gatherer promclient.Gatherer
+	resource *resource.Resource
+}
+
+// Option sets an option of the bridge.
+type Option func(*config)
+
+// WithGatherer sets the gatherer the metrics are gathered from. It
+// defaults to prometheus.DefaultGatherer.
+func WithGatherer(g promclient.Gatherer) Option {
+	return func(c *config) {
+		c.gatherer = g
+	}
+}
+
+// WithResource sets the resource of the records of the gathered metrics.
+// They have none by default.
+func WithResource(res *resource.Resource) Option {
+	return func(c *config) {
+		c.resource = res
+	}
+}
+
+// NewExporter returns an exporter that exports, with base, the checkpoint
+// sets it is given along with the metrics of the gatherer set with
+// WithGatherer. The metrics are gathered on every export.
+func NewExporter(base export.Exporter, opts ...Option) export.Exporter {
+	cfg := config{gatherer: promclient.DefaultGatherer}
+	for _, opt := range opts {
+		opt(&cfg)
+	}
+	return &exporter{
+		Exporter: base,
+		cfg:      cfg,
+		start:    time.Now(),
+	}
+}
+
+type exporter struct {
+	// Exporter is the wrapped exporter, providing the export kinds.
+	export.Exporter
+
+	cfg config
+	// start is the start time of the cumulative records.
+	start time.Time
+}
+
+// Export gathers the metrics and exports them with the records of cps.
+// Gathering errors are passed to otel.Handle, the metrics gathered
+// successfully are exported anyway.
+func (e *exporter) Export(ctx context.Context, cps export.CheckpointSet) error {
+	families, err := e.cfg.gatherer.Gather()
+	if err != nil {
+		otel.Handle(err)
+	}
+	return e.Exporter.Export(ctx, &checkpointSet{
+		CheckpointSet: cps,
+		families:      families,
+		resource:      e.cfg.resource,
+		start:         e.start,
+		end:           time.Now(),
+	})
+}
+
+// checkpointSet is an export.CheckpointSet of the records of a checkpoint
+// set followed by the records of gathered metric families.
+type checkpointSet struct {
+	// CheckpointSet is the wrapped checkpoint set, providing the
+	// locking.
+	export.CheckpointSet
+
+	families []*dto.MetricFamily
+	resource *resource.Resource
+	start    time.Time
+	end      time.Time
+}
+
+// ForEach calls recordFunc with the records of the wrapped checkpoint set,
+// then with a record for each metric of the gathered families. The
+// gathered records are cumulative whatever the kindSelector. Metrics that
+// cannot be converted are passed to otel.Handle and skipped.
+func (c *checkpointSet) ForEach(kindSelector export.ExportKindSelector, recordFunc func(export.Record) error) error {
+	if c.CheckpointSet != nil {
+		if err := c.CheckpointSet.ForEach(kindSelector, recordFunc); err != nil {
+			return err
+		}
+	}
+	for _, family := range c.families {
+		descriptor, err := convertDescriptor(family)
+		if err != nil {
+			otel.Handle(err)
+			continue
+		}
+		for _, m := range family.GetMetric() {
+			end := c.end
+			if m.TimestampMs != nil {
+				end = time.Unix(0, m.GetTimestampMs()*int64(time.Millisecond))
+			}
+			agg, err := newAggregation(family.GetType(), m, end)
+			if err != nil {
+				otel.Handle(fmt.Errorf("metric %q: %w", family.GetName(), err))
+				continue
+			}
+			labels := convertLabels(m.GetLabel())
+			record := export.NewRecord(&descriptor, &labels, c.resource, agg, c.start, end)
+			if err := recordFunc(record); err != nil && !errors.Is(err, aggregation.ErrNoData) {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// convertDescriptor converts the type, name and help of a Prometheus
+// metric family to an OpenTelemetry descriptor. Counters become
+// SumObservers, gauges and untyped metrics ValueObservers, and histograms
+// ValueRecorders.
+func convertDescriptor(family *dto.MetricFamily) (metric.Descriptor, error) {
+	var ikind metric.InstrumentKind
+	switch family.GetType() {
+	case dto.MetricType_COUNTER:
+		ikind = metric.SumObserverInstrumentKind
+	case dto.MetricType_GAUGE, dto.MetricType_UNTYPED:
+		ikind = metric.ValueObserverInstrumentKind
+	case dto.MetricType_HISTOGRAM:
+		ikind = metric.ValueRecorderInstrumentKind
+	default:
+		// MetricType_SUMMARY has no OpenTelemetry equivalent.
+		return metric.Descriptor{}, fmt.Errorf("%w: metric %q of type %v", errConversion, family.GetName(), family.GetType())
+	}
+	return metric.NewDescriptor(family.GetName(), ikind, number.Float64Kind,
+		metric.WithDescription(family.GetHelp()),
+		metric.WithInstrumentationName(instrumentationName),
+	), nil
+}
+
+// convertLabels converts the label pairs of a Prometheus metric to a
+// label set.
+func convertLabels(pairs []*dto.LabelPair) label.Set {
+	kvs := make([]label.KeyValue, 0, len(pairs))
+	for _, p := range pairs {
+		kvs = append(kvs, label.String(p.GetName(), p.GetValue()))
+	}
+	return label.NewSet(kvs...)
+}
@@ -0,0 +1,289 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package prometheus
+
+import (
+	"context"
+	"errors"
+	"math"
+	"sync"
+	"testing"
+	"time"
+
+	promclient "github.com/prometheus/client_golang/prometheus"
+	dto "github.com/prometheus/client_model/go"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/label"
+	"go.opentelemetry.io/otel/metric"
+	"go.opentelemetry.io/otel/metric/number"
+	export "go.opentelemetry.io/otel/sdk/export/metric"
+	"go.opentelemetry.io/otel/sdk/export/metric/aggregation"
+	"go.opentelemetry.io/otel/sdk/resource"
+)
+
+type recordingExporter struct {
+	export.ExportKindSelector
+	records []export.Record
+}
+
+func (e *recordingExporter) Export(_ context.Context, cps export.CheckpointSet) error {
+	return cps.ForEach(e, func(r export.Record) error {
+		e.records = append(e.records, r)
+		return nil
+	})
+}
+
+// oneRecordCheckpointSet is a checkpoint set with a single record.
+type oneRecordCheckpointSet struct {
+	sync.RWMutex
+	record export.Record
+}
+
+func (c *oneRecordCheckpointSet) ForEach(_ export.ExportKindSelector, recordFunc func(export.Record) error) error {
+	return recordFunc(c.record)
+}
+
+type storingHandler struct {
+	errs []error
+}
+
+func (h *storingHandler) Handle(err error) { h.errs = append(h.errs, err) }
+
+var handler = &storingHandler{}
+
+func init() {
+	otel.SetErrorHandler(handler)
+}
+
+func metricType(t dto.MetricType) *dto.MetricType { return &t }
+
+func float64Ptr(v float64) *float64 { return &v }
+
+func uint64Ptr(v uint64) *uint64 { return &v }
+
+func stringPtr(v string) *string { return &v }
+
+func TestExporter(t *testing.T) {
+	handler.errs = nil
+	ts := time.Unix(100, 0)
+	families := []*dto.MetricFamily{
+		{
+			Name: stringPtr("requests_total"),
+			Help: stringPtr("The number of requests."),
+			Type: metricType(dto.MetricType_COUNTER),
+			Metric: []*dto.Metric{{
+				Label: []*dto.LabelPair{
+					{Name: stringPtr("code"), Value: stringPtr("200")},
+					{Name: stringPtr("method"), Value: stringPtr("GET")},
+				},
+				Counter: &dto.Counter{Value: float64Ptr(3)},
+			}},
+		},
+		{
+			Name: stringPtr("temperature"),
+			Type: metricType(dto.MetricType_GAUGE),
+			Metric: []*dto.Metric{{
+				Gauge:       &dto.Gauge{Value: float64Ptr(21.5)},
+				TimestampMs: func() *int64 { v := ts.UnixNano() / int64(time.Millisecond); return &v }(),
+			}},
+		},
+		{
+			Name:   stringPtr("untyped"),
+			Type:   metricType(dto.MetricType_UNTYPED),
+			Metric: []*dto.Metric{{Untyped: &dto.Untyped{Value: float64Ptr(1)}}},
+		},
+		{
+			Name: stringPtr("latency_seconds"),
+			Type: metricType(dto.MetricType_HISTOGRAM),
+			Metric: []*dto.Metric{{
+				Histogram: &dto.Histogram{
+					SampleCount: uint64Ptr(6),
+					SampleSum:   float64Ptr(4.5),
+					Bucket: []*dto.Bucket{
+						{UpperBound: float64Ptr(0.5), CumulativeCount: uint64Ptr(2)},
+						{UpperBound: float64Ptr(1), CumulativeCount: uint64Ptr(5)},
+						{UpperBound: float64Ptr(math.Inf(1)), CumulativeCount: uint64Ptr(6)},
+					},
+				},
+			}},
+		},
+		{
+			Name:   stringPtr("summary"),
+			Type:   metricType(dto.MetricType_SUMMARY),
+			Metric: []*dto.Metric{{Summary: &dto.Summary{}}},
+		},
+	}
+	gatherErr := errors.New("gather failed")
+	gatherer := promclient.GathererFunc(func() ([]*dto.MetricFamily, error) {
+		return families, gatherErr
+	})
+
+	otelDesc := metric.NewDescriptor("otel", metric.CounterInstrumentKind, number.Int64Kind)
+	otelLabels := label.NewSet()
+	cps := &oneRecordCheckpointSet{
+		record: export.NewRecord(&otelDesc, &otelLabels, nil, &promSum{}, time.Time{}, time.Time{}),
+	}
+
+	res := resource.NewWithAttributes(label.String("service.name", "test"))
+	base := &recordingExporter{ExportKindSelector: export.CumulativeExportKindSelector()}
+	before := time.Now()
+	exp := NewExporter(base, WithGatherer(gatherer), WithResource(res))
+	if err := exp.Export(context.Background(), cps); err != nil {
+		t.Fatal(err)
+	}
+
+	// The gathering error and the dropped summary are handled.
+	if len(handler.errs) != 2 || !errors.Is(handler.errs[0], gatherErr) || !errors.Is(handler.errs[1], errConversion) {
+		t.Errorf("handled errors = %v, want the gathering and summary errors", handler.errs)
+	}
+
+	if len(base.records) != 5 {
+		t.Fatalf("got %d records, want 5", len(base.records))
+	}
+	if got := base.records[0].Descriptor().Name(); got != "otel" {
+		t.Errorf("first record = %q, want the record of the wrapped checkpoint set", got)
+	}
+
+	for i, want := range []struct {
+		name string
+		kind metric.InstrumentKind
+	}{
+		{"requests_total", metric.SumObserverInstrumentKind},
+		{"temperature", metric.ValueObserverInstrumentKind},
+		{"untyped", metric.ValueObserverInstrumentKind},
+		{"latency_seconds", metric.ValueRecorderInstrumentKind},
+	} {
+		r := base.records[i+1]
+		if r.Descriptor().Name() != want.name || r.Descriptor().InstrumentKind() != want.kind {
+			t.Errorf("record %d = %q of kind %v, want %q of kind %v", i+1, r.Descriptor().Name(), r.Descriptor().InstrumentKind(), want.name, want.kind)
+		}
+		if r.Descriptor().NumberKind() != number.Float64Kind {
+			t.Errorf("record %q number kind = %v, want Float64Kind", want.name, r.Descriptor().NumberKind())
+		}
+		if r.Descriptor().InstrumentationName() != instrumentationName {
+			t.Errorf("record %q instrumentation name = %q", want.name, r.Descriptor().InstrumentationName())
+		}
+		if r.Resource() != res {
+			t.Errorf("record %q resource = %v, want %v", want.name, r.Resource(), res)
+		}
+		if r.StartTime().Before(before) {
+			t.Errorf("record %q start time %v is before the exporter creation", want.name, r.StartTime())
+		}
+	}
+
+	counter := base.records[1]
+	if got := counter.Descriptor().Description(); got != "The number of requests." {
+		t.Errorf("counter description = %q", got)
+	}
+	wantLabels := label.NewSet(label.String("code", "200"), label.String("method", "GET"))
+	if !counter.Labels().Equals(&wantLabels) {
+		t.Errorf("counter labels = %v, want %v", counter.Labels().ToSlice(), wantLabels.ToSlice())
+	}
+	if sum, _ := counter.Aggregation().(aggregation.Sum).Sum(); sum.AsFloat64() != 3 {
+		t.Errorf("counter sum = %v, want 3", sum.AsFloat64())
+	}
+
+	gauge := base.records[2]
+	value, valueTime, _ := gauge.Aggregation().(aggregation.LastValue).LastValue()
+	if value.AsFloat64() != 21.5 || !valueTime.Equal(ts) || !gauge.EndTime().Equal(ts) {
+		t.Errorf("gauge = %v at %v ending %v, want 21.5 at %v", value.AsFloat64(), valueTime, gauge.EndTime(), ts)
+	}
+
+	histogram := base.records[4].Aggregation().(aggregation.Histogram)
+	count, _ := histogram.Count()
+	sum, _ := histogram.Sum()
+	buckets, _ := histogram.Histogram()
+	if count != 6 || sum.AsFloat64() != 4.5 {
+		t.Errorf("histogram count, sum = %v, %v, want 6, 4.5", count, sum.AsFloat64())
+	}
+	wantBounds, wantCounts := []float64{0.5, 1}, []uint64{2, 3, 1}
+	if !equalFloats(buckets.Boundaries, wantBounds) || !equalUints(buckets.Counts, wantCounts) {
+		t.Errorf("histogram buckets = %v %v, want %v %v", buckets.Boundaries, buckets.Counts, wantBounds, wantCounts)
+	}
+}
+
+func TestExporterDefaultGatherer(t *testing.T) {
+	base := &recordingExporter{ExportKindSelector: export.CumulativeExportKindSelector()}
+	e := NewExporter(base).(*exporter)
+	if e.cfg.gatherer != promclient.DefaultGatherer {
+		t.Errorf("gatherer = %v, want the default gatherer", e.cfg.gatherer)
+	}
+	// The kinds of the wrapped exporter are used.
+	desc := metric.NewDescriptor("sum", metric.CounterInstrumentKind, number.Float64Kind)
+	if got := e.ExportKindFor(&desc, aggregation.SumKind); got != export.CumulativeExportKind {
+		t.Errorf("export kind = %v, want %v", got, export.CumulativeExportKind)
+	}
+}
+
+func TestNewHistogramErrors(t *testing.T) {
+	for _, h := range []*dto.Histogram{
+		{
+			SampleCount: uint64Ptr(2),
+			Bucket: []*dto.Bucket{
+				{UpperBound: float64Ptr(1), CumulativeCount: uint64Ptr(1)},
+				{UpperBound: float64Ptr(0.5), CumulativeCount: uint64Ptr(2)},
+			},
+		},
+		{
+			SampleCount: uint64Ptr(2),
+			Bucket: []*dto.Bucket{
+				{UpperBound: float64Ptr(0.5), CumulativeCount: uint64Ptr(2)},
+				{UpperBound: float64Ptr(1), CumulativeCount: uint64Ptr(1)},
+			},
+		},
+		{
+			SampleCount: uint64Ptr(1),
+			Bucket:      []*dto.Bucket{{UpperBound: float64Ptr(1), CumulativeCount: uint64Ptr(2)}},
+		},
+	} {
+		if _, err := newHistogram(h); !errors.Is(err, errConversion) {
+			t.Errorf("newHistogram(%v) error = %v, want %v", h, err, errConversion)
+		}
+	}
+
+	// A histogram without buckets has a single implied bucket.
+	a, err := newHistogram(&dto.Histogram{SampleCount: uint64Ptr(3)})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if buckets, _ := a.Histogram(); len(buckets.Boundaries) != 0 || !equalUints(buckets.Counts, []uint64{3}) {
+		t.Errorf("buckets = %v %v, want [] [3]", buckets.Boundaries, buckets.Counts)
+	}
+}
+
+func equalFloats(a, b []float64) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
+func equalUints(a, b []uint64) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,40 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package prometheus provides a bridge exporting the metrics of the
+// Prometheus client library, github.com/prometheus/client_golang, with an
+// OpenTelemetry metric exporter.
+//
+// The bridge wraps the exporter of an OpenTelemetry metric controller.
+// Every time the controller collects and exports its checkpoint set, the
+// bridge gathers the metrics of a prometheus.Gatherer and exports them
+// along with the OpenTelemetry ones, so metrics instrumented with the
+// Prometheus client library can be shipped over OTLP without instrumenting
+// them twice:
+//
+//	exporter, err := otlp.NewExporter(ctx, driver)
+//	if err != nil {
+//		...
+//	}
+//	pusher := controller.New(
+//		processor.New(simple.NewWithInexpensiveDistribution(), exporter),
+//		controller.WithPusher(prometheus.NewExporter(exporter)),
+//	)
+//
+// Counters are exported as SumObserver records, gauges and untyped
+// metrics as ValueObserver records and histograms as ValueRecorder
+// records with a histogram aggregation. The Prometheus values are
+// cumulative, so the wrapped exporter should accept cumulative records.
+// Summaries have no OpenTelemetry equivalent and are dropped.
+package prometheus // import "go.opentelemetry.io/otel/bridge/prometheus"
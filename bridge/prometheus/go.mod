@@ -0,0 +1,15 @@
+module go.opentelemetry.io/otel/bridge/prometheus
+
+go 1.14
+
+replace (
+	go.opentelemetry.io/otel => ../..
+	go.opentelemetry.io/otel/sdk => ../../sdk
+)
+
+require (
+	github.com/prometheus/client_golang v1.9.0
+	github.com/prometheus/client_model v0.2.0
+	go.opentelemetry.io/otel v0.16.0
+	go.opentelemetry.io/otel/sdk v0.16.0
+)
@@ -0,0 +1,94 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package codes // import "go.opentelemetry.io/otel/codes"
+
+import "net/http"
+
+type httpStatusRange struct {
+	fromInclusive int
+	toInclusive   int
+}
+
+func (r httpStatusRange) contains(code int) bool {
+	return r.fromInclusive <= code && code <= r.toInclusive
+}
+
+var validHTTPStatusRanges = map[int][]httpStatusRange{
+	1: {
+		{http.StatusContinue, http.StatusEarlyHints},
+	},
+	2: {
+		{http.StatusOK, http.StatusAlreadyReported},
+		{http.StatusIMUsed, http.StatusIMUsed},
+	},
+	3: {
+		{http.StatusMultipleChoices, http.StatusUseProxy},
+		{http.StatusTemporaryRedirect, http.StatusPermanentRedirect},
+	},
+	4: {
+		{http.StatusBadRequest, http.StatusTeapot}, // yes, teapot is so useful…
+		{http.StatusMisdirectedRequest, http.StatusUpgradeRequired},
+		{http.StatusPreconditionRequired, http.StatusTooManyRequests},
+		{http.StatusRequestHeaderFieldsTooLarge, http.StatusRequestHeaderFieldsTooLarge},
+		{http.StatusUnavailableForLegalReasons, http.StatusUnavailableForLegalReasons},
+	},
+	5: {
+		{http.StatusInternalServerError, http.StatusLoopDetected},
+		{http.StatusNotExtended, http.StatusNetworkAuthenticationRequired},
+	},
+}
+
+// validHTTPStatus returns if code is a registered HTTP status code.
+func validHTTPStatus(code int) bool {
+	for _, r := range validHTTPStatusRanges[code/100] {
+		if r.contains(code) {
+			return true
+		}
+	}
+	return false
+}
+
+// FromHTTPStatus returns the status code of an HTTP client span that received
+// a response with the HTTP status code code. Informational, success, and
+// redirection responses leave the span status Unset. Client and server
+// errors, as well as invalid HTTP status codes, are an Error.
+func FromHTTPStatus(code int) Code {
+	if !validHTTPStatus(code) || code >= http.StatusBadRequest {
+		return Error
+	}
+	return Unset
+}
+
+// FromHTTPServerStatus returns the status code of an HTTP server span that
+// responded with the HTTP status code code. It is the same as FromHTTPStatus
+// except that client errors (4xx) leave the span status Unset, as the server
+// handled the request correctly.
+func FromHTTPServerStatus(code int) Code {
+	if validHTTPStatus(code) && code < http.StatusInternalServerError {
+		return Unset
+	}
+	return Error
+}
+
+// FromGRPCCode returns the status code of a gRPC client or server span that
+// completed with the gRPC status code code, e.g.
+// FromGRPCCode(uint32(status.Code(err))). The gRPC code OK leaves the span
+// status Unset, all other codes are an Error.
+func FromGRPCCode(code uint32) Code {
+	if code == 0 {
+		return Unset
+	}
+	return Error
+}
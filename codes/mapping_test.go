@@ -0,0 +1,51 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package codes
+
+import (
+	"net/http"
+	"testing"
+)
+
+func TestFromHTTPStatus(t *testing.T) {
+	for code := 0; code < 1000; code++ {
+		client, server := Error, Error
+		if http.StatusText(code) != "" {
+			if code < 400 {
+				client = Unset
+			}
+			if code < 500 {
+				server = Unset
+			}
+		}
+		if got := FromHTTPStatus(code); got != client {
+			t.Errorf("FromHTTPStatus(%d) = %s, want %s", code, got, client)
+		}
+		if got := FromHTTPServerStatus(code); got != server {
+			t.Errorf("FromHTTPServerStatus(%d) = %s, want %s", code, got, server)
+		}
+	}
+}
+
+func TestFromGRPCCode(t *testing.T) {
+	if got := FromGRPCCode(0); got != Unset {
+		t.Errorf("FromGRPCCode(0) = %s, want %s", got, Unset)
+	}
+	for code := uint32(1); code <= 16; code++ {
+		if got := FromGRPCCode(code); got != Error {
+			t.Errorf("FromGRPCCode(%d) = %s, want %s", code, got, Error)
+		}
+	}
+}
@@ -14,9 +14,95 @@
 
 package otel // import "go.opentelemetry.io/otel"
 
+import (
+	"errors"
+	"fmt"
+)
+
 // ErrorHandler handles irremediable events.
+//
+// Errors reported with HandleSeverity are wrapped in an *Error that
+// carries their severity and reporting component.
 type ErrorHandler interface {
 	// Handle handles any error deemed irremediable by an OpenTelemetry
 	// component.
 	Handle(error)
 }
+
+// ErrorHandlerFunc is an adapter to allow the use of an ordinary function
+// as an ErrorHandler.
+type ErrorHandlerFunc func(error)
+
+// Handle calls f(err).
+func (f ErrorHandlerFunc) Handle(err error) {
+	f(err)
+}
+
+// Severity is the severity of an error passed to an ErrorHandler. More
+// severe errors have larger values. The zero value is SeverityError.
+type Severity int
+
+const (
+	// SeverityDebug is for events that are only useful when
+	// diagnosing OpenTelemetry itself.
+	SeverityDebug Severity = -3
+	// SeverityInfo is for notable events that need no action.
+	SeverityInfo Severity = -2
+	// SeverityWarning is for events where telemetry is degraded but
+	// the component keeps working, e.g. an ignored option.
+	SeverityWarning Severity = -1
+	// SeverityError is for events where telemetry was lost. Errors
+	// not wrapped in an *Error have this severity.
+	SeverityError Severity = 0
+)
+
+// String returns the lower-case name of the severity.
+func (s Severity) String() string {
+	switch s {
+	case SeverityDebug:
+		return "debug"
+	case SeverityInfo:
+		return "info"
+	case SeverityWarning:
+		return "warning"
+	case SeverityError:
+		return "error"
+	}
+	return fmt.Sprintf("severity(%d)", int(s))
+}
+
+// Error is an error tagged with the severity and the name of the
+// component that reported it. ErrorHandlers can retrieve it from the
+// errors they are passed with errors.As.
+type Error struct {
+	// Severity is the severity of Err.
+	Severity Severity
+	// Component is the name of the reporting component, e.g.
+	// "sdk/trace" or "otlp". It may be empty.
+	Component string
+	// Err is the reported error.
+	Err error
+}
+
+// Error returns the error message prefixed with the component name.
+func (e *Error) Error() string {
+	if e.Component == "" {
+		return e.Err.Error()
+	}
+	return e.Component + ": " + e.Err.Error()
+}
+
+// Unwrap returns the reported error.
+func (e *Error) Unwrap() error {
+	return e.Err
+}
+
+// SeverityOf returns the severity err was tagged with, or
+// SeverityError if it was not tagged.
+func SeverityOf(err error) Severity {
+	var e *Error
+	if errors.As(err, &e) {
+		return e.Severity
+	}
+	return SeverityError
+}
@@ -0,0 +1,130 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package otel // import "go.opentelemetry.io/otel"
+
+import (
+	"fmt"
+	"sync"
+	"time"
+)
+
+const (
+	// defaultRateLimitInterval is the interval the default
+	// ErrorHandler waits before logging an identical error again.
+	defaultRateLimitInterval = 10 * time.Second
+
+	// maxRateLimitEntries bounds the number of distinct errors a
+	// rateLimiter tracks.
+	maxRateLimitEntries = 1024
+)
+
+// rateLimiter suppresses repeats of identical errors within an
+// interval. Errors are identical if they have the same severity and
+// message.
+type rateLimiter struct {
+	interval time.Duration
+	now      func() time.Time
+
+	mu   sync.Mutex
+	seen map[string]*rateEntry
+}
+
+type rateEntry struct {
+	last       time.Time
+	suppressed int
+}
+
+func newRateLimiter(interval time.Duration) *rateLimiter {
+	return &rateLimiter{
+		interval: interval,
+		now:      time.Now,
+		seen:     make(map[string]*rateEntry),
+	}
+}
+
+// allow reports whether err should be handled. If it should, the
+// number of identical errors suppressed since it was last handled is
+// also returned.
+func (r *rateLimiter) allow(err error) (bool, int) {
+	key := SeverityOf(err).String() + ":" + err.Error()
+	now := r.now()
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if e, ok := r.seen[key]; ok {
+		if now.Sub(e.last) < r.interval {
+			e.suppressed++
+			return false, 0
+		}
+		n := e.suppressed
+		e.last, e.suppressed = now, 0
+		return true, n
+	}
+	if len(r.seen) >= maxRateLimitEntries {
+		r.prune(now)
+	}
+	r.seen[key] = &rateEntry{last: now}
+	return true, 0
+}
+
+// prune removes entries whose interval has passed. If every entry is
+// still current, all of them are removed to bound memory use.
+func (r *rateLimiter) prune(now time.Time) {
+	for k, e := range r.seen {
+		if now.Sub(e.last) >= r.interval {
+			delete(r.seen, k)
+		}
+	}
+	if len(r.seen) >= maxRateLimitEntries {
+		r.seen = make(map[string]*rateEntry)
+	}
+}
+
+// withSuppressed annotates err with the number of identical errors
+// that were suppressed before it.
+func withSuppressed(err error, n int) error {
+	if n == 0 {
+		return err
+	}
+	return fmt.Errorf("%w (%d identical errors suppressed)", err, n)
+}
+
+// rateLimitedErrorHandler is an ErrorHandler that drops repeats of
+// identical errors before passing them to its delegate.
+type rateLimitedErrorHandler struct {
+	delegate ErrorHandler
+	limiter  *rateLimiter
+}
+
+// NewRateLimitedErrorHandler returns an ErrorHandler that passes errors
+// to h, but drops an error if an identical one was passed to h less
+// than interval ago. The next error passed to h after a drop notes how
+// many identical errors were dropped.
+func NewRateLimitedErrorHandler(h ErrorHandler, interval time.Duration) ErrorHandler {
+	return &rateLimitedErrorHandler{
+		delegate: h,
+		limiter:  newRateLimiter(interval),
+	}
+}
+
+// Handle implements ErrorHandler.
+func (h *rateLimitedErrorHandler) Handle(err error) {
+	ok, n := h.limiter.allow(err)
+	if !ok {
+		return
+	}
+	h.delegate.Handle(withSuppressed(err, n))
+}
@@ -0,0 +1,291 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package autoexport // import "go.opentelemetry.io/otel/exporters/autoexport"
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+	"strings"
+	"sync"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/exporters/metric/prometheus"
+	"go.opentelemetry.io/otel/exporters/otlp"
+	"go.opentelemetry.io/otel/exporters/otlp/otlpgrpc"
+	"go.opentelemetry.io/otel/exporters/otlp/otlphttp"
+	"go.opentelemetry.io/otel/exporters/stdout"
+	"go.opentelemetry.io/otel/exporters/trace/jaeger"
+	"go.opentelemetry.io/otel/exporters/trace/zipkin"
+	"go.opentelemetry.io/otel/metric"
+	exportmetric "go.opentelemetry.io/otel/sdk/export/metric"
+	"go.opentelemetry.io/otel/sdk/export/metric/aggregation"
+	exporttrace "go.opentelemetry.io/otel/sdk/export/trace"
+	controller "go.opentelemetry.io/otel/sdk/metric/controller/basic"
+)
+
+// Environment variable names
+const (
+	// The name of the span exporter.
+	tracesEnvVar = "OTEL_TRACES_EXPORTER"
+	// The name of the metric exporter.
+	metricsEnvVar = "OTEL_METRICS_EXPORTER"
+	// The protocol of the otlp exporters: grpc or http/protobuf.
+	otlpProtocolEnvVar = "OTEL_EXPORTER_OTLP_PROTOCOL"
+	// The collector URL of the zipkin exporter.
+	zipkinEndpointEnvVar = "OTEL_EXPORTER_ZIPKIN_ENDPOINT"
+	// The service name reported by the zipkin exporter.
+	serviceNameEnvVar = "OTEL_SERVICE_NAME"
+)
+
+const (
+	// none is the name of the exporters that drop everything.
+	none = "none"
+	// defaultName is the name of the span exporter used when
+	// OTEL_TRACES_EXPORTER is not set. It matches the default of the
+	// metric exporters of the basic controller.
+	defaultName = "otlp"
+
+	defaultJaegerAgentEndpoint = "localhost:6831"
+	defaultZipkinEndpoint      = "http://localhost:9411/api/v2/spans"
+)
+
+var (
+	// errUnknownExporter is returned when an exporter name is not
+	// registered.
+	errUnknownExporter = errors.New("unknown exporter")
+	// errDuplicateRegistration is returned when an exporter name is
+	// already registered.
+	errDuplicateRegistration = errors.New("duplicate exporter registration")
+	// errInvalidName is returned when an exporter name cannot be
+	// registered.
+	errInvalidName = errors.New("invalid exporter name")
+	// errUnknownProtocol is returned when the otlp protocol is not
+	// supported.
+	errUnknownProtocol = errors.New("unknown otlp protocol")
+)
+
+// SpanExporterFactory creates a span exporter.
+type SpanExporterFactory func(context.Context) (exporttrace.SpanExporter, error)
+
+// registry holds the known span exporter factories by name. Metric
+// exporters are registered with the basic controller.
+type registry struct {
+	mu    sync.Mutex
+	spans map[string]SpanExporterFactory
+}
+
+func (r *registry) loadSpans(name string) (SpanExporterFactory, bool) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	f, ok := r.spans[name]
+	return f, ok
+}
+
+func (r *registry) storeSpans(name string, f SpanExporterFactory) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if _, ok := r.spans[name]; ok {
+		return fmt.Errorf("%w: %q", errDuplicateRegistration, name)
+	}
+	r.spans[name] = f
+	return nil
+}
+
+var envRegistry = &registry{
+	spans: map[string]SpanExporterFactory{
+		"otlp":   func(ctx context.Context) (exporttrace.SpanExporter, error) { return newOTLP(ctx) },
+		"jaeger": newJaeger,
+		"zipkin": newZipkin,
+		"stdout": func(context.Context) (exporttrace.SpanExporter, error) { return stdout.NewExporter() },
+		none:     func(context.Context) (exporttrace.SpanExporter, error) { return noopExporter{}, nil },
+	},
+}
+
+// PullExporter is a metric exporter whose metrics are read by its
+// backend, like Prometheus, instead of being pushed. It serves the
+// metrics collected by its Controller.
+type PullExporter interface {
+	http.Handler
+	Controller() *controller.Controller
+}
+
+// PullExporterFactory creates a pull metric exporter.
+type PullExporterFactory func(context.Context) (PullExporter, error)
+
+// pullExporters holds the factories of the pull metric exporters by
+// name.
+var pullExporters = map[string]PullExporterFactory{
+	"prometheus": newPrometheus,
+}
+
+func init() {
+	for name, f := range map[string]controller.ExporterFactory{
+		"otlp":   func(ctx context.Context) (exportmetric.Exporter, error) { return newOTLP(ctx) },
+		"stdout": func(context.Context) (exportmetric.Exporter, error) { return stdout.NewExporter() },
+		// Pull exporters push nothing, they are created with
+		// NewMetricPullExporter.
+		"prometheus": func(context.Context) (exportmetric.Exporter, error) { return nil, nil },
+	} {
+		if err := controller.RegisterExporter(name, f); err != nil {
+			otel.Handle(err)
+		}
+	}
+}
+
+// RegisterSpanExporter makes the span exporter created by f available
+// under name to SpanExporter and NewSpanExporter. Names are
+// case-insensitive. An error is returned if name is empty or already
+// registered, or f is nil.
+func RegisterSpanExporter(name string, f SpanExporterFactory) error {
+	name = strings.ToLower(strings.TrimSpace(name))
+	if name == "" || f == nil {
+		return fmt.Errorf("%w: %q", errInvalidName, name)
+	}
+	return envRegistry.storeSpans(name, f)
+}
+
+// SpanExporter returns a new span exporter of the kind registered with
+// name. An error is returned if name is not registered or the exporter
+// cannot be created.
+func SpanExporter(ctx context.Context, name string) (exporttrace.SpanExporter, error) {
+	name = strings.ToLower(strings.TrimSpace(name))
+	f, ok := envRegistry.loadSpans(name)
+	if !ok {
+		return nil, fmt.Errorf("%w: %q", errUnknownExporter, name)
+	}
+	return f(ctx)
+}
+
+// NewSpanExporter returns a new span exporter of the kind named by the
+// OTEL_TRACES_EXPORTER environment variable, otlp if it is not set.
+func NewSpanExporter(ctx context.Context) (exporttrace.SpanExporter, error) {
+	e, err := SpanExporter(ctx, envName(tracesEnvVar))
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", tracesEnvVar, err)
+	}
+	return e, nil
+}
+
+// NewMetricExporter returns a new metric exporter of the kind named by
+// the OTEL_METRICS_EXPORTER environment variable, otlp if it is not set.
+// It looks the name up among the exporters registered with
+// basic.RegisterExporter, and returns an exporter dropping all metrics
+// for none and for pull exporters like prometheus, which are created
+// with NewMetricPullExporter.
+func NewMetricExporter(ctx context.Context) (exportmetric.Exporter, error) {
+	e, err := controller.ExporterFromEnv(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if e == nil {
+		return noopExporter{}, nil
+	}
+	return e, nil
+}
+
+// NewMetricPullExporter returns a new pull metric exporter of the kind
+// named by the OTEL_METRICS_EXPORTER environment variable. It returns nil
+// if the variable names a push exporter, or is not set. The metrics of
+// the Controller of the exporter are served by its ServeHTTP method.
+//
+// For example, with OTEL_METRICS_EXPORTER=prometheus:
+//
+//	e, err := autoexport.NewMetricPullExporter(ctx)
+//	if err != nil {
+//		...
+//	}
+//	if e != nil {
+//		otel.SetMeterProvider(e.Controller().MeterProvider())
+//		http.Handle("/metrics", e)
+//	}
+func NewMetricPullExporter(ctx context.Context) (PullExporter, error) {
+	name := strings.ToLower(envName(metricsEnvVar))
+	f, ok := pullExporters[name]
+	if !ok {
+		return nil, nil
+	}
+	return f(ctx)
+}
+
+// envName returns the exporter name held by the environment variable
+// key, or the default name if it is not set.
+func envName(key string) string {
+	if name := strings.TrimSpace(os.Getenv(key)); name != "" {
+		return name
+	}
+	return defaultName
+}
+
+// newOTLP returns an OTLP exporter using the protocol of
+// OTEL_EXPORTER_OTLP_PROTOCOL.
+func newOTLP(ctx context.Context) (*otlp.Exporter, error) {
+	var driver otlp.ProtocolDriver
+	switch protocol := strings.TrimSpace(os.Getenv(otlpProtocolEnvVar)); protocol {
+	case "", "grpc":
+		driver = otlpgrpc.NewDriver()
+	case "http/protobuf":
+		driver = otlphttp.NewDriver()
+	default:
+		return nil, fmt.Errorf("%w: %s=%q", errUnknownProtocol, otlpProtocolEnvVar, protocol)
+	}
+	return otlp.NewExporter(ctx, driver)
+}
+
+// newPrometheus returns a Prometheus exporter with its own pull
+// controller.
+func newPrometheus(context.Context) (PullExporter, error) {
+	return prometheus.NewExportPipeline(prometheus.Config{})
+}
+
+// newJaeger returns a Jaeger exporter sending spans to the collector of
+// JAEGER_ENDPOINT if it is set, to the local agent otherwise.
+func newJaeger(context.Context) (exporttrace.SpanExporter, error) {
+	endpoint := jaeger.WithAgentEndpoint(defaultJaegerAgentEndpoint)
+	if collector := jaeger.CollectorEndpointFromEnv(); collector != "" {
+		endpoint = jaeger.WithCollectorEndpoint(collector, jaeger.WithCollectorEndpointOptionFromEnv())
+	}
+	return jaeger.NewRawExporter(endpoint, jaeger.WithProcessFromEnv(), jaeger.WithDisabledFromEnv())
+}
+
+// newZipkin returns a Zipkin exporter sending spans to the collector of
+// OTEL_EXPORTER_ZIPKIN_ENDPOINT.
+func newZipkin(context.Context) (exporttrace.SpanExporter, error) {
+	endpoint := strings.TrimSpace(os.Getenv(zipkinEndpointEnvVar))
+	if endpoint == "" {
+		endpoint = defaultZipkinEndpoint
+	}
+	return zipkin.NewRawExporter(endpoint, os.Getenv(serviceNameEnvVar))
+}
+
+// noopExporter drops all spans and metrics.
+type noopExporter struct{}
+
+var (
+	_ exporttrace.SpanExporter = noopExporter{}
+	_ exportmetric.Exporter    = noopExporter{}
+)
+
+func (noopExporter) ExportSpans(context.Context, []*exporttrace.SpanSnapshot) error { return nil }
+
+func (noopExporter) Shutdown(context.Context) error { return nil }
+
+func (noopExporter) Export(context.Context, exportmetric.CheckpointSet) error { return nil }
+
+func (noopExporter) ExportKindFor(desc *metric.Descriptor, kind aggregation.Kind) exportmetric.ExportKind {
+	return exportmetric.CumulativeExportKindSelector().ExportKindFor(desc, kind)
+}
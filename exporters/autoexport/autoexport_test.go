@@ -0,0 +1,155 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package autoexport
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.opentelemetry.io/otel/exporters/metric/prometheus"
+	"go.opentelemetry.io/otel/exporters/otlp"
+	"go.opentelemetry.io/otel/exporters/stdout"
+	"go.opentelemetry.io/otel/exporters/trace/jaeger"
+	"go.opentelemetry.io/otel/exporters/trace/zipkin"
+	ottest "go.opentelemetry.io/otel/internal/internaltest"
+	"go.opentelemetry.io/otel/metric"
+	exportmetric "go.opentelemetry.io/otel/sdk/export/metric"
+	exporttrace "go.opentelemetry.io/otel/sdk/export/trace"
+	controller "go.opentelemetry.io/otel/sdk/metric/controller/basic"
+)
+
+func setEnv(t *testing.T, env map[string]string) func() {
+	store, err := ottest.SetEnvVariables(env)
+	require.NoError(t, err)
+	return func() { require.NoError(t, store.Restore()) }
+}
+
+func TestNewSpanExporter(t *testing.T) {
+	tests := []struct {
+		env  map[string]string
+		want interface{}
+		err  error
+	}{
+		{env: map[string]string{tracesEnvVar: "", otlpProtocolEnvVar: "http/protobuf"}, want: &otlp.Exporter{}},
+		{env: map[string]string{tracesEnvVar: "otlp", otlpProtocolEnvVar: "http/json"}, err: errUnknownProtocol},
+		{env: map[string]string{tracesEnvVar: "jaeger"}, want: &jaeger.Exporter{}},
+		{env: map[string]string{tracesEnvVar: "Zipkin"}, want: &zipkin.Exporter{}},
+		{env: map[string]string{tracesEnvVar: "stdout"}, want: &stdout.Exporter{}},
+		{env: map[string]string{tracesEnvVar: "none"}, want: noopExporter{}},
+		{env: map[string]string{tracesEnvVar: "kafka"}, err: errUnknownExporter},
+	}
+	for _, test := range tests {
+		t.Run(test.env[tracesEnvVar], func(t *testing.T) {
+			defer setEnv(t, test.env)()
+
+			e, err := NewSpanExporter(context.Background())
+			if test.err != nil {
+				assert.True(t, errors.Is(err, test.err), "%v", err)
+				return
+			}
+			require.NoError(t, err)
+			assert.IsType(t, test.want, e)
+			assert.NoError(t, e.Shutdown(context.Background()))
+		})
+	}
+}
+
+func TestNewMetricExporter(t *testing.T) {
+	tests := []struct {
+		env  map[string]string
+		want interface{}
+	}{
+		{env: map[string]string{"OTEL_METRICS_EXPORTER": "", otlpProtocolEnvVar: "http/protobuf"}, want: &otlp.Exporter{}},
+		{env: map[string]string{"OTEL_METRICS_EXPORTER": "stdout"}, want: &stdout.Exporter{}},
+		{env: map[string]string{"OTEL_METRICS_EXPORTER": "none"}, want: noopExporter{}},
+		{env: map[string]string{"OTEL_METRICS_EXPORTER": "prometheus"}, want: noopExporter{}},
+		{env: map[string]string{"OTEL_METRICS_EXPORTER": "jaeger"}},
+	}
+	for _, test := range tests {
+		t.Run(test.env["OTEL_METRICS_EXPORTER"], func(t *testing.T) {
+			defer setEnv(t, test.env)()
+
+			e, err := NewMetricExporter(context.Background())
+			if test.want == nil {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.IsType(t, test.want, e)
+		})
+	}
+}
+
+func TestNewMetricPullExporter(t *testing.T) {
+	for _, name := range []string{"", "otlp", "stdout", "none", "unknown"} {
+		t.Run(name, func(t *testing.T) {
+			defer setEnv(t, map[string]string{"OTEL_METRICS_EXPORTER": name})()
+
+			e, err := NewMetricPullExporter(context.Background())
+			require.NoError(t, err)
+			assert.Nil(t, e)
+		})
+	}
+
+	defer setEnv(t, map[string]string{"OTEL_METRICS_EXPORTER": "Prometheus"})()
+	e, err := NewMetricPullExporter(context.Background())
+	require.NoError(t, err)
+	require.IsType(t, &prometheus.Exporter{}, e)
+
+	counter := metric.Must(e.Controller().MeterProvider().Meter("test")).NewInt64Counter("requests")
+	counter.Add(context.Background(), 2)
+
+	rec := httptest.NewRecorder()
+	e.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/metrics", nil))
+	assert.Contains(t, rec.Body.String(), "requests 2")
+
+	// The basic controller pushes nothing for pull exporters.
+	me, err := controller.ExporterFromEnv(context.Background())
+	require.NoError(t, err)
+	assert.Nil(t, me)
+}
+
+func TestRegister(t *testing.T) {
+	spans := func(context.Context) (exporttrace.SpanExporter, error) { return noopExporter{}, nil }
+	metrics := func(context.Context) (exportmetric.Exporter, error) { return noopExporter{}, nil }
+
+	assert.True(t, errors.Is(RegisterSpanExporter("", spans), errInvalidName))
+	assert.True(t, errors.Is(RegisterSpanExporter("custom", nil), errInvalidName))
+	assert.True(t, errors.Is(RegisterSpanExporter("OTLP", spans), errDuplicateRegistration))
+	assert.Error(t, controller.RegisterExporter("stdout", metrics))
+
+	require.NoError(t, RegisterSpanExporter("Custom", spans))
+	require.NoError(t, controller.RegisterExporter("custom", metrics))
+
+	se, err := SpanExporter(context.Background(), "CUSTOM")
+	require.NoError(t, err)
+	assert.Equal(t, noopExporter{}, se)
+
+	defer setEnv(t, map[string]string{"OTEL_METRICS_EXPORTER": "custom"})()
+	me, err := NewMetricExporter(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, noopExporter{}, me)
+
+	// The basic controller sees the same exporters.
+	me, err = controller.ExporterFromEnv(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, noopExporter{}, me)
+}
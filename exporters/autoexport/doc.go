@@ -0,0 +1,40 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package autoexport creates the span and metric exporters of an
+// application from the OTEL_TRACES_EXPORTER and OTEL_METRICS_EXPORTER
+// environment variables, so the telemetry backend can be switched
+// without changing code.
+//
+// The following names are supported by default:
+//
+//	otlp:       OTLP, over the protocol of OTEL_EXPORTER_OTLP_PROTOCOL
+//	            (grpc, the default, or http/protobuf)
+//	jaeger:     Jaeger, spans only
+//	zipkin:     Zipkin, spans only
+//	prometheus: Prometheus, metrics only
+//	stdout:     standard output
+//	none:       no export
+//
+// The variables default to otlp. Any push exporter can be added with
+// RegisterSpanExporter, or with the RegisterExporter function of the
+// basic metric controller for metrics. The metric exporters are
+// registered with the basic controller, so its WithPusherFromEnv option
+// also finds them once this package is imported.
+//
+// Prometheus pulls the metrics it exports, so its exporter is created
+// along with its own controller by NewMetricPullExporter, and serves
+// them over HTTP. NewMetricExporter and WithPusherFromEnv push nothing
+// for it.
+package autoexport // import "go.opentelemetry.io/otel/exporters/autoexport"
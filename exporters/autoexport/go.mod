@@ -0,0 +1,24 @@
+module go.opentelemetry.io/otel/exporters/autoexport
+
+go 1.14
+
+replace (
+	go.opentelemetry.io/otel => ../..
+	go.opentelemetry.io/otel/exporters/metric/prometheus => ../metric/prometheus
+	go.opentelemetry.io/otel/exporters/otlp => ../otlp
+	go.opentelemetry.io/otel/exporters/stdout => ../stdout
+	go.opentelemetry.io/otel/exporters/trace/jaeger => ../trace/jaeger
+	go.opentelemetry.io/otel/exporters/trace/zipkin => ../trace/zipkin
+	go.opentelemetry.io/otel/sdk => ../../sdk
+)
+
+require (
+	github.com/stretchr/testify v1.7.0
+	go.opentelemetry.io/otel v0.16.0
+	go.opentelemetry.io/otel/exporters/metric/prometheus v0.16.0
+	go.opentelemetry.io/otel/exporters/otlp v0.16.0
+	go.opentelemetry.io/otel/exporters/stdout v0.16.0
+	go.opentelemetry.io/otel/exporters/trace/jaeger v0.16.0
+	go.opentelemetry.io/otel/exporters/trace/zipkin v0.16.0
+	go.opentelemetry.io/otel/sdk v0.16.0
+)
@@ -0,0 +1,146 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package influxdb // import "go.opentelemetry.io/otel/exporters/metric/influxdb"
+
+import "net/http"
+
+const (
+	// DefaultEndpoint is the base URL of the InfluxDB server the
+	// exporter writes to if none is configured.
+	DefaultEndpoint = "http://localhost:8086"
+	// DefaultDatabase is the InfluxDB 1.x database written to if
+	// neither a database nor a bucket is configured.
+	DefaultDatabase = "opentelemetry"
+	// DefaultBatchSize is the maximum number of lines sent in a
+	// single write request if no other size is configured.
+	DefaultBatchSize = 5000
+)
+
+type config struct {
+	endpoint  string
+	database  string
+	org       string
+	bucket    string
+	username  string
+	password  string
+	token     string
+	batchSize int
+	client    *http.Client
+}
+
+// Option applies an option to the InfluxDB exporter.
+type Option interface {
+	Apply(*config)
+}
+
+type endpointOption string
+
+func (o endpointOption) Apply(cfg *config) {
+	cfg.endpoint = (string)(o)
+}
+
+// WithEndpoint sets the base URL of the InfluxDB server, e.g.
+// "https://influxdb.example.com:8086". The URL must not contain the
+// path of the write API. If unset, DefaultEndpoint will be used.
+func WithEndpoint(endpoint string) Option {
+	return (endpointOption)(endpoint)
+}
+
+type databaseOption string
+
+func (o databaseOption) Apply(cfg *config) {
+	cfg.database = (string)(o)
+	cfg.org, cfg.bucket = "", ""
+}
+
+// WithDatabase tells the exporter to use the InfluxDB 1.x write API
+// (also served by InfluxDB 2.x for compatibility and by Telegraf) to
+// write into the given database. If neither a database nor a bucket
+// is set, DefaultDatabase will be used.
+func WithDatabase(database string) Option {
+	return (databaseOption)(database)
+}
+
+type bucketOption struct {
+	org    string
+	bucket string
+}
+
+func (o bucketOption) Apply(cfg *config) {
+	cfg.org, cfg.bucket = o.org, o.bucket
+	cfg.database = ""
+}
+
+// WithBucket tells the exporter to use the InfluxDB 2.x write API to
+// write into the given bucket of the given organization.
+func WithBucket(org, bucket string) Option {
+	return bucketOption{org: org, bucket: bucket}
+}
+
+type basicAuthOption struct {
+	username string
+	password string
+}
+
+func (o basicAuthOption) Apply(cfg *config) {
+	cfg.username, cfg.password = o.username, o.password
+}
+
+// WithBasicAuth tells the exporter to authenticate its requests with
+// the HTTP basic authentication scheme.
+func WithBasicAuth(username, password string) Option {
+	return basicAuthOption{username: username, password: password}
+}
+
+type tokenOption string
+
+func (o tokenOption) Apply(cfg *config) {
+	cfg.token = (string)(o)
+}
+
+// WithToken tells the exporter to authenticate its requests with an
+// InfluxDB API token. It takes precedence over WithBasicAuth.
+func WithToken(token string) Option {
+	return (tokenOption)(token)
+}
+
+type batchSizeOption int
+
+func (o batchSizeOption) Apply(cfg *config) {
+	cfg.batchSize = (int)(o)
+}
+
+// WithBatchSize sets the maximum number of lines sent in a single
+// write request. An export producing more lines is split into
+// several requests. If unset or not positive, DefaultBatchSize will
+// be used.
+func WithBatchSize(size int) Option {
+	return (batchSizeOption)(size)
+}
+
+type httpClientOption struct {
+	client *http.Client
+}
+
+func (o httpClientOption) Apply(cfg *config) {
+	cfg.client = o.client
+}
+
+// WithHTTPClient sets the client used to send write requests. Use it
+// to configure timeouts, proxies or TLS. If unset,
+// http.DefaultClient will be used.
+func WithHTTPClient(client *http.Client) Option {
+	return httpClientOption{client: client}
+}
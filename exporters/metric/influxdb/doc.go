@@ -0,0 +1,33 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package influxdb provides a push exporter that writes metric data in
+// the InfluxDB line protocol to the HTTP write API of InfluxDB 1.x,
+// InfluxDB 2.x or Telegraf's influxdb_listener input.
+//
+// Every record is written as one line whose measurement is the
+// metric name and whose tags are the resource attributes and labels.
+// Sums and last values are written as a "value" field. MinMaxSumCount
+// aggregations are written with "count", "sum", "min" and "max"
+// fields, and histograms with "count" and "sum" fields plus one
+// field per bucket holding the cumulative count of values less than
+// the bucket's upper boundary, named after that boundary ("+Inf" for
+// the last bucket). Exact aggregations are written as one line per
+// recorded point.
+//
+// This package is currently in a pre-GA phase. Backwards incompatible
+// changes may be introduced in subsequent minor version releases as we
+// work to track the evolving OpenTelemetry specification and user
+// feedback.
+package influxdb // import "go.opentelemetry.io/otel/exporters/metric/influxdb"
@@ -0,0 +1,367 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package influxdb // import "go.opentelemetry.io/otel/exporters/metric/influxdb"
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/label"
+	"go.opentelemetry.io/otel/metric"
+	"go.opentelemetry.io/otel/metric/number"
+	export "go.opentelemetry.io/otel/sdk/export/metric"
+	"go.opentelemetry.io/otel/sdk/export/metric/aggregation"
+	controller "go.opentelemetry.io/otel/sdk/metric/controller/basic"
+	processor "go.opentelemetry.io/otel/sdk/metric/processor/basic"
+	"go.opentelemetry.io/otel/sdk/metric/selector/simple"
+)
+
+// Exporter writes metric data in the InfluxDB line protocol to the
+// HTTP write API.
+type Exporter struct {
+	cfg      config
+	writeURL string
+	encoder  *tagEncoder
+}
+
+var _ export.Exporter = &Exporter{}
+
+// NewExporter returns a new InfluxDB exporter.
+func NewExporter(opts ...Option) (*Exporter, error) {
+	cfg := config{
+		endpoint:  DefaultEndpoint,
+		database:  DefaultDatabase,
+		batchSize: DefaultBatchSize,
+		client:    http.DefaultClient,
+	}
+	for _, opt := range opts {
+		opt.Apply(&cfg)
+	}
+	if cfg.batchSize <= 0 {
+		cfg.batchSize = DefaultBatchSize
+	}
+	if cfg.client == nil {
+		cfg.client = http.DefaultClient
+	}
+
+	u, err := url.Parse(cfg.endpoint)
+	if err != nil {
+		return nil, fmt.Errorf("influxdb: invalid endpoint %q: %w", cfg.endpoint, err)
+	}
+	query := url.Values{}
+	query.Set("precision", "ns")
+	if cfg.bucket != "" {
+		u.Path = strings.TrimSuffix(u.Path, "/") + "/api/v2/write"
+		query.Set("org", cfg.org)
+		query.Set("bucket", cfg.bucket)
+	} else {
+		u.Path = strings.TrimSuffix(u.Path, "/") + "/write"
+		query.Set("db", cfg.database)
+	}
+	u.RawQuery = query.Encode()
+
+	return &Exporter{
+		cfg:      cfg,
+		writeURL: u.String(),
+		encoder:  newTagEncoder(),
+	}, nil
+}
+
+// NewExportPipeline sets up a complete export pipeline with the
+// recommended setup, using the inexpensive distribution selector.
+func NewExportPipeline(opts []Option, pushOpts ...controller.Option) (*controller.Controller, error) {
+	exporter, err := NewExporter(opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	pusher := controller.New(
+		processor.New(
+			simple.NewWithInexpensiveDistribution(),
+			exporter,
+		),
+		append(pushOpts, controller.WithPusher(exporter))...,
+	)
+	if err := pusher.Start(context.Background()); err != nil {
+		return nil, err
+	}
+	return pusher, nil
+}
+
+// InstallNewPipeline instantiates a NewExportPipeline and registers
+// it globally.
+func InstallNewPipeline(opts []Option, pushOpts ...controller.Option) (*controller.Controller, error) {
+	pusher, err := NewExportPipeline(opts, pushOpts...)
+	if err != nil {
+		return nil, err
+	}
+	otel.SetMeterProvider(pusher.MeterProvider())
+	return pusher, nil
+}
+
+// ExportKindFor implements export.ExportKindSelector. InfluxDB stores
+// every written value as-is, so cumulative values are exported.
+func (e *Exporter) ExportKindFor(desc *metric.Descriptor, kind aggregation.Kind) export.ExportKind {
+	return export.CumulativeExportKindSelector().ExportKindFor(desc, kind)
+}
+
+// Export implements export.Exporter. It writes the lines of all
+// records in batches of at most the configured batch size.
+func (e *Exporter) Export(ctx context.Context, checkpointSet export.CheckpointSet) error {
+	var (
+		buf   bytes.Buffer
+		lines int
+	)
+	err := checkpointSet.ForEach(e, func(rec export.Record) error {
+		n, err := e.appendRecord(&buf, rec)
+		if err != nil {
+			return err
+		}
+		lines += n
+		if lines >= e.cfg.batchSize {
+			lines = 0
+			return e.write(ctx, &buf)
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	if buf.Len() == 0 {
+		return nil
+	}
+	return e.write(ctx, &buf)
+}
+
+func (e *Exporter) write(ctx context.Context, buf *bytes.Buffer) error {
+	defer buf.Reset()
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, e.writeURL, bytes.NewReader(buf.Bytes()))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "text/plain; charset=utf-8")
+	switch {
+	case e.cfg.token != "":
+		req.Header.Set("Authorization", "Token "+e.cfg.token)
+	case e.cfg.username != "":
+		req.SetBasicAuth(e.cfg.username, e.cfg.password)
+	}
+
+	resp, err := e.cfg.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode/100 == 2 {
+		// Read the body to allow reusing the connection.
+		_, _ = io.Copy(ioutil.Discard, resp.Body)
+		return nil
+	}
+	msg, _ := ioutil.ReadAll(io.LimitReader(resp.Body, 512))
+	return fmt.Errorf("influxdb: write failed with HTTP status %s: %s", resp.Status, strings.TrimSpace(string(msg)))
+}
+
+// appendRecord appends the lines representing the record to buf and
+// returns their number.
+func (e *Exporter) appendRecord(buf *bytes.Buffer, rec export.Record) (int, error) {
+	desc := rec.Descriptor()
+	kind := desc.NumberKind()
+	series := e.series(rec)
+	ts := rec.EndTime()
+
+	var f fields
+	switch agg := rec.Aggregation().(type) {
+	case aggregation.Points:
+		points, err := agg.Points()
+		if err != nil {
+			return 0, err
+		}
+		for _, p := range points {
+			var pf fields
+			pf.addNumber("value", p.Number, kind)
+			appendLine(buf, series, pf, p.Time)
+		}
+		return len(points), nil
+	case aggregation.Histogram:
+		count, err := agg.Count()
+		if err != nil {
+			return 0, err
+		}
+		sum, err := agg.Sum()
+		if err != nil {
+			return 0, err
+		}
+		buckets, err := agg.Histogram()
+		if err != nil {
+			return 0, err
+		}
+		f.addUint("count", count)
+		f.addNumber("sum", sum, kind)
+		var cumulative uint64
+		for i, c := range buckets.Counts {
+			cumulative += c
+			boundary := "+Inf"
+			if i < len(buckets.Boundaries) {
+				boundary = strconv.FormatFloat(buckets.Boundaries[i], 'g', -1, 64)
+			}
+			f.addUint(boundary, cumulative)
+		}
+	case aggregation.MinMaxSumCount:
+		count, err := agg.Count()
+		if err != nil {
+			return 0, err
+		}
+		sum, err := agg.Sum()
+		if err != nil {
+			return 0, err
+		}
+		min, err := agg.Min()
+		if err != nil {
+			return 0, err
+		}
+		max, err := agg.Max()
+		if err != nil {
+			return 0, err
+		}
+		f.addUint("count", count)
+		f.addNumber("sum", sum, kind)
+		f.addNumber("min", min, kind)
+		f.addNumber("max", max, kind)
+	case aggregation.LastValue:
+		value, timestamp, err := agg.LastValue()
+		if err != nil {
+			return 0, err
+		}
+		f.addNumber("value", value, kind)
+		ts = timestamp
+	case aggregation.Sum:
+		sum, err := agg.Sum()
+		if err != nil {
+			return 0, err
+		}
+		f.addNumber("value", sum, kind)
+	default:
+		return 0, fmt.Errorf("influxdb: unsupported aggregation %q for %s", agg.Kind(), desc.Name())
+	}
+	appendLine(buf, series, f, ts)
+	return 1, nil
+}
+
+// series returns the escaped measurement and tag set of the record.
+func (e *Exporter) series(rec export.Record) string {
+	var sb strings.Builder
+	sb.WriteString(measurementReplacer.Replace(rec.Descriptor().Name()))
+	if res := rec.Resource().Encoded(e.encoder); res != "" {
+		sb.WriteByte(',')
+		sb.WriteString(res)
+	}
+	if labels := rec.Labels().Encoded(e.encoder); labels != "" {
+		sb.WriteByte(',')
+		sb.WriteString(labels)
+	}
+	return sb.String()
+}
+
+// fields is an encoded field set.
+type fields struct {
+	strings.Builder
+}
+
+func (f *fields) addKey(key string) {
+	if f.Len() > 0 {
+		f.WriteByte(',')
+	}
+	f.WriteString(keyReplacer.Replace(key))
+	f.WriteByte('=')
+}
+
+func (f *fields) addNumber(key string, n number.Number, kind number.Kind) {
+	f.addKey(key)
+	if kind == number.Float64Kind {
+		f.WriteString(strconv.FormatFloat(n.AsFloat64(), 'g', -1, 64))
+		return
+	}
+	f.WriteString(strconv.FormatInt(n.AsInt64(), 10))
+	f.WriteByte('i')
+}
+
+func (f *fields) addUint(key string, n uint64) {
+	f.addKey(key)
+	f.WriteString(strconv.FormatUint(n, 10))
+	f.WriteByte('i')
+}
+
+func appendLine(buf *bytes.Buffer, series string, f fields, ts time.Time) {
+	buf.WriteString(series)
+	buf.WriteByte(' ')
+	buf.WriteString(f.String())
+	if !ts.IsZero() {
+		buf.WriteByte(' ')
+		buf.WriteString(strconv.FormatInt(ts.UnixNano(), 10))
+	}
+	buf.WriteByte('\n')
+}
+
+var (
+	measurementReplacer = strings.NewReplacer(",", `\,`, " ", `\ `, "\n", `\n`)
+	keyReplacer         = strings.NewReplacer(",", `\,`, "=", `\=`, " ", `\ `, "\n", `\n`)
+)
+
+// tagEncoder encodes label sets as an InfluxDB tag set, a comma
+// separated list of key=value pairs. Labels with an empty value are
+// omitted because InfluxDB does not accept them.
+type tagEncoder struct{}
+
+var (
+	_            label.Encoder = (*tagEncoder)(nil)
+	tagEncoderID               = label.NewEncoderID()
+)
+
+func newTagEncoder() *tagEncoder {
+	return &tagEncoder{}
+}
+
+// Encode implements label.Encoder.
+func (*tagEncoder) Encode(iter label.Iterator) string {
+	var sb strings.Builder
+	for iter.Next() {
+		kv := iter.Label()
+		value := kv.Value.Emit()
+		if value == "" {
+			continue
+		}
+		if sb.Len() > 0 {
+			sb.WriteByte(',')
+		}
+		sb.WriteString(keyReplacer.Replace(string(kv.Key)))
+		sb.WriteByte('=')
+		sb.WriteString(keyReplacer.Replace(value))
+	}
+	return sb.String()
+}
+
+// ID implements label.Encoder.
+func (*tagEncoder) ID() label.EncoderID {
+	return tagEncoderID
+}
@@ -0,0 +1,205 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package influxdb_test
+
+import (
+	"context"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.opentelemetry.io/otel/exporters/metric/influxdb"
+	"go.opentelemetry.io/otel/label"
+	"go.opentelemetry.io/otel/metric"
+	"go.opentelemetry.io/otel/metric/number"
+	"go.opentelemetry.io/otel/sdk/export/metric/metrictest"
+	"go.opentelemetry.io/otel/sdk/metric/aggregator/aggregatortest"
+	"go.opentelemetry.io/otel/sdk/metric/aggregator/histogram"
+	"go.opentelemetry.io/otel/sdk/metric/aggregator/minmaxsumcount"
+	"go.opentelemetry.io/otel/sdk/metric/aggregator/sum"
+	"go.opentelemetry.io/otel/sdk/resource"
+)
+
+type request struct {
+	url    string
+	header http.Header
+	body   string
+}
+
+type mockServer struct {
+	*httptest.Server
+
+	mu       sync.Mutex
+	requests []request
+	status   int
+}
+
+func newMockServer() *mockServer {
+	s := &mockServer{status: http.StatusNoContent}
+	s.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := ioutil.ReadAll(r.Body)
+		s.mu.Lock()
+		defer s.mu.Unlock()
+		s.requests = append(s.requests, request{
+			url:    r.URL.String(),
+			header: r.Header,
+			body:   string(body),
+		})
+		w.WriteHeader(s.status)
+		if s.status != http.StatusNoContent {
+			_, _ = w.Write([]byte(`{"message":"nope"}`))
+		}
+	}))
+	return s
+}
+
+func (s *mockServer) Requests() []request {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]request(nil), s.requests...)
+}
+
+var testResource = resource.NewWithAttributes(label.String("R", "V"))
+
+func sumCheckpointSet(t *testing.T, names ...string) *metrictest.CheckpointSet {
+	cps := metrictest.NewCheckpointSet(testResource)
+	for _, name := range names {
+		desc := metric.NewDescriptor(name, metric.CounterInstrumentKind, number.Int64Kind)
+		agg, ckpt := metrictest.Unslice2(sum.New(2))
+		aggregatortest.CheckedUpdate(t, agg, number.NewInt64Number(3), &desc)
+		require.NoError(t, agg.SynchronizedMove(ckpt, &desc))
+		cps.Add(&desc, ckpt, label.String("host name", "a,b"))
+	}
+	return cps
+}
+
+func TestExportSum(t *testing.T) {
+	srv := newMockServer()
+	defer srv.Close()
+
+	exp, err := influxdb.NewExporter(influxdb.WithEndpoint(srv.URL), influxdb.WithDatabase("db0"))
+	require.NoError(t, err)
+	require.NoError(t, exp.Export(context.Background(), sumCheckpointSet(t, "requests")))
+
+	reqs := srv.Requests()
+	require.Len(t, reqs, 1)
+	assert.Equal(t, "/write?db=db0&precision=ns", reqs[0].url)
+	// The metrictest CheckpointSet produces records without an end
+	// time, so no timestamp is written.
+	assert.Equal(t, "requests,R=V,host\\ name=a\\,b value=3i\n", reqs[0].body)
+	assert.Empty(t, reqs[0].header.Get("Authorization"))
+}
+
+func TestExportDistributions(t *testing.T) {
+	srv := newMockServer()
+	defer srv.Close()
+
+	cps := metrictest.NewCheckpointSet(resource.Empty())
+	desc := metric.NewDescriptor("dist", metric.ValueRecorderInstrumentKind, number.Float64Kind)
+	mmsc, mmscCkpt := metrictest.Unslice2(minmaxsumcount.New(2, &desc))
+	hist, histCkpt := metrictest.Unslice2(histogram.New(2, &desc, []float64{1, 10}))
+	for _, v := range []float64{0.5, 4} {
+		aggregatortest.CheckedUpdate(t, mmsc, number.NewFloat64Number(v), &desc)
+		aggregatortest.CheckedUpdate(t, hist, number.NewFloat64Number(v), &desc)
+	}
+	require.NoError(t, mmsc.SynchronizedMove(mmscCkpt, &desc))
+	require.NoError(t, hist.SynchronizedMove(histCkpt, &desc))
+	cps.Add(&desc, mmscCkpt, label.String("agg", "mmsc"))
+	cps.Add(&desc, histCkpt, label.String("agg", "hist"))
+
+	exp, err := influxdb.NewExporter(influxdb.WithEndpoint(srv.URL))
+	require.NoError(t, err)
+	require.NoError(t, exp.Export(context.Background(), cps))
+
+	reqs := srv.Requests()
+	require.Len(t, reqs, 1)
+	assert.ElementsMatch(t, []string{
+		"dist,agg=mmsc count=2i,sum=4.5,min=0.5,max=4",
+		"dist,agg=hist count=2i,sum=4.5,1=1i,10=2i,+Inf=2i",
+	}, strings.Split(strings.TrimSpace(reqs[0].body), "\n"))
+}
+
+func TestBucketAndAuth(t *testing.T) {
+	srv := newMockServer()
+	defer srv.Close()
+
+	exp, err := influxdb.NewExporter(
+		influxdb.WithEndpoint(srv.URL),
+		influxdb.WithBucket("my org", "metrics"),
+		influxdb.WithToken("secret"),
+	)
+	require.NoError(t, err)
+	require.NoError(t, exp.Export(context.Background(), sumCheckpointSet(t, "requests")))
+
+	exp, err = influxdb.NewExporter(
+		influxdb.WithEndpoint(srv.URL),
+		influxdb.WithBasicAuth("user", "pass"),
+	)
+	require.NoError(t, err)
+	require.NoError(t, exp.Export(context.Background(), sumCheckpointSet(t, "requests")))
+
+	reqs := srv.Requests()
+	require.Len(t, reqs, 2)
+	assert.Equal(t, "/api/v2/write?bucket=metrics&org=my+org&precision=ns", reqs[0].url)
+	assert.Equal(t, "Token secret", reqs[0].header.Get("Authorization"))
+	assert.Equal(t, "/write?db=opentelemetry&precision=ns", reqs[1].url)
+	assert.Equal(t, "Basic dXNlcjpwYXNz", reqs[1].header.Get("Authorization"))
+}
+
+func TestBatchSize(t *testing.T) {
+	srv := newMockServer()
+	defer srv.Close()
+
+	exp, err := influxdb.NewExporter(influxdb.WithEndpoint(srv.URL), influxdb.WithBatchSize(2))
+	require.NoError(t, err)
+	require.NoError(t, exp.Export(context.Background(), sumCheckpointSet(t, "a", "b", "c", "d", "e")))
+
+	reqs := srv.Requests()
+	require.Len(t, reqs, 3)
+	var lines int
+	for _, r := range reqs {
+		lines += strings.Count(r.body, "\n")
+	}
+	assert.Equal(t, 5, lines)
+}
+
+func TestWriteError(t *testing.T) {
+	srv := newMockServer()
+	defer srv.Close()
+	srv.status = http.StatusUnauthorized
+
+	exp, err := influxdb.NewExporter(influxdb.WithEndpoint(srv.URL))
+	require.NoError(t, err)
+	err = exp.Export(context.Background(), sumCheckpointSet(t, "requests"))
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "401")
+	assert.Contains(t, err.Error(), "nope")
+}
+
+func TestEmptyExport(t *testing.T) {
+	srv := newMockServer()
+	defer srv.Close()
+
+	exp, err := influxdb.NewExporter(influxdb.WithEndpoint(srv.URL))
+	require.NoError(t, err)
+	require.NoError(t, exp.Export(context.Background(), metrictest.NewCheckpointSet(testResource)))
+	assert.Empty(t, srv.Requests())
+}
@@ -0,0 +1,121 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package statsd // import "go.opentelemetry.io/otel/exporters/metric/statsd"
+
+import "io"
+
+// Dialect selects the flavor of the line protocol the exporter emits.
+type Dialect int
+
+const (
+	// StatsD is the original Etsy StatsD line protocol. It does
+	// not support tags.
+	StatsD Dialect = iota
+	// DogStatsD is the Datadog extension of the StatsD line
+	// protocol that supports tags.
+	DogStatsD
+)
+
+const (
+	// DefaultEndpoint is the address of the statsd agent the
+	// exporter sends data to if none is configured.
+	DefaultEndpoint = "udp://localhost:8125"
+	// DefaultMaxPacketSize is the maximum number of bytes written
+	// to the agent at once if no other size is configured. It
+	// keeps UDP datagrams within the payload size of a typical
+	// Ethernet MTU.
+	DefaultMaxPacketSize = 1432
+)
+
+type config struct {
+	endpoint      string
+	writer        io.Writer
+	dialect       Dialect
+	prefix        string
+	maxPacketSize int
+}
+
+// Option applies an option to the statsd exporter.
+type Option interface {
+	Apply(*config)
+}
+
+type endpointOption string
+
+func (o endpointOption) Apply(cfg *config) {
+	cfg.endpoint = (string)(o)
+}
+
+// WithEndpoint sets the URL of the statsd agent. Supported schemes
+// are "udp" (e.g. "udp://localhost:8125"), "unixgram" for Unix
+// domain datagram sockets and "unix" for Unix domain stream sockets
+// (e.g. "unixgram:///var/run/datadog/dsd.socket"). If unset,
+// DefaultEndpoint will be used.
+func WithEndpoint(endpoint string) Option {
+	return (endpointOption)(endpoint)
+}
+
+type writerOption struct {
+	w io.Writer
+}
+
+func (o writerOption) Apply(cfg *config) {
+	cfg.writer = o.w
+}
+
+// WithWriter tells the exporter to write packets to w instead of
+// connecting to an endpoint. Every call to w.Write receives a single
+// packet.
+func WithWriter(w io.Writer) Option {
+	return writerOption{w: w}
+}
+
+type dialectOption Dialect
+
+func (o dialectOption) Apply(cfg *config) {
+	cfg.dialect = (Dialect)(o)
+}
+
+// WithDialect selects the line protocol dialect. If unset, StatsD
+// will be used.
+func WithDialect(dialect Dialect) Option {
+	return (dialectOption)(dialect)
+}
+
+type prefixOption string
+
+func (o prefixOption) Apply(cfg *config) {
+	cfg.prefix = (string)(o)
+}
+
+// WithPrefix sets a prefix that is prepended, followed by a dot, to
+// every exported metric name.
+func WithPrefix(prefix string) Option {
+	return (prefixOption)(prefix)
+}
+
+type maxPacketSizeOption int
+
+func (o maxPacketSizeOption) Apply(cfg *config) {
+	cfg.maxPacketSize = (int)(o)
+}
+
+// WithMaxPacketSize sets the size of the buffer lines are collected
+// in before they are flushed to the agent. A single line longer than
+// the buffer is still sent, in a packet on its own. If unset or not
+// positive, DefaultMaxPacketSize will be used.
+func WithMaxPacketSize(size int) Option {
+	return (maxPacketSizeOption)(size)
+}
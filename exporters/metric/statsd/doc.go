@@ -0,0 +1,37 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package statsd provides a push exporter that writes metric data in
+// the StatsD or DogStatsD line protocol, by default to a UDP or Unix
+// domain socket of a statsd agent.
+//
+// Sums of monotonic instruments are exported as statsd counters
+// carrying the delta since the last export, while sums of
+// non-monotonic instruments and last values are exported as gauges.
+// Exact aggregations are exported as one histogram (DogStatsD) or
+// timing (StatsD) sample per recorded point. Histogram and
+// MinMaxSumCount aggregations, which cannot be split into individual
+// samples, are exported as a ".count" and a ".sum" counter, with
+// MinMaxSumCount aggregations additionally reporting ".min" and
+// ".max" gauges.
+//
+// Labels and resource attributes are exported as tags when using the
+// DogStatsD dialect. The plain StatsD dialect has no notion of tags,
+// so they are dropped.
+//
+// This package is currently in a pre-GA phase. Backwards incompatible
+// changes may be introduced in subsequent minor version releases as we
+// work to track the evolving OpenTelemetry specification and user
+// feedback.
+package statsd // import "go.opentelemetry.io/otel/exporters/metric/statsd"
@@ -0,0 +1,14 @@
+module go.opentelemetry.io/otel/exporters/metric/statsd
+
+go 1.14
+
+replace (
+	go.opentelemetry.io/otel => ../../..
+	go.opentelemetry.io/otel/sdk => ../../../sdk
+)
+
+require (
+	github.com/stretchr/testify v1.7.0
+	go.opentelemetry.io/otel v0.16.0
+	go.opentelemetry.io/otel/sdk v0.16.0
+)
@@ -0,0 +1,368 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package statsd // import "go.opentelemetry.io/otel/exporters/metric/statsd"
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/label"
+	"go.opentelemetry.io/otel/metric"
+	"go.opentelemetry.io/otel/metric/number"
+	export "go.opentelemetry.io/otel/sdk/export/metric"
+	"go.opentelemetry.io/otel/sdk/export/metric/aggregation"
+	controller "go.opentelemetry.io/otel/sdk/metric/controller/basic"
+	processor "go.opentelemetry.io/otel/sdk/metric/processor/basic"
+	"go.opentelemetry.io/otel/sdk/metric/selector/simple"
+)
+
+// Exporter writes metric data in the StatsD or DogStatsD line
+// protocol.
+type Exporter struct {
+	cfg    config
+	writer io.Writer
+	closer io.Closer
+
+	encoder *tagEncoder
+
+	// buf holds the lines of the packet being built. err is the
+	// first error writing a packet during an export.
+	buf bytes.Buffer
+	err error
+}
+
+var _ export.Exporter = &Exporter{}
+
+// ErrInvalidScheme is returned when the endpoint URL uses an
+// unsupported scheme.
+var ErrInvalidScheme = fmt.Errorf("invalid statsd endpoint scheme")
+
+// NewExporter returns a new statsd exporter. Unless a writer is
+// configured with WithWriter, it connects to the configured endpoint.
+func NewExporter(opts ...Option) (*Exporter, error) {
+	cfg := config{
+		endpoint:      DefaultEndpoint,
+		dialect:       StatsD,
+		maxPacketSize: DefaultMaxPacketSize,
+	}
+	for _, opt := range opts {
+		opt.Apply(&cfg)
+	}
+	if cfg.maxPacketSize <= 0 {
+		cfg.maxPacketSize = DefaultMaxPacketSize
+	}
+
+	e := &Exporter{
+		cfg:     cfg,
+		writer:  cfg.writer,
+		encoder: newTagEncoder(),
+	}
+	if e.writer == nil {
+		conn, err := dial(cfg.endpoint)
+		if err != nil {
+			return nil, err
+		}
+		e.writer = conn
+		e.closer = conn
+	}
+	e.buf.Grow(cfg.maxPacketSize)
+	return e, nil
+}
+
+func dial(endpoint string) (net.Conn, error) {
+	u, err := url.Parse(endpoint)
+	if err != nil {
+		return nil, fmt.Errorf("statsd: invalid endpoint %q: %w", endpoint, err)
+	}
+	switch u.Scheme {
+	case "udp", "udp4", "udp6":
+		return net.Dial(u.Scheme, u.Host)
+	case "unix", "unixgram":
+		return net.Dial(u.Scheme, u.Path)
+	default:
+		return nil, fmt.Errorf("%w: %q", ErrInvalidScheme, u.Scheme)
+	}
+}
+
+// NewExportPipeline sets up a complete export pipeline with a
+// selector using exact aggregation for ValueRecorder instruments, so
+// that each recorded value is passed to the statsd agent as a
+// sample.
+func NewExportPipeline(opts []Option, pushOpts ...controller.Option) (*controller.Controller, error) {
+	exporter, err := NewExporter(opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	pusher := controller.New(
+		processor.New(
+			simple.NewWithExactDistribution(),
+			exporter,
+		),
+		append(pushOpts, controller.WithPusher(exporter))...,
+	)
+	if err := pusher.Start(context.Background()); err != nil {
+		return nil, err
+	}
+	return pusher, nil
+}
+
+// InstallNewPipeline instantiates a NewExportPipeline and registers
+// it globally.
+func InstallNewPipeline(opts []Option, pushOpts ...controller.Option) (*controller.Controller, error) {
+	pusher, err := NewExportPipeline(opts, pushOpts...)
+	if err != nil {
+		return nil, err
+	}
+	otel.SetMeterProvider(pusher.MeterProvider())
+	return pusher, nil
+}
+
+// ExportKindFor implements export.ExportKindSelector. Statsd counters
+// are increments, so monotonic sums are exported as deltas, while
+// non-monotonic sums are exported as cumulative values to be reported
+// as gauges.
+func (e *Exporter) ExportKindFor(desc *metric.Descriptor, _ aggregation.Kind) export.ExportKind {
+	ikind := desc.InstrumentKind()
+	if ikind.Adding() && !ikind.Monotonic() {
+		return export.CumulativeExportKind
+	}
+	return export.DeltaExportKind
+}
+
+// Export implements export.Exporter.
+func (e *Exporter) Export(_ context.Context, checkpointSet export.CheckpointSet) error {
+	e.buf.Reset()
+	e.err = nil
+	if err := checkpointSet.ForEach(e, func(rec export.Record) error {
+		if err := e.appendRecord(rec); err != nil {
+			return err
+		}
+		return e.err
+	}); err != nil {
+		return err
+	}
+	e.flush()
+	return e.err
+}
+
+// Close closes the connection to the statsd agent, if the exporter
+// opened one.
+func (e *Exporter) Close() error {
+	if e.closer == nil {
+		return nil
+	}
+	return e.closer.Close()
+}
+
+// flush sends the buffered lines as a single packet.
+func (e *Exporter) flush() {
+	if e.buf.Len() == 0 || e.err != nil {
+		return
+	}
+	// Strip the newline terminating the last line.
+	_, e.err = e.writer.Write(e.buf.Bytes()[:e.buf.Len()-1])
+	e.buf.Reset()
+}
+
+func (e *Exporter) appendRecord(rec export.Record) error {
+	desc := rec.Descriptor()
+	kind := desc.NumberKind()
+	name := e.metricName(desc.Name())
+	tags := e.tags(rec)
+
+	switch agg := rec.Aggregation().(type) {
+	case aggregation.Points:
+		points, err := agg.Points()
+		if err != nil {
+			return err
+		}
+		for _, p := range points {
+			e.appendLine(name, "", formatNumber(p.Number, kind), e.sampleType(), tags)
+		}
+	case aggregation.Histogram:
+		count, err := agg.Count()
+		if err != nil {
+			return err
+		}
+		sum, err := agg.Sum()
+		if err != nil {
+			return err
+		}
+		e.appendLine(name, ".count", strconv.FormatUint(count, 10), "c", tags)
+		e.appendLine(name, ".sum", formatNumber(sum, kind), "c", tags)
+	case aggregation.MinMaxSumCount:
+		count, err := agg.Count()
+		if err != nil {
+			return err
+		}
+		sum, err := agg.Sum()
+		if err != nil {
+			return err
+		}
+		min, err := agg.Min()
+		if err != nil {
+			return err
+		}
+		max, err := agg.Max()
+		if err != nil {
+			return err
+		}
+		e.appendLine(name, ".count", strconv.FormatUint(count, 10), "c", tags)
+		e.appendLine(name, ".sum", formatNumber(sum, kind), "c", tags)
+		e.appendGauge(name, ".min", min, kind, tags)
+		e.appendGauge(name, ".max", max, kind, tags)
+	case aggregation.LastValue:
+		value, _, err := agg.LastValue()
+		if err != nil {
+			return err
+		}
+		e.appendGauge(name, "", value, kind, tags)
+	case aggregation.Sum:
+		sum, err := agg.Sum()
+		if err != nil {
+			return err
+		}
+		if e.ExportKindFor(desc, agg.Kind()) == export.CumulativeExportKind {
+			e.appendGauge(name, "", sum, kind, tags)
+		} else {
+			e.appendLine(name, "", formatNumber(sum, kind), "c", tags)
+		}
+	default:
+		return fmt.Errorf("statsd: unsupported aggregation %q for %s", agg.Kind(), desc.Name())
+	}
+	return nil
+}
+
+// appendGauge appends a gauge line. In the StatsD dialect a signed
+// gauge value is interpreted as a change to the current value, so a
+// negative value is preceded by a reset to zero.
+func (e *Exporter) appendGauge(name, suffix string, value number.Number, kind number.Kind, tags string) {
+	if e.cfg.dialect == StatsD && value.IsNegative(kind) {
+		e.appendLine(name, suffix, "0", "g", tags)
+	}
+	e.appendLine(name, suffix, formatNumber(value, kind), "g", tags)
+}
+
+func (e *Exporter) appendLine(name, suffix, value, mtype, tags string) {
+	n := len(name) + len(suffix) + len(value) + len(mtype) + 3
+	if tags != "" {
+		n += len(tags) + 2
+	}
+	if e.buf.Len() > 0 && e.buf.Len()+n > e.cfg.maxPacketSize {
+		e.flush()
+	}
+	e.buf.WriteString(name)
+	e.buf.WriteString(suffix)
+	e.buf.WriteByte(':')
+	e.buf.WriteString(value)
+	e.buf.WriteByte('|')
+	e.buf.WriteString(mtype)
+	if tags != "" {
+		e.buf.WriteString("|#")
+		e.buf.WriteString(tags)
+	}
+	e.buf.WriteByte('\n')
+}
+
+func (e *Exporter) sampleType() string {
+	if e.cfg.dialect == DogStatsD {
+		return "h"
+	}
+	return "ms"
+}
+
+func (e *Exporter) metricName(name string) string {
+	if e.cfg.prefix != "" {
+		name = e.cfg.prefix + "." + name
+	}
+	return sanitizeName(name)
+}
+
+// tags returns the encoded resource attributes and labels of the
+// record, or an empty string if the dialect does not support tags.
+func (e *Exporter) tags(rec export.Record) string {
+	if e.cfg.dialect != DogStatsD {
+		return ""
+	}
+	res := rec.Resource().Encoded(e.encoder)
+	labels := rec.Labels().Encoded(e.encoder)
+	switch {
+	case res == "":
+		return labels
+	case labels == "":
+		return res
+	}
+	return res + "," + labels
+}
+
+func formatNumber(n number.Number, kind number.Kind) string {
+	if kind == number.Float64Kind {
+		return strconv.FormatFloat(n.AsFloat64(), 'g', -1, 64)
+	}
+	return strconv.FormatInt(n.AsInt64(), 10)
+}
+
+// sanitizeName replaces the characters that delimit the parts of a
+// statsd line.
+func sanitizeName(name string) string {
+	return nameReplacer.Replace(name)
+}
+
+var (
+	nameReplacer = strings.NewReplacer(":", "_", "|", "_", "@", "_", "\n", "_", "#", "_", ",", "_")
+	tagReplacer  = strings.NewReplacer("|", "_", ",", "_", "\n", "_", "#", "_")
+)
+
+// tagEncoder encodes label sets as DogStatsD tags, a comma separated
+// list of key:value pairs.
+type tagEncoder struct {
+	id label.EncoderID
+}
+
+var _ label.Encoder = (*tagEncoder)(nil)
+
+func newTagEncoder() *tagEncoder {
+	return &tagEncoder{id: tagEncoderID}
+}
+
+var tagEncoderID = label.NewEncoderID()
+
+// Encode implements label.Encoder.
+func (*tagEncoder) Encode(iter label.Iterator) string {
+	var sb strings.Builder
+	for iter.Next() {
+		i, kv := iter.IndexedLabel()
+		if i > 0 {
+			sb.WriteByte(',')
+		}
+		sb.WriteString(tagReplacer.Replace(string(kv.Key)))
+		sb.WriteByte(':')
+		sb.WriteString(tagReplacer.Replace(kv.Value.Emit()))
+	}
+	return sb.String()
+}
+
+// ID implements label.Encoder.
+func (e *tagEncoder) ID() label.EncoderID {
+	return e.id
+}
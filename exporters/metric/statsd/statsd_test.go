@@ -0,0 +1,195 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package statsd_test
+
+import (
+	"context"
+	"net"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.opentelemetry.io/otel/exporters/metric/statsd"
+	"go.opentelemetry.io/otel/label"
+	"go.opentelemetry.io/otel/metric"
+	"go.opentelemetry.io/otel/metric/number"
+	export "go.opentelemetry.io/otel/sdk/export/metric"
+	"go.opentelemetry.io/otel/sdk/export/metric/metrictest"
+	"go.opentelemetry.io/otel/sdk/metric/aggregator/aggregatortest"
+	"go.opentelemetry.io/otel/sdk/metric/aggregator/exact"
+	"go.opentelemetry.io/otel/sdk/metric/aggregator/histogram"
+	"go.opentelemetry.io/otel/sdk/metric/aggregator/lastvalue"
+	"go.opentelemetry.io/otel/sdk/metric/aggregator/minmaxsumcount"
+	"go.opentelemetry.io/otel/sdk/metric/aggregator/sum"
+	"go.opentelemetry.io/otel/sdk/resource"
+)
+
+type packetWriter struct {
+	packets []string
+}
+
+func (w *packetWriter) Write(p []byte) (int, error) {
+	w.packets = append(w.packets, string(p))
+	return len(p), nil
+}
+
+var testResource = resource.NewWithAttributes(label.String("R", "V"))
+
+func export1(t *testing.T, cps export.CheckpointSet, opts ...statsd.Option) []string {
+	w := &packetWriter{}
+	exp, err := statsd.NewExporter(append(opts, statsd.WithWriter(w))...)
+	require.NoError(t, err)
+	require.NoError(t, exp.Export(context.Background(), cps))
+	require.NoError(t, exp.Close())
+	return w.packets
+}
+
+func TestSum(t *testing.T) {
+	for _, test := range []struct {
+		name  string
+		ikind metric.InstrumentKind
+		want  string
+	}{
+		{"counter", metric.CounterInstrumentKind, "test.sum:5|c|#R:V,A:B"},
+		{"updowncounter", metric.UpDownCounterInstrumentKind, "test.sum:5|g|#R:V,A:B"},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			cps := metrictest.NewCheckpointSet(testResource)
+			desc := metric.NewDescriptor("test.sum", test.ikind, number.Int64Kind)
+			agg, ckpt := metrictest.Unslice2(sum.New(2))
+			aggregatortest.CheckedUpdate(t, agg, number.NewInt64Number(5), &desc)
+			require.NoError(t, agg.SynchronizedMove(ckpt, &desc))
+			cps.Add(&desc, ckpt, label.String("A", "B"))
+
+			assert.Equal(t, []string{test.want}, export1(t, cps, statsd.WithDialect(statsd.DogStatsD)))
+		})
+	}
+}
+
+func TestNegativeGauge(t *testing.T) {
+	cps := metrictest.NewCheckpointSet(testResource)
+	desc := metric.NewDescriptor("test.gauge", metric.ValueObserverInstrumentKind, number.Float64Kind)
+	agg, ckpt := metrictest.Unslice2(lastvalue.New(2))
+	aggregatortest.CheckedUpdate(t, agg, number.NewFloat64Number(-1.5), &desc)
+	require.NoError(t, agg.SynchronizedMove(ckpt, &desc))
+	cps.Add(&desc, ckpt, label.String("A", "B"))
+
+	assert.Equal(t, []string{"test.gauge:0|g\ntest.gauge:-1.5|g"}, export1(t, cps))
+	assert.Equal(t, []string{"test.gauge:-1.5|g|#R:V,A:B"}, export1(t, cps, statsd.WithDialect(statsd.DogStatsD)))
+}
+
+func TestExactPoints(t *testing.T) {
+	cps := metrictest.NewCheckpointSet(testResource)
+	desc := metric.NewDescriptor("latency", metric.ValueRecorderInstrumentKind, number.Int64Kind)
+	agg, ckpt := metrictest.Unslice2(exact.New(2))
+	aggregatortest.CheckedUpdate(t, agg, number.NewInt64Number(3), &desc)
+	aggregatortest.CheckedUpdate(t, agg, number.NewInt64Number(7), &desc)
+	require.NoError(t, agg.SynchronizedMove(ckpt, &desc))
+	cps.Add(&desc, ckpt)
+
+	assert.Equal(t, []string{"app.latency:3|ms\napp.latency:7|ms"}, export1(t, cps, statsd.WithPrefix("app")))
+	assert.Equal(t, []string{"latency:3|h|#R:V\nlatency:7|h|#R:V"}, export1(t, cps, statsd.WithDialect(statsd.DogStatsD)))
+}
+
+func TestDistributions(t *testing.T) {
+	cps := metrictest.NewCheckpointSet(resource.Empty())
+	desc := metric.NewDescriptor("dist", metric.ValueRecorderInstrumentKind, number.Float64Kind)
+
+	mmsc, mmscCkpt := metrictest.Unslice2(minmaxsumcount.New(2, &desc))
+	hist, histCkpt := metrictest.Unslice2(histogram.New(2, &desc, []float64{1, 10}))
+	for _, v := range []float64{0.5, 4} {
+		aggregatortest.CheckedUpdate(t, mmsc, number.NewFloat64Number(v), &desc)
+		aggregatortest.CheckedUpdate(t, hist, number.NewFloat64Number(v), &desc)
+	}
+	require.NoError(t, mmsc.SynchronizedMove(mmscCkpt, &desc))
+	require.NoError(t, hist.SynchronizedMove(histCkpt, &desc))
+	cps.Add(&desc, mmscCkpt, label.String("agg", "mmsc"))
+	cps.Add(&desc, histCkpt, label.String("agg", "hist"))
+
+	packets := export1(t, cps, statsd.WithDialect(statsd.DogStatsD))
+	require.Len(t, packets, 1)
+	assert.ElementsMatch(t, []string{
+		"dist.count:2|c|#agg:mmsc",
+		"dist.sum:4.5|c|#agg:mmsc",
+		"dist.min:0.5|g|#agg:mmsc",
+		"dist.max:4|g|#agg:mmsc",
+		"dist.count:2|c|#agg:hist",
+		"dist.sum:4.5|c|#agg:hist",
+	}, strings.Split(packets[0], "\n"))
+}
+
+func TestMaxPacketSize(t *testing.T) {
+	cps := metrictest.NewCheckpointSet(resource.Empty())
+	desc := metric.NewDescriptor("counter", metric.CounterInstrumentKind, number.Int64Kind)
+	for _, v := range []string{"a", "b", "c"} {
+		agg, ckpt := metrictest.Unslice2(sum.New(2))
+		aggregatortest.CheckedUpdate(t, agg, number.NewInt64Number(1), &desc)
+		require.NoError(t, agg.SynchronizedMove(ckpt, &desc))
+		cps.Add(&desc, ckpt, label.String("k", v))
+	}
+
+	// Each line is 17 bytes long with its newline, so two of them
+	// fit into a packet.
+	packets := export1(t, cps, statsd.WithDialect(statsd.DogStatsD), statsd.WithMaxPacketSize(40))
+	require.Len(t, packets, 2)
+	assert.Len(t, strings.Split(packets[0], "\n"), 2)
+	assert.Len(t, strings.Split(packets[1], "\n"), 1)
+	for _, p := range packets {
+		assert.LessOrEqual(t, len(p), 40)
+	}
+}
+
+func TestSanitize(t *testing.T) {
+	cps := metrictest.NewCheckpointSet(resource.Empty())
+	desc := metric.NewDescriptor("a:b|c", metric.CounterInstrumentKind, number.Int64Kind)
+	agg, ckpt := metrictest.Unslice2(sum.New(2))
+	aggregatortest.CheckedUpdate(t, agg, number.NewInt64Number(1), &desc)
+	require.NoError(t, agg.SynchronizedMove(ckpt, &desc))
+	cps.Add(&desc, ckpt, label.String("k", "x,y|z"))
+
+	assert.Equal(t, []string{"a_b_c:1|c|#k:x_y_z"}, export1(t, cps, statsd.WithDialect(statsd.DogStatsD)))
+}
+
+func TestUDPEndpoint(t *testing.T) {
+	conn, err := net.ListenPacket("udp", "127.0.0.1:0")
+	require.NoError(t, err)
+	defer conn.Close()
+
+	exp, err := statsd.NewExporter(statsd.WithEndpoint("udp://" + conn.LocalAddr().String()))
+	require.NoError(t, err)
+	defer func() { assert.NoError(t, exp.Close()) }()
+
+	cps := metrictest.NewCheckpointSet(resource.Empty())
+	desc := metric.NewDescriptor("counter", metric.CounterInstrumentKind, number.Int64Kind)
+	agg, ckpt := metrictest.Unslice2(sum.New(2))
+	aggregatortest.CheckedUpdate(t, agg, number.NewInt64Number(1), &desc)
+	require.NoError(t, agg.SynchronizedMove(ckpt, &desc))
+	cps.Add(&desc, ckpt)
+	require.NoError(t, exp.Export(context.Background(), cps))
+
+	buf := make([]byte, statsd.DefaultMaxPacketSize)
+	require.NoError(t, conn.SetReadDeadline(time.Now().Add(5*time.Second)))
+	n, _, err := conn.ReadFrom(buf)
+	require.NoError(t, err)
+	assert.Equal(t, "counter:1|c", string(buf[:n]))
+}
+
+func TestInvalidEndpoint(t *testing.T) {
+	_, err := statsd.NewExporter(statsd.WithEndpoint("http://localhost:8125"))
+	assert.ErrorIs(t, err, statsd.ErrInvalidScheme)
+}
@@ -0,0 +1,68 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package otlp // import "go.opentelemetry.io/otel/exporters/otlp"
+
+import (
+	"context"
+	"time"
+)
+
+// ConnectionHooks are functions a ProtocolDriver calls when the state
+// of its connection to the collector changes. Any of them may be nil.
+// The hooks are called synchronously from the driver, so they should
+// return quickly.
+type ConnectionHooks struct {
+	// OnConnected is called when the driver established a
+	// connection to the collector.
+	OnConnected func()
+	// OnDisconnected is called with the cause when the driver lost
+	// its connection to the collector or failed to establish one.
+	OnDisconnected func(err error)
+	// OnRetryScheduled is called when the driver scheduled another
+	// attempt to reach the collector after the passed delay.
+	OnRetryScheduled func(delay time.Duration)
+}
+
+// Connected calls the OnConnected hook if it is set.
+func (h ConnectionHooks) Connected() {
+	if h.OnConnected != nil {
+		h.OnConnected()
+	}
+}
+
+// Disconnected calls the OnDisconnected hook if it is set.
+func (h ConnectionHooks) Disconnected(err error) {
+	if h.OnDisconnected != nil {
+		h.OnDisconnected(err)
+	}
+}
+
+// RetryScheduled calls the OnRetryScheduled hook if it is set.
+func (h ConnectionHooks) RetryScheduled(delay time.Duration) {
+	if h.OnRetryScheduled != nil {
+		h.OnRetryScheduled(delay)
+	}
+}
+
+// ReadinessChecker is an optional interface of a ProtocolDriver that
+// is able to tell whether it can currently send telemetry to the
+// collector.
+type ReadinessChecker interface {
+	// Ready returns nil if the driver is connected to the
+	// collector, otherwise an error describing why it is not. It
+	// may block until the passed context is done while the
+	// connection is being established.
+	Ready(ctx context.Context) error
+}
@@ -0,0 +1,133 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package otlp // import "go.opentelemetry.io/otel/exporters/otlp"
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+)
+
+// CredentialsProvider supplies the credentials attached to every
+// export request sent by a protocol driver. The gRPC driver sends
+// them as per-RPC metadata and the HTTP driver as request headers.
+type CredentialsProvider interface {
+	// Credentials returns the metadata or headers carrying the
+	// credentials, e.g. an "authorization" entry. It is called
+	// for every request, so implementations are expected to
+	// cache the credentials and refresh them when they expire.
+	// It may be called concurrently.
+	Credentials(ctx context.Context) (map[string]string, error)
+	// RequireTransportSecurity reports whether the credentials
+	// may only be sent over a secure connection.
+	RequireTransportSecurity() bool
+}
+
+// Token is an access token obtained from a TokenSource.
+type Token struct {
+	// Value is the encoded token.
+	Value string
+	// Type is the authorization scheme of the token. If empty,
+	// "Bearer" is used.
+	Type string
+	// Expiry is the time the token expires at. A zero Expiry
+	// means the token never expires.
+	Expiry time.Time
+}
+
+// TokenSource fetches access tokens, e.g. through an OAuth2 client
+// credentials flow.
+type TokenSource interface {
+	// Token returns a new token.
+	Token(ctx context.Context) (Token, error)
+}
+
+// TokenSourceFunc is an adapter to allow the use of ordinary
+// functions as a TokenSource.
+type TokenSourceFunc func(ctx context.Context) (Token, error)
+
+// Token implements TokenSource.
+func (f TokenSourceFunc) Token(ctx context.Context) (Token, error) {
+	return f(ctx)
+}
+
+// DefaultTokenExpiryDelta is how long before its expiry a token is
+// refreshed by the CredentialsProvider returned by
+// NewTokenCredentialsProvider.
+const DefaultTokenExpiryDelta = 10 * time.Second
+
+type tokenCredentials struct {
+	source TokenSource
+
+	mu    sync.Mutex
+	token Token
+
+	// now is replaced in tests.
+	now func() time.Time
+}
+
+var _ CredentialsProvider = (*tokenCredentials)(nil)
+
+// NewTokenCredentialsProvider returns a CredentialsProvider that
+// sends tokens fetched from the source in the "authorization"
+// metadata or header. A token is cached until it is about to expire
+// and only then is a new one fetched. The returned provider requires
+// transport security.
+func NewTokenCredentialsProvider(source TokenSource) CredentialsProvider {
+	return &tokenCredentials{
+		source: source,
+		now:    time.Now,
+	}
+}
+
+// Credentials implements CredentialsProvider.
+func (c *tokenCredentials) Credentials(ctx context.Context) (map[string]string, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if !c.valid() {
+		token, err := c.source.Token(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to fetch token: %w", err)
+		}
+		c.token = token
+	}
+
+	tokenType := c.token.Type
+	if tokenType == "" {
+		tokenType = "Bearer"
+	}
+	return map[string]string{
+		"authorization": tokenType + " " + c.token.Value,
+	}, nil
+}
+
+// RequireTransportSecurity implements CredentialsProvider.
+func (c *tokenCredentials) RequireTransportSecurity() bool {
+	return true
+}
+
+// valid reports whether the cached token can still be used. It must
+// be called with the lock held.
+func (c *tokenCredentials) valid() bool {
+	if c.token.Value == "" {
+		return false
+	}
+	if c.token.Expiry.IsZero() {
+		return true
+	}
+	return c.now().Add(DefaultTokenExpiryDelta).Before(c.token.Expiry)
+}
@@ -0,0 +1,76 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package otlp
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTokenCredentialsProvider(t *testing.T) {
+	now := time.Date(2021, time.January, 1, 0, 0, 0, 0, time.UTC)
+	var fetched int
+	source := TokenSourceFunc(func(context.Context) (Token, error) {
+		fetched++
+		return Token{
+			Value:  "token",
+			Expiry: now.Add(time.Minute),
+		}, nil
+	})
+	provider := NewTokenCredentialsProvider(source)
+	provider.(*tokenCredentials).now = func() time.Time { return now }
+	ctx := context.Background()
+
+	assert.True(t, provider.RequireTransportSecurity())
+
+	creds, err := provider.Credentials(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{"authorization": "Bearer token"}, creds)
+	assert.Equal(t, 1, fetched)
+
+	// The cached token is used until it is about to expire.
+	now = now.Add(time.Minute - DefaultTokenExpiryDelta - time.Second)
+	_, err = provider.Credentials(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 1, fetched)
+
+	now = now.Add(time.Second)
+	_, err = provider.Credentials(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 2, fetched)
+}
+
+func TestTokenCredentialsProviderTokenType(t *testing.T) {
+	provider := NewTokenCredentialsProvider(TokenSourceFunc(func(context.Context) (Token, error) {
+		return Token{Value: "token", Type: "Basic"}, nil
+	}))
+	creds, err := provider.Credentials(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{"authorization": "Basic token"}, creds)
+}
+
+func TestTokenCredentialsProviderError(t *testing.T) {
+	errFetch := errors.New("unauthorized client")
+	provider := NewTokenCredentialsProvider(TokenSourceFunc(func(context.Context) (Token, error) {
+		return Token{}, errFetch
+	}))
+	_, err := provider.Credentials(context.Background())
+	assert.True(t, errors.Is(err, errFetch))
+}
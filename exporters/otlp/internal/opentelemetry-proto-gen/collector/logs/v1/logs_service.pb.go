@@ -128,7 +128,7 @@ func init() {
 }
 
 var fileDescriptor_8e3bf87aaa43acd4 = []byte{
-	// 290 bytes of a gzipped FileDescriptorProto
+	// 286 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x92, 0x41, 0x4a, 0x03, 0x31,
 	0x14, 0x86, 0x0d, 0x42, 0x17, 0xa9, 0x82, 0xcc, 0xaa, 0x56, 0x18, 0x64, 0x40, 0xa9, 0x8b, 0x26,
 	0xb4, 0x6e, 0xdc, 0x29, 0x05, 0x77, 0x22, 0x65, 0xdc, 0x75, 0x53, 0x74, 0x78, 0x0c, 0x23, 0x31,
@@ -146,8 +146,7 @@ var fileDescriptor_8e3bf87aaa43acd4 = []byte{
 	0xab, 0x98, 0xf1, 0x51, 0x81, 0xdd, 0x4c, 0x67, 0x7b, 0x0d, 0xbf, 0x79, 0xad, 0x99, 0xb3, 0xc5,
 	0x22, 0xff, 0x49, 0x17, 0x28, 0xd1, 0x81, 0x92, 0xe0, 0x2b, 0x00, 0x59, 0x89, 0x4e, 0x19, 0x59,
 	0x68, 0x07, 0xa4, 0x6f, 0x95, 0xdc, 0x50, 0x8f, 0x7d, 0xd6, 0x38, 0x07, 0xfd, 0xfb, 0xcf, 0xdc,
-	0xf5, 0xfc, 0xe3, 0xe9, 0x57, 0x00, 0x00, 0x00, 0xff, 0xff, 0x46, 0x1c, 0xa2, 0x18, 0x63, 0x02,
-	0x00, 0x00,
+	0xf5, 0xfc, 0xe3, 0xe9, 0xd7, 0x00, 0x46, 0x1c, 0xa2, 0x18, 0x63, 0x02, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
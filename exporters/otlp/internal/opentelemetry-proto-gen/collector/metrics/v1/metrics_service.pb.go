@@ -128,7 +128,7 @@ func init() {
 }
 
 var fileDescriptor_75fb6015e6e64798 = []byte{
-	// 289 bytes of a gzipped FileDescriptorProto
+	// 286 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x92, 0xc1, 0x4a, 0x03, 0x31,
 	0x10, 0x86, 0x0d, 0x42, 0x0f, 0x11, 0x54, 0xd6, 0x8b, 0x54, 0x59, 0xa4, 0xa7, 0x82, 0x36, 0x43,
 	0xeb, 0xdd, 0x43, 0xa1, 0xde, 0x84, 0xb2, 0xde, 0x0a, 0x52, 0x74, 0x19, 0xca, 0x42, 0xcc, 0xc4,
@@ -146,8 +146,7 @@ var fileDescriptor_75fb6015e6e64798 = []byte{
 	0x52, 0x21, 0x4f, 0x0b, 0xda, 0x18, 0x3d, 0x3e, 0x88, 0xa9, 0xd3, 0xf5, 0xe6, 0x54, 0xcc, 0x6e,
 	0x16, 0x3f, 0x19, 0x05, 0x01, 0x79, 0xd4, 0x80, 0x75, 0x17, 0x64, 0x07, 0xe4, 0xb5, 0x85, 0xc2,
 	0x78, 0x64, 0x73, 0xab, 0x21, 0xda, 0x1e, 0xd4, 0x89, 0x83, 0x05, 0x9a, 0xd6, 0x9f, 0x76, 0xd7,
-	0xa9, 0xe7, 0xe7, 0x1f, 0x01, 0x00, 0x00, 0xff, 0xff, 0x0b, 0x25, 0x50, 0x26, 0x9c, 0x02, 0x00,
-	0x00,
+	0xa9, 0xe7, 0xe7, 0x1f, 0x03, 0x00, 0x0b, 0x25, 0x50, 0x26, 0x9c, 0x02, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -365,7 +365,7 @@ func init() {
 }
 
 var fileDescriptor_5936aa8fa6443e6f = []byte{
-	// 548 bytes of a gzipped FileDescriptorProto
+	// 545 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x94, 0xdf, 0x6e, 0x12, 0x41,
 	0x14, 0xc6, 0xbb, 0xc5, 0xfe, 0x3b, 0x4d, 0xdb, 0x65, 0xb0, 0x66, 0x63, 0x1a, 0xac, 0x1b, 0x13,
 	0xb9, 0x81, 0x0d, 0xf5, 0xc2, 0xe8, 0x85, 0x09, 0xf4, 0x8f, 0x35, 0x21, 0x94, 0x6c, 0x49, 0x8c,
@@ -399,8 +399,8 @@ var fileDescriptor_5936aa8fa6443e6f = []byte{
 	0x2f, 0xfc, 0x9b, 0x3b, 0x99, 0xd5, 0xb1, 0x7a, 0x9f, 0xc3, 0x65, 0x88, 0x4b, 0x4f, 0x6a, 0x8c,
 	0x3c, 0x1c, 0x27, 0x52, 0x69, 0x54, 0xa9, 0x27, 0x75, 0x94, 0x78, 0x5c, 0x68, 0x54, 0x82, 0x45,
 	0xf9, 0x47, 0xa6, 0x6a, 0x5a, 0x54, 0x43, 0x14, 0x5e, 0x20, 0xa3, 0x08, 0x03, 0x2d, 0xd5, 0xfc,
-	0xc9, 0xe9, 0x6f, 0x1a, 0xf7, 0xd5, 0xdf, 0x00, 0x00, 0x00, 0xff, 0xff, 0x69, 0x02, 0x09, 0x0c,
-	0x99, 0x04, 0x00, 0x00,
+	0xc9, 0xe9, 0x6f, 0x1a, 0xf7, 0xd5, 0xdf, 0x01, 0x00, 0x69, 0x02, 0x09, 0x0c, 0x99, 0x04, 0x00,
+	0x00,
 }
 
 func (m *TraceConfig) Marshal() (dAtA []byte, err error) {
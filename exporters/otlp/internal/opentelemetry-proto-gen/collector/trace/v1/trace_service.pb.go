@@ -128,7 +128,7 @@ func init() {
 }
 
 var fileDescriptor_192a962890318cf4 = []byte{
-	// 290 bytes of a gzipped FileDescriptorProto
+	// 286 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x92, 0xcf, 0x4a, 0xc4, 0x30,
 	0x10, 0xc6, 0x0d, 0xc2, 0x1e, 0xe2, 0x1f, 0xb0, 0x27, 0x2d, 0x52, 0xa4, 0x07, 0x59, 0x91, 0x4d,
 	0xd8, 0xf5, 0xe6, 0xcd, 0x82, 0xf7, 0xa5, 0xeb, 0x49, 0x0f, 0x52, 0xcb, 0xb0, 0x14, 0x62, 0x26,
@@ -146,8 +146,7 @@ var fileDescriptor_192a962890318cf4 = []byte{
 	0x9a, 0x84, 0xf1, 0x93, 0x0a, 0xd7, 0xb4, 0xcd, 0xf6, 0xba, 0x8e, 0xf3, 0x56, 0x35, 0x67, 0xd7,
 	0x37, 0xcb, 0xdf, 0x7c, 0x85, 0x12, 0x1d, 0x28, 0x09, 0xbe, 0x05, 0x90, 0x95, 0xe8, 0x94, 0x91,
 	0x95, 0x76, 0x40, 0xba, 0x50, 0xb2, 0xa7, 0x9e, 0xf8, 0xb4, 0xc9, 0x12, 0xf4, 0xc0, 0x2b, 0xba,
-	0x1b, 0xf9, 0xed, 0xd9, 0x67, 0x00, 0x00, 0x00, 0xff, 0xff, 0xd3, 0xba, 0x8a, 0x6d, 0x76, 0x02,
-	0x00, 0x00,
+	0x1b, 0xf9, 0xed, 0xd9, 0xe7, 0x00, 0xd3, 0xba, 0x8a, 0x6d, 0x76, 0x02, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
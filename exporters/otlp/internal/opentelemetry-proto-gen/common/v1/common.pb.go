@@ -37,6 +37,7 @@ type AnyValue struct {
 	//	*AnyValue_DoubleValue
 	//	*AnyValue_ArrayValue
 	//	*AnyValue_KvlistValue
+	//	*AnyValue_BytesValue
 	Value                isAnyValue_Value `protobuf_oneof:"value"`
 	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
 	XXX_unrecognized     []byte           `json:"-"`
@@ -100,6 +101,9 @@ type AnyValue_ArrayValue struct {
 type AnyValue_KvlistValue struct {
 	KvlistValue *KeyValueList `protobuf:"bytes,6,opt,name=kvlist_value,json=kvlistValue,proto3,oneof" json:"kvlist_value,omitempty"`
 }
+type AnyValue_BytesValue struct {
+	BytesValue []byte `protobuf:"bytes,7,opt,name=bytes_value,json=bytesValue,proto3,oneof" json:"bytes_value,omitempty"`
+}
 
 func (*AnyValue_StringValue) isAnyValue_Value() {}
 func (*AnyValue_BoolValue) isAnyValue_Value()   {}
@@ -107,6 +111,7 @@ func (*AnyValue_IntValue) isAnyValue_Value()    {}
 func (*AnyValue_DoubleValue) isAnyValue_Value() {}
 func (*AnyValue_ArrayValue) isAnyValue_Value()  {}
 func (*AnyValue_KvlistValue) isAnyValue_Value() {}
+func (*AnyValue_BytesValue) isAnyValue_Value()  {}
 
 func (m *AnyValue) GetValue() isAnyValue_Value {
 	if m != nil {
@@ -157,6 +162,13 @@ func (m *AnyValue) GetKvlistValue() *KeyValueList {
 	return nil
 }
 
+func (m *AnyValue) GetBytesValue() []byte {
+	if x, ok := m.GetValue().(*AnyValue_BytesValue); ok {
+		return x.BytesValue
+	}
+	return nil
+}
+
 // XXX_OneofWrappers is for the internal use of the proto package.
 func (*AnyValue) XXX_OneofWrappers() []interface{} {
 	return []interface{}{
@@ -166,6 +178,7 @@ func (*AnyValue) XXX_OneofWrappers() []interface{} {
 		(*AnyValue_DoubleValue)(nil),
 		(*AnyValue_ArrayValue)(nil),
 		(*AnyValue_KvlistValue)(nil),
+		(*AnyValue_BytesValue)(nil),
 	}
 }
 
@@ -332,6 +345,8 @@ func (m *KeyValue) GetValue() *AnyValue {
 
 // StringKeyValue is a pair of key/value strings. This is the simpler (and faster) version
 // of KeyValue that only supports string values.
+//
+// Deprecated: Do not use.
 type StringKeyValue struct {
 	Key                  string   `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
 	Value                string   `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
@@ -390,6 +405,7 @@ func (m *StringKeyValue) GetValue() string {
 // InstrumentationLibrary is a message representing the instrumentation library information
 // such as the fully qualified name and version.
 type InstrumentationLibrary struct {
+	// An empty instrumentation library name means the name is unknown.
 	Name                 string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
 	Version              string   `protobuf:"bytes,2,opt,name=version,proto3" json:"version,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
@@ -458,35 +474,36 @@ func init() {
 }
 
 var fileDescriptor_62ba46dcb97aa817 = []byte{
-	// 443 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x53, 0x4f, 0x8b, 0x13, 0x31,
-	0x14, 0x6f, 0xb6, 0xbb, 0xdd, 0xf6, 0x4d, 0x11, 0x09, 0x22, 0xbd, 0x6c, 0x1d, 0xea, 0xc1, 0x51,
-	0xd9, 0x09, 0xbb, 0x5e, 0xbc, 0x88, 0x6c, 0x05, 0x19, 0xb1, 0x62, 0x89, 0xe0, 0x41, 0x0f, 0x92,
-	0xd1, 0x50, 0xc2, 0x66, 0x92, 0x21, 0x93, 0x0e, 0xce, 0xc7, 0xf0, 0x03, 0x79, 0xf7, 0xe8, 0x47,
-	0x90, 0x7e, 0x12, 0x99, 0x24, 0xd3, 0xfd, 0x73, 0xe8, 0xd2, 0xdb, 0x7b, 0xbf, 0xf9, 0xfd, 0x79,
-	0x6f, 0x92, 0xc0, 0x33, 0x5d, 0x72, 0x65, 0xb9, 0xe4, 0x05, 0xb7, 0xa6, 0x21, 0xa5, 0xd1, 0x56,
-	0x93, 0xef, 0xba, 0x28, 0xb4, 0x22, 0xf5, 0x59, 0xa8, 0x52, 0x07, 0xe3, 0x93, 0x1b, 0x5c, 0x0f,
-	0xa6, 0x81, 0x51, 0x9f, 0xcd, 0x7e, 0x1f, 0xc0, 0xf0, 0x42, 0x35, 0x9f, 0x99, 0x5c, 0x73, 0xfc,
-	0x18, 0xc6, 0x95, 0x35, 0x42, 0xad, 0xbe, 0xd5, 0x6d, 0x3f, 0x41, 0x31, 0x4a, 0x46, 0x59, 0x8f,
-	0x46, 0x1e, 0xf5, 0xa4, 0x47, 0x00, 0xb9, 0xd6, 0x32, 0x50, 0x0e, 0x62, 0x94, 0x0c, 0xb3, 0x1e,
-	0x1d, 0xb5, 0x98, 0x27, 0x9c, 0xc0, 0x48, 0x28, 0x1b, 0xbe, 0xf7, 0x63, 0x94, 0xf4, 0xb3, 0x1e,
-	0x1d, 0x0a, 0x65, 0xb7, 0x21, 0x3f, 0xf4, 0x3a, 0x97, 0x3c, 0x30, 0x0e, 0x63, 0x94, 0xa0, 0x36,
-	0xc4, 0xa3, 0x9e, 0xb4, 0x80, 0x88, 0x19, 0xc3, 0x9a, 0xc0, 0x39, 0x8a, 0x51, 0x12, 0x9d, 0x3f,
-	0x4d, 0x77, 0xee, 0x92, 0x5e, 0xb4, 0x0a, 0xa7, 0xcf, 0x7a, 0x14, 0xd8, 0xb6, 0xc3, 0x4b, 0x18,
-	0x5f, 0xd6, 0x52, 0x54, 0xdd, 0x50, 0x03, 0x67, 0xf7, 0xfc, 0x0e, 0xbb, 0xf7, 0xdc, 0xcb, 0x17,
-	0xa2, 0xb2, 0xed, 0x7c, 0xde, 0xc2, 0x41, 0xf3, 0x63, 0x38, 0x72, 0x56, 0xb3, 0x0f, 0x00, 0x57,
-	0xb1, 0xf8, 0x35, 0x0c, 0x1c, 0x5c, 0x4d, 0x50, 0xdc, 0x4f, 0xa2, 0xf3, 0x27, 0x77, 0x4d, 0x1c,
-	0xfe, 0x3c, 0x0d, 0xb2, 0xd9, 0x47, 0x18, 0x5f, 0x8f, 0xdd, 0xdb, 0xb0, 0x13, 0x6f, 0x0d, 0xbf,
-	0xc2, 0xb0, 0xc3, 0xf0, 0x7d, 0xe8, 0x5f, 0xf2, 0xc6, 0x9f, 0x2a, 0x6d, 0x4b, 0xfc, 0x2a, 0xac,
-	0xe1, 0x8e, 0x71, 0x8f, 0x71, 0xc3, 0xf2, 0x2f, 0xe1, 0xde, 0x27, 0x77, 0x33, 0x76, 0x44, 0x3c,
-	0xb8, 0x1e, 0x31, 0xea, 0x94, 0x6f, 0xe1, 0xe1, 0x3b, 0x55, 0x59, 0xb3, 0x2e, 0xb8, 0xb2, 0xcc,
-	0x0a, 0xad, 0x16, 0x22, 0x37, 0xcc, 0x34, 0x18, 0xc3, 0xa1, 0x62, 0x45, 0xb8, 0x7b, 0xd4, 0xd5,
-	0x78, 0x02, 0xc7, 0x35, 0x37, 0x95, 0xd0, 0x2a, 0xb8, 0x74, 0xed, 0xfc, 0x17, 0xfa, 0xb3, 0x99,
-	0xa2, 0xbf, 0x9b, 0x29, 0xfa, 0xb7, 0x99, 0x22, 0x88, 0x85, 0xde, 0xbd, 0xc2, 0x3c, 0x7a, 0xe3,
-	0xca, 0x65, 0x0b, 0x2f, 0xd1, 0x17, 0xba, 0xba, 0x2d, 0x10, 0x9a, 0x68, 0xcb, 0x25, 0xe1, 0x3f,
-	0x4b, 0x6d, 0x2c, 0x37, 0x15, 0xd1, 0x56, 0x96, 0x44, 0x28, 0xcb, 0x8d, 0x62, 0x92, 0xdc, 0x60,
-	0x9f, 0x3a, 0xfb, 0xd3, 0x15, 0x57, 0x57, 0xcf, 0x2f, 0x1f, 0x38, 0xf0, 0xc5, 0xff, 0x00, 0x00,
-	0x00, 0xff, 0xff, 0x0e, 0x09, 0x81, 0x21, 0xa6, 0x03, 0x00, 0x00,
+	// 459 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x53, 0x5d, 0x6b, 0x13, 0x41,
+	0x14, 0xcd, 0x24, 0xcd, 0xd7, 0xdd, 0x20, 0x32, 0x88, 0xe4, 0xa5, 0x71, 0x8d, 0x0f, 0xae, 0x4a,
+	0xb3, 0xb4, 0xbe, 0x89, 0x22, 0x8d, 0x20, 0x91, 0x46, 0x0c, 0x2b, 0xf8, 0xa0, 0x0f, 0xb2, 0xab,
+	0x97, 0x30, 0x74, 0x76, 0x26, 0xcc, 0x4c, 0x16, 0xf7, 0x67, 0xf8, 0xaf, 0x7c, 0xf4, 0x27, 0x94,
+	0xfc, 0x92, 0x32, 0x1f, 0x69, 0xda, 0x3e, 0xa4, 0xf4, 0xed, 0xde, 0xb3, 0xe7, 0x9c, 0x7b, 0x2e,
+	0x77, 0x16, 0x5e, 0xca, 0x15, 0x0a, 0x83, 0x1c, 0x4b, 0x34, 0xaa, 0x4e, 0x57, 0x4a, 0x1a, 0x99,
+	0xfe, 0x92, 0x65, 0x29, 0x45, 0x5a, 0x1d, 0x87, 0x6a, 0xe2, 0x60, 0x7a, 0x78, 0x83, 0xeb, 0xc1,
+	0x49, 0x60, 0x54, 0xc7, 0xe3, 0x8b, 0x26, 0xf4, 0x4e, 0x45, 0xfd, 0x2d, 0xe7, 0x6b, 0xa4, 0xcf,
+	0x60, 0xa0, 0x8d, 0x62, 0x62, 0xf9, 0xb3, 0xb2, 0xfd, 0x90, 0xc4, 0x24, 0xe9, 0xcf, 0x1a, 0x59,
+	0xe4, 0x51, 0x4f, 0x7a, 0x02, 0x50, 0x48, 0xc9, 0x03, 0xa5, 0x19, 0x93, 0xa4, 0x37, 0x6b, 0x64,
+	0x7d, 0x8b, 0x79, 0xc2, 0x21, 0xf4, 0x99, 0x30, 0xe1, 0x7b, 0x2b, 0x26, 0x49, 0x6b, 0xd6, 0xc8,
+	0x7a, 0x4c, 0x98, 0xab, 0x21, 0xbf, 0xe5, 0xba, 0xe0, 0x18, 0x18, 0x07, 0x31, 0x49, 0x88, 0x1d,
+	0xe2, 0x51, 0x4f, 0x9a, 0x43, 0x94, 0x2b, 0x95, 0xd7, 0x81, 0xd3, 0x8e, 0x49, 0x12, 0x9d, 0xbc,
+	0x98, 0xec, 0xdd, 0x65, 0x72, 0x6a, 0x15, 0x4e, 0x3f, 0x6b, 0x64, 0x90, 0x5f, 0x75, 0x74, 0x01,
+	0x83, 0xf3, 0x8a, 0x33, 0xbd, 0x0d, 0xd5, 0x71, 0x76, 0xaf, 0xee, 0xb0, 0x3b, 0x43, 0x2f, 0x9f,
+	0x33, 0x6d, 0x6c, 0x3e, 0x6f, 0xe1, 0x1d, 0x9f, 0x42, 0x54, 0xd4, 0x06, 0x75, 0x30, 0xec, 0xc6,
+	0x24, 0x19, 0xd8, 0xa1, 0x0e, 0x74, 0x94, 0x69, 0x17, 0xda, 0xee, 0xe3, 0xf8, 0x33, 0xc0, 0x2e,
+	0x19, 0x7d, 0x0f, 0x1d, 0x07, 0xeb, 0x21, 0x89, 0x5b, 0x49, 0x74, 0xf2, 0xfc, 0xae, 0xa5, 0xc2,
+	0x71, 0xb2, 0x20, 0x1b, 0x7f, 0x81, 0xc1, 0xf5, 0x64, 0xf7, 0x36, 0x3c, 0xc3, 0x5b, 0x86, 0x3f,
+	0xa0, 0xb7, 0xc5, 0xe8, 0x43, 0x68, 0x9d, 0x63, 0xed, 0x0f, 0x9f, 0xd9, 0x92, 0xbe, 0x83, 0xf6,
+	0xee, 0xd2, 0xf7, 0x88, 0x1b, 0x96, 0x7f, 0x0b, 0x0f, 0xbe, 0xba, 0xc7, 0xb3, 0x67, 0xc4, 0xa3,
+	0xeb, 0x23, 0xfa, 0x41, 0xf9, 0xa6, 0x39, 0x24, 0xe3, 0x8f, 0xf0, 0xf8, 0x93, 0xd0, 0x46, 0xad,
+	0x4b, 0x14, 0x26, 0x37, 0x4c, 0x8a, 0x39, 0x2b, 0x54, 0xae, 0x6a, 0x4a, 0xe1, 0x40, 0xe4, 0x65,
+	0x78, 0xa2, 0x99, 0xab, 0xe9, 0x10, 0xba, 0x15, 0x2a, 0xcd, 0xa4, 0x08, 0x4e, 0xdb, 0x76, 0xfa,
+	0x97, 0xfc, 0xdb, 0x8c, 0xc8, 0xff, 0xcd, 0x88, 0x5c, 0x6c, 0x46, 0x04, 0x62, 0x26, 0xf7, 0xaf,
+	0x31, 0x8d, 0x3e, 0xb8, 0x72, 0x61, 0xe1, 0x05, 0xf9, 0x9e, 0x2d, 0x6f, 0x0b, 0x98, 0x4c, 0xa5,
+	0x41, 0x9e, 0xe2, 0x9f, 0x95, 0x54, 0x06, 0x95, 0x4e, 0xa5, 0xe1, 0xab, 0x94, 0x09, 0x83, 0x4a,
+	0xe4, 0x3c, 0xbd, 0xc1, 0x3e, 0x72, 0xf6, 0x47, 0x4b, 0x14, 0xbb, 0xbf, 0xb4, 0xe8, 0x38, 0xf0,
+	0xf5, 0xe5, 0x00, 0x02, 0x03, 0x78, 0x04, 0xcd, 0x03, 0x00, 0x00,
 }
 
 func (m *AnyValue) Marshal() (dAtA []byte, err error) {
@@ -623,6 +640,22 @@ func (m *AnyValue_KvlistValue) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	}
 	return len(dAtA) - i, nil
 }
+func (m *AnyValue_BytesValue) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AnyValue_BytesValue) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.BytesValue != nil {
+		i -= len(m.BytesValue)
+		copy(dAtA[i:], m.BytesValue)
+		i = encodeVarintCommon(dAtA, i, uint64(len(m.BytesValue)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	return len(dAtA) - i, nil
+}
 func (m *ArrayValue) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -920,6 +953,18 @@ func (m *AnyValue_KvlistValue) Size() (n int) {
 	}
 	return n
 }
+func (m *AnyValue_BytesValue) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BytesValue != nil {
+		l = len(m.BytesValue)
+		n += 1 + l + sovCommon(uint64(l))
+	}
+	return n
+}
 func (m *ArrayValue) Size() (n int) {
 	if m == nil {
 		return 0
@@ -1205,6 +1250,39 @@ func (m *AnyValue) Unmarshal(dAtA []byte) error {
 			}
 			m.Value = &AnyValue_KvlistValue{v}
 			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BytesValue", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCommon
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthCommon
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCommon
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := make([]byte, postIndex-iNdEx)
+			copy(v, dAtA[iNdEx:postIndex])
+			m.Value = &AnyValue_BytesValue{v}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipCommon(dAtA[iNdEx:])
@@ -29,7 +29,7 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 type SeverityNumber int32
 
 const (
-	// UNSPECIFIED is the default SeverityNumber, it MUST not be used.
+	// UNSPECIFIED is the default SeverityNumber, it MUST NOT be used.
 	SeverityNumber_SEVERITY_NUMBER_UNSPECIFIED SeverityNumber = 0
 	SeverityNumber_SEVERITY_NUMBER_TRACE       SeverityNumber = 1
 	SeverityNumber_SEVERITY_NUMBER_TRACE2      SeverityNumber = 2
@@ -150,13 +150,17 @@ func (LogRecordFlags) EnumDescriptor() ([]byte, []int) {
 // A collection of InstrumentationLibraryLogs from a Resource.
 type ResourceLogs struct {
 	// The resource for the logs in this message.
-	// If this field is not set then no resource info is known.
+	// If this field is not set then resource info is unknown.
 	Resource *v1.Resource `protobuf:"bytes,1,opt,name=resource,proto3" json:"resource,omitempty"`
 	// A list of InstrumentationLibraryLogs that originate from a resource.
 	InstrumentationLibraryLogs []*InstrumentationLibraryLogs `protobuf:"bytes,2,rep,name=instrumentation_library_logs,json=instrumentationLibraryLogs,proto3" json:"instrumentation_library_logs,omitempty"`
-	XXX_NoUnkeyedLiteral       struct{}                      `json:"-"`
-	XXX_unrecognized           []byte                        `json:"-"`
-	XXX_sizecache              int32                         `json:"-"`
+	// This schema_url applies to the data in the "resource" field. It does not apply
+	// to the data in the "instrumentation_library_logs" field which have their own
+	// schema_url field.
+	SchemaUrl            string   `protobuf:"bytes,3,opt,name=schema_url,json=schemaUrl,proto3" json:"schema_url,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *ResourceLogs) Reset()         { *m = ResourceLogs{} }
@@ -206,16 +210,26 @@ func (m *ResourceLogs) GetInstrumentationLibraryLogs() []*InstrumentationLibrary
 	return nil
 }
 
+func (m *ResourceLogs) GetSchemaUrl() string {
+	if m != nil {
+		return m.SchemaUrl
+	}
+	return ""
+}
+
 // A collection of Logs produced by an InstrumentationLibrary.
 type InstrumentationLibraryLogs struct {
 	// The instrumentation library information for the logs in this message.
-	// If this field is not set then no library info is known.
+	// Semantically when InstrumentationLibrary isn't set, it is equivalent with
+	// an empty instrumentation library name (unknown).
 	InstrumentationLibrary *v11.InstrumentationLibrary `protobuf:"bytes,1,opt,name=instrumentation_library,json=instrumentationLibrary,proto3" json:"instrumentation_library,omitempty"`
 	// A list of log records.
-	Logs                 []*LogRecord `protobuf:"bytes,2,rep,name=logs,proto3" json:"logs,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
-	XXX_unrecognized     []byte       `json:"-"`
-	XXX_sizecache        int32        `json:"-"`
+	Logs []*LogRecord `protobuf:"bytes,2,rep,name=logs,proto3" json:"logs,omitempty"`
+	// This schema_url applies to all logs in the "logs" field.
+	SchemaUrl            string   `protobuf:"bytes,3,opt,name=schema_url,json=schemaUrl,proto3" json:"schema_url,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *InstrumentationLibraryLogs) Reset()         { *m = InstrumentationLibraryLogs{} }
@@ -265,8 +279,15 @@ func (m *InstrumentationLibraryLogs) GetLogs() []*LogRecord {
 	return nil
 }
 
+func (m *InstrumentationLibraryLogs) GetSchemaUrl() string {
+	if m != nil {
+		return m.SchemaUrl
+	}
+	return ""
+}
+
 // A log record according to OpenTelemetry Log Data Model:
-// https://github.com/open-telemetry/oteps/blob/master/text/logs/0097-log-data-model.md
+// https://github.com/open-telemetry/oteps/blob/main/text/logs/0097-log-data-model.md
 type LogRecord struct {
 	// time_unix_nano is the time when the event occurred.
 	// Value is UNIX Epoch time in nanoseconds since 00:00:00 UTC on 1 January 1970.
@@ -426,57 +447,58 @@ func init() {
 }
 
 var fileDescriptor_d1c030a3ec7e961e = []byte{
-	// 785 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x95, 0xdd, 0x6e, 0x22, 0x37,
-	0x14, 0xc7, 0xeb, 0x84, 0x40, 0xe2, 0x10, 0xd6, 0x75, 0xb3, 0xc9, 0x2c, 0xa9, 0xb2, 0xa3, 0xb4,
-	0xdd, 0xd2, 0x54, 0xcb, 0x28, 0x03, 0x55, 0xab, 0xf6, 0x6a, 0x42, 0x06, 0x84, 0x96, 0x05, 0x64,
-	0x60, 0xfb, 0x71, 0x33, 0x1a, 0xc0, 0x45, 0x23, 0x0d, 0x36, 0xf2, 0x18, 0x04, 0xcf, 0xd0, 0xc7,
-	0xea, 0xcd, 0xaa, 0x57, 0xfb, 0x08, 0x55, 0x5e, 0xa4, 0xd5, 0x98, 0x8f, 0x5d, 0xd0, 0x98, 0x5c,
-	0x61, 0x9f, 0xdf, 0xf9, 0xff, 0x7d, 0xce, 0x91, 0xf1, 0xc0, 0x57, 0x7c, 0x42, 0x99, 0xa4, 0x21,
-	0x1d, 0x53, 0x29, 0x16, 0xd6, 0x44, 0x70, 0xc9, 0xad, 0x90, 0x8f, 0x22, 0x6b, 0x76, 0xa7, 0x7e,
-	0x8b, 0x2a, 0x84, 0xaf, 0xb6, 0xf2, 0x96, 0xc1, 0xa2, 0xe2, 0xb3, 0xbb, 0xfc, 0x6d, 0x92, 0xc9,
-	0x80, 0x8f, 0xc7, 0x9c, 0xc5, 0x36, 0xcb, 0xd5, 0x52, 0x93, 0x2f, 0x26, 0xe5, 0x0a, 0x1a, 0xf1,
-	0xa9, 0x18, 0xd0, 0x38, 0x7b, 0xbd, 0x5e, 0xe6, 0xdf, 0x7c, 0x00, 0x30, 0x4b, 0x56, 0xa1, 0x06,
-	0x1f, 0x45, 0xd8, 0x85, 0xc7, 0xeb, 0x14, 0x03, 0x98, 0xa0, 0x70, 0x6a, 0x7f, 0x57, 0x4c, 0x2a,
-	0x6e, 0xe3, 0x33, 0xbb, 0x2b, 0xae, 0x0d, 0xc8, 0x46, 0x8a, 0x17, 0xf0, 0xcb, 0x80, 0x45, 0x52,
-	0x4c, 0xc7, 0x94, 0x49, 0x5f, 0x06, 0x9c, 0x79, 0x61, 0xd0, 0x17, 0xbe, 0x58, 0x78, 0x71, 0x5b,
-	0xc6, 0x81, 0x79, 0x58, 0x38, 0xb5, 0x7f, 0x2c, 0xee, 0xe9, 0xbb, 0x58, 0xdf, 0x36, 0x68, 0x2c,
-	0xf5, 0x71, 0x95, 0x24, 0x1f, 0x68, 0xd9, 0xcd, 0x7b, 0x00, 0xf3, 0x7a, 0x29, 0x66, 0xf0, 0x52,
-	0x53, 0xd9, 0xaa, 0xdf, 0x1f, 0x12, 0x8b, 0x5a, 0x4d, 0x59, 0x5b, 0x16, 0xb9, 0x48, 0x2e, 0x09,
-	0xff, 0x0c, 0x53, 0x9f, 0x74, 0xfc, 0x6a, 0x6f, 0xc7, 0x0d, 0x3e, 0x22, 0x74, 0xc0, 0xc5, 0x90,
-	0x28, 0xcd, 0xcd, 0x3f, 0x87, 0xf0, 0x64, 0x13, 0xc3, 0x5f, 0xc3, 0x9c, 0x0c, 0xc6, 0xd4, 0x9b,
-	0xb2, 0x60, 0xee, 0x31, 0x9f, 0x71, 0x55, 0x70, 0x9a, 0x64, 0xe3, 0x68, 0x8f, 0x05, 0xf3, 0xa6,
-	0xcf, 0x38, 0xee, 0xc2, 0x67, 0x11, 0x9d, 0x51, 0x11, 0xc8, 0x85, 0xc7, 0xa6, 0xe3, 0x3e, 0x15,
-	0xc6, 0x81, 0x09, 0x0a, 0x39, 0xfb, 0xfb, 0xbd, 0x47, 0x77, 0x56, 0x9a, 0xa6, 0x92, 0x90, 0x5c,
-	0xb4, 0xb5, 0xc7, 0x5f, 0xc1, 0xb3, 0x8d, 0xab, 0xa4, 0x73, 0x69, 0x1c, 0x9a, 0xa0, 0x70, 0x42,
-	0xb2, 0xeb, 0x60, 0x97, 0xce, 0x25, 0xc6, 0x30, 0xc5, 0xfc, 0x31, 0x35, 0x52, 0x8a, 0xa9, 0x35,
-	0xfe, 0x05, 0xa6, 0xfa, 0x7c, 0xb8, 0x30, 0x8e, 0xd4, 0x6c, 0xbf, 0x7d, 0x62, 0xb6, 0x0e, 0x5b,
-	0xbc, 0xf3, 0xc3, 0x29, 0x25, 0x4a, 0x84, 0x6b, 0x10, 0xfa, 0x52, 0x8a, 0xa0, 0x3f, 0x95, 0x34,
-	0x32, 0xd2, 0x6a, 0x82, 0x4f, 0x59, 0xbc, 0xa1, 0x2b, 0x8b, 0x4f, 0xa4, 0xf8, 0x27, 0x68, 0x0c,
-	0x05, 0x9f, 0x4c, 0xe8, 0xd0, 0xfb, 0x18, 0xf5, 0x06, 0x7c, 0xca, 0xa4, 0x91, 0x31, 0x41, 0xe1,
-	0x8c, 0x5c, 0xac, 0xb8, 0xb3, 0xc1, 0x95, 0x98, 0xe2, 0x73, 0x78, 0xf4, 0x67, 0xe8, 0x8f, 0x22,
-	0xe3, 0xd8, 0x04, 0x85, 0x0c, 0x59, 0x6e, 0xf0, 0x0b, 0x78, 0x2c, 0x85, 0x3f, 0xa0, 0x5e, 0x30,
-	0x34, 0x4e, 0x4c, 0x50, 0xc8, 0x92, 0x8c, 0xda, 0xd7, 0x87, 0xf8, 0x12, 0x66, 0xa2, 0x89, 0xcf,
-	0x62, 0x02, 0x15, 0x49, 0xc7, 0xdb, 0xfa, 0xf0, 0xf6, 0xef, 0x23, 0x98, 0xdb, 0x9e, 0x32, 0x7e,
-	0x09, 0xaf, 0x3a, 0xee, 0x3b, 0x97, 0xd4, 0xbb, 0xbf, 0x7b, 0xcd, 0xde, 0xdb, 0x7b, 0x97, 0x78,
-	0xbd, 0x66, 0xa7, 0xed, 0x56, 0xea, 0xd5, 0xba, 0xfb, 0x80, 0x3e, 0xc3, 0x2f, 0xe0, 0xf3, 0xdd,
-	0x84, 0x2e, 0x71, 0x2a, 0x2e, 0x02, 0x38, 0x0f, 0x2f, 0x12, 0x91, 0x8d, 0x0e, 0xb4, 0xac, 0x84,
-	0x0e, 0xb5, 0xac, 0x8c, 0x52, 0x49, 0xc7, 0x3d, 0xb8, 0xf7, 0xbd, 0x1a, 0x3a, 0x4a, 0x92, 0x29,
-	0x64, 0xa3, 0xb4, 0x96, 0x95, 0x50, 0x46, 0xcb, 0xca, 0xe8, 0x18, 0x1b, 0xf0, 0x7c, 0x97, 0xd5,
-	0x9b, 0xd5, 0x16, 0x3a, 0x49, 0x2a, 0x24, 0x26, 0x36, 0x82, 0x3a, 0x54, 0x42, 0xa7, 0x3a, 0x54,
-	0x46, 0xd9, 0xa4, 0xa3, 0x7e, 0x75, 0x48, 0x13, 0x9d, 0x25, 0x89, 0x62, 0x62, 0xa3, 0x9c, 0x0e,
-	0x95, 0xd0, 0x33, 0x1d, 0x2a, 0x23, 0x94, 0x84, 0x5c, 0x42, 0x5a, 0x04, 0x7d, 0x9e, 0x34, 0x0c,
-	0x85, 0x6c, 0x84, 0xb5, 0xac, 0x84, 0xbe, 0xd0, 0xb2, 0x32, 0x3a, 0x4f, 0x3a, 0xae, 0xea, 0x74,
-	0x9d, 0x06, 0x7a, 0x9e, 0x24, 0x53, 0xc8, 0x46, 0x17, 0x5a, 0x56, 0x42, 0x97, 0x5a, 0x56, 0x46,
-	0xc6, 0xed, 0x6f, 0x30, 0xb7, 0x79, 0x91, 0xaa, 0xea, 0xbf, 0xf0, 0x12, 0x5e, 0x35, 0x5a, 0x35,
-	0x8f, 0xb8, 0x95, 0x16, 0x79, 0xf0, 0xaa, 0x0d, 0xa7, 0xb6, 0x73, 0x89, 0xbf, 0x81, 0xe6, 0x6e,
-	0x82, 0xba, 0x71, 0x6a, 0xd9, 0xf1, 0xde, 0x3a, 0x9d, 0x37, 0xe8, 0x3f, 0x70, 0xff, 0x17, 0x78,
-	0xff, 0x78, 0x0d, 0x3e, 0x3c, 0x5e, 0x83, 0x7f, 0x1f, 0xaf, 0x01, 0xbc, 0x0e, 0xf8, 0xbe, 0x07,
-	0xeb, 0x3e, 0x7e, 0x18, 0xa3, 0x76, 0x1c, 0x6a, 0x83, 0x3f, 0xda, 0xa3, 0xdd, 0xe4, 0x80, 0x5b,
-	0x5c, 0xd2, 0xd0, 0xa2, 0xf3, 0x09, 0x17, 0x92, 0x8a, 0xc8, 0xe2, 0x32, 0x9c, 0x58, 0x01, 0x93,
-	0x54, 0x30, 0x3f, 0xb4, 0xb6, 0xb2, 0x5f, 0x2b, 0xeb, 0xd7, 0x23, 0xca, 0xd6, 0x1f, 0xe7, 0x7e,
-	0x5a, 0x85, 0x4a, 0xff, 0x07, 0x00, 0x00, 0xff, 0xff, 0xe0, 0x15, 0xaa, 0xeb, 0xc2, 0x07, 0x00,
-	0x00,
+	// 807 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x95, 0xdf, 0x6e, 0x22, 0x37,
+	0x14, 0xc6, 0xeb, 0x40, 0x20, 0x9c, 0x10, 0xd6, 0x75, 0xb3, 0xc9, 0x2c, 0x69, 0xb3, 0xa3, 0x6d,
+	0xbb, 0xa5, 0xa9, 0x16, 0x94, 0x81, 0xaa, 0x55, 0x7b, 0x45, 0x92, 0x21, 0x42, 0xcb, 0x92, 0xc8,
+	0xc0, 0xf6, 0xcf, 0xcd, 0x68, 0x00, 0x97, 0x8e, 0x34, 0xd8, 0xc8, 0x63, 0x22, 0x78, 0x86, 0x3e,
+	0x56, 0x6f, 0xaa, 0x5e, 0xf5, 0x11, 0xaa, 0xdc, 0xf4, 0xb2, 0x8f, 0xd0, 0x6a, 0xcc, 0x9f, 0x2e,
+	0x68, 0x9c, 0xe6, 0x0a, 0xfb, 0xfc, 0xce, 0xf7, 0xcd, 0xe7, 0xc3, 0x60, 0xe0, 0xa5, 0x98, 0x30,
+	0xae, 0x58, 0xc8, 0xc6, 0x4c, 0xc9, 0x79, 0x65, 0x22, 0x85, 0x12, 0x95, 0x50, 0x8c, 0xa2, 0xca,
+	0xdd, 0xb9, 0xfe, 0x2c, 0xeb, 0x12, 0x39, 0xd9, 0xe8, 0x5b, 0x14, 0xcb, 0x9a, 0xdf, 0x9d, 0x17,
+	0xcf, 0x92, 0x4c, 0x06, 0x62, 0x3c, 0x16, 0x3c, 0xb6, 0x59, 0xac, 0x16, 0x9a, 0x62, 0x39, 0xa9,
+	0x57, 0xb2, 0x48, 0x4c, 0xe5, 0x80, 0xc5, 0xdd, 0xab, 0xf5, 0xa2, 0xff, 0xc5, 0xdf, 0x08, 0xf2,
+	0x74, 0x59, 0x6a, 0x89, 0x51, 0x44, 0x5c, 0xd8, 0x5b, 0xb5, 0x58, 0xc8, 0x46, 0xa5, 0x7d, 0xe7,
+	0xf3, 0x72, 0x52, 0xb8, 0xb5, 0xcf, 0xdd, 0x79, 0x79, 0x65, 0x40, 0xd7, 0x52, 0x32, 0x87, 0x0f,
+	0x03, 0x1e, 0x29, 0x39, 0x1d, 0x33, 0xae, 0x7c, 0x15, 0x08, 0xee, 0x85, 0x41, 0x5f, 0xfa, 0x72,
+	0xee, 0xc5, 0xc7, 0xb2, 0x76, 0xec, 0x54, 0x69, 0xdf, 0xf9, 0xaa, 0xfc, 0xc0, 0xb9, 0xcb, 0xcd,
+	0x4d, 0x83, 0xd6, 0x42, 0x1f, 0xa7, 0xa4, 0xc5, 0xc0, 0xc8, 0xc8, 0x47, 0x00, 0xd1, 0xe0, 0x67,
+	0x36, 0xf6, 0xbd, 0xa9, 0x0c, 0xad, 0x94, 0x8d, 0x4a, 0x39, 0x9a, 0x5b, 0x54, 0x7a, 0x32, 0x7c,
+	0xf1, 0x17, 0x82, 0xa2, 0xd9, 0x99, 0x70, 0x38, 0x36, 0x04, 0x5f, 0x8e, 0xe3, 0xcb, 0xc4, 0xcc,
+	0xcb, 0x2f, 0xc1, 0x98, 0x9a, 0x1e, 0x25, 0x27, 0x26, 0xdf, 0x40, 0xfa, 0x9d, 0x81, 0xbc, 0x7c,
+	0x70, 0x20, 0x2d, 0x31, 0xa2, 0x6c, 0x20, 0xe4, 0x90, 0xa6, 0xc3, 0x47, 0x9c, 0xf4, 0xf7, 0x14,
+	0xe4, 0xd6, 0x12, 0xf2, 0x09, 0x14, 0x54, 0x30, 0x66, 0xde, 0x94, 0x07, 0x33, 0x8f, 0xfb, 0x5c,
+	0xe8, 0xf3, 0x64, 0x68, 0x3e, 0xae, 0xf6, 0x78, 0x30, 0x6b, 0xfb, 0x5c, 0x90, 0x2e, 0x3c, 0x89,
+	0xd8, 0x1d, 0x93, 0x81, 0x9a, 0x7b, 0x7c, 0x3a, 0xee, 0x33, 0x69, 0xed, 0xd8, 0xa8, 0x54, 0x70,
+	0xbe, 0x78, 0x30, 0x59, 0x67, 0xa9, 0x69, 0x6b, 0x09, 0x2d, 0x44, 0x1b, 0x7b, 0xf2, 0x31, 0x1c,
+	0xac, 0x5d, 0x15, 0x9b, 0xa9, 0x65, 0xd6, 0xfc, 0xaa, 0xd8, 0x65, 0x33, 0x45, 0x08, 0xa4, 0xb9,
+	0x3f, 0x66, 0x56, 0x5a, 0x33, 0xbd, 0x26, 0xdf, 0x42, 0xba, 0x2f, 0x86, 0x73, 0x6b, 0x57, 0x8f,
+	0xfe, 0xb3, 0xff, 0x19, 0x7d, 0x9d, 0xcf, 0xdf, 0xfa, 0xe1, 0x94, 0x51, 0x2d, 0x22, 0xd7, 0x00,
+	0xbe, 0x52, 0x32, 0xe8, 0x4f, 0x15, 0x8b, 0xac, 0x8c, 0x9d, 0x7a, 0x84, 0xc5, 0x6b, 0xb6, 0xb4,
+	0x78, 0x47, 0x4a, 0xbe, 0x06, 0x6b, 0x28, 0xc5, 0x64, 0xc2, 0x86, 0xde, 0x7f, 0x55, 0x6f, 0x20,
+	0xa6, 0x5c, 0x59, 0x59, 0x1b, 0x95, 0x0e, 0xe8, 0xd1, 0x92, 0xd7, 0xd7, 0xf8, 0x32, 0xa6, 0xe4,
+	0x10, 0x76, 0x7f, 0x0a, 0xfd, 0x51, 0x64, 0xed, 0xd9, 0xa8, 0x94, 0xa5, 0x8b, 0x0d, 0x79, 0x06,
+	0x7b, 0x4a, 0xfa, 0x03, 0xe6, 0x05, 0x43, 0x2b, 0x67, 0xa3, 0x52, 0x9e, 0x66, 0xf5, 0xbe, 0x39,
+	0x24, 0xc7, 0x90, 0x8d, 0x26, 0x3e, 0x8f, 0x09, 0x68, 0x92, 0x89, 0xb7, 0xcd, 0xe1, 0xd9, 0xaf,
+	0xbb, 0x50, 0xd8, 0x9c, 0x32, 0x79, 0x0e, 0x27, 0x1d, 0xf7, 0xad, 0x4b, 0x9b, 0xdd, 0x1f, 0xbc,
+	0x76, 0xef, 0xcd, 0x85, 0x4b, 0xbd, 0x5e, 0xbb, 0x73, 0xeb, 0x5e, 0x36, 0x1b, 0x4d, 0xf7, 0x0a,
+	0xbf, 0x47, 0x9e, 0xc1, 0xd3, 0xed, 0x86, 0x2e, 0xad, 0x5f, 0xba, 0x18, 0x91, 0x22, 0x1c, 0x25,
+	0x22, 0x07, 0xef, 0x18, 0x59, 0x15, 0xa7, 0x8c, 0xac, 0x86, 0xd3, 0x49, 0x8f, 0xbb, 0x72, 0x2f,
+	0x7a, 0xd7, 0x78, 0x37, 0x49, 0xa6, 0x91, 0x83, 0x33, 0x46, 0x56, 0xc5, 0x59, 0x23, 0xab, 0xe1,
+	0x3d, 0x62, 0xc1, 0xe1, 0x36, 0x6b, 0xb6, 0x1b, 0x37, 0x38, 0x97, 0x14, 0x24, 0x26, 0x0e, 0x06,
+	0x13, 0xaa, 0xe2, 0x7d, 0x13, 0xaa, 0xe1, 0x7c, 0xd2, 0xa3, 0xbe, 0xab, 0xd3, 0x36, 0x3e, 0x48,
+	0x12, 0xc5, 0xc4, 0xc1, 0x05, 0x13, 0xaa, 0xe2, 0x27, 0x26, 0x54, 0xc3, 0x38, 0x09, 0xb9, 0x94,
+	0xde, 0x50, 0xfc, 0x7e, 0xd2, 0x30, 0x34, 0x72, 0x30, 0x31, 0xb2, 0x2a, 0xfe, 0xc0, 0xc8, 0x6a,
+	0xf8, 0x30, 0xe9, 0x71, 0x8d, 0x7a, 0xb7, 0xde, 0xc2, 0x4f, 0x93, 0x64, 0x1a, 0x39, 0xf8, 0xc8,
+	0xc8, 0xaa, 0xf8, 0xd8, 0xc8, 0x6a, 0xd8, 0x3a, 0xfb, 0x1e, 0x0a, 0xeb, 0x1b, 0xa9, 0xa1, 0x7f,
+	0x0b, 0xcf, 0xe1, 0xa4, 0x75, 0x73, 0xed, 0x51, 0xf7, 0xf2, 0x86, 0x5e, 0x79, 0x8d, 0x56, 0xfd,
+	0x7a, 0xeb, 0x25, 0xfe, 0x14, 0xec, 0xed, 0x06, 0xfd, 0xc6, 0xe9, 0x65, 0xc7, 0x7b, 0x53, 0xef,
+	0xbc, 0xc6, 0xff, 0xa0, 0x8b, 0x5f, 0xd0, 0x6f, 0xf7, 0xa7, 0xe8, 0x8f, 0xfb, 0x53, 0xf4, 0xe7,
+	0xfd, 0x29, 0x82, 0xd3, 0x40, 0x3c, 0x74, 0x61, 0x5d, 0xc4, 0x17, 0x63, 0x74, 0x1b, 0x97, 0x6e,
+	0xd1, 0x8f, 0xb7, 0xa3, 0xed, 0xe6, 0x40, 0x54, 0x84, 0x62, 0x61, 0x85, 0xcd, 0x26, 0x42, 0x2a,
+	0x26, 0xa3, 0x8a, 0x50, 0xe1, 0xa4, 0x12, 0x70, 0xc5, 0x24, 0xf7, 0xc3, 0xca, 0x46, 0xf7, 0x2b,
+	0x6d, 0xfd, 0x6a, 0xc4, 0xf8, 0xea, 0xaf, 0xbd, 0x9f, 0xd1, 0xa5, 0xea, 0xbf, 0x03, 0x00, 0x26,
+	0x23, 0xc8, 0xb7, 0x00, 0x08, 0x00, 0x00,
 }
 
 func (m *ResourceLogs) Marshal() (dAtA []byte, err error) {
@@ -503,6 +525,13 @@ func (m *ResourceLogs) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if len(m.SchemaUrl) > 0 {
+		i -= len(m.SchemaUrl)
+		copy(dAtA[i:], m.SchemaUrl)
+		i = encodeVarintLogs(dAtA, i, uint64(len(m.SchemaUrl)))
+		i--
+		dAtA[i] = 0x1a
+	}
 	if len(m.InstrumentationLibraryLogs) > 0 {
 		for iNdEx := len(m.InstrumentationLibraryLogs) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -556,6 +585,13 @@ func (m *InstrumentationLibraryLogs) MarshalToSizedBuffer(dAtA []byte) (int, err
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if len(m.SchemaUrl) > 0 {
+		i -= len(m.SchemaUrl)
+		copy(dAtA[i:], m.SchemaUrl)
+		i = encodeVarintLogs(dAtA, i, uint64(len(m.SchemaUrl)))
+		i--
+		dAtA[i] = 0x1a
+	}
 	if len(m.Logs) > 0 {
 		for iNdEx := len(m.Logs) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -715,6 +751,10 @@ func (m *ResourceLogs) Size() (n int) {
 			n += 1 + l + sovLogs(uint64(l))
 		}
 	}
+	l = len(m.SchemaUrl)
+	if l > 0 {
+		n += 1 + l + sovLogs(uint64(l))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -737,6 +777,10 @@ func (m *InstrumentationLibraryLogs) Size() (n int) {
 			n += 1 + l + sovLogs(uint64(l))
 		}
 	}
+	l = len(m.SchemaUrl)
+	if l > 0 {
+		n += 1 + l + sovLogs(uint64(l))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -898,6 +942,38 @@ func (m *ResourceLogs) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SchemaUrl", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLogs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLogs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLogs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SchemaUrl = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipLogs(dAtA[iNdEx:])
@@ -1022,6 +1098,38 @@ func (m *InstrumentationLibraryLogs) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SchemaUrl", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLogs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLogs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLogs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SchemaUrl = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipLogs(dAtA[iNdEx:])
@@ -58,7 +58,7 @@ const (
 	//      number of requests received over the interval of time t_0+1 to
 	//      t_0+2 with a value of 2.
 	AggregationTemporality_AGGREGATION_TEMPORALITY_DELTA AggregationTemporality = 1
-	// CUMULATIVE is an AggregationTemporality for a metic aggregator which
+	// CUMULATIVE is an AggregationTemporality for a metric aggregator which
 	// reports changes since a fixed start time. This means that current values
 	// of a CUMULATIVE metric depend on all previous measurements since the
 	// start time. Because of this, the sender is required to retain this state
@@ -123,9 +123,13 @@ type ResourceMetrics struct {
 	Resource *v1.Resource `protobuf:"bytes,1,opt,name=resource,proto3" json:"resource,omitempty"`
 	// A list of metrics that originate from a resource.
 	InstrumentationLibraryMetrics []*InstrumentationLibraryMetrics `protobuf:"bytes,2,rep,name=instrumentation_library_metrics,json=instrumentationLibraryMetrics,proto3" json:"instrumentation_library_metrics,omitempty"`
-	XXX_NoUnkeyedLiteral          struct{}                         `json:"-"`
-	XXX_unrecognized              []byte                           `json:"-"`
-	XXX_sizecache                 int32                            `json:"-"`
+	// This schema_url applies to the data in the "resource" field. It does not apply
+	// to the data in the "instrumentation_library_metrics" field which have their own
+	// schema_url field.
+	SchemaUrl            string   `protobuf:"bytes,3,opt,name=schema_url,json=schemaUrl,proto3" json:"schema_url,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *ResourceMetrics) Reset()         { *m = ResourceMetrics{} }
@@ -175,16 +179,26 @@ func (m *ResourceMetrics) GetInstrumentationLibraryMetrics() []*InstrumentationL
 	return nil
 }
 
+func (m *ResourceMetrics) GetSchemaUrl() string {
+	if m != nil {
+		return m.SchemaUrl
+	}
+	return ""
+}
+
 // A collection of Metrics produced by an InstrumentationLibrary.
 type InstrumentationLibraryMetrics struct {
 	// The instrumentation library information for the metrics in this message.
-	// If this field is not set then no library info is known.
+	// Semantically when InstrumentationLibrary isn't set, it is equivalent with
+	// an empty instrumentation library name (unknown).
 	InstrumentationLibrary *v11.InstrumentationLibrary `protobuf:"bytes,1,opt,name=instrumentation_library,json=instrumentationLibrary,proto3" json:"instrumentation_library,omitempty"`
 	// A list of metrics that originate from an instrumentation library.
-	Metrics              []*Metric `protobuf:"bytes,2,rep,name=metrics,proto3" json:"metrics,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}  `json:"-"`
-	XXX_unrecognized     []byte    `json:"-"`
-	XXX_sizecache        int32     `json:"-"`
+	Metrics []*Metric `protobuf:"bytes,2,rep,name=metrics,proto3" json:"metrics,omitempty"`
+	// This schema_url applies to all metrics in the "metrics" field.
+	SchemaUrl            string   `protobuf:"bytes,3,opt,name=schema_url,json=schemaUrl,proto3" json:"schema_url,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *InstrumentationLibraryMetrics) Reset()         { *m = InstrumentationLibraryMetrics{} }
@@ -234,7 +248,18 @@ func (m *InstrumentationLibraryMetrics) GetMetrics() []*Metric {
 	return nil
 }
 
-// Defines a Metric which has one or more timeseries.
+func (m *InstrumentationLibraryMetrics) GetSchemaUrl() string {
+	if m != nil {
+		return m.SchemaUrl
+	}
+	return ""
+}
+
+// Defines a Metric which has one or more timeseries.  The following is a
+// brief summary of the Metric data model.  For more details, see:
+//
+//   https://github.com/open-telemetry/opentelemetry-specification/blob/main/specification/metrics/datamodel.md
+//
 //
 // The data model and relation between entities is shown in the
 // diagram below. Here, "DataPoint" is the term used to refer to any
@@ -243,19 +268,19 @@ func (m *InstrumentationLibraryMetrics) GetMetrics() []*Metric {
 //
 // - Metric is composed of a metadata and data.
 // - Metadata part contains a name, description, unit.
-// - Data is one of the possible types (Gauge, Sum, Histogram, etc.).
-// - DataPoint contains timestamps, labels, and one of the possible value type
+// - Data is one of the possible types (Sum, Gauge, Histogram, Summary).
+// - DataPoint contains timestamps, attributes, and one of the possible value type
 //   fields.
 //
 //     Metric
 //  +------------+
 //  |name        |
 //  |description |
-//  |unit        |     +---------------------------+
-//  |data        |---> |Gauge, Sum, Histogram, ... |
-//  +------------+     +---------------------------+
+//  |unit        |     +------------------------------------+
+//  |data        |---> |Gauge, Sum, Histogram, Summary, ... |
+//  +------------+     +------------------------------------+
 //
-//    Data [One of Gauge, Sum, Histogram, ...]
+//    Data [One of Gauge, Sum, Histogram, Summary, ...]
 //  +-----------+
 //  |...        |  // Metadata about the Data.
 //  |points     |--+
@@ -284,14 +309,37 @@ func (m *InstrumentationLibraryMetrics) GetMetrics() []*Metric {
 //                        |+-----+                    |
 //                        +---------------------------+
 //
+// Each distinct type of DataPoint represents the output of a specific
+// aggregation function, the result of applying the DataPoint's
+// associated function of to one or more measurements.
+//
 // All DataPoint types have three common fields:
-// - Labels zero or more key-value pairs associated with the data point.
-// - StartTimeUnixNano MUST be set to the start of the interval when the data's
-//   type includes an AggregationTemporality. This field is not set otherwise.
-// - TimeUnixNano MUST be set to:
-//   - the moment when an aggregation is reported (independent of the
-//     aggregation temporality).
-//   - the instantaneous time of the event.
+// - Attributes includes key-value pairs associated with the data point
+// - TimeUnixNano is required, set to the end time of the aggregation
+// - StartTimeUnixNano is optional, but strongly encouraged for DataPoints
+//   having an AggregationTemporality field, as discussed below.
+//
+// Both TimeUnixNano and StartTimeUnixNano values are expressed as
+// UNIX Epoch time in nanoseconds since 00:00:00 UTC on 1 January 1970.
+//
+// # TimeUnixNano
+//
+// This field is required, having consistent interpretation across
+// DataPoint types.  TimeUnixNano is the moment corresponding to when
+// the data point's aggregate value was captured.
+//
+// Data points with the 0 value for TimeUnixNano SHOULD be rejected
+// by consumers.
+//
+// # StartTimeUnixNano
+//
+// StartTimeUnixNano in general allows detecting when a sequence of
+// observations is unbroken.  This field indicates to consumers the
+// start time for points with cumulative and delta
+// AggregationTemporality, and it should be included whenever possible
+// to support correct rate calculation.  Although it may be omitted
+// when the start time is truly unknown, setting StartTimeUnixNano is
+// strongly encouraged.
 type Metric struct {
 	// name of the metric, including its DNS name prefix. It must be unique.
 	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
@@ -304,27 +352,14 @@ type Metric struct {
 	// reported value type for the data points, as well as the relatationship to
 	// the time interval over which they are reported.
 	//
-	// TODO: Update table after the decision on:
-	// https://github.com/open-telemetry/opentelemetry-specification/issues/731.
-	// By default, metrics recording using the OpenTelemetry API are exported as
-	// (the table does not include MeasurementValueType to avoid extra rows):
-	//
-	//   Instrument         Type
-	//   ----------------------------------------------
-	//   Counter            Sum(aggregation_temporality=delta;is_monotonic=true)
-	//   UpDownCounter      Sum(aggregation_temporality=delta;is_monotonic=false)
-	//   ValueRecorder      TBD
-	//   SumObserver        Sum(aggregation_temporality=cumulative;is_monotonic=true)
-	//   UpDownSumObserver  Sum(aggregation_temporality=cumulative;is_monotonic=false)
-	//   ValueObserver      Gauge()
-	//
 	// Types that are valid to be assigned to Data:
 	//	*Metric_IntGauge
-	//	*Metric_DoubleGauge
+	//	*Metric_Gauge
 	//	*Metric_IntSum
-	//	*Metric_DoubleSum
+	//	*Metric_Sum
 	//	*Metric_IntHistogram
-	//	*Metric_DoubleHistogram
+	//	*Metric_Histogram
+	//	*Metric_Summary
 	Data                 isMetric_Data `protobuf_oneof:"data"`
 	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
 	XXX_unrecognized     []byte        `json:"-"`
@@ -373,28 +408,32 @@ type isMetric_Data interface {
 type Metric_IntGauge struct {
 	IntGauge *IntGauge `protobuf:"bytes,4,opt,name=int_gauge,json=intGauge,proto3,oneof" json:"int_gauge,omitempty"`
 }
-type Metric_DoubleGauge struct {
-	DoubleGauge *DoubleGauge `protobuf:"bytes,5,opt,name=double_gauge,json=doubleGauge,proto3,oneof" json:"double_gauge,omitempty"`
+type Metric_Gauge struct {
+	Gauge *Gauge `protobuf:"bytes,5,opt,name=gauge,proto3,oneof" json:"gauge,omitempty"`
 }
 type Metric_IntSum struct {
 	IntSum *IntSum `protobuf:"bytes,6,opt,name=int_sum,json=intSum,proto3,oneof" json:"int_sum,omitempty"`
 }
-type Metric_DoubleSum struct {
-	DoubleSum *DoubleSum `protobuf:"bytes,7,opt,name=double_sum,json=doubleSum,proto3,oneof" json:"double_sum,omitempty"`
+type Metric_Sum struct {
+	Sum *Sum `protobuf:"bytes,7,opt,name=sum,proto3,oneof" json:"sum,omitempty"`
 }
 type Metric_IntHistogram struct {
 	IntHistogram *IntHistogram `protobuf:"bytes,8,opt,name=int_histogram,json=intHistogram,proto3,oneof" json:"int_histogram,omitempty"`
 }
-type Metric_DoubleHistogram struct {
-	DoubleHistogram *DoubleHistogram `protobuf:"bytes,9,opt,name=double_histogram,json=doubleHistogram,proto3,oneof" json:"double_histogram,omitempty"`
+type Metric_Histogram struct {
+	Histogram *Histogram `protobuf:"bytes,9,opt,name=histogram,proto3,oneof" json:"histogram,omitempty"`
+}
+type Metric_Summary struct {
+	Summary *Summary `protobuf:"bytes,11,opt,name=summary,proto3,oneof" json:"summary,omitempty"`
 }
 
-func (*Metric_IntGauge) isMetric_Data()        {}
-func (*Metric_DoubleGauge) isMetric_Data()     {}
-func (*Metric_IntSum) isMetric_Data()          {}
-func (*Metric_DoubleSum) isMetric_Data()       {}
-func (*Metric_IntHistogram) isMetric_Data()    {}
-func (*Metric_DoubleHistogram) isMetric_Data() {}
+func (*Metric_IntGauge) isMetric_Data()     {}
+func (*Metric_Gauge) isMetric_Data()        {}
+func (*Metric_IntSum) isMetric_Data()       {}
+func (*Metric_Sum) isMetric_Data()          {}
+func (*Metric_IntHistogram) isMetric_Data() {}
+func (*Metric_Histogram) isMetric_Data()    {}
+func (*Metric_Summary) isMetric_Data()      {}
 
 func (m *Metric) GetData() isMetric_Data {
 	if m != nil {
@@ -424,6 +463,7 @@ func (m *Metric) GetUnit() string {
 	return ""
 }
 
+// Deprecated: Do not use.
 func (m *Metric) GetIntGauge() *IntGauge {
 	if x, ok := m.GetData().(*Metric_IntGauge); ok {
 		return x.IntGauge
@@ -431,13 +471,14 @@ func (m *Metric) GetIntGauge() *IntGauge {
 	return nil
 }
 
-func (m *Metric) GetDoubleGauge() *DoubleGauge {
-	if x, ok := m.GetData().(*Metric_DoubleGauge); ok {
-		return x.DoubleGauge
+func (m *Metric) GetGauge() *Gauge {
+	if x, ok := m.GetData().(*Metric_Gauge); ok {
+		return x.Gauge
 	}
 	return nil
 }
 
+// Deprecated: Do not use.
 func (m *Metric) GetIntSum() *IntSum {
 	if x, ok := m.GetData().(*Metric_IntSum); ok {
 		return x.IntSum
@@ -445,13 +486,14 @@ func (m *Metric) GetIntSum() *IntSum {
 	return nil
 }
 
-func (m *Metric) GetDoubleSum() *DoubleSum {
-	if x, ok := m.GetData().(*Metric_DoubleSum); ok {
-		return x.DoubleSum
+func (m *Metric) GetSum() *Sum {
+	if x, ok := m.GetData().(*Metric_Sum); ok {
+		return x.Sum
 	}
 	return nil
 }
 
+// Deprecated: Do not use.
 func (m *Metric) GetIntHistogram() *IntHistogram {
 	if x, ok := m.GetData().(*Metric_IntHistogram); ok {
 		return x.IntHistogram
@@ -459,9 +501,16 @@ func (m *Metric) GetIntHistogram() *IntHistogram {
 	return nil
 }
 
-func (m *Metric) GetDoubleHistogram() *DoubleHistogram {
-	if x, ok := m.GetData().(*Metric_DoubleHistogram); ok {
-		return x.DoubleHistogram
+func (m *Metric) GetHistogram() *Histogram {
+	if x, ok := m.GetData().(*Metric_Histogram); ok {
+		return x.Histogram
+	}
+	return nil
+}
+
+func (m *Metric) GetSummary() *Summary {
+	if x, ok := m.GetData().(*Metric_Summary); ok {
+		return x.Summary
 	}
 	return nil
 }
@@ -470,15 +519,18 @@ func (m *Metric) GetDoubleHistogram() *DoubleHistogram {
 func (*Metric) XXX_OneofWrappers() []interface{} {
 	return []interface{}{
 		(*Metric_IntGauge)(nil),
-		(*Metric_DoubleGauge)(nil),
+		(*Metric_Gauge)(nil),
 		(*Metric_IntSum)(nil),
-		(*Metric_DoubleSum)(nil),
+		(*Metric_Sum)(nil),
 		(*Metric_IntHistogram)(nil),
-		(*Metric_DoubleHistogram)(nil),
+		(*Metric_Histogram)(nil),
+		(*Metric_Summary)(nil),
 	}
 }
 
-// Gauge represents the type of a int scalar metric that always exports the
+// IntGauge is deprecated.  Use Gauge with an integer value in NumberDataPoint.
+//
+// IntGauge represents the type of a int scalar metric that always exports the
 // "current value" for every data point. It should be used for an "unknown"
 // aggregation.
 //
@@ -487,6 +539,8 @@ func (*Metric) XXX_OneofWrappers() []interface{} {
 // aggregation, regardless of aggregation temporalities. Therefore,
 // AggregationTemporality is not included. Consequently, this also means
 // "StartTimeUnixNano" is ignored for all data points.
+//
+// Deprecated: Do not use.
 type IntGauge struct {
 	DataPoints           []*IntDataPoint `protobuf:"bytes,1,rep,name=data_points,json=dataPoints,proto3" json:"data_points,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
@@ -543,25 +597,25 @@ func (m *IntGauge) GetDataPoints() []*IntDataPoint {
 // aggregation, regardless of aggregation temporalities. Therefore,
 // AggregationTemporality is not included. Consequently, this also means
 // "StartTimeUnixNano" is ignored for all data points.
-type DoubleGauge struct {
-	DataPoints           []*DoubleDataPoint `protobuf:"bytes,1,rep,name=data_points,json=dataPoints,proto3" json:"data_points,omitempty"`
+type Gauge struct {
+	DataPoints           []*NumberDataPoint `protobuf:"bytes,1,rep,name=data_points,json=dataPoints,proto3" json:"data_points,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
 	XXX_unrecognized     []byte             `json:"-"`
 	XXX_sizecache        int32              `json:"-"`
 }
 
-func (m *DoubleGauge) Reset()         { *m = DoubleGauge{} }
-func (m *DoubleGauge) String() string { return proto.CompactTextString(m) }
-func (*DoubleGauge) ProtoMessage()    {}
-func (*DoubleGauge) Descriptor() ([]byte, []int) {
+func (m *Gauge) Reset()         { *m = Gauge{} }
+func (m *Gauge) String() string { return proto.CompactTextString(m) }
+func (*Gauge) ProtoMessage()    {}
+func (*Gauge) Descriptor() ([]byte, []int) {
 	return fileDescriptor_3c3112f9fa006917, []int{4}
 }
-func (m *DoubleGauge) XXX_Unmarshal(b []byte) error {
+func (m *Gauge) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *DoubleGauge) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *Gauge) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_DoubleGauge.Marshal(b, m, deterministic)
+		return xxx_messageInfo_Gauge.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -571,27 +625,31 @@ func (m *DoubleGauge) XXX_Marshal(b []byte, deterministic bool) ([]byte, error)
 		return b[:n], nil
 	}
 }
-func (m *DoubleGauge) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_DoubleGauge.Merge(m, src)
+func (m *Gauge) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Gauge.Merge(m, src)
 }
-func (m *DoubleGauge) XXX_Size() int {
+func (m *Gauge) XXX_Size() int {
 	return m.Size()
 }
-func (m *DoubleGauge) XXX_DiscardUnknown() {
-	xxx_messageInfo_DoubleGauge.DiscardUnknown(m)
+func (m *Gauge) XXX_DiscardUnknown() {
+	xxx_messageInfo_Gauge.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_DoubleGauge proto.InternalMessageInfo
+var xxx_messageInfo_Gauge proto.InternalMessageInfo
 
-func (m *DoubleGauge) GetDataPoints() []*DoubleDataPoint {
+func (m *Gauge) GetDataPoints() []*NumberDataPoint {
 	if m != nil {
 		return m.DataPoints
 	}
 	return nil
 }
 
-// Sum represents the type of a numeric int scalar metric that is calculated as
+// IntSum is deprecated.  Use Sum with an integer value in NumberDataPoint.
+//
+// IntSum represents the type of a numeric int scalar metric that is calculated as
 // a sum of all reported measurements over a time interval.
+//
+// Deprecated: Do not use.
 type IntSum struct {
 	DataPoints []*IntDataPoint `protobuf:"bytes,1,rep,name=data_points,json=dataPoints,proto3" json:"data_points,omitempty"`
 	// aggregation_temporality describes if the aggregator reports delta changes
@@ -660,8 +718,8 @@ func (m *IntSum) GetIsMonotonic() bool {
 
 // Sum represents the type of a numeric double scalar metric that is calculated
 // as a sum of all reported measurements over a time interval.
-type DoubleSum struct {
-	DataPoints []*DoubleDataPoint `protobuf:"bytes,1,rep,name=data_points,json=dataPoints,proto3" json:"data_points,omitempty"`
+type Sum struct {
+	DataPoints []*NumberDataPoint `protobuf:"bytes,1,rep,name=data_points,json=dataPoints,proto3" json:"data_points,omitempty"`
 	// aggregation_temporality describes if the aggregator reports delta changes
 	// since last report time, or cumulative changes since a fixed start time.
 	AggregationTemporality AggregationTemporality `protobuf:"varint,2,opt,name=aggregation_temporality,json=aggregationTemporality,proto3,enum=opentelemetry.proto.metrics.v1.AggregationTemporality" json:"aggregation_temporality,omitempty"`
@@ -672,18 +730,18 @@ type DoubleSum struct {
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *DoubleSum) Reset()         { *m = DoubleSum{} }
-func (m *DoubleSum) String() string { return proto.CompactTextString(m) }
-func (*DoubleSum) ProtoMessage()    {}
-func (*DoubleSum) Descriptor() ([]byte, []int) {
+func (m *Sum) Reset()         { *m = Sum{} }
+func (m *Sum) String() string { return proto.CompactTextString(m) }
+func (*Sum) ProtoMessage()    {}
+func (*Sum) Descriptor() ([]byte, []int) {
 	return fileDescriptor_3c3112f9fa006917, []int{6}
 }
-func (m *DoubleSum) XXX_Unmarshal(b []byte) error {
+func (m *Sum) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *DoubleSum) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *Sum) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_DoubleSum.Marshal(b, m, deterministic)
+		return xxx_messageInfo_Sum.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -693,41 +751,46 @@ func (m *DoubleSum) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 		return b[:n], nil
 	}
 }
-func (m *DoubleSum) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_DoubleSum.Merge(m, src)
+func (m *Sum) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Sum.Merge(m, src)
 }
-func (m *DoubleSum) XXX_Size() int {
+func (m *Sum) XXX_Size() int {
 	return m.Size()
 }
-func (m *DoubleSum) XXX_DiscardUnknown() {
-	xxx_messageInfo_DoubleSum.DiscardUnknown(m)
+func (m *Sum) XXX_DiscardUnknown() {
+	xxx_messageInfo_Sum.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_DoubleSum proto.InternalMessageInfo
+var xxx_messageInfo_Sum proto.InternalMessageInfo
 
-func (m *DoubleSum) GetDataPoints() []*DoubleDataPoint {
+func (m *Sum) GetDataPoints() []*NumberDataPoint {
 	if m != nil {
 		return m.DataPoints
 	}
 	return nil
 }
 
-func (m *DoubleSum) GetAggregationTemporality() AggregationTemporality {
+func (m *Sum) GetAggregationTemporality() AggregationTemporality {
 	if m != nil {
 		return m.AggregationTemporality
 	}
 	return AggregationTemporality_AGGREGATION_TEMPORALITY_UNSPECIFIED
 }
 
-func (m *DoubleSum) GetIsMonotonic() bool {
+func (m *Sum) GetIsMonotonic() bool {
 	if m != nil {
 		return m.IsMonotonic
 	}
 	return false
 }
 
-// Represents the type of a metric that is calculated by aggregating as a
+// IntHistogram is deprecated, replaced by Histogram points using double-
+// valued exemplars.
+//
+// This represents the type of a metric that is calculated by aggregating as a
 // Histogram of all reported int measurements over a time interval.
+//
+// Deprecated: Do not use.
 type IntHistogram struct {
 	DataPoints []*IntHistogramDataPoint `protobuf:"bytes,1,rep,name=data_points,json=dataPoints,proto3" json:"data_points,omitempty"`
 	// aggregation_temporality describes if the aggregator reports delta changes
@@ -785,10 +848,10 @@ func (m *IntHistogram) GetAggregationTemporality() AggregationTemporality {
 	return AggregationTemporality_AGGREGATION_TEMPORALITY_UNSPECIFIED
 }
 
-// Represents the type of a metric that is calculated by aggregating as a
-// Histogram of all reported double measurements over a time interval.
-type DoubleHistogram struct {
-	DataPoints []*DoubleHistogramDataPoint `protobuf:"bytes,1,rep,name=data_points,json=dataPoints,proto3" json:"data_points,omitempty"`
+// Histogram represents the type of a metric that is calculated by aggregating
+// as a Histogram of all reported double measurements over a time interval.
+type Histogram struct {
+	DataPoints []*HistogramDataPoint `protobuf:"bytes,1,rep,name=data_points,json=dataPoints,proto3" json:"data_points,omitempty"`
 	// aggregation_temporality describes if the aggregator reports delta changes
 	// since last report time, or cumulative changes since a fixed start time.
 	AggregationTemporality AggregationTemporality `protobuf:"varint,2,opt,name=aggregation_temporality,json=aggregationTemporality,proto3,enum=opentelemetry.proto.metrics.v1.AggregationTemporality" json:"aggregation_temporality,omitempty"`
@@ -797,18 +860,18 @@ type DoubleHistogram struct {
 	XXX_sizecache          int32                  `json:"-"`
 }
 
-func (m *DoubleHistogram) Reset()         { *m = DoubleHistogram{} }
-func (m *DoubleHistogram) String() string { return proto.CompactTextString(m) }
-func (*DoubleHistogram) ProtoMessage()    {}
-func (*DoubleHistogram) Descriptor() ([]byte, []int) {
+func (m *Histogram) Reset()         { *m = Histogram{} }
+func (m *Histogram) String() string { return proto.CompactTextString(m) }
+func (*Histogram) ProtoMessage()    {}
+func (*Histogram) Descriptor() ([]byte, []int) {
 	return fileDescriptor_3c3112f9fa006917, []int{8}
 }
-func (m *DoubleHistogram) XXX_Unmarshal(b []byte) error {
+func (m *Histogram) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *DoubleHistogram) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *Histogram) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_DoubleHistogram.Marshal(b, m, deterministic)
+		return xxx_messageInfo_Histogram.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -818,51 +881,99 @@ func (m *DoubleHistogram) XXX_Marshal(b []byte, deterministic bool) ([]byte, err
 		return b[:n], nil
 	}
 }
-func (m *DoubleHistogram) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_DoubleHistogram.Merge(m, src)
+func (m *Histogram) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Histogram.Merge(m, src)
 }
-func (m *DoubleHistogram) XXX_Size() int {
+func (m *Histogram) XXX_Size() int {
 	return m.Size()
 }
-func (m *DoubleHistogram) XXX_DiscardUnknown() {
-	xxx_messageInfo_DoubleHistogram.DiscardUnknown(m)
+func (m *Histogram) XXX_DiscardUnknown() {
+	xxx_messageInfo_Histogram.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_DoubleHistogram proto.InternalMessageInfo
+var xxx_messageInfo_Histogram proto.InternalMessageInfo
 
-func (m *DoubleHistogram) GetDataPoints() []*DoubleHistogramDataPoint {
+func (m *Histogram) GetDataPoints() []*HistogramDataPoint {
 	if m != nil {
 		return m.DataPoints
 	}
 	return nil
 }
 
-func (m *DoubleHistogram) GetAggregationTemporality() AggregationTemporality {
+func (m *Histogram) GetAggregationTemporality() AggregationTemporality {
 	if m != nil {
 		return m.AggregationTemporality
 	}
 	return AggregationTemporality_AGGREGATION_TEMPORALITY_UNSPECIFIED
 }
 
+// Summary metric data are used to convey quantile summaries,
+// a Prometheus (see: https://prometheus.io/docs/concepts/metric_types/#summary)
+// and OpenMetrics (see: https://github.com/OpenObservability/OpenMetrics/blob/4dbf6075567ab43296eed941037c12951faafb92/protos/prometheus.proto#L45)
+// data type. These data points cannot always be merged in a meaningful way.
+// While they can be useful in some applications, histogram data points are
+// recommended for new applications.
+type Summary struct {
+	DataPoints           []*SummaryDataPoint `protobuf:"bytes,1,rep,name=data_points,json=dataPoints,proto3" json:"data_points,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
+	XXX_unrecognized     []byte              `json:"-"`
+	XXX_sizecache        int32               `json:"-"`
+}
+
+func (m *Summary) Reset()         { *m = Summary{} }
+func (m *Summary) String() string { return proto.CompactTextString(m) }
+func (*Summary) ProtoMessage()    {}
+func (*Summary) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3c3112f9fa006917, []int{9}
+}
+func (m *Summary) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Summary) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Summary.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Summary) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Summary.Merge(m, src)
+}
+func (m *Summary) XXX_Size() int {
+	return m.Size()
+}
+func (m *Summary) XXX_DiscardUnknown() {
+	xxx_messageInfo_Summary.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Summary proto.InternalMessageInfo
+
+func (m *Summary) GetDataPoints() []*SummaryDataPoint {
+	if m != nil {
+		return m.DataPoints
+	}
+	return nil
+}
+
 // IntDataPoint is a single data point in a timeseries that describes the
 // time-varying values of a int64 metric.
+//
+// Deprecated: Do not use.
 type IntDataPoint struct {
 	// The set of labels that uniquely identify this timeseries.
 	Labels []*v11.StringKeyValue `protobuf:"bytes,1,rep,name=labels,proto3" json:"labels,omitempty"`
-	// start_time_unix_nano is the last time when the aggregation value was reset
-	// to "zero". For some metric types this is ignored, see data types for more
-	// details.
-	//
-	// The aggregation value is over the time interval (start_time_unix_nano,
-	// time_unix_nano].
+	// StartTimeUnixNano is optional but strongly encouraged, see the
+	// the detiled comments above Metric.
 	//
 	// Value is UNIX Epoch time in nanoseconds since 00:00:00 UTC on 1 January
 	// 1970.
-	//
-	// Value of 0 indicates that the timestamp is unspecified. In that case the
-	// timestamp may be decided by the backend.
 	StartTimeUnixNano uint64 `protobuf:"fixed64,2,opt,name=start_time_unix_nano,json=startTimeUnixNano,proto3" json:"start_time_unix_nano,omitempty"`
-	// time_unix_nano is the moment when this aggregation value was reported.
+	// TimeUnixNano is required, see the detailed comments above Metric.
 	//
 	// Value is UNIX Epoch time in nanoseconds since 00:00:00 UTC on 1 January
 	// 1970.
@@ -881,7 +992,7 @@ func (m *IntDataPoint) Reset()         { *m = IntDataPoint{} }
 func (m *IntDataPoint) String() string { return proto.CompactTextString(m) }
 func (*IntDataPoint) ProtoMessage()    {}
 func (*IntDataPoint) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3c3112f9fa006917, []int{9}
+	return fileDescriptor_3c3112f9fa006917, []int{10}
 }
 func (m *IntDataPoint) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -945,51 +1056,59 @@ func (m *IntDataPoint) GetExemplars() []*IntExemplar {
 	return nil
 }
 
-// DoubleDataPoint is a single data point in a timeseries that describes the
+// NumberDataPoint is a single data point in a timeseries that describes the
 // time-varying value of a double metric.
-type DoubleDataPoint struct {
-	// The set of labels that uniquely identify this timeseries.
-	Labels []*v11.StringKeyValue `protobuf:"bytes,1,rep,name=labels,proto3" json:"labels,omitempty"`
-	// start_time_unix_nano is the last time when the aggregation value was reset
-	// to "zero". For some metric types this is ignored, see data types for more
-	// details.
+type NumberDataPoint struct {
+	// The set of key/value pairs that uniquely identify the timeseries from
+	// where this point belongs. The list may be empty (may contain 0 elements).
+	Attributes []*v11.KeyValue `protobuf:"bytes,7,rep,name=attributes,proto3" json:"attributes,omitempty"`
+	// Labels is deprecated and will be removed soon.
+	// 1. Old senders and receivers that are not aware of this change will
+	// continue using the `labels` field.
+	// 2. New senders, which are aware of this change MUST send only `attributes`.
+	// 3. New receivers, which are aware of this change MUST convert this into
+	// `labels` by simply converting all int64 values into float.
 	//
-	// The aggregation value is over the time interval (start_time_unix_nano,
-	// time_unix_nano].
+	// This field will be removed in ~3 months, on July 1, 2021.
+	Labels []*v11.StringKeyValue `protobuf:"bytes,1,rep,name=labels,proto3" json:"labels,omitempty"` // Deprecated: Do not use.
+	// StartTimeUnixNano is optional but strongly encouraged, see the
+	// the detiled comments above Metric.
 	//
 	// Value is UNIX Epoch time in nanoseconds since 00:00:00 UTC on 1 January
 	// 1970.
-	//
-	// Value of 0 indicates that the timestamp is unspecified. In that case the
-	// timestamp may be decided by the backend.
 	StartTimeUnixNano uint64 `protobuf:"fixed64,2,opt,name=start_time_unix_nano,json=startTimeUnixNano,proto3" json:"start_time_unix_nano,omitempty"`
-	// time_unix_nano is the moment when this aggregation value was reported.
+	// TimeUnixNano is required, see the detailed comments above Metric.
 	//
 	// Value is UNIX Epoch time in nanoseconds since 00:00:00 UTC on 1 January
 	// 1970.
 	TimeUnixNano uint64 `protobuf:"fixed64,3,opt,name=time_unix_nano,json=timeUnixNano,proto3" json:"time_unix_nano,omitempty"`
-	// value itself.
-	Value float64 `protobuf:"fixed64,4,opt,name=value,proto3" json:"value,omitempty"`
+	// The value itself.  A point is considered invalid when one of the recognized
+	// value fields is not present inside this oneof.
+	//
+	// Types that are valid to be assigned to Value:
+	//	*NumberDataPoint_AsDouble
+	//	*NumberDataPoint_AsInt
+	Value isNumberDataPoint_Value `protobuf_oneof:"value"`
 	// (Optional) List of exemplars collected from
 	// measurements that were used to form the data point
-	Exemplars            []*DoubleExemplar `protobuf:"bytes,5,rep,name=exemplars,proto3" json:"exemplars,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
-	XXX_unrecognized     []byte            `json:"-"`
-	XXX_sizecache        int32             `json:"-"`
+	Exemplars            []*Exemplar `protobuf:"bytes,5,rep,name=exemplars,proto3" json:"exemplars,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
+	XXX_unrecognized     []byte      `json:"-"`
+	XXX_sizecache        int32       `json:"-"`
 }
 
-func (m *DoubleDataPoint) Reset()         { *m = DoubleDataPoint{} }
-func (m *DoubleDataPoint) String() string { return proto.CompactTextString(m) }
-func (*DoubleDataPoint) ProtoMessage()    {}
-func (*DoubleDataPoint) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3c3112f9fa006917, []int{10}
+func (m *NumberDataPoint) Reset()         { *m = NumberDataPoint{} }
+func (m *NumberDataPoint) String() string { return proto.CompactTextString(m) }
+func (*NumberDataPoint) ProtoMessage()    {}
+func (*NumberDataPoint) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3c3112f9fa006917, []int{11}
 }
-func (m *DoubleDataPoint) XXX_Unmarshal(b []byte) error {
+func (m *NumberDataPoint) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *DoubleDataPoint) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *NumberDataPoint) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_DoubleDataPoint.Marshal(b, m, deterministic)
+		return xxx_messageInfo_NumberDataPoint.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -999,74 +1118,123 @@ func (m *DoubleDataPoint) XXX_Marshal(b []byte, deterministic bool) ([]byte, err
 		return b[:n], nil
 	}
 }
-func (m *DoubleDataPoint) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_DoubleDataPoint.Merge(m, src)
+func (m *NumberDataPoint) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NumberDataPoint.Merge(m, src)
 }
-func (m *DoubleDataPoint) XXX_Size() int {
+func (m *NumberDataPoint) XXX_Size() int {
 	return m.Size()
 }
-func (m *DoubleDataPoint) XXX_DiscardUnknown() {
-	xxx_messageInfo_DoubleDataPoint.DiscardUnknown(m)
+func (m *NumberDataPoint) XXX_DiscardUnknown() {
+	xxx_messageInfo_NumberDataPoint.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_NumberDataPoint proto.InternalMessageInfo
+
+type isNumberDataPoint_Value interface {
+	isNumberDataPoint_Value()
+	MarshalTo([]byte) (int, error)
+	Size() int
+}
+
+type NumberDataPoint_AsDouble struct {
+	AsDouble float64 `protobuf:"fixed64,4,opt,name=as_double,json=asDouble,proto3,oneof" json:"as_double,omitempty"`
+}
+type NumberDataPoint_AsInt struct {
+	AsInt int64 `protobuf:"fixed64,6,opt,name=as_int,json=asInt,proto3,oneof" json:"as_int,omitempty"`
+}
+
+func (*NumberDataPoint_AsDouble) isNumberDataPoint_Value() {}
+func (*NumberDataPoint_AsInt) isNumberDataPoint_Value()    {}
+
+func (m *NumberDataPoint) GetValue() isNumberDataPoint_Value {
+	if m != nil {
+		return m.Value
+	}
+	return nil
 }
 
-var xxx_messageInfo_DoubleDataPoint proto.InternalMessageInfo
+func (m *NumberDataPoint) GetAttributes() []*v11.KeyValue {
+	if m != nil {
+		return m.Attributes
+	}
+	return nil
+}
 
-func (m *DoubleDataPoint) GetLabels() []*v11.StringKeyValue {
+// Deprecated: Do not use.
+func (m *NumberDataPoint) GetLabels() []*v11.StringKeyValue {
 	if m != nil {
 		return m.Labels
 	}
 	return nil
 }
 
-func (m *DoubleDataPoint) GetStartTimeUnixNano() uint64 {
+func (m *NumberDataPoint) GetStartTimeUnixNano() uint64 {
 	if m != nil {
 		return m.StartTimeUnixNano
 	}
 	return 0
 }
 
-func (m *DoubleDataPoint) GetTimeUnixNano() uint64 {
+func (m *NumberDataPoint) GetTimeUnixNano() uint64 {
 	if m != nil {
 		return m.TimeUnixNano
 	}
 	return 0
 }
 
-func (m *DoubleDataPoint) GetValue() float64 {
-	if m != nil {
-		return m.Value
+func (m *NumberDataPoint) GetAsDouble() float64 {
+	if x, ok := m.GetValue().(*NumberDataPoint_AsDouble); ok {
+		return x.AsDouble
+	}
+	return 0
+}
+
+func (m *NumberDataPoint) GetAsInt() int64 {
+	if x, ok := m.GetValue().(*NumberDataPoint_AsInt); ok {
+		return x.AsInt
 	}
 	return 0
 }
 
-func (m *DoubleDataPoint) GetExemplars() []*DoubleExemplar {
+func (m *NumberDataPoint) GetExemplars() []*Exemplar {
 	if m != nil {
 		return m.Exemplars
 	}
 	return nil
 }
 
-// IntHistogramDataPoint is a single data point in a timeseries that describes
+// XXX_OneofWrappers is for the internal use of the proto package.
+func (*NumberDataPoint) XXX_OneofWrappers() []interface{} {
+	return []interface{}{
+		(*NumberDataPoint_AsDouble)(nil),
+		(*NumberDataPoint_AsInt)(nil),
+	}
+}
+
+// IntHistogramDataPoint is deprecated; use HistogramDataPoint.
+//
+// This is a single data point in a timeseries that describes
 // the time-varying values of a Histogram of int values. A Histogram contains
 // summary statistics for a population of values, it may optionally contain
 // the distribution of those values across a set of buckets.
+//
+// If the histogram contains the distribution of values, then both
+// "explicit_bounds" and "bucket counts" fields must be defined.
+// If the histogram does not contain the distribution of values, then both
+// "explicit_bounds" and "bucket_counts" must be omitted and only "count" and
+// "sum" are known.
+//
+// Deprecated: Do not use.
 type IntHistogramDataPoint struct {
 	// The set of labels that uniquely identify this timeseries.
 	Labels []*v11.StringKeyValue `protobuf:"bytes,1,rep,name=labels,proto3" json:"labels,omitempty"`
-	// start_time_unix_nano is the last time when the aggregation value was reset
-	// to "zero". For some metric types this is ignored, see data types for more
-	// details.
-	//
-	// The aggregation value is over the time interval (start_time_unix_nano,
-	// time_unix_nano].
+	// StartTimeUnixNano is optional but strongly encouraged, see the
+	// the detiled comments above Metric.
 	//
 	// Value is UNIX Epoch time in nanoseconds since 00:00:00 UTC on 1 January
 	// 1970.
-	//
-	// Value of 0 indicates that the timestamp is unspecified. In that case the
-	// timestamp may be decided by the backend.
 	StartTimeUnixNano uint64 `protobuf:"fixed64,2,opt,name=start_time_unix_nano,json=startTimeUnixNano,proto3" json:"start_time_unix_nano,omitempty"`
-	// time_unix_nano is the moment when this aggregation value was reported.
+	// TimeUnixNano is required, see the detailed comments above Metric.
 	//
 	// Value is UNIX Epoch time in nanoseconds since 00:00:00 UTC on 1 January
 	// 1970.
@@ -1088,19 +1256,19 @@ type IntHistogramDataPoint struct {
 	// the number of elements in explicit_bounds array.
 	BucketCounts []uint64 `protobuf:"fixed64,6,rep,packed,name=bucket_counts,json=bucketCounts,proto3" json:"bucket_counts,omitempty"`
 	// explicit_bounds specifies buckets with explicitly defined bounds for values.
-	// The bucket boundaries are described by "bounds" field.
 	//
-	// This defines size(bounds) + 1 (= N) buckets. The boundaries for bucket
-	// at index i are:
+	// This defines size(explicit_bounds) + 1 (= N) buckets. The boundaries for
+	// bucket at index i are:
+	//
+	// (-infinity, explicit_bounds[i]] for i == 0
+	// (explicit_bounds[i-1], explicit_bounds[i]] for 0 < i < N-1
+	// (explicit_bounds[i], +infinity) for i == N-1
 	//
-	// (-infinity, bounds[i]) for i == 0
-	// [bounds[i-1], bounds[i]) for 0 < i < N-1
-	// [bounds[i], +infinity) for i == N-1
-	// The values in bounds array must be strictly increasing.
+	// The values in the explicit_bounds array must be strictly increasing.
 	//
-	// Note: only [a, b) intervals are currently supported for each bucket except the first one.
-	// If we decide to also support (a, b] intervals we should add support for these by defining
-	// a boolean value which decides what type of intervals to use.
+	// Histogram buckets are inclusive of their upper boundary, except the last
+	// bucket where the boundary is at infinity. This format is intentionally
+	// compatible with the OpenMetrics histogram definition.
 	ExplicitBounds []float64 `protobuf:"fixed64,7,rep,packed,name=explicit_bounds,json=explicitBounds,proto3" json:"explicit_bounds,omitempty"`
 	// (Optional) List of exemplars collected from
 	// measurements that were used to form the data point
@@ -1114,7 +1282,7 @@ func (m *IntHistogramDataPoint) Reset()         { *m = IntHistogramDataPoint{} }
 func (m *IntHistogramDataPoint) String() string { return proto.CompactTextString(m) }
 func (*IntHistogramDataPoint) ProtoMessage()    {}
 func (*IntHistogramDataPoint) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3c3112f9fa006917, []int{11}
+	return fileDescriptor_3c3112f9fa006917, []int{12}
 }
 func (m *IntHistogramDataPoint) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1203,23 +1371,32 @@ func (m *IntHistogramDataPoint) GetExemplars() []*IntExemplar {
 // time-varying values of a Histogram of double values. A Histogram contains
 // summary statistics for a population of values, it may optionally contain the
 // distribution of those values across a set of buckets.
-type DoubleHistogramDataPoint struct {
-	// The set of labels that uniquely identify this timeseries.
-	Labels []*v11.StringKeyValue `protobuf:"bytes,1,rep,name=labels,proto3" json:"labels,omitempty"`
-	// start_time_unix_nano is the last time when the aggregation value was reset
-	// to "zero". For some metric types this is ignored, see data types for more
-	// details.
+//
+// If the histogram contains the distribution of values, then both
+// "explicit_bounds" and "bucket counts" fields must be defined.
+// If the histogram does not contain the distribution of values, then both
+// "explicit_bounds" and "bucket_counts" must be omitted and only "count" and
+// "sum" are known.
+type HistogramDataPoint struct {
+	// The set of key/value pairs that uniquely identify the timeseries from
+	// where this point belongs. The list may be empty (may contain 0 elements).
+	Attributes []*v11.KeyValue `protobuf:"bytes,9,rep,name=attributes,proto3" json:"attributes,omitempty"`
+	// Labels is deprecated and will be removed soon.
+	// 1. Old senders and receivers that are not aware of this change will
+	// continue using the `labels` field.
+	// 2. New senders, which are aware of this change MUST send only `attributes`.
+	// 3. New receivers, which are aware of this change MUST convert this into
+	// `labels` by simply converting all int64 values into float.
 	//
-	// The aggregation value is over the time interval (start_time_unix_nano,
-	// time_unix_nano].
+	// This field will be removed in ~3 months, on July 1, 2021.
+	Labels []*v11.StringKeyValue `protobuf:"bytes,1,rep,name=labels,proto3" json:"labels,omitempty"` // Deprecated: Do not use.
+	// StartTimeUnixNano is optional but strongly encouraged, see the
+	// the detiled comments above Metric.
 	//
 	// Value is UNIX Epoch time in nanoseconds since 00:00:00 UTC on 1 January
 	// 1970.
-	//
-	// Value of 0 indicates that the timestamp is unspecified. In that case the
-	// timestamp may be decided by the backend.
 	StartTimeUnixNano uint64 `protobuf:"fixed64,2,opt,name=start_time_unix_nano,json=startTimeUnixNano,proto3" json:"start_time_unix_nano,omitempty"`
-	// time_unix_nano is the moment when this aggregation value was reported.
+	// TimeUnixNano is required, see the detailed comments above Metric.
 	//
 	// Value is UNIX Epoch time in nanoseconds since 00:00:00 UTC on 1 January
 	// 1970.
@@ -1231,6 +1408,12 @@ type DoubleHistogramDataPoint struct {
 	// sum of the values in the population. If count is zero then this field
 	// must be zero. This value must be equal to the sum of the "sum" fields in
 	// buckets if a histogram is provided.
+	//
+	// Note: Sum should only be filled out when measuring non-negative discrete
+	// events, and is assumed to be monotonic over the values of these events.
+	// Negative events *can* be recorded, but sum should not be filled out when
+	// doing so.  This is specifically to enforce compatibility w/ OpenMetrics,
+	// see: https://github.com/OpenObservability/OpenMetrics/blob/main/specification/OpenMetrics.md#histogram
 	Sum float64 `protobuf:"fixed64,5,opt,name=sum,proto3" json:"sum,omitempty"`
 	// bucket_counts is an optional field contains the count values of histogram
 	// for each bucket.
@@ -1241,40 +1424,40 @@ type DoubleHistogramDataPoint struct {
 	// the number of elements in explicit_bounds array.
 	BucketCounts []uint64 `protobuf:"fixed64,6,rep,packed,name=bucket_counts,json=bucketCounts,proto3" json:"bucket_counts,omitempty"`
 	// explicit_bounds specifies buckets with explicitly defined bounds for values.
-	// The bucket boundaries are described by "bounds" field.
 	//
-	// This defines size(bounds) + 1 (= N) buckets. The boundaries for bucket
-	// at index i are:
+	// This defines size(explicit_bounds) + 1 (= N) buckets. The boundaries for
+	// bucket at index i are:
+	//
+	// (-infinity, explicit_bounds[i]] for i == 0
+	// (explicit_bounds[i-1], explicit_bounds[i]] for 0 < i < N-1
+	// (explicit_bounds[i], +infinity) for i == N-1
 	//
-	// (-infinity, bounds[i]) for i == 0
-	// [bounds[i-1], bounds[i]) for 0 < i < N-1
-	// [bounds[i], +infinity) for i == N-1
-	// The values in bounds array must be strictly increasing.
+	// The values in the explicit_bounds array must be strictly increasing.
 	//
-	// Note: only [a, b) intervals are currently supported for each bucket except the first one.
-	// If we decide to also support (a, b] intervals we should add support for these by defining
-	// a boolean value which decides what type of intervals to use.
+	// Histogram buckets are inclusive of their upper boundary, except the last
+	// bucket where the boundary is at infinity. This format is intentionally
+	// compatible with the OpenMetrics histogram definition.
 	ExplicitBounds []float64 `protobuf:"fixed64,7,rep,packed,name=explicit_bounds,json=explicitBounds,proto3" json:"explicit_bounds,omitempty"`
 	// (Optional) List of exemplars collected from
 	// measurements that were used to form the data point
-	Exemplars            []*DoubleExemplar `protobuf:"bytes,8,rep,name=exemplars,proto3" json:"exemplars,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
-	XXX_unrecognized     []byte            `json:"-"`
-	XXX_sizecache        int32             `json:"-"`
+	Exemplars            []*Exemplar `protobuf:"bytes,8,rep,name=exemplars,proto3" json:"exemplars,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
+	XXX_unrecognized     []byte      `json:"-"`
+	XXX_sizecache        int32       `json:"-"`
 }
 
-func (m *DoubleHistogramDataPoint) Reset()         { *m = DoubleHistogramDataPoint{} }
-func (m *DoubleHistogramDataPoint) String() string { return proto.CompactTextString(m) }
-func (*DoubleHistogramDataPoint) ProtoMessage()    {}
-func (*DoubleHistogramDataPoint) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3c3112f9fa006917, []int{12}
+func (m *HistogramDataPoint) Reset()         { *m = HistogramDataPoint{} }
+func (m *HistogramDataPoint) String() string { return proto.CompactTextString(m) }
+func (*HistogramDataPoint) ProtoMessage()    {}
+func (*HistogramDataPoint) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3c3112f9fa006917, []int{13}
 }
-func (m *DoubleHistogramDataPoint) XXX_Unmarshal(b []byte) error {
+func (m *HistogramDataPoint) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *DoubleHistogramDataPoint) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *HistogramDataPoint) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_DoubleHistogramDataPoint.Marshal(b, m, deterministic)
+		return xxx_messageInfo_HistogramDataPoint.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1284,78 +1467,284 @@ func (m *DoubleHistogramDataPoint) XXX_Marshal(b []byte, deterministic bool) ([]
 		return b[:n], nil
 	}
 }
-func (m *DoubleHistogramDataPoint) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_DoubleHistogramDataPoint.Merge(m, src)
+func (m *HistogramDataPoint) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HistogramDataPoint.Merge(m, src)
 }
-func (m *DoubleHistogramDataPoint) XXX_Size() int {
+func (m *HistogramDataPoint) XXX_Size() int {
 	return m.Size()
 }
-func (m *DoubleHistogramDataPoint) XXX_DiscardUnknown() {
-	xxx_messageInfo_DoubleHistogramDataPoint.DiscardUnknown(m)
+func (m *HistogramDataPoint) XXX_DiscardUnknown() {
+	xxx_messageInfo_HistogramDataPoint.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_DoubleHistogramDataPoint proto.InternalMessageInfo
+var xxx_messageInfo_HistogramDataPoint proto.InternalMessageInfo
+
+func (m *HistogramDataPoint) GetAttributes() []*v11.KeyValue {
+	if m != nil {
+		return m.Attributes
+	}
+	return nil
+}
 
-func (m *DoubleHistogramDataPoint) GetLabels() []*v11.StringKeyValue {
+// Deprecated: Do not use.
+func (m *HistogramDataPoint) GetLabels() []*v11.StringKeyValue {
 	if m != nil {
 		return m.Labels
 	}
 	return nil
 }
 
-func (m *DoubleHistogramDataPoint) GetStartTimeUnixNano() uint64 {
+func (m *HistogramDataPoint) GetStartTimeUnixNano() uint64 {
 	if m != nil {
 		return m.StartTimeUnixNano
 	}
 	return 0
 }
 
-func (m *DoubleHistogramDataPoint) GetTimeUnixNano() uint64 {
+func (m *HistogramDataPoint) GetTimeUnixNano() uint64 {
 	if m != nil {
 		return m.TimeUnixNano
 	}
 	return 0
 }
 
-func (m *DoubleHistogramDataPoint) GetCount() uint64 {
+func (m *HistogramDataPoint) GetCount() uint64 {
 	if m != nil {
 		return m.Count
 	}
 	return 0
 }
 
-func (m *DoubleHistogramDataPoint) GetSum() float64 {
+func (m *HistogramDataPoint) GetSum() float64 {
 	if m != nil {
 		return m.Sum
 	}
 	return 0
 }
 
-func (m *DoubleHistogramDataPoint) GetBucketCounts() []uint64 {
+func (m *HistogramDataPoint) GetBucketCounts() []uint64 {
 	if m != nil {
 		return m.BucketCounts
 	}
 	return nil
 }
 
-func (m *DoubleHistogramDataPoint) GetExplicitBounds() []float64 {
+func (m *HistogramDataPoint) GetExplicitBounds() []float64 {
 	if m != nil {
 		return m.ExplicitBounds
 	}
 	return nil
 }
 
-func (m *DoubleHistogramDataPoint) GetExemplars() []*DoubleExemplar {
+func (m *HistogramDataPoint) GetExemplars() []*Exemplar {
 	if m != nil {
 		return m.Exemplars
 	}
 	return nil
 }
 
+// SummaryDataPoint is a single data point in a timeseries that describes the
+// time-varying values of a Summary metric.
+type SummaryDataPoint struct {
+	// The set of key/value pairs that uniquely identify the timeseries from
+	// where this point belongs. The list may be empty (may contain 0 elements).
+	Attributes []*v11.KeyValue `protobuf:"bytes,7,rep,name=attributes,proto3" json:"attributes,omitempty"`
+	// Labels is deprecated and will be removed soon.
+	// 1. Old senders and receivers that are not aware of this change will
+	// continue using the `labels` field.
+	// 2. New senders, which are aware of this change MUST send only `attributes`.
+	// 3. New receivers, which are aware of this change MUST convert this into
+	// `labels` by simply converting all int64 values into float.
+	//
+	// This field will be removed in ~3 months, on July 1, 2021.
+	Labels []*v11.StringKeyValue `protobuf:"bytes,1,rep,name=labels,proto3" json:"labels,omitempty"` // Deprecated: Do not use.
+	// StartTimeUnixNano is optional but strongly encouraged, see the
+	// the detiled comments above Metric.
+	//
+	// Value is UNIX Epoch time in nanoseconds since 00:00:00 UTC on 1 January
+	// 1970.
+	StartTimeUnixNano uint64 `protobuf:"fixed64,2,opt,name=start_time_unix_nano,json=startTimeUnixNano,proto3" json:"start_time_unix_nano,omitempty"`
+	// TimeUnixNano is required, see the detailed comments above Metric.
+	//
+	// Value is UNIX Epoch time in nanoseconds since 00:00:00 UTC on 1 January
+	// 1970.
+	TimeUnixNano uint64 `protobuf:"fixed64,3,opt,name=time_unix_nano,json=timeUnixNano,proto3" json:"time_unix_nano,omitempty"`
+	// count is the number of values in the population. Must be non-negative.
+	Count uint64 `protobuf:"fixed64,4,opt,name=count,proto3" json:"count,omitempty"`
+	// sum of the values in the population. If count is zero then this field
+	// must be zero.
+	//
+	// Note: Sum should only be filled out when measuring non-negative discrete
+	// events, and is assumed to be monotonic over the values of these events.
+	// Negative events *can* be recorded, but sum should not be filled out when
+	// doing so.  This is specifically to enforce compatibility w/ OpenMetrics,
+	// see: https://github.com/OpenObservability/OpenMetrics/blob/main/specification/OpenMetrics.md#summary
+	Sum float64 `protobuf:"fixed64,5,opt,name=sum,proto3" json:"sum,omitempty"`
+	// (Optional) list of values at different quantiles of the distribution calculated
+	// from the current snapshot. The quantiles must be strictly increasing.
+	QuantileValues       []*SummaryDataPoint_ValueAtQuantile `protobuf:"bytes,6,rep,name=quantile_values,json=quantileValues,proto3" json:"quantile_values,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                            `json:"-"`
+	XXX_unrecognized     []byte                              `json:"-"`
+	XXX_sizecache        int32                               `json:"-"`
+}
+
+func (m *SummaryDataPoint) Reset()         { *m = SummaryDataPoint{} }
+func (m *SummaryDataPoint) String() string { return proto.CompactTextString(m) }
+func (*SummaryDataPoint) ProtoMessage()    {}
+func (*SummaryDataPoint) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3c3112f9fa006917, []int{14}
+}
+func (m *SummaryDataPoint) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SummaryDataPoint) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SummaryDataPoint.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SummaryDataPoint) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SummaryDataPoint.Merge(m, src)
+}
+func (m *SummaryDataPoint) XXX_Size() int {
+	return m.Size()
+}
+func (m *SummaryDataPoint) XXX_DiscardUnknown() {
+	xxx_messageInfo_SummaryDataPoint.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SummaryDataPoint proto.InternalMessageInfo
+
+func (m *SummaryDataPoint) GetAttributes() []*v11.KeyValue {
+	if m != nil {
+		return m.Attributes
+	}
+	return nil
+}
+
+// Deprecated: Do not use.
+func (m *SummaryDataPoint) GetLabels() []*v11.StringKeyValue {
+	if m != nil {
+		return m.Labels
+	}
+	return nil
+}
+
+func (m *SummaryDataPoint) GetStartTimeUnixNano() uint64 {
+	if m != nil {
+		return m.StartTimeUnixNano
+	}
+	return 0
+}
+
+func (m *SummaryDataPoint) GetTimeUnixNano() uint64 {
+	if m != nil {
+		return m.TimeUnixNano
+	}
+	return 0
+}
+
+func (m *SummaryDataPoint) GetCount() uint64 {
+	if m != nil {
+		return m.Count
+	}
+	return 0
+}
+
+func (m *SummaryDataPoint) GetSum() float64 {
+	if m != nil {
+		return m.Sum
+	}
+	return 0
+}
+
+func (m *SummaryDataPoint) GetQuantileValues() []*SummaryDataPoint_ValueAtQuantile {
+	if m != nil {
+		return m.QuantileValues
+	}
+	return nil
+}
+
+// Represents the value at a given quantile of a distribution.
+//
+// To record Min and Max values following conventions are used:
+// - The 1.0 quantile is equivalent to the maximum value observed.
+// - The 0.0 quantile is equivalent to the minimum value observed.
+//
+// See the following issue for more context:
+// https://github.com/open-telemetry/opentelemetry-proto/issues/125
+type SummaryDataPoint_ValueAtQuantile struct {
+	// The quantile of a distribution. Must be in the interval
+	// [0.0, 1.0].
+	Quantile float64 `protobuf:"fixed64,1,opt,name=quantile,proto3" json:"quantile,omitempty"`
+	// The value at the given quantile of a distribution.
+	//
+	// Quantile values must NOT be negative.
+	Value                float64  `protobuf:"fixed64,2,opt,name=value,proto3" json:"value,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SummaryDataPoint_ValueAtQuantile) Reset()         { *m = SummaryDataPoint_ValueAtQuantile{} }
+func (m *SummaryDataPoint_ValueAtQuantile) String() string { return proto.CompactTextString(m) }
+func (*SummaryDataPoint_ValueAtQuantile) ProtoMessage()    {}
+func (*SummaryDataPoint_ValueAtQuantile) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3c3112f9fa006917, []int{14, 0}
+}
+func (m *SummaryDataPoint_ValueAtQuantile) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SummaryDataPoint_ValueAtQuantile) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SummaryDataPoint_ValueAtQuantile.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SummaryDataPoint_ValueAtQuantile) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SummaryDataPoint_ValueAtQuantile.Merge(m, src)
+}
+func (m *SummaryDataPoint_ValueAtQuantile) XXX_Size() int {
+	return m.Size()
+}
+func (m *SummaryDataPoint_ValueAtQuantile) XXX_DiscardUnknown() {
+	xxx_messageInfo_SummaryDataPoint_ValueAtQuantile.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SummaryDataPoint_ValueAtQuantile proto.InternalMessageInfo
+
+func (m *SummaryDataPoint_ValueAtQuantile) GetQuantile() float64 {
+	if m != nil {
+		return m.Quantile
+	}
+	return 0
+}
+
+func (m *SummaryDataPoint_ValueAtQuantile) GetValue() float64 {
+	if m != nil {
+		return m.Value
+	}
+	return 0
+}
+
 // A representation of an exemplar, which is a sample input int measurement.
 // Exemplars also hold information about the environment when the measurement
 // was recorded, for example the span and trace ID of the active span when the
 // exemplar was recorded.
+//
+// Deprecated: Do not use.
 type IntExemplar struct {
 	// The set of labels that were filtered out by the aggregator, but recorded
 	// alongside the original measurement. Only labels that were filtered out
@@ -1385,7 +1774,7 @@ func (m *IntExemplar) Reset()         { *m = IntExemplar{} }
 func (m *IntExemplar) String() string { return proto.CompactTextString(m) }
 func (*IntExemplar) ProtoMessage()    {}
 func (*IntExemplar) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3c3112f9fa006917, []int{13}
+	return fileDescriptor_3c3112f9fa006917, []int{15}
 }
 func (m *IntExemplar) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1449,22 +1838,38 @@ func (m *IntExemplar) GetTraceId() []byte {
 	return nil
 }
 
-// A representation of an exemplar, which is a sample input double measurement.
+// A representation of an exemplar, which is a sample input measurement.
 // Exemplars also hold information about the environment when the measurement
 // was recorded, for example the span and trace ID of the active span when the
 // exemplar was recorded.
-type DoubleExemplar struct {
-	// The set of labels that were filtered out by the aggregator, but recorded
-	// alongside the original measurement. Only labels that were filtered out
-	// by the aggregator should be included
-	FilteredLabels []*v11.StringKeyValue `protobuf:"bytes,1,rep,name=filtered_labels,json=filteredLabels,proto3" json:"filtered_labels,omitempty"`
+type Exemplar struct {
+	// The set of key/value pairs that were filtered out by the aggregator, but
+	// recorded alongside the original measurement. Only key/value pairs that were
+	// filtered out by the aggregator should be included
+	FilteredAttributes []*v11.KeyValue `protobuf:"bytes,7,rep,name=filtered_attributes,json=filteredAttributes,proto3" json:"filtered_attributes,omitempty"`
+	// Labels is deprecated and will be removed soon.
+	// 1. Old senders and receivers that are not aware of this change will
+	// continue using the `filtered_labels` field.
+	// 2. New senders, which are aware of this change MUST send only
+	// `filtered_attributes`.
+	// 3. New receivers, which are aware of this change MUST convert this into
+	// `filtered_labels` by simply converting all int64 values into float.
+	//
+	// This field will be removed in ~3 months, on July 1, 2021.
+	FilteredLabels []*v11.StringKeyValue `protobuf:"bytes,1,rep,name=filtered_labels,json=filteredLabels,proto3" json:"filtered_labels,omitempty"` // Deprecated: Do not use.
 	// time_unix_nano is the exact time when this exemplar was recorded
 	//
 	// Value is UNIX Epoch time in nanoseconds since 00:00:00 UTC on 1 January
 	// 1970.
 	TimeUnixNano uint64 `protobuf:"fixed64,2,opt,name=time_unix_nano,json=timeUnixNano,proto3" json:"time_unix_nano,omitempty"`
-	// Numerical double value of the measurement that was recorded.
-	Value float64 `protobuf:"fixed64,3,opt,name=value,proto3" json:"value,omitempty"`
+	// Numerical value of the measurement that was recorded. An exemplar is
+	// considered invalid when one of the recognized value fields is not present
+	// inside this oneof.
+	//
+	// Types that are valid to be assigned to Value:
+	//	*Exemplar_AsDouble
+	//	*Exemplar_AsInt
+	Value isExemplar_Value `protobuf_oneof:"value"`
 	// (Optional) Span ID of the exemplar trace.
 	// span_id may be missing if the measurement is not recorded inside a trace
 	// or if the trace is not sampled.
@@ -1478,18 +1883,18 @@ type DoubleExemplar struct {
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *DoubleExemplar) Reset()         { *m = DoubleExemplar{} }
-func (m *DoubleExemplar) String() string { return proto.CompactTextString(m) }
-func (*DoubleExemplar) ProtoMessage()    {}
-func (*DoubleExemplar) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3c3112f9fa006917, []int{14}
+func (m *Exemplar) Reset()         { *m = Exemplar{} }
+func (m *Exemplar) String() string { return proto.CompactTextString(m) }
+func (*Exemplar) ProtoMessage()    {}
+func (*Exemplar) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3c3112f9fa006917, []int{16}
 }
-func (m *DoubleExemplar) XXX_Unmarshal(b []byte) error {
+func (m *Exemplar) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *DoubleExemplar) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *Exemplar) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_DoubleExemplar.Marshal(b, m, deterministic)
+		return xxx_messageInfo_Exemplar.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1499,70 +1904,119 @@ func (m *DoubleExemplar) XXX_Marshal(b []byte, deterministic bool) ([]byte, erro
 		return b[:n], nil
 	}
 }
-func (m *DoubleExemplar) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_DoubleExemplar.Merge(m, src)
+func (m *Exemplar) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Exemplar.Merge(m, src)
 }
-func (m *DoubleExemplar) XXX_Size() int {
+func (m *Exemplar) XXX_Size() int {
 	return m.Size()
 }
-func (m *DoubleExemplar) XXX_DiscardUnknown() {
-	xxx_messageInfo_DoubleExemplar.DiscardUnknown(m)
+func (m *Exemplar) XXX_DiscardUnknown() {
+	xxx_messageInfo_Exemplar.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_DoubleExemplar proto.InternalMessageInfo
+var xxx_messageInfo_Exemplar proto.InternalMessageInfo
 
-func (m *DoubleExemplar) GetFilteredLabels() []*v11.StringKeyValue {
-	if m != nil {
-		return m.FilteredLabels
-	}
-	return nil
+type isExemplar_Value interface {
+	isExemplar_Value()
+	MarshalTo([]byte) (int, error)
+	Size() int
 }
 
-func (m *DoubleExemplar) GetTimeUnixNano() uint64 {
-	if m != nil {
+type Exemplar_AsDouble struct {
+	AsDouble float64 `protobuf:"fixed64,3,opt,name=as_double,json=asDouble,proto3,oneof" json:"as_double,omitempty"`
+}
+type Exemplar_AsInt struct {
+	AsInt int64 `protobuf:"fixed64,6,opt,name=as_int,json=asInt,proto3,oneof" json:"as_int,omitempty"`
+}
+
+func (*Exemplar_AsDouble) isExemplar_Value() {}
+func (*Exemplar_AsInt) isExemplar_Value()    {}
+
+func (m *Exemplar) GetValue() isExemplar_Value {
+	if m != nil {
+		return m.Value
+	}
+	return nil
+}
+
+func (m *Exemplar) GetFilteredAttributes() []*v11.KeyValue {
+	if m != nil {
+		return m.FilteredAttributes
+	}
+	return nil
+}
+
+// Deprecated: Do not use.
+func (m *Exemplar) GetFilteredLabels() []*v11.StringKeyValue {
+	if m != nil {
+		return m.FilteredLabels
+	}
+	return nil
+}
+
+func (m *Exemplar) GetTimeUnixNano() uint64 {
+	if m != nil {
 		return m.TimeUnixNano
 	}
 	return 0
 }
 
-func (m *DoubleExemplar) GetValue() float64 {
-	if m != nil {
-		return m.Value
+func (m *Exemplar) GetAsDouble() float64 {
+	if x, ok := m.GetValue().(*Exemplar_AsDouble); ok {
+		return x.AsDouble
 	}
 	return 0
 }
 
-func (m *DoubleExemplar) GetSpanId() []byte {
+func (m *Exemplar) GetAsInt() int64 {
+	if x, ok := m.GetValue().(*Exemplar_AsInt); ok {
+		return x.AsInt
+	}
+	return 0
+}
+
+func (m *Exemplar) GetSpanId() []byte {
 	if m != nil {
 		return m.SpanId
 	}
 	return nil
 }
 
-func (m *DoubleExemplar) GetTraceId() []byte {
+func (m *Exemplar) GetTraceId() []byte {
 	if m != nil {
 		return m.TraceId
 	}
 	return nil
 }
 
+// XXX_OneofWrappers is for the internal use of the proto package.
+func (*Exemplar) XXX_OneofWrappers() []interface{} {
+	return []interface{}{
+		(*Exemplar_AsDouble)(nil),
+		(*Exemplar_AsInt)(nil),
+	}
+}
+
 func init() {
 	proto.RegisterEnum("opentelemetry.proto.metrics.v1.AggregationTemporality", AggregationTemporality_name, AggregationTemporality_value)
 	proto.RegisterType((*ResourceMetrics)(nil), "opentelemetry.proto.metrics.v1.ResourceMetrics")
 	proto.RegisterType((*InstrumentationLibraryMetrics)(nil), "opentelemetry.proto.metrics.v1.InstrumentationLibraryMetrics")
 	proto.RegisterType((*Metric)(nil), "opentelemetry.proto.metrics.v1.Metric")
 	proto.RegisterType((*IntGauge)(nil), "opentelemetry.proto.metrics.v1.IntGauge")
-	proto.RegisterType((*DoubleGauge)(nil), "opentelemetry.proto.metrics.v1.DoubleGauge")
+	proto.RegisterType((*Gauge)(nil), "opentelemetry.proto.metrics.v1.Gauge")
 	proto.RegisterType((*IntSum)(nil), "opentelemetry.proto.metrics.v1.IntSum")
-	proto.RegisterType((*DoubleSum)(nil), "opentelemetry.proto.metrics.v1.DoubleSum")
+	proto.RegisterType((*Sum)(nil), "opentelemetry.proto.metrics.v1.Sum")
 	proto.RegisterType((*IntHistogram)(nil), "opentelemetry.proto.metrics.v1.IntHistogram")
-	proto.RegisterType((*DoubleHistogram)(nil), "opentelemetry.proto.metrics.v1.DoubleHistogram")
+	proto.RegisterType((*Histogram)(nil), "opentelemetry.proto.metrics.v1.Histogram")
+	proto.RegisterType((*Summary)(nil), "opentelemetry.proto.metrics.v1.Summary")
 	proto.RegisterType((*IntDataPoint)(nil), "opentelemetry.proto.metrics.v1.IntDataPoint")
-	proto.RegisterType((*DoubleDataPoint)(nil), "opentelemetry.proto.metrics.v1.DoubleDataPoint")
+	proto.RegisterType((*NumberDataPoint)(nil), "opentelemetry.proto.metrics.v1.NumberDataPoint")
 	proto.RegisterType((*IntHistogramDataPoint)(nil), "opentelemetry.proto.metrics.v1.IntHistogramDataPoint")
-	proto.RegisterType((*DoubleHistogramDataPoint)(nil), "opentelemetry.proto.metrics.v1.DoubleHistogramDataPoint")
+	proto.RegisterType((*HistogramDataPoint)(nil), "opentelemetry.proto.metrics.v1.HistogramDataPoint")
+	proto.RegisterType((*SummaryDataPoint)(nil), "opentelemetry.proto.metrics.v1.SummaryDataPoint")
+	proto.RegisterType((*SummaryDataPoint_ValueAtQuantile)(nil), "opentelemetry.proto.metrics.v1.SummaryDataPoint.ValueAtQuantile")
 	proto.RegisterType((*IntExemplar)(nil), "opentelemetry.proto.metrics.v1.IntExemplar")
-	proto.RegisterType((*DoubleExemplar)(nil), "opentelemetry.proto.metrics.v1.DoubleExemplar")
+	proto.RegisterType((*Exemplar)(nil), "opentelemetry.proto.metrics.v1.Exemplar")
 }
 
 func init() {
@@ -1570,75 +2024,90 @@ func init() {
 }
 
 var fileDescriptor_3c3112f9fa006917 = []byte{
-	// 1086 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x58, 0x41, 0x6f, 0x1b, 0x45,
-	0x14, 0xce, 0xd8, 0xc9, 0xda, 0x7e, 0x76, 0x13, 0x33, 0x2a, 0xed, 0x52, 0xa9, 0x21, 0x71, 0x51,
-	0x1a, 0xda, 0xc6, 0x56, 0x82, 0x8a, 0xb8, 0x20, 0xe1, 0x24, 0x26, 0x31, 0x38, 0xa9, 0x35, 0x71,
-	0x22, 0x05, 0x21, 0xad, 0xc6, 0xde, 0xc1, 0x8c, 0xd8, 0x9d, 0xb1, 0x76, 0x67, 0xa3, 0xe4, 0x07,
-	0x70, 0x83, 0x13, 0xfc, 0x17, 0xfe, 0x02, 0x47, 0x38, 0x20, 0xb8, 0x20, 0x55, 0xb9, 0x72, 0xe2,
-	0xc2, 0x89, 0x03, 0xda, 0xd9, 0xdd, 0xc4, 0x4e, 0x36, 0xb1, 0xab, 0x14, 0x29, 0xbd, 0xbd, 0x79,
-	0xf3, 0xde, 0x37, 0xdf, 0xfb, 0xde, 0x7b, 0xde, 0x36, 0xf0, 0x4c, 0x0e, 0x98, 0x50, 0xcc, 0x61,
-	0x2e, 0x53, 0xde, 0x49, 0x6d, 0xe0, 0x49, 0x25, 0x6b, 0xa1, 0xcd, 0x7b, 0x7e, 0xed, 0x68, 0x35,
-	0x31, 0xab, 0xfa, 0x02, 0xcf, 0x8f, 0x44, 0x47, 0xce, 0x6a, 0x12, 0x72, 0xb4, 0xfa, 0xe0, 0x49,
-	0x1a, 0x5a, 0x4f, 0xba, 0xae, 0x14, 0x21, 0x58, 0x64, 0x45, 0x69, 0x0f, 0xaa, 0x69, 0xb1, 0x1e,
-	0xf3, 0x65, 0xe0, 0xf5, 0x58, 0x18, 0x9d, 0xd8, 0x51, 0x7c, 0xe5, 0x25, 0x82, 0x39, 0x12, 0xbb,
-	0x76, 0xa2, 0x27, 0x71, 0x03, 0xf2, 0x49, 0x94, 0x89, 0x16, 0xd0, 0x72, 0x71, 0xed, 0xfd, 0x6a,
-	0x1a, 0xc5, 0x33, 0xa8, 0xa3, 0xd5, 0x6a, 0x82, 0x41, 0xce, 0x52, 0xf1, 0xb7, 0x08, 0xde, 0xe5,
-	0xc2, 0x57, 0x5e, 0xe0, 0x32, 0xa1, 0xa8, 0xe2, 0x52, 0x58, 0x0e, 0xef, 0x7a, 0xd4, 0x3b, 0xb1,
-	0xe2, 0xea, 0xcc, 0xcc, 0x42, 0x76, 0xb9, 0xb8, 0xf6, 0x71, 0xf5, 0x7a, 0x05, 0xaa, 0xcd, 0x51,
-	0x98, 0x56, 0x84, 0x12, 0xf3, 0x25, 0x0f, 0xf9, 0x75, 0xd7, 0x95, 0x5f, 0x11, 0x3c, 0xbc, 0x16,
-	0x00, 0x0b, 0xb8, 0x7f, 0x05, 0xd1, 0xb8, 0xfe, 0xe7, 0xa9, 0x04, 0x63, 0xe1, 0xaf, 0xe4, 0x47,
-	0xee, 0xa5, 0x13, 0xc3, 0x9f, 0x40, 0x6e, 0x54, 0x80, 0xa5, 0x71, 0x02, 0x44, 0x4c, 0x49, 0x92,
-	0x56, 0xf9, 0x69, 0x1a, 0x8c, 0xc8, 0x87, 0x31, 0x4c, 0x0b, 0xea, 0x46, 0x9d, 0x2a, 0x10, 0x6d,
-	0xe3, 0x05, 0x28, 0xda, 0xcc, 0xef, 0x79, 0x7c, 0x10, 0x3e, 0x6b, 0x66, 0xf4, 0xd5, 0xb0, 0x2b,
-	0xcc, 0x0a, 0x04, 0x57, 0x66, 0x36, 0xca, 0x0a, 0x6d, 0xbc, 0x05, 0x05, 0x2e, 0x94, 0xd5, 0xa7,
-	0x41, 0x9f, 0x99, 0xd3, 0xba, 0xf0, 0xe5, 0xf1, 0x9d, 0x51, 0x5b, 0x61, 0xfc, 0xf6, 0x14, 0xc9,
-	0xf3, 0xd8, 0xc6, 0x6d, 0x28, 0xd9, 0x32, 0xe8, 0x3a, 0x2c, 0xc6, 0x9a, 0xd1, 0x58, 0x4f, 0xc7,
-	0x61, 0x6d, 0xea, 0x9c, 0x04, 0xae, 0x68, 0x9f, 0x1f, 0x71, 0x1d, 0x72, 0x21, 0x35, 0x3f, 0x70,
-	0x4d, 0x43, 0x83, 0x2d, 0x4d, 0x40, 0x6c, 0x2f, 0x70, 0xb7, 0xa7, 0x88, 0xc1, 0xb5, 0x85, 0x3f,
-	0x03, 0x88, 0x49, 0x85, 0x28, 0xb9, 0x6b, 0xe6, 0xfa, 0x12, 0xa5, 0x08, 0xa8, 0x60, 0x27, 0x07,
-	0xbc, 0x07, 0x77, 0x42, 0x3a, 0x5f, 0x73, 0x5f, 0xc9, 0xbe, 0x47, 0x5d, 0x33, 0xaf, 0xe1, 0x9e,
-	0x4d, 0x40, 0x6a, 0x3b, 0xc9, 0xd9, 0x9e, 0x22, 0x25, 0x3e, 0x74, 0xc6, 0x5f, 0x42, 0x39, 0x26,
-	0x78, 0x8e, 0x5b, 0xd0, 0xb8, 0xb5, 0xc9, 0x68, 0x0e, 0x43, 0xcf, 0xd9, 0xa3, 0xae, 0x75, 0x03,
-	0xa6, 0x6d, 0xaa, 0x68, 0xe5, 0x10, 0xf2, 0x49, 0xcf, 0xf0, 0x0e, 0x14, 0x43, 0x9f, 0x35, 0x90,
-	0x5c, 0x28, 0xdf, 0x44, 0x7a, 0x16, 0x27, 0x29, 0x62, 0x93, 0x2a, 0xda, 0x0e, 0x93, 0x08, 0xd8,
-	0x89, 0xe9, 0x57, 0x2c, 0x28, 0x0e, 0xb5, 0x10, 0xb7, 0xd3, 0xd0, 0x27, 0x2c, 0x25, 0xfd, 0x81,
-	0xbf, 0x10, 0x18, 0x51, 0x5f, 0x5f, 0x33, 0x75, 0x2c, 0xe1, 0x3e, 0xed, 0xf7, 0x3d, 0xd6, 0x8f,
-	0xb6, 0x5f, 0x31, 0x77, 0x20, 0x3d, 0xea, 0x70, 0x75, 0xa2, 0x97, 0x67, 0x76, 0xed, 0xc3, 0x71,
-	0xd0, 0xf5, 0xf3, 0xf4, 0xce, 0x79, 0x36, 0xb9, 0x47, 0x53, 0xfd, 0x78, 0x11, 0x4a, 0xdc, 0xb7,
-	0x5c, 0x29, 0xa4, 0x92, 0x82, 0xf7, 0xf4, 0x1e, 0xe6, 0x49, 0x91, 0xfb, 0x3b, 0x89, 0xab, 0xf2,
-	0x37, 0x82, 0xc2, 0xd9, 0xfc, 0xbd, 0x7e, 0x35, 0x6f, 0x65, 0xcd, 0xbf, 0x23, 0x28, 0x0d, 0x2f,
-	0x09, 0x3e, 0x48, 0x2b, 0xfb, 0xf9, 0xab, 0xec, 0xd9, 0xed, 0x28, 0xbe, 0xf2, 0x27, 0x82, 0xb9,
-	0x0b, 0x6b, 0x8a, 0x0f, 0xd3, 0x8a, 0xfb, 0xe8, 0x15, 0x97, 0xfd, 0x96, 0xd4, 0xf7, 0x7d, 0x46,
-	0x77, 0xee, 0x8c, 0x0d, 0x6e, 0x80, 0xe1, 0xd0, 0x2e, 0x73, 0x92, 0xba, 0x56, 0xc6, 0x7c, 0x43,
-	0xf7, 0x94, 0xc7, 0x45, 0xff, 0x73, 0x76, 0x72, 0x40, 0x9d, 0x80, 0x91, 0x38, 0x19, 0xd7, 0xe0,
-	0xae, 0xaf, 0xa8, 0xa7, 0x2c, 0xc5, 0x5d, 0x66, 0x05, 0x82, 0x1f, 0x5b, 0x82, 0x0a, 0xa9, 0xab,
-	0x30, 0xc8, 0x5b, 0xfa, 0xae, 0xc3, 0x5d, 0xb6, 0x2f, 0xf8, 0xf1, 0x2e, 0x15, 0x12, 0xbf, 0x07,
-	0xb3, 0x17, 0x42, 0xb3, 0x3a, 0xb4, 0xa4, 0x86, 0xa3, 0xee, 0xc2, 0xcc, 0x51, 0xf8, 0x8e, 0xfe,
-	0xce, 0x95, 0x49, 0x74, 0xc0, 0x4d, 0x28, 0xb0, 0x63, 0xe6, 0x0e, 0x1c, 0xea, 0xf9, 0xe6, 0x8c,
-	0xa6, 0xfd, 0x74, 0x82, 0x59, 0x6b, 0xc4, 0x39, 0xe4, 0x3c, 0xbb, 0xf2, 0x43, 0x26, 0xe9, 0xf7,
-	0x1b, 0x29, 0x09, 0x4a, 0x24, 0x69, 0x5d, 0x96, 0xa4, 0x3a, 0xd9, 0x84, 0xa6, 0xa9, 0xf2, 0x4f,
-	0x06, 0xde, 0x4e, 0x5d, 0xce, 0xdb, 0xaf, 0x4d, 0x4f, 0x06, 0x42, 0x69, 0x6d, 0x0c, 0x12, 0x1d,
-	0x70, 0x19, 0xb2, 0xe1, 0xbf, 0x25, 0x66, 0xf4, 0x08, 0x85, 0x26, 0x7e, 0x04, 0x77, 0xba, 0x41,
-	0xef, 0x1b, 0xa6, 0x2c, 0x1d, 0xe1, 0x9b, 0xc6, 0x42, 0x36, 0x04, 0x8b, 0x9c, 0x1b, 0xda, 0x87,
-	0x1f, 0xc3, 0x1c, 0x3b, 0x1e, 0x38, 0xbc, 0xc7, 0x95, 0xd5, 0x95, 0x81, 0xb0, 0x7d, 0x33, 0xb7,
-	0x90, 0x5d, 0x46, 0x64, 0x36, 0x71, 0xaf, 0x6b, 0xef, 0xe8, 0x38, 0xe6, 0x6f, 0x34, 0x8e, 0xff,
-	0x66, 0xc0, 0xbc, 0xea, 0x87, 0xe3, 0x4d, 0xd7, 0x1e, 0xfd, 0x1f, 0xda, 0xb7, 0x2e, 0x6b, 0x7f,
-	0x83, 0xb9, 0xff, 0x0d, 0x41, 0x71, 0xa8, 0x33, 0xf8, 0x00, 0xe6, 0xbe, 0xe2, 0x8e, 0x62, 0x1e,
-	0xb3, 0xad, 0x9b, 0x48, 0x3f, 0x9b, 0xa0, 0xb4, 0xa2, 0x16, 0x5c, 0x56, 0x34, 0x73, 0xdd, 0xa6,
-	0x67, 0x87, 0x7f, 0xfc, 0xee, 0x43, 0xce, 0x1f, 0x50, 0x61, 0x71, 0x5b, 0x2b, 0x5d, 0x22, 0x46,
-	0x78, 0x6c, 0xda, 0xf8, 0x1d, 0xc8, 0x2b, 0x8f, 0xf6, 0x58, 0x78, 0x33, 0xa3, 0x6f, 0x72, 0xfa,
-	0xdc, 0xb4, 0x2b, 0x7f, 0x20, 0x98, 0x1d, 0xad, 0xfa, 0x36, 0x95, 0x86, 0x6e, 0x50, 0xda, 0x93,
-	0xef, 0x10, 0xdc, 0x4b, 0xff, 0x06, 0xe2, 0xc7, 0xf0, 0xa8, 0xbe, 0xb5, 0x45, 0x1a, 0x5b, 0xf5,
-	0x4e, 0xf3, 0xc5, 0xae, 0xd5, 0x69, 0xec, 0xb4, 0x5f, 0x90, 0x7a, 0xab, 0xd9, 0x39, 0xb4, 0xf6,
-	0x77, 0xf7, 0xda, 0x8d, 0x8d, 0xe6, 0xa7, 0xcd, 0xc6, 0x66, 0x79, 0x0a, 0x2f, 0xc2, 0xc3, 0xab,
-	0x02, 0x37, 0x1b, 0xad, 0x4e, 0xbd, 0x8c, 0xf0, 0x12, 0x54, 0xae, 0x0a, 0xd9, 0xd8, 0xdf, 0xd9,
-	0x6f, 0xd5, 0x3b, 0xcd, 0x83, 0x46, 0x39, 0xb3, 0xfe, 0x23, 0xfa, 0xf9, 0x74, 0x1e, 0xfd, 0x72,
-	0x3a, 0x8f, 0x5e, 0x9e, 0xce, 0x23, 0x58, 0xe4, 0x72, 0xcc, 0x34, 0xae, 0x97, 0xe2, 0xff, 0xde,
-	0xb6, 0xc3, 0x8b, 0x36, 0xfa, 0x62, 0xaf, 0x7f, 0x31, 0x85, 0xcb, 0x9a, 0x54, 0xcc, 0xa9, 0xb1,
-	0xe3, 0x81, 0xf4, 0x14, 0xf3, 0xfc, 0x9a, 0x54, 0xce, 0xa0, 0xc6, 0x85, 0x62, 0x9e, 0xa0, 0x4e,
-	0x6d, 0x24, 0x7a, 0x45, 0x3f, 0xb0, 0xd2, 0x67, 0x62, 0xe8, 0x2f, 0x19, 0x5d, 0x43, 0x7b, 0x3f,
-	0xf8, 0x2f, 0x00, 0x00, 0xff, 0xff, 0x7c, 0x51, 0xc2, 0xe2, 0xf2, 0x10, 0x00, 0x00,
+	// 1320 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe4, 0x58, 0x4f, 0x6f, 0x1b, 0x45,
+	0x14, 0xcf, 0x78, 0xe3, 0x7f, 0xcf, 0x69, 0x62, 0x86, 0xd2, 0x2c, 0x91, 0x12, 0x5c, 0x17, 0xda,
+	0x50, 0x5a, 0x9b, 0x06, 0x15, 0x24, 0xa4, 0x4a, 0x75, 0x12, 0x37, 0x59, 0x35, 0x49, 0xd3, 0x89,
+	0x13, 0x68, 0x05, 0x5a, 0x8d, 0xed, 0xc1, 0x1d, 0xb1, 0x3b, 0x6b, 0x76, 0x67, 0xa3, 0xe4, 0x03,
+	0xf4, 0xc6, 0x05, 0x89, 0xaf, 0xc3, 0x9d, 0x1b, 0x70, 0xe0, 0x80, 0x7a, 0x41, 0xbd, 0x72, 0x80,
+	0x33, 0x17, 0xd0, 0xce, 0xee, 0xfa, 0x4f, 0xba, 0x89, 0xdd, 0x34, 0x95, 0x22, 0xb8, 0xcd, 0xbc,
+	0x79, 0xef, 0xb7, 0xef, 0xfd, 0xde, 0xbc, 0xf7, 0xc6, 0x86, 0x1b, 0x4e, 0x97, 0x09, 0xc9, 0x2c,
+	0x66, 0x33, 0xe9, 0x1e, 0x56, 0xbb, 0xae, 0x23, 0x9d, 0x6a, 0xb0, 0xe6, 0x2d, 0xaf, 0xba, 0x7f,
+	0x2b, 0x5e, 0x56, 0xd4, 0x01, 0x5e, 0x18, 0xd2, 0x0e, 0x85, 0x95, 0x58, 0x65, 0xff, 0xd6, 0xdc,
+	0xf5, 0x24, 0xb4, 0x96, 0x63, 0xdb, 0x8e, 0x08, 0xc0, 0xc2, 0x55, 0x68, 0x36, 0x57, 0x49, 0xd2,
+	0x75, 0x99, 0xe7, 0xf8, 0x6e, 0x8b, 0x05, 0xda, 0xf1, 0x3a, 0xd4, 0x2f, 0xff, 0x83, 0x60, 0x86,
+	0x44, 0xa2, 0xcd, 0xf0, 0x93, 0xb8, 0x0e, 0xb9, 0x58, 0x4b, 0x47, 0x25, 0xb4, 0x58, 0x58, 0x7a,
+	0xbf, 0x92, 0xe4, 0x62, 0x0f, 0x6a, 0xff, 0x56, 0x25, 0xc6, 0x20, 0x3d, 0x53, 0xfc, 0x14, 0xc1,
+	0x3b, 0x5c, 0x78, 0xd2, 0xf5, 0x6d, 0x26, 0x24, 0x95, 0xdc, 0x11, 0xa6, 0xc5, 0x9b, 0x2e, 0x75,
+	0x0f, 0xcd, 0x28, 0x3a, 0x3d, 0x55, 0xd2, 0x16, 0x0b, 0x4b, 0x77, 0x2a, 0x27, 0x33, 0x50, 0x31,
+	0x86, 0x61, 0x36, 0x42, 0x94, 0xc8, 0x5f, 0x32, 0xcf, 0x4f, 0x3a, 0xc6, 0xf3, 0x00, 0x5e, 0xeb,
+	0x09, 0xb3, 0xa9, 0xe9, 0xbb, 0x96, 0xae, 0x95, 0xd0, 0x62, 0x9e, 0xe4, 0x43, 0xc9, 0xae, 0x6b,
+	0x95, 0xff, 0x42, 0x30, 0x7f, 0x22, 0x3e, 0x16, 0x30, 0x7b, 0x4c, 0x1c, 0x11, 0x3d, 0xb7, 0x13,
+	0xfd, 0x8f, 0xf2, 0x72, 0xac, 0xfb, 0xe4, 0x52, 0xb2, 0xdf, 0xf8, 0x2e, 0x64, 0x87, 0xf9, 0xb9,
+	0x3a, 0x8a, 0x9f, 0xd0, 0x53, 0x92, 0xb5, 0xc7, 0x0b, 0xf9, 0xa7, 0x49, 0xc8, 0x84, 0x26, 0x18,
+	0xc3, 0xa4, 0xa0, 0x76, 0x98, 0xe7, 0x3c, 0x51, 0x6b, 0x5c, 0x82, 0x42, 0x9b, 0x79, 0x2d, 0x97,
+	0x77, 0x03, 0xaf, 0xf4, 0x94, 0x3a, 0x1a, 0x14, 0x05, 0x56, 0xbe, 0xe0, 0x32, 0x42, 0x56, 0x6b,
+	0x7c, 0x1f, 0xf2, 0x5c, 0x48, 0xb3, 0x43, 0xfd, 0x0e, 0xd3, 0x27, 0x15, 0x2f, 0x8b, 0xa3, 0xf3,
+	0x2a, 0xd7, 0x02, 0xfd, 0xe5, 0x94, 0x8e, 0xd6, 0x27, 0x48, 0x8e, 0x47, 0x7b, 0x7c, 0x07, 0xd2,
+	0x21, 0x50, 0x5a, 0x01, 0xbd, 0x37, 0x0a, 0x48, 0x59, 0xad, 0x4f, 0x90, 0xd0, 0x0a, 0xd7, 0x21,
+	0x1b, 0xf8, 0xe2, 0xf9, 0xb6, 0x9e, 0x29, 0xa1, 0x71, 0x18, 0x34, 0x84, 0xdc, 0xf1, 0xed, 0xc8,
+	0x8f, 0x0c, 0x57, 0x3b, 0xfc, 0x09, 0x68, 0x01, 0x44, 0x56, 0x41, 0x5c, 0x19, 0x05, 0xb1, 0xe3,
+	0xdb, 0xeb, 0x13, 0x24, 0xb0, 0xc0, 0x9f, 0xc1, 0x85, 0xe0, 0xfb, 0x4f, 0xb8, 0x27, 0x9d, 0x8e,
+	0x4b, 0x6d, 0x3d, 0xa7, 0x20, 0x6e, 0x8c, 0xe1, 0xc5, 0x7a, 0x6c, 0x13, 0xf9, 0x32, 0xc5, 0x07,
+	0x64, 0xd8, 0x80, 0x7c, 0x1f, 0x34, 0x7f, 0x42, 0x6d, 0x0e, 0x80, 0xf6, 0xac, 0xd7, 0x27, 0x48,
+	0xdf, 0x1a, 0xaf, 0x40, 0xd6, 0xf3, 0x6d, 0x3b, 0xb8, 0xc5, 0x05, 0x05, 0x74, 0x6d, 0x8c, 0x00,
+	0x03, 0xf5, 0xf5, 0x09, 0x12, 0x5b, 0x2e, 0x67, 0x60, 0xb2, 0x4d, 0x25, 0x2d, 0x7f, 0x09, 0xb9,
+	0x38, 0x97, 0x78, 0x13, 0x0a, 0x81, 0xcc, 0xec, 0x3a, 0x5c, 0x48, 0x4f, 0x47, 0x25, 0x6d, 0xcc,
+	0xd0, 0x57, 0xa9, 0xa4, 0xdb, 0x81, 0x11, 0x81, 0x76, 0xbc, 0xf4, 0x3e, 0x4d, 0xe9, 0xa8, 0xfc,
+	0x08, 0xd2, 0x21, 0xf6, 0x76, 0x12, 0x76, 0x75, 0x14, 0xf6, 0x96, 0x6f, 0x37, 0x99, 0x9b, 0x08,
+	0x5f, 0xfe, 0x13, 0x41, 0x26, 0x4c, 0xfe, 0x19, 0x3b, 0x8e, 0x1d, 0x98, 0xa5, 0x9d, 0x8e, 0xcb,
+	0x3a, 0x61, 0xcb, 0x90, 0xcc, 0xee, 0x3a, 0x2e, 0xb5, 0xb8, 0x3c, 0x54, 0x25, 0x35, 0xbd, 0xf4,
+	0xf1, 0x28, 0xe8, 0x5a, 0xdf, 0xbc, 0xd1, 0xb7, 0x26, 0x97, 0x68, 0xa2, 0x1c, 0x5f, 0x86, 0x29,
+	0xee, 0x99, 0xb6, 0x23, 0x1c, 0xe9, 0x08, 0xde, 0x52, 0xd5, 0x99, 0x23, 0x05, 0xee, 0x6d, 0xc6,
+	0x22, 0x45, 0xe6, 0x1f, 0x08, 0xb4, 0x20, 0xdc, 0x33, 0xe7, 0xf2, 0x3c, 0x46, 0x5c, 0x7e, 0x86,
+	0x60, 0x6a, 0xb0, 0xac, 0xf0, 0x5e, 0x52, 0xd8, 0xb7, 0x5f, 0xa6, 0x32, 0xcf, 0x47, 0xf0, 0x2a,
+	0x97, 0xbf, 0x20, 0xc8, 0xf7, 0x43, 0xdb, 0x49, 0x0a, 0x6d, 0x69, 0xec, 0xfe, 0x70, 0x3e, 0xe2,
+	0x2a, 0x7f, 0x01, 0xd9, 0xa8, 0xd3, 0xe0, 0x87, 0x49, 0x01, 0x7d, 0x38, 0x66, 0x9f, 0x4a, 0xae,
+	0xf7, 0xef, 0x52, 0xea, 0x3e, 0xf4, 0x0e, 0x71, 0x1d, 0x32, 0x16, 0x6d, 0x32, 0x2b, 0x86, 0xbf,
+	0x39, 0x62, 0x98, 0xef, 0x48, 0x97, 0x8b, 0xce, 0x7d, 0x76, 0xb8, 0x47, 0x2d, 0x9f, 0x91, 0xc8,
+	0x18, 0x57, 0xe1, 0xa2, 0x27, 0xa9, 0x2b, 0x4d, 0xc9, 0x6d, 0x66, 0xfa, 0x82, 0x1f, 0x98, 0x82,
+	0x0a, 0x47, 0x71, 0x94, 0x21, 0x6f, 0xa8, 0xb3, 0x06, 0xb7, 0xd9, 0xae, 0xe0, 0x07, 0x5b, 0x54,
+	0x38, 0xf8, 0x5d, 0x98, 0x3e, 0xa2, 0xaa, 0x29, 0xd5, 0x29, 0x39, 0xa8, 0x75, 0x11, 0xd2, 0xfb,
+	0xc1, 0x77, 0xd4, 0x44, 0x2d, 0x92, 0x70, 0x13, 0x8c, 0x01, 0x76, 0xc0, 0xec, 0xae, 0x45, 0x5d,
+	0x4f, 0x4f, 0x2b, 0xb7, 0x3f, 0x18, 0xe3, 0x06, 0xd7, 0x23, 0x1b, 0xd2, 0xb7, 0x56, 0xb7, 0xe8,
+	0xa9, 0x06, 0x33, 0x47, 0xea, 0x1a, 0xaf, 0x01, 0x50, 0x29, 0x5d, 0xde, 0xf4, 0x25, 0xf3, 0xf4,
+	0x6c, 0x49, 0x3b, 0x76, 0x42, 0xf4, 0xa9, 0xe9, 0x91, 0x32, 0x60, 0x8a, 0x8d, 0x57, 0xe2, 0x37,
+	0x98, 0x82, 0xaf, 0x9b, 0xe3, 0x79, 0xc8, 0x53, 0xcf, 0x6c, 0x3b, 0x7e, 0xd3, 0x0a, 0x79, 0x56,
+	0x6f, 0x11, 0xea, 0xad, 0x2a, 0x09, 0x9e, 0x85, 0x0c, 0xf5, 0x4c, 0x2e, 0xa4, 0x7a, 0x4b, 0x14,
+	0x83, 0x57, 0x06, 0xf5, 0x0c, 0x21, 0xf1, 0xbd, 0x17, 0xb3, 0x30, 0xf2, 0xc5, 0x93, 0x90, 0x82,
+	0xe5, 0x6c, 0x94, 0xe3, 0xf2, 0xdf, 0x29, 0x78, 0x2b, 0xb1, 0xd1, 0x9c, 0xff, 0x4b, 0xda, 0x72,
+	0x7c, 0x21, 0x15, 0x79, 0x19, 0x12, 0x6e, 0x70, 0x31, 0x7c, 0x3d, 0xa5, 0xd5, 0xc5, 0x0d, 0x96,
+	0xf8, 0x0a, 0x5c, 0x68, 0xfa, 0xad, 0xaf, 0x99, 0x34, 0x95, 0x86, 0xa7, 0x67, 0x4a, 0x5a, 0x00,
+	0x16, 0x0a, 0x57, 0x94, 0x0c, 0x5f, 0x83, 0x19, 0x76, 0xd0, 0xb5, 0x78, 0x8b, 0x4b, 0xb3, 0xe9,
+	0xf8, 0xa2, 0x1d, 0xde, 0x3e, 0x44, 0xa6, 0x63, 0xf1, 0xb2, 0x92, 0x0e, 0x17, 0x41, 0xee, 0x95,
+	0x8b, 0xe0, 0x07, 0x0d, 0x70, 0x02, 0xf3, 0xc3, 0x75, 0x90, 0xff, 0x2f, 0xd7, 0xc1, 0xc8, 0x34,
+	0xa2, 0xd7, 0x91, 0xc6, 0x7b, 0x2f, 0xa6, 0xf1, 0x34, 0x55, 0x54, 0xfe, 0x55, 0x83, 0xe2, 0xd1,
+	0xce, 0xff, 0x7f, 0xea, 0x62, 0xe3, 0x66, 0x8f, 0xc3, 0xcc, 0x37, 0x3e, 0x15, 0x92, 0x5b, 0xcc,
+	0x54, 0x6d, 0x27, 0xcc, 0x5f, 0x61, 0xe9, 0xee, 0xcb, 0xce, 0xd5, 0x8a, 0x8a, 0xad, 0x26, 0x1f,
+	0x46, 0x70, 0x64, 0x3a, 0x06, 0x56, 0x07, 0xde, 0xdc, 0x0a, 0xcc, 0x1c, 0x51, 0xc1, 0x73, 0x90,
+	0x8b, 0x95, 0xd4, 0x6f, 0x4e, 0x44, 0x7a, 0xfb, 0xfe, 0xac, 0x4b, 0xa9, 0x83, 0xa8, 0x29, 0xfe,
+	0x86, 0xa0, 0x30, 0x50, 0xb6, 0x78, 0x0f, 0x66, 0xbe, 0xe2, 0x96, 0x64, 0x2e, 0x6b, 0x9b, 0xaf,
+	0xd2, 0x13, 0xa7, 0x63, 0x94, 0x8d, 0x30, 0x2d, 0x2f, 0xb2, 0x9c, 0x3a, 0x69, 0x1e, 0x6b, 0x83,
+	0xf3, 0x78, 0x16, 0xb2, 0x5e, 0x97, 0x0a, 0x93, 0xb7, 0x15, 0xfb, 0x53, 0x24, 0x13, 0x6c, 0x8d,
+	0x36, 0x7e, 0x1b, 0x72, 0xd2, 0xa5, 0x2d, 0x16, 0x9c, 0xa4, 0xd5, 0x49, 0x56, 0xed, 0x8d, 0xb6,
+	0xea, 0x39, 0xcf, 0x52, 0x90, 0xeb, 0x05, 0xf6, 0x39, 0xbc, 0xd9, 0x0b, 0xec, 0xf4, 0x97, 0x16,
+	0xc7, 0x18, 0xb5, 0xfe, 0xe5, 0x7d, 0x7c, 0x36, 0x94, 0xa9, 0x5b, 0x7c, 0x3a, 0xda, 0x86, 0x46,
+	0xac, 0x36, 0xfe, 0x88, 0x3d, 0x05, 0xb1, 0xbd, 0x71, 0x7a, 0xfd, 0x5b, 0x04, 0x97, 0x92, 0xdf,
+	0x9e, 0xf8, 0x1a, 0x5c, 0xa9, 0xad, 0xad, 0x91, 0xfa, 0x5a, 0xad, 0x61, 0x3c, 0xd8, 0x32, 0x1b,
+	0xf5, 0xcd, 0xed, 0x07, 0xa4, 0xb6, 0x61, 0x34, 0x1e, 0x99, 0xbb, 0x5b, 0x3b, 0xdb, 0xf5, 0x15,
+	0xe3, 0x9e, 0x51, 0x5f, 0x2d, 0x4e, 0xe0, 0xcb, 0x30, 0x7f, 0x9c, 0xe2, 0x6a, 0x7d, 0xa3, 0x51,
+	0x2b, 0x22, 0x7c, 0x15, 0xca, 0xc7, 0xa9, 0xac, 0xec, 0x6e, 0xee, 0x6e, 0xd4, 0x1a, 0xc6, 0x5e,
+	0xbd, 0x98, 0x5a, 0xfe, 0x1e, 0xfd, 0xf8, 0x7c, 0x01, 0xfd, 0xfc, 0x7c, 0x01, 0xfd, 0xfe, 0x7c,
+	0x01, 0xc1, 0x65, 0xee, 0x8c, 0x28, 0xb8, 0xe5, 0xa9, 0xe8, 0x1f, 0xa8, 0xed, 0xe0, 0x60, 0x1b,
+	0x3d, 0xde, 0xe9, 0x1c, 0x35, 0xe1, 0x4e, 0xd5, 0x91, 0xcc, 0xaa, 0xb2, 0x83, 0xae, 0xe3, 0x4a,
+	0xe6, 0x7a, 0x55, 0x47, 0x5a, 0xdd, 0x2a, 0x17, 0x92, 0xb9, 0x82, 0x5a, 0xd5, 0x21, 0xed, 0x9b,
+	0xea, 0x03, 0x37, 0x3b, 0x4c, 0x0c, 0xfc, 0x17, 0xd9, 0xcc, 0x28, 0xe9, 0x47, 0xff, 0x0e, 0x00,
+	0x95, 0x10, 0x08, 0x43, 0xb4, 0x14, 0x00, 0x00,
 }
 
 func (m *ResourceMetrics) Marshal() (dAtA []byte, err error) {
@@ -1665,6 +2134,13 @@ func (m *ResourceMetrics) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if len(m.SchemaUrl) > 0 {
+		i -= len(m.SchemaUrl)
+		copy(dAtA[i:], m.SchemaUrl)
+		i = encodeVarintMetrics(dAtA, i, uint64(len(m.SchemaUrl)))
+		i--
+		dAtA[i] = 0x1a
+	}
 	if len(m.InstrumentationLibraryMetrics) > 0 {
 		for iNdEx := len(m.InstrumentationLibraryMetrics) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -1718,6 +2194,13 @@ func (m *InstrumentationLibraryMetrics) MarshalToSizedBuffer(dAtA []byte) (int,
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if len(m.SchemaUrl) > 0 {
+		i -= len(m.SchemaUrl)
+		copy(dAtA[i:], m.SchemaUrl)
+		i = encodeVarintMetrics(dAtA, i, uint64(len(m.SchemaUrl)))
+		i--
+		dAtA[i] = 0x1a
+	}
 	if len(m.Metrics) > 0 {
 		for iNdEx := len(m.Metrics) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -1825,16 +2308,16 @@ func (m *Metric_IntGauge) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	}
 	return len(dAtA) - i, nil
 }
-func (m *Metric_DoubleGauge) MarshalTo(dAtA []byte) (int, error) {
+func (m *Metric_Gauge) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *Metric_DoubleGauge) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *Metric_Gauge) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
-	if m.DoubleGauge != nil {
+	if m.Gauge != nil {
 		{
-			size, err := m.DoubleGauge.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.Gauge.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -1867,16 +2350,16 @@ func (m *Metric_IntSum) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	}
 	return len(dAtA) - i, nil
 }
-func (m *Metric_DoubleSum) MarshalTo(dAtA []byte) (int, error) {
+func (m *Metric_Sum) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *Metric_DoubleSum) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *Metric_Sum) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
-	if m.DoubleSum != nil {
+	if m.Sum != nil {
 		{
-			size, err := m.DoubleSum.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.Sum.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -1909,16 +2392,16 @@ func (m *Metric_IntHistogram) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	}
 	return len(dAtA) - i, nil
 }
-func (m *Metric_DoubleHistogram) MarshalTo(dAtA []byte) (int, error) {
+func (m *Metric_Histogram) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *Metric_DoubleHistogram) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *Metric_Histogram) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
-	if m.DoubleHistogram != nil {
+	if m.Histogram != nil {
 		{
-			size, err := m.DoubleHistogram.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.Histogram.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -1930,6 +2413,27 @@ func (m *Metric_DoubleHistogram) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	}
 	return len(dAtA) - i, nil
 }
+func (m *Metric_Summary) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Metric_Summary) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.Summary != nil {
+		{
+			size, err := m.Summary.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintMetrics(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x5a
+	}
+	return len(dAtA) - i, nil
+}
 func (m *IntGauge) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -1971,7 +2475,7 @@ func (m *IntGauge) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *DoubleGauge) Marshal() (dAtA []byte, err error) {
+func (m *Gauge) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1981,12 +2485,12 @@ func (m *DoubleGauge) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *DoubleGauge) MarshalTo(dAtA []byte) (int, error) {
+func (m *Gauge) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *DoubleGauge) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *Gauge) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -2068,7 +2572,7 @@ func (m *IntSum) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *DoubleSum) Marshal() (dAtA []byte, err error) {
+func (m *Sum) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2078,12 +2582,12 @@ func (m *DoubleSum) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *DoubleSum) MarshalTo(dAtA []byte) (int, error) {
+func (m *Sum) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *DoubleSum) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *Sum) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -2170,7 +2674,7 @@ func (m *IntHistogram) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *DoubleHistogram) Marshal() (dAtA []byte, err error) {
+func (m *Histogram) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2180,12 +2684,12 @@ func (m *DoubleHistogram) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *DoubleHistogram) MarshalTo(dAtA []byte) (int, error) {
+func (m *Histogram) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *DoubleHistogram) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *Histogram) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -2216,6 +2720,47 @@ func (m *DoubleHistogram) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *Summary) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Summary) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Summary) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.DataPoints) > 0 {
+		for iNdEx := len(m.DataPoints) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DataPoints[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintMetrics(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *IntDataPoint) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -2289,7 +2834,7 @@ func (m *IntDataPoint) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *DoubleDataPoint) Marshal() (dAtA []byte, err error) {
+func (m *NumberDataPoint) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2299,12 +2844,12 @@ func (m *DoubleDataPoint) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *DoubleDataPoint) MarshalTo(dAtA []byte) (int, error) {
+func (m *NumberDataPoint) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *DoubleDataPoint) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *NumberDataPoint) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -2313,6 +2858,29 @@ func (m *DoubleDataPoint) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if len(m.Attributes) > 0 {
+		for iNdEx := len(m.Attributes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Attributes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintMetrics(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if m.Value != nil {
+		{
+			size := m.Value.Size()
+			i -= size
+			if _, err := m.Value.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+		}
+	}
 	if len(m.Exemplars) > 0 {
 		for iNdEx := len(m.Exemplars) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -2327,12 +2895,6 @@ func (m *DoubleDataPoint) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			dAtA[i] = 0x2a
 		}
 	}
-	if m.Value != 0 {
-		i -= 8
-		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.Value))))
-		i--
-		dAtA[i] = 0x21
-	}
 	if m.TimeUnixNano != 0 {
 		i -= 8
 		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(m.TimeUnixNano))
@@ -2362,6 +2924,32 @@ func (m *DoubleDataPoint) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *NumberDataPoint_AsDouble) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *NumberDataPoint_AsDouble) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	i -= 8
+	encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.AsDouble))))
+	i--
+	dAtA[i] = 0x21
+	return len(dAtA) - i, nil
+}
+func (m *NumberDataPoint_AsInt) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *NumberDataPoint_AsInt) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	i -= 8
+	encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(m.AsInt))
+	i--
+	dAtA[i] = 0x31
+	return len(dAtA) - i, nil
+}
 func (m *IntHistogramDataPoint) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -2402,9 +2990,9 @@ func (m *IntHistogramDataPoint) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	}
 	if len(m.ExplicitBounds) > 0 {
 		for iNdEx := len(m.ExplicitBounds) - 1; iNdEx >= 0; iNdEx-- {
-			f9 := math.Float64bits(float64(m.ExplicitBounds[iNdEx]))
+			f10 := math.Float64bits(float64(m.ExplicitBounds[iNdEx]))
 			i -= 8
-			encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(f9))
+			encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(f10))
 		}
 		i = encodeVarintMetrics(dAtA, i, uint64(len(m.ExplicitBounds)*8))
 		i--
@@ -2460,7 +3048,7 @@ func (m *IntHistogramDataPoint) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *DoubleHistogramDataPoint) Marshal() (dAtA []byte, err error) {
+func (m *HistogramDataPoint) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2470,12 +3058,12 @@ func (m *DoubleHistogramDataPoint) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *DoubleHistogramDataPoint) MarshalTo(dAtA []byte) (int, error) {
+func (m *HistogramDataPoint) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *DoubleHistogramDataPoint) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *HistogramDataPoint) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -2484,6 +3072,20 @@ func (m *DoubleHistogramDataPoint) MarshalToSizedBuffer(dAtA []byte) (int, error
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if len(m.Attributes) > 0 {
+		for iNdEx := len(m.Attributes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Attributes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintMetrics(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
 	if len(m.Exemplars) > 0 {
 		for iNdEx := len(m.Exemplars) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -2500,9 +3102,9 @@ func (m *DoubleHistogramDataPoint) MarshalToSizedBuffer(dAtA []byte) (int, error
 	}
 	if len(m.ExplicitBounds) > 0 {
 		for iNdEx := len(m.ExplicitBounds) - 1; iNdEx >= 0; iNdEx-- {
-			f10 := math.Float64bits(float64(m.ExplicitBounds[iNdEx]))
+			f11 := math.Float64bits(float64(m.ExplicitBounds[iNdEx]))
 			i -= 8
-			encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(f10))
+			encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(f11))
 		}
 		i = encodeVarintMetrics(dAtA, i, uint64(len(m.ExplicitBounds)*8))
 		i--
@@ -2558,7 +3160,7 @@ func (m *DoubleHistogramDataPoint) MarshalToSizedBuffer(dAtA []byte) (int, error
 	return len(dAtA) - i, nil
 }
 
-func (m *IntExemplar) Marshal() (dAtA []byte, err error) {
+func (m *SummaryDataPoint) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2568,12 +3170,12 @@ func (m *IntExemplar) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *IntExemplar) MarshalTo(dAtA []byte) (int, error) {
+func (m *SummaryDataPoint) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *IntExemplar) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *SummaryDataPoint) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -2582,36 +3184,62 @@ func (m *IntExemplar) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if len(m.TraceId) > 0 {
-		i -= len(m.TraceId)
-		copy(dAtA[i:], m.TraceId)
-		i = encodeVarintMetrics(dAtA, i, uint64(len(m.TraceId)))
-		i--
-		dAtA[i] = 0x2a
+	if len(m.Attributes) > 0 {
+		for iNdEx := len(m.Attributes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Attributes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintMetrics(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
 	}
-	if len(m.SpanId) > 0 {
-		i -= len(m.SpanId)
-		copy(dAtA[i:], m.SpanId)
-		i = encodeVarintMetrics(dAtA, i, uint64(len(m.SpanId)))
+	if len(m.QuantileValues) > 0 {
+		for iNdEx := len(m.QuantileValues) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.QuantileValues[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintMetrics(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if m.Sum != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.Sum))))
 		i--
-		dAtA[i] = 0x22
+		dAtA[i] = 0x29
 	}
-	if m.Value != 0 {
+	if m.Count != 0 {
 		i -= 8
-		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(m.Value))
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(m.Count))
 		i--
-		dAtA[i] = 0x19
+		dAtA[i] = 0x21
 	}
 	if m.TimeUnixNano != 0 {
 		i -= 8
 		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(m.TimeUnixNano))
 		i--
+		dAtA[i] = 0x19
+	}
+	if m.StartTimeUnixNano != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(m.StartTimeUnixNano))
+		i--
 		dAtA[i] = 0x11
 	}
-	if len(m.FilteredLabels) > 0 {
-		for iNdEx := len(m.FilteredLabels) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.Labels) > 0 {
+		for iNdEx := len(m.Labels) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.FilteredLabels[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.Labels[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -2625,7 +3253,46 @@ func (m *IntExemplar) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *DoubleExemplar) Marshal() (dAtA []byte, err error) {
+func (m *SummaryDataPoint_ValueAtQuantile) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SummaryDataPoint_ValueAtQuantile) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SummaryDataPoint_ValueAtQuantile) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Value != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.Value))))
+		i--
+		dAtA[i] = 0x11
+	}
+	if m.Quantile != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.Quantile))))
+		i--
+		dAtA[i] = 0x9
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *IntExemplar) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2635,12 +3302,12 @@ func (m *DoubleExemplar) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *DoubleExemplar) MarshalTo(dAtA []byte) (int, error) {
+func (m *IntExemplar) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *DoubleExemplar) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *IntExemplar) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -2665,7 +3332,7 @@ func (m *DoubleExemplar) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	}
 	if m.Value != 0 {
 		i -= 8
-		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.Value))))
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(m.Value))
 		i--
 		dAtA[i] = 0x19
 	}
@@ -2692,22 +3359,132 @@ func (m *DoubleExemplar) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintMetrics(dAtA []byte, offset int, v uint64) int {
-	offset -= sovMetrics(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *Exemplar) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *ResourceMetrics) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
+
+func (m *Exemplar) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Exemplar) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.FilteredAttributes) > 0 {
+		for iNdEx := len(m.FilteredAttributes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FilteredAttributes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintMetrics(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if m.Value != nil {
+		{
+			size := m.Value.Size()
+			i -= size
+			if _, err := m.Value.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+		}
+	}
+	if len(m.TraceId) > 0 {
+		i -= len(m.TraceId)
+		copy(dAtA[i:], m.TraceId)
+		i = encodeVarintMetrics(dAtA, i, uint64(len(m.TraceId)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.SpanId) > 0 {
+		i -= len(m.SpanId)
+		copy(dAtA[i:], m.SpanId)
+		i = encodeVarintMetrics(dAtA, i, uint64(len(m.SpanId)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.TimeUnixNano != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(m.TimeUnixNano))
+		i--
+		dAtA[i] = 0x11
+	}
+	if len(m.FilteredLabels) > 0 {
+		for iNdEx := len(m.FilteredLabels) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FilteredLabels[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintMetrics(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Exemplar_AsDouble) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Exemplar_AsDouble) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	i -= 8
+	encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.AsDouble))))
+	i--
+	dAtA[i] = 0x19
+	return len(dAtA) - i, nil
+}
+func (m *Exemplar_AsInt) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Exemplar_AsInt) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	i -= 8
+	encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(m.AsInt))
+	i--
+	dAtA[i] = 0x31
+	return len(dAtA) - i, nil
+}
+func encodeVarintMetrics(dAtA []byte, offset int, v uint64) int {
+	offset -= sovMetrics(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *ResourceMetrics) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
 	_ = l
 	if m.Resource != nil {
 		l = m.Resource.Size()
@@ -2719,6 +3496,10 @@ func (m *ResourceMetrics) Size() (n int) {
 			n += 1 + l + sovMetrics(uint64(l))
 		}
 	}
+	l = len(m.SchemaUrl)
+	if l > 0 {
+		n += 1 + l + sovMetrics(uint64(l))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -2741,6 +3522,10 @@ func (m *InstrumentationLibraryMetrics) Size() (n int) {
 			n += 1 + l + sovMetrics(uint64(l))
 		}
 	}
+	l = len(m.SchemaUrl)
+	if l > 0 {
+		n += 1 + l + sovMetrics(uint64(l))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -2786,14 +3571,14 @@ func (m *Metric_IntGauge) Size() (n int) {
 	}
 	return n
 }
-func (m *Metric_DoubleGauge) Size() (n int) {
+func (m *Metric_Gauge) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.DoubleGauge != nil {
-		l = m.DoubleGauge.Size()
+	if m.Gauge != nil {
+		l = m.Gauge.Size()
 		n += 1 + l + sovMetrics(uint64(l))
 	}
 	return n
@@ -2810,14 +3595,14 @@ func (m *Metric_IntSum) Size() (n int) {
 	}
 	return n
 }
-func (m *Metric_DoubleSum) Size() (n int) {
+func (m *Metric_Sum) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.DoubleSum != nil {
-		l = m.DoubleSum.Size()
+	if m.Sum != nil {
+		l = m.Sum.Size()
 		n += 1 + l + sovMetrics(uint64(l))
 	}
 	return n
@@ -2834,14 +3619,26 @@ func (m *Metric_IntHistogram) Size() (n int) {
 	}
 	return n
 }
-func (m *Metric_DoubleHistogram) Size() (n int) {
+func (m *Metric_Histogram) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Histogram != nil {
+		l = m.Histogram.Size()
+		n += 1 + l + sovMetrics(uint64(l))
+	}
+	return n
+}
+func (m *Metric_Summary) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.DoubleHistogram != nil {
-		l = m.DoubleHistogram.Size()
+	if m.Summary != nil {
+		l = m.Summary.Size()
 		n += 1 + l + sovMetrics(uint64(l))
 	}
 	return n
@@ -2864,7 +3661,7 @@ func (m *IntGauge) Size() (n int) {
 	return n
 }
 
-func (m *DoubleGauge) Size() (n int) {
+func (m *Gauge) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -2906,7 +3703,7 @@ func (m *IntSum) Size() (n int) {
 	return n
 }
 
-func (m *DoubleSum) Size() (n int) {
+func (m *Sum) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -2951,7 +3748,7 @@ func (m *IntHistogram) Size() (n int) {
 	return n
 }
 
-func (m *DoubleHistogram) Size() (n int) {
+func (m *Histogram) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -2972,6 +3769,24 @@ func (m *DoubleHistogram) Size() (n int) {
 	return n
 }
 
+func (m *Summary) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.DataPoints) > 0 {
+		for _, e := range m.DataPoints {
+			l = e.Size()
+			n += 1 + l + sovMetrics(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
 func (m *IntDataPoint) Size() (n int) {
 	if m == nil {
 		return 0
@@ -3005,7 +3820,7 @@ func (m *IntDataPoint) Size() (n int) {
 	return n
 }
 
-func (m *DoubleDataPoint) Size() (n int) {
+func (m *NumberDataPoint) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -3023,8 +3838,8 @@ func (m *DoubleDataPoint) Size() (n int) {
 	if m.TimeUnixNano != 0 {
 		n += 9
 	}
-	if m.Value != 0 {
-		n += 9
+	if m.Value != nil {
+		n += m.Value.Size()
 	}
 	if len(m.Exemplars) > 0 {
 		for _, e := range m.Exemplars {
@@ -3032,12 +3847,36 @@ func (m *DoubleDataPoint) Size() (n int) {
 			n += 1 + l + sovMetrics(uint64(l))
 		}
 	}
+	if len(m.Attributes) > 0 {
+		for _, e := range m.Attributes {
+			l = e.Size()
+			n += 1 + l + sovMetrics(uint64(l))
+		}
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
 	return n
 }
 
+func (m *NumberDataPoint_AsDouble) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	n += 9
+	return n
+}
+func (m *NumberDataPoint_AsInt) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	n += 9
+	return n
+}
 func (m *IntHistogramDataPoint) Size() (n int) {
 	if m == nil {
 		return 0
@@ -3080,7 +3919,7 @@ func (m *IntHistogramDataPoint) Size() (n int) {
 	return n
 }
 
-func (m *DoubleHistogramDataPoint) Size() (n int) {
+func (m *HistogramDataPoint) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -3116,6 +3955,72 @@ func (m *DoubleHistogramDataPoint) Size() (n int) {
 			n += 1 + l + sovMetrics(uint64(l))
 		}
 	}
+	if len(m.Attributes) > 0 {
+		for _, e := range m.Attributes {
+			l = e.Size()
+			n += 1 + l + sovMetrics(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *SummaryDataPoint) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Labels) > 0 {
+		for _, e := range m.Labels {
+			l = e.Size()
+			n += 1 + l + sovMetrics(uint64(l))
+		}
+	}
+	if m.StartTimeUnixNano != 0 {
+		n += 9
+	}
+	if m.TimeUnixNano != 0 {
+		n += 9
+	}
+	if m.Count != 0 {
+		n += 9
+	}
+	if m.Sum != 0 {
+		n += 9
+	}
+	if len(m.QuantileValues) > 0 {
+		for _, e := range m.QuantileValues {
+			l = e.Size()
+			n += 1 + l + sovMetrics(uint64(l))
+		}
+	}
+	if len(m.Attributes) > 0 {
+		for _, e := range m.Attributes {
+			l = e.Size()
+			n += 1 + l + sovMetrics(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *SummaryDataPoint_ValueAtQuantile) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Quantile != 0 {
+		n += 9
+	}
+	if m.Value != 0 {
+		n += 9
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -3154,7 +4059,7 @@ func (m *IntExemplar) Size() (n int) {
 	return n
 }
 
-func (m *DoubleExemplar) Size() (n int) {
+func (m *Exemplar) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -3169,8 +4074,8 @@ func (m *DoubleExemplar) Size() (n int) {
 	if m.TimeUnixNano != 0 {
 		n += 9
 	}
-	if m.Value != 0 {
-		n += 9
+	if m.Value != nil {
+		n += m.Value.Size()
 	}
 	l = len(m.SpanId)
 	if l > 0 {
@@ -3180,12 +4085,37 @@ func (m *DoubleExemplar) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovMetrics(uint64(l))
 	}
+	if len(m.FilteredAttributes) > 0 {
+		for _, e := range m.FilteredAttributes {
+			l = e.Size()
+			n += 1 + l + sovMetrics(uint64(l))
+		}
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
 	return n
 }
 
+func (m *Exemplar_AsDouble) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	n += 9
+	return n
+}
+func (m *Exemplar_AsInt) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	n += 9
+	return n
+}
+
 func sovMetrics(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -3291,6 +4221,38 @@ func (m *ResourceMetrics) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SchemaUrl", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetrics
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMetrics
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMetrics
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SchemaUrl = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMetrics(dAtA[iNdEx:])
@@ -3415,6 +4377,38 @@ func (m *InstrumentationLibraryMetrics) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SchemaUrl", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetrics
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMetrics
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMetrics
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SchemaUrl = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMetrics(dAtA[iNdEx:])
@@ -3602,7 +4596,7 @@ func (m *Metric) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DoubleGauge", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Gauge", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3629,11 +4623,11 @@ func (m *Metric) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &DoubleGauge{}
+			v := &Gauge{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Data = &Metric_DoubleGauge{v}
+			m.Data = &Metric_Gauge{v}
 			iNdEx = postIndex
 		case 6:
 			if wireType != 2 {
@@ -3672,7 +4666,7 @@ func (m *Metric) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DoubleSum", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Sum", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3699,11 +4693,11 @@ func (m *Metric) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &DoubleSum{}
+			v := &Sum{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Data = &Metric_DoubleSum{v}
+			m.Data = &Metric_Sum{v}
 			iNdEx = postIndex
 		case 8:
 			if wireType != 2 {
@@ -3742,7 +4736,42 @@ func (m *Metric) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DoubleHistogram", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Histogram", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetrics
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMetrics
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMetrics
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &Histogram{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Data = &Metric_Histogram{v}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Summary", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3769,11 +4798,11 @@ func (m *Metric) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &DoubleHistogram{}
+			v := &Summary{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Data = &Metric_DoubleHistogram{v}
+			m.Data = &Metric_Summary{v}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3888,7 +4917,7 @@ func (m *IntGauge) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DoubleGauge) Unmarshal(dAtA []byte) error {
+func (m *Gauge) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3911,10 +4940,10 @@ func (m *DoubleGauge) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DoubleGauge: wiretype end group for non-group")
+			return fmt.Errorf("proto: Gauge: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DoubleGauge: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Gauge: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -3946,7 +4975,7 @@ func (m *DoubleGauge) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DataPoints = append(m.DataPoints, &DoubleDataPoint{})
+			m.DataPoints = append(m.DataPoints, &NumberDataPoint{})
 			if err := m.DataPoints[len(m.DataPoints)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
@@ -4103,7 +5132,7 @@ func (m *IntSum) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DoubleSum) Unmarshal(dAtA []byte) error {
+func (m *Sum) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4126,10 +5155,10 @@ func (m *DoubleSum) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DoubleSum: wiretype end group for non-group")
+			return fmt.Errorf("proto: Sum: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DoubleSum: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Sum: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -4161,7 +5190,7 @@ func (m *DoubleSum) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DataPoints = append(m.DataPoints, &DoubleDataPoint{})
+			m.DataPoints = append(m.DataPoints, &NumberDataPoint{})
 			if err := m.DataPoints[len(m.DataPoints)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
@@ -4337,7 +5366,7 @@ func (m *IntHistogram) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DoubleHistogram) Unmarshal(dAtA []byte) error {
+func (m *Histogram) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4360,10 +5389,10 @@ func (m *DoubleHistogram) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DoubleHistogram: wiretype end group for non-group")
+			return fmt.Errorf("proto: Histogram: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DoubleHistogram: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Histogram: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -4395,7 +5424,7 @@ func (m *DoubleHistogram) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DataPoints = append(m.DataPoints, &DoubleHistogramDataPoint{})
+			m.DataPoints = append(m.DataPoints, &HistogramDataPoint{})
 			if err := m.DataPoints[len(m.DataPoints)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
@@ -4444,6 +5473,94 @@ func (m *DoubleHistogram) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *Summary) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMetrics
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Summary: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Summary: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DataPoints", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetrics
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMetrics
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMetrics
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DataPoints = append(m.DataPoints, &SummaryDataPoint{})
+			if err := m.DataPoints[len(m.DataPoints)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMetrics(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthMetrics
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthMetrics
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *IntDataPoint) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -4596,7 +5713,7 @@ func (m *IntDataPoint) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DoubleDataPoint) Unmarshal(dAtA []byte) error {
+func (m *NumberDataPoint) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4619,10 +5736,10 @@ func (m *DoubleDataPoint) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DoubleDataPoint: wiretype end group for non-group")
+			return fmt.Errorf("proto: NumberDataPoint: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DoubleDataPoint: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: NumberDataPoint: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -4681,7 +5798,7 @@ func (m *DoubleDataPoint) Unmarshal(dAtA []byte) error {
 			iNdEx += 8
 		case 4:
 			if wireType != 1 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AsDouble", wireType)
 			}
 			var v uint64
 			if (iNdEx + 8) > l {
@@ -4689,7 +5806,7 @@ func (m *DoubleDataPoint) Unmarshal(dAtA []byte) error {
 			}
 			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
 			iNdEx += 8
-			m.Value = float64(math.Float64frombits(v))
+			m.Value = &NumberDataPoint_AsDouble{float64(math.Float64frombits(v))}
 		case 5:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Exemplars", wireType)
@@ -4719,11 +5836,56 @@ func (m *DoubleDataPoint) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Exemplars = append(m.Exemplars, &DoubleExemplar{})
+			m.Exemplars = append(m.Exemplars, &Exemplar{})
 			if err := m.Exemplars[len(m.Exemplars)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 6:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AsInt", wireType)
+			}
+			var v int64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = int64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.Value = &NumberDataPoint_AsInt{v}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Attributes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetrics
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMetrics
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMetrics
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Attributes = append(m.Attributes, &v11.KeyValue{})
+			if err := m.Attributes[len(m.Attributes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMetrics(dAtA[iNdEx:])
@@ -5017,7 +6179,7 @@ func (m *IntHistogramDataPoint) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DoubleHistogramDataPoint) Unmarshal(dAtA []byte) error {
+func (m *HistogramDataPoint) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5040,10 +6202,10 @@ func (m *DoubleHistogramDataPoint) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DoubleHistogramDataPoint: wiretype end group for non-group")
+			return fmt.Errorf("proto: HistogramDataPoint: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DoubleHistogramDataPoint: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: HistogramDataPoint: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -5256,11 +6418,45 @@ func (m *DoubleHistogramDataPoint) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Exemplars = append(m.Exemplars, &DoubleExemplar{})
+			m.Exemplars = append(m.Exemplars, &Exemplar{})
 			if err := m.Exemplars[len(m.Exemplars)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Attributes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetrics
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMetrics
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMetrics
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Attributes = append(m.Attributes, &v11.KeyValue{})
+			if err := m.Attributes[len(m.Attributes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMetrics(dAtA[iNdEx:])
@@ -5286,7 +6482,7 @@ func (m *DoubleHistogramDataPoint) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *IntExemplar) Unmarshal(dAtA []byte) error {
+func (m *SummaryDataPoint) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5309,15 +6505,15 @@ func (m *IntExemplar) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: IntExemplar: wiretype end group for non-group")
+			return fmt.Errorf("proto: SummaryDataPoint: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: IntExemplar: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SummaryDataPoint: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FilteredLabels", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Labels", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5344,12 +6540,22 @@ func (m *IntExemplar) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.FilteredLabels = append(m.FilteredLabels, &v11.StringKeyValue{})
-			if err := m.FilteredLabels[len(m.FilteredLabels)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Labels = append(m.Labels, &v11.StringKeyValue{})
+			if err := m.Labels[len(m.Labels)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTimeUnixNano", wireType)
+			}
+			m.StartTimeUnixNano = 0
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StartTimeUnixNano = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+		case 3:
 			if wireType != 1 {
 				return fmt.Errorf("proto: wrong wireType = %d for field TimeUnixNano", wireType)
 			}
@@ -5359,26 +6565,289 @@ func (m *IntExemplar) Unmarshal(dAtA []byte) error {
 			}
 			m.TimeUnixNano = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
 			iNdEx += 8
-		case 3:
+		case 4:
 			if wireType != 1 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
 			}
-			m.Value = 0
+			m.Count = 0
 			if (iNdEx + 8) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Value = int64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			m.Count = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
 			iNdEx += 8
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SpanId", wireType)
+		case 5:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sum", wireType)
 			}
-			var byteLen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowMetrics
-				}
-				if iNdEx >= l {
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.Sum = float64(math.Float64frombits(v))
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QuantileValues", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetrics
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMetrics
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMetrics
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.QuantileValues = append(m.QuantileValues, &SummaryDataPoint_ValueAtQuantile{})
+			if err := m.QuantileValues[len(m.QuantileValues)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Attributes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetrics
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMetrics
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMetrics
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Attributes = append(m.Attributes, &v11.KeyValue{})
+			if err := m.Attributes[len(m.Attributes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMetrics(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthMetrics
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthMetrics
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SummaryDataPoint_ValueAtQuantile) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMetrics
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ValueAtQuantile: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ValueAtQuantile: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Quantile", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.Quantile = float64(math.Float64frombits(v))
+		case 2:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.Value = float64(math.Float64frombits(v))
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMetrics(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthMetrics
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthMetrics
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *IntExemplar) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMetrics
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: IntExemplar: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: IntExemplar: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FilteredLabels", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetrics
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMetrics
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMetrics
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FilteredLabels = append(m.FilteredLabels, &v11.StringKeyValue{})
+			if err := m.FilteredLabels[len(m.FilteredLabels)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeUnixNano", wireType)
+			}
+			m.TimeUnixNano = 0
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TimeUnixNano = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+		case 3:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			m.Value = 0
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Value = int64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpanId", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetrics
+				}
+				if iNdEx >= l {
 					return io.ErrUnexpectedEOF
 				}
 				b := dAtA[iNdEx]
@@ -5462,7 +6931,7 @@ func (m *IntExemplar) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DoubleExemplar) Unmarshal(dAtA []byte) error {
+func (m *Exemplar) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5485,10 +6954,10 @@ func (m *DoubleExemplar) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DoubleExemplar: wiretype end group for non-group")
+			return fmt.Errorf("proto: Exemplar: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DoubleExemplar: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Exemplar: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -5537,7 +7006,7 @@ func (m *DoubleExemplar) Unmarshal(dAtA []byte) error {
 			iNdEx += 8
 		case 3:
 			if wireType != 1 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AsDouble", wireType)
 			}
 			var v uint64
 			if (iNdEx + 8) > l {
@@ -5545,7 +7014,7 @@ func (m *DoubleExemplar) Unmarshal(dAtA []byte) error {
 			}
 			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
 			iNdEx += 8
-			m.Value = float64(math.Float64frombits(v))
+			m.Value = &Exemplar_AsDouble{float64(math.Float64frombits(v))}
 		case 4:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field SpanId", wireType)
@@ -5614,6 +7083,51 @@ func (m *DoubleExemplar) Unmarshal(dAtA []byte) error {
 				m.TraceId = []byte{}
 			}
 			iNdEx = postIndex
+		case 6:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AsInt", wireType)
+			}
+			var v int64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = int64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.Value = &Exemplar_AsInt{v}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FilteredAttributes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetrics
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMetrics
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMetrics
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FilteredAttributes = append(m.FilteredAttributes, &v11.KeyValue{})
+			if err := m.FilteredAttributes[len(m.FilteredAttributes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMetrics(dAtA[iNdEx:])
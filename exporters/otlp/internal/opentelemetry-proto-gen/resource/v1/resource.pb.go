@@ -91,7 +91,7 @@ func init() {
 }
 
 var fileDescriptor_446f73eacf88f3f5 = []byte{
-	// 260 bytes of a gzipped FileDescriptorProto
+	// 257 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0xd2, 0xcb, 0x2f, 0x48, 0xcd,
 	0x2b, 0x49, 0xcd, 0x49, 0xcd, 0x4d, 0x2d, 0x29, 0xaa, 0xd4, 0x2f, 0x28, 0xca, 0x2f, 0xc9, 0xd7,
 	0x2f, 0x4a, 0x2d, 0xce, 0x2f, 0x2d, 0x4a, 0x4e, 0xd5, 0x2f, 0x33, 0x84, 0xb3, 0xf5, 0xc0, 0x52,
@@ -107,8 +107,8 @@ var fileDescriptor_446f73eacf88f3f5 = []byte{
 	0xc6, 0xa8, 0x90, 0x74, 0x74, 0x4d, 0x99, 0xf9, 0xfa, 0xf9, 0x25, 0xa9, 0x39, 0xfa, 0xa9, 0x15,
 	0x05, 0xf9, 0x45, 0x25, 0xa9, 0x45, 0xc5, 0xfa, 0xf9, 0x25, 0x39, 0x05, 0xfa, 0x99, 0x79, 0x25,
 	0xa9, 0x45, 0x79, 0x89, 0x39, 0xfa, 0x28, 0xaa, 0x75, 0xc1, 0x56, 0xe8, 0xa6, 0xa7, 0xe6, 0x21,
-	0xc7, 0x42, 0x12, 0x1b, 0x58, 0xd8, 0x18, 0x10, 0x00, 0x00, 0xff, 0xff, 0x40, 0xde, 0xfb, 0xf4,
-	0xaf, 0x01, 0x00, 0x00,
+	0xc7, 0x42, 0x12, 0x1b, 0x58, 0xd8, 0x18, 0x30, 0x00, 0x40, 0xde, 0xfb, 0xf4, 0xaf, 0x01, 0x00,
+	0x00,
 }
 
 func (m *Resource) Marshal() (dAtA []byte, err error) {
@@ -34,7 +34,7 @@ const (
 	// Implementations MAY assume SpanKind to be INTERNAL when receiving UNSPECIFIED.
 	Span_SPAN_KIND_UNSPECIFIED Span_SpanKind = 0
 	// Indicates that the span represents an internal operation within an application,
-	// as opposed to an operations happening at the boundaries. Default value.
+	// as opposed to an operation happening at the boundaries. Default value.
 	Span_SPAN_KIND_INTERNAL Span_SpanKind = 1
 	// Indicates that the span covers server-side handling of an RPC or other
 	// remote network request.
@@ -149,7 +149,7 @@ func (Status_DeprecatedStatusCode) EnumDescriptor() ([]byte, []int) {
 }
 
 // For the semantics of status codes see
-// https://github.com/open-telemetry/opentelemetry-specification/blob/master/specification/trace/api.md#set-status
+// https://github.com/open-telemetry/opentelemetry-specification/blob/main/specification/trace/api.md#set-status
 type Status_StatusCode int32
 
 const (
@@ -189,9 +189,13 @@ type ResourceSpans struct {
 	Resource *v1.Resource `protobuf:"bytes,1,opt,name=resource,proto3" json:"resource,omitempty"`
 	// A list of InstrumentationLibrarySpans that originate from a resource.
 	InstrumentationLibrarySpans []*InstrumentationLibrarySpans `protobuf:"bytes,2,rep,name=instrumentation_library_spans,json=instrumentationLibrarySpans,proto3" json:"instrumentation_library_spans,omitempty"`
-	XXX_NoUnkeyedLiteral        struct{}                       `json:"-"`
-	XXX_unrecognized            []byte                         `json:"-"`
-	XXX_sizecache               int32                          `json:"-"`
+	// This schema_url applies to the data in the "resource" field. It does not apply
+	// to the data in the "instrumentation_library_spans" field which have their own
+	// schema_url field.
+	SchemaUrl            string   `protobuf:"bytes,3,opt,name=schema_url,json=schemaUrl,proto3" json:"schema_url,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *ResourceSpans) Reset()         { *m = ResourceSpans{} }
@@ -241,13 +245,23 @@ func (m *ResourceSpans) GetInstrumentationLibrarySpans() []*InstrumentationLibra
 	return nil
 }
 
+func (m *ResourceSpans) GetSchemaUrl() string {
+	if m != nil {
+		return m.SchemaUrl
+	}
+	return ""
+}
+
 // A collection of Spans produced by an InstrumentationLibrary.
 type InstrumentationLibrarySpans struct {
 	// The instrumentation library information for the spans in this message.
-	// If this field is not set then no library info is known.
+	// Semantically when InstrumentationLibrary isn't set, it is equivalent with
+	// an empty instrumentation library name (unknown).
 	InstrumentationLibrary *v11.InstrumentationLibrary `protobuf:"bytes,1,opt,name=instrumentation_library,json=instrumentationLibrary,proto3" json:"instrumentation_library,omitempty"`
 	// A list of Spans that originate from an instrumentation library.
-	Spans                []*Span  `protobuf:"bytes,2,rep,name=spans,proto3" json:"spans,omitempty"`
+	Spans []*Span `protobuf:"bytes,2,rep,name=spans,proto3" json:"spans,omitempty"`
+	// This schema_url applies to all spans and span events in the "spans" field.
+	SchemaUrl            string   `protobuf:"bytes,3,opt,name=schema_url,json=schemaUrl,proto3" json:"schema_url,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -300,6 +314,13 @@ func (m *InstrumentationLibrarySpans) GetSpans() []*Span {
 	return nil
 }
 
+func (m *InstrumentationLibrarySpans) GetSchemaUrl() string {
+	if m != nil {
+		return m.SchemaUrl
+	}
+	return ""
+}
+
 // Span represents a single operation within a trace. Spans can be
 // nested to form a trace tree. Spans may also be linked to other spans
 // from the same or different trace and form graphs. Often, a trace
@@ -797,80 +818,81 @@ func init() {
 }
 
 var fileDescriptor_5c407ac9c675a601 = []byte{
-	// 1160 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x56, 0x41, 0x6f, 0xdc, 0x44,
-	0x14, 0xae, 0x93, 0xdd, 0x4d, 0xfa, 0x92, 0x6c, 0xdc, 0x21, 0x6d, 0xdd, 0x94, 0xa6, 0xab, 0x25,
-	0xb4, 0xdb, 0x96, 0xee, 0xd2, 0x22, 0xa4, 0x22, 0x81, 0xc0, 0xb1, 0x27, 0xad, 0x15, 0xc7, 0x5e,
-	0xc6, 0x76, 0x28, 0x5c, 0x46, 0x6e, 0x3c, 0x54, 0x56, 0x77, 0xc7, 0x2b, 0x7b, 0x36, 0x6a, 0x0f,
-	0xfc, 0x05, 0xee, 0x48, 0xfc, 0x1c, 0x24, 0x38, 0x72, 0xe1, 0xc2, 0x01, 0xa1, 0xfe, 0x0e, 0x0e,
-	0xc8, 0x63, 0x6f, 0x92, 0x8d, 0x62, 0xa7, 0x97, 0x5e, 0xa2, 0xf1, 0xf7, 0xbe, 0xef, 0x7d, 0xef,
-	0xcd, 0x7b, 0xa3, 0x0d, 0xf4, 0x92, 0x09, 0xe3, 0x82, 0x8d, 0xd8, 0x98, 0x89, 0xf4, 0xcd, 0x60,
-	0x92, 0x26, 0x22, 0x19, 0x88, 0x34, 0x3c, 0x64, 0x83, 0xa3, 0x47, 0xc5, 0xa1, 0x2f, 0x41, 0xf4,
-	0xe1, 0x1c, 0xb3, 0x00, 0xfb, 0x05, 0xe1, 0xe8, 0xd1, 0xe6, 0xfd, 0xf3, 0xf2, 0x1c, 0x26, 0xe3,
-	0x71, 0xc2, 0xf3, 0x44, 0xc5, 0xa9, 0x10, 0x6d, 0xf6, 0xcf, 0xe3, 0xa6, 0x2c, 0x4b, 0xa6, 0x69,
-	0x61, 0x3b, 0x3b, 0x17, 0xfc, 0xee, 0x5f, 0x0a, 0xac, 0x91, 0x12, 0xf2, 0x26, 0x21, 0xcf, 0x10,
-	0x86, 0xe5, 0x19, 0x47, 0x53, 0x3a, 0x4a, 0x6f, 0xe5, 0xf1, 0xbd, 0xfe, 0x79, 0xe5, 0x1d, 0x27,
-	0x3a, 0x7a, 0xd4, 0x9f, 0x65, 0x20, 0xc7, 0x52, 0xf4, 0x13, 0xdc, 0x8a, 0x79, 0x26, 0xd2, 0xe9,
-	0x98, 0x71, 0x11, 0x8a, 0x38, 0xe1, 0x74, 0x14, 0xbf, 0x48, 0xc3, 0xf4, 0x0d, 0xcd, 0x72, 0x1f,
-	0x6d, 0xa1, 0xb3, 0xd8, 0x5b, 0x79, 0xfc, 0x45, 0xbf, 0xae, 0xf5, 0xbe, 0x35, 0x9f, 0xc2, 0x2e,
-	0x32, 0xc8, 0x42, 0xc9, 0xcd, 0xb8, 0x3a, 0xd8, 0xfd, 0x5d, 0x81, 0x9b, 0x35, 0x62, 0xc4, 0xe1,
-	0x7a, 0x45, 0x79, 0x65, 0xd3, 0x9f, 0x9f, 0x5b, 0x58, 0x79, 0xd7, 0x95, 0x95, 0x91, 0x6b, 0xe7,
-	0x17, 0x85, 0x9e, 0x40, 0xf3, 0x74, 0xdb, 0xdd, 0xfa, 0xb6, 0xf3, 0x1a, 0x49, 0x21, 0xe8, 0xfe,
-	0x0a, 0xd0, 0xc8, 0xbf, 0xd1, 0x0d, 0x58, 0x96, 0x04, 0x1a, 0x47, 0xb2, 0xc6, 0x55, 0xb2, 0x24,
-	0xbf, 0xad, 0x08, 0x5d, 0x87, 0xa5, 0x9c, 0x9c, 0x47, 0x16, 0x64, 0xa4, 0x95, 0x7f, 0x5a, 0x11,
-	0xba, 0x0d, 0x2b, 0x85, 0x26, 0x13, 0xa1, 0x60, 0xda, 0x62, 0x47, 0xe9, 0x5d, 0x26, 0x20, 0x21,
-	0x2f, 0x47, 0xd0, 0x36, 0xb4, 0x27, 0x61, 0xca, 0xb8, 0xa0, 0xb3, 0x04, 0x0d, 0x99, 0x60, 0xb5,
-	0x40, 0xbd, 0x22, 0x0d, 0x82, 0x06, 0x0f, 0xc7, 0x4c, 0x6b, 0x4a, 0xbd, 0x3c, 0xa3, 0xaf, 0xa1,
-	0xf1, 0x2a, 0xe6, 0x91, 0xd6, 0xea, 0x28, 0xbd, 0xf6, 0xe3, 0x07, 0x17, 0x37, 0x24, 0xff, 0xec,
-	0xc5, 0x3c, 0x22, 0x52, 0x88, 0x06, 0xb0, 0x91, 0x89, 0x30, 0x15, 0x54, 0xc4, 0x63, 0x46, 0xa7,
-	0x3c, 0x7e, 0x4d, 0x79, 0xc8, 0x13, 0x6d, 0xa9, 0xa3, 0xf4, 0x5a, 0xe4, 0x8a, 0x8c, 0xf9, 0xf1,
-	0x98, 0x05, 0x3c, 0x7e, 0xed, 0x84, 0x3c, 0x41, 0x0f, 0x00, 0x31, 0x1e, 0x9d, 0xa5, 0x2f, 0x4b,
-	0xfa, 0x3a, 0xe3, 0xd1, 0x1c, 0xf9, 0x29, 0x40, 0x28, 0x44, 0x1a, 0xbf, 0x98, 0x0a, 0x96, 0x69,
-	0x97, 0xe5, 0xad, 0xdf, 0xbd, 0x60, 0xa6, 0x7b, 0xec, 0xcd, 0x41, 0x38, 0x9a, 0x32, 0x72, 0x4a,
-	0x8a, 0x9e, 0x80, 0x16, 0xa5, 0xc9, 0x64, 0xc2, 0x22, 0x7a, 0x82, 0xd2, 0xc3, 0x64, 0xca, 0x85,
-	0x06, 0x1d, 0xa5, 0xb7, 0x46, 0xae, 0x95, 0x71, 0xfd, 0x38, 0x6c, 0xe4, 0x51, 0xf4, 0x0d, 0xb4,
-	0xd8, 0x11, 0xe3, 0x22, 0xd3, 0x56, 0xa4, 0x7d, 0xef, 0x1d, 0xee, 0x08, 0xe7, 0x02, 0x52, 0xea,
-	0xd0, 0xa7, 0xb0, 0x31, 0xf3, 0x2e, 0x90, 0xd2, 0x77, 0x55, 0xfa, 0xa2, 0x32, 0x26, 0x35, 0xa5,
-	0xe7, 0x57, 0xd0, 0x1c, 0xc5, 0xfc, 0x55, 0xa6, 0xad, 0xd5, 0x74, 0x3c, 0x6f, 0x69, 0xc7, 0xfc,
-	0x15, 0x29, 0x54, 0xa8, 0x0f, 0x1f, 0xcc, 0x0c, 0x25, 0x50, 0xfa, 0xb5, 0xa5, 0xdf, 0x95, 0x32,
-	0x94, 0x0b, 0x4a, 0xbb, 0x2f, 0xa1, 0x95, 0x6f, 0xd6, 0x34, 0xd3, 0xd6, 0xe5, 0xab, 0xd9, 0xbe,
-	0xc0, 0x4f, 0x72, 0x49, 0xa9, 0xd9, 0xfc, 0x4d, 0x81, 0xa6, 0x2c, 0x3e, 0x5f, 0xc3, 0x33, 0x63,
-	0x55, 0xe4, 0x58, 0x57, 0xc5, 0xe9, 0x99, 0xce, 0xd6, 0x70, 0xe1, 0xd4, 0x1a, 0xce, 0xcf, 0x79,
-	0xf1, 0xfd, 0xcc, 0xb9, 0x51, 0x37, 0xe7, 0xcd, 0x7f, 0x14, 0x68, 0xe4, 0x77, 0xf2, 0x7e, 0x5e,
-	0xe8, 0x7c, 0x83, 0x8d, 0xf7, 0xd3, 0x60, 0xb3, 0xae, 0xc1, 0xee, 0x2f, 0x0a, 0x2c, 0xcf, 0x1e,
-	0x2f, 0xba, 0x01, 0x57, 0xbd, 0xa1, 0xee, 0xd0, 0x3d, 0xcb, 0x31, 0x69, 0xe0, 0x78, 0x43, 0x6c,
-	0x58, 0xbb, 0x16, 0x36, 0xd5, 0x4b, 0xe8, 0x1a, 0xa0, 0x93, 0x90, 0xe5, 0xf8, 0x98, 0x38, 0xba,
-	0xad, 0x2a, 0x68, 0x03, 0xd4, 0x13, 0xdc, 0xc3, 0xe4, 0x00, 0x13, 0x75, 0x61, 0x1e, 0x35, 0x6c,
-	0x0b, 0x3b, 0xbe, 0xba, 0x38, 0x9f, 0x63, 0x48, 0x5c, 0x33, 0x30, 0x30, 0x51, 0x1b, 0xf3, 0xb8,
-	0xe1, 0x3a, 0x5e, 0xb0, 0x8f, 0x89, 0xda, 0xec, 0xfe, 0xb7, 0x04, 0xad, 0x62, 0xad, 0xd0, 0x8f,
-	0xb0, 0x1e, 0xb1, 0x49, 0xca, 0x0e, 0x43, 0xc1, 0x22, 0x7a, 0x98, 0x44, 0xc5, 0x0f, 0x58, 0xfb,
-	0xa2, 0x1f, 0x99, 0x42, 0xde, 0x37, 0x8f, 0xb5, 0x05, 0x60, 0x24, 0x11, 0xdb, 0x59, 0xd0, 0x14,
-	0xd2, 0x3e, 0xc9, 0x9a, 0x63, 0x48, 0x83, 0xa5, 0x31, 0xcb, 0xb2, 0xf0, 0xe5, 0x6c, 0x13, 0x67,
-	0x9f, 0xc8, 0x80, 0x86, 0xb4, 0x5d, 0x94, 0xb6, 0x83, 0x77, 0xb2, 0x3d, 0x31, 0x23, 0x52, 0xdc,
-	0xfd, 0xbb, 0x09, 0x1b, 0xe7, 0xd5, 0x82, 0x6e, 0xc1, 0x0d, 0x13, 0x0f, 0x09, 0x36, 0x74, 0x1f,
-	0x9b, 0xd4, 0xf3, 0x75, 0x3f, 0xf0, 0xa8, 0xe1, 0x9a, 0x98, 0xba, 0x7b, 0xea, 0x25, 0xb4, 0x0d,
-	0x9d, 0x8a, 0xb0, 0xa1, 0x3b, 0x06, 0xb6, 0x6d, 0x6c, 0xaa, 0x0a, 0xea, 0xc1, 0x76, 0x05, 0x2b,
-	0x70, 0xf6, 0x1c, 0xf7, 0x3b, 0x87, 0x62, 0x42, 0xdc, 0x7c, 0x3e, 0x0f, 0xe0, 0x6e, 0x05, 0xd3,
-	0x72, 0x0e, 0x74, 0xdb, 0x32, 0xa9, 0x4e, 0x9e, 0x06, 0xfb, 0xc5, 0xd8, 0x3e, 0x81, 0x5e, 0x05,
-	0xd9, 0xc4, 0xba, 0x69, 0x5b, 0x0e, 0xa6, 0xf8, 0xb9, 0x81, 0xb1, 0x89, 0x4d, 0xb5, 0x51, 0x53,
-	0xaa, 0xe3, 0xfa, 0x74, 0xd7, 0x0d, 0x1c, 0x53, 0x6d, 0xa2, 0x7b, 0xf0, 0x71, 0x05, 0x4b, 0xb7,
-	0x09, 0xd6, 0xcd, 0xef, 0x29, 0x7e, 0x6e, 0x79, 0xbe, 0xa7, 0xb6, 0x6a, 0xec, 0x87, 0x98, 0xec,
-	0x5b, 0x9e, 0x67, 0xb9, 0x0e, 0x35, 0xb1, 0x93, 0xef, 0xe9, 0x12, 0x7a, 0x08, 0xf7, 0x2a, 0xd8,
-	0x04, 0x7b, 0x6e, 0x40, 0x8c, 0xbc, 0xd8, 0x67, 0x7a, 0xe0, 0xf9, 0xd8, 0x54, 0x97, 0x51, 0x1f,
-	0xee, 0x57, 0xd0, 0x77, 0x75, 0xcb, 0xc6, 0xf9, 0x9a, 0x62, 0xc3, 0x75, 0x4c, 0xcb, 0xb7, 0x5c,
-	0x47, 0xbd, 0x8c, 0xba, 0xb0, 0x55, 0x55, 0xf7, 0x8e, 0x4b, 0xf2, 0x9c, 0x80, 0xee, 0xc2, 0x47,
-	0x55, 0xb3, 0x0c, 0x7c, 0xea, 0xee, 0x52, 0xa2, 0x3b, 0x4f, 0xb1, 0xba, 0x52, 0x3b, 0x2f, 0x6b,
-	0x7f, 0x68, 0xe3, 0x7c, 0x00, 0xd8, 0x54, 0x57, 0x6b, 0xae, 0x6b, 0xf6, 0x14, 0xcb, 0xd1, 0xae,
-	0xa1, 0x3b, 0xd0, 0xad, 0x4c, 0xaa, 0x1f, 0xe8, 0x96, 0xad, 0xef, 0xd8, 0x58, 0x6d, 0xd7, 0xcc,
-	0xc9, 0xd4, 0x7d, 0x9d, 0xda, 0xae, 0xe7, 0xa9, 0xeb, 0xe8, 0x3e, 0xdc, 0xa9, 0xce, 0x16, 0xf8,
-	0xcf, 0xb0, 0xe3, 0x5b, 0x32, 0xa6, 0xaa, 0x5d, 0x07, 0xe0, 0xd4, 0x46, 0x5f, 0x85, 0x2b, 0xf3,
-	0x74, 0x0f, 0xfb, 0xea, 0x25, 0x84, 0xa0, 0x7d, 0x66, 0xbb, 0x95, 0xb3, 0xd4, 0x72, 0x49, 0x77,
-	0x7e, 0x56, 0xfe, 0x78, 0xbb, 0xa5, 0xfc, 0xf9, 0x76, 0x4b, 0xf9, 0xf7, 0xed, 0x96, 0x02, 0xb7,
-	0xe3, 0xa4, 0xf6, 0xd1, 0xed, 0x80, 0x9f, 0x9f, 0x86, 0x39, 0x38, 0x54, 0x7e, 0xf8, 0xf6, 0xe5,
-	0x59, 0x7a, 0x9c, 0x0c, 0x12, 0xc1, 0x46, 0x03, 0xf6, 0x7a, 0x92, 0xa4, 0x82, 0xa5, 0xd9, 0x20,
-	0x11, 0xa3, 0xc9, 0x20, 0xe6, 0x82, 0xa5, 0x3c, 0x1c, 0x0d, 0xe6, 0xd8, 0x0f, 0x65, 0xf2, 0x87,
-	0x2f, 0x19, 0x3f, 0xfe, 0xb7, 0xfe, 0x45, 0x4b, 0x62, 0x9f, 0xfd, 0x1f, 0x00, 0x00, 0xff, 0xff,
-	0xc6, 0x22, 0x3f, 0x48, 0xfd, 0x0b, 0x00, 0x00,
+	// 1180 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x57, 0x41, 0x6f, 0xdb, 0x46,
+	0x13, 0x0d, 0x6d, 0x49, 0xb6, 0xc7, 0xb6, 0xcc, 0xec, 0xe7, 0x24, 0x8c, 0xf3, 0xc5, 0x11, 0x54,
+	0x37, 0x51, 0x92, 0x46, 0x6a, 0x52, 0x14, 0x48, 0x81, 0x16, 0x2d, 0x4d, 0xae, 0x13, 0xc2, 0x34,
+	0xa9, 0x2e, 0x49, 0x37, 0xed, 0x65, 0xc1, 0x98, 0xdb, 0x94, 0x88, 0xb4, 0x14, 0xc8, 0x95, 0x91,
+	0x1c, 0xfa, 0x17, 0x7a, 0x2f, 0xd0, 0x9f, 0xd3, 0x43, 0x8f, 0x3d, 0xf7, 0x50, 0x14, 0x39, 0xf4,
+	0x17, 0xf4, 0xd8, 0x43, 0xc1, 0x25, 0x65, 0x5b, 0x86, 0x28, 0xe7, 0xe2, 0x8b, 0xb1, 0x7c, 0xf3,
+	0xe6, 0xbd, 0x99, 0x9d, 0x59, 0x08, 0x86, 0x4e, 0x32, 0x62, 0x5c, 0xb0, 0x01, 0x1b, 0x32, 0x91,
+	0xbe, 0xed, 0x8d, 0xd2, 0x44, 0x24, 0x3d, 0x91, 0x86, 0x47, 0xac, 0x77, 0xfc, 0xb8, 0x38, 0x74,
+	0x25, 0x88, 0xfe, 0x3f, 0xc5, 0x2c, 0xc0, 0x6e, 0x41, 0x38, 0x7e, 0xbc, 0xf5, 0x60, 0x96, 0xce,
+	0x51, 0x32, 0x1c, 0x26, 0x3c, 0x17, 0x2a, 0x4e, 0x45, 0xd2, 0x56, 0x77, 0x16, 0x37, 0x65, 0x59,
+	0x32, 0x4e, 0x0b, 0xdb, 0xc9, 0xb9, 0xe0, 0xb7, 0xff, 0x51, 0x60, 0x9d, 0x94, 0x90, 0x37, 0x0a,
+	0x79, 0x86, 0x30, 0x2c, 0x4f, 0x38, 0x9a, 0xd2, 0x52, 0x3a, 0xab, 0x4f, 0xee, 0x77, 0x67, 0x95,
+	0x77, 0x22, 0x74, 0xfc, 0xb8, 0x3b, 0x51, 0x20, 0x27, 0xa9, 0xe8, 0x47, 0xb8, 0x1d, 0xf3, 0x4c,
+	0xa4, 0xe3, 0x21, 0xe3, 0x22, 0x14, 0x71, 0xc2, 0xe9, 0x20, 0x7e, 0x99, 0x86, 0xe9, 0x5b, 0x9a,
+	0xe5, 0x3e, 0xda, 0x42, 0x6b, 0xb1, 0xb3, 0xfa, 0xe4, 0xb3, 0xee, 0xbc, 0xd6, 0xbb, 0xd6, 0xb4,
+	0x84, 0x5d, 0x28, 0xc8, 0x42, 0xc9, 0xad, 0xb8, 0x3a, 0x88, 0x6e, 0x03, 0x64, 0x47, 0x3f, 0xb0,
+	0x61, 0x48, 0xc7, 0xe9, 0x40, 0x5b, 0x6c, 0x29, 0x9d, 0x15, 0xb2, 0x52, 0x20, 0x41, 0x3a, 0x68,
+	0xff, 0xad, 0xc0, 0xad, 0x39, 0xda, 0x88, 0xc3, 0x8d, 0x8a, 0xea, 0xcb, 0x3b, 0xf9, 0x74, 0x66,
+	0xdd, 0xe5, 0x28, 0x2a, 0x0b, 0x27, 0xd7, 0x67, 0xd7, 0x8c, 0x9e, 0x42, 0xfd, 0xec, 0xad, 0xb4,
+	0xe7, 0xdf, 0x4a, 0x5e, 0x23, 0xa9, 0x67, 0xef, 0xd3, 0xe8, 0x2f, 0x00, 0xb5, 0x9c, 0x8e, 0x6e,
+	0xc2, 0xb2, 0xcc, 0xa7, 0x71, 0x24, 0x5b, 0x58, 0x23, 0x4b, 0xf2, 0xdb, 0x8a, 0xd0, 0x0d, 0x58,
+	0xca, 0xb5, 0xf2, 0xc8, 0x82, 0x8c, 0x34, 0xf2, 0x4f, 0x2b, 0x42, 0x77, 0x60, 0xb5, 0xc8, 0xc9,
+	0x44, 0x28, 0x58, 0x29, 0x0e, 0x12, 0xf2, 0x72, 0x04, 0xed, 0x40, 0x73, 0x14, 0xa6, 0x8c, 0x0b,
+	0x3a, 0x11, 0xa8, 0x49, 0x81, 0xb5, 0x02, 0xf5, 0x0a, 0x19, 0x04, 0x35, 0x1e, 0x0e, 0x99, 0x56,
+	0x97, 0xf9, 0xf2, 0x8c, 0xbe, 0x84, 0xda, 0xeb, 0x98, 0x47, 0x5a, 0xa3, 0xa5, 0x74, 0x9a, 0x4f,
+	0x1e, 0x5e, 0xdc, 0xaf, 0xfc, 0xb3, 0x1f, 0xf3, 0x88, 0xc8, 0x44, 0xd4, 0x83, 0xcd, 0x4c, 0x84,
+	0xa9, 0xa0, 0x22, 0x1e, 0x32, 0x3a, 0xe6, 0xf1, 0x1b, 0xca, 0x43, 0x9e, 0x68, 0x4b, 0x2d, 0xa5,
+	0xd3, 0x20, 0x57, 0x65, 0xcc, 0x8f, 0x87, 0x2c, 0xe0, 0xf1, 0x1b, 0x27, 0xe4, 0x09, 0x7a, 0x08,
+	0x88, 0xf1, 0xe8, 0x3c, 0x7d, 0x59, 0xd2, 0x37, 0x18, 0x8f, 0xa6, 0xc8, 0xcf, 0x00, 0x42, 0x21,
+	0xd2, 0xf8, 0xe5, 0x58, 0xb0, 0x4c, 0x5b, 0x91, 0x43, 0xb9, 0x77, 0xc1, 0xc8, 0xf7, 0xd9, 0xdb,
+	0xc3, 0x70, 0x30, 0x66, 0xe4, 0x4c, 0x2a, 0x7a, 0x0a, 0x5a, 0x94, 0x26, 0xa3, 0x11, 0x8b, 0xe8,
+	0x29, 0x4a, 0x8f, 0x92, 0x31, 0x17, 0x1a, 0xb4, 0x94, 0xce, 0x3a, 0xb9, 0x5e, 0xc6, 0xf5, 0x93,
+	0xb0, 0x91, 0x47, 0xd1, 0x57, 0xd0, 0x60, 0xc7, 0x8c, 0x8b, 0x4c, 0x5b, 0x95, 0xf6, 0x9d, 0xf7,
+	0xb8, 0x23, 0x9c, 0x27, 0x90, 0x32, 0x0f, 0x7d, 0x0c, 0x9b, 0x13, 0xef, 0x02, 0x29, 0x7d, 0xd7,
+	0xa4, 0x2f, 0x2a, 0x63, 0x32, 0xa7, 0xf4, 0xfc, 0x02, 0xea, 0x83, 0x98, 0xbf, 0xce, 0xb4, 0xf5,
+	0x39, 0x1d, 0x4f, 0x5b, 0xda, 0x31, 0x7f, 0x4d, 0x8a, 0x2c, 0xd4, 0x85, 0xff, 0x4d, 0x0c, 0x25,
+	0x50, 0xfa, 0x35, 0xa5, 0xdf, 0xd5, 0x32, 0x94, 0x27, 0x94, 0x76, 0x9f, 0x43, 0x23, 0xdf, 0xac,
+	0x71, 0xa6, 0x6d, 0xc8, 0x47, 0xb5, 0x73, 0x81, 0x9f, 0xe4, 0x92, 0x32, 0x67, 0xeb, 0x57, 0x05,
+	0xea, 0xb2, 0xf8, 0x7c, 0x0d, 0xcf, 0x8d, 0x55, 0x91, 0x63, 0x5d, 0x13, 0x67, 0x67, 0x3a, 0x59,
+	0xc3, 0x85, 0x33, 0x6b, 0x38, 0x3d, 0xe7, 0xc5, 0xcb, 0x99, 0x73, 0x6d, 0xde, 0x9c, 0xb7, 0xfe,
+	0x54, 0xa0, 0x96, 0xdf, 0xc9, 0xe5, 0xbc, 0xd0, 0xe9, 0x06, 0x6b, 0x97, 0xd3, 0x60, 0x7d, 0x5e,
+	0x83, 0xed, 0x9f, 0x15, 0x58, 0x9e, 0x3c, 0x5e, 0x74, 0x13, 0xae, 0x79, 0x7d, 0xdd, 0xa1, 0xfb,
+	0x96, 0x63, 0xd2, 0xc0, 0xf1, 0xfa, 0xd8, 0xb0, 0xf6, 0x2c, 0x6c, 0xaa, 0x57, 0xd0, 0x75, 0x40,
+	0xa7, 0x21, 0xcb, 0xf1, 0x31, 0x71, 0x74, 0x5b, 0x55, 0xd0, 0x26, 0xa8, 0xa7, 0xb8, 0x87, 0xc9,
+	0x21, 0x26, 0xea, 0xc2, 0x34, 0x6a, 0xd8, 0x16, 0x76, 0x7c, 0x75, 0x71, 0x5a, 0xa3, 0x4f, 0x5c,
+	0x33, 0x30, 0x30, 0x51, 0x6b, 0xd3, 0xb8, 0xe1, 0x3a, 0x5e, 0x70, 0x80, 0x89, 0x5a, 0x6f, 0xff,
+	0xbb, 0x04, 0x8d, 0x62, 0xad, 0xd0, 0xf7, 0xb0, 0x11, 0xb1, 0x51, 0xca, 0x8e, 0x42, 0xc1, 0x22,
+	0x7a, 0x94, 0x44, 0xc5, 0xcf, 0x5f, 0xf3, 0xa2, 0x9f, 0xa8, 0x22, 0xbd, 0x6b, 0x9e, 0xe4, 0x16,
+	0x80, 0x91, 0x44, 0x6c, 0x77, 0x41, 0x53, 0x48, 0xf3, 0x54, 0x35, 0xc7, 0x90, 0x06, 0x4b, 0x43,
+	0x96, 0x65, 0xe1, 0xab, 0xc9, 0x26, 0x4e, 0x3e, 0x91, 0x01, 0x35, 0x69, 0xbb, 0x28, 0x6d, 0x7b,
+	0xef, 0x65, 0x7b, 0x6a, 0x46, 0x64, 0x72, 0xfb, 0x8f, 0x3a, 0x6c, 0xce, 0xaa, 0x05, 0xdd, 0x86,
+	0x9b, 0x26, 0xee, 0x13, 0x6c, 0xe8, 0x3e, 0x36, 0xa9, 0xe7, 0xeb, 0x7e, 0xe0, 0x51, 0xc3, 0x35,
+	0x31, 0x75, 0xf7, 0xd5, 0x2b, 0x68, 0x07, 0x5a, 0x15, 0x61, 0x43, 0x77, 0x0c, 0x6c, 0xdb, 0xd8,
+	0x54, 0x15, 0xd4, 0x81, 0x9d, 0x0a, 0x56, 0xe0, 0xec, 0x3b, 0xee, 0x37, 0x0e, 0xc5, 0x84, 0xb8,
+	0xf9, 0x7c, 0x1e, 0xc2, 0xbd, 0x0a, 0xa6, 0xe5, 0x1c, 0xea, 0xb6, 0x65, 0x52, 0x9d, 0x3c, 0x0b,
+	0x0e, 0x8a, 0xb1, 0x7d, 0x04, 0x9d, 0x0a, 0xb2, 0x89, 0x75, 0xd3, 0xb6, 0x1c, 0x4c, 0xf1, 0x0b,
+	0x03, 0x63, 0x13, 0x9b, 0x6a, 0x6d, 0x4e, 0xa9, 0x8e, 0xeb, 0xd3, 0x3d, 0x37, 0x70, 0x4c, 0xb5,
+	0x8e, 0xee, 0xc3, 0x87, 0x15, 0x2c, 0xdd, 0x26, 0x58, 0x37, 0xbf, 0xa5, 0xf8, 0x85, 0xe5, 0xf9,
+	0x9e, 0xda, 0x98, 0x63, 0xdf, 0xc7, 0xe4, 0xc0, 0xf2, 0x3c, 0xcb, 0x75, 0xa8, 0x89, 0x9d, 0x7c,
+	0x4f, 0x97, 0xd0, 0x23, 0xb8, 0x5f, 0xc1, 0x26, 0xd8, 0x73, 0x03, 0x62, 0xe4, 0xc5, 0x3e, 0xd7,
+	0x03, 0xcf, 0xc7, 0xa6, 0xba, 0x8c, 0xba, 0xf0, 0xa0, 0x82, 0xbe, 0xa7, 0x5b, 0x36, 0xce, 0xd7,
+	0x14, 0x1b, 0xae, 0x63, 0x5a, 0xbe, 0xe5, 0x3a, 0xea, 0x0a, 0x6a, 0xc3, 0x76, 0x55, 0xdd, 0xbb,
+	0x2e, 0xc9, 0x35, 0x01, 0xdd, 0x83, 0x0f, 0xaa, 0x66, 0x19, 0xf8, 0xd4, 0xdd, 0xa3, 0x44, 0x77,
+	0x9e, 0x61, 0x75, 0x75, 0xee, 0xbc, 0xac, 0x83, 0xbe, 0x8d, 0xf3, 0x01, 0x60, 0x53, 0x5d, 0x9b,
+	0x73, 0x5d, 0x93, 0xa7, 0x58, 0x8e, 0x76, 0x1d, 0xdd, 0x85, 0x76, 0xa5, 0xa8, 0x7e, 0xa8, 0x5b,
+	0xb6, 0xbe, 0x6b, 0x63, 0xb5, 0x39, 0x67, 0x4e, 0xa6, 0xee, 0xeb, 0xd4, 0x76, 0x3d, 0x4f, 0xdd,
+	0x40, 0x0f, 0xe0, 0x6e, 0xb5, 0x5a, 0xe0, 0x3f, 0xc7, 0x8e, 0x6f, 0xc9, 0x98, 0xaa, 0xb6, 0x1d,
+	0x80, 0x33, 0x1b, 0x7d, 0x0d, 0xae, 0x4e, 0xd3, 0x3d, 0xec, 0xab, 0x57, 0x10, 0x82, 0xe6, 0xb9,
+	0xed, 0x56, 0xce, 0x53, 0xcb, 0x25, 0xdd, 0xfd, 0x49, 0xf9, 0xed, 0xdd, 0xb6, 0xf2, 0xfb, 0xbb,
+	0x6d, 0xe5, 0xaf, 0x77, 0xdb, 0x0a, 0xdc, 0x89, 0x93, 0xb9, 0x8f, 0x6e, 0x17, 0xfc, 0xfc, 0xd4,
+	0xcf, 0xc1, 0xbe, 0xf2, 0xdd, 0xd7, 0xaf, 0xce, 0xd3, 0xe3, 0xa4, 0x97, 0x08, 0x36, 0xe8, 0xb1,
+	0x37, 0xa3, 0x24, 0x15, 0x2c, 0xcd, 0x7a, 0x89, 0x18, 0x8c, 0x7a, 0x31, 0x17, 0x2c, 0xe5, 0xe1,
+	0xa0, 0x37, 0xc5, 0x7e, 0x24, 0xc5, 0x1f, 0xbd, 0x62, 0xfc, 0xe4, 0x9f, 0x82, 0x97, 0x0d, 0x89,
+	0x7d, 0xf2, 0xdf, 0x00, 0xfe, 0xad, 0x6f, 0x1b, 0x3b, 0x0c, 0x00, 0x00,
 }
 
 func (m *ResourceSpans) Marshal() (dAtA []byte, err error) {
@@ -897,6 +919,13 @@ func (m *ResourceSpans) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if len(m.SchemaUrl) > 0 {
+		i -= len(m.SchemaUrl)
+		copy(dAtA[i:], m.SchemaUrl)
+		i = encodeVarintTrace(dAtA, i, uint64(len(m.SchemaUrl)))
+		i--
+		dAtA[i] = 0x1a
+	}
 	if len(m.InstrumentationLibrarySpans) > 0 {
 		for iNdEx := len(m.InstrumentationLibrarySpans) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -950,6 +979,13 @@ func (m *InstrumentationLibrarySpans) MarshalToSizedBuffer(dAtA []byte) (int, er
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if len(m.SchemaUrl) > 0 {
+		i -= len(m.SchemaUrl)
+		copy(dAtA[i:], m.SchemaUrl)
+		i = encodeVarintTrace(dAtA, i, uint64(len(m.SchemaUrl)))
+		i--
+		dAtA[i] = 0x1a
+	}
 	if len(m.Spans) > 0 {
 		for iNdEx := len(m.Spans) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -1324,6 +1360,10 @@ func (m *ResourceSpans) Size() (n int) {
 			n += 1 + l + sovTrace(uint64(l))
 		}
 	}
+	l = len(m.SchemaUrl)
+	if l > 0 {
+		n += 1 + l + sovTrace(uint64(l))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -1346,6 +1386,10 @@ func (m *InstrumentationLibrarySpans) Size() (n int) {
 			n += 1 + l + sovTrace(uint64(l))
 		}
 	}
+	l = len(m.SchemaUrl)
+	if l > 0 {
+		n += 1 + l + sovTrace(uint64(l))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -1612,6 +1656,38 @@ func (m *ResourceSpans) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SchemaUrl", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTrace
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTrace
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTrace
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SchemaUrl = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTrace(dAtA[iNdEx:])
@@ -1736,6 +1812,38 @@ func (m *InstrumentationLibrarySpans) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SchemaUrl", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTrace
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTrace
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTrace
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SchemaUrl = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTrace(dAtA[iNdEx:])
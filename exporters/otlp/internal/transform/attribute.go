@@ -82,6 +82,18 @@ func toAttribute(v label.KeyValue) *commonpb.KeyValue {
 				Values: arrayValues(v),
 			},
 		}
+	case label.LIST:
+		result.Value.Value = &commonpb.AnyValue_ArrayValue{
+			ArrayValue: &commonpb.ArrayValue{
+				Values: listValues(v),
+			},
+		}
+	case label.MAP:
+		result.Value.Value = &commonpb.AnyValue_KvlistValue{
+			KvlistValue: &commonpb.KeyValueList{
+				Values: Attributes(v.Value.AsMap()),
+			},
+		}
 	default:
 		result.Value.Value = &commonpb.AnyValue_StringValue{
 			StringValue: "INVALID",
@@ -90,6 +102,17 @@ func toAttribute(v label.KeyValue) *commonpb.KeyValue {
 	return result
 }
 
+// listValues transforms the elements of a LIST value, which may be of
+// different types and may themselves be LIST or MAP values.
+func listValues(kv label.KeyValue) []*commonpb.AnyValue {
+	list := kv.Value.AsList()
+	results := make([]*commonpb.AnyValue, len(list))
+	for i, v := range list {
+		results[i] = toAttribute(label.KeyValue{Value: v}).Value
+	}
+	return results
+}
+
 func arrayValues(kv label.KeyValue) []*commonpb.AnyValue {
 	a := kv.Value.AsArray()
 	aType := reflect.TypeOf(a)
@@ -310,3 +310,48 @@ func newOTelArray(key string, arrayValues []*commonpb.AnyValue) *commonpb.KeyVal
 		},
 	}
 }
+
+func TestNestedAttributes(t *testing.T) {
+	attrs := Attributes([]label.KeyValue{
+		label.List("list",
+			label.StringValue("a"),
+			label.Int64Value(1),
+			label.MapValue(label.Bool("ok", true)),
+		),
+		label.Map("map",
+			label.String("k", "v"),
+			label.List("inner", label.Float64Value(1.5)),
+		),
+	})
+
+	expected := []*commonpb.KeyValue{
+		{
+			Key: "list",
+			Value: &commonpb.AnyValue{Value: &commonpb.AnyValue_ArrayValue{ArrayValue: &commonpb.ArrayValue{
+				Values: []*commonpb.AnyValue{
+					{Value: &commonpb.AnyValue_StringValue{StringValue: "a"}},
+					{Value: &commonpb.AnyValue_IntValue{IntValue: 1}},
+					{Value: &commonpb.AnyValue_KvlistValue{KvlistValue: &commonpb.KeyValueList{
+						Values: []*commonpb.KeyValue{
+							{Key: "ok", Value: &commonpb.AnyValue{Value: &commonpb.AnyValue_BoolValue{BoolValue: true}}},
+						},
+					}}},
+				},
+			}}},
+		},
+		{
+			Key: "map",
+			Value: &commonpb.AnyValue{Value: &commonpb.AnyValue_KvlistValue{KvlistValue: &commonpb.KeyValueList{
+				Values: []*commonpb.KeyValue{
+					{Key: "inner", Value: &commonpb.AnyValue{Value: &commonpb.AnyValue_ArrayValue{ArrayValue: &commonpb.ArrayValue{
+						Values: []*commonpb.AnyValue{
+							{Value: &commonpb.AnyValue_DoubleValue{DoubleValue: 1.5}},
+						},
+					}}}},
+					{Key: "k", Value: &commonpb.AnyValue{Value: &commonpb.AnyValue_StringValue{StringValue: "v"}}},
+				},
+			}}},
+		},
+	}
+	assert.Equal(t, expected, attrs)
+}
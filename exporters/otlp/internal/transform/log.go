@@ -0,0 +1,133 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package transform
+
+import (
+	commonpb "go.opentelemetry.io/otel/exporters/otlp/internal/opentelemetry-proto-gen/common/v1"
+	logspb "go.opentelemetry.io/otel/exporters/otlp/internal/opentelemetry-proto-gen/logs/v1"
+
+	"go.opentelemetry.io/otel/label"
+	export "go.opentelemetry.io/otel/sdk/export/log"
+	"go.opentelemetry.io/otel/sdk/instrumentation"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// w3cTraceFlagRandom is the W3C trace-context trace-flag of the random bit.
+const w3cTraceFlagRandom = byte(0x02)
+
+// LogRecords transforms a slice of log Records into a slice of OTLP
+// ResourceLogs.
+func LogRecords(records []*export.Record) []*logspb.ResourceLogs {
+	if len(records) == 0 {
+		return nil
+	}
+
+	rlm := make(map[label.Distinct]*logspb.ResourceLogs)
+
+	type illKey struct {
+		r  label.Distinct
+		il instrumentation.Library
+	}
+	illm := make(map[illKey]*logspb.InstrumentationLibraryLogs)
+
+	var resources int
+	for _, r := range records {
+		if r == nil {
+			continue
+		}
+
+		rKey := r.Resource.Equivalent()
+		iKey := illKey{
+			r:  rKey,
+			il: r.InstrumentationLibrary,
+		}
+		ill, iOk := illm[iKey]
+		if !iOk {
+			// Either the resource or instrumentation library were unknown.
+			ill = &logspb.InstrumentationLibraryLogs{
+				InstrumentationLibrary: instrumentationLibrary(r.InstrumentationLibrary),
+				Logs:                   []*logspb.LogRecord{},
+				SchemaUrl:              r.InstrumentationLibrary.SchemaURL,
+			}
+		}
+		ill.Logs = append(ill.Logs, logRecord(r))
+		illm[iKey] = ill
+
+		rl, rOk := rlm[rKey]
+		if !rOk {
+			resources++
+			// The resource was unknown.
+			rl = &logspb.ResourceLogs{
+				Resource:                   Resource(r.Resource),
+				InstrumentationLibraryLogs: []*logspb.InstrumentationLibraryLogs{ill},
+				SchemaUrl:                  r.Resource.SchemaURL(),
+			}
+			rlm[rKey] = rl
+			continue
+		}
+
+		// The resource has been seen before, add the instrumentation
+		// library logs if they are new.
+		if !iOk {
+			rl.InstrumentationLibraryLogs = append(rl.InstrumentationLibraryLogs, ill)
+		}
+	}
+
+	// Transform the categorized map into a slice
+	rls := make([]*logspb.ResourceLogs, 0, resources)
+	for _, rl := range rlm {
+		rls = append(rls, rl)
+	}
+	return rls
+}
+
+// logRecord transforms a log Record into an OTLP log record.
+func logRecord(r *export.Record) *logspb.LogRecord {
+	lr := &logspb.LogRecord{
+		SeverityNumber:         logspb.SeverityNumber(r.Severity),
+		SeverityText:           r.SeverityText,
+		Body:                   logBody(r.Body),
+		Attributes:             Attributes(r.Attributes),
+		DroppedAttributesCount: uint32(r.DroppedAttributeCount),
+	}
+	if !r.Timestamp.IsZero() {
+		lr.TimeUnixNano = uint64(r.Timestamp.UnixNano())
+	}
+	if r.SpanContext.IsValid() {
+		lr.TraceId = r.SpanContext.TraceID[:]
+		lr.SpanId = r.SpanContext.SpanID[:]
+		lr.Flags = uint32(traceFlags(r.SpanContext.TraceFlags))
+	}
+	return lr
+}
+
+// traceFlags returns the W3C trace-context trace-flags of flags. Only the
+// sampled and random bits are supported.
+func traceFlags(flags byte) byte {
+	encoded := flags & trace.FlagsSampled
+	if flags&trace.FlagsRandom != 0 {
+		encoded |= w3cTraceFlagRandom
+	}
+	return encoded
+}
+
+// logBody transforms the body of a log record into an OTLP value, or nil
+// if the record has no body.
+func logBody(v label.Value) *commonpb.AnyValue {
+	if v.Type() == label.INVALID {
+		return nil
+	}
+	return toAttribute(label.KeyValue{Value: v}).Value
+}
@@ -0,0 +1,156 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package transform
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	commonpb "go.opentelemetry.io/otel/exporters/otlp/internal/opentelemetry-proto-gen/common/v1"
+	logspb "go.opentelemetry.io/otel/exporters/otlp/internal/opentelemetry-proto-gen/logs/v1"
+	"go.opentelemetry.io/otel/label"
+	"go.opentelemetry.io/otel/log"
+	export "go.opentelemetry.io/otel/sdk/export/log"
+	"go.opentelemetry.io/otel/sdk/instrumentation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	"go.opentelemetry.io/otel/trace"
+)
+
+func TestNilLogRecords(t *testing.T) {
+	assert.Nil(t, LogRecords(nil))
+}
+
+func TestLogRecord(t *testing.T) {
+	ts := time.Unix(1585674086, 1234)
+	r := &export.Record{
+		Timestamp:             ts,
+		Severity:              log.SeverityWarn + 1,
+		SeverityText:          "WARN2",
+		Body:                  label.StringValue("message"),
+		Attributes:            []label.KeyValue{label.Int64("k", 1)},
+		DroppedAttributeCount: 2,
+		SpanContext: trace.SpanContext{
+			TraceID:    trace.TraceID{0x01},
+			SpanID:     trace.SpanID{0x02},
+			TraceFlags: trace.FlagsSampled,
+		},
+	}
+	got := logRecord(r)
+	assert.Equal(t, &logspb.LogRecord{
+		TimeUnixNano:   uint64(ts.UnixNano()),
+		SeverityNumber: logspb.SeverityNumber_SEVERITY_NUMBER_WARN2,
+		SeverityText:   "WARN2",
+		Body: &commonpb.AnyValue{
+			Value: &commonpb.AnyValue_StringValue{StringValue: "message"},
+		},
+		Attributes: []*commonpb.KeyValue{
+			{
+				Key:   "k",
+				Value: &commonpb.AnyValue{Value: &commonpb.AnyValue_IntValue{IntValue: 1}},
+			},
+		},
+		DroppedAttributesCount: 2,
+		Flags:                  1,
+		TraceId:                []byte{0x01, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0},
+		SpanId:                 []byte{0x02, 0, 0, 0, 0, 0, 0, 0},
+	}, got)
+}
+
+func TestLogRecordTraceFlags(t *testing.T) {
+	for _, tc := range []struct {
+		flags byte
+		want  uint32
+	}{
+		{flags: 0, want: 0},
+		{flags: trace.FlagsSampled, want: 0x01},
+		{flags: trace.FlagsRandom, want: 0x02},
+		{flags: trace.FlagsSampled | trace.FlagsRandom, want: 0x03},
+		{flags: trace.FlagsDeferred | trace.FlagsDebug, want: 0},
+	} {
+		got := logRecord(&export.Record{SpanContext: trace.SpanContext{
+			TraceID:    trace.TraceID{0x01},
+			SpanID:     trace.SpanID{0x02},
+			TraceFlags: tc.flags,
+		}})
+		assert.Equalf(t, tc.want, got.Flags, "flags %#x", tc.flags)
+	}
+}
+
+func TestLogRecordWithoutSpanOrBody(t *testing.T) {
+	got := logRecord(&export.Record{Severity: log.SeverityInfo})
+	assert.Equal(t, logspb.SeverityNumber_SEVERITY_NUMBER_INFO, got.SeverityNumber)
+	assert.Zero(t, got.TimeUnixNano)
+	assert.Nil(t, got.Body)
+	assert.Nil(t, got.TraceId)
+	assert.Nil(t, got.SpanId)
+	assert.Zero(t, got.Flags)
+}
+
+func TestLogRecordsGrouping(t *testing.T) {
+	r1 := resource.NewWithAttributes(label.String("service.name", "one"))
+	r2 := resource.NewWithAttributes(label.String("service.name", "two"))
+	lib1 := instrumentation.Library{Name: "lib1"}
+	lib2 := instrumentation.Library{Name: "lib2", Version: "v1"}
+
+	records := []*export.Record{
+		{Resource: r1, InstrumentationLibrary: lib1},
+		{Resource: r1, InstrumentationLibrary: lib2},
+		{Resource: r1, InstrumentationLibrary: lib1},
+		nil,
+		{Resource: r2, InstrumentationLibrary: lib1},
+	}
+	rls := LogRecords(records)
+	require.Len(t, rls, 2)
+
+	counts := make(map[string]map[string]int)
+	for _, rl := range rls {
+		require.Len(t, rl.Resource.Attributes, 1)
+		service := rl.Resource.Attributes[0].Value.GetStringValue()
+		counts[service] = make(map[string]int)
+		for _, ill := range rl.InstrumentationLibraryLogs {
+			counts[service][ill.InstrumentationLibrary.Name] += len(ill.Logs)
+		}
+	}
+	assert.Equal(t, map[string]map[string]int{
+		"one": {"lib1": 2, "lib2": 1},
+		"two": {"lib1": 1},
+	}, counts)
+}
+
+func TestLogRecordsSchemaURL(t *testing.T) {
+	const schemaURL = "https://opentelemetry.io/schemas/1.0.0"
+	rls := LogRecords([]*export.Record{{
+		Resource: resource.NewWithSchemaURL(schemaURL, label.String("rk1", "rv1")),
+	}})
+	require.Len(t, rls, 1)
+	assert.Equal(t, schemaURL, rls[0].GetSchemaUrl())
+}
+
+func TestLogRecordsInstrumentationLibrarySchemaURL(t *testing.T) {
+	const schemaURL = "https://opentelemetry.io/schemas/1.13.0"
+	rls := LogRecords([]*export.Record{{
+		InstrumentationLibrary: instrumentation.Library{
+			Name:      "lib",
+			Version:   "v0.1.0",
+			SchemaURL: schemaURL,
+		},
+	}})
+	require.Len(t, rls, 1)
+	require.Len(t, rls[0].InstrumentationLibraryLogs, 1)
+	assert.Equal(t, schemaURL, rls[0].InstrumentationLibraryLogs[0].GetSchemaUrl())
+}
@@ -141,8 +141,9 @@ func transformer(ctx context.Context, exportSelector export.ExportKindSelector,
 		res := result{
 			Resource: r.Resource(),
 			InstrumentationLibrary: instrumentation.Library{
-				Name:    r.Descriptor().InstrumentationName(),
-				Version: r.Descriptor().InstrumentationVersion(),
+				Name:      r.Descriptor().InstrumentationName(),
+				Version:   r.Descriptor().InstrumentationVersion(),
+				SchemaURL: r.Descriptor().InstrumentationSchemaURL(),
 			},
 			Metric: m,
 			Err:    err,
@@ -164,7 +165,8 @@ func sink(ctx context.Context, in <-chan result) ([]*metricpb.ResourceMetrics, e
 	var errStrings []string
 
 	type resourceBatch struct {
-		Resource *resourcepb.Resource
+		Resource  *resourcepb.Resource
+		SchemaURL string
 		// Group by instrumentation library name and then the MetricDescriptor.
 		InstrumentationLibraryBatches map[instrumentation.Library]map[string]*metricpb.Metric
 	}
@@ -182,6 +184,7 @@ func sink(ctx context.Context, in <-chan result) ([]*metricpb.ResourceMetrics, e
 		if !ok {
 			rb = resourceBatch{
 				Resource:                      Resource(res.Resource),
+				SchemaURL:                     res.Resource.SchemaURL(),
 				InstrumentationLibraryBatches: make(map[instrumentation.Library]map[string]*metricpb.Metric),
 			}
 			grouped[rID] = rb
@@ -206,12 +209,12 @@ func sink(ctx context.Context, in <-chan result) ([]*metricpb.ResourceMetrics, e
 			m.GetIntHistogram().DataPoints = append(m.GetIntHistogram().DataPoints, res.Metric.GetIntHistogram().DataPoints...)
 		case *metricpb.Metric_IntSum:
 			m.GetIntSum().DataPoints = append(m.GetIntSum().DataPoints, res.Metric.GetIntSum().DataPoints...)
-		case *metricpb.Metric_DoubleGauge:
-			m.GetDoubleGauge().DataPoints = append(m.GetDoubleGauge().DataPoints, res.Metric.GetDoubleGauge().DataPoints...)
-		case *metricpb.Metric_DoubleHistogram:
-			m.GetDoubleHistogram().DataPoints = append(m.GetDoubleHistogram().DataPoints, res.Metric.GetDoubleHistogram().DataPoints...)
-		case *metricpb.Metric_DoubleSum:
-			m.GetDoubleSum().DataPoints = append(m.GetDoubleSum().DataPoints, res.Metric.GetDoubleSum().DataPoints...)
+		case *metricpb.Metric_Gauge:
+			m.GetGauge().DataPoints = append(m.GetGauge().DataPoints, res.Metric.GetGauge().DataPoints...)
+		case *metricpb.Metric_Histogram:
+			m.GetHistogram().DataPoints = append(m.GetHistogram().DataPoints, res.Metric.GetHistogram().DataPoints...)
+		case *metricpb.Metric_Sum:
+			m.GetSum().DataPoints = append(m.GetSum().DataPoints, res.Metric.GetSum().DataPoints...)
 		default:
 		}
 	}
@@ -222,16 +225,15 @@ func sink(ctx context.Context, in <-chan result) ([]*metricpb.ResourceMetrics, e
 
 	var rms []*metricpb.ResourceMetrics
 	for _, rb := range grouped {
-		rm := &metricpb.ResourceMetrics{Resource: rb.Resource}
+		rm := &metricpb.ResourceMetrics{
+			Resource:  rb.Resource,
+			SchemaUrl: rb.SchemaURL,
+		}
 		for il, mb := range rb.InstrumentationLibraryBatches {
 			ilm := &metricpb.InstrumentationLibraryMetrics{
-				Metrics: make([]*metricpb.Metric, 0, len(mb)),
-			}
-			if il != (instrumentation.Library{}) {
-				ilm.InstrumentationLibrary = &commonpb.InstrumentationLibrary{
-					Name:    il.Name,
-					Version: il.Version,
-				}
+				InstrumentationLibrary: instrumentationLibrary(il),
+				Metrics:                make([]*metricpb.Metric, 0, len(mb)),
+				SchemaUrl:              il.SchemaURL,
 			}
 			for _, m := range mb {
 				ilm.Metrics = append(ilm.Metrics, m)
@@ -332,17 +334,17 @@ func gaugeArray(record export.Record, points []aggregation.Point) (*metricpb.Met
 		}
 
 	case number.Float64Kind:
-		var pts []*metricpb.DoubleDataPoint
+		var pts []*metricpb.NumberDataPoint
 		for _, s := range points {
-			pts = append(pts, &metricpb.DoubleDataPoint{
+			pts = append(pts, &metricpb.NumberDataPoint{
 				Labels:            nil,
 				StartTimeUnixNano: toNanos(record.StartTime()),
 				TimeUnixNano:      toNanos(record.EndTime()),
-				Value:             s.Number.CoerceToFloat64(nk),
+				Value:             &metricpb.NumberDataPoint_AsDouble{AsDouble: s.Number.CoerceToFloat64(nk)},
 			})
 		}
-		m.Data = &metricpb.Metric_DoubleGauge{
-			DoubleGauge: &metricpb.DoubleGauge{
+		m.Data = &metricpb.Metric_Gauge{
+			Gauge: &metricpb.Gauge{
 				DataPoints: pts,
 			},
 		}
@@ -379,11 +381,11 @@ func gaugePoint(record export.Record, num number.Number, start, end time.Time) (
 			},
 		}
 	case number.Float64Kind:
-		m.Data = &metricpb.Metric_DoubleGauge{
-			DoubleGauge: &metricpb.DoubleGauge{
-				DataPoints: []*metricpb.DoubleDataPoint{
+		m.Data = &metricpb.Metric_Gauge{
+			Gauge: &metricpb.Gauge{
+				DataPoints: []*metricpb.NumberDataPoint{
 					{
-						Value:             num.CoerceToFloat64(n),
+						Value:             &metricpb.NumberDataPoint_AsDouble{AsDouble: num.CoerceToFloat64(n)},
 						Labels:            stringKeyValues(labels.Iter()),
 						StartTimeUnixNano: toNanos(start),
 						TimeUnixNano:      toNanos(end),
@@ -435,13 +437,13 @@ func sumPoint(record export.Record, num number.Number, start, end time.Time, ek
 			},
 		}
 	case number.Float64Kind:
-		m.Data = &metricpb.Metric_DoubleSum{
-			DoubleSum: &metricpb.DoubleSum{
+		m.Data = &metricpb.Metric_Sum{
+			Sum: &metricpb.Sum{
 				IsMonotonic:            monotonic,
 				AggregationTemporality: exportKindToTemporality(ek),
-				DataPoints: []*metricpb.DoubleDataPoint{
+				DataPoints: []*metricpb.NumberDataPoint{
 					{
-						Value:             num.CoerceToFloat64(n),
+						Value:             &metricpb.NumberDataPoint_AsDouble{AsDouble: num.CoerceToFloat64(n)},
 						Labels:            stringKeyValues(labels.Iter()),
 						StartTimeUnixNano: toNanos(start),
 						TimeUnixNano:      toNanos(end),
@@ -510,9 +512,9 @@ func minMaxSumCount(record export.Record, a aggregation.MinMaxSumCount) (*metric
 			},
 		}
 	case number.Float64Kind:
-		m.Data = &metricpb.Metric_DoubleHistogram{
-			DoubleHistogram: &metricpb.DoubleHistogram{
-				DataPoints: []*metricpb.DoubleHistogramDataPoint{
+		m.Data = &metricpb.Metric_Histogram{
+			Histogram: &metricpb.Histogram{
+				DataPoints: []*metricpb.HistogramDataPoint{
 					{
 						Sum:               sum.CoerceToFloat64(n),
 						Labels:            stringKeyValues(labels.Iter()),
@@ -587,10 +589,10 @@ func histogramPoint(record export.Record, ek export.ExportKind, a aggregation.Hi
 			},
 		}
 	case number.Float64Kind:
-		m.Data = &metricpb.Metric_DoubleHistogram{
-			DoubleHistogram: &metricpb.DoubleHistogram{
+		m.Data = &metricpb.Metric_Histogram{
+			Histogram: &metricpb.Histogram{
 				AggregationTemporality: exportKindToTemporality(ek),
-				DataPoints: []*metricpb.DoubleHistogramDataPoint{
+				DataPoints: []*metricpb.HistogramDataPoint{
 					{
 						Sum:               sum.CoerceToFloat64(n),
 						Labels:            stringKeyValues(labels.Iter()),
@@ -145,8 +145,8 @@ func TestMinMaxSumCountDatapoints(t *testing.T) {
 		assert.Nil(t, m.GetIntGauge())
 		assert.Equal(t, expected, m.GetIntHistogram().DataPoints)
 		assert.Nil(t, m.GetIntSum())
-		assert.Nil(t, m.GetDoubleGauge())
-		assert.Nil(t, m.GetDoubleHistogram())
+		assert.Nil(t, m.GetGauge())
+		assert.Nil(t, m.GetHistogram())
 	}
 }
 
@@ -183,8 +183,8 @@ func TestSumIntDataPoints(t *testing.T) {
 				StartTimeUnixNano: uint64(intervalStart.UnixNano()),
 				TimeUnixNano:      uint64(intervalEnd.UnixNano()),
 			}}}, m.GetIntSum())
-		assert.Nil(t, m.GetDoubleGauge())
-		assert.Nil(t, m.GetDoubleHistogram())
+		assert.Nil(t, m.GetGauge())
+		assert.Nil(t, m.GetHistogram())
 	}
 }
 
@@ -204,16 +204,16 @@ func TestSumFloatDataPoints(t *testing.T) {
 		assert.Nil(t, m.GetIntGauge())
 		assert.Nil(t, m.GetIntHistogram())
 		assert.Nil(t, m.GetIntSum())
-		assert.Nil(t, m.GetDoubleGauge())
-		assert.Nil(t, m.GetDoubleHistogram())
-		assert.Equal(t, &metricpb.DoubleSum{
+		assert.Nil(t, m.GetGauge())
+		assert.Nil(t, m.GetHistogram())
+		assert.Equal(t, &metricpb.Sum{
 			IsMonotonic:            false,
 			AggregationTemporality: otelDelta,
-			DataPoints: []*metricpb.DoubleDataPoint{{
-				Value:             1,
+			DataPoints: []*metricpb.NumberDataPoint{{
+				Value:             &metricpb.NumberDataPoint_AsDouble{AsDouble: 1},
 				StartTimeUnixNano: uint64(intervalStart.UnixNano()),
 				TimeUnixNano:      uint64(intervalEnd.UnixNano()),
-			}}}, m.GetDoubleSum())
+			}}}, m.GetSum())
 	}
 }
 
@@ -237,9 +237,9 @@ func TestLastValueIntDataPoints(t *testing.T) {
 		}}, m.GetIntGauge().DataPoints)
 		assert.Nil(t, m.GetIntHistogram())
 		assert.Nil(t, m.GetIntSum())
-		assert.Nil(t, m.GetDoubleGauge())
-		assert.Nil(t, m.GetDoubleHistogram())
-		assert.Nil(t, m.GetDoubleSum())
+		assert.Nil(t, m.GetGauge())
+		assert.Nil(t, m.GetHistogram())
+		assert.Nil(t, m.GetSum())
 	}
 }
 
@@ -263,9 +263,9 @@ func TestExactIntDataPoints(t *testing.T) {
 		}}, m.GetIntGauge().DataPoints)
 		assert.Nil(t, m.GetIntHistogram())
 		assert.Nil(t, m.GetIntSum())
-		assert.Nil(t, m.GetDoubleGauge())
-		assert.Nil(t, m.GetDoubleHistogram())
-		assert.Nil(t, m.GetDoubleSum())
+		assert.Nil(t, m.GetGauge())
+		assert.Nil(t, m.GetHistogram())
+		assert.Nil(t, m.GetSum())
 	}
 }
 
@@ -282,16 +282,16 @@ func TestExactFloatDataPoints(t *testing.T) {
 	require.NoError(t, err)
 
 	if m, err := gaugeArray(record, pts); assert.NoError(t, err) {
-		assert.Equal(t, []*metricpb.DoubleDataPoint{{
-			Value:             100,
+		assert.Equal(t, []*metricpb.NumberDataPoint{{
+			Value:             &metricpb.NumberDataPoint_AsDouble{AsDouble: 100},
 			StartTimeUnixNano: toNanos(intervalStart),
 			TimeUnixNano:      toNanos(intervalEnd),
-		}}, m.GetDoubleGauge().DataPoints)
+		}}, m.GetGauge().DataPoints)
 		assert.Nil(t, m.GetIntHistogram())
 		assert.Nil(t, m.GetIntSum())
 		assert.Nil(t, m.GetIntGauge())
-		assert.Nil(t, m.GetDoubleHistogram())
-		assert.Nil(t, m.GetDoubleSum())
+		assert.Nil(t, m.GetHistogram())
+		assert.Nil(t, m.GetSum())
 	}
 }
 
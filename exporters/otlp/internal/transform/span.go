@@ -31,9 +31,18 @@ const (
 // SpanData transforms a slice of SpanSnapshot into a slice of OTLP
 // ResourceSpans.
 func SpanData(sdl []*export.SpanSnapshot) []*tracepb.ResourceSpans {
+	return (*SpanBuffer)(nil).SpanData(sdl)
+}
+
+// SpanData transforms a slice of SpanSnapshot into a slice of OTLP
+// ResourceSpans, like the SpanData function.  The messages of the spans
+// and of their attributes are taken from b, they are only valid until
+// b is released.  A nil SpanBuffer allocates new messages.
+func (b *SpanBuffer) SpanData(sdl []*export.SpanSnapshot) []*tracepb.ResourceSpans {
 	if len(sdl) == 0 {
 		return nil
 	}
+	b.reserve(sdl)
 
 	rsm := make(map[label.Distinct]*tracepb.ResourceSpans)
 
@@ -60,9 +69,10 @@ func SpanData(sdl []*export.SpanSnapshot) []*tracepb.ResourceSpans {
 			ils = &tracepb.InstrumentationLibrarySpans{
 				InstrumentationLibrary: instrumentationLibrary(sd.InstrumentationLibrary),
 				Spans:                  []*tracepb.Span{},
+				SchemaUrl:              sd.InstrumentationLibrary.SchemaURL,
 			}
 		}
-		ils.Spans = append(ils.Spans, span(sd))
+		ils.Spans = append(ils.Spans, b.span(sd))
 		ilsm[iKey] = ils
 
 		rs, rOk := rsm[rKey]
@@ -72,6 +82,7 @@ func SpanData(sdl []*export.SpanSnapshot) []*tracepb.ResourceSpans {
 			rs = &tracepb.ResourceSpans{
 				Resource:                    Resource(sd.Resource),
 				InstrumentationLibrarySpans: []*tracepb.InstrumentationLibrarySpans{ils},
+				SchemaUrl:                   sd.Resource.SchemaURL(),
 			}
 			rsm[rKey] = rs
 			continue
@@ -97,51 +108,70 @@ func SpanData(sdl []*export.SpanSnapshot) []*tracepb.ResourceSpans {
 
 // span transforms a Span into an OTLP span.
 func span(sd *export.SpanSnapshot) *tracepb.Span {
+	return (*SpanBuffer)(nil).span(sd)
+}
+
+func (b *SpanBuffer) span(sd *export.SpanSnapshot) *tracepb.Span {
 	if sd == nil {
 		return nil
 	}
 
-	s := &tracepb.Span{
-		TraceId:                sd.SpanContext.TraceID[:],
-		SpanId:                 sd.SpanContext.SpanID[:],
+	e := b.newSpan()
+	e.traceID = sd.SpanContext.TraceID
+	e.spanID = sd.SpanContext.SpanID
+	e.status = tracepb.Status{
+		Code:    statusCode(sd.StatusCode),
+		Message: sd.StatusMessage,
+	}
+	e.span = tracepb.Span{
+		TraceId:                e.traceID[:],
+		SpanId:                 e.spanID[:],
 		TraceState:             sd.SpanContext.TraceState.String(),
-		Status:                 status(sd.StatusCode, sd.StatusMessage),
+		Status:                 &e.status,
 		StartTimeUnixNano:      uint64(sd.StartTime.UnixNano()),
 		EndTimeUnixNano:        uint64(sd.EndTime.UnixNano()),
-		Links:                  links(sd.Links),
+		Links:                  b.links(sd.Links),
 		Kind:                   spanKind(sd.SpanKind),
 		Name:                   sd.Name,
-		Attributes:             Attributes(sd.Attributes),
-		Events:                 spanEvents(sd.MessageEvents),
+		Attributes:             b.attributes(sd.Attributes),
+		Events:                 b.spanEvents(sd.MessageEvents),
 		DroppedAttributesCount: uint32(sd.DroppedAttributeCount),
 		DroppedEventsCount:     uint32(sd.DroppedMessageEventCount),
 		DroppedLinksCount:      uint32(sd.DroppedLinkCount),
 	}
 
 	if sd.ParentSpanID.IsValid() {
-		s.ParentSpanId = sd.ParentSpanID[:]
+		e.parentSpanID = sd.ParentSpanID
+		e.span.ParentSpanId = e.parentSpanID[:]
 	}
 
-	return s
+	return &e.span
 }
 
 // status transform a span code and message into an OTLP span status.
 func status(status codes.Code, message string) *tracepb.Status {
-	var c tracepb.Status_StatusCode
+	return &tracepb.Status{
+		Code:    statusCode(status),
+		Message: message,
+	}
+}
+
+// statusCode transforms a span code into an OTLP span status code.
+func statusCode(status codes.Code) tracepb.Status_StatusCode {
 	switch status {
 	case codes.Error:
-		c = tracepb.Status_STATUS_CODE_ERROR
+		return tracepb.Status_STATUS_CODE_ERROR
 	default:
-		c = tracepb.Status_STATUS_CODE_OK
-	}
-	return &tracepb.Status{
-		Code:    c,
-		Message: message,
+		return tracepb.Status_STATUS_CODE_OK
 	}
 }
 
 // links transforms span Links to OTLP span links.
 func links(links []trace.Link) []*tracepb.Span_Link {
+	return (*SpanBuffer)(nil).links(links)
+}
+
+func (b *SpanBuffer) links(links []trace.Link) []*tracepb.Span_Link {
 	if len(links) == 0 {
 		return nil
 	}
@@ -155,7 +185,7 @@ func links(links []trace.Link) []*tracepb.Span_Link {
 		sl = append(sl, &tracepb.Span_Link{
 			TraceId:    otLink.TraceID[:],
 			SpanId:     otLink.SpanID[:],
-			Attributes: Attributes(otLink.Attributes),
+			Attributes: b.attributes(otLink.Attributes),
 		})
 	}
 	return sl
@@ -163,6 +193,10 @@ func links(links []trace.Link) []*tracepb.Span_Link {
 
 // spanEvents transforms span Events to an OTLP span events.
 func spanEvents(es []trace.Event) []*tracepb.Span_Event {
+	return (*SpanBuffer)(nil).spanEvents(es)
+}
+
+func (b *SpanBuffer) spanEvents(es []trace.Event) []*tracepb.Span_Event {
 	if len(es) == 0 {
 		return nil
 	}
@@ -184,7 +218,7 @@ func spanEvents(es []trace.Event) []*tracepb.Span_Event {
 			&tracepb.Span_Event{
 				Name:         e.Name,
 				TimeUnixNano: uint64(e.Time.UnixNano()),
-				Attributes:   Attributes(e.Attributes),
+				Attributes:   b.attributes(e.Attributes),
 				// TODO (rghetia) : Add Drop Counts when supported.
 			},
 		)
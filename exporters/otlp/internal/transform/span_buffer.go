@@ -0,0 +1,202 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package transform
+
+import (
+	"sync"
+
+	commonpb "go.opentelemetry.io/otel/exporters/otlp/internal/opentelemetry-proto-gen/common/v1"
+	tracepb "go.opentelemetry.io/otel/exporters/otlp/internal/opentelemetry-proto-gen/trace/v1"
+
+	"go.opentelemetry.io/otel/label"
+	export "go.opentelemetry.io/otel/sdk/export/trace"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// SpanBuffer holds the OTLP messages of transformed spans and of their
+// attributes so that the following transformations reuse them instead of
+// allocating a new tree of messages for every batch.  Drivers take a
+// SpanBuffer with NewSpanBuffer when exporting a batch and release it
+// once the messages are marshaled.
+//
+// A SpanBuffer is not safe for concurrent use.
+type SpanBuffer struct {
+	spans []spanEntry
+
+	attrs []attributeEntry
+	// attrPtrs is carved into the attribute slices of the
+	// messages.
+	attrPtrs []*commonpb.KeyValue
+}
+
+// spanEntry holds the messages of a span, and copies of its IDs for the
+// bytes fields to refer to.
+type spanEntry struct {
+	span         tracepb.Span
+	status       tracepb.Status
+	traceID      trace.TraceID
+	spanID       trace.SpanID
+	parentSpanID trace.SpanID
+}
+
+// attributeEntry holds the messages of an attribute.  Only the oneof
+// wrapper of the type of the value is used.
+type attributeEntry struct {
+	kv          commonpb.KeyValue
+	value       commonpb.AnyValue
+	boolValue   commonpb.AnyValue_BoolValue
+	intValue    commonpb.AnyValue_IntValue
+	doubleValue commonpb.AnyValue_DoubleValue
+	stringValue commonpb.AnyValue_StringValue
+}
+
+// spanBufferPool holds released SpanBuffers.  Their slices grow to the
+// size of the largest batches they transformed.
+var spanBufferPool = sync.Pool{
+	New: func() interface{} {
+		return &SpanBuffer{}
+	},
+}
+
+// NewSpanBuffer returns an empty SpanBuffer, reusing one that was
+// returned with Release if possible.
+func NewSpanBuffer() *SpanBuffer {
+	return spanBufferPool.Get().(*SpanBuffer)
+}
+
+// Release clears the messages held by b and returns it to the pool used
+// by NewSpanBuffer.  The messages returned by b must not be used
+// afterwards.
+func (b *SpanBuffer) Release() {
+	b.reset()
+	spanBufferPool.Put(b)
+}
+
+// reset clears the messages held by b for them to be reused.
+func (b *SpanBuffer) reset() {
+	for i := range b.spans {
+		b.spans[i] = spanEntry{}
+	}
+	b.spans = b.spans[:0]
+	for i := range b.attrs {
+		b.attrs[i] = attributeEntry{}
+	}
+	b.attrs = b.attrs[:0]
+	for i := range b.attrPtrs {
+		b.attrPtrs[i] = nil
+	}
+	b.attrPtrs = b.attrPtrs[:0]
+}
+
+// reserve makes room for the messages of the spans.  Slices that are
+// too small are replaced, never grown, as the messages already returned
+// refer to their elements.
+func (b *SpanBuffer) reserve(sdl []*export.SpanSnapshot) {
+	if b == nil {
+		return
+	}
+	var spans, attributes int
+	for _, sd := range sdl {
+		if sd == nil {
+			continue
+		}
+		spans++
+		attributes += len(sd.Attributes)
+		for _, e := range sd.MessageEvents {
+			attributes += len(e.Attributes)
+		}
+		for _, l := range sd.Links {
+			attributes += len(l.Attributes)
+		}
+	}
+
+	if cap(b.spans)-len(b.spans) < spans {
+		b.spans = make([]spanEntry, 0, grow(cap(b.spans), spans))
+	}
+	if cap(b.attrs)-len(b.attrs) < attributes {
+		b.attrs = make([]attributeEntry, 0, grow(cap(b.attrs), attributes))
+	}
+	if cap(b.attrPtrs)-len(b.attrPtrs) < attributes {
+		b.attrPtrs = make([]*commonpb.KeyValue, 0, grow(cap(b.attrPtrs), attributes))
+	}
+}
+
+// grow returns the capacity of a slice replacing one of capacity c that
+// is missing room for n elements.
+func grow(c, n int) int {
+	if n < 2*c {
+		return 2 * c
+	}
+	return n
+}
+
+// newSpan returns a spanEntry for a span, allocating it if b is nil.
+func (b *SpanBuffer) newSpan() *spanEntry {
+	if b == nil || len(b.spans) == cap(b.spans) {
+		return new(spanEntry)
+	}
+	b.spans = b.spans[:len(b.spans)+1]
+	return &b.spans[len(b.spans)-1]
+}
+
+// attributes transforms a slice of KeyValues into a slice of OTLP
+// attribute key-values, like Attributes.  The messages of scalar values
+// are taken from b.
+func (b *SpanBuffer) attributes(attrs []label.KeyValue) []*commonpb.KeyValue {
+	if b == nil || len(attrs) == 0 {
+		return Attributes(attrs)
+	}
+	n := len(b.attrPtrs)
+	if cap(b.attrPtrs)-n < len(attrs) || cap(b.attrs)-len(b.attrs) < len(attrs) {
+		// Not reserved for.
+		return Attributes(attrs)
+	}
+	out := b.attrPtrs[n : n+len(attrs) : n+len(attrs)]
+	b.attrPtrs = b.attrPtrs[:n+len(attrs)]
+
+	for i, kv := range attrs {
+		out[i] = b.attribute(kv)
+	}
+	return out
+}
+
+func (b *SpanBuffer) attribute(kv label.KeyValue) *commonpb.KeyValue {
+	b.attrs = b.attrs[:len(b.attrs)+1]
+	e := &b.attrs[len(b.attrs)-1]
+	e.kv.Key = string(kv.Key)
+	e.kv.Value = &e.value
+
+	switch kv.Value.Type() {
+	case label.BOOL:
+		e.boolValue.BoolValue = kv.Value.AsBool()
+		e.value.Value = &e.boolValue
+	case label.INT64, label.INT32, label.UINT32, label.UINT64:
+		e.intValue.IntValue = kv.Value.AsInt64()
+		e.value.Value = &e.intValue
+	case label.FLOAT32:
+		e.doubleValue.DoubleValue = float64(kv.Value.AsFloat32())
+		e.value.Value = &e.doubleValue
+	case label.FLOAT64:
+		e.doubleValue.DoubleValue = kv.Value.AsFloat64()
+		e.value.Value = &e.doubleValue
+	case label.STRING:
+		e.stringValue.StringValue = kv.Value.AsString()
+		e.value.Value = &e.stringValue
+	default:
+		// Composite values are rare, they are allocated.
+		e.value = *toAttribute(kv).Value
+	}
+	return &e.kv
+}
@@ -311,3 +311,113 @@ func TestRootSpanData(t *testing.T) {
 func TestSpanDataNilResource(t *testing.T) {
 	assert.NotPanics(t, func() { SpanData([]*export.SpanSnapshot{{}}) })
 }
+
+func TestSpanDataSchemaURL(t *testing.T) {
+	const schemaURL = "https://opentelemetry.io/schemas/1.0.0"
+	sd := SpanData([]*export.SpanSnapshot{{
+		Resource: resource.NewWithSchemaURL(schemaURL, label.String("rk1", "rv1")),
+	}})
+	require.Len(t, sd, 1)
+	assert.Equal(t, schemaURL, sd[0].GetSchemaUrl())
+
+	// The schema URL survives a marshaling round trip.
+	b, err := proto.Marshal(sd[0])
+	require.NoError(t, err)
+	got := new(tracepb.ResourceSpans)
+	require.NoError(t, proto.Unmarshal(b, got))
+	assert.Equal(t, schemaURL, got.GetSchemaUrl())
+}
+
+func TestSpanDataInstrumentationLibrarySchemaURL(t *testing.T) {
+	const schemaURL = "https://opentelemetry.io/schemas/1.13.0"
+	sd := SpanData([]*export.SpanSnapshot{{
+		InstrumentationLibrary: instrumentation.Library{
+			Name:      "lib",
+			Version:   "v0.1.0",
+			SchemaURL: schemaURL,
+		},
+	}})
+	require.Len(t, sd, 1)
+	require.Len(t, sd[0].InstrumentationLibrarySpans, 1)
+	assert.Equal(t, schemaURL, sd[0].InstrumentationLibrarySpans[0].GetSchemaUrl())
+
+	// The schema URL survives a marshaling round trip.
+	b, err := proto.Marshal(sd[0])
+	require.NoError(t, err)
+	got := new(tracepb.ResourceSpans)
+	require.NoError(t, proto.Unmarshal(b, got))
+	require.Len(t, got.InstrumentationLibrarySpans, 1)
+	assert.Equal(t, schemaURL, got.InstrumentationLibrarySpans[0].GetSchemaUrl())
+	assert.Equal(t, "lib", got.InstrumentationLibrarySpans[0].GetInstrumentationLibrary().GetName())
+}
+
+func TestSpanBuffer(t *testing.T) {
+	res := resource.NewWithAttributes(label.String("rk1", "rv1"))
+	snapshot := func(i int) *export.SpanSnapshot {
+		return &export.SpanSnapshot{
+			SpanContext: trace.SpanContext{
+				TraceID: trace.TraceID{byte(i)},
+				SpanID:  trace.SpanID{byte(i)},
+			},
+			ParentSpanID: trace.SpanID{0xff},
+			Name:         strconv.Itoa(i),
+			StatusCode:   codes.Error,
+			Attributes: []label.KeyValue{
+				label.Bool("bool", true),
+				label.Int("int", i),
+				label.Float32("float32", 1.5),
+				label.Float64("float64", 2.5),
+				label.String("string", "value"),
+				label.Array("array", []string{"a", "b"}),
+			},
+			MessageEvents: []trace.Event{{
+				Name:       "event",
+				Attributes: []label.KeyValue{label.Int("event", i)},
+			}},
+			Links: []trace.Link{{
+				SpanContext: trace.SpanContext{TraceID: trace.TraceID{0xee}},
+				Attributes:  []label.KeyValue{label.Int("link", i)},
+			}},
+			Resource: res,
+		}
+	}
+	batch := func(n int) []*export.SpanSnapshot {
+		ss := make([]*export.SpanSnapshot, n)
+		for i := range ss {
+			ss[i] = snapshot(i)
+		}
+		return ss
+	}
+
+	// The buffer grows with the batches it transforms.
+	for _, n := range []int{1, 10, 5, 100} {
+		ss := batch(n)
+		want := SpanData(ss)
+
+		buf := NewSpanBuffer()
+		got := buf.SpanData(ss)
+		// The spans are transformed again to ensure the messages
+		// of a batch do not share memory.
+		got = append(got, buf.SpanData(ss)...)
+		require.Len(t, got, 2)
+		for _, rs := range got {
+			if diff := cmp.Diff(want[0], rs, cmp.Comparer(proto.Equal)); diff != "" {
+				t.Fatalf("batch of %d spans differs: %v", n, diff)
+			}
+		}
+		buf.Release()
+	}
+
+	ss := batch(10)
+	buf := &SpanBuffer{}
+	buf.SpanData(ss)
+	buf.reset()
+	buffered := testing.AllocsPerRun(10, func() {
+		buf.SpanData(ss)
+		buf.reset()
+	})
+	unbuffered := testing.AllocsPerRun(10, func() {
+		SpanData(ss)
+	})
+	assert.Less(t, buffered, unbuffered/2, "span and attribute messages must be reused")
+}
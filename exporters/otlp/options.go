@@ -15,6 +15,7 @@
 package otlp // import "go.opentelemetry.io/otel/exporters/otlp"
 
 import (
+	"go.opentelemetry.io/otel/label"
 	metricsdk "go.opentelemetry.io/otel/sdk/export/metric"
 )
 
@@ -32,6 +33,7 @@ type ExporterOption func(*config)
 
 type config struct {
 	exportKindSelector metricsdk.ExportKindSelector
+	resourceLabelKeys  []label.Key
 }
 
 // WithMetricExportKindSelector defines the ExportKindSelector used
@@ -43,3 +45,14 @@ func WithMetricExportKindSelector(selector metricsdk.ExportKindSelector) Exporte
 		cfg.exportKindSelector = selector
 	}
 }
+
+// WithResourceAttributesAsLabels adds the resource attributes with the
+// passed keys to the labels of the exported metric data points, for the
+// backends that do not index the resource of OTLP metrics.  The resource
+// is still exported as is.  A label of the data point takes precedence
+// over a resource attribute with the same key.
+func WithResourceAttributesAsLabels(keys ...label.Key) ExporterOption {
+	return func(cfg *config) {
+		cfg.resourceLabelKeys = append(cfg.resourceLabelKeys, keys...)
+	}
+}
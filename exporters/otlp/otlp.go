@@ -20,6 +20,7 @@ import (
 	"sync"
 
 	"go.opentelemetry.io/otel/metric"
+	logsdk "go.opentelemetry.io/otel/sdk/export/log"
 	metricsdk "go.opentelemetry.io/otel/sdk/export/metric"
 	"go.opentelemetry.io/otel/sdk/export/metric/aggregation"
 	tracesdk "go.opentelemetry.io/otel/sdk/export/trace"
@@ -41,6 +42,7 @@ type Exporter struct {
 
 var _ tracesdk.SpanExporter = (*Exporter)(nil)
 var _ metricsdk.Exporter = (*Exporter)(nil)
+var _ logsdk.Exporter = (*Exporter)(nil)
 
 // NewExporter constructs a new Exporter and starts it.
 func NewExporter(ctx context.Context, driver ProtocolDriver, opts ...ExporterOption) (*Exporter, error) {
@@ -70,8 +72,13 @@ func NewUnstartedExporter(driver ProtocolDriver, opts ...ExporterOption) *Export
 
 var (
 	errAlreadyStarted = errors.New("already started")
+	errNotStarted     = errors.New("not started")
 )
 
+// ErrLogsUnsupported is returned by ExportLogs when the protocol driver
+// of the exporter does not implement LogsProtocolDriver.
+var ErrLogsUnsupported = errors.New("protocol driver does not support logs")
+
 // Start establishes connections to the OpenTelemetry collector. Starting an
 // already started exporter returns an error.
 func (e *Exporter) Start(ctx context.Context) error {
@@ -111,10 +118,32 @@ func (e *Exporter) Shutdown(ctx context.Context) error {
 	return err
 }
 
+// Ready returns nil if the exporter is started and its driver is able to
+// send telemetry to the collector, otherwise an error describing why it
+// is not. Drivers that do not implement ReadinessChecker are considered
+// ready as soon as the exporter is started. It can be used to gate the
+// readiness of an application on the connectivity of its telemetry.
+func (e *Exporter) Ready(ctx context.Context) error {
+	e.mu.RLock()
+	started := e.started
+	e.mu.RUnlock()
+
+	if !started {
+		return errNotStarted
+	}
+	if rc, ok := e.driver.(ReadinessChecker); ok {
+		return rc.Ready(ctx)
+	}
+	return nil
+}
+
 // Export implements the "go.opentelemetry.io/otel/sdk/export/metric".Exporter
 // interface. It transforms and batches metric Records into OTLP Metrics and
 // transmits them to the configured collector.
 func (e *Exporter) Export(parent context.Context, cps metricsdk.CheckpointSet) error {
+	if len(e.cfg.resourceLabelKeys) > 0 {
+		cps = resourceLabelsCheckpointSet{CheckpointSet: cps, keys: e.cfg.resourceLabelKeys}
+	}
 	return e.driver.ExportMetrics(parent, cps, e.cfg.exportKindSelector)
 }
 
@@ -131,3 +160,16 @@ func (e *Exporter) ExportKindFor(desc *metric.Descriptor, kind aggregation.Kind)
 func (e *Exporter) ExportSpans(ctx context.Context, ss []*tracesdk.SpanSnapshot) error {
 	return e.driver.ExportTraces(ctx, ss)
 }
+
+// ExportLogs implements the
+// "go.opentelemetry.io/otel/sdk/export/log".Exporter interface. It
+// transforms and batches log Records into OTLP Logs and transmits them to
+// the configured collector. It returns ErrLogsUnsupported if the driver
+// does not implement LogsProtocolDriver.
+func (e *Exporter) ExportLogs(ctx context.Context, records []*logsdk.Record) error {
+	ld, ok := e.driver.(LogsProtocolDriver)
+	if !ok {
+		return ErrLogsUnsupported
+	}
+	return ld.ExportLogs(ctx, records)
+}
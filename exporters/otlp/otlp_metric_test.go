@@ -35,6 +35,9 @@ import (
 	"go.opentelemetry.io/otel/sdk/export/metric/metrictest"
 	"go.opentelemetry.io/otel/sdk/metric/aggregator/histogram"
 	"go.opentelemetry.io/otel/sdk/metric/aggregator/sum"
+	controller "go.opentelemetry.io/otel/sdk/metric/controller/basic"
+	processor "go.opentelemetry.io/otel/sdk/metric/processor/basic"
+	"go.opentelemetry.io/otel/sdk/metric/selector/simple"
 	"go.opentelemetry.io/otel/sdk/resource"
 )
 
@@ -331,13 +334,13 @@ func TestCountFloat64MetricGroupingExport(t *testing.T) {
 						Metrics: []*metricpb.Metric{
 							{
 								Name: "float64-count",
-								Data: &metricpb.Metric_DoubleSum{
-									DoubleSum: &metricpb.DoubleSum{
+								Data: &metricpb.Metric_Sum{
+									Sum: &metricpb.Sum{
 										IsMonotonic:            true,
 										AggregationTemporality: metricpb.AggregationTemporality_AGGREGATION_TEMPORALITY_CUMULATIVE,
-										DataPoints: []*metricpb.DoubleDataPoint{
+										DataPoints: []*metricpb.NumberDataPoint{
 											{
-												Value: 11,
+												Value: &metricpb.NumberDataPoint_AsDouble{AsDouble: 11},
 												Labels: []*commonpb.StringKeyValue{
 													{
 														Key:   "CPU",
@@ -352,7 +355,7 @@ func TestCountFloat64MetricGroupingExport(t *testing.T) {
 												TimeUnixNano:      pointTime(),
 											},
 											{
-												Value: 11,
+												Value: &metricpb.NumberDataPoint_AsDouble{AsDouble: 11},
 												Labels: []*commonpb.StringKeyValue{
 													{
 														Key:   "CPU",
@@ -485,6 +488,94 @@ func TestResourceMetricGroupingExport(t *testing.T) {
 	)
 }
 
+func TestResourceAttributesAsLabelsExport(t *testing.T) {
+	runMetricExportTests(
+		t,
+		[]otlp.ExporterOption{otlp.WithResourceAttributesAsLabels("instance", "missing")},
+		[]record{
+			{
+				"int64-count",
+				metric.CounterInstrumentKind,
+				number.Int64Kind,
+				testInstA,
+				nil,
+				append(baseKeyValues, cpuKey.Int(1)),
+			},
+			{
+				"int64-count",
+				metric.CounterInstrumentKind,
+				number.Int64Kind,
+				testInstA,
+				nil,
+				append(baseKeyValues, label.String("instance", "local")),
+			},
+		},
+		[]metricpb.ResourceMetrics{
+			{
+				Resource: testerAResource,
+				InstrumentationLibraryMetrics: []*metricpb.InstrumentationLibraryMetrics{
+					{
+						Metrics: []*metricpb.Metric{
+							{
+								Name: "int64-count",
+								Data: &metricpb.Metric_IntSum{
+									IntSum: &metricpb.IntSum{
+										IsMonotonic:            true,
+										AggregationTemporality: metricpb.AggregationTemporality_AGGREGATION_TEMPORALITY_CUMULATIVE,
+										DataPoints: []*metricpb.IntDataPoint{
+											{
+												Value: 11,
+												Labels: append(cpu1Labels[:2:2], &commonpb.StringKeyValue{
+													Key:   "instance",
+													Value: "tester-a",
+												}),
+												StartTimeUnixNano: startTime(),
+												TimeUnixNano:      pointTime(),
+											},
+											{
+												Value: 11,
+												Labels: []*commonpb.StringKeyValue{
+													{
+														Key:   "host",
+														Value: "test.com",
+													},
+													{
+														Key:   "instance",
+														Value: "local",
+													},
+												},
+												StartTimeUnixNano: startTime(),
+												TimeUnixNano:      pointTime(),
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	)
+}
+
+func TestResourceAttributesAsLabelsWithoutResource(t *testing.T) {
+	ctx := context.Background()
+	exp, driver := newExporter(t, otlp.WithResourceAttributesAsLabels("instance"))
+	cont := controller.New(
+		processor.New(simple.NewWithInexpensiveDistribution(), exp),
+		controller.WithPusher(exp),
+	)
+	counter := metric.Must(cont.MeterProvider().Meter("test")).NewInt64Counter("int64-count")
+	counter.Add(ctx, 1)
+	counter.Add(ctx, 1, label.String("k", "v"))
+
+	require.NoError(t, cont.Start(ctx))
+	require.NoError(t, cont.Stop(ctx))
+	require.Len(t, driver.rm, 1)
+	assert.Nil(t, driver.rm[0].Resource)
+}
+
 func TestResourceInstLibMetricGroupingExport(t *testing.T) {
 	countingLib1 := []metric.InstrumentOption{
 		metric.WithInstrumentationName("counting-lib"),
@@ -493,6 +584,7 @@ func TestResourceInstLibMetricGroupingExport(t *testing.T) {
 	countingLib2 := []metric.InstrumentOption{
 		metric.WithInstrumentationName("counting-lib"),
 		metric.WithInstrumentationVersion("v2"),
+		metric.WithSchemaURL("https://opentelemetry.io/schemas/1.13.0"),
 	}
 	summingLib := []metric.InstrumentOption{
 		metric.WithInstrumentationName("summing-lib"),
@@ -596,6 +688,7 @@ func TestResourceInstLibMetricGroupingExport(t *testing.T) {
 							Name:    "counting-lib",
 							Version: "v2",
 						},
+						SchemaUrl: "https://opentelemetry.io/schemas/1.13.0",
 						Metrics: []*metricpb.Metric{
 							{
 								Name: "int64-count",
@@ -799,7 +892,7 @@ func runMetricExportTests(t *testing.T, opts []otlp.ExporterOption, rs []record,
 	// that validate the metric elements match for all expected pairs. Finally,
 	// make we saw all expected pairs.
 	type key struct {
-		resource, instrumentationLibrary string
+		resource, instrumentationLibrary, schemaURL string
 	}
 	got := map[key][]*metricpb.Metric{}
 	for _, rm := range driver.rm {
@@ -807,6 +900,7 @@ func runMetricExportTests(t *testing.T, opts []otlp.ExporterOption, rs []record,
 			k := key{
 				resource:               rm.GetResource().String(),
 				instrumentationLibrary: ilm.GetInstrumentationLibrary().String(),
+				schemaURL:              ilm.GetSchemaUrl(),
 			}
 			got[k] = ilm.GetMetrics()
 		}
@@ -817,11 +911,12 @@ func runMetricExportTests(t *testing.T, opts []otlp.ExporterOption, rs []record,
 			k := key{
 				resource:               rm.GetResource().String(),
 				instrumentationLibrary: ilm.GetInstrumentationLibrary().String(),
+				schemaURL:              ilm.GetSchemaUrl(),
 			}
 			seen[k] = struct{}{}
 			g, ok := got[k]
 			if !ok {
-				t.Errorf("missing metrics for:\n\tResource: %s\n\tInstrumentationLibrary: %s\n", k.resource, k.instrumentationLibrary)
+				t.Errorf("missing metrics for:\n\tResource: %s\n\tInstrumentationLibrary: %s\n\tSchemaURL: %s\n", k.resource, k.instrumentationLibrary, k.schemaURL)
 				continue
 			}
 			if !assert.Len(t, g, len(ilm.GetMetrics())) {
@@ -850,24 +945,24 @@ func runMetricExportTests(t *testing.T, opts []otlp.ExporterOption, rs []record,
 						g[i].GetIntSum().GetIsMonotonic(),
 					)
 					assert.ElementsMatch(t, expected.GetIntSum().DataPoints, g[i].GetIntSum().DataPoints)
-				case *metricpb.Metric_DoubleGauge:
-					assert.ElementsMatch(t, expected.GetDoubleGauge().DataPoints, g[i].GetDoubleGauge().DataPoints)
-				case *metricpb.Metric_DoubleHistogram:
+				case *metricpb.Metric_Gauge:
+					assert.ElementsMatch(t, expected.GetGauge().DataPoints, g[i].GetGauge().DataPoints)
+				case *metricpb.Metric_Histogram:
 					assert.Equal(t,
-						expected.GetDoubleHistogram().GetAggregationTemporality(),
-						g[i].GetDoubleHistogram().GetAggregationTemporality(),
+						expected.GetHistogram().GetAggregationTemporality(),
+						g[i].GetHistogram().GetAggregationTemporality(),
 					)
-					assert.ElementsMatch(t, expected.GetDoubleHistogram().DataPoints, g[i].GetDoubleHistogram().DataPoints)
-				case *metricpb.Metric_DoubleSum:
+					assert.ElementsMatch(t, expected.GetHistogram().DataPoints, g[i].GetHistogram().DataPoints)
+				case *metricpb.Metric_Sum:
 					assert.Equal(t,
-						expected.GetDoubleSum().GetAggregationTemporality(),
-						g[i].GetDoubleSum().GetAggregationTemporality(),
+						expected.GetSum().GetAggregationTemporality(),
+						g[i].GetSum().GetAggregationTemporality(),
 					)
 					assert.Equal(t,
-						expected.GetDoubleSum().GetIsMonotonic(),
-						g[i].GetDoubleSum().GetIsMonotonic(),
+						expected.GetSum().GetIsMonotonic(),
+						g[i].GetSum().GetIsMonotonic(),
 					)
-					assert.ElementsMatch(t, expected.GetDoubleSum().DataPoints, g[i].GetDoubleSum().DataPoints)
+					assert.ElementsMatch(t, expected.GetSum().DataPoints, g[i].GetSum().DataPoints)
 				default:
 					assert.Failf(t, "unknown data type", g[i].Name)
 				}
@@ -876,7 +971,7 @@ func runMetricExportTests(t *testing.T, opts []otlp.ExporterOption, rs []record,
 	}
 	for k := range got {
 		if _, ok := seen[k]; !ok {
-			t.Errorf("did not expect metrics for:\n\tResource: %s\n\tInstrumentationLibrary: %s\n", k.resource, k.instrumentationLibrary)
+			t.Errorf("did not expect metrics for:\n\tResource: %s\n\tInstrumentationLibrary: %s\n\tSchemaURL: %s\n", k.resource, k.instrumentationLibrary, k.schemaURL)
 		}
 	}
 }
@@ -28,6 +28,7 @@ import (
 	metricpb "go.opentelemetry.io/otel/exporters/otlp/internal/opentelemetry-proto-gen/metrics/v1"
 	tracepb "go.opentelemetry.io/otel/exporters/otlp/internal/opentelemetry-proto-gen/trace/v1"
 	"go.opentelemetry.io/otel/exporters/otlp/internal/transform"
+	logsdk "go.opentelemetry.io/otel/sdk/export/log"
 	metricsdk "go.opentelemetry.io/otel/sdk/export/metric"
 	tracesdk "go.opentelemetry.io/otel/sdk/export/trace"
 )
@@ -338,3 +339,100 @@ func TestSplitDriverFail(t *testing.T) {
 		}
 	}
 }
+
+type stubReadyProtocolDriver struct {
+	stubProtocolDriver
+
+	injectedReadyError error
+}
+
+var _ otlp.ReadinessChecker = (*stubReadyProtocolDriver)(nil)
+
+func (m *stubReadyProtocolDriver) Ready(context.Context) error {
+	return m.injectedReadyError
+}
+
+func TestExporterReady(t *testing.T) {
+	ctx := context.Background()
+
+	exp := otlp.NewUnstartedExporter(&stubProtocolDriver{})
+	assert.Error(t, exp.Ready(ctx), "unstarted exporter must not be ready")
+	require.NoError(t, exp.Start(ctx))
+	assert.NoError(t, exp.Ready(ctx), "driver without readiness check")
+	require.NoError(t, exp.Shutdown(ctx))
+	assert.Error(t, exp.Ready(ctx), "shut down exporter must not be ready")
+
+	errNotReady := errors.New("not ready")
+	driver := &stubReadyProtocolDriver{injectedReadyError: errNotReady}
+	exp, err := otlp.NewExporter(ctx, driver)
+	require.NoError(t, err)
+	assert.Equal(t, errNotReady, exp.Ready(ctx))
+	driver.injectedReadyError = nil
+	assert.NoError(t, exp.Ready(ctx))
+}
+
+func TestSplitDriverReady(t *testing.T) {
+	ctx := context.Background()
+	errNotReady := errors.New("not ready")
+	driverTraces := &stubReadyProtocolDriver{}
+	driverMetrics := &stubReadyProtocolDriver{}
+	driver := otlp.NewSplitDriver(otlp.SplitConfig{
+		ForMetrics: driverMetrics,
+		ForTraces:  driverTraces,
+	})
+	rc, ok := driver.(otlp.ReadinessChecker)
+	require.True(t, ok)
+	assert.NoError(t, rc.Ready(ctx))
+
+	driverTraces.injectedReadyError = errNotReady
+	assert.Equal(t, errNotReady, rc.Ready(ctx))
+
+	driverTraces.injectedReadyError = nil
+	driverMetrics.injectedReadyError = errNotReady
+	assert.Equal(t, errNotReady, rc.Ready(ctx))
+}
+
+type stubLogsProtocolDriver struct {
+	stubProtocolDriver
+
+	records []*logsdk.Record
+}
+
+var _ otlp.LogsProtocolDriver = (*stubLogsProtocolDriver)(nil)
+
+func (m *stubLogsProtocolDriver) ExportLogs(ctx context.Context, records []*logsdk.Record) error {
+	m.records = append(m.records, records...)
+	return nil
+}
+
+func TestExporterExportLogs(t *testing.T) {
+	ctx := context.Background()
+	records := []*logsdk.Record{{SeverityText: "INFO"}}
+
+	exp := otlp.NewUnstartedExporter(&stubProtocolDriver{})
+	assert.True(t, errors.Is(exp.ExportLogs(ctx, records), otlp.ErrLogsUnsupported))
+
+	driver := &stubLogsProtocolDriver{}
+	exp = otlp.NewUnstartedExporter(driver)
+	require.NoError(t, exp.ExportLogs(ctx, records))
+	assert.Equal(t, records, driver.records)
+}
+
+func TestSplitDriverExportLogs(t *testing.T) {
+	ctx := context.Background()
+	records := []*logsdk.Record{{SeverityText: "INFO"}}
+
+	driverTraces := &stubLogsProtocolDriver{}
+	exp := otlp.NewUnstartedExporter(otlp.NewSplitDriver(otlp.SplitConfig{
+		ForMetrics: &stubLogsProtocolDriver{},
+		ForTraces:  driverTraces,
+	}))
+	require.NoError(t, exp.ExportLogs(ctx, records))
+	assert.Equal(t, records, driverTraces.records)
+
+	exp = otlp.NewUnstartedExporter(otlp.NewSplitDriver(otlp.SplitConfig{
+		ForMetrics: &stubLogsProtocolDriver{},
+		ForTraces:  &stubProtocolDriver{},
+	}))
+	assert.True(t, errors.Is(exp.ExportLogs(ctx, records), otlp.ErrLogsUnsupported))
+}
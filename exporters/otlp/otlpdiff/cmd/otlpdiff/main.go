@@ -0,0 +1,64 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Command otlpdiff compares the traces of two OTLP-JSON files, ignoring
+// the trace and span IDs and the timestamps of the spans.  It prints the
+// differences and exits with status 1 if the files have different spans.
+//
+//	otlpdiff want.json got.json
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+
+	"go.opentelemetry.io/otel/exporters/otlp/otlpdiff"
+)
+
+func main() {
+	flag.Usage = func() {
+		fmt.Fprintf(flag.CommandLine.Output(), "usage: %s want.json got.json\n", os.Args[0])
+		flag.PrintDefaults()
+	}
+	flag.Parse()
+	if flag.NArg() != 2 {
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	diff, err := diffFiles(flag.Arg(0), flag.Arg(1))
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(2)
+	}
+	if diff != "" {
+		fmt.Printf("spans differ (-want +got):\n%s", diff)
+		os.Exit(1)
+	}
+}
+
+func diffFiles(wantPath, gotPath string) (string, error) {
+	want, err := os.Open(wantPath)
+	if err != nil {
+		return "", err
+	}
+	defer want.Close()
+	got, err := os.Open(gotPath)
+	if err != nil {
+		return "", err
+	}
+	defer got.Close()
+	return otlpdiff.Diff(want, got)
+}
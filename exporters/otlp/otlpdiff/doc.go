@@ -0,0 +1,24 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package otlpdiff compares the traces of OTLP-JSON files, like the
+// requests posted by the otlpwebhook driver, ignoring the trace and span
+// IDs and the timestamps of the spans.  It is meant for regression tests
+// of instrumentation, where the same operations must produce the same
+// spans but never produce the same IDs and timestamps twice.
+//
+// The spans are identified by their path, the names of the spans from the
+// root of their trace down to them, so that their parent-child
+// relationships are compared without their IDs.
+package otlpdiff // import "go.opentelemetry.io/otel/exporters/otlp/otlpdiff"
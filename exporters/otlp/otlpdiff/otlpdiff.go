@@ -0,0 +1,254 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package otlpdiff
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/gogo/protobuf/jsonpb"
+	"github.com/gogo/protobuf/proto"
+	"github.com/google/go-cmp/cmp"
+
+	coltracepb "go.opentelemetry.io/otel/exporters/otlp/internal/opentelemetry-proto-gen/collector/trace/v1"
+	commonpb "go.opentelemetry.io/otel/exporters/otlp/internal/opentelemetry-proto-gen/common/v1"
+	tracepb "go.opentelemetry.io/otel/exporters/otlp/internal/opentelemetry-proto-gen/trace/v1"
+)
+
+// pathSeparator separates the names of the spans of a path.
+const pathSeparator = " > "
+
+// unknownParent starts the paths of the spans whose parent is not in
+// the compared file, like the spans with a remote parent.
+const unknownParent = "?"
+
+// Span is a span of an OTLP-JSON file without its IDs and timestamps.
+// The attributes are formatted as "key=value" and sorted.
+type Span struct {
+	// Path holds the names of the span and of its ancestors, from
+	// the root of the trace, separated by " > ".  It starts with
+	// "?" if the root of the trace is not in the file.
+	Path                   string
+	Resource               []string
+	InstrumentationLibrary string
+	Kind                   string
+	Attributes             []string
+	Events                 []Event
+	Links                  []Link
+	StatusCode             string
+	StatusMessage          string
+	DroppedAttributesCount uint32
+	DroppedEventsCount     uint32
+	DroppedLinksCount      uint32
+}
+
+// Event is an event of a Span without its timestamp.
+type Event struct {
+	Name       string
+	Attributes []string
+}
+
+// Link is a link of a Span without its IDs.
+type Link struct {
+	// Path is the path of the linked span, or "?" if it is not in
+	// the file.
+	Path       string
+	Attributes []string
+}
+
+// Read reads the OTLP-JSON trace export requests of r, concatenated or
+// one per line, and returns their spans sorted by path.
+func Read(r io.Reader) ([]Span, error) {
+	var rss []*tracepb.ResourceSpans
+	dec := json.NewDecoder(r)
+	for {
+		var req coltracepb.ExportTraceServiceRequest
+		err := jsonpb.UnmarshalNext(dec, &req)
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("otlpdiff: invalid OTLP-JSON trace request: %w", err)
+		}
+		rss = append(rss, req.ResourceSpans...)
+	}
+	return normalize(rss), nil
+}
+
+// Diff returns a human-readable report of the differences between the
+// spans of want and got, read with Read, or an empty string if they have
+// the same spans.
+func Diff(want, got io.Reader) (string, error) {
+	wantSpans, err := Read(want)
+	if err != nil {
+		return "", err
+	}
+	gotSpans, err := Read(got)
+	if err != nil {
+		return "", err
+	}
+	return cmp.Diff(wantSpans, gotSpans), nil
+}
+
+// spanKey identifies a span across the resources of a file.
+type spanKey struct {
+	traceID string
+	spanID  string
+}
+
+// normalize returns the spans of rss without their IDs and timestamps,
+// sorted by path.
+func normalize(rss []*tracepb.ResourceSpans) []Span {
+	type protoSpan struct {
+		span     *tracepb.Span
+		resource []string
+		library  string
+	}
+	var spans []protoSpan
+	byID := make(map[spanKey]*tracepb.Span)
+	for _, rs := range rss {
+		var resource []string
+		if rs.Resource != nil {
+			resource = attributes(rs.Resource.Attributes)
+		}
+		for _, ils := range rs.InstrumentationLibrarySpans {
+			library := ""
+			if il := ils.InstrumentationLibrary; il != nil {
+				library = il.Name
+				if il.Version != "" {
+					library += "@" + il.Version
+				}
+			}
+			for _, s := range ils.Spans {
+				spans = append(spans, protoSpan{span: s, resource: resource, library: library})
+				byID[spanKey{string(s.TraceId), string(s.SpanId)}] = s
+			}
+		}
+	}
+
+	normalized := make([]Span, 0, len(spans))
+	for _, ps := range spans {
+		s := ps.span
+		n := Span{
+			Path:                   path(byID, s.TraceId, s.SpanId),
+			Resource:               ps.resource,
+			InstrumentationLibrary: ps.library,
+			Kind:                   s.Kind.String(),
+			Attributes:             attributes(s.Attributes),
+			DroppedAttributesCount: s.DroppedAttributesCount,
+			DroppedEventsCount:     s.DroppedEventsCount,
+			DroppedLinksCount:      s.DroppedLinksCount,
+		}
+		for _, e := range s.Events {
+			n.Events = append(n.Events, Event{Name: e.Name, Attributes: attributes(e.Attributes)})
+		}
+		for _, l := range s.Links {
+			n.Links = append(n.Links, Link{
+				Path:       path(byID, l.TraceId, l.SpanId),
+				Attributes: attributes(l.Attributes),
+			})
+		}
+		if s.Status != nil {
+			n.StatusCode = s.Status.Code.String()
+			n.StatusMessage = s.Status.Message
+		}
+		normalized = append(normalized, n)
+	}
+
+	// Sort by path, then by content for the spans with the same path.
+	keys := make([]string, len(normalized))
+	for i, n := range normalized {
+		keys[i] = fmt.Sprintf("%+v", n)
+	}
+	sort.Sort(byPath{normalized, keys})
+	return normalized
+}
+
+// byPath sorts spans by path and then by their formatted keys.
+type byPath struct {
+	spans []Span
+	keys  []string
+}
+
+func (b byPath) Len() int { return len(b.spans) }
+
+func (b byPath) Less(i, j int) bool {
+	if b.spans[i].Path != b.spans[j].Path {
+		return b.spans[i].Path < b.spans[j].Path
+	}
+	return b.keys[i] < b.keys[j]
+}
+
+func (b byPath) Swap(i, j int) {
+	b.spans[i], b.spans[j] = b.spans[j], b.spans[i]
+	b.keys[i], b.keys[j] = b.keys[j], b.keys[i]
+}
+
+// path returns the path of the span with the IDs, see Span.
+func path(byID map[spanKey]*tracepb.Span, traceID, spanID []byte) string {
+	var names []string
+	// seen protects from cycles in invalid files.
+	seen := make(map[string]bool)
+	for len(spanID) > 0 && !seen[string(spanID)] {
+		seen[string(spanID)] = true
+		s, ok := byID[spanKey{string(traceID), string(spanID)}]
+		if !ok {
+			names = append(names, unknownParent)
+			break
+		}
+		names = append(names, s.Name)
+		spanID = s.ParentSpanId
+	}
+	for i, j := 0, len(names)-1; i < j; i, j = i+1, j-1 {
+		names[i], names[j] = names[j], names[i]
+	}
+	return strings.Join(names, pathSeparator)
+}
+
+// attributes formats kvs as sorted "key=value" strings.
+func attributes(kvs []*commonpb.KeyValue) []string {
+	if len(kvs) == 0 {
+		return nil
+	}
+	attrs := make([]string, 0, len(kvs))
+	for _, kv := range kvs {
+		attrs = append(attrs, kv.Key+"="+value(kv.Value))
+	}
+	sort.Strings(attrs)
+	return attrs
+}
+
+func value(v *commonpb.AnyValue) string {
+	if v == nil {
+		return ""
+	}
+	switch val := v.Value.(type) {
+	case *commonpb.AnyValue_StringValue:
+		return strconv.Quote(val.StringValue)
+	case *commonpb.AnyValue_BoolValue:
+		return strconv.FormatBool(val.BoolValue)
+	case *commonpb.AnyValue_IntValue:
+		return strconv.FormatInt(val.IntValue, 10)
+	case *commonpb.AnyValue_DoubleValue:
+		return strconv.FormatFloat(val.DoubleValue, 'g', -1, 64)
+	default:
+		return proto.CompactTextString(v)
+	}
+}
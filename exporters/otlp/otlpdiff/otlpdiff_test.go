@@ -0,0 +1,115 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package otlpdiff
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gogo/protobuf/jsonpb"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	coltracepb "go.opentelemetry.io/otel/exporters/otlp/internal/opentelemetry-proto-gen/collector/trace/v1"
+	"go.opentelemetry.io/otel/exporters/otlp/internal/transform"
+	"go.opentelemetry.io/otel/label"
+	export "go.opentelemetry.io/otel/sdk/export/trace"
+	"go.opentelemetry.io/otel/sdk/instrumentation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// exportJSON returns the OTLP-JSON requests of a trace with a root span and
+// a child span, whose IDs and timestamps are derived from seed.
+func exportJSON(t *testing.T, seed byte, childAttrs ...label.KeyValue) string {
+	tid := trace.TraceID{seed}
+	start := time.Unix(int64(seed), 0)
+	res := resource.NewWithAttributes(label.String("service.name", "test"))
+	lib := instrumentation.Library{Name: "lib", Version: "v1"}
+	child := &export.SpanSnapshot{
+		SpanContext:            trace.SpanContext{TraceID: tid, SpanID: trace.SpanID{seed, 2}},
+		ParentSpanID:           trace.SpanID{seed, 1},
+		Name:                   "child",
+		StartTime:              start.Add(time.Millisecond),
+		EndTime:                start.Add(2 * time.Millisecond),
+		Attributes:             childAttrs,
+		MessageEvents:          []trace.Event{{Name: "event", Time: start}},
+		Resource:               res,
+		InstrumentationLibrary: lib,
+	}
+	root := &export.SpanSnapshot{
+		SpanContext:            trace.SpanContext{TraceID: tid, SpanID: trace.SpanID{seed, 1}},
+		Name:                   "root",
+		StartTime:              start,
+		EndTime:                start.Add(3 * time.Millisecond),
+		Links:                  []trace.Link{{SpanContext: trace.SpanContext{TraceID: trace.TraceID{9}, SpanID: trace.SpanID{9}}}},
+		Resource:               res,
+		InstrumentationLibrary: lib,
+	}
+
+	var buf bytes.Buffer
+	m := jsonpb.Marshaler{EnumsAsInts: true}
+	// One request per span, like separate exports.
+	for _, sd := range []*export.SpanSnapshot{child, root} {
+		req := &coltracepb.ExportTraceServiceRequest{ResourceSpans: transform.SpanData([]*export.SpanSnapshot{sd})}
+		require.NoError(t, m.Marshal(&buf, req))
+		buf.WriteString("\n")
+	}
+	return buf.String()
+}
+
+func TestRead(t *testing.T) {
+	spans, err := Read(strings.NewReader(exportJSON(t, 1, label.Int("count", 3))))
+	require.NoError(t, err)
+	require.Len(t, spans, 2)
+
+	assert.Equal(t, Span{
+		Path:                   "root",
+		Resource:               []string{`service.name="test"`},
+		InstrumentationLibrary: "lib@v1",
+		Kind:                   "SPAN_KIND_UNSPECIFIED",
+		Links:                  []Link{{Path: "?"}},
+		StatusCode:             "STATUS_CODE_OK",
+	}, spans[0])
+	assert.Equal(t, Span{
+		Path:                   "root > child",
+		Resource:               []string{`service.name="test"`},
+		InstrumentationLibrary: "lib@v1",
+		Kind:                   "SPAN_KIND_UNSPECIFIED",
+		Attributes:             []string{"count=3"},
+		Events:                 []Event{{Name: "event"}},
+		StatusCode:             "STATUS_CODE_OK",
+	}, spans[1])
+}
+
+func TestReadInvalid(t *testing.T) {
+	_, err := Read(strings.NewReader(`{"resourceSpans": 1}`))
+	assert.Error(t, err)
+}
+
+func TestDiff(t *testing.T) {
+	want := exportJSON(t, 1, label.Bool("ok", true))
+
+	diff, err := Diff(strings.NewReader(want), strings.NewReader(exportJSON(t, 2, label.Bool("ok", true))))
+	require.NoError(t, err)
+	assert.Empty(t, diff, "IDs and timestamps must be ignored")
+
+	diff, err = Diff(strings.NewReader(want), strings.NewReader(exportJSON(t, 2, label.Bool("ok", false))))
+	require.NoError(t, err)
+	assert.Contains(t, diff, "ok=true")
+	assert.Contains(t, diff, "ok=false")
+}
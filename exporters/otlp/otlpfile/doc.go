@@ -0,0 +1,34 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+/*
+Package otlpfile implements a protocol driver that writes traces, metrics
+and log records as JSON encoded OTLP export requests to an io.Writer,
+usually os.Stdout or a file.
+
+It is meant for local development, where telemetry is read by people or
+by tools like otlpdiff instead of a collector:
+
+	driver := otlpfile.NewDriver(os.Stdout, otlpfile.WithPrettyPrint())
+	exporter, err := otlp.NewExporter(ctx, driver)
+
+Every export request is written on its own line, or as an indented JSON
+document with WithPrettyPrint.
+
+This package is currently in a pre-GA phase. Backwards incompatible
+changes may be introduced in subsequent minor version releases as we
+work to track the evolving OpenTelemetry specification and user
+feedback.
+*/
+package otlpfile // import "go.opentelemetry.io/otel/exporters/otlp/otlpfile"
@@ -0,0 +1,139 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package otlpfile
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"sync"
+
+	"github.com/gogo/protobuf/jsonpb"
+	"github.com/gogo/protobuf/proto"
+
+	"go.opentelemetry.io/otel/exporters/otlp"
+	collogspb "go.opentelemetry.io/otel/exporters/otlp/internal/opentelemetry-proto-gen/collector/logs/v1"
+	colmetricspb "go.opentelemetry.io/otel/exporters/otlp/internal/opentelemetry-proto-gen/collector/metrics/v1"
+	coltracepb "go.opentelemetry.io/otel/exporters/otlp/internal/opentelemetry-proto-gen/collector/trace/v1"
+	"go.opentelemetry.io/otel/exporters/otlp/internal/transform"
+	logsdk "go.opentelemetry.io/otel/sdk/export/log"
+	metricsdk "go.opentelemetry.io/otel/sdk/export/metric"
+	tracesdk "go.opentelemetry.io/otel/sdk/export/trace"
+)
+
+var errStopped = errors.New("otlpfile: driver is stopped")
+
+type driver struct {
+	marshaler jsonpb.Marshaler
+
+	mu      sync.Mutex
+	w       io.Writer
+	stopped bool
+}
+
+var (
+	_ otlp.ProtocolDriver     = (*driver)(nil)
+	_ otlp.LogsProtocolDriver = (*driver)(nil)
+)
+
+// NewDriver creates a new file driver writing requests to w. Writes to w
+// are serialized. The driver does not close w when it is stopped.
+func NewDriver(w io.Writer, opts ...Option) otlp.ProtocolDriver {
+	var cfg config
+	for _, opt := range opts {
+		opt.Apply(&cfg)
+	}
+	d := &driver{
+		w: w,
+		marshaler: jsonpb.Marshaler{
+			EnumsAsInts: true,
+		},
+	}
+	if cfg.prettyPrint {
+		d.marshaler.Indent = "\t"
+	}
+	return d
+}
+
+// Start implements otlp.ProtocolDriver.
+func (d *driver) Start(ctx context.Context) error {
+	// nothing to do
+	return nil
+}
+
+// Stop implements otlp.ProtocolDriver.
+func (d *driver) Stop(ctx context.Context) error {
+	d.mu.Lock()
+	d.stopped = true
+	d.mu.Unlock()
+	return nil
+}
+
+// ExportMetrics implements otlp.ProtocolDriver.
+func (d *driver) ExportMetrics(ctx context.Context, cps metricsdk.CheckpointSet, selector metricsdk.ExportKindSelector) error {
+	rms, err := transform.CheckpointSet(ctx, selector, cps, 1)
+	if err != nil {
+		return err
+	}
+	if len(rms) == 0 {
+		return nil
+	}
+	return d.write(&colmetricspb.ExportMetricsServiceRequest{
+		ResourceMetrics: rms,
+	})
+}
+
+// ExportTraces implements otlp.ProtocolDriver.
+func (d *driver) ExportTraces(ctx context.Context, ss []*tracesdk.SpanSnapshot) error {
+	// The messages are marshaled by the time write returns.
+	buf := transform.NewSpanBuffer()
+	defer buf.Release()
+	protoSpans := buf.SpanData(ss)
+	if len(protoSpans) == 0 {
+		return nil
+	}
+	return d.write(&coltracepb.ExportTraceServiceRequest{
+		ResourceSpans: protoSpans,
+	})
+}
+
+// ExportLogs implements otlp.LogsProtocolDriver.
+func (d *driver) ExportLogs(ctx context.Context, records []*logsdk.Record) error {
+	protoLogs := transform.LogRecords(records)
+	if len(protoLogs) == 0 {
+		return nil
+	}
+	return d.write(&collogspb.ExportLogsServiceRequest{
+		ResourceLogs: protoLogs,
+	})
+}
+
+// write writes pbRequest to the writer of d, followed by a newline.
+func (d *driver) write(pbRequest proto.Message) error {
+	var body bytes.Buffer
+	if err := d.marshaler.Marshal(&body, pbRequest); err != nil {
+		return err
+	}
+	body.WriteByte('\n')
+
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if d.stopped {
+		return errStopped
+	}
+	_, err := d.w.Write(body.Bytes())
+	return err
+}
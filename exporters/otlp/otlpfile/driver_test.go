@@ -0,0 +1,100 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package otlpfile_test
+
+import (
+	"bytes"
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/gogo/protobuf/jsonpb"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.opentelemetry.io/otel/exporters/otlp"
+	collogspb "go.opentelemetry.io/otel/exporters/otlp/internal/opentelemetry-proto-gen/collector/logs/v1"
+	"go.opentelemetry.io/otel/exporters/otlp/otlpdiff"
+	"go.opentelemetry.io/otel/exporters/otlp/otlpfile"
+	"go.opentelemetry.io/otel/label"
+	"go.opentelemetry.io/otel/log"
+	logsdk "go.opentelemetry.io/otel/sdk/export/log"
+	tracesdk "go.opentelemetry.io/otel/sdk/export/trace"
+	"go.opentelemetry.io/otel/sdk/resource"
+	"go.opentelemetry.io/otel/trace"
+)
+
+func TestExportTraces(t *testing.T) {
+	var b bytes.Buffer
+	exp, err := otlp.NewExporter(context.Background(), otlpfile.NewDriver(&b))
+	require.NoError(t, err)
+
+	spans := []*tracesdk.SpanSnapshot{{
+		SpanContext: trace.SpanContext{TraceID: trace.TraceID{1}, SpanID: trace.SpanID{1}},
+		Name:        "root",
+		Resource:    resource.NewWithAttributes(label.String("service.name", "test")),
+	}}
+	require.NoError(t, exp.ExportSpans(context.Background(), spans))
+	require.NoError(t, exp.ExportSpans(context.Background(), spans))
+	require.NoError(t, exp.Shutdown(context.Background()))
+	assert.Equal(t, 2, strings.Count(b.String(), "\n"), "one request per line")
+
+	got, err := otlpdiff.Read(&b)
+	require.NoError(t, err)
+	require.Len(t, got, 2)
+	assert.Equal(t, "root", got[0].Path)
+	assert.Equal(t, []string{`service.name="test"`}, got[0].Resource)
+}
+
+func TestExportLogs(t *testing.T) {
+	var b bytes.Buffer
+	exp, err := otlp.NewExporter(context.Background(), otlpfile.NewDriver(&b, otlpfile.WithPrettyPrint()))
+	require.NoError(t, err)
+
+	records := []*logsdk.Record{{
+		Severity: log.SeverityWarn,
+		Body:     label.StringValue("message"),
+	}}
+	require.NoError(t, exp.ExportLogs(context.Background(), records))
+	assert.Contains(t, b.String(), "\n\t\"resourceLogs\"")
+
+	var req collogspb.ExportLogsServiceRequest
+	require.NoError(t, jsonpb.Unmarshal(&b, &req))
+	require.Len(t, req.ResourceLogs, 1)
+	lr := req.ResourceLogs[0].InstrumentationLibraryLogs[0].Logs
+	require.Len(t, lr, 1)
+	assert.Equal(t, "message", lr[0].Body.GetStringValue())
+	assert.EqualValues(t, log.SeverityWarn, lr[0].SeverityNumber)
+}
+
+func TestExportNothing(t *testing.T) {
+	var b bytes.Buffer
+	exp, err := otlp.NewExporter(context.Background(), otlpfile.NewDriver(&b))
+	require.NoError(t, err)
+	require.NoError(t, exp.ExportSpans(context.Background(), nil))
+	require.NoError(t, exp.ExportLogs(context.Background(), nil))
+	assert.Empty(t, b.String())
+}
+
+func TestExportAfterStop(t *testing.T) {
+	var b bytes.Buffer
+	driver := otlpfile.NewDriver(&b)
+	require.NoError(t, driver.Start(context.Background()))
+	require.NoError(t, driver.Stop(context.Background()))
+
+	err := driver.(otlp.LogsProtocolDriver).ExportLogs(context.Background(), []*logsdk.Record{{}})
+	assert.Error(t, err)
+	assert.Empty(t, b.String())
+}
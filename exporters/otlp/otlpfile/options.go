@@ -0,0 +1,36 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package otlpfile
+
+type config struct {
+	prettyPrint bool
+}
+
+// Option applies an option to the file driver.
+type Option interface {
+	Apply(*config)
+}
+
+type prettyPrintOption bool
+
+func (o prettyPrintOption) Apply(cfg *config) {
+	cfg.prettyPrint = bool(o)
+}
+
+// WithPrettyPrint tells the driver to write every request as an
+// indented JSON document instead of a single line.
+func WithPrettyPrint() Option {
+	return prettyPrintOption(true)
+}
@@ -16,6 +16,7 @@ package otlpgrpc
 
 import (
 	"context"
+	"fmt"
 	"math/rand"
 	"sync"
 	"sync/atomic"
@@ -23,7 +24,12 @@ import (
 	"unsafe"
 
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/connectivity"
+	"google.golang.org/grpc/credentials"
 	"google.golang.org/grpc/metadata"
+
+	"go.opentelemetry.io/otel/exporters/otlp"
+	"go.opentelemetry.io/otel/internal/global"
 )
 
 type connection struct {
@@ -85,25 +91,31 @@ func (c *connection) lastConnectError() error {
 	return *errPtr
 }
 
-func (c *connection) saveLastConnectError(err error) {
+// saveLastConnectError stores err as the last connection error and
+// reports whether the connection was considered connected before.
+func (c *connection) saveLastConnectError(err error) bool {
 	var errPtr *error
 	if err != nil {
 		errPtr = &err
 	}
-	atomic.StorePointer(&c.lastConnectErrPtr, unsafe.Pointer(errPtr))
+	return atomic.SwapPointer(&c.lastConnectErrPtr, unsafe.Pointer(errPtr)) == nil
 }
 
 func (c *connection) setStateDisconnected(err error) {
-	c.saveLastConnectError(err)
+	wasConnected := c.saveLastConnectError(err)
 	select {
 	case c.disconnectedCh <- true:
 	default:
 	}
 	c.newConnectionHandler(nil)
+	if wasConnected {
+		c.cfg.connectionHooks.Disconnected(err)
+	}
 }
 
 func (c *connection) setStateConnected() {
 	c.saveLastConnectError(nil)
+	c.cfg.connectionHooks.Connected()
 }
 
 func (c *connection) connected() bool {
@@ -152,16 +164,19 @@ func (c *connection) indefiniteBackgroundConnection() {
 			// Normal scenario that we'll wait for
 		}
 
+		// Apply some jitter to avoid lockstep retrials of other
+		// collector-exporters. Lockstep retrials could result in an
+		// innocent DDOS, by clogging the machine's resources and network.
+		jitter := time.Duration(rng.Int63n(maxJitterNanos))
 		if err := c.connect(context.Background()); err == nil {
 			c.setStateConnected()
 		} else {
 			c.setStateDisconnected(err)
+			global.Debug("otlpgrpc: reconnect failed",
+				"error", err, "delay", connReattemptPeriod+jitter)
+			c.cfg.connectionHooks.RetryScheduled(connReattemptPeriod + jitter)
 		}
 
-		// Apply some jitter to avoid lockstep retrials of other
-		// collector-exporters. Lockstep retrials could result in an
-		// innocent DDOS, by clogging the machine's resources and network.
-		jitter := time.Duration(rng.Int63n(maxJitterNanos))
 		select {
 		case <-c.stopCh:
 			return
@@ -170,6 +185,35 @@ func (c *connection) indefiniteBackgroundConnection() {
 	}
 }
 
+// ready returns nil once the client connection is ready to send
+// requests. It waits for the connection to become ready until ctx is
+// done.
+func (c *connection) ready(ctx context.Context) error {
+	if err := c.lastConnectError(); err != nil {
+		return err
+	}
+
+	c.mu.Lock()
+	cc := c.cc
+	c.mu.Unlock()
+	if cc == nil {
+		return errNoClient
+	}
+
+	for {
+		state := cc.GetState()
+		switch state {
+		case connectivity.Ready:
+			return nil
+		case connectivity.Shutdown:
+			return errDisconnected
+		}
+		if !cc.WaitForStateChange(ctx, state) {
+			return fmt.Errorf("connection state %s: %w", state, ctx.Err())
+		}
+	}
+}
+
 func (c *connection) connect(ctx context.Context) error {
 	cc, err := c.dialToCollector(ctx)
 	if err != nil {
@@ -213,6 +257,9 @@ func (c *connection) dialToCollector(ctx context.Context) (*grpc.ClientConn, err
 	} else if c.cfg.canDialInsecure {
 		dialOpts = append(dialOpts, grpc.WithInsecure())
 	}
+	if c.cfg.credsProvider != nil {
+		dialOpts = append(dialOpts, grpc.WithPerRPCCredentials(perRPCCredentials{c.cfg.credsProvider}))
+	}
 	if c.cfg.compressor != "" {
 		dialOpts = append(dialOpts, grpc.WithDefaultCallOptions(grpc.UseCompressor(c.cfg.compressor)))
 	}
@@ -269,3 +316,19 @@ func (c *connection) contextWithStop(ctx context.Context) (context.Context, cont
 	}(ctx, cancel)
 	return ctx, cancel
 }
+
+// perRPCCredentials adapts an otlp.CredentialsProvider to the
+// credentials.PerRPCCredentials interface of gRPC.
+type perRPCCredentials struct {
+	provider otlp.CredentialsProvider
+}
+
+var _ credentials.PerRPCCredentials = perRPCCredentials{}
+
+func (c perRPCCredentials) GetRequestMetadata(ctx context.Context, _ ...string) (map[string]string, error) {
+	return c.provider.Credentials(ctx)
+}
+
+func (c perRPCCredentials) RequireTransportSecurity() bool {
+	return c.provider.RequireTransportSecurity()
+}
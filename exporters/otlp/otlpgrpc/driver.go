@@ -23,11 +23,14 @@ import (
 	"google.golang.org/grpc"
 
 	"go.opentelemetry.io/otel/exporters/otlp"
+	collogspb "go.opentelemetry.io/otel/exporters/otlp/internal/opentelemetry-proto-gen/collector/logs/v1"
 	colmetricpb "go.opentelemetry.io/otel/exporters/otlp/internal/opentelemetry-proto-gen/collector/metrics/v1"
 	coltracepb "go.opentelemetry.io/otel/exporters/otlp/internal/opentelemetry-proto-gen/collector/trace/v1"
+	logspb "go.opentelemetry.io/otel/exporters/otlp/internal/opentelemetry-proto-gen/logs/v1"
 	metricpb "go.opentelemetry.io/otel/exporters/otlp/internal/opentelemetry-proto-gen/metrics/v1"
 	tracepb "go.opentelemetry.io/otel/exporters/otlp/internal/opentelemetry-proto-gen/trace/v1"
 	"go.opentelemetry.io/otel/exporters/otlp/internal/transform"
+	logsdk "go.opentelemetry.io/otel/sdk/export/log"
 	metricsdk "go.opentelemetry.io/otel/sdk/export/metric"
 	tracesdk "go.opentelemetry.io/otel/sdk/export/trace"
 )
@@ -38,8 +41,12 @@ type driver struct {
 	lock          sync.Mutex
 	metricsClient colmetricpb.MetricsServiceClient
 	tracesClient  coltracepb.TraceServiceClient
+	logsClient    collogspb.LogsServiceClient
 }
 
+var _ otlp.ReadinessChecker = (*driver)(nil)
+var _ otlp.LogsProtocolDriver = (*driver)(nil)
+
 var (
 	errNoClient     = errors.New("no client")
 	errDisconnected = errors.New("exporter disconnected")
@@ -65,9 +72,11 @@ func (d *driver) handleNewConnection(cc *grpc.ClientConn) {
 	if cc != nil {
 		d.metricsClient = colmetricpb.NewMetricsServiceClient(cc)
 		d.tracesClient = coltracepb.NewTraceServiceClient(cc)
+		d.logsClient = collogspb.NewLogsServiceClient(cc)
 	} else {
 		d.metricsClient = nil
 		d.tracesClient = nil
+		d.logsClient = nil
 	}
 }
 
@@ -84,6 +93,12 @@ func (d *driver) Stop(ctx context.Context) error {
 	return d.connection.shutdown(ctx)
 }
 
+// Ready implements otlp.ReadinessChecker. It returns nil once the
+// connection to the collector is ready to send requests.
+func (d *driver) Ready(ctx context.Context) error {
+	return d.connection.ready(ctx)
+}
+
 // ExportMetrics implements otlp.ProtocolDriver. It transforms metrics
 // to protobuf binary format and sends the result to the collector.
 func (d *driver) ExportMetrics(ctx context.Context, cps metricsdk.CheckpointSet, selector metricsdk.ExportKindSelector) error {
@@ -132,7 +147,10 @@ func (d *driver) ExportTraces(ctx context.Context, ss []*tracesdk.SpanSnapshot)
 	ctx, cancel := d.connection.contextWithStop(ctx)
 	defer cancel()
 
-	protoSpans := transform.SpanData(ss)
+	// The messages are marshaled by the time uploadTraces returns.
+	buf := transform.NewSpanBuffer()
+	defer buf.Release()
+	protoSpans := buf.SpanData(ss)
 	if len(protoSpans) == 0 {
 		return nil
 	}
@@ -158,3 +176,40 @@ func (d *driver) uploadTraces(ctx context.Context, protoSpans []*tracepb.Resourc
 	}
 	return err
 }
+
+// ExportLogs implements otlp.LogsProtocolDriver. It transforms log
+// records to protobuf binary format and sends the result to the
+// collector.
+func (d *driver) ExportLogs(ctx context.Context, records []*logsdk.Record) error {
+	if !d.connection.connected() {
+		return errDisconnected
+	}
+	ctx, cancel := d.connection.contextWithStop(ctx)
+	defer cancel()
+
+	protoLogs := transform.LogRecords(records)
+	if len(protoLogs) == 0 {
+		return nil
+	}
+
+	return d.uploadLogs(ctx, protoLogs)
+}
+
+func (d *driver) uploadLogs(ctx context.Context, protoLogs []*logspb.ResourceLogs) error {
+	ctx = d.connection.contextWithMetadata(ctx)
+	err := func() error {
+		d.lock.Lock()
+		defer d.lock.Unlock()
+		if d.logsClient == nil {
+			return errNoClient
+		}
+		_, err := d.logsClient.Export(ctx, &collogspb.ExportLogsServiceRequest{
+			ResourceLogs: protoLogs,
+		})
+		return err
+	}()
+	if err != nil {
+		d.connection.setStateDisconnected(err)
+	}
+	return err
+}
@@ -25,8 +25,10 @@ import (
 	"google.golang.org/grpc"
 	metadata "google.golang.org/grpc/metadata"
 
+	collectorlogspb "go.opentelemetry.io/otel/exporters/otlp/internal/opentelemetry-proto-gen/collector/logs/v1"
 	collectormetricpb "go.opentelemetry.io/otel/exporters/otlp/internal/opentelemetry-proto-gen/collector/metrics/v1"
 	collectortracepb "go.opentelemetry.io/otel/exporters/otlp/internal/opentelemetry-proto-gen/collector/trace/v1"
+	logspb "go.opentelemetry.io/otel/exporters/otlp/internal/opentelemetry-proto-gen/logs/v1"
 	metricpb "go.opentelemetry.io/otel/exporters/otlp/internal/opentelemetry-proto-gen/metrics/v1"
 	tracepb "go.opentelemetry.io/otel/exporters/otlp/internal/opentelemetry-proto-gen/trace/v1"
 	"go.opentelemetry.io/otel/exporters/otlp/internal/otlptest"
@@ -41,6 +43,7 @@ func makeMockCollector(t *testing.T) *mockCollector {
 		metricSvc: &mockMetricService{
 			storage: otlptest.NewMetricsStorage(),
 		},
+		logsSvc: &mockLogsService{},
 	}
 }
 
@@ -96,11 +99,31 @@ func (mms *mockMetricService) Export(ctx context.Context, exp *collectormetricpb
 	return reply, nil
 }
 
+type mockLogsService struct {
+	mu           sync.RWMutex
+	resourceLogs []*logspb.ResourceLogs
+}
+
+func (mls *mockLogsService) getResourceLogs() []*logspb.ResourceLogs {
+	mls.mu.RLock()
+	defer mls.mu.RUnlock()
+	return mls.resourceLogs
+}
+
+func (mls *mockLogsService) Export(ctx context.Context, exp *collectorlogspb.ExportLogsServiceRequest) (*collectorlogspb.ExportLogsServiceResponse, error) {
+	reply := &collectorlogspb.ExportLogsServiceResponse{}
+	mls.mu.Lock()
+	defer mls.mu.Unlock()
+	mls.resourceLogs = append(mls.resourceLogs, exp.GetResourceLogs()...)
+	return reply, nil
+}
+
 type mockCollector struct {
 	t *testing.T
 
 	traceSvc  *mockTraceService
 	metricSvc *mockMetricService
+	logsSvc   *mockLogsService
 
 	endpoint string
 	stopFunc func() error
@@ -109,6 +132,7 @@ type mockCollector struct {
 
 var _ collectortracepb.TraceServiceServer = (*mockTraceService)(nil)
 var _ collectormetricpb.MetricsServiceServer = (*mockMetricService)(nil)
+var _ collectorlogspb.LogsServiceServer = (*mockLogsService)(nil)
 
 var errAlreadyStopped = fmt.Errorf("already stopped")
 
@@ -162,6 +186,10 @@ func (mc *mockCollector) getHeaders() metadata.MD {
 	return mc.traceSvc.getHeaders()
 }
 
+func (mc *mockCollector) getResourceLogs() []*logspb.ResourceLogs {
+	return mc.logsSvc.getResourceLogs()
+}
+
 func (mc *mockCollector) getMetrics() []*metricpb.Metric {
 	return mc.metricSvc.getMetrics()
 }
@@ -185,6 +213,7 @@ func runMockCollectorAtEndpoint(t *testing.T, endpoint string) *mockCollector {
 	mc := makeMockCollector(t)
 	collectortracepb.RegisterTraceServiceServer(srv, mc.traceSvc)
 	collectormetricpb.RegisterMetricsServiceServer(srv, mc.metricSvc)
+	collectorlogspb.RegisterLogsServiceServer(srv, mc.logsSvc)
 	go func() {
 		_ = srv.Serve(ln)
 	}()
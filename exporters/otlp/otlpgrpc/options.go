@@ -19,6 +19,8 @@ import (
 
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials"
+
+	"go.opentelemetry.io/otel/exporters/otlp"
 )
 
 const (
@@ -68,6 +70,8 @@ type config struct {
 	dialOptions        []grpc.DialOption
 	headers            map[string]string
 	clientCredentials  credentials.TransportCredentials
+	credsProvider      otlp.CredentialsProvider
+	connectionHooks    otlp.ConnectionHooks
 }
 
 // Option applies an option to the gRPC driver.
@@ -128,6 +132,25 @@ func WithTLSCredentials(creds credentials.TransportCredentials) Option {
 	}
 }
 
+// WithCredentialsProvider sets a provider of per-RPC credentials,
+// e.g. OAuth2 access tokens, that are sent as metadata with every
+// export request. If the provider requires transport security, the
+// connection must not be configured with WithInsecure.
+func WithCredentialsProvider(provider otlp.CredentialsProvider) Option {
+	return func(cfg *config) {
+		cfg.credsProvider = provider
+	}
+}
+
+// WithConnectionHooks sets the functions called when the driver
+// connects to the collector, loses the connection, or schedules a
+// reconnection attempt.
+func WithConnectionHooks(hooks otlp.ConnectionHooks) Option {
+	return func(cfg *config) {
+		cfg.connectionHooks = hooks
+	}
+}
+
 // WithServiceConfig defines the default gRPC service config used.
 func WithServiceConfig(serviceConfig string) Option {
 	return func(cfg *config) {
@@ -19,6 +19,7 @@ import (
 	"fmt"
 	"net"
 	"strings"
+	"sync/atomic"
 	"testing"
 	"time"
 
@@ -33,6 +34,8 @@ import (
 	"go.opentelemetry.io/otel/exporters/otlp/internal/otlptest"
 	"go.opentelemetry.io/otel/exporters/otlp/otlpgrpc"
 	"go.opentelemetry.io/otel/label"
+	"go.opentelemetry.io/otel/log"
+	exportlog "go.opentelemetry.io/otel/sdk/export/log"
 	exporttrace "go.opentelemetry.io/otel/sdk/export/trace"
 	sdktrace "go.opentelemetry.io/otel/sdk/trace"
 )
@@ -250,6 +253,102 @@ func TestNewExporter_withHeaders(t *testing.T) {
 	assert.Equal(t, "value1", headers.Get("header1")[0])
 }
 
+func TestNewExporter_exportLogs(t *testing.T) {
+	mc := runMockCollector(t)
+	defer func() {
+		_ = mc.stop()
+	}()
+
+	ctx := context.Background()
+	exp := newGRPCExporter(t, ctx, mc.endpoint)
+	defer func() {
+		_ = exp.Shutdown(ctx)
+	}()
+
+	traceID := [16]byte{1}
+	spanID := [8]byte{2}
+	records := []*exportlog.Record{{
+		Severity: log.SeverityError,
+		Body:     label.StringValue("message"),
+	}}
+	records[0].SpanContext.TraceID = traceID
+	records[0].SpanContext.SpanID = spanID
+	require.NoError(t, exp.ExportLogs(ctx, records))
+	require.NoError(t, exp.ExportLogs(ctx, nil))
+
+	rls := mc.getResourceLogs()
+	require.Len(t, rls, 1)
+	require.Len(t, rls[0].InstrumentationLibraryLogs, 1)
+	logs := rls[0].InstrumentationLibraryLogs[0].Logs
+	require.Len(t, logs, 1)
+	assert.Equal(t, "message", logs[0].Body.GetStringValue())
+	assert.Equal(t, int32(log.SeverityError), int32(logs[0].SeverityNumber))
+	assert.Equal(t, traceID[:], logs[0].TraceId)
+	assert.Equal(t, spanID[:], logs[0].SpanId)
+}
+
+type insecureCredentials map[string]string
+
+func (c insecureCredentials) Credentials(context.Context) (map[string]string, error) {
+	return c, nil
+}
+
+func (insecureCredentials) RequireTransportSecurity() bool {
+	return false
+}
+
+func TestNewExporter_withCredentialsProvider(t *testing.T) {
+	mc := runMockCollector(t)
+	defer func() {
+		_ = mc.stop()
+	}()
+
+	ctx := context.Background()
+	exp := newGRPCExporter(t, ctx, mc.endpoint,
+		otlpgrpc.WithCredentialsProvider(insecureCredentials{"authorization": "Bearer t0ken"}))
+	require.NoError(t, exp.ExportSpans(ctx, []*exporttrace.SpanSnapshot{{Name: "in the midst"}}))
+
+	defer func() {
+		_ = exp.Shutdown(ctx)
+	}()
+
+	headers := mc.getHeaders()
+	require.Len(t, headers.Get("authorization"), 1)
+	assert.Equal(t, "Bearer t0ken", headers.Get("authorization")[0])
+}
+
+func TestNewExporter_connectionHooks(t *testing.T) {
+	mc := runMockCollector(t)
+
+	var connected, disconnected int32
+	ctx := context.Background()
+	exp := newGRPCExporter(t, ctx, mc.endpoint,
+		otlpgrpc.WithReconnectionPeriod(time.Hour),
+		otlpgrpc.WithConnectionHooks(otlp.ConnectionHooks{
+			OnConnected:    func() { atomic.AddInt32(&connected, 1) },
+			OnDisconnected: func(error) { atomic.AddInt32(&disconnected, 1) },
+		}))
+	defer func() {
+		_ = exp.Shutdown(ctx)
+	}()
+
+	readyCtx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+	require.NoError(t, exp.Ready(readyCtx))
+	assert.EqualValues(t, 1, atomic.LoadInt32(&connected))
+	assert.EqualValues(t, 0, atomic.LoadInt32(&disconnected))
+
+	_ = mc.stop()
+	require.Error(t, exp.ExportSpans(ctx, []*exporttrace.SpanSnapshot{{Name: "in the midst"}}))
+	assert.EqualValues(t, 1, atomic.LoadInt32(&disconnected))
+
+	// The collector is gone, so the exporter must not become ready
+	// even if it reconnects in the background.
+	readyCtx, cancel = context.WithTimeout(ctx, 100*time.Millisecond)
+	defer cancel()
+	assert.Error(t, exp.Ready(readyCtx))
+}
+
 func TestNewExporter_withMultipleAttributeTypes(t *testing.T) {
 	mc := runMockCollector(t)
 
@@ -417,6 +516,7 @@ func TestDisconnected(t *testing.T) {
 
 	assert.Error(t, exp.Export(ctx, otlptest.OneRecordCheckpointSet{}))
 	assert.Error(t, exp.ExportSpans(ctx, otlptest.SingleSpanSnapshot()))
+	assert.Error(t, exp.ExportLogs(ctx, []*exportlog.Record{{}}))
 }
 
 func TestEmptyData(t *testing.T) {
@@ -18,6 +18,7 @@ import (
 	"bytes"
 	"compress/gzip"
 	"context"
+	"errors"
 	"fmt"
 	"io"
 	"io/ioutil"
@@ -26,19 +27,25 @@ import (
 	"net/http"
 	"path"
 	"strings"
+	"sync"
 	"time"
 
 	"go.opentelemetry.io/otel"
 	"go.opentelemetry.io/otel/exporters/otlp"
+	collogspb "go.opentelemetry.io/otel/exporters/otlp/internal/opentelemetry-proto-gen/collector/logs/v1"
 	colmetricspb "go.opentelemetry.io/otel/exporters/otlp/internal/opentelemetry-proto-gen/collector/metrics/v1"
 	coltracepb "go.opentelemetry.io/otel/exporters/otlp/internal/opentelemetry-proto-gen/collector/trace/v1"
 	"go.opentelemetry.io/otel/exporters/otlp/internal/transform"
+	"go.opentelemetry.io/otel/internal/global"
+	logsdk "go.opentelemetry.io/otel/sdk/export/log"
 	metricsdk "go.opentelemetry.io/otel/sdk/export/metric"
 	tracesdk "go.opentelemetry.io/otel/sdk/export/trace"
 )
 
 const contentType = "application/x-protobuf"
 
+var errInsecureCredentials = errors.New("otlphttp: credentials require transport security, but the driver is insecure")
+
 // Keep it in sync with golang's DefaultTransport from net/http! We
 // have our own copy to avoid handling a situation where the
 // DefaultTransport is overwritten with some different implementation
@@ -62,9 +69,17 @@ type driver struct {
 	cfg    config
 
 	stopCh chan struct{}
+
+	// stateMu protects the state of the connection to the
+	// collector as seen by the last request.
+	stateMu   sync.Mutex
+	connected bool
+	lastErr   error
 }
 
 var _ otlp.ProtocolDriver = (*driver)(nil)
+var _ otlp.ReadinessChecker = (*driver)(nil)
+var _ otlp.LogsProtocolDriver = (*driver)(nil)
 
 // NewDriver creates a new HTTP driver.
 func NewDriver(opts ...Option) otlp.ProtocolDriver {
@@ -73,6 +88,7 @@ func NewDriver(opts ...Option) otlp.ProtocolDriver {
 		compression:    NoCompression,
 		tracesURLPath:  DefaultTracesPath,
 		metricsURLPath: DefaultMetricsPath,
+		logsURLPath:    DefaultLogsPath,
 		maxAttempts:    DefaultMaxAttempts,
 		backoff:        DefaultBackoff,
 	}
@@ -82,6 +98,7 @@ func NewDriver(opts ...Option) otlp.ProtocolDriver {
 	for pathPtr, defaultPath := range map[*string]string{
 		&cfg.tracesURLPath:  DefaultTracesPath,
 		&cfg.metricsURLPath: DefaultMetricsPath,
+		&cfg.logsURLPath:    DefaultLogsPath,
 	} {
 		tmp := strings.TrimSpace(*pathPtr)
 		if tmp == "" {
@@ -130,6 +147,25 @@ func (d *driver) Stop(ctx context.Context) error {
 	return nil
 }
 
+// Ready implements otlp.ReadinessChecker. It returns nil if the last
+// request reached the collector. Otherwise it checks whether a TCP
+// connection to the collector can be established.
+func (d *driver) Ready(ctx context.Context) error {
+	d.stateMu.Lock()
+	connected := d.connected
+	d.stateMu.Unlock()
+	if connected {
+		return nil
+	}
+
+	var dialer net.Dialer
+	conn, err := dialer.DialContext(ctx, "tcp", d.cfg.endpoint)
+	if err != nil {
+		return err
+	}
+	return conn.Close()
+}
+
 // ExportMetrics implements otlp.ProtocolDriver.
 func (d *driver) ExportMetrics(ctx context.Context, cps metricsdk.CheckpointSet, selector metricsdk.ExportKindSelector) error {
 	rms, err := transform.CheckpointSet(ctx, selector, cps, 1)
@@ -151,20 +187,39 @@ func (d *driver) ExportMetrics(ctx context.Context, cps metricsdk.CheckpointSet,
 
 // ExportTraces implements otlp.ProtocolDriver.
 func (d *driver) ExportTraces(ctx context.Context, ss []*tracesdk.SpanSnapshot) error {
-	protoSpans := transform.SpanData(ss)
+	buf := transform.NewSpanBuffer()
+	protoSpans := buf.SpanData(ss)
 	if len(protoSpans) == 0 {
+		buf.Release()
 		return nil
 	}
 	pbRequest := &coltracepb.ExportTraceServiceRequest{
 		ResourceSpans: protoSpans,
 	}
 	rawRequest, err := pbRequest.Marshal()
+	buf.Release()
 	if err != nil {
 		return err
 	}
 	return d.send(ctx, rawRequest, d.cfg.tracesURLPath)
 }
 
+// ExportLogs implements otlp.LogsProtocolDriver.
+func (d *driver) ExportLogs(ctx context.Context, records []*logsdk.Record) error {
+	protoLogs := transform.LogRecords(records)
+	if len(protoLogs) == 0 {
+		return nil
+	}
+	pbRequest := &collogspb.ExportLogsServiceRequest{
+		ResourceLogs: protoLogs,
+	}
+	rawRequest, err := pbRequest.Marshal()
+	if err != nil {
+		return err
+	}
+	return d.send(ctx, rawRequest, d.cfg.logsURLPath)
+}
+
 func (d *driver) send(ctx context.Context, rawRequest []byte, urlPath string) error {
 	address := fmt.Sprintf("%s://%s%s", d.getScheme(), d.cfg.endpoint, urlPath)
 	var cancel context.CancelFunc
@@ -186,8 +241,12 @@ func (d *driver) send(ctx context.Context, rawRequest []byte, urlPath string) er
 		case http.StatusTooManyRequests:
 			fallthrough
 		case http.StatusServiceUnavailable:
+			delay := getWaitDuration(d.cfg.backoff, i)
+			global.Debug("otlphttp: retrying export",
+				"status", response.Status, "attempt", i+1, "delay", delay)
+			d.cfg.hooks.RetryScheduled(delay)
 			select {
-			case <-time.After(getWaitDuration(d.cfg.backoff, i)):
+			case <-time.After(delay):
 				continue
 			case <-ctx.Done():
 				return ctx.Err()
@@ -247,6 +306,9 @@ func (d *driver) singleSend(ctx context.Context, rawRequest []byte, address stri
 	if err != nil {
 		return nil, err
 	}
+	if err := d.addCredentials(ctx, request.Header); err != nil {
+		return nil, err
+	}
 	bodyReader, contentLength, headers := d.prepareBody(rawRequest)
 	// Not closing bodyReader through defer, the HTTP Client's
 	// Transport will do it for us
@@ -257,7 +319,55 @@ func (d *driver) singleSend(ctx context.Context, rawRequest []byte, address stri
 			request.Header.Add(key, value)
 		}
 	}
-	return d.client.Do(request)
+	response, err := d.client.Do(request)
+	if err == nil {
+		d.setStateConnected()
+	} else if ctx.Err() == nil {
+		d.setStateDisconnected(err)
+	}
+	return response, err
+}
+
+func (d *driver) setStateConnected() {
+	d.stateMu.Lock()
+	changed := !d.connected
+	d.connected = true
+	d.lastErr = nil
+	d.stateMu.Unlock()
+
+	if changed {
+		d.cfg.hooks.Connected()
+	}
+}
+
+func (d *driver) setStateDisconnected(err error) {
+	d.stateMu.Lock()
+	changed := d.connected || d.lastErr == nil
+	d.connected = false
+	d.lastErr = err
+	d.stateMu.Unlock()
+
+	if changed {
+		d.cfg.hooks.Disconnected(err)
+	}
+}
+
+func (d *driver) addCredentials(ctx context.Context, header http.Header) error {
+	provider := d.cfg.credsProvider
+	if provider == nil {
+		return nil
+	}
+	if provider.RequireTransportSecurity() && d.cfg.insecure {
+		return errInsecureCredentials
+	}
+	creds, err := provider.Credentials(ctx)
+	if err != nil {
+		return err
+	}
+	for key, value := range creds {
+		header.Set(key, value)
+	}
+	return nil
 }
 
 func (d *driver) prepareBody(rawRequest []byte) (io.ReadCloser, int64, http.Header) {
@@ -26,6 +26,9 @@ import (
 	"go.opentelemetry.io/otel/exporters/otlp"
 	"go.opentelemetry.io/otel/exporters/otlp/internal/otlptest"
 	"go.opentelemetry.io/otel/exporters/otlp/otlphttp"
+	"go.opentelemetry.io/otel/label"
+	"go.opentelemetry.io/otel/log"
+	exportlog "go.opentelemetry.io/otel/sdk/export/log"
 )
 
 const (
@@ -105,6 +108,21 @@ func TestEndToEnd(t *testing.T) {
 				ExpectedHeaders: testHeaders,
 			},
 		},
+		{
+			name: "with credentials provider",
+			opts: []otlphttp.Option{
+				otlphttp.WithCredentialsProvider(otlp.NewTokenCredentialsProvider(
+					otlp.TokenSourceFunc(func(context.Context) (otlp.Token, error) {
+						return otlp.Token{Value: "t0ken"}, nil
+					}),
+				)),
+			},
+			mcCfg: mockCollectorConfig{
+				WithTLS:         true,
+				ExpectedHeaders: map[string]string{"Authorization": "Bearer t0ken"},
+			},
+			tls: true,
+		},
 	}
 
 	for _, tc := range tests {
@@ -135,6 +153,29 @@ func TestEndToEnd(t *testing.T) {
 	}
 }
 
+func TestInsecureCredentials(t *testing.T) {
+	mc := runMockCollector(t, mockCollectorConfig{})
+	defer mc.MustStop(t)
+	driver := otlphttp.NewDriver(
+		otlphttp.WithEndpoint(mc.Endpoint()),
+		otlphttp.WithInsecure(),
+		otlphttp.WithCredentialsProvider(otlp.NewTokenCredentialsProvider(
+			otlp.TokenSourceFunc(func(context.Context) (otlp.Token, error) {
+				return otlp.Token{Value: "t0ken"}, nil
+			}),
+		)),
+	)
+	ctx := context.Background()
+	exporter, err := otlp.NewExporter(ctx, driver)
+	require.NoError(t, err)
+	defer func() {
+		assert.NoError(t, exporter.Shutdown(ctx))
+	}()
+	err = exporter.ExportSpans(ctx, otlptest.SingleSpanSnapshot())
+	assert.Error(t, err)
+	assert.Empty(t, mc.GetSpans())
+}
+
 func TestRetry(t *testing.T) {
 	statuses := []int{
 		http.StatusTooManyRequests,
@@ -161,6 +202,45 @@ func TestRetry(t *testing.T) {
 	assert.Len(t, mc.GetSpans(), 1)
 }
 
+func TestConnectionHooks(t *testing.T) {
+	mcCfg := mockCollectorConfig{
+		InjectHTTPStatus: []int{http.StatusServiceUnavailable},
+	}
+	mc := runMockCollector(t, mcCfg)
+	var (
+		connected, retries int
+		disconnectErr      error
+	)
+	driver := otlphttp.NewDriver(
+		otlphttp.WithEndpoint(mc.Endpoint()),
+		otlphttp.WithInsecure(),
+		otlphttp.WithBackoff(time.Millisecond),
+		otlphttp.WithConnectionHooks(otlp.ConnectionHooks{
+			OnConnected:      func() { connected++ },
+			OnDisconnected:   func(err error) { disconnectErr = err },
+			OnRetryScheduled: func(time.Duration) { retries++ },
+		}),
+	)
+	ctx := context.Background()
+	exporter, err := otlp.NewExporter(ctx, driver)
+	require.NoError(t, err)
+	defer func() {
+		assert.NoError(t, exporter.Shutdown(ctx))
+	}()
+
+	assert.NoError(t, exporter.Ready(ctx))
+	assert.NoError(t, exporter.ExportSpans(ctx, otlptest.SingleSpanSnapshot()))
+	assert.Equal(t, 1, connected)
+	assert.Equal(t, 1, retries)
+	assert.NoError(t, disconnectErr)
+	assert.NoError(t, exporter.Ready(ctx))
+
+	mc.MustStop(t)
+	assert.Error(t, exporter.ExportSpans(ctx, otlptest.SingleSpanSnapshot()))
+	assert.Error(t, disconnectErr)
+	assert.Error(t, exporter.Ready(ctx))
+}
+
 func TestRetryFailed(t *testing.T) {
 	statuses := []int{
 		http.StatusTooManyRequests,
@@ -253,6 +333,56 @@ func TestEmptyData(t *testing.T) {
 	assert.Empty(t, mc.GetSpans())
 }
 
+func TestExportLogs(t *testing.T) {
+	for _, tt := range []struct {
+		name  string
+		opts  []otlphttp.Option
+		mcCfg mockCollectorConfig
+	}{
+		{
+			name: "default path",
+		},
+		{
+			name: "custom path",
+			opts: []otlphttp.Option{
+				otlphttp.WithLogsURLPath("post/logs/here"),
+			},
+			mcCfg: mockCollectorConfig{
+				LogsURLPath: "/post/logs/here",
+			},
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := runMockCollector(t, tt.mcCfg)
+			defer mc.MustStop(t)
+			opts := append([]otlphttp.Option{
+				otlphttp.WithEndpoint(mc.Endpoint()),
+				otlphttp.WithInsecure(),
+			}, tt.opts...)
+			ctx := context.Background()
+			exporter, err := otlp.NewExporter(ctx, otlphttp.NewDriver(opts...))
+			require.NoError(t, err)
+			defer func() {
+				assert.NoError(t, exporter.Shutdown(ctx))
+			}()
+
+			records := []*exportlog.Record{{
+				Severity: log.SeverityInfo,
+				Body:     label.StringValue("message"),
+			}}
+			require.NoError(t, exporter.ExportLogs(ctx, records))
+			require.NoError(t, exporter.ExportLogs(ctx, nil))
+
+			rls := mc.GetResourceLogs()
+			require.Len(t, rls, 1)
+			require.Len(t, rls[0].InstrumentationLibraryLogs, 1)
+			logs := rls[0].InstrumentationLibraryLogs[0].Logs
+			require.Len(t, logs, 1)
+			assert.Equal(t, "message", logs[0].Body.GetStringValue())
+		})
+	}
+}
+
 func TestUnreasonableMaxAttempts(t *testing.T) {
 	// Max attempts is 5, we set collector to fail 7 times and try
 	// to configure max attempts to be either negative or too
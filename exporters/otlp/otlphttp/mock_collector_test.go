@@ -30,8 +30,10 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
+	collectorlogspb "go.opentelemetry.io/otel/exporters/otlp/internal/opentelemetry-proto-gen/collector/logs/v1"
 	collectormetricpb "go.opentelemetry.io/otel/exporters/otlp/internal/opentelemetry-proto-gen/collector/metrics/v1"
 	collectortracepb "go.opentelemetry.io/otel/exporters/otlp/internal/opentelemetry-proto-gen/collector/trace/v1"
+	logspb "go.opentelemetry.io/otel/exporters/otlp/internal/opentelemetry-proto-gen/logs/v1"
 	metricpb "go.opentelemetry.io/otel/exporters/otlp/internal/opentelemetry-proto-gen/metrics/v1"
 	tracepb "go.opentelemetry.io/otel/exporters/otlp/internal/opentelemetry-proto-gen/trace/v1"
 	"go.opentelemetry.io/otel/exporters/otlp/internal/otlptest"
@@ -48,6 +50,9 @@ type mockCollector struct {
 	metricLock     sync.Mutex
 	metricsStorage otlptest.MetricsStorage
 
+	logsLock     sync.Mutex
+	resourceLogs []*logspb.ResourceLogs
+
 	injectHTTPStatus  []int
 	injectContentType string
 
@@ -81,6 +86,12 @@ func (c *mockCollector) GetMetrics() []*metricpb.Metric {
 	return c.metricsStorage.GetMetrics()
 }
 
+func (c *mockCollector) GetResourceLogs() []*logspb.ResourceLogs {
+	c.logsLock.Lock()
+	defer c.logsLock.Unlock()
+	return c.resourceLogs
+}
+
 func (c *mockCollector) Endpoint() string {
 	return c.endpoint
 }
@@ -151,6 +162,37 @@ func (c *mockCollector) serveTraces(w http.ResponseWriter, r *http.Request) {
 	c.spansStorage.AddSpans(&request)
 }
 
+func (c *mockCollector) serveLogs(w http.ResponseWriter, r *http.Request) {
+	if !c.checkHeaders(r) {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+	response := collectorlogspb.ExportLogsServiceResponse{}
+	rawResponse, err := response.Marshal()
+	if err != nil {
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+	if injectedStatus := c.getInjectHTTPStatus(); injectedStatus != 0 {
+		writeReply(w, rawResponse, injectedStatus, c.injectContentType)
+		return
+	}
+	rawRequest, err := readRequest(r)
+	if err != nil {
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+	request := collectorlogspb.ExportLogsServiceRequest{}
+	if err := request.Unmarshal(rawRequest); err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+	writeReply(w, rawResponse, 0, c.injectContentType)
+	c.logsLock.Lock()
+	defer c.logsLock.Unlock()
+	c.resourceLogs = append(c.resourceLogs, request.GetResourceLogs()...)
+}
+
 func (c *mockCollector) checkHeaders(r *http.Request) bool {
 	for k, v := range c.expectedHeaders {
 		got := r.Header.Get(k)
@@ -211,6 +253,7 @@ func writeReply(w http.ResponseWriter, rawResponse []byte, injectHTTPStatus int,
 type mockCollectorConfig struct {
 	MetricsURLPath    string
 	TracesURLPath     string
+	LogsURLPath       string
 	Port              int
 	InjectHTTPStatus  []int
 	InjectContentType string
@@ -225,6 +268,9 @@ func (c *mockCollectorConfig) fillInDefaults() {
 	if c.TracesURLPath == "" {
 		c.TracesURLPath = otlphttp.DefaultTracesPath
 	}
+	if c.LogsURLPath == "" {
+		c.LogsURLPath = otlphttp.DefaultLogsPath
+	}
 }
 
 func runMockCollector(t *testing.T, cfg mockCollectorConfig) *mockCollector {
@@ -244,6 +290,7 @@ func runMockCollector(t *testing.T, cfg mockCollectorConfig) *mockCollector {
 	mux := http.NewServeMux()
 	mux.Handle(cfg.MetricsURLPath, http.HandlerFunc(m.serveMetrics))
 	mux.Handle(cfg.TracesURLPath, http.HandlerFunc(m.serveTraces))
+	mux.Handle(cfg.LogsURLPath, http.HandlerFunc(m.serveLogs))
 	server := &http.Server{
 		Handler: mux,
 	}
@@ -17,6 +17,8 @@ package otlphttp
 import (
 	"crypto/tls"
 	"time"
+
+	"go.opentelemetry.io/otel/exporters/otlp"
 )
 
 // Compression describes the compression used for payloads sent to the
@@ -43,6 +45,9 @@ const (
 	// DefaultMetricsPath is a default URL path for endpoint that
 	// receives metrics.
 	DefaultMetricsPath string = "/v1/metrics"
+	// DefaultLogsPath is a default URL path for endpoint that
+	// receives log records.
+	DefaultLogsPath string = "/v1/logs"
 	// DefaultBackoff is a default base backoff time used in the
 	// exponential backoff strategy.
 	DefaultBackoff time.Duration = 300 * time.Millisecond
@@ -53,11 +58,14 @@ type config struct {
 	compression    Compression
 	tracesURLPath  string
 	metricsURLPath string
+	logsURLPath    string
 	maxAttempts    int
 	backoff        time.Duration
 	tlsCfg         *tls.Config
 	insecure       bool
 	headers        map[string]string
+	credsProvider  otlp.CredentialsProvider
+	hooks          otlp.ConnectionHooks
 }
 
 // Option applies an option to the HTTP driver.
@@ -115,6 +123,18 @@ func WithMetricsURLPath(urlPath string) Option {
 	return (metricsURLPathOption)(urlPath)
 }
 
+type logsURLPathOption string
+
+func (o logsURLPathOption) Apply(cfg *config) {
+	cfg.logsURLPath = (string)(o)
+}
+
+// WithLogsURLPath allows one to override the default URL path used
+// for sending log records. If unset, DefaultLogsPath will be used.
+func WithLogsURLPath(urlPath string) Option {
+	return (logsURLPathOption)(urlPath)
+}
+
 type maxAttemptsOption int
 
 func (o maxAttemptsOption) Apply(cfg *config) {
@@ -178,3 +198,34 @@ func (o headersOption) Apply(cfg *config) {
 func WithHeaders(headers map[string]string) Option {
 	return (headersOption)(headers)
 }
+
+type credentialsProviderOption struct {
+	provider otlp.CredentialsProvider
+}
+
+func (o credentialsProviderOption) Apply(cfg *config) {
+	cfg.credsProvider = o.provider
+}
+
+// WithCredentialsProvider sets a provider of credentials, e.g. OAuth2
+// access tokens, that are sent as HTTP headers with every request,
+// including retries. If the provider requires transport security,
+// sending fails when the driver is configured with WithInsecure.
+func WithCredentialsProvider(provider otlp.CredentialsProvider) Option {
+	return credentialsProviderOption{provider: provider}
+}
+
+type connectionHooksOption struct {
+	hooks otlp.ConnectionHooks
+}
+
+func (o connectionHooksOption) Apply(cfg *config) {
+	cfg.hooks = o.hooks
+}
+
+// WithConnectionHooks sets the functions called when requests reach
+// the collector again, when a request fails to reach it, and when a
+// request is retried after the collector asked to back off.
+func WithConnectionHooks(hooks otlp.ConnectionHooks) Option {
+	return connectionHooksOption{hooks: hooks}
+}
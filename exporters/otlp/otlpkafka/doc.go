@@ -0,0 +1,30 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+/*
+Package otlpkafka implements a protocol driver that publishes traces
+and metrics as binary protobuf OTLP payloads to Kafka topics.
+
+The driver does not depend on any particular Kafka client library.
+Instead, users provide an implementation of the Producer interface
+that wraps the client of their choice. Spans are published one
+message per trace, keyed by the hex encoded trace ID, so that all
+spans belonging to a trace end up in the same partition.
+
+This package is currently in a pre-GA phase. Backwards incompatible
+changes may be introduced in subsequent minor version releases as we
+work to track the evolving OpenTelemetry specification and user
+feedback.
+*/
+package otlpkafka // import "go.opentelemetry.io/otel/exporters/otlp/otlpkafka"
@@ -0,0 +1,205 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package otlpkafka
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"sync"
+
+	"go.opentelemetry.io/otel/exporters/otlp"
+	colmetricspb "go.opentelemetry.io/otel/exporters/otlp/internal/opentelemetry-proto-gen/collector/metrics/v1"
+	coltracepb "go.opentelemetry.io/otel/exporters/otlp/internal/opentelemetry-proto-gen/collector/trace/v1"
+	"go.opentelemetry.io/otel/exporters/otlp/internal/transform"
+	metricsdk "go.opentelemetry.io/otel/sdk/export/metric"
+	tracesdk "go.opentelemetry.io/otel/sdk/export/trace"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// Message is a single record to be published to Kafka.
+type Message struct {
+	// Topic is the Kafka topic the message should be published to.
+	Topic string
+	// Key is the message key used by the Producer to select a
+	// partition. It is nil for messages without a key.
+	Key []byte
+	// Value is a binary protobuf encoded OTLP export request.
+	Value []byte
+}
+
+// Producer publishes messages to Kafka. Implementations usually wrap
+// a synchronous producer of some Kafka client library.
+type Producer interface {
+	// Produce publishes all the passed messages. It should not
+	// return before the messages are acknowledged by the broker
+	// or the context is done. It may be called concurrently.
+	Produce(ctx context.Context, msgs []Message) error
+	// Close flushes any buffered messages and releases the
+	// resources held by the producer. It is called once when the
+	// driver is stopped.
+	Close() error
+}
+
+var errStopped = errors.New("otlpkafka: driver is stopped")
+
+type driver struct {
+	producer Producer
+	cfg      config
+
+	mu      sync.RWMutex
+	stopped bool
+}
+
+var _ otlp.ProtocolDriver = (*driver)(nil)
+
+// NewDriver creates a new Kafka driver publishing messages with the
+// passed producer.
+func NewDriver(producer Producer, opts ...Option) otlp.ProtocolDriver {
+	cfg := config{
+		tracesTopic:  DefaultTracesTopic,
+		metricsTopic: DefaultMetricsTopic,
+		keyByTraceID: true,
+	}
+	for _, opt := range opts {
+		opt.Apply(&cfg)
+	}
+	if strings.TrimSpace(cfg.tracesTopic) == "" {
+		cfg.tracesTopic = DefaultTracesTopic
+	}
+	if strings.TrimSpace(cfg.metricsTopic) == "" {
+		cfg.metricsTopic = DefaultMetricsTopic
+	}
+	return &driver{
+		producer: producer,
+		cfg:      cfg,
+	}
+}
+
+// Start implements otlp.ProtocolDriver.
+func (d *driver) Start(ctx context.Context) error {
+	// nothing to do
+	return nil
+}
+
+// Stop implements otlp.ProtocolDriver. It closes the producer.
+func (d *driver) Stop(ctx context.Context) error {
+	d.mu.Lock()
+	d.stopped = true
+	d.mu.Unlock()
+	return d.producer.Close()
+}
+
+// ExportMetrics implements otlp.ProtocolDriver.
+func (d *driver) ExportMetrics(ctx context.Context, cps metricsdk.CheckpointSet, selector metricsdk.ExportKindSelector) error {
+	rms, err := transform.CheckpointSet(ctx, selector, cps, 1)
+	if err != nil {
+		return err
+	}
+	if len(rms) == 0 {
+		return nil
+	}
+	pbRequest := &colmetricspb.ExportMetricsServiceRequest{
+		ResourceMetrics: rms,
+	}
+	rawRequest, err := pbRequest.Marshal()
+	if err != nil {
+		return err
+	}
+	return d.produce(ctx, []Message{{
+		Topic: d.cfg.metricsTopic,
+		Value: rawRequest,
+	}})
+}
+
+// ExportTraces implements otlp.ProtocolDriver.
+func (d *driver) ExportTraces(ctx context.Context, ss []*tracesdk.SpanSnapshot) error {
+	if !d.cfg.keyByTraceID {
+		msg, ok, err := d.tracesMessage(nil, ss)
+		if err != nil || !ok {
+			return err
+		}
+		return d.produce(ctx, []Message{msg})
+	}
+
+	var msgs []Message
+	for _, group := range groupByTraceID(ss) {
+		key := group[0].SpanContext.TraceID.String()
+		msg, ok, err := d.tracesMessage([]byte(key), group)
+		if err != nil {
+			return err
+		}
+		if ok {
+			msgs = append(msgs, msg)
+		}
+	}
+	if len(msgs) == 0 {
+		return nil
+	}
+	return d.produce(ctx, msgs)
+}
+
+// tracesMessage encodes the span snapshots into a single message. It
+// returns false if there was nothing to encode.
+func (d *driver) tracesMessage(key []byte, ss []*tracesdk.SpanSnapshot) (Message, bool, error) {
+	buf := transform.NewSpanBuffer()
+	defer buf.Release()
+	protoSpans := buf.SpanData(ss)
+	if len(protoSpans) == 0 {
+		return Message{}, false, nil
+	}
+	pbRequest := &coltracepb.ExportTraceServiceRequest{
+		ResourceSpans: protoSpans,
+	}
+	rawRequest, err := pbRequest.Marshal()
+	if err != nil {
+		return Message{}, false, err
+	}
+	return Message{
+		Topic: d.cfg.tracesTopic,
+		Key:   key,
+		Value: rawRequest,
+	}, true, nil
+}
+
+func (d *driver) produce(ctx context.Context, msgs []Message) error {
+	d.mu.RLock()
+	defer d.mu.RUnlock()
+	if d.stopped {
+		return errStopped
+	}
+	return d.producer.Produce(ctx, msgs)
+}
+
+// groupByTraceID splits the span snapshots into groups sharing the
+// same trace ID. Groups are returned in the order their traces were
+// first seen, and spans keep their relative order within a group.
+func groupByTraceID(ss []*tracesdk.SpanSnapshot) [][]*tracesdk.SpanSnapshot {
+	var groups [][]*tracesdk.SpanSnapshot
+	index := make(map[trace.TraceID]int)
+	for _, sd := range ss {
+		if sd == nil {
+			continue
+		}
+		i, ok := index[sd.SpanContext.TraceID]
+		if !ok {
+			i = len(groups)
+			index[sd.SpanContext.TraceID] = i
+			groups = append(groups, nil)
+		}
+		groups[i] = append(groups[i], sd)
+	}
+	return groups
+}
@@ -0,0 +1,169 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package otlpkafka_test
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.opentelemetry.io/otel/exporters/otlp"
+	colmetricspb "go.opentelemetry.io/otel/exporters/otlp/internal/opentelemetry-proto-gen/collector/metrics/v1"
+	coltracepb "go.opentelemetry.io/otel/exporters/otlp/internal/opentelemetry-proto-gen/collector/trace/v1"
+	"go.opentelemetry.io/otel/exporters/otlp/internal/otlptest"
+	"go.opentelemetry.io/otel/exporters/otlp/otlpkafka"
+	metricsdk "go.opentelemetry.io/otel/sdk/export/metric"
+	tracesdk "go.opentelemetry.io/otel/sdk/export/trace"
+	"go.opentelemetry.io/otel/trace"
+)
+
+type mockProducer struct {
+	mu     sync.Mutex
+	msgs   []otlpkafka.Message
+	err    error
+	closed bool
+}
+
+func (p *mockProducer) Produce(ctx context.Context, msgs []otlpkafka.Message) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if p.err != nil {
+		return p.err
+	}
+	p.msgs = append(p.msgs, msgs...)
+	return nil
+}
+
+func (p *mockProducer) Close() error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.closed = true
+	return nil
+}
+
+func (p *mockProducer) messages() []otlpkafka.Message {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return append([]otlpkafka.Message(nil), p.msgs...)
+}
+
+func twoTraceSnapshots() []*tracesdk.SpanSnapshot {
+	first := otlptest.SingleSpanSnapshot()[0]
+	second := *first
+	second.SpanContext.SpanID = trace.SpanID{9, 8, 7, 6, 5, 4, 3, 2}
+	third := *first
+	third.SpanContext.TraceID = trace.TraceID{1}
+	return []*tracesdk.SpanSnapshot{first, &third, &second}
+}
+
+func TestExportTracesKeyedByTraceID(t *testing.T) {
+	producer := &mockProducer{}
+	driver := otlpkafka.NewDriver(producer)
+	ctx := context.Background()
+	require.NoError(t, driver.Start(ctx))
+	defer func() {
+		assert.NoError(t, driver.Stop(ctx))
+	}()
+
+	ss := twoTraceSnapshots()
+	require.NoError(t, driver.ExportTraces(ctx, ss))
+
+	msgs := producer.messages()
+	require.Len(t, msgs, 2)
+	wantSpans := map[string]int{
+		ss[0].SpanContext.TraceID.String(): 2,
+		ss[1].SpanContext.TraceID.String(): 1,
+	}
+	for i, msg := range msgs {
+		assert.Equal(t, otlpkafka.DefaultTracesTopic, msg.Topic)
+		var request coltracepb.ExportTraceServiceRequest
+		require.NoError(t, request.Unmarshal(msg.Value))
+		storage := otlptest.NewSpansStorage()
+		storage.AddSpans(&request)
+		assert.Len(t, storage.GetSpans(), wantSpans[string(msg.Key)], "message %d", i)
+	}
+	assert.Equal(t, ss[0].SpanContext.TraceID.String(), string(msgs[0].Key))
+	assert.Equal(t, ss[1].SpanContext.TraceID.String(), string(msgs[1].Key))
+}
+
+func TestExportTracesWithoutTraceIDKey(t *testing.T) {
+	producer := &mockProducer{}
+	driver := otlpkafka.NewDriver(producer,
+		otlpkafka.WithoutTraceIDKey(),
+		otlpkafka.WithTracesTopic("spans"),
+	)
+	ctx := context.Background()
+	require.NoError(t, driver.ExportTraces(ctx, twoTraceSnapshots()))
+
+	msgs := producer.messages()
+	require.Len(t, msgs, 1)
+	assert.Equal(t, "spans", msgs[0].Topic)
+	assert.Nil(t, msgs[0].Key)
+	var request coltracepb.ExportTraceServiceRequest
+	require.NoError(t, request.Unmarshal(msgs[0].Value))
+	storage := otlptest.NewSpansStorage()
+	storage.AddSpans(&request)
+	assert.Len(t, storage.GetSpans(), 3)
+}
+
+func TestExportMetrics(t *testing.T) {
+	producer := &mockProducer{}
+	driver := otlpkafka.NewDriver(producer, otlpkafka.WithMetricsTopic("metrics"))
+	ctx := context.Background()
+	selector := metricsdk.CumulativeExportKindSelector()
+	require.NoError(t, driver.ExportMetrics(ctx, otlptest.OneRecordCheckpointSet{}, selector))
+
+	msgs := producer.messages()
+	require.Len(t, msgs, 1)
+	assert.Equal(t, "metrics", msgs[0].Topic)
+	assert.Nil(t, msgs[0].Key)
+	var request colmetricspb.ExportMetricsServiceRequest
+	require.NoError(t, request.Unmarshal(msgs[0].Value))
+	storage := otlptest.NewMetricsStorage()
+	storage.AddMetrics(&request)
+	assert.Len(t, storage.GetMetrics(), 1)
+}
+
+func TestEmptyData(t *testing.T) {
+	producer := &mockProducer{}
+	driver := otlpkafka.NewDriver(producer)
+	ctx := context.Background()
+	selector := metricsdk.CumulativeExportKindSelector()
+	assert.NoError(t, driver.ExportTraces(ctx, nil))
+	assert.NoError(t, driver.ExportMetrics(ctx, otlptest.EmptyCheckpointSet{}, selector))
+	assert.Empty(t, producer.messages())
+}
+
+func TestProducerError(t *testing.T) {
+	producer := &mockProducer{err: errors.New("broker unavailable")}
+	exp, err := otlp.NewExporter(context.Background(), otlpkafka.NewDriver(producer))
+	require.NoError(t, err)
+	assert.Error(t, exp.ExportSpans(context.Background(), otlptest.SingleSpanSnapshot()))
+}
+
+func TestStop(t *testing.T) {
+	producer := &mockProducer{}
+	driver := otlpkafka.NewDriver(producer)
+	ctx := context.Background()
+	require.NoError(t, driver.Start(ctx))
+	require.NoError(t, driver.Stop(ctx))
+	assert.True(t, producer.closed)
+	assert.Error(t, driver.ExportTraces(ctx, otlptest.SingleSpanSnapshot()))
+	assert.Empty(t, producer.messages())
+}
@@ -0,0 +1,74 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package otlpkafka
+
+const (
+	// DefaultTracesTopic is the Kafka topic spans are published to
+	// if no other topic is configured. It matches the default
+	// topic of the collector's Kafka receiver.
+	DefaultTracesTopic string = "otlp_spans"
+	// DefaultMetricsTopic is the Kafka topic metrics are published
+	// to if no other topic is configured.
+	DefaultMetricsTopic string = "otlp_metrics"
+)
+
+type config struct {
+	tracesTopic  string
+	metricsTopic string
+	keyByTraceID bool
+}
+
+// Option applies an option to the Kafka driver.
+type Option interface {
+	Apply(*config)
+}
+
+type tracesTopicOption string
+
+func (o tracesTopicOption) Apply(cfg *config) {
+	cfg.tracesTopic = (string)(o)
+}
+
+// WithTracesTopic allows one to override the Kafka topic spans are
+// published to. If unset, DefaultTracesTopic will be used.
+func WithTracesTopic(topic string) Option {
+	return (tracesTopicOption)(topic)
+}
+
+type metricsTopicOption string
+
+func (o metricsTopicOption) Apply(cfg *config) {
+	cfg.metricsTopic = (string)(o)
+}
+
+// WithMetricsTopic allows one to override the Kafka topic metrics
+// are published to. If unset, DefaultMetricsTopic will be used.
+func WithMetricsTopic(topic string) Option {
+	return (metricsTopicOption)(topic)
+}
+
+type withoutTraceIDKeyOption struct{}
+
+func (withoutTraceIDKeyOption) Apply(cfg *config) {
+	cfg.keyByTraceID = false
+}
+
+// WithoutTraceIDKey tells the driver to publish all spans of an
+// export batch in a single message without a key, instead of
+// publishing one message per trace keyed by the trace ID. This
+// leaves the partition assignment entirely to the Producer.
+func WithoutTraceIDKey() Option {
+	return withoutTraceIDKeyOption{}
+}
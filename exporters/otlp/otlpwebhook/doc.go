@@ -0,0 +1,34 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+/*
+Package otlpwebhook implements a protocol driver that posts traces and
+metrics as JSON encoded OTLP payloads to an arbitrary HTTP endpoint.
+
+It is meant for piping telemetry into ingestion services that accept
+webhooks, without running a collector. The URL of every request is
+rendered from a text/template, so a single driver can send traces and
+metrics to different paths, for example:
+
+	driver, err := otlpwebhook.NewDriver("https://ingest.example.com/otel/{{.Signal}}")
+
+Requests are only sent over HTTPS unless the driver is configured with
+WithInsecure.
+
+This package is currently in a pre-GA phase. Backwards incompatible
+changes may be introduced in subsequent minor version releases as we
+work to track the evolving OpenTelemetry specification and user
+feedback.
+*/
+package otlpwebhook // import "go.opentelemetry.io/otel/exporters/otlp/otlpwebhook"
@@ -0,0 +1,233 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package otlpwebhook
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"text/template"
+	"time"
+
+	"github.com/gogo/protobuf/jsonpb"
+	"github.com/gogo/protobuf/proto"
+
+	"go.opentelemetry.io/otel/exporters/otlp"
+	colmetricspb "go.opentelemetry.io/otel/exporters/otlp/internal/opentelemetry-proto-gen/collector/metrics/v1"
+	coltracepb "go.opentelemetry.io/otel/exporters/otlp/internal/opentelemetry-proto-gen/collector/trace/v1"
+	"go.opentelemetry.io/otel/exporters/otlp/internal/transform"
+	metricsdk "go.opentelemetry.io/otel/sdk/export/metric"
+	tracesdk "go.opentelemetry.io/otel/sdk/export/trace"
+)
+
+const (
+	// TracesSignal is the Signal of requests containing spans.
+	TracesSignal string = "traces"
+	// MetricsSignal is the Signal of requests containing metrics.
+	MetricsSignal string = "metrics"
+
+	// DefaultTimeout is the timeout of the HTTP client used when
+	// none is configured with WithHTTPClient.
+	DefaultTimeout = 10 * time.Second
+)
+
+const contentType = "application/json"
+
+// URLData is the data the URL template is executed with.
+type URLData struct {
+	// Signal is the kind of telemetry sent with the request,
+	// either TracesSignal or MetricsSignal.
+	Signal string
+}
+
+var (
+	errStopped = errors.New("otlpwebhook: driver is stopped")
+
+	errInsecureCredentials = errors.New("otlpwebhook: credentials require transport security, but the driver is insecure")
+)
+
+type driver struct {
+	cfg        config
+	tracesURL  string
+	metricsURL string
+	marshaler  jsonpb.Marshaler
+
+	mu      sync.RWMutex
+	stopped bool
+}
+
+var _ otlp.ProtocolDriver = (*driver)(nil)
+
+// NewDriver creates a new webhook driver posting requests to the URL
+// rendered from urlTemplate with URLData. An error is returned if the
+// template is invalid or does not render an absolute https URL, or an
+// http URL if the driver is insecure.
+func NewDriver(urlTemplate string, opts ...Option) (otlp.ProtocolDriver, error) {
+	cfg := config{
+		headers: make(map[string]string),
+	}
+	for _, opt := range opts {
+		opt.Apply(&cfg)
+	}
+	if cfg.client == nil {
+		cfg.client = &http.Client{Timeout: DefaultTimeout}
+	}
+	if cfg.credsProvider != nil && cfg.credsProvider.RequireTransportSecurity() && cfg.insecure {
+		return nil, errInsecureCredentials
+	}
+
+	tmpl, err := template.New("url").Option("missingkey=error").Parse(urlTemplate)
+	if err != nil {
+		return nil, fmt.Errorf("otlpwebhook: invalid URL template: %w", err)
+	}
+	d := &driver{
+		cfg: cfg,
+		marshaler: jsonpb.Marshaler{
+			EnumsAsInts: true,
+		},
+	}
+	if d.tracesURL, err = renderURL(tmpl, TracesSignal, cfg.insecure); err != nil {
+		return nil, err
+	}
+	if d.metricsURL, err = renderURL(tmpl, MetricsSignal, cfg.insecure); err != nil {
+		return nil, err
+	}
+	return d, nil
+}
+
+// renderURL executes the URL template for the signal and validates
+// the result.
+func renderURL(tmpl *template.Template, signal string, insecure bool) (string, error) {
+	var sb strings.Builder
+	if err := tmpl.Execute(&sb, URLData{Signal: signal}); err != nil {
+		return "", fmt.Errorf("otlpwebhook: failed to render URL for %s: %w", signal, err)
+	}
+	u, err := url.Parse(sb.String())
+	if err != nil {
+		return "", fmt.Errorf("otlpwebhook: invalid URL for %s: %w", signal, err)
+	}
+	switch {
+	case u.Host == "":
+		return "", fmt.Errorf("otlpwebhook: URL for %s has no host: %q", signal, u)
+	case u.Scheme == "https":
+	case u.Scheme == "http" && insecure:
+	default:
+		return "", fmt.Errorf("otlpwebhook: unsupported scheme of URL for %s: %q", signal, u.Scheme)
+	}
+	return u.String(), nil
+}
+
+// Start implements otlp.ProtocolDriver.
+func (d *driver) Start(ctx context.Context) error {
+	// nothing to do
+	return nil
+}
+
+// Stop implements otlp.ProtocolDriver. It closes idle connections of
+// the HTTP client.
+func (d *driver) Stop(ctx context.Context) error {
+	d.mu.Lock()
+	d.stopped = true
+	d.mu.Unlock()
+	d.cfg.client.CloseIdleConnections()
+	return nil
+}
+
+// ExportMetrics implements otlp.ProtocolDriver.
+func (d *driver) ExportMetrics(ctx context.Context, cps metricsdk.CheckpointSet, selector metricsdk.ExportKindSelector) error {
+	rms, err := transform.CheckpointSet(ctx, selector, cps, 1)
+	if err != nil {
+		return err
+	}
+	if len(rms) == 0 {
+		return nil
+	}
+	return d.send(ctx, d.metricsURL, &colmetricspb.ExportMetricsServiceRequest{
+		ResourceMetrics: rms,
+	})
+}
+
+// ExportTraces implements otlp.ProtocolDriver.
+func (d *driver) ExportTraces(ctx context.Context, ss []*tracesdk.SpanSnapshot) error {
+	// The messages are marshaled by the time send returns.
+	buf := transform.NewSpanBuffer()
+	defer buf.Release()
+	protoSpans := buf.SpanData(ss)
+	if len(protoSpans) == 0 {
+		return nil
+	}
+	return d.send(ctx, d.tracesURL, &coltracepb.ExportTraceServiceRequest{
+		ResourceSpans: protoSpans,
+	})
+}
+
+func (d *driver) send(ctx context.Context, address string, pbRequest proto.Message) error {
+	d.mu.RLock()
+	stopped := d.stopped
+	d.mu.RUnlock()
+	if stopped {
+		return errStopped
+	}
+
+	var body bytes.Buffer
+	if err := d.marshaler.Marshal(&body, pbRequest); err != nil {
+		return err
+	}
+	request, err := http.NewRequestWithContext(ctx, http.MethodPost, address, &body)
+	if err != nil {
+		return err
+	}
+	for k, v := range d.cfg.headers {
+		request.Header.Set(k, v)
+	}
+	request.Header.Set("Content-Type", contentType)
+	if err := d.addCredentials(ctx, request.Header); err != nil {
+		return err
+	}
+
+	response, err := d.cfg.client.Do(request)
+	if err != nil {
+		return err
+	}
+	// Read the body to allow the connection to be reused.
+	_, _ = io.Copy(ioutil.Discard, response.Body)
+	_ = response.Body.Close()
+	if response.StatusCode < 200 || response.StatusCode >= 300 {
+		return fmt.Errorf("otlpwebhook: request to %s failed with HTTP status %s", address, response.Status)
+	}
+	return nil
+}
+
+func (d *driver) addCredentials(ctx context.Context, header http.Header) error {
+	provider := d.cfg.credsProvider
+	if provider == nil {
+		return nil
+	}
+	creds, err := provider.Credentials(ctx)
+	if err != nil {
+		return err
+	}
+	for key, value := range creds {
+		header.Set(key, value)
+	}
+	return nil
+}
@@ -0,0 +1,176 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package otlpwebhook_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+
+	"github.com/gogo/protobuf/jsonpb"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.opentelemetry.io/otel/exporters/otlp"
+	colmetricspb "go.opentelemetry.io/otel/exporters/otlp/internal/opentelemetry-proto-gen/collector/metrics/v1"
+	coltracepb "go.opentelemetry.io/otel/exporters/otlp/internal/opentelemetry-proto-gen/collector/trace/v1"
+	"go.opentelemetry.io/otel/exporters/otlp/internal/otlptest"
+	"go.opentelemetry.io/otel/exporters/otlp/otlpwebhook"
+	metricsdk "go.opentelemetry.io/otel/sdk/export/metric"
+)
+
+type request struct {
+	path   string
+	header http.Header
+	body   *jsonBody
+}
+
+type jsonBody struct {
+	traces  coltracepb.ExportTraceServiceRequest
+	metrics colmetricspb.ExportMetricsServiceRequest
+}
+
+type mockReceiver struct {
+	mu       sync.Mutex
+	requests []request
+	status   int
+}
+
+func (m *mockReceiver) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	body := &jsonBody{}
+	var err error
+	switch r.URL.Path {
+	case "/otel/traces":
+		err = jsonpb.Unmarshal(r.Body, &body.traces)
+	case "/otel/metrics":
+		err = jsonpb.Unmarshal(r.Body, &body.metrics)
+	}
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.requests = append(m.requests, request{
+		path:   r.URL.Path,
+		header: r.Header,
+		body:   body,
+	})
+	if m.status != 0 {
+		w.WriteHeader(m.status)
+	}
+}
+
+func (m *mockReceiver) received() []request {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.requests
+}
+
+func TestNewDriverInvalidURL(t *testing.T) {
+	for _, tc := range []struct {
+		name        string
+		urlTemplate string
+		opts        []otlpwebhook.Option
+	}{
+		{name: "invalid template", urlTemplate: "https://example.com/{{.Signal"},
+		{name: "unknown field", urlTemplate: "https://example.com/{{.Service}}"},
+		{name: "no host", urlTemplate: "https:///{{.Signal}}"},
+		{name: "relative", urlTemplate: "{{.Signal}}"},
+		{name: "unsupported scheme", urlTemplate: "ftp://example.com/{{.Signal}}"},
+		{name: "http without insecure", urlTemplate: "http://example.com/{{.Signal}}"},
+		{
+			name:        "insecure credentials",
+			urlTemplate: "http://example.com/{{.Signal}}",
+			opts: []otlpwebhook.Option{
+				otlpwebhook.WithInsecure(),
+				otlpwebhook.WithCredentialsProvider(otlp.NewTokenCredentialsProvider(nil)),
+			},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := otlpwebhook.NewDriver(tc.urlTemplate, tc.opts...)
+			assert.Error(t, err)
+		})
+	}
+}
+
+func TestExport(t *testing.T) {
+	receiver := &mockReceiver{}
+	srv := httptest.NewTLSServer(receiver)
+	defer srv.Close()
+
+	driver, err := otlpwebhook.NewDriver(
+		srv.URL+"/otel/{{.Signal}}",
+		otlpwebhook.WithHTTPClient(srv.Client()),
+		otlpwebhook.WithHeaders(map[string]string{"X-Api-Key": "s3cret"}),
+	)
+	require.NoError(t, err)
+	ctx := context.Background()
+	exp, err := otlp.NewExporter(ctx, driver)
+	require.NoError(t, err)
+
+	require.NoError(t, exp.ExportSpans(ctx, otlptest.SingleSpanSnapshot()))
+	require.NoError(t, exp.Export(ctx, otlptest.OneRecordCheckpointSet{}))
+	require.NoError(t, exp.Shutdown(ctx))
+
+	requests := receiver.received()
+	require.Len(t, requests, 2)
+
+	traces := requests[0]
+	assert.Equal(t, "/otel/traces", traces.path)
+	assert.Equal(t, "application/json", traces.header.Get("Content-Type"))
+	assert.Equal(t, "s3cret", traces.header.Get("X-Api-Key"))
+	require.Len(t, traces.body.traces.ResourceSpans, 1)
+	require.Len(t, traces.body.traces.ResourceSpans[0].InstrumentationLibrarySpans, 1)
+	spans := traces.body.traces.ResourceSpans[0].InstrumentationLibrarySpans[0].Spans
+	require.Len(t, spans, 1)
+	assert.Equal(t, otlptest.SingleSpanSnapshot()[0].Name, spans[0].Name)
+
+	metrics := requests[1]
+	assert.Equal(t, "/otel/metrics", metrics.path)
+	assert.Equal(t, "s3cret", metrics.header.Get("X-Api-Key"))
+	assert.Len(t, metrics.body.metrics.ResourceMetrics, 1)
+
+	assert.Error(t, exp.ExportSpans(ctx, otlptest.SingleSpanSnapshot()), "export after shutdown")
+}
+
+func TestEmptyData(t *testing.T) {
+	receiver := &mockReceiver{}
+	srv := httptest.NewTLSServer(receiver)
+	defer srv.Close()
+
+	driver, err := otlpwebhook.NewDriver(srv.URL+"/otel/{{.Signal}}", otlpwebhook.WithHTTPClient(srv.Client()))
+	require.NoError(t, err)
+	ctx := context.Background()
+	assert.NoError(t, driver.ExportTraces(ctx, nil))
+	selector := metricsdk.CumulativeExportKindSelector()
+	assert.NoError(t, driver.ExportMetrics(ctx, otlptest.EmptyCheckpointSet{}, selector))
+	assert.Empty(t, receiver.received())
+}
+
+func TestHTTPStatusError(t *testing.T) {
+	receiver := &mockReceiver{status: http.StatusInternalServerError}
+	srv := httptest.NewServer(receiver)
+	defer srv.Close()
+
+	driver, err := otlpwebhook.NewDriver(srv.URL+"/otel/{{.Signal}}", otlpwebhook.WithInsecure())
+	require.NoError(t, err)
+	assert.Error(t, driver.ExportTraces(context.Background(), otlptest.SingleSpanSnapshot()))
+	assert.Len(t, receiver.received(), 1)
+}
@@ -0,0 +1,89 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package otlpwebhook
+
+import (
+	"net/http"
+
+	"go.opentelemetry.io/otel/exporters/otlp"
+)
+
+type config struct {
+	headers       map[string]string
+	client        *http.Client
+	insecure      bool
+	credsProvider otlp.CredentialsProvider
+}
+
+// Option applies an option to the webhook driver.
+type Option interface {
+	Apply(*config)
+}
+
+type headersOption map[string]string
+
+func (o headersOption) Apply(cfg *config) {
+	for k, v := range o {
+		cfg.headers[k] = v
+	}
+}
+
+// WithHeaders allows one to add custom HTTP headers to every request,
+// e.g. a static API key expected by the ingestion service.
+func WithHeaders(headers map[string]string) Option {
+	return (headersOption)(headers)
+}
+
+type clientOption struct {
+	client *http.Client
+}
+
+func (o clientOption) Apply(cfg *config) {
+	cfg.client = o.client
+}
+
+// WithHTTPClient sets the HTTP client used to send requests. If unset,
+// a client with a timeout of DefaultTimeout is used.
+func WithHTTPClient(client *http.Client) Option {
+	return clientOption{client: client}
+}
+
+type insecureOption struct{}
+
+func (insecureOption) Apply(cfg *config) {
+	cfg.insecure = true
+}
+
+// WithInsecure allows the URL to use the plain http scheme. Without
+// this option only https URLs are accepted.
+func WithInsecure() Option {
+	return insecureOption{}
+}
+
+type credentialsProviderOption struct {
+	provider otlp.CredentialsProvider
+}
+
+func (o credentialsProviderOption) Apply(cfg *config) {
+	cfg.credsProvider = o.provider
+}
+
+// WithCredentialsProvider sets a provider of credentials, e.g. OAuth2
+// access tokens, that are sent as HTTP headers with every request.
+// If the provider requires transport security, it can't be used
+// together with WithInsecure.
+func WithCredentialsProvider(provider otlp.CredentialsProvider) Option {
+	return credentialsProviderOption{provider: provider}
+}
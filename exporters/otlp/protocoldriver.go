@@ -18,6 +18,7 @@ import (
 	"context"
 	"sync"
 
+	logsdk "go.opentelemetry.io/otel/sdk/export/log"
 	metricsdk "go.opentelemetry.io/otel/sdk/export/metric"
 	tracesdk "go.opentelemetry.io/otel/sdk/export/trace"
 )
@@ -51,6 +52,17 @@ type ProtocolDriver interface {
 	ExportTraces(ctx context.Context, ss []*tracesdk.SpanSnapshot) error
 }
 
+// LogsProtocolDriver is an optional interface of a ProtocolDriver that
+// is able to send log records to the collector.
+type LogsProtocolDriver interface {
+	// ExportLogs should transform the passed log records to the
+	// wire format and send them to the collector. May be called
+	// concurrently with ExportMetrics and ExportTraces, so the
+	// manager needs to take this into account by doing proper
+	// locking.
+	ExportLogs(ctx context.Context, records []*logsdk.Record) error
+}
+
 // SplitConfig is used to configure a split driver.
 type SplitConfig struct {
 	// ForMetrics driver will be used for sending metrics to the
@@ -67,6 +79,8 @@ type splitDriver struct {
 }
 
 var _ ProtocolDriver = (*splitDriver)(nil)
+var _ ReadinessChecker = (*splitDriver)(nil)
+var _ LogsProtocolDriver = (*splitDriver)(nil)
 
 // NewSplitDriver creates a protocol driver which contains two other
 // protocol drivers and will forward traces to one of them and metrics
@@ -143,3 +157,27 @@ func (d *splitDriver) ExportMetrics(ctx context.Context, cps metricsdk.Checkpoin
 func (d *splitDriver) ExportTraces(ctx context.Context, ss []*tracesdk.SpanSnapshot) error {
 	return d.trace.ExportTraces(ctx, ss)
 }
+
+// ExportLogs implements LogsProtocolDriver. It forwards the call to
+// the driver used for sending spans, log records being correlated
+// with them.
+func (d *splitDriver) ExportLogs(ctx context.Context, records []*logsdk.Record) error {
+	ld, ok := d.trace.(LogsProtocolDriver)
+	if !ok {
+		return ErrLogsUnsupported
+	}
+	return ld.ExportLogs(ctx, records)
+}
+
+// Ready implements ReadinessChecker. It is ready when both drivers
+// are ready.
+func (d *splitDriver) Ready(ctx context.Context) error {
+	for _, driver := range []ProtocolDriver{d.metric, d.trace} {
+		if rc, ok := driver.(ReadinessChecker); ok {
+			if err := rc.Ready(ctx); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,70 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package otlp // import "go.opentelemetry.io/otel/exporters/otlp"
+
+import (
+	"go.opentelemetry.io/otel/label"
+	metricsdk "go.opentelemetry.io/otel/sdk/export/metric"
+)
+
+// resourceLabelsCheckpointSet is a CheckpointSet adding the resource
+// attributes with the keys to the labels of the records of the
+// CheckpointSet.
+type resourceLabelsCheckpointSet struct {
+	metricsdk.CheckpointSet
+	keys []label.Key
+}
+
+func (c resourceLabelsCheckpointSet) ForEach(selector metricsdk.ExportKindSelector, f func(metricsdk.Record) error) error {
+	return c.CheckpointSet.ForEach(selector, func(r metricsdk.Record) error {
+		return f(c.record(r))
+	})
+}
+
+// record returns r with the labels added, or r if it has no label to add.
+// The resource and the labels are iterated, the lookups of label.Set do
+// not support the empty sets of records without resource or labels.
+func (c resourceLabelsCheckpointSet) record(r metricsdk.Record) metricsdk.Record {
+	var added []label.KeyValue
+	for iter := r.Resource().Iter(); iter.Next(); {
+		kv := iter.Label()
+		if c.selected(kv.Key) && !hasKey(r.Labels(), kv.Key) {
+			added = append(added, kv)
+		}
+	}
+	if len(added) == 0 {
+		return r
+	}
+	set := label.NewSet(append(r.Labels().ToSlice(), added...)...)
+	return metricsdk.NewRecord(r.Descriptor(), &set, r.Resource(), r.Aggregation(), r.StartTime(), r.EndTime())
+}
+
+func (c resourceLabelsCheckpointSet) selected(k label.Key) bool {
+	for _, key := range c.keys {
+		if key == k {
+			return true
+		}
+	}
+	return false
+}
+
+func hasKey(labels *label.Set, k label.Key) bool {
+	for iter := labels.Iter(); iter.Next(); {
+		if iter.Label().Key == k {
+			return true
+		}
+	}
+	return false
+}
@@ -25,9 +25,25 @@ var (
 	defaultWriter              = os.Stdout
 	defaultPrettyPrint         = false
 	defaultTimestamps          = true
+	defaultIDs                 = true
 	defaultLabelEncoder        = label.DefaultEncoder()
 	defaultDisableTraceExport  = false
 	defaultDisableMetricExport = false
+	defaultDisableLogExport    = false
+	defaultLogFormat           = JSONLogFormat
+)
+
+// LogFormat is the format log records are written in.
+type LogFormat int
+
+const (
+	// JSONLogFormat writes the log records of every export as a JSON
+	// array.
+	JSONLogFormat LogFormat = iota
+	// TextLogFormat writes every log record on a human-readable line
+	// made of its timestamp, severity, body and sorted attributes, the
+	// format of local development logs.
+	TextLogFormat
 )
 
 // Config contains options for the STDOUT exporter.
@@ -40,9 +56,15 @@ type Config struct {
 	PrettyPrint bool
 
 	// Timestamps specifies if timestamps should be pritted. Default is
-	// true.
+	// true. The timestamps of spans, span events and log records are
+	// zeroed when it is false.
 	Timestamps bool
 
+	// IDs specifies if trace and span IDs should be printed. Default is
+	// true. The IDs of spans, span links and log records are zeroed when
+	// it is false.
+	IDs bool
+
 	// LabelEncoder encodes the labels.
 	LabelEncoder label.Encoder
 
@@ -51,6 +73,14 @@ type Config struct {
 
 	// DisableMetricExport prevents any export of metric telemetry.
 	DisableMetricExport bool
+
+	// DisableLogExport prevents any export of log telemetry.
+	DisableLogExport bool
+
+	// LogFormat is the format log records are written in. Default is
+	// JSONLogFormat. With PrettyPrint, the text format writes every
+	// attribute of a record on its own indented line.
+	LogFormat LogFormat
 }
 
 // NewConfig creates a validated Config configured with options.
@@ -59,9 +89,12 @@ func NewConfig(options ...Option) (Config, error) {
 		Writer:              defaultWriter,
 		PrettyPrint:         defaultPrettyPrint,
 		Timestamps:          defaultTimestamps,
+		IDs:                 defaultIDs,
 		LabelEncoder:        defaultLabelEncoder,
 		DisableTraceExport:  defaultDisableTraceExport,
 		DisableMetricExport: defaultDisableMetricExport,
+		DisableLogExport:    defaultDisableLogExport,
+		LogFormat:           defaultLogFormat,
 	}
 	for _, opt := range options {
 		opt.Apply(&config)
@@ -111,6 +144,19 @@ func (o timestampsOption) Apply(config *Config) {
 	config.Timestamps = bool(o)
 }
 
+// WithoutIDs sets the export stream to not include trace and span IDs.
+// Together with WithoutTimestamps, it makes the output of the exporter
+// reproducible so it can be compared to golden files in tests.
+func WithoutIDs() Option {
+	return idsOption(false)
+}
+
+type idsOption bool
+
+func (o idsOption) Apply(config *Config) {
+	config.IDs = bool(o)
+}
+
 // WithLabelEncoder sets the label encoder used in export.
 func WithLabelEncoder(enc label.Encoder) Option {
 	return labelEncoderOption{enc}
@@ -145,3 +191,25 @@ type disableMetricExportOption bool
 func (o disableMetricExportOption) Apply(config *Config) {
 	config.DisableMetricExport = bool(o)
 }
+
+// WithoutLogExport disables all log exporting.
+func WithoutLogExport() Option {
+	return disableLogExportOption(true)
+}
+
+type disableLogExportOption bool
+
+func (o disableLogExportOption) Apply(config *Config) {
+	config.DisableLogExport = bool(o)
+}
+
+// WithLogFormat sets the format log records are written in.
+func WithLogFormat(format LogFormat) Option {
+	return logFormatOption(format)
+}
+
+type logFormatOption LogFormat
+
+func (o logFormatOption) Apply(config *Config) {
+	config.LogFormat = LogFormat(o)
+}
@@ -18,6 +18,7 @@ import (
 	"context"
 
 	"go.opentelemetry.io/otel"
+	exportlog "go.opentelemetry.io/otel/sdk/export/log"
 	"go.opentelemetry.io/otel/sdk/export/metric"
 	exporttrace "go.opentelemetry.io/otel/sdk/export/trace"
 	controller "go.opentelemetry.io/otel/sdk/metric/controller/basic"
@@ -35,6 +36,7 @@ type Exporter struct {
 var (
 	_ metric.Exporter          = &Exporter{}
 	_ exporttrace.SpanExporter = &Exporter{}
+	_ exportlog.Exporter       = &Exporter{}
 )
 
 // NewExporter creates an Exporter with the passed options.
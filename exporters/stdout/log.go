@@ -0,0 +1,138 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package stdout // import "go.opentelemetry.io/otel/exporters/stdout"
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"go.opentelemetry.io/otel/label"
+	"go.opentelemetry.io/otel/sdk/export/log"
+)
+
+// ExportLogs writes log Records in the configured format, json by
+// default, to stdout. Nothing is written once the Exporter is shut down.
+func (e *Exporter) ExportLogs(ctx context.Context, records []*log.Record) error {
+	e.stoppedMu.RLock()
+	stopped := e.stopped
+	e.stoppedMu.RUnlock()
+	if stopped {
+		return nil
+	}
+
+	if e.traceExporter.config.DisableLogExport || len(records) == 0 {
+		return nil
+	}
+	config := e.traceExporter.config
+	if config.LogFormat == TextLogFormat {
+		var b bytes.Buffer
+		for _, r := range e.normalizeLogs(records) {
+			writeText(&b, r, config.PrettyPrint)
+		}
+		_, err := config.Writer.Write(b.Bytes())
+		return err
+	}
+	out, err := e.traceExporter.marshal(e.normalizeLogs(records))
+	if err != nil {
+		return err
+	}
+	_, err = fmt.Fprintln(config.Writer, string(out))
+	return err
+}
+
+// writeText writes r to b on a line made of its timestamp, severity and
+// body, followed by its sorted attributes and its span context as
+// key=value pairs. They are written on their own indented lines instead
+// if pretty is true.
+func writeText(b *bytes.Buffer, r *log.Record, pretty bool) {
+	var head []string
+	if !r.Timestamp.IsZero() {
+		head = append(head, r.Timestamp.UTC().Format(time.RFC3339Nano))
+	}
+	if r.SeverityText != "" {
+		head = append(head, r.SeverityText)
+	} else if r.Severity != 0 {
+		head = append(head, r.Severity.String())
+	}
+	switch r.Body.Type() {
+	case label.INVALID:
+	case label.STRING:
+		head = append(head, r.Body.AsString())
+	default:
+		head = append(head, r.Body.Emit())
+	}
+
+	var fields []string
+	for _, kv := range r.Attributes {
+		fields = append(fields, string(kv.Key)+"="+textValue(kv.Value))
+	}
+	if r.DroppedAttributeCount > 0 {
+		fields = append(fields, "dropped_attributes="+strconv.Itoa(r.DroppedAttributeCount))
+	}
+	if r.SpanContext.IsValid() {
+		fields = append(fields,
+			"trace_id="+r.SpanContext.TraceID.String(),
+			"span_id="+r.SpanContext.SpanID.String(),
+		)
+	}
+
+	b.WriteString(strings.Join(head, " "))
+	sep := " "
+	if pretty {
+		sep = "\n\t"
+	}
+	for _, f := range fields {
+		b.WriteString(sep)
+		b.WriteString(f)
+	}
+	b.WriteByte('\n')
+}
+
+// textValue formats v for the text format, quoting the strings that are
+// empty or would be ambiguous unquoted.
+func textValue(v label.Value) string {
+	if v.Type() != label.STRING {
+		return v.Emit()
+	}
+	s := v.AsString()
+	if q := strconv.Quote(s); s == "" || strings.ContainsAny(s, " =") || q[1:len(q)-1] != s {
+		return q
+	}
+	return s
+}
+
+// normalizeLogs returns copies of records whose attributes are sorted by
+// key, and whose timestamps and IDs are zeroed if they are not to be
+// printed.
+func (e *Exporter) normalizeLogs(records []*log.Record) []*log.Record {
+	config := e.traceExporter.config
+	out := make([]*log.Record, len(records))
+	for i, r := range records {
+		c := *r
+		c.Attributes = sortedKeyValues(r.Attributes)
+		if !config.Timestamps {
+			c.Timestamp, c.ObservedTimestamp = time.Time{}, time.Time{}
+		}
+		if !config.IDs {
+			c.SpanContext = withoutIDs(c.SpanContext)
+		}
+		out[i] = &c
+	}
+	return out
+}
@@ -0,0 +1,190 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package stdout_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.opentelemetry.io/otel/exporters/stdout"
+	"go.opentelemetry.io/otel/label"
+	otellog "go.opentelemetry.io/otel/log"
+	export "go.opentelemetry.io/otel/sdk/export/log"
+	"go.opentelemetry.io/otel/sdk/instrumentation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	"go.opentelemetry.io/otel/trace"
+)
+
+func TestExporter_ExportLogs(t *testing.T) {
+	var b bytes.Buffer
+	ex, err := stdout.NewExporter(stdout.WithWriter(&b))
+	require.NoError(t, err)
+
+	now := time.Now()
+	traceID, _ := trace.TraceIDFromHex("0102030405060708090a0b0c0d0e0f10")
+	spanID, _ := trace.SpanIDFromHex("0102030405060708")
+	record := &export.Record{
+		Timestamp:         now,
+		ObservedTimestamp: now,
+		Severity:          otellog.SeverityError,
+		SeverityText:      "ERROR",
+		Body:              label.StringValue("message"),
+		Attributes:        []label.KeyValue{label.Int64("k", 1)},
+		SpanContext: trace.SpanContext{
+			TraceID:    traceID,
+			SpanID:     spanID,
+			TraceFlags: trace.FlagsSampled,
+		},
+		Resource:               resource.NewWithAttributes(label.String("rk1", "rv11")),
+		InstrumentationLibrary: instrumentation.Library{Name: "test"},
+	}
+	require.NoError(t, ex.ExportLogs(context.Background(), []*export.Record{record}))
+
+	serializedNow, _ := json.Marshal(now)
+	expected := `[{` +
+		`"Timestamp":` + string(serializedNow) + `,` +
+		`"ObservedTimestamp":` + string(serializedNow) + `,` +
+		`"Severity":17,` +
+		`"SeverityText":"ERROR",` +
+		`"Body":{"Type":"STRING","Value":"message"},` +
+		`"Attributes":[{"Key":"k","Value":{"Type":"INT64","Value":1}}],` +
+		`"DroppedAttributeCount":0,` +
+		`"SpanContext":{` +
+		`"TraceID":"0102030405060708090a0b0c0d0e0f10",` +
+		`"SpanID":"0102030405060708",` +
+		`"TraceFlags":1,` +
+		`"TraceState":null},` +
+		`"Resource":[{"Key":"rk1","Value":{"Type":"STRING","Value":"rv11"}}],` +
+		`"InstrumentationLibrary":{"Name":"test","Version":"","SchemaURL":""}` +
+		"}]\n"
+	assert.Equal(t, expected, b.String())
+}
+
+func TestExporter_ExportLogsGolden(t *testing.T) {
+	var b bytes.Buffer
+	ex, err := stdout.NewExporter(stdout.WithWriter(&b), stdout.WithoutTimestamps(), stdout.WithoutIDs())
+	require.NoError(t, err)
+
+	record := &export.Record{
+		Timestamp:         time.Now(),
+		ObservedTimestamp: time.Now(),
+		Body:              label.StringValue("message"),
+		Attributes:        []label.KeyValue{label.Int64("k2", 2), label.Int64("k1", 1)},
+		SpanContext: trace.SpanContext{
+			TraceID:    trace.TraceID{1},
+			SpanID:     trace.SpanID{1},
+			TraceFlags: trace.FlagsSampled,
+		},
+	}
+	require.NoError(t, ex.ExportLogs(context.Background(), []*export.Record{record}))
+
+	expected := `[{` +
+		`"Timestamp":"0001-01-01T00:00:00Z",` +
+		`"ObservedTimestamp":"0001-01-01T00:00:00Z",` +
+		`"Severity":0,` +
+		`"SeverityText":"",` +
+		`"Body":{"Type":"STRING","Value":"message"},` +
+		`"Attributes":[{"Key":"k1","Value":{"Type":"INT64","Value":1}},{"Key":"k2","Value":{"Type":"INT64","Value":2}}],` +
+		`"DroppedAttributeCount":0,` +
+		`"SpanContext":{` +
+		`"TraceID":"00000000000000000000000000000000",` +
+		`"SpanID":"0000000000000000",` +
+		`"TraceFlags":1,` +
+		`"TraceState":null},` +
+		`"Resource":null,` +
+		`"InstrumentationLibrary":{"Name":"","Version":"","SchemaURL":""}` +
+		"}]\n"
+	assert.Equal(t, expected, b.String())
+	assert.Equal(t, label.Int64("k2", 2), record.Attributes[0], "exported record modified")
+}
+
+func TestExporter_ExportLogsDisabled(t *testing.T) {
+	var b bytes.Buffer
+	ex, err := stdout.NewExporter(stdout.WithWriter(&b), stdout.WithoutLogExport())
+	require.NoError(t, err)
+
+	record := &export.Record{Body: label.StringValue("message")}
+	require.NoError(t, ex.ExportLogs(context.Background(), []*export.Record{record}))
+	assert.Empty(t, b.String())
+}
+
+func TestExporter_ExportLogsAfterShutdown(t *testing.T) {
+	var b bytes.Buffer
+	ex, err := stdout.NewExporter(stdout.WithWriter(&b))
+	require.NoError(t, err)
+
+	require.NoError(t, ex.Shutdown(context.Background()))
+	record := &export.Record{Body: label.StringValue("message")}
+	require.NoError(t, ex.ExportLogs(context.Background(), []*export.Record{record}))
+	assert.Empty(t, b.String())
+}
+
+func TestExporter_ExportLogsText(t *testing.T) {
+	ts := time.Date(2021, 1, 2, 3, 4, 5, 6, time.UTC)
+	records := []*export.Record{
+		{
+			Timestamp:    ts,
+			Severity:     otellog.SeverityError,
+			SeverityText: "error",
+			Body:         label.StringValue("request failed"),
+			Attributes: []label.KeyValue{
+				label.String("path", "/users"),
+				label.Int64("code", 500),
+				label.String("client", "curl 7.0"),
+			},
+			DroppedAttributeCount: 2,
+			SpanContext: trace.SpanContext{
+				TraceID:    trace.TraceID{1},
+				SpanID:     trace.SpanID{2},
+				TraceFlags: trace.FlagsSampled,
+			},
+		},
+		{
+			Timestamp: ts,
+			Severity:  otellog.SeverityInfo,
+			Body:      label.Int64Value(42),
+		},
+	}
+
+	var b bytes.Buffer
+	ex, err := stdout.NewExporter(stdout.WithWriter(&b), stdout.WithLogFormat(stdout.TextLogFormat))
+	require.NoError(t, err)
+	require.NoError(t, ex.ExportLogs(context.Background(), records))
+	assert.Equal(t, `2021-01-02T03:04:05.000000006Z error request failed client="curl 7.0" code=500 path=/users dropped_attributes=2 trace_id=01000000000000000000000000000000 span_id=0200000000000000
+2021-01-02T03:04:05.000000006Z INFO 42
+`, b.String())
+
+	b.Reset()
+	ex, err = stdout.NewExporter(
+		stdout.WithWriter(&b),
+		stdout.WithLogFormat(stdout.TextLogFormat),
+		stdout.WithPrettyPrint(),
+		stdout.WithoutTimestamps(),
+		stdout.WithoutIDs(),
+	)
+	require.NoError(t, err)
+	require.NoError(t, ex.ExportLogs(context.Background(), records[:1]))
+	assert.Equal(t, "error request failed\n"+
+		"\tclient=\"curl 7.0\"\n"+
+		"\tcode=500\n"+
+		"\tpath=/users\n"+
+		"\tdropped_attributes=2\n", b.String())
+}
@@ -18,6 +18,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"sort"
 	"strings"
 	"time"
 
@@ -143,6 +144,11 @@ func (e *metricExporter) Export(_ context.Context, checkpointSet exportmetric.Ch
 	if len(batch) == 0 {
 		return aggError
 	}
+	// The checkpoint set is not ordered, sort its records so the output
+	// is deterministic.
+	sort.SliceStable(batch, func(i, j int) bool {
+		return batch[i].Name < batch[j].Name
+	})
 
 	data, err := e.marshal(batch)
 	if err != nil {
@@ -142,6 +142,23 @@ func TestStdoutCounterFormat(t *testing.T) {
 	require.Equal(t, `[{"Name":"test.name{R=V,A=B,C=D}","Sum":123}]`, fix.Output())
 }
 
+func TestStdoutSortedRecords(t *testing.T) {
+	fix := newFixture(t)
+
+	checkpointSet := metrictest.NewCheckpointSet(testResource)
+	for _, name := range []string{"c", "a", "b"} {
+		desc := metric.NewDescriptor(name, metric.CounterInstrumentKind, number.Int64Kind)
+		cagg, ckpt := metrictest.Unslice2(sum.New(2))
+		aggregatortest.CheckedUpdate(fix.t, cagg, number.NewInt64Number(1), &desc)
+		require.NoError(t, cagg.SynchronizedMove(ckpt, &desc))
+		checkpointSet.Add(&desc, ckpt)
+	}
+
+	fix.Export(checkpointSet)
+
+	require.Equal(t, `[{"Name":"a{R=V}","Sum":1},{"Name":"b{R=V}","Sum":1},{"Name":"c{R=V}","Sum":1}]`, fix.Output())
+}
+
 func TestStdoutLastValueFormat(t *testing.T) {
 	fix := newFixture(t)
 
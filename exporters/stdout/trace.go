@@ -18,9 +18,13 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"sort"
 	"sync"
+	"time"
 
+	"go.opentelemetry.io/otel/label"
 	"go.opentelemetry.io/otel/sdk/export/trace"
+	apitrace "go.opentelemetry.io/otel/trace"
 )
 
 // Exporter is an implementation of trace.SpanSyncer that writes spans to stdout.
@@ -43,7 +47,7 @@ func (e *traceExporter) ExportSpans(ctx context.Context, ss []*trace.SpanSnapsho
 	if e.config.DisableTraceExport || len(ss) == 0 {
 		return nil
 	}
-	out, err := e.marshal(ss)
+	out, err := e.marshal(e.normalize(ss))
 	if err != nil {
 		return err
 	}
@@ -65,6 +69,67 @@ func (e *traceExporter) Shutdown(ctx context.Context) error {
 	return nil
 }
 
+// normalize returns copies of ss whose attributes are sorted by key, and
+// whose timestamps and IDs are zeroed if they are not to be printed.
+func (e *traceExporter) normalize(ss []*trace.SpanSnapshot) []*trace.SpanSnapshot {
+	out := make([]*trace.SpanSnapshot, len(ss))
+	for i, s := range ss {
+		c := *s
+		c.Attributes = sortedKeyValues(s.Attributes)
+		if !e.config.Timestamps {
+			c.StartTime, c.EndTime = time.Time{}, time.Time{}
+		}
+		if !e.config.IDs {
+			c.SpanContext = withoutIDs(c.SpanContext)
+			c.ParentSpanID = apitrace.SpanID{}
+		}
+
+		if s.MessageEvents != nil {
+			c.MessageEvents = make([]apitrace.Event, len(s.MessageEvents))
+			for j, ev := range s.MessageEvents {
+				ev.Attributes = sortedKeyValues(ev.Attributes)
+				if !e.config.Timestamps {
+					ev.Time = time.Time{}
+				}
+				c.MessageEvents[j] = ev
+			}
+		}
+		if s.Links != nil {
+			c.Links = make([]apitrace.Link, len(s.Links))
+			for j, l := range s.Links {
+				l.Attributes = sortedKeyValues(l.Attributes)
+				if !e.config.IDs {
+					l.SpanContext = withoutIDs(l.SpanContext)
+				}
+				c.Links[j] = l
+			}
+		}
+		out[i] = &c
+	}
+	return out
+}
+
+// withoutIDs returns sc with zero trace and span IDs.
+func withoutIDs(sc apitrace.SpanContext) apitrace.SpanContext {
+	sc.TraceID = apitrace.TraceID{}
+	sc.SpanID = apitrace.SpanID{}
+	return sc
+}
+
+// sortedKeyValues returns a copy of kvs sorted by key. Attributes with the
+// same key keep their relative order.
+func sortedKeyValues(kvs []label.KeyValue) []label.KeyValue {
+	if kvs == nil {
+		return nil
+	}
+	sorted := make([]label.KeyValue, len(kvs))
+	copy(sorted, kvs)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return sorted[i].Key < sorted[j].Key
+	})
+	return sorted
+}
+
 // marshal v with approriate indentation.
 func (e *traceExporter) marshal(v interface{}) ([]byte, error) {
 	if e.config.PrettyPrint {
@@ -22,6 +22,9 @@ import (
 	"testing"
 	"time"
 
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
 	"go.opentelemetry.io/otel/codes"
 	"go.opentelemetry.io/otel/exporters/stdout"
 	"go.opentelemetry.io/otel/label"
@@ -91,12 +94,12 @@ func TestExporter_ExportSpan(t *testing.T) {
 		`"EndTime":` + string(expectedSerializedNow) + "," +
 		`"Attributes":[` +
 		`{` +
-		`"Key":"key",` +
-		`"Value":{"Type":"STRING","Value":"value"}` +
-		`},` +
-		`{` +
 		`"Key":"double",` +
 		`"Value":{"Type":"FLOAT64","Value":123.456}` +
+		`},` +
+		`{` +
+		`"Key":"key",` +
+		`"Value":{"Type":"STRING","Value":"value"}` +
 		`}],` +
 		`"MessageEvents":[` +
 		`{` +
@@ -135,7 +138,8 @@ func TestExporter_ExportSpan(t *testing.T) {
 		`}],` +
 		`"InstrumentationLibrary":{` +
 		`"Name":"",` +
-		`"Version":""` +
+		`"Version":"",` +
+		`"SchemaURL":""` +
 		`}}]` + "\n"
 
 	if got != expectedOutput {
@@ -143,6 +147,51 @@ func TestExporter_ExportSpan(t *testing.T) {
 	}
 }
 
+func TestExporter_ExportSpanGolden(t *testing.T) {
+	output := func(seed byte) string {
+		var b bytes.Buffer
+		ex, err := stdout.NewExporter(
+			stdout.WithWriter(&b),
+			stdout.WithoutTimestamps(),
+			stdout.WithoutIDs(),
+		)
+		require.NoError(t, err)
+
+		now := time.Now()
+		attrs := []label.KeyValue{label.Int("b", 2), label.Int("a", 1)}
+		if seed%2 == 0 {
+			// Set the same attributes in a different order.
+			attrs[0], attrs[1] = attrs[1], attrs[0]
+		}
+		span := &export.SpanSnapshot{
+			SpanContext: trace.SpanContext{
+				TraceID:    trace.TraceID{seed},
+				SpanID:     trace.SpanID{seed},
+				TraceFlags: trace.FlagsSampled,
+			},
+			ParentSpanID:  trace.SpanID{seed, 1},
+			Name:          "/foo",
+			StartTime:     now,
+			EndTime:       now.Add(time.Duration(seed)),
+			Attributes:    attrs,
+			MessageEvents: []trace.Event{{Name: "foo", Time: now}},
+			Links: []trace.Link{{
+				SpanContext: trace.SpanContext{TraceID: trace.TraceID{seed}, SpanID: trace.SpanID{seed, 2}},
+			}},
+		}
+		require.NoError(t, ex.ExportSpans(context.Background(), []*export.SpanSnapshot{span}))
+		return b.String()
+	}
+
+	got := output(1)
+	assert.Equal(t, got, output(2))
+	assert.Contains(t, got, `"TraceID":"00000000000000000000000000000000","SpanID":"0000000000000000","TraceFlags":1`)
+	assert.Contains(t, got, `"ParentSpanID":"0000000000000000"`)
+	assert.Contains(t, got, `"StartTime":"0001-01-01T00:00:00Z"`)
+	assert.Contains(t, got, `"Attributes":[{"Key":"a","Value":{"Type":"INT64","Value":1}},{"Key":"b","Value":{"Type":"INT64","Value":2}}]`)
+	assert.NotContains(t, got, `"Time":"2`)
+}
+
 func TestExporterShutdownHonorsTimeout(t *testing.T) {
 	ctx, cancel := context.WithTimeout(context.Background(), 1*time.Minute)
 	defer cancel()
@@ -15,8 +15,8 @@
 package jaeger // import "go.opentelemetry.io/otel/exporters/trace/jaeger"
 
 import (
+	"bytes"
 	"context"
-	"encoding/binary"
 	"fmt"
 	"sync"
 
@@ -36,6 +36,10 @@ const (
 
 	keyInstrumentationLibraryName    = "otel.instrumentation_library.name"
 	keyInstrumentationLibraryVersion = "otel.instrumentation_library.version"
+
+	// Jaeger span flags. The 0x08 firehose flag is never set.
+	flagsSampled = 0x01
+	flagsDebug   = 0x02
 )
 
 type Option func(*options)
@@ -51,6 +55,9 @@ type options struct {
 	// BatchMaxCount defines the maximum number of spans sent in one batch
 	BatchMaxCount int
 
+	// ProcessTags are additional tags added to the Jaeger process.
+	ProcessTags []label.KeyValue
+
 	Config *sdktrace.Config
 
 	Disabled bool
@@ -63,6 +70,14 @@ func WithProcess(process Process) Option {
 	}
 }
 
+// WithProcessTags adds tags to the Jaeger process, overriding any tag with
+// the same key derived from the Process, Resource or sampler.
+func WithProcessTags(tags ...label.KeyValue) Option {
+	return func(o *options) {
+		o.ProcessTags = append(o.ProcessTags, tags...)
+	}
+}
+
 // WithBufferMaxCount defines the total number of traces that can be buffered in memory
 func WithBufferMaxCount(bufferMaxCount int) Option {
 	return func(o *options) {
@@ -78,6 +93,9 @@ func WithBatchMaxCount(batchMaxCount int) Option {
 }
 
 // WithSDK sets the SDK config for the exporter pipeline.
+//
+// The attributes of the config Resource and the type and parameter of its
+// default sampler are exported as tags of the Jaeger process.
 func WithSDK(config *sdktrace.Config) Option {
 	return func(o *options) {
 		o.Config = config
@@ -108,27 +126,16 @@ func NewRawExporter(endpointOption EndpointOption, opts ...Option) (*Exporter, e
 		opt(&o)
 	}
 
-	service := o.Process.ServiceName
-	if service == "" {
-		service = defaultServiceName
-	}
-	tags := make([]*gen.Tag, 0, len(o.Process.Tags))
-	for _, tag := range o.Process.Tags {
-		t := keyValueToTag(tag)
-		if t != nil {
-			tags = append(tags, t)
-		}
-	}
+	uploadCtx, cancelUploads := context.WithCancel(context.Background())
 	e := &Exporter{
-		uploader: uploader,
-		process: &gen.Process{
-			ServiceName: service,
-			Tags:        tags,
-		},
-		o: o,
+		uploader:      uploader,
+		process:       newProcess(o),
+		o:             o,
+		uploadCtx:     uploadCtx,
+		cancelUploads: cancelUploads,
 	}
 	bundler := bundler.NewBundler((*gen.Span)(nil), func(bundle interface{}) {
-		if err := e.upload(bundle.([]*gen.Span)); err != nil {
+		if err := e.upload(e.uploadCtx, bundle.([]*gen.Span)); err != nil {
 			otel.Handle(err)
 		}
 	})
@@ -204,6 +211,12 @@ type Exporter struct {
 	uploader batchUploader
 	o        options
 
+	// uploadCtx is the context of the uploads of bundled spans. It
+	// is canceled when Shutdown does not complete in time, so a hung
+	// collector does not keep the upload running.
+	uploadCtx     context.Context
+	cancelUploads context.CancelFunc
+
 	stoppedMu sync.RWMutex
 	stopped   bool
 }
@@ -220,8 +233,11 @@ func (e *Exporter) ExportSpans(ctx context.Context, ss []*export.SpanSnapshot) e
 	}
 
 	for _, span := range ss {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
 		// TODO(jbd): Handle oversized bundlers.
-		err := e.bundler.Add(spanSnapshotToThrift(span), 1)
+		err := e.bundler.Add(spanSnapshotToThrift(span, e.process), 1)
 		if err != nil {
 			return fmt.Errorf("failed to bundle %q: %w", span.Name, err)
 		}
@@ -240,6 +256,10 @@ func (e *Exporter) Shutdown(ctx context.Context) error {
 	e.stopped = true
 	e.stoppedMu.Unlock()
 
+	// Abort the upload still in progress if flushing does not complete
+	// before ctx is done.
+	defer e.cancelUploads()
+
 	done := make(chan struct{}, 1)
 	// Shadow so if the goroutine is leaked in testing it doesn't cause a race
 	// condition when the file level var is reset.
@@ -260,7 +280,10 @@ func (e *Exporter) Shutdown(ctx context.Context) error {
 	return nil
 }
 
-func spanSnapshotToThrift(ss *export.SpanSnapshot) *gen.Span {
+// spanSnapshotToThrift transforms ss into a Jaeger span. The Resource
+// attributes of ss already exported as tags of process are not repeated
+// on the span.
+func spanSnapshotToThrift(ss *export.SpanSnapshot, process *gen.Process) *gen.Span {
 	tags := make([]*gen.Tag, 0, len(ss.Attributes))
 	for _, kv := range ss.Attributes {
 		tag := keyValueToTag(kv)
@@ -275,7 +298,7 @@ func spanSnapshotToThrift(ss *export.SpanSnapshot) *gen.Span {
 	// overwrite resource attributes?
 	if ss.Resource != nil {
 		for iter := ss.Resource.Iter(); iter.Next(); {
-			if tag := keyValueToTag(iter.Attribute()); tag != nil {
+			if tag := keyValueToTag(iter.Attribute()); tag != nil && !hasTag(process, tag) {
 				tags = append(tags, tag)
 			}
 		}
@@ -318,9 +341,9 @@ func spanSnapshotToThrift(ss *export.SpanSnapshot) *gen.Span {
 	var refs []*gen.SpanRef
 	for _, link := range ss.Links {
 		refs = append(refs, &gen.SpanRef{
-			TraceIdHigh: int64(binary.BigEndian.Uint64(link.TraceID[0:8])),
-			TraceIdLow:  int64(binary.BigEndian.Uint64(link.TraceID[8:16])),
-			SpanId:      int64(binary.BigEndian.Uint64(link.SpanID[:])),
+			TraceIdHigh: int64(link.TraceID.High64()),
+			TraceIdLow:  int64(link.TraceID.Low64()),
+			SpanId:      int64(link.SpanID.Uint64()),
 			// TODO(paivagustavo): properly set the reference type when specs are defined
 			//  see https://github.com/open-telemetry/opentelemetry-specification/issues/65
 			RefType: gen.SpanRefType_CHILD_OF,
@@ -328,12 +351,12 @@ func spanSnapshotToThrift(ss *export.SpanSnapshot) *gen.Span {
 	}
 
 	return &gen.Span{
-		TraceIdHigh:   int64(binary.BigEndian.Uint64(ss.SpanContext.TraceID[0:8])),
-		TraceIdLow:    int64(binary.BigEndian.Uint64(ss.SpanContext.TraceID[8:16])),
-		SpanId:        int64(binary.BigEndian.Uint64(ss.SpanContext.SpanID[:])),
-		ParentSpanId:  int64(binary.BigEndian.Uint64(ss.ParentSpanID[:])),
+		TraceIdHigh:   int64(ss.SpanContext.TraceID.High64()),
+		TraceIdLow:    int64(ss.SpanContext.TraceID.Low64()),
+		SpanId:        int64(ss.SpanContext.SpanID.Uint64()),
+		ParentSpanId:  int64(ss.ParentSpanID.Uint64()),
 		OperationName: ss.Name, // TODO: if span kind is added then add prefix "Sent"/"Recv"
-		Flags:         int32(ss.SpanContext.TraceFlags),
+		Flags:         spanFlags(ss.SpanContext),
 		StartTime:     ss.StartTime.UnixNano() / 1000,
 		Duration:      ss.EndTime.Sub(ss.StartTime).Nanoseconds() / 1000,
 		Tags:          tags,
@@ -342,6 +365,35 @@ func spanSnapshotToThrift(ss *export.SpanSnapshot) *gen.Span {
 	}
 }
 
+// spanFlags returns the Jaeger flags of sc. Only the sampled and debug
+// flags are exported, the other trace flags have no Jaeger equivalent.
+func spanFlags(sc trace.SpanContext) int32 {
+	var flags int32
+	if sc.IsSampled() {
+		flags |= flagsSampled
+	}
+	if sc.IsDebug() {
+		flags |= flagsDebug
+	}
+	return flags
+}
+
+// hasTag returns true if process has a tag equal to t.
+func hasTag(process *gen.Process, t *gen.Tag) bool {
+	for _, pt := range process.GetTags() {
+		if pt.GetKey() == t.GetKey() &&
+			pt.GetVType() == t.GetVType() &&
+			pt.GetVStr() == t.GetVStr() &&
+			pt.GetVDouble() == t.GetVDouble() &&
+			pt.GetVBool() == t.GetVBool() &&
+			pt.GetVLong() == t.GetVLong() &&
+			bytes.Equal(pt.GetVBinary(), t.GetVBinary()) {
+			return true
+		}
+	}
+	return false
+}
+
 func keyValueToTag(keyValue label.KeyValue) *gen.Tag {
 	var tag *gen.Tag
 	switch keyValue.Value.Type() {
@@ -438,11 +490,11 @@ func (e *Exporter) Flush() {
 	flush(e)
 }
 
-func (e *Exporter) upload(spans []*gen.Span) error {
+func (e *Exporter) upload(ctx context.Context, spans []*gen.Span) error {
 	batch := &gen.Batch{
 		Spans:   spans,
 		Process: e.process,
 	}
 
-	return e.uploader.upload(batch)
+	return e.uploader.upload(ctx, batch)
 }
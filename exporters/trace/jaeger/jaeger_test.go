@@ -306,7 +306,7 @@ type testCollectorEnpoint struct {
 	spansUploaded []*gen.Span
 }
 
-func (c *testCollectorEnpoint) upload(batch *gen.Batch) error {
+func (c *testCollectorEnpoint) upload(_ context.Context, batch *gen.Batch) error {
 	c.spansUploaded = append(c.spansUploaded, batch.Spans...)
 	return nil
 }
@@ -373,6 +373,9 @@ func Test_spanSnapshotToThrift(t *testing.T) {
 	rv2 := int64(5)
 	instrLibName := "instrumentation-library"
 	instrLibVersion := "semver:1.0.0"
+	statusCodeUnset := int64(codes.Unset)
+	emptyValue := ""
+	spanKindUnspecified := "unspecified"
 
 	tests := []struct {
 		name string
@@ -462,10 +465,64 @@ func Test_spanSnapshotToThrift(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "random trace flags",
+			data: &export.SpanSnapshot{
+				SpanContext: trace.SpanContext{
+					TraceID:    traceID,
+					SpanID:     spanID,
+					TraceFlags: trace.FlagsSampled | trace.FlagsRandom,
+				},
+				Name:      "/foo",
+				StartTime: now,
+				EndTime:   now,
+			},
+			want: &gen.Span{
+				TraceIdLow:    651345242494996240,
+				TraceIdHigh:   72623859790382856,
+				SpanId:        72623859790382856,
+				OperationName: "/foo",
+				Flags:         1,
+				StartTime:     now.UnixNano() / 1000,
+				Duration:      0,
+				Tags: []*gen.Tag{
+					{Key: "status.code", VType: gen.TagType_LONG, VLong: &statusCodeUnset},
+					{Key: "status.message", VType: gen.TagType_STRING, VStr: &emptyValue},
+					{Key: "span.kind", VType: gen.TagType_STRING, VStr: &spanKindUnspecified},
+				},
+			},
+		},
+		{
+			name: "debug trace flags",
+			data: &export.SpanSnapshot{
+				SpanContext: trace.SpanContext{
+					TraceID:    traceID,
+					SpanID:     spanID,
+					TraceFlags: trace.FlagsSampled | trace.FlagsDebug | trace.FlagsDeferred,
+				},
+				Name:      "/foo",
+				StartTime: now,
+				EndTime:   now,
+			},
+			want: &gen.Span{
+				TraceIdLow:    651345242494996240,
+				TraceIdHigh:   72623859790382856,
+				SpanId:        72623859790382856,
+				OperationName: "/foo",
+				Flags:         3,
+				StartTime:     now.UnixNano() / 1000,
+				Duration:      0,
+				Tags: []*gen.Tag{
+					{Key: "status.code", VType: gen.TagType_LONG, VLong: &statusCodeUnset},
+					{Key: "status.message", VType: gen.TagType_STRING, VStr: &emptyValue},
+					{Key: "span.kind", VType: gen.TagType_STRING, VStr: &spanKindUnspecified},
+				},
+			},
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			got := spanSnapshotToThrift(tt.data)
+			got := spanSnapshotToThrift(tt.data, &gen.Process{})
 			sort.Slice(got.Tags, func(i, j int) bool {
 				return got.Tags[i].Key < got.Tags[j].Key
 			})
@@ -479,6 +536,34 @@ func Test_spanSnapshotToThrift(t *testing.T) {
 	}
 }
 
+func TestSpanSnapshotToThriftOmitsProcessTags(t *testing.T) {
+	res := resource.NewWithAttributes(
+		label.String("service.name", "svc"),
+		label.String("rk1", "rv1"),
+	)
+	process := newProcess(options{Config: &sdktrace.Config{Resource: res}})
+
+	ss := &export.SpanSnapshot{
+		Name: "/foo",
+		Resource: resource.NewWithAttributes(
+			label.String("service.name", "svc"),
+			label.String("rk1", "other"),
+			label.Int64("rk2", 5),
+		),
+	}
+	got := make(map[string]*gen.Tag)
+	for _, tag := range spanSnapshotToThrift(ss, process).Tags {
+		got[tag.Key] = tag
+	}
+	assert.NotContains(t, got, "service.name")
+	if assert.Contains(t, got, "rk1") {
+		assert.Equal(t, "other", got["rk1"].GetVStr())
+	}
+	if assert.Contains(t, got, "rk2") {
+		assert.Equal(t, int64(5), got["rk2"].GetVLong())
+	}
+}
+
 func TestExporterShutdownHonorsCancel(t *testing.T) {
 	orig := flush
 	ctx, cancel := context.WithTimeout(context.Background(), 1*time.Second)
@@ -525,3 +610,43 @@ func TestErrorOnExportShutdownExporter(t *testing.T) {
 	assert.NoError(t, e.Shutdown(context.Background()))
 	assert.NoError(t, e.ExportSpans(context.Background(), nil))
 }
+
+func TestExportSpansHonorsContext(t *testing.T) {
+	e, err := NewRawExporter(withTestCollectorEndpoint())
+	require.NoError(t, err)
+	defer func() {
+		assert.NoError(t, e.Shutdown(context.Background()))
+	}()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	err = e.ExportSpans(ctx, []*export.SpanSnapshot{{Name: "canceled"}})
+	assert.Equal(t, context.Canceled, err)
+}
+
+type blockingUploader struct {
+	canceled chan struct{}
+}
+
+func (u *blockingUploader) upload(ctx context.Context, _ *gen.Batch) error {
+	<-ctx.Done()
+	close(u.canceled)
+	return ctx.Err()
+}
+
+func TestExporterShutdownCancelsUpload(t *testing.T) {
+	u := &blockingUploader{canceled: make(chan struct{})}
+	e, err := NewRawExporter(func() (batchUploader, error) { return u, nil })
+	require.NoError(t, err)
+	require.NoError(t, e.ExportSpans(context.Background(), []*export.SpanSnapshot{{Name: "hung"}}))
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	assert.Equal(t, context.DeadlineExceeded, e.Shutdown(ctx))
+
+	select {
+	case <-u.canceled:
+	case <-time.After(10 * time.Second):
+		t.Fatal("upload was not canceled after shutdown timed out")
+	}
+}
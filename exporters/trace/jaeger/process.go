@@ -0,0 +1,142 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package jaeger // import "go.opentelemetry.io/otel/exporters/trace/jaeger"
+
+import (
+	"strconv"
+	"strings"
+
+	gen "go.opentelemetry.io/otel/exporters/trace/jaeger/internal/gen-go/jaeger"
+	"go.opentelemetry.io/otel/label"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/semconv"
+)
+
+const (
+	keySamplerType  = "sampler.type"
+	keySamplerParam = "sampler.param"
+
+	parentBasedPrefix = "ParentBased{root:"
+
+	samplerTypeConst         = "const"
+	samplerTypeProbabilistic = "probabilistic"
+)
+
+// newProcess returns the Jaeger process describing the exporting process.
+//
+// Tags are merged from, in increasing order of precedence, the Resource and
+// sampler of the SDK config, the configured Process and the additional
+// process tags. The service name is taken from the configured Process, then
+// the service.name Resource attribute, then the default service name.
+func newProcess(o options) *gen.Process {
+	var kvs []label.KeyValue
+	service := o.Process.ServiceName
+	if o.Config != nil {
+		if res := o.Config.Resource; res != nil {
+			for iter := res.Iter(); iter.Next(); {
+				kv := iter.Label()
+				if kv.Key == semconv.ServiceNameKey && service == "" {
+					service = kv.Value.Emit()
+				}
+				kvs = append(kvs, kv)
+			}
+		}
+		if s := o.Config.DefaultSampler; s != nil {
+			kvs = append(kvs, samplerAttributes(s)...)
+		}
+	}
+	if service == "" {
+		service = defaultServiceName
+	}
+	kvs = append(kvs, o.Process.Tags...)
+	kvs = append(kvs, o.ProcessTags...)
+
+	// Later values overwrite earlier ones with the same key while
+	// keeping the position of the first occurrence.
+	tags := make([]*gen.Tag, 0, len(kvs))
+	index := make(map[string]int, len(kvs))
+	for _, kv := range kvs {
+		t := keyValueToTag(kv)
+		if t == nil {
+			continue
+		}
+		if i, ok := index[t.Key]; ok {
+			tags[i] = t
+			continue
+		}
+		index[t.Key] = len(tags)
+		tags = append(tags, t)
+	}
+	return &gen.Process{
+		ServiceName: service,
+		Tags:        tags,
+	}
+}
+
+// samplerAttributes returns the sampler.type and sampler.param attributes
+// native Jaeger clients report for the sampler s. Samplers delegating to a
+// root sampler based on the parent are described by their root sampler.
+// Samplers unknown to Jaeger are reported by their description only.
+func samplerAttributes(s sdktrace.Sampler) []label.KeyValue {
+	desc := s.Description()
+	for strings.HasPrefix(desc, parentBasedPrefix) {
+		desc = parentBasedRoot(desc)
+	}
+
+	switch {
+	case desc == "AlwaysOnSampler":
+		return []label.KeyValue{
+			label.String(keySamplerType, samplerTypeConst),
+			label.Bool(keySamplerParam, true),
+		}
+	case desc == "AlwaysOffSampler":
+		return []label.KeyValue{
+			label.String(keySamplerType, samplerTypeConst),
+			label.Bool(keySamplerParam, false),
+		}
+	case strings.HasPrefix(desc, "TraceIDRatioBased{"):
+		param := strings.TrimSuffix(strings.TrimPrefix(desc, "TraceIDRatioBased{"), "}")
+		if f, err := strconv.ParseFloat(param, 64); err == nil {
+			return []label.KeyValue{
+				label.String(keySamplerType, samplerTypeProbabilistic),
+				label.Float64(keySamplerParam, f),
+			}
+		}
+	}
+	return []label.KeyValue{label.String(keySamplerType, desc)}
+}
+
+// parentBasedRoot returns the description of the root sampler contained in
+// the description desc of a parent based sampler.
+func parentBasedRoot(desc string) string {
+	desc = strings.TrimPrefix(desc, parentBasedPrefix)
+	depth := 0
+	for i, r := range desc {
+		switch r {
+		case '{':
+			depth++
+		case '}':
+			if depth == 0 {
+				return desc[:i]
+			}
+			depth--
+		case ',':
+			if depth == 0 {
+				return desc[:i]
+			}
+		}
+	}
+	return desc
+}
@@ -0,0 +1,192 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package jaeger
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	gen "go.opentelemetry.io/otel/exporters/trace/jaeger/internal/gen-go/jaeger"
+	"go.opentelemetry.io/otel/label"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/semconv"
+)
+
+func TestNewProcess(t *testing.T) {
+	res := resource.NewWithAttributes(
+		semconv.ServiceNameKey.String("resource-service"),
+		semconv.HostNameKey.String("host"),
+	)
+
+	testCases := []struct {
+		name    string
+		options options
+		want    *gen.Process
+	}{
+		{
+			name: "default",
+			want: &gen.Process{
+				ServiceName: defaultServiceName,
+				Tags:        []*gen.Tag{},
+			},
+		},
+		{
+			name: "resource and sampler",
+			options: options{
+				Config: &sdktrace.Config{
+					Resource:       res,
+					DefaultSampler: sdktrace.TraceIDRatioBased(0.25),
+				},
+			},
+			want: &gen.Process{
+				ServiceName: "resource-service",
+				Tags: []*gen.Tag{
+					getStringTag("host.name", "host"),
+					getStringTag("service.name", "resource-service"),
+					getStringTag(keySamplerType, samplerTypeProbabilistic),
+					getFloat64Tag(keySamplerParam, 0.25),
+				},
+			},
+		},
+		{
+			name: "empty resource",
+			options: options{
+				Config: &sdktrace.Config{Resource: resource.Empty()},
+			},
+			want: &gen.Process{
+				ServiceName: defaultServiceName,
+				Tags:        []*gen.Tag{},
+			},
+		},
+		{
+			name: "process overrides resource",
+			options: options{
+				Process: Process{
+					ServiceName: "process-service",
+					Tags:        []label.KeyValue{semconv.HostNameKey.String("process-host")},
+				},
+				ProcessTags: []label.KeyValue{label.String("custom", "tag")},
+				Config:      &sdktrace.Config{Resource: res},
+			},
+			want: &gen.Process{
+				ServiceName: "process-service",
+				Tags: []*gen.Tag{
+					getStringTag("host.name", "process-host"),
+					getStringTag("service.name", "resource-service"),
+					getStringTag("custom", "tag"),
+				},
+			},
+		},
+		{
+			name: "process tags override process",
+			options: options{
+				Process: Process{
+					Tags: []label.KeyValue{label.String("key", "process")},
+				},
+				ProcessTags: []label.KeyValue{label.String("key", "custom")},
+			},
+			want: &gen.Process{
+				ServiceName: defaultServiceName,
+				Tags:        []*gen.Tag{getStringTag("key", "custom")},
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.want, newProcess(tc.options))
+		})
+	}
+}
+
+func TestSamplerAttributes(t *testing.T) {
+	testCases := []struct {
+		name    string
+		sampler sdktrace.Sampler
+		want    []label.KeyValue
+	}{
+		{
+			name:    "always on",
+			sampler: sdktrace.AlwaysSample(),
+			want: []label.KeyValue{
+				label.String(keySamplerType, samplerTypeConst),
+				label.Bool(keySamplerParam, true),
+			},
+		},
+		{
+			name:    "always off",
+			sampler: sdktrace.NeverSample(),
+			want: []label.KeyValue{
+				label.String(keySamplerType, samplerTypeConst),
+				label.Bool(keySamplerParam, false),
+			},
+		},
+		{
+			name:    "ratio",
+			sampler: sdktrace.TraceIDRatioBased(0.5),
+			want: []label.KeyValue{
+				label.String(keySamplerType, samplerTypeProbabilistic),
+				label.Float64(keySamplerParam, 0.5),
+			},
+		},
+		{
+			name:    "parent based",
+			sampler: sdktrace.ParentBased(sdktrace.TraceIDRatioBased(0.1)),
+			want: []label.KeyValue{
+				label.String(keySamplerType, samplerTypeProbabilistic),
+				label.Float64(keySamplerParam, 0.1),
+			},
+		},
+		{
+			name:    "nested parent based",
+			sampler: sdktrace.ParentBased(sdktrace.ParentBased(sdktrace.NeverSample())),
+			want: []label.KeyValue{
+				label.String(keySamplerType, samplerTypeConst),
+				label.Bool(keySamplerParam, false),
+			},
+		},
+		{
+			name:    "unknown",
+			sampler: customSampler{},
+			want: []label.KeyValue{
+				label.String(keySamplerType, "custom"),
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.want, samplerAttributes(tc.sampler))
+		})
+	}
+}
+
+type customSampler struct{}
+
+func (customSampler) ShouldSample(p sdktrace.SamplingParameters) sdktrace.SamplingResult {
+	return sdktrace.SamplingResult{Decision: sdktrace.RecordAndSample}
+}
+
+func (customSampler) Description() string { return "custom" }
+
+func getFloat64Tag(k string, f float64) *gen.Tag {
+	return &gen.Tag{
+		Key:     k,
+		VDouble: &f,
+		VType:   gen.TagType_DOUBLE,
+	}
+}
@@ -16,6 +16,7 @@ package jaeger // import "go.opentelemetry.io/otel/exporters/trace/jaeger"
 
 import (
 	"bytes"
+	"context"
 	"errors"
 	"fmt"
 	"io"
@@ -31,7 +32,7 @@ import (
 
 // batchUploader send a batch of spans to Jaeger
 type batchUploader interface {
-	upload(batch *gen.Batch) error
+	upload(ctx context.Context, batch *gen.Batch) error
 }
 
 type EndpointOption func() (batchUploader, error)
@@ -164,7 +165,12 @@ type agentUploader struct {
 
 var _ batchUploader = (*agentUploader)(nil)
 
-func (a *agentUploader) upload(batch *gen.Batch) error {
+func (a *agentUploader) upload(ctx context.Context, batch *gen.Batch) error {
+	// Writing a UDP datagram does not block, so the context is only
+	// checked before emitting the batch.
+	if err := ctx.Err(); err != nil {
+		return err
+	}
 	return a.client.EmitBatch(batch)
 }
 
@@ -179,12 +185,12 @@ type collectorUploader struct {
 
 var _ batchUploader = (*collectorUploader)(nil)
 
-func (c *collectorUploader) upload(batch *gen.Batch) error {
+func (c *collectorUploader) upload(ctx context.Context, batch *gen.Batch) error {
 	body, err := serialize(batch)
 	if err != nil {
 		return err
 	}
-	req, err := http.NewRequest("POST", c.endpoint, body)
+	req, err := http.NewRequestWithContext(ctx, "POST", c.endpoint, body)
 	if err != nil {
 		return err
 	}
@@ -15,14 +15,16 @@
 package zipkin // import "go.opentelemetry.io/otel/exporters/trace/zipkin"
 
 import (
-	"encoding/binary"
 	"encoding/json"
 	"fmt"
+	"net"
+	"strconv"
 
 	zkmodel "github.com/openzipkin/zipkin-go/model"
 
 	"go.opentelemetry.io/otel/label"
 	export "go.opentelemetry.io/otel/sdk/export/trace"
+	"go.opentelemetry.io/otel/semconv"
 	"go.opentelemetry.io/otel/trace"
 )
 
@@ -31,31 +33,100 @@ const (
 	keyInstrumentationLibraryVersion = "otel.instrumentation_library.version"
 )
 
-func toZipkinSpanModels(batch []*export.SpanSnapshot, serviceName string) []zkmodel.SpanModel {
+// remoteEndpointKeyRank lists the span attributes, in order of precedence,
+// used to name the remote endpoint of an outgoing span.
+var remoteEndpointKeyRank = []label.Key{
+	semconv.PeerServiceKey,
+	semconv.NetPeerNameKey,
+	"peer.hostname",
+	"peer.address",
+	semconv.HTTPHostKey,
+	semconv.DBNameKey,
+}
+
+func toZipkinSpanModels(batch []*export.SpanSnapshot, local zkmodel.Endpoint) []zkmodel.SpanModel {
 	models := make([]zkmodel.SpanModel, 0, len(batch))
 	for _, data := range batch {
-		models = append(models, toZipkinSpanModel(data, serviceName))
+		models = append(models, toZipkinSpanModel(data, local))
 	}
 	return models
 }
 
-func toZipkinSpanModel(data *export.SpanSnapshot, serviceName string) zkmodel.SpanModel {
+func toZipkinSpanModel(data *export.SpanSnapshot, local zkmodel.Endpoint) zkmodel.SpanModel {
 	return zkmodel.SpanModel{
-		SpanContext: toZipkinSpanContext(data),
-		Name:        data.Name,
-		Kind:        toZipkinKind(data.SpanKind),
-		Timestamp:   data.StartTime,
-		Duration:    data.EndTime.Sub(data.StartTime),
-		Shared:      false,
-		LocalEndpoint: &zkmodel.Endpoint{
-			ServiceName: serviceName,
-		},
-		RemoteEndpoint: nil, // *Endpoint
+		SpanContext:    toZipkinSpanContext(data),
+		Name:           data.Name,
+		Kind:           toZipkinKind(data.SpanKind),
+		Timestamp:      data.StartTime,
+		Duration:       data.EndTime.Sub(data.StartTime),
+		Shared:         false,
+		LocalEndpoint:  toZipkinLocalEndpoint(data, local),
+		RemoteEndpoint: toZipkinRemoteEndpoint(data),
 		Annotations:    toZipkinAnnotations(data.MessageEvents),
 		Tags:           toZipkinTags(data),
 	}
 }
 
+// toZipkinLocalEndpoint returns the local endpoint of the span. The
+// service name is taken from the span Resource when it is set there,
+// otherwise the configured one is used.
+func toZipkinLocalEndpoint(data *export.SpanSnapshot, local zkmodel.Endpoint) *zkmodel.Endpoint {
+	for iter := data.Resource.Iter(); iter.Next(); {
+		kv := iter.Label()
+		if kv.Key != semconv.ServiceNameKey {
+			continue
+		}
+		if name := kv.Value.Emit(); name != "" {
+			local.ServiceName = name
+		}
+		break
+	}
+	return &local
+}
+
+// toZipkinRemoteEndpoint derives the remote endpoint of client and
+// producer spans from their peer attributes. It returns nil if the span
+// is of any other kind or has no attributes identifying the peer.
+func toZipkinRemoteEndpoint(data *export.SpanSnapshot) *zkmodel.Endpoint {
+	if data.SpanKind != trace.SpanKindClient && data.SpanKind != trace.SpanKindProducer {
+		return nil
+	}
+
+	attrs := make(map[label.Key]label.Value, len(data.Attributes))
+	for _, kv := range data.Attributes {
+		attrs[kv.Key] = kv.Value
+	}
+
+	var endpoint zkmodel.Endpoint
+	for _, key := range remoteEndpointKeyRank {
+		if v, ok := attrs[key]; ok {
+			endpoint.ServiceName = v.Emit()
+			break
+		}
+	}
+
+	if v, ok := attrs[semconv.NetPeerIPKey]; ok {
+		if ip := net.ParseIP(v.Emit()); ip != nil {
+			if ip4 := ip.To4(); ip4 != nil {
+				endpoint.IPv4 = ip4
+			} else {
+				endpoint.IPv6 = ip
+			}
+		}
+	}
+
+	if endpoint.ServiceName == "" && endpoint.IPv4 == nil && endpoint.IPv6 == nil {
+		return nil
+	}
+
+	if v, ok := attrs[semconv.NetPeerPortKey]; ok {
+		if port, err := strconv.ParseUint(v.Emit(), 10, 16); err == nil {
+			endpoint.Port = uint16(port)
+		}
+	}
+	return &endpoint
+}
+
 func toZipkinSpanContext(data *export.SpanSnapshot) zkmodel.SpanContext {
 	return zkmodel.SpanContext{
 		TraceID:  toZipkinTraceID(data.SpanContext.TraceID),
@@ -69,13 +140,13 @@ func toZipkinSpanContext(data *export.SpanSnapshot) zkmodel.SpanContext {
 
 func toZipkinTraceID(traceID trace.TraceID) zkmodel.TraceID {
 	return zkmodel.TraceID{
-		High: binary.BigEndian.Uint64(traceID[:8]),
-		Low:  binary.BigEndian.Uint64(traceID[8:]),
+		High: traceID.High64(),
+		Low:  traceID.Low64(),
 	}
 }
 
 func toZipkinID(spanID trace.SpanID) zkmodel.ID {
-	return zkmodel.ID(binary.BigEndian.Uint64(spanID[:]))
+	return zkmodel.ID(spanID.Uint64())
 }
 
 func toZipkinParentID(spanID trace.SpanID) *zkmodel.ID {
@@ -16,6 +16,7 @@ package zipkin
 
 import (
 	"fmt"
+	"net"
 	"strconv"
 	"testing"
 	"time"
@@ -28,6 +29,8 @@ import (
 	"go.opentelemetry.io/otel/label"
 	export "go.opentelemetry.io/otel/sdk/export/trace"
 	"go.opentelemetry.io/otel/sdk/instrumentation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	"go.opentelemetry.io/otel/semconv"
 	"go.opentelemetry.io/otel/trace"
 )
 
@@ -652,10 +655,121 @@ func TestModelConversion(t *testing.T) {
 			},
 		},
 	}
-	gottenOutputBatch := toZipkinSpanModels(inputBatch, "model-test")
+	gottenOutputBatch := toZipkinSpanModels(inputBatch, zkmodel.Endpoint{ServiceName: "model-test"})
 	require.Equal(t, expectedOutputBatch, gottenOutputBatch)
 }
 
+func TestToZipkinLocalEndpoint(t *testing.T) {
+	local := zkmodel.Endpoint{
+		ServiceName: "default",
+		IPv4:        net.ParseIP("10.0.0.1").To4(),
+		Port:        8080,
+	}
+
+	got := toZipkinLocalEndpoint(&export.SpanSnapshot{}, local)
+	require.Equal(t, &local, got)
+
+	got = toZipkinLocalEndpoint(&export.SpanSnapshot{
+		Resource: resource.NewWithAttributes(semconv.ServiceNameKey.String("from-resource")),
+	}, local)
+	require.Equal(t, &zkmodel.Endpoint{
+		ServiceName: "from-resource",
+		IPv4:        local.IPv4,
+		Port:        8080,
+	}, got)
+	require.Equal(t, "default", local.ServiceName, "template endpoint must not be modified")
+}
+
+func TestToZipkinRemoteEndpoint(t *testing.T) {
+	tests := []struct {
+		name  string
+		kind  trace.SpanKind
+		attrs []label.KeyValue
+		want  *zkmodel.Endpoint
+	}{
+		{
+			name: "server span",
+			kind: trace.SpanKindServer,
+			attrs: []label.KeyValue{
+				semconv.PeerServiceKey.String("peer"),
+			},
+			want: nil,
+		},
+		{
+			name: "no peer attributes",
+			kind: trace.SpanKindClient,
+			attrs: []label.KeyValue{
+				label.String("key", "value"),
+			},
+			want: nil,
+		},
+		{
+			name: "ranked service name",
+			kind: trace.SpanKindClient,
+			attrs: []label.KeyValue{
+				semconv.DBNameKey.String("db"),
+				semconv.NetPeerNameKey.String("peer-name"),
+				semconv.HTTPHostKey.String("http-host"),
+			},
+			want: &zkmodel.Endpoint{ServiceName: "peer-name"},
+		},
+		{
+			name: "peer service wins",
+			kind: trace.SpanKindProducer,
+			attrs: []label.KeyValue{
+				semconv.NetPeerNameKey.String("peer-name"),
+				semconv.PeerServiceKey.String("peer-service"),
+			},
+			want: &zkmodel.Endpoint{ServiceName: "peer-service"},
+		},
+		{
+			name: "ipv4 and port",
+			kind: trace.SpanKindClient,
+			attrs: []label.KeyValue{
+				semconv.NetPeerIPKey.String("1.2.3.4"),
+				semconv.NetPeerPortKey.Int(9090),
+			},
+			want: &zkmodel.Endpoint{
+				IPv4: net.ParseIP("1.2.3.4").To4(),
+				Port: 9090,
+			},
+		},
+		{
+			name: "ipv6",
+			kind: trace.SpanKindClient,
+			attrs: []label.KeyValue{
+				semconv.PeerServiceKey.String("peer"),
+				semconv.NetPeerIPKey.String("::1"),
+				semconv.NetPeerPortKey.String("443"),
+			},
+			want: &zkmodel.Endpoint{
+				ServiceName: "peer",
+				IPv6:        net.ParseIP("::1"),
+				Port:        443,
+			},
+		},
+		{
+			name: "invalid ip and port",
+			kind: trace.SpanKindClient,
+			attrs: []label.KeyValue{
+				semconv.PeerServiceKey.String("peer"),
+				semconv.NetPeerIPKey.String("not-an-ip"),
+				semconv.NetPeerPortKey.Int(70000),
+			},
+			want: &zkmodel.Endpoint{ServiceName: "peer"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := toZipkinRemoteEndpoint(&export.SpanSnapshot{
+				SpanKind:   tt.kind,
+				Attributes: tt.attrs,
+			})
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
+
 func zkmodelIDPtr(n uint64) *zkmodel.ID {
 	id := zkmodel.ID(n)
 	return &id
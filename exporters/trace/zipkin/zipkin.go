@@ -23,10 +23,13 @@ import (
 	"io"
 	"io/ioutil"
 	"log"
+	"net"
 	"net/http"
 	"net/url"
 	"sync"
 
+	zkmodel "github.com/openzipkin/zipkin-go/model"
+
 	"go.opentelemetry.io/otel"
 	export "go.opentelemetry.io/otel/sdk/export/trace"
 	sdktrace "go.opentelemetry.io/otel/sdk/trace"
@@ -52,9 +55,11 @@ var (
 
 // Options contains configuration for the exporter.
 type options struct {
-	client *http.Client
-	logger *log.Logger
-	config *sdktrace.Config
+	client    *http.Client
+	logger    *log.Logger
+	config    *sdktrace.Config
+	localIP   net.IP
+	localPort uint16
 }
 
 // Option defines a function that configures the exporter.
@@ -81,6 +86,18 @@ func WithSDK(config *sdktrace.Config) Option {
 	}
 }
 
+// WithLocalEndpoint sets the network address reported in the local
+// endpoint of every exported span. The IP address may be either IPv4 or
+// IPv6, and a zero port is omitted. The service name of the local
+// endpoint is taken from the service.name attribute of the span Resource,
+// falling back to the service name the exporter was created with.
+func WithLocalEndpoint(ip net.IP, port uint16) Option {
+	return func(o *options) {
+		o.localIP = ip
+		o.localPort = port
+	}
+}
+
 // NewRawExporter creates a new Zipkin exporter.
 func NewRawExporter(collectorURL, serviceName string, opts ...Option) (*Exporter, error) {
 	if collectorURL == "" {
@@ -152,7 +169,7 @@ func (e *Exporter) ExportSpans(ctx context.Context, ss []*export.SpanSnapshot) e
 		e.logf("no spans to export")
 		return nil
 	}
-	models := toZipkinSpanModels(ss, e.serviceName)
+	models := toZipkinSpanModels(ss, e.localEndpoint())
 	body, err := json.Marshal(models)
 	if err != nil {
 		return e.errf("failed to serialize zipkin models to JSON: %v", err)
@@ -199,6 +216,21 @@ func (e *Exporter) Shutdown(ctx context.Context) error {
 	return nil
 }
 
+// localEndpoint returns the configured local endpoint spans are reported
+// from.
+func (e *Exporter) localEndpoint() zkmodel.Endpoint {
+	endpoint := zkmodel.Endpoint{
+		ServiceName: e.serviceName,
+		Port:        e.o.localPort,
+	}
+	if ip4 := e.o.localIP.To4(); ip4 != nil {
+		endpoint.IPv4 = ip4
+	} else if e.o.localIP != nil {
+		endpoint.IPv6 = e.o.localIP
+	}
+	return endpoint
+}
+
 func (e *Exporter) logf(format string, args ...interface{}) {
 	if e.logger != nil {
 		e.logger.Printf(format, args...)
@@ -22,6 +22,7 @@ import (
 	"log"
 	"net"
 	"net/http"
+	"net/http/httptest"
 	"sync"
 	"testing"
 	"time"
@@ -382,6 +383,34 @@ func TestExporterShutdownHonorsCancel(t *testing.T) {
 	assert.Errorf(t, exp.Shutdown(innerCtx), context.Canceled.Error())
 }
 
+func TestExportSpansHonorsDeadline(t *testing.T) {
+	done := make(chan struct{})
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		select {
+		case <-r.Context().Done():
+		case <-done:
+		}
+	}))
+	defer srv.Close()
+	defer close(done)
+
+	exp, err := NewRawExporter(srv.URL, serviceName)
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	errCh := make(chan error, 1)
+	go func() {
+		errCh <- exp.ExportSpans(ctx, []*export.SpanSnapshot{{Name: "hung"}})
+	}()
+	select {
+	case err := <-errCh:
+		assert.Error(t, err)
+	case <-time.After(10 * time.Second):
+		t.Fatal("export did not return after its deadline")
+	}
+}
+
 func TestErrorOnExportShutdownExporter(t *testing.T) {
 	exp, err := NewRawExporter(collectorURL, serviceName)
 	require.NoError(t, err)
@@ -3,6 +3,8 @@ module go.opentelemetry.io/otel
 go 1.14
 
 require (
+	github.com/go-logr/logr v1.2.3
+	github.com/go-logr/stdr v1.2.2
 	github.com/google/go-cmp v0.5.4
 	github.com/stretchr/testify v1.7.0
 )
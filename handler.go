@@ -27,7 +27,9 @@ var (
 	// specified ErrorHandler is registered (`SetErrorHandler`) all calls to
 	// `Handle` and will be delegated to the registered ErrorHandler.
 	globalErrorHandler = &loggingErrorHandler{
-		l: log.New(os.Stderr, "", log.LstdFlags),
+		l:       log.New(os.Stderr, "", log.LstdFlags),
+		min:     SeverityInfo,
+		limiter: newRateLimiter(defaultRateLimitInterval),
 	}
 
 	// delegateErrorHandlerOnce ensures that a user provided ErrorHandler is
@@ -38,11 +40,15 @@ var (
 	_ ErrorHandler = (*loggingErrorHandler)(nil)
 )
 
-// loggingErrorHandler logs all errors to STDERR.
+// loggingErrorHandler logs errors to STDERR. Errors less severe than
+// min are dropped, and if limiter is not nil repeated errors are
+// rate limited.
 type loggingErrorHandler struct {
 	delegate atomic.Value
 
-	l *log.Logger
+	l       *log.Logger
+	min     Severity
+	limiter *rateLimiter
 }
 
 // setDelegate sets the ErrorHandler delegate if one is not already set.
@@ -60,12 +66,29 @@ func (h *loggingErrorHandler) Handle(err error) {
 		d.(ErrorHandler).Handle(err)
 		return
 	}
+	severity := SeverityOf(err)
+	if severity < h.min {
+		return
+	}
+	if h.limiter != nil {
+		ok, n := h.limiter.allow(err)
+		if !ok {
+			return
+		}
+		err = withSuppressed(err, n)
+	}
+	if severity != SeverityError {
+		h.l.Printf("%s: %v", severity, err)
+		return
+	}
 	h.l.Print(err)
 }
 
 // GetErrorHandler returns the global ErrorHandler instance. If no ErrorHandler
 // instance has been set (`SetErrorHandler`), the default ErrorHandler which
-// logs errors to STDERR is returned.
+// logs errors to STDERR is returned. The default ErrorHandler drops
+// SeverityDebug errors and logs an identical error at most once every 10
+// seconds.
 func GetErrorHandler() ErrorHandler {
 	return globalErrorHandler
 }
@@ -87,3 +110,13 @@ func SetErrorHandler(h ErrorHandler) {
 func Handle(err error) {
 	GetErrorHandler().Handle(err)
 }
+
+// HandleSeverity is a convenience function for reporting err from component
+// with severity to the global ErrorHandler. The error is wrapped in an *Error.
+func HandleSeverity(component string, severity Severity, err error) {
+	GetErrorHandler().Handle(&Error{
+		Severity:  severity,
+		Component: component,
+		Err:       err,
+	})
+}
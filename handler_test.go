@@ -22,6 +22,8 @@ import (
 	"testing"
 	"time"
 
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
 )
 
@@ -141,3 +143,57 @@ func (s *HandlerTestSuite) TestNoDropsOnDelegate() {
 func TestHandlerTestSuite(t *testing.T) {
 	suite.Run(t, new(HandlerTestSuite))
 }
+
+func TestLoggingErrorHandlerSeverity(t *testing.T) {
+	l := new(errLogger)
+	h := &loggingErrorHandler{
+		l:   log.New(l, "", 0),
+		min: SeverityInfo,
+	}
+
+	h.Handle(&Error{Severity: SeverityDebug, Component: "test", Err: errors.New("dropped")})
+	h.Handle(&Error{Severity: SeverityWarning, Component: "test", Err: errors.New("ignored option")})
+	h.Handle(fmt.Errorf("wrapped: %w", &Error{Severity: SeverityInfo, Err: errors.New("note")}))
+	h.Handle(errors.New("untagged"))
+
+	assert.Equal(t, []string{
+		"warning: test: ignored option",
+		"info: wrapped: note",
+		"untagged",
+	}, l.Got())
+}
+
+func TestRateLimitedErrorHandler(t *testing.T) {
+	l := new(errLogger)
+	h := NewRateLimitedErrorHandler(&loggingErrorHandler{l: log.New(l, "", 0), min: SeverityDebug}, time.Second).(*rateLimitedErrorHandler)
+	now := time.Unix(0, 0)
+	h.limiter.now = func() time.Time { return now }
+
+	flapping := &Error{Component: "exporter", Err: errors.New("connection refused")}
+	for i := 0; i < 1000; i++ {
+		h.Handle(flapping)
+	}
+	h.Handle(errors.New("other"))
+	// The same message with a different severity is not identical.
+	h.Handle(&Error{Severity: SeverityWarning, Component: "exporter", Err: errors.New("connection refused")})
+
+	now = now.Add(time.Second)
+	h.Handle(flapping)
+	h.Handle(flapping)
+
+	assert.Equal(t, []string{
+		"exporter: connection refused",
+		"other",
+		"warning: exporter: connection refused",
+		"exporter: connection refused (999 identical errors suppressed)",
+	}, l.Got())
+
+	var e *Error
+	l.Reset()
+	now = now.Add(time.Second)
+	h.delegate = ErrorHandlerFunc(func(err error) {
+		require.True(t, errors.As(err, &e))
+	})
+	h.Handle(flapping)
+	assert.Equal(t, "exporter", e.Component)
+}
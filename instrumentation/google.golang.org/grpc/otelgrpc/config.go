@@ -0,0 +1,85 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package otelgrpc // import "go.opentelemetry.io/otel/instrumentation/google.golang.org/grpc/otelgrpc"
+
+import (
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/metric"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// instrumentationName is the name of the Tracer and Meter of the
+// instrumentation.
+const instrumentationName = "go.opentelemetry.io/otel/instrumentation/google.golang.org/grpc/otelgrpc"
+
+// config is a group of options for the stats handlers.
+type config struct {
+	TracerProvider trace.TracerProvider
+	MeterProvider  metric.MeterProvider
+	Propagators    propagation.TextMapPropagator
+	MessageEvents  bool
+}
+
+// Option applies an option value for a config.
+type Option func(*config)
+
+// newConfig returns a config configured with all the passed Options.
+func newConfig(opts ...Option) *config {
+	c := &config{
+		TracerProvider: otel.GetTracerProvider(),
+		MeterProvider:  otel.GetMeterProvider(),
+		Propagators:    otel.GetTextMapPropagator(),
+		MessageEvents:  true,
+	}
+	for _, opt := range opts {
+		opt(c)
+	}
+	return c
+}
+
+// WithTracerProvider returns an Option to use the TracerProvider when
+// creating a Tracer. If none is specified, the global provider is used.
+func WithTracerProvider(provider trace.TracerProvider) Option {
+	return func(c *config) {
+		c.TracerProvider = provider
+	}
+}
+
+// WithMeterProvider returns an Option to use the MeterProvider when
+// creating a Meter. If none is specified, the global provider is used.
+func WithMeterProvider(provider metric.MeterProvider) Option {
+	return func(c *config) {
+		c.MeterProvider = provider
+	}
+}
+
+// WithPropagators returns an Option to use the Propagators when extracting
+// and injecting trace context from requests. If none is specified, the
+// global TextMapPropagator is used.
+func WithPropagators(propagators propagation.TextMapPropagator) Option {
+	return func(c *config) {
+		c.Propagators = propagators
+	}
+}
+
+// WithoutMessageEvents returns an Option to not add a message event to the
+// span of an RPC for each of its messages. The sizes of the messages are
+// still measured.
+func WithoutMessageEvents() Option {
+	return func(c *config) {
+		c.MessageEvents = false
+	}
+}
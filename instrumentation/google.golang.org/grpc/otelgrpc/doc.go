@@ -0,0 +1,30 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package otelgrpc provides gRPC stats.Handler implementations
+// instrumenting gRPC servers and clients with OpenTelemetry.
+//
+// A server is instrumented with the grpc.StatsHandler server option and
+// NewServerHandler, a client with the grpc.WithStatsHandler dial option and
+// NewClientHandler:
+//
+//	server := grpc.NewServer(grpc.StatsHandler(otelgrpc.NewServerHandler()))
+//	conn, err := grpc.Dial(target, grpc.WithStatsHandler(otelgrpc.NewClientHandler()))
+//
+// Every RPC is traced by a span, a message event of which is added for each
+// message sent and received, and measured by the rpc.server.duration,
+// rpc.server.request.size and rpc.server.response.size instruments on the
+// server side, and by their rpc.client counterparts on the client side.
+// The span context is propagated in the gRPC metadata of the RPC.
+package otelgrpc // import "go.opentelemetry.io/otel/instrumentation/google.golang.org/grpc/otelgrpc"
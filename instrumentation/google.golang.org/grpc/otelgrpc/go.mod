@@ -0,0 +1,12 @@
+module go.opentelemetry.io/otel/instrumentation/google.golang.org/grpc/otelgrpc
+
+go 1.14
+
+replace go.opentelemetry.io/otel => ../../../..
+
+require (
+	github.com/stretchr/testify v1.7.0
+	go.opentelemetry.io/otel v0.16.0
+	golang.org/x/net v0.0.0-20201021035429-f5854403a974 // indirect
+	google.golang.org/grpc v1.34.0
+)
@@ -0,0 +1,271 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package otelgrpc // import "go.opentelemetry.io/otel/instrumentation/google.golang.org/grpc/otelgrpc"
+
+import (
+	"context"
+	"net"
+	"strconv"
+	"strings"
+	"sync/atomic"
+
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/stats"
+	"google.golang.org/grpc/status"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/label"
+	"go.opentelemetry.io/otel/metric"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/semconv"
+	"go.opentelemetry.io/otel/trace"
+	"go.opentelemetry.io/otel/unit"
+)
+
+// gRPCContextKey is the key of the gRPCContext of an RPC in its context.
+type gRPCContextKey struct{}
+
+// gRPCContext is the state of an instrumented RPC.
+type gRPCContext struct {
+	messagesReceived int64
+	messagesSent     int64
+	labels           []label.KeyValue
+}
+
+// handler is a stats.Handler tracing and measuring the RPCs of a server or
+// a client.
+type handler struct {
+	config *config
+	tracer trace.Tracer
+	kind   trace.SpanKind
+
+	duration     metric.Float64ValueRecorder
+	requestSize  metric.Int64ValueRecorder
+	responseSize metric.Int64ValueRecorder
+}
+
+var _ stats.Handler = &handler{}
+
+// NewServerHandler returns a stats.Handler to pass to grpc.StatsHandler
+// tracing and measuring the RPCs served by a gRPC server.
+func NewServerHandler(opts ...Option) stats.Handler {
+	return newHandler(trace.SpanKindServer, "rpc.server", opts)
+}
+
+// NewClientHandler returns a stats.Handler to pass to grpc.WithStatsHandler
+// tracing and measuring the RPCs made by a gRPC client.
+func NewClientHandler(opts ...Option) stats.Handler {
+	return newHandler(trace.SpanKindClient, "rpc.client", opts)
+}
+
+func newHandler(kind trace.SpanKind, prefix string, opts []Option) *handler {
+	c := newConfig(opts...)
+	h := &handler{
+		config: c,
+		tracer: c.TracerProvider.Tracer(
+			instrumentationName,
+			trace.WithInstrumentationVersion(otel.Version()),
+		),
+		kind: kind,
+	}
+
+	meter := c.MeterProvider.Meter(
+		instrumentationName,
+		metric.WithInstrumentationVersion(otel.Version()),
+	)
+	var err error
+	if h.duration, err = meter.NewFloat64ValueRecorder(
+		prefix+".duration",
+		metric.WithUnit(unit.Milliseconds),
+		metric.WithDescription("Duration of the RPCs"),
+	); err != nil {
+		otel.Handle(err)
+	}
+	if h.requestSize, err = meter.NewInt64ValueRecorder(
+		prefix+".request.size",
+		metric.WithUnit(unit.Bytes),
+		metric.WithDescription("Uncompressed size of the request messages"),
+	); err != nil {
+		otel.Handle(err)
+	}
+	if h.responseSize, err = meter.NewInt64ValueRecorder(
+		prefix+".response.size",
+		metric.WithUnit(unit.Bytes),
+		metric.WithDescription("Uncompressed size of the response messages"),
+	); err != nil {
+		otel.Handle(err)
+	}
+	return h
+}
+
+// TagRPC starts the span of an RPC, propagating its context in the gRPC
+// metadata.
+func (h *handler) TagRPC(ctx context.Context, info *stats.RPCTagInfo) context.Context {
+	name, labels := spanInfo(info.FullMethodName)
+	if h.kind == trace.SpanKindServer {
+		md, _ := metadata.FromIncomingContext(ctx)
+		ctx = h.config.Propagators.Extract(ctx, propagation.MetadataCarrier(md))
+	}
+
+	ctx, _ = h.tracer.Start(
+		ctx,
+		name,
+		trace.WithSpanKind(h.kind),
+		trace.WithAttributes(labels...),
+	)
+
+	if h.kind == trace.SpanKindClient {
+		md, _ := metadata.FromOutgoingContext(ctx)
+		md = md.Copy()
+		h.config.Propagators.Inject(ctx, propagation.MetadataCarrier(md))
+		ctx = metadata.NewOutgoingContext(ctx, md)
+	}
+
+	return context.WithValue(ctx, gRPCContextKey{}, &gRPCContext{labels: labels})
+}
+
+// HandleRPC adds the events and attributes of the stats of an RPC to its
+// span and ends it, with the measurements of the RPC, when it completes.
+func (h *handler) HandleRPC(ctx context.Context, rs stats.RPCStats) {
+	gctx, ok := ctx.Value(gRPCContextKey{}).(*gRPCContext)
+	if !ok {
+		return
+	}
+	span := trace.SpanFromContext(ctx)
+
+	switch rs := rs.(type) {
+	case *stats.InPayload:
+		id := atomic.AddInt64(&gctx.messagesReceived, 1)
+		if h.kind == trace.SpanKindServer {
+			h.requestSize.Record(ctx, int64(rs.Length), gctx.labels...)
+		} else {
+			h.responseSize.Record(ctx, int64(rs.Length), gctx.labels...)
+		}
+		if h.config.MessageEvents {
+			span.AddEvent("message", trace.WithAttributes(
+				semconv.RPCMessageTypeReceived,
+				semconv.RPCMessageIDKey.Int64(id),
+				semconv.RPCMessageCompressedSizeKey.Int(rs.WireLength),
+				semconv.RPCMessageUncompressedSizeKey.Int(rs.Length),
+			))
+		}
+	case *stats.OutPayload:
+		id := atomic.AddInt64(&gctx.messagesSent, 1)
+		if h.kind == trace.SpanKindServer {
+			h.responseSize.Record(ctx, int64(rs.Length), gctx.labels...)
+		} else {
+			h.requestSize.Record(ctx, int64(rs.Length), gctx.labels...)
+		}
+		if h.config.MessageEvents {
+			span.AddEvent("message", trace.WithAttributes(
+				semconv.RPCMessageTypeSent,
+				semconv.RPCMessageIDKey.Int64(id),
+				semconv.RPCMessageCompressedSizeKey.Int(rs.WireLength),
+				semconv.RPCMessageUncompressedSizeKey.Int(rs.Length),
+			))
+		}
+	case *stats.InHeader:
+		if h.kind == trace.SpanKindServer {
+			span.SetAttributes(peerAttributes(rs.RemoteAddr)...)
+		}
+	case *stats.OutHeader:
+		if h.kind == trace.SpanKindClient {
+			span.SetAttributes(peerAttributes(rs.RemoteAddr)...)
+		}
+	case *stats.End:
+		code := status.Code(rs.Error)
+		if rs.Error != nil {
+			span.RecordError(rs.Error)
+		}
+		span.SetStatus(spanStatus(h.kind, uint32(code)))
+		span.SetAttributes(semconv.RPCAttributesFromGRPCCode(uint32(code))...)
+		span.End(trace.WithTimestamp(rs.EndTime))
+
+		labels := append(gctx.labels[:len(gctx.labels):len(gctx.labels)], semconv.RPCGRPCStatusCodeKey.Int64(int64(code)))
+		h.duration.Record(ctx, float64(rs.EndTime.Sub(rs.BeginTime))/1e6, labels...)
+	}
+}
+
+// TagConn returns ctx unchanged, connections are not instrumented.
+func (h *handler) TagConn(ctx context.Context, _ *stats.ConnTagInfo) context.Context {
+	return ctx
+}
+
+// HandleConn does nothing, connections are not instrumented.
+func (h *handler) HandleConn(context.Context, stats.ConnStats) {}
+
+// spanInfo returns the span name and the labels of the RPC of the full
+// method name, formatted as /package.service/method.
+func spanInfo(fullMethod string) (string, []label.KeyValue) {
+	name := strings.TrimLeft(fullMethod, "/")
+	labels := []label.KeyValue{semconv.RPCSystemGRPC}
+	if i := strings.LastIndexByte(name, '/'); i >= 0 {
+		labels = append(labels,
+			semconv.RPCServiceKey.String(name[:i]),
+			semconv.RPCMethodKey.String(name[i+1:]),
+		)
+	}
+	return name, labels
+}
+
+// spanStatus returns the span status of an RPC that completed with the
+// gRPC status code. Server spans leave their status unset for the codes
+// reporting an error of the client, as the HTTP server spans do for 4xx
+// status codes.
+func spanStatus(kind trace.SpanKind, code uint32) (codes.Code, string) {
+	c, msg := semconv.SpanStatusFromGRPCCode(code)
+	if kind == trace.SpanKindServer && c == codes.Error && isClientError(code) {
+		return codes.Unset, msg
+	}
+	return c, msg
+}
+
+// isClientError reports whether the gRPC status code is caused by the
+// client of an RPC.
+func isClientError(code uint32) bool {
+	switch code {
+	case 3, // InvalidArgument
+		5,  // NotFound
+		6,  // AlreadyExists
+		7,  // PermissionDenied
+		9,  // FailedPrecondition
+		11, // OutOfRange
+		16: // Unauthenticated
+		return true
+	}
+	return false
+}
+
+// peerAttributes returns the net.peer attributes of a peer address.
+func peerAttributes(addr net.Addr) []label.KeyValue {
+	if addr == nil {
+		return nil
+	}
+	host, port, err := net.SplitHostPort(addr.String())
+	if err != nil {
+		return nil
+	}
+	var attrs []label.KeyValue
+	if ip := net.ParseIP(host); ip != nil {
+		attrs = append(attrs, semconv.NetPeerIPKey.String(ip.String()))
+	} else if host != "" {
+		attrs = append(attrs, semconv.NetPeerNameKey.String(host))
+	}
+	if p, err := strconv.Atoi(port); err == nil && p > 0 {
+		attrs = append(attrs, semconv.NetPeerPortKey.Int(p))
+	}
+	return attrs
+}
@@ -0,0 +1,194 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package otelgrpc
+
+import (
+	"context"
+	"net"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	grpccodes "google.golang.org/grpc/codes"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/label"
+	"go.opentelemetry.io/otel/oteltest"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/semconv"
+	"go.opentelemetry.io/otel/trace"
+)
+
+type testPipeline struct {
+	clientSpans *oteltest.StandardSpanRecorder
+	serverSpans *oteltest.StandardSpanRecorder
+	clientMeter *oteltest.MeterImpl
+	serverMeter *oteltest.MeterImpl
+	client      healthpb.HealthClient
+	stop        func()
+}
+
+func newTestPipeline(t *testing.T, opts ...Option) *testPipeline {
+	p := &testPipeline{
+		clientSpans: new(oteltest.StandardSpanRecorder),
+		serverSpans: new(oteltest.StandardSpanRecorder),
+	}
+	clientMeter, clientMP := oteltest.NewMeterProvider()
+	serverMeter, serverMP := oteltest.NewMeterProvider()
+	p.clientMeter, p.serverMeter = clientMeter, serverMeter
+
+	listener := bufconn.Listen(1 << 20)
+	server := grpc.NewServer(grpc.StatsHandler(NewServerHandler(append([]Option{
+		WithTracerProvider(oteltest.NewTracerProvider(oteltest.WithSpanRecorder(p.serverSpans))),
+		WithMeterProvider(serverMP),
+		WithPropagators(propagation.TraceContext{}),
+	}, opts...)...)))
+	healthServer := health.NewServer()
+	healthServer.SetServingStatus("test", healthpb.HealthCheckResponse_SERVING)
+	healthpb.RegisterHealthServer(server, healthServer)
+	go func() { _ = server.Serve(listener) }()
+	t.Cleanup(server.Stop)
+
+	conn, err := grpc.Dial(
+		"bufnet",
+		grpc.WithContextDialer(func(context.Context, string) (net.Conn, error) {
+			return listener.Dial()
+		}),
+		grpc.WithInsecure(),
+		grpc.WithStatsHandler(NewClientHandler(append([]Option{
+			WithTracerProvider(oteltest.NewTracerProvider(oteltest.WithSpanRecorder(p.clientSpans))),
+			WithMeterProvider(clientMP),
+			WithPropagators(propagation.TraceContext{}),
+		}, opts...)...)),
+	)
+	require.NoError(t, err)
+	p.client = healthpb.NewHealthClient(conn)
+	// The server handles the end of an RPC after the client received its
+	// response, the server is stopped gracefully to wait for it.
+	p.stop = func() {
+		_ = conn.Close()
+		server.GracefulStop()
+	}
+	return p
+}
+
+func measured(impl *oteltest.MeterImpl, name string) []oteltest.Measured {
+	var r []oteltest.Measured
+	for _, m := range oteltest.AsStructs(impl.MeasurementBatches) {
+		if m.Name == name {
+			r = append(r, m)
+		}
+	}
+	return r
+}
+
+func TestUnaryRPC(t *testing.T) {
+	p := newTestPipeline(t)
+
+	res, err := p.client.Check(context.Background(), &healthpb.HealthCheckRequest{Service: "test"})
+	require.NoError(t, err)
+	assert.Equal(t, healthpb.HealthCheckResponse_SERVING, res.Status)
+	p.stop()
+
+	clientSpans, serverSpans := p.clientSpans.Completed(), p.serverSpans.Completed()
+	require.Len(t, clientSpans, 1)
+	require.Len(t, serverSpans, 1)
+	clientSpan, serverSpan := clientSpans[0], serverSpans[0]
+
+	for _, span := range []*oteltest.Span{clientSpan, serverSpan} {
+		assert.Equal(t, "grpc.health.v1.Health/Check", span.Name())
+		attrs := span.Attributes()
+		assert.Equal(t, label.StringValue("grpc"), attrs[semconv.RPCSystemKey])
+		assert.Equal(t, label.StringValue("grpc.health.v1.Health"), attrs[semconv.RPCServiceKey])
+		assert.Equal(t, label.StringValue("Check"), attrs[semconv.RPCMethodKey])
+		assert.Equal(t, label.Int64Value(0), attrs[semconv.RPCGRPCStatusCodeKey])
+		assert.Equal(t, codes.Unset, span.StatusCode())
+
+		events := span.Events()
+		require.Len(t, events, 2)
+		for _, e := range events {
+			assert.Equal(t, "message", e.Name)
+			assert.Equal(t, label.Int64Value(1), e.Attributes[semconv.RPCMessageIDKey])
+		}
+	}
+	assert.Equal(t, trace.SpanKindClient, clientSpan.SpanKind())
+	assert.Equal(t, trace.SpanKindServer, serverSpan.SpanKind())
+	assert.Equal(t, clientSpan.SpanContext().TraceID, serverSpan.SpanContext().TraceID)
+	assert.Equal(t, clientSpan.SpanContext().SpanID, serverSpan.ParentSpanID())
+	assert.Equal(t, semconv.RPCMessageTypeSent.Value, clientSpan.Events()[0].Attributes[semconv.RPCMessageTypeKey])
+	assert.Equal(t, semconv.RPCMessageTypeReceived.Value, serverSpan.Events()[0].Attributes[semconv.RPCMessageTypeKey])
+
+	for prefix, impl := range map[string]*oteltest.MeterImpl{
+		"rpc.client": p.clientMeter,
+		"rpc.server": p.serverMeter,
+	} {
+		duration := measured(impl, prefix+".duration")
+		require.Len(t, duration, 1, prefix)
+		assert.Equal(t, label.Int64Value(0), duration[0].Labels[semconv.RPCGRPCStatusCodeKey])
+		assert.Equal(t, label.StringValue("Check"), duration[0].Labels[semconv.RPCMethodKey])
+		assert.Len(t, measured(impl, prefix+".request.size"), 1, prefix)
+		assert.Len(t, measured(impl, prefix+".response.size"), 1, prefix)
+	}
+}
+
+func TestUnaryRPCError(t *testing.T) {
+	p := newTestPipeline(t, WithoutMessageEvents())
+
+	_, err := p.client.Check(context.Background(), &healthpb.HealthCheckRequest{Service: "unknown"})
+	require.Equal(t, grpccodes.NotFound, status.Code(err))
+	p.stop()
+
+	clientSpans, serverSpans := p.clientSpans.Completed(), p.serverSpans.Completed()
+	require.Len(t, clientSpans, 1)
+	require.Len(t, serverSpans, 1)
+	clientSpan, serverSpan := clientSpans[0], serverSpans[0]
+
+	assert.Equal(t, codes.Error, clientSpan.StatusCode())
+	// NotFound is an error of the client, the server span status is unset.
+	assert.Equal(t, codes.Unset, serverSpan.StatusCode())
+	for _, span := range []*oteltest.Span{clientSpan, serverSpan} {
+		assert.Equal(t, label.Int64Value(int64(grpccodes.NotFound)), span.Attributes()[semconv.RPCGRPCStatusCodeKey])
+		// The only event is the recorded error.
+		require.Len(t, span.Events(), 1)
+		assert.Equal(t, "error", span.Events()[0].Name)
+	}
+}
+
+func TestSpanInfo(t *testing.T) {
+	name, labels := spanInfo("/foo.Bar/Baz")
+	assert.Equal(t, "foo.Bar/Baz", name)
+	assert.Equal(t, []label.KeyValue{
+		semconv.RPCSystemGRPC,
+		semconv.RPCServiceKey.String("foo.Bar"),
+		semconv.RPCMethodKey.String("Baz"),
+	}, labels)
+
+	name, labels = spanInfo("invalid")
+	assert.Equal(t, "invalid", name)
+	assert.Equal(t, []label.KeyValue{semconv.RPCSystemGRPC}, labels)
+}
+
+func TestPeerAttributes(t *testing.T) {
+	assert.Equal(t, []label.KeyValue{
+		semconv.NetPeerIPKey.String("127.0.0.1"),
+		semconv.NetPeerPortKey.Int(8080),
+	}, peerAttributes(&net.TCPAddr{IP: net.IPv4(127, 0, 0, 1), Port: 8080}))
+	assert.Nil(t, peerAttributes(nil))
+}
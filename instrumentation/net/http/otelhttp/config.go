@@ -0,0 +1,149 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package otelhttp // import "go.opentelemetry.io/otel/instrumentation/net/http/otelhttp"
+
+import (
+	"net/http"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/metric"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// instrumentationName is the name of the Tracer and Meter of the
+// instrumentation.
+const instrumentationName = "go.opentelemetry.io/otel/instrumentation/net/http/otelhttp"
+
+// Filter is a predicate used to determine whether a given http.Request
+// should be traced and measured. A Filter must return true if the request
+// should be traced.
+type Filter func(*http.Request) bool
+
+// config represents the configuration options available for the
+// http.Handler and http.RoundTripper types.
+type config struct {
+	TracerProvider    trace.TracerProvider
+	MeterProvider     metric.MeterProvider
+	Propagators       propagation.TextMapPropagator
+	SpanStartOptions  []trace.SpanOption
+	PublicEndpoint    bool
+	Filters           []Filter
+	SpanNameFormatter func(operation string, r *http.Request) string
+}
+
+// Option applies a configuration value.
+type Option func(*config)
+
+// newConfig creates a new config struct and applies opts to it.
+func newConfig(opts ...Option) *config {
+	c := &config{
+		TracerProvider: otel.GetTracerProvider(),
+		MeterProvider:  otel.GetMeterProvider(),
+		Propagators:    otel.GetTextMapPropagator(),
+	}
+	for _, opt := range opts {
+		opt(c)
+	}
+	return c
+}
+
+// tracer returns the Tracer of the instrumentation.
+func (c *config) tracer() trace.Tracer {
+	return c.TracerProvider.Tracer(
+		instrumentationName,
+		trace.WithInstrumentationVersion(otel.Version()),
+	)
+}
+
+// meter returns the Meter of the instrumentation.
+func (c *config) meter() metric.Meter {
+	return c.MeterProvider.Meter(
+		instrumentationName,
+		metric.WithInstrumentationVersion(otel.Version()),
+	)
+}
+
+// filter reports whether all the filters accept r.
+func (c *config) filter(r *http.Request) bool {
+	for _, f := range c.Filters {
+		if !f(r) {
+			return false
+		}
+	}
+	return true
+}
+
+// WithTracerProvider specifies a tracer provider to use for creating a
+// tracer. If none is specified, the global provider is used.
+func WithTracerProvider(provider trace.TracerProvider) Option {
+	return func(c *config) {
+		c.TracerProvider = provider
+	}
+}
+
+// WithMeterProvider specifies a meter provider to use for creating a meter.
+// If none is specified, the global provider is used.
+func WithMeterProvider(provider metric.MeterProvider) Option {
+	return func(c *config) {
+		c.MeterProvider = provider
+	}
+}
+
+// WithPropagators configures specific propagators. If this option isn't
+// specified, then the global TextMapPropagator is used.
+func WithPropagators(propagators propagation.TextMapPropagator) Option {
+	return func(c *config) {
+		c.Propagators = propagators
+	}
+}
+
+// WithSpanOptions configures additional SpanOptions to use when starting
+// the spans.
+func WithSpanOptions(opts ...trace.SpanOption) Option {
+	return func(c *config) {
+		c.SpanStartOptions = append(c.SpanStartOptions, opts...)
+	}
+}
+
+// WithPublicEndpoint configures the Handler to link the span with an
+// incoming span context, rather than to make it a child, as a public
+// endpoint should not trust the span context of its callers.
+func WithPublicEndpoint() Option {
+	return func(c *config) {
+		c.PublicEndpoint = true
+	}
+}
+
+// WithFilter adds a filter to the list of filters used by the Handler and
+// the Transport. If any filter indicates to exclude a request then the
+// request will not be traced nor measured. All filters must allow a
+// request to be traced for a span to be created. If no filters are
+// provided then all requests are traced. Filters will be invoked for each
+// processed request, it is advised to make them simple and fast.
+func WithFilter(f Filter) Option {
+	return func(c *config) {
+		c.Filters = append(c.Filters, f)
+	}
+}
+
+// WithSpanNameFormatter takes a function that will be called on every
+// request and the returned string will become the span name. The
+// operation is the one passed to NewHandler, or empty for the Transport.
+func WithSpanNameFormatter(f func(operation string, r *http.Request) string) Option {
+	return func(c *config) {
+		c.SpanNameFormatter = f
+	}
+}
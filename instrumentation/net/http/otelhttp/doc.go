@@ -0,0 +1,33 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package otelhttp provides an http.Handler and an http.RoundTripper
+// instrumenting HTTP servers and clients with OpenTelemetry.
+//
+// NewHandler wraps an http.Handler: every request it serves is traced by a
+// server span, continuing the trace propagated in the request headers, and
+// measured by the http.server.duration and http.server.active_requests
+// instruments.
+//
+// NewTransport wraps an http.RoundTripper: every request it sends is traced
+// by a client span, the context of which is propagated in the request
+// headers, and measured by the http.client.duration and
+// http.client.active_requests instruments. The client span ends when the
+// response body is read to its end or closed.
+//
+// The spans and measurements are reported to the global TracerProvider and
+// MeterProvider unless other ones are set with WithTracerProvider and
+// WithMeterProvider, and the context is propagated with the global
+// TextMapPropagator unless another one is set with WithPropagators.
+package otelhttp // import "go.opentelemetry.io/otel/instrumentation/net/http/otelhttp"
@@ -0,0 +1,10 @@
+module go.opentelemetry.io/otel/instrumentation/net/http/otelhttp
+
+go 1.14
+
+replace go.opentelemetry.io/otel => ../../../..
+
+require (
+	github.com/stretchr/testify v1.7.0
+	go.opentelemetry.io/otel v0.16.0
+)
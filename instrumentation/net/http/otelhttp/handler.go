@@ -0,0 +1,146 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package otelhttp // import "go.opentelemetry.io/otel/instrumentation/net/http/otelhttp"
+
+import (
+	"net/http"
+	"time"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/label"
+	"go.opentelemetry.io/otel/metric"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/semconv"
+	"go.opentelemetry.io/otel/trace"
+	"go.opentelemetry.io/otel/unit"
+)
+
+var _ http.Handler = &Handler{}
+
+// Handler is an http.Handler which wraps an http.Handler with tracing and
+// metrics of the requests it serves. The incoming span context is
+// extracted from the request headers and the server span is available to
+// the wrapped handler in the request context.
+type Handler struct {
+	operation string
+	handler   http.Handler
+	config    *config
+	tracer    trace.Tracer
+
+	duration       metric.Float64ValueRecorder
+	activeRequests metric.Int64UpDownCounter
+}
+
+// NewHandler wraps the passed handler in a Handler named for the
+// operation, the name of its spans unless WithSpanNameFormatter is used
+// and the value of their http.server_name attribute.
+func NewHandler(handler http.Handler, operation string, opts ...Option) *Handler {
+	c := newConfig(opts...)
+	h := &Handler{
+		operation: operation,
+		handler:   handler,
+		config:    c,
+		tracer:    c.tracer(),
+	}
+
+	var err error
+	meter := c.meter()
+	if h.duration, err = meter.NewFloat64ValueRecorder(
+		"http.server.duration",
+		metric.WithUnit(unit.Milliseconds),
+		metric.WithDescription("Duration of the inbound HTTP requests"),
+	); err != nil {
+		otel.Handle(err)
+	}
+	if h.activeRequests, err = meter.NewInt64UpDownCounter(
+		"http.server.active_requests",
+		metric.WithDescription("Number of inbound HTTP requests in flight"),
+	); err != nil {
+		otel.Handle(err)
+	}
+	return h
+}
+
+// ServeHTTP serves HTTP requests (http.Handler).
+func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if !h.config.filter(r) {
+		h.handler.ServeHTTP(w, r)
+		return
+	}
+
+	start := time.Now()
+	ctx := h.config.Propagators.Extract(r.Context(), propagation.MIMEHeaderCarrier(r.Header))
+
+	opts := append([]trace.SpanOption{
+		trace.WithAttributes(semconv.HTTPServerAttributes(h.operation, "", r, 0)...),
+		trace.WithAttributes(semconv.EndUserAttributesFromHTTPRequest(r)...),
+		trace.WithSpanKind(trace.SpanKindServer),
+	}, h.config.SpanStartOptions...)
+	if h.config.PublicEndpoint {
+		// The span is linked to the extracted span context by the
+		// tracer.
+		opts = append(opts, trace.WithNewRoot())
+	}
+
+	ctx, span := h.tracer.Start(ctx, h.spanName(r), opts...)
+	defer span.End()
+
+	labels := semconv.HTTPServerMetricAttributesFromHTTPRequest(h.operation, r)
+	h.activeRequests.Add(ctx, 1, labels...)
+	defer h.activeRequests.Add(ctx, -1, labels...)
+
+	rww := &respWriterWrapper{ResponseWriter: w}
+	h.handler.ServeHTTP(rww, r.WithContext(ctx))
+
+	statusCode := rww.statusCode
+	if !rww.wroteHeader {
+		// The handler returned without writing anything, the server
+		// responds with a 200 OK status.
+		statusCode = http.StatusOK
+	}
+	span.SetAttributes(semconv.HTTPAttributesFromHTTPStatusCode(statusCode)...)
+	if rww.written > 0 {
+		span.SetAttributes(semconv.HTTPResponseContentLengthKey.Int64(rww.written))
+	}
+	span.SetStatus(semconv.SpanStatusFromHTTPServerStatusCode(statusCode))
+
+	h.duration.Record(ctx, elapsedMilliseconds(start), statusLabels(labels, statusCode)...)
+}
+
+func (h *Handler) spanName(r *http.Request) string {
+	if h.config.SpanNameFormatter != nil {
+		return h.config.SpanNameFormatter(h.operation, r)
+	}
+	return h.operation
+}
+
+// WithRouteTag annotates the server span of the requests handled by h with
+// the http.route attribute set to route.
+func WithRouteTag(route string, h http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		trace.SpanFromContext(r.Context()).SetAttributes(semconv.HTTPRouteKey.String(route))
+		h.ServeHTTP(w, r)
+	})
+}
+
+// elapsedMilliseconds returns the milliseconds elapsed since start.
+func elapsedMilliseconds(start time.Time) float64 {
+	return float64(time.Since(start)) / float64(time.Millisecond)
+}
+
+// statusLabels returns labels with the status code label appended.
+func statusLabels(labels []label.KeyValue, statusCode int) []label.KeyValue {
+	return append(labels[:len(labels):len(labels)], semconv.HTTPStatusCodeKey.Int(statusCode))
+}
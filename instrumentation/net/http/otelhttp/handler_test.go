@@ -0,0 +1,196 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package otelhttp
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/label"
+	"go.opentelemetry.io/otel/oteltest"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/semconv"
+	"go.opentelemetry.io/otel/trace"
+)
+
+func measured(impl *oteltest.MeterImpl, name string) []oteltest.Measured {
+	var r []oteltest.Measured
+	for _, m := range oteltest.AsStructs(impl.MeasurementBatches) {
+		if m.Name == name {
+			r = append(r, m)
+		}
+	}
+	return r
+}
+
+func TestHandler(t *testing.T) {
+	sr := new(oteltest.StandardSpanRecorder)
+	tp := oteltest.NewTracerProvider(oteltest.WithSpanRecorder(sr))
+	impl, mp := oteltest.NewMeterProvider()
+
+	var handlerSpan trace.Span
+	h := NewHandler(
+		http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			handlerSpan = trace.SpanFromContext(r.Context())
+			w.WriteHeader(http.StatusTeapot)
+			_, _ = io.WriteString(w, "hello")
+		}),
+		"server",
+		WithTracerProvider(tp),
+		WithMeterProvider(mp),
+		WithPropagators(propagation.TraceContext{}),
+	)
+
+	remote := trace.SpanContext{
+		TraceID:    trace.TraceID{0x01},
+		SpanID:     trace.SpanID{0x02},
+		TraceFlags: trace.FlagsSampled,
+	}
+	r := httptest.NewRequest(http.MethodGet, "http://localhost/hello", nil)
+	r.Header.Set("traceparent", "00-"+remote.TraceID.String()+"-"+remote.SpanID.String()+"-01")
+	w := httptest.NewRecorder()
+	h.ServeHTTP(w, r)
+
+	assert.Equal(t, http.StatusTeapot, w.Code)
+	assert.Equal(t, "hello", w.Body.String())
+
+	spans := sr.Completed()
+	require.Len(t, spans, 1)
+	span := spans[0]
+	assert.Same(t, span, handlerSpan)
+	assert.Equal(t, "server", span.Name())
+	assert.Equal(t, trace.SpanKindServer, span.SpanKind())
+	assert.Equal(t, remote.TraceID, span.SpanContext().TraceID)
+	assert.Equal(t, remote.SpanID, span.ParentSpanID())
+	attrs := span.Attributes()
+	assert.Equal(t, label.StringValue(http.MethodGet), attrs[semconv.HTTPMethodKey])
+	assert.Equal(t, label.StringValue("server"), attrs[semconv.HTTPServerNameKey])
+	assert.Equal(t, label.IntValue(http.StatusTeapot), attrs[semconv.HTTPStatusCodeKey])
+	assert.Equal(t, label.Int64Value(5), attrs[semconv.HTTPResponseContentLengthKey])
+	// 4xx responses leave the status of server spans unset.
+	assert.Equal(t, codes.Unset, span.StatusCode())
+
+	active := measured(impl, "http.server.active_requests")
+	require.Len(t, active, 2)
+	assert.Equal(t, int64(1), active[0].Number.AsInt64())
+	assert.Equal(t, int64(-1), active[1].Number.AsInt64())
+	duration := measured(impl, "http.server.duration")
+	require.Len(t, duration, 1)
+	assert.Equal(t, label.IntValue(http.StatusTeapot), duration[0].Labels[semconv.HTTPStatusCodeKey])
+	assert.Equal(t, label.StringValue("server"), duration[0].Labels[semconv.HTTPServerNameKey])
+}
+
+func TestHandlerDefaultStatus(t *testing.T) {
+	sr := new(oteltest.StandardSpanRecorder)
+	h := NewHandler(
+		http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}),
+		"server",
+		WithTracerProvider(oteltest.NewTracerProvider(oteltest.WithSpanRecorder(sr))),
+	)
+	h.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, "/", nil))
+
+	spans := sr.Completed()
+	require.Len(t, spans, 1)
+	assert.Equal(t, label.IntValue(http.StatusOK), spans[0].Attributes()[semconv.HTTPStatusCodeKey])
+}
+
+func TestHandlerServerError(t *testing.T) {
+	sr := new(oteltest.StandardSpanRecorder)
+	h := NewHandler(
+		http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			http.Error(w, "oops", http.StatusInternalServerError)
+		}),
+		"server",
+		WithTracerProvider(oteltest.NewTracerProvider(oteltest.WithSpanRecorder(sr))),
+	)
+	h.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, "/", nil))
+
+	spans := sr.Completed()
+	require.Len(t, spans, 1)
+	assert.Equal(t, codes.Error, spans[0].StatusCode())
+}
+
+func TestHandlerPublicEndpoint(t *testing.T) {
+	sr := new(oteltest.StandardSpanRecorder)
+	h := NewHandler(
+		http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}),
+		"server",
+		WithTracerProvider(oteltest.NewTracerProvider(oteltest.WithSpanRecorder(sr))),
+		WithPropagators(propagation.TraceContext{}),
+		WithPublicEndpoint(),
+	)
+
+	remote := trace.SpanContext{
+		TraceID:    trace.TraceID{0x01},
+		SpanID:     trace.SpanID{0x02},
+		TraceFlags: trace.FlagsSampled,
+	}
+	r := httptest.NewRequest(http.MethodGet, "/", nil)
+	r.Header.Set("traceparent", "00-"+remote.TraceID.String()+"-"+remote.SpanID.String()+"-01")
+	h.ServeHTTP(httptest.NewRecorder(), r)
+
+	spans := sr.Completed()
+	require.Len(t, spans, 1)
+	assert.NotEqual(t, remote.TraceID, spans[0].SpanContext().TraceID)
+	require.Len(t, spans[0].Links(), 1)
+	assert.Equal(t, remote.SpanID, spans[0].Links()[0].SpanID)
+}
+
+func TestHandlerFilterAndSpanName(t *testing.T) {
+	sr := new(oteltest.StandardSpanRecorder)
+	h := NewHandler(
+		http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}),
+		"server",
+		WithTracerProvider(oteltest.NewTracerProvider(oteltest.WithSpanRecorder(sr))),
+		WithFilter(func(r *http.Request) bool { return r.URL.Path != "/health" }),
+		WithSpanNameFormatter(func(operation string, r *http.Request) string {
+			return operation + " " + r.URL.Path
+		}),
+	)
+	h.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, "/health", nil))
+	h.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, "/users", nil))
+
+	spans := sr.Completed()
+	require.Len(t, spans, 1)
+	assert.Equal(t, "server /users", spans[0].Name())
+}
+
+func TestWithRouteTag(t *testing.T) {
+	sr := new(oteltest.StandardSpanRecorder)
+	h := NewHandler(
+		WithRouteTag("/users/{id}", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {})),
+		"server",
+		WithTracerProvider(oteltest.NewTracerProvider(oteltest.WithSpanRecorder(sr))),
+	)
+	h.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, "/users/1", nil))
+
+	spans := sr.Completed()
+	require.Len(t, spans, 1)
+	assert.Equal(t, label.StringValue("/users/{id}"), spans[0].Attributes()[semconv.HTTPRouteKey])
+}
+
+func TestRespWriterWrapperFlush(t *testing.T) {
+	w := httptest.NewRecorder()
+	rww := &respWriterWrapper{ResponseWriter: w}
+	rww.Flush()
+	assert.True(t, w.Flushed)
+	assert.Equal(t, http.StatusOK, rww.statusCode)
+}
@@ -0,0 +1,170 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package otelhttp // import "go.opentelemetry.io/otel/instrumentation/net/http/otelhttp"
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/label"
+	"go.opentelemetry.io/otel/metric"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/semconv"
+	"go.opentelemetry.io/otel/trace"
+	"go.opentelemetry.io/otel/unit"
+)
+
+// DefaultClient is an http.Client with a Transport wrapping
+// http.DefaultTransport.
+var DefaultClient = &http.Client{Transport: NewTransport(http.DefaultTransport)}
+
+var _ http.RoundTripper = &Transport{}
+
+// Transport implements the http.RoundTripper interface and wraps outbound
+// HTTP requests with a client span and metrics. The span context is
+// injected in the headers of the requests.
+type Transport struct {
+	rt     http.RoundTripper
+	config *config
+	tracer trace.Tracer
+
+	duration       metric.Float64ValueRecorder
+	activeRequests metric.Int64UpDownCounter
+}
+
+// NewTransport wraps the provided http.RoundTripper with one that starts a
+// span and injects the span context into the outbound request headers.
+//
+// If the provided http.RoundTripper is nil, http.DefaultTransport will be
+// used as the base http.RoundTripper.
+func NewTransport(base http.RoundTripper, opts ...Option) *Transport {
+	if base == nil {
+		base = http.DefaultTransport
+	}
+	c := newConfig(opts...)
+	t := &Transport{
+		rt:     base,
+		config: c,
+		tracer: c.tracer(),
+	}
+
+	var err error
+	meter := c.meter()
+	if t.duration, err = meter.NewFloat64ValueRecorder(
+		"http.client.duration",
+		metric.WithUnit(unit.Milliseconds),
+		metric.WithDescription("Duration of the outbound HTTP requests until their response headers are received"),
+	); err != nil {
+		otel.Handle(err)
+	}
+	if t.activeRequests, err = meter.NewInt64UpDownCounter(
+		"http.client.active_requests",
+		metric.WithDescription("Number of outbound HTTP requests in flight"),
+	); err != nil {
+		otel.Handle(err)
+	}
+	return t
+}
+
+// RoundTrip creates a Span and propagates its context via the provided
+// request's headers before handing the request to the configured base
+// RoundTripper. The created span will end when the response body is
+// closed or when a RoundTrip error is encountered.
+func (t *Transport) RoundTrip(r *http.Request) (*http.Response, error) {
+	if !t.config.filter(r) {
+		return t.rt.RoundTrip(r)
+	}
+
+	start := time.Now()
+	opts := append([]trace.SpanOption{
+		trace.WithAttributes(semconv.HTTPClientAttributes(r, nil)...),
+		trace.WithSpanKind(trace.SpanKindClient),
+	}, t.config.SpanStartOptions...)
+	ctx, span := t.tracer.Start(r.Context(), t.spanName(r), opts...)
+
+	// The request must not be modified by a RoundTripper, the span
+	// context is injected in the headers of a copy of it.
+	r = r.Clone(ctx)
+	t.config.Propagators.Inject(ctx, propagation.MIMEHeaderCarrier(r.Header))
+
+	labels := clientMetricLabels(r)
+	t.activeRequests.Add(ctx, 1, labels...)
+	res, err := t.rt.RoundTrip(r)
+	t.activeRequests.Add(ctx, -1, labels...)
+
+	if err != nil {
+		t.duration.Record(ctx, elapsedMilliseconds(start), labels...)
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		span.End()
+		return res, err
+	}
+
+	t.duration.Record(ctx, elapsedMilliseconds(start), statusLabels(labels, res.StatusCode)...)
+	span.SetAttributes(semconv.HTTPAttributesFromHTTPStatusCode(res.StatusCode)...)
+	if res.ContentLength > 0 {
+		span.SetAttributes(semconv.HTTPResponseContentLengthKey.Int64(res.ContentLength))
+	}
+	span.SetStatus(semconv.SpanStatusFromHTTPStatusCode(res.StatusCode))
+
+	if res.Body == nil || res.Body == http.NoBody {
+		span.End()
+		return res, nil
+	}
+	res.Body = newWrappedBody(res.Body, func(err error) {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Error())
+		}
+		span.End()
+	})
+	return res, nil
+}
+
+func (t *Transport) spanName(r *http.Request) string {
+	if t.config.SpanNameFormatter != nil {
+		return t.config.SpanNameFormatter("", r)
+	}
+	return "HTTP " + r.Method
+}
+
+// clientMetricLabels returns the low-cardinality labels of the client
+// metrics of r: the method, scheme and host of the request.
+func clientMetricLabels(r *http.Request) []label.KeyValue {
+	method := r.Method
+	if method == "" {
+		method = http.MethodGet
+	}
+	labels := []label.KeyValue{
+		semconv.HTTPMethodKey.String(method),
+		semconv.HTTPSchemeKey.String(r.URL.Scheme),
+	}
+	if r.URL.Host != "" {
+		labels = append(labels, semconv.HTTPHostKey.String(r.URL.Host))
+	}
+	return labels
+}
+
+// Get issues a GET to the specified URL with ctx using DefaultClient.
+func Get(ctx context.Context, url string) (*http.Response, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, err
+	}
+	return DefaultClient.Do(req)
+}
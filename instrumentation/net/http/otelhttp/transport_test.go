@@ -0,0 +1,164 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package otelhttp
+
+import (
+	"context"
+	"errors"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/label"
+	"go.opentelemetry.io/otel/oteltest"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/semconv"
+	"go.opentelemetry.io/otel/trace"
+)
+
+type roundTripperFunc func(*http.Request) (*http.Response, error)
+
+func (f roundTripperFunc) RoundTrip(r *http.Request) (*http.Response, error) {
+	return f(r)
+}
+
+func TestTransport(t *testing.T) {
+	sr := new(oteltest.StandardSpanRecorder)
+	impl, mp := oteltest.NewMeterProvider()
+
+	var propagated trace.SpanContext
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := propagation.TraceContext{}.Extract(r.Context(), propagation.MIMEHeaderCarrier(r.Header))
+		propagated = trace.RemoteSpanContextFromContext(ctx)
+		_, _ = io.WriteString(w, "hello")
+	}))
+	defer ts.Close()
+
+	client := &http.Client{Transport: NewTransport(
+		nil,
+		WithTracerProvider(oteltest.NewTracerProvider(oteltest.WithSpanRecorder(sr))),
+		WithMeterProvider(mp),
+		WithPropagators(propagation.TraceContext{}),
+	)}
+	req, err := http.NewRequest(http.MethodGet, ts.URL, nil)
+	require.NoError(t, err)
+	res, err := client.Do(req)
+	require.NoError(t, err)
+	assert.Empty(t, req.Header, "the request must not be modified")
+
+	// The span ends when the body is read to its end.
+	assert.Empty(t, sr.Completed())
+	body, err := ioutil.ReadAll(res.Body)
+	require.NoError(t, err)
+	assert.Equal(t, "hello", string(body))
+	require.NoError(t, res.Body.Close())
+
+	spans := sr.Completed()
+	require.Len(t, spans, 1)
+	span := spans[0]
+	assert.Equal(t, "HTTP GET", span.Name())
+	assert.Equal(t, trace.SpanKindClient, span.SpanKind())
+	assert.Equal(t, span.SpanContext().TraceID, propagated.TraceID)
+	assert.Equal(t, span.SpanContext().SpanID, propagated.SpanID)
+	attrs := span.Attributes()
+	assert.Equal(t, label.StringValue(ts.URL), attrs[semconv.HTTPURLKey])
+	assert.Equal(t, label.IntValue(http.StatusOK), attrs[semconv.HTTPStatusCodeKey])
+	assert.Equal(t, codes.Unset, span.StatusCode())
+
+	active := measured(impl, "http.client.active_requests")
+	require.Len(t, active, 2)
+	assert.Equal(t, int64(1), active[0].Number.AsInt64())
+	assert.Equal(t, int64(-1), active[1].Number.AsInt64())
+	duration := measured(impl, "http.client.duration")
+	require.Len(t, duration, 1)
+	assert.Equal(t, label.IntValue(http.StatusOK), duration[0].Labels[semconv.HTTPStatusCodeKey])
+	assert.Equal(t, label.StringValue(http.MethodGet), duration[0].Labels[semconv.HTTPMethodKey])
+	assert.NotContains(t, duration[0].Labels, semconv.HTTPURLKey)
+}
+
+func TestTransportError(t *testing.T) {
+	sr := new(oteltest.StandardSpanRecorder)
+	errRoundTrip := errors.New("connection refused")
+	tr := NewTransport(
+		roundTripperFunc(func(*http.Request) (*http.Response, error) {
+			return nil, errRoundTrip
+		}),
+		WithTracerProvider(oteltest.NewTracerProvider(oteltest.WithSpanRecorder(sr))),
+	)
+
+	req := httptest.NewRequest(http.MethodGet, "http://localhost/", nil)
+	_, err := tr.RoundTrip(req)
+	assert.Equal(t, errRoundTrip, err)
+
+	spans := sr.Completed()
+	require.Len(t, spans, 1)
+	assert.Equal(t, codes.Error, spans[0].StatusCode())
+	assert.Equal(t, errRoundTrip.Error(), spans[0].StatusMessage())
+}
+
+func TestTransportBodyClose(t *testing.T) {
+	sr := new(oteltest.StandardSpanRecorder)
+	tr := NewTransport(
+		roundTripperFunc(func(r *http.Request) (*http.Response, error) {
+			return &http.Response{
+				StatusCode: http.StatusNotFound,
+				Body:       ioutil.NopCloser(strings.NewReader("not found")),
+				Request:    r,
+			}, nil
+		}),
+		WithTracerProvider(oteltest.NewTracerProvider(oteltest.WithSpanRecorder(sr))),
+		WithSpanNameFormatter(func(_ string, r *http.Request) string {
+			return r.Method + " " + r.URL.Path
+		}),
+	)
+
+	req := httptest.NewRequest(http.MethodGet, "http://localhost/missing", nil)
+	res, err := tr.RoundTrip(req.WithContext(context.Background()))
+	require.NoError(t, err)
+	assert.Empty(t, sr.Completed())
+	require.NoError(t, res.Body.Close())
+	require.NoError(t, res.Body.Close())
+
+	spans := sr.Completed()
+	require.Len(t, spans, 1)
+	assert.Equal(t, "GET /missing", spans[0].Name())
+	assert.Equal(t, codes.Error, spans[0].StatusCode())
+}
+
+func TestTransportFilter(t *testing.T) {
+	sr := new(oteltest.StandardSpanRecorder)
+	var header http.Header
+	tr := NewTransport(
+		roundTripperFunc(func(r *http.Request) (*http.Response, error) {
+			header = r.Header
+			return &http.Response{StatusCode: http.StatusOK, Body: http.NoBody}, nil
+		}),
+		WithTracerProvider(oteltest.NewTracerProvider(oteltest.WithSpanRecorder(sr))),
+		WithPropagators(propagation.TraceContext{}),
+		WithFilter(func(*http.Request) bool { return false }),
+	)
+
+	_, err := tr.RoundTrip(httptest.NewRequest(http.MethodGet, "http://localhost/", nil))
+	require.NoError(t, err)
+	assert.Empty(t, sr.Started())
+	assert.Empty(t, header.Get("traceparent"))
+}
@@ -0,0 +1,110 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package otelhttp // import "go.opentelemetry.io/otel/instrumentation/net/http/otelhttp"
+
+import (
+	"io"
+	"net/http"
+	"sync"
+)
+
+var _ http.ResponseWriter = &respWriterWrapper{}
+
+// respWriterWrapper wraps a http.ResponseWriter in order to record the
+// status code and the number of bytes written.
+type respWriterWrapper struct {
+	http.ResponseWriter
+
+	written     int64
+	statusCode  int
+	wroteHeader bool
+}
+
+func (w *respWriterWrapper) Write(p []byte) (int, error) {
+	if !w.wroteHeader {
+		w.WriteHeader(http.StatusOK)
+	}
+	n, err := w.ResponseWriter.Write(p)
+	w.written += int64(n)
+	return n, err
+}
+
+func (w *respWriterWrapper) WriteHeader(statusCode int) {
+	if w.wroteHeader {
+		return
+	}
+	w.wroteHeader = true
+	w.statusCode = statusCode
+	w.ResponseWriter.WriteHeader(statusCode)
+}
+
+// Flush sends any buffered data to the client if the wrapped
+// http.ResponseWriter is an http.Flusher.
+func (w *respWriterWrapper) Flush() {
+	if !w.wroteHeader {
+		w.WriteHeader(http.StatusOK)
+	}
+	if f, ok := w.ResponseWriter.(http.Flusher); ok {
+		f.Flush()
+	}
+}
+
+// Unwrap returns the wrapped http.ResponseWriter, for the
+// http.ResponseController to access its other methods.
+func (w *respWriterWrapper) Unwrap() http.ResponseWriter {
+	return w.ResponseWriter
+}
+
+// wrappedBody calls onEnd once, when the response body it wraps is read to
+// its end, fails to be read or is closed.
+type wrappedBody struct {
+	body  io.ReadCloser
+	once  sync.Once
+	onEnd func(err error)
+}
+
+func newWrappedBody(body io.ReadCloser, onEnd func(err error)) io.ReadCloser {
+	wb := &wrappedBody{body: body, onEnd: onEnd}
+	if wr, ok := body.(io.Writer); ok {
+		// Keep the body an io.Writer, as it is for the responses of
+		// protocol switches.
+		return struct {
+			*wrappedBody
+			io.Writer
+		}{wb, wr}
+	}
+	return wb
+}
+
+func (wb *wrappedBody) Read(p []byte) (int, error) {
+	n, err := wb.body.Read(p)
+	switch err {
+	case nil:
+	case io.EOF:
+		wb.end(nil)
+	default:
+		wb.end(err)
+	}
+	return n, err
+}
+
+func (wb *wrappedBody) Close() error {
+	wb.end(nil)
+	return wb.body.Close()
+}
+
+func (wb *wrappedBody) end(err error) {
+	wb.once.Do(func() { wb.onEnd(err) })
+}
@@ -0,0 +1,90 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package runtime // import "go.opentelemetry.io/otel/instrumentation/runtime"
+
+import (
+	"sort"
+	"time"
+
+	"go.opentelemetry.io/otel/metric"
+)
+
+// DefaultMinimumReadMemStatsInterval is the default minimum interval
+// between calls to runtime.ReadMemStats.
+const DefaultMinimumReadMemStatsInterval time.Duration = 15 * time.Second
+
+// DefaultHistogramBoundaries are the default boundaries, in seconds, of the
+// runtime/metrics histograms: one per decade from a microsecond to a
+// second.
+var DefaultHistogramBoundaries = []float64{1e-6, 1e-5, 1e-4, 1e-3, 1e-2, 1e-1, 1}
+
+// config contains optional settings for reporting runtime metrics.
+type config struct {
+	// MinimumReadMemStatsInterval sets the minimum interval between
+	// calls to runtime.ReadMemStats().
+	MinimumReadMemStatsInterval time.Duration
+	// HistogramBoundaries are the sorted boundaries of the
+	// runtime/metrics histograms.
+	HistogramBoundaries []float64
+	// MeterProvider sets the metric.MeterProvider. If nil, the global
+	// Provider will be used.
+	MeterProvider metric.MeterProvider
+}
+
+// Option supports configuring optional settings for runtime metrics.
+type Option func(*config)
+
+// WithMinimumReadMemStatsInterval sets a minimum interval between calls
+// to runtime.ReadMemStats(), which is a relatively expensive call to make
+// frequently. A negative interval is ignored. The default is
+// DefaultMinimumReadMemStatsInterval.
+func WithMinimumReadMemStatsInterval(d time.Duration) Option {
+	return func(c *config) {
+		if d >= 0 {
+			c.MinimumReadMemStatsInterval = d
+		}
+	}
+}
+
+// WithHistogramBoundaries sets the boundaries, in seconds, the
+// runtime/metrics histograms are reported with. Fewer boundaries mean
+// fewer reported time series. The default is DefaultHistogramBoundaries.
+func WithHistogramBoundaries(boundaries ...float64) Option {
+	return func(c *config) {
+		c.HistogramBoundaries = append([]float64(nil), boundaries...)
+		sort.Float64s(c.HistogramBoundaries)
+	}
+}
+
+// WithMeterProvider sets the Metric implementation to use for reporting.
+// If this option is not used, the global metric.MeterProvider will be
+// used. `provider` must be non-nil.
+func WithMeterProvider(provider metric.MeterProvider) Option {
+	return func(c *config) {
+		c.MeterProvider = provider
+	}
+}
+
+// newConfig computes a config from the supplied Options.
+func newConfig(opts ...Option) config {
+	c := config{
+		MinimumReadMemStatsInterval: DefaultMinimumReadMemStatsInterval,
+		HistogramBoundaries:         DefaultHistogramBoundaries,
+	}
+	for _, opt := range opts {
+		opt(&c)
+	}
+	return c
+}
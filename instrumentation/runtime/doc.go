@@ -0,0 +1,47 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package runtime implements the conventional runtime metrics specified by
+// OpenTelemetry.
+//
+// The metrics are reported by observers registered with a Meter of the
+// global MeterProvider, or of the one set with WithMeterProvider, when
+// Start is called:
+//
+//	runtime.uptime (ms)               Milliseconds since the application was initialized
+//	runtime.go.goroutines             Number of goroutines that currently exist
+//	runtime.go.cgo.calls              Number of cgo calls made by the current process
+//	runtime.go.mem.heap_alloc (By)    Bytes of allocated heap objects
+//	runtime.go.mem.heap_idle (By)     Bytes in idle (unused) spans
+//	runtime.go.mem.heap_inuse (By)    Bytes in in-use spans
+//	runtime.go.mem.heap_objects       Number of allocated heap objects
+//	runtime.go.mem.heap_released (By) Bytes of idle spans whose physical memory has been returned to the OS
+//	runtime.go.mem.heap_sys (By)      Bytes of heap memory obtained from the OS
+//	runtime.go.mem.lookups            Number of pointer lookups performed by the runtime
+//	runtime.go.mem.live_objects       Number of live objects, the number of mallocs minus frees
+//	runtime.go.gc.count               Number of completed garbage collection cycles
+//	runtime.go.gc.pause_total_ns      Cumulative nanoseconds in GC stop-the-world pauses
+//	runtime.go.gc.pause_ns            Amount of nanoseconds in GC stop-the-world pauses
+//
+// The memory and garbage collection metrics are read with
+// runtime.ReadMemStats, which stops the world. WithMinimumReadMemStatsInterval
+// sets how often it may be called.
+//
+// With Go 1.16 or later, the histograms of the runtime/metrics package
+// measured in seconds, like /gc/pauses:seconds and /sched/latencies:seconds,
+// are also reported, as runtime.go.gc.pauses and runtime.go.sched.latencies
+// for example. They are cumulative counts of the values lower than or
+// equal to the "le" label, the boundaries of which are set with
+// WithHistogramBoundaries.
+package runtime // import "go.opentelemetry.io/otel/instrumentation/runtime"
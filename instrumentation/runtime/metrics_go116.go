@@ -0,0 +1,145 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// +build go1.16
+
+package runtime // import "go.opentelemetry.io/otel/instrumentation/runtime"
+
+import (
+	"context"
+	"math"
+	"runtime/metrics"
+	"strconv"
+	"strings"
+	"sync"
+
+	"go.opentelemetry.io/otel/label"
+	"go.opentelemetry.io/otel/metric"
+	"go.opentelemetry.io/otel/unit"
+)
+
+// leKey is the key of the label of the upper boundary of the cumulative
+// histogram counts.
+const leKey = label.Key("le")
+
+// registerRuntimeMetrics registers a batch observer reporting the
+// histograms of the runtime/metrics package measured in seconds.
+func (r *runtime) registerRuntimeMetrics() error {
+	var descs []metrics.Description
+	for _, d := range metrics.All() {
+		if d.Kind == metrics.KindFloat64Histogram && strings.HasSuffix(d.Name, ":seconds") {
+			descs = append(descs, d)
+		}
+	}
+	if len(descs) == 0 {
+		return nil
+	}
+
+	samples := make([]metrics.Sample, len(descs))
+	for i, d := range descs {
+		samples[i].Name = d.Name
+	}
+	bounds := r.config.HistogramBoundaries
+	leLabels := make([][]label.KeyValue, len(bounds)+1)
+	for i, b := range bounds {
+		leLabels[i] = []label.KeyValue{leKey.String(strconv.FormatFloat(b, 'g', -1, 64))}
+	}
+	leLabels[len(bounds)] = []label.KeyValue{leKey.String("+Inf")}
+
+	var (
+		instruments = make([]metric.Int64SumObserver, len(descs))
+		counts      = make([]uint64, len(bounds)+1)
+
+		// lock prevents a race between batch observer and instrument registration.
+		lock sync.Mutex
+	)
+
+	lock.Lock()
+	defer lock.Unlock()
+
+	batchObserver := r.meter.NewBatchObserver(func(_ context.Context, result metric.BatchObserverResult) {
+		lock.Lock()
+		defer lock.Unlock()
+
+		metrics.Read(samples)
+		for i, s := range samples {
+			if s.Value.Kind() != metrics.KindFloat64Histogram {
+				continue
+			}
+			cumulativeCounts(counts, bounds, s.Value.Float64Histogram())
+			for j, c := range counts {
+				result.Observe(leLabels[j], instruments[i].Observation(int64(c)))
+			}
+		}
+	})
+
+	for i, d := range descs {
+		var err error
+		if instruments[i], err = batchObserver.NewInt64SumObserver(
+			instrumentName(d.Name),
+			metric.WithUnit(unit.Unit("s")),
+			metric.WithDescription(d.Description),
+		); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// instrumentName returns the name of the instrument of a runtime/metrics
+// metric, e.g. runtime.go.gc.pauses for /gc/pauses:seconds.
+func instrumentName(name string) string {
+	if i := strings.IndexByte(name, ':'); i >= 0 {
+		name = name[:i]
+	}
+	return "runtime.go" + strings.ReplaceAll(name, "/", ".")
+}
+
+// cumulativeCounts sets counts, which has one more element than bounds,
+// to the cumulative counts of h lower than or equal to each of bounds, the
+// last element being the total count. The values of a bucket of h are
+// counted in the first boundary greater than or equal to the upper edge of
+// the bucket, so counts may overestimate the values lower than a
+// boundary within a bucket.
+func cumulativeCounts(counts []uint64, bounds []float64, h *metrics.Float64Histogram) {
+	for i := range counts {
+		counts[i] = 0
+	}
+	for i, c := range h.Counts {
+		upper := h.Buckets[i+1]
+		j := len(bounds)
+		if !math.IsInf(upper, 1) {
+			j = sortSearchFloat64s(bounds, upper)
+		}
+		counts[j] += c
+	}
+	for i := 1; i < len(counts); i++ {
+		counts[i] += counts[i-1]
+	}
+}
+
+// sortSearchFloat64s returns the index of the first of the sorted bounds
+// greater than or equal to v, or len(bounds) if there is none.
+func sortSearchFloat64s(bounds []float64, v float64) int {
+	lo, hi := 0, len(bounds)
+	for lo < hi {
+		m := int(uint(lo+hi) >> 1)
+		if bounds[m] < v {
+			lo = m + 1
+		} else {
+			hi = m
+		}
+	}
+	return lo
+}
@@ -0,0 +1,72 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// +build go1.16
+
+package runtime
+
+import (
+	"math"
+	"runtime/metrics"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.opentelemetry.io/otel/label"
+	"go.opentelemetry.io/otel/oteltest"
+)
+
+func TestInstrumentName(t *testing.T) {
+	assert.Equal(t, "runtime.go.gc.pauses", instrumentName("/gc/pauses:seconds"))
+	assert.Equal(t, "runtime.go.sched.latencies", instrumentName("/sched/latencies:seconds"))
+}
+
+func TestCumulativeCounts(t *testing.T) {
+	h := &metrics.Float64Histogram{
+		Counts:  []uint64{1, 2, 3, 4, 5},
+		Buckets: []float64{math.Inf(-1), 0.001, 0.005, 0.01, 0.5, math.Inf(1)},
+	}
+	counts := make([]uint64, 3)
+	cumulativeCounts(counts, []float64{0.001, 0.1}, h)
+	// [-Inf, 0.001) → 0.001, [0.001, 0.005) and [0.005, 0.01) → 0.1,
+	// [0.01, 0.5) and [0.5, +Inf) → +Inf.
+	assert.Equal(t, []uint64{1, 6, 15}, counts)
+
+	// The counts are reset on each call.
+	cumulativeCounts(counts, []float64{0.001, 0.1}, h)
+	assert.Equal(t, []uint64{1, 6, 15}, counts)
+}
+
+func TestRuntimeMetrics(t *testing.T) {
+	impl, provider := oteltest.NewMeterProvider()
+	require.NoError(t, Start(
+		WithMeterProvider(provider),
+		WithHistogramBoundaries(0.001, 1),
+	))
+
+	impl.RunAsyncInstruments()
+
+	var les []label.Value
+	for _, m := range oteltest.AsStructs(impl.MeasurementBatches) {
+		if m.Name == "runtime.go.gc.pauses" {
+			les = append(les, m.Labels[leKey])
+		}
+	}
+	assert.Equal(t, []label.Value{
+		label.StringValue("0.001"),
+		label.StringValue("1"),
+		label.StringValue("+Inf"),
+	}, les)
+}
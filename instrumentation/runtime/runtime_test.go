@@ -0,0 +1,125 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package runtime
+
+import (
+	"context"
+	goruntime "runtime"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.opentelemetry.io/otel/metric"
+	"go.opentelemetry.io/otel/oteltest"
+)
+
+func measuredNames(impl *oteltest.MeterImpl) map[string]int {
+	names := map[string]int{}
+	for _, m := range oteltest.AsStructs(impl.MeasurementBatches) {
+		names[m.Name]++
+	}
+	return names
+}
+
+func TestRuntime(t *testing.T) {
+	impl, provider := oteltest.NewMeterProvider()
+	require.NoError(t, Start(
+		WithMeterProvider(provider),
+		WithMinimumReadMemStatsInterval(0),
+	))
+
+	goruntime.GC()
+	impl.RunAsyncInstruments()
+
+	names := measuredNames(impl)
+	for _, name := range []string{
+		"runtime.uptime",
+		"runtime.go.goroutines",
+		"runtime.go.cgo.calls",
+		"runtime.go.mem.heap_alloc",
+		"runtime.go.mem.heap_idle",
+		"runtime.go.mem.heap_inuse",
+		"runtime.go.mem.heap_objects",
+		"runtime.go.mem.heap_released",
+		"runtime.go.mem.heap_sys",
+		"runtime.go.mem.lookups",
+		"runtime.go.mem.live_objects",
+		"runtime.go.gc.count",
+		"runtime.go.gc.pause_total_ns",
+		"runtime.go.gc.pause_ns",
+	} {
+		assert.Contains(t, names, name)
+	}
+
+	for _, m := range oteltest.AsStructs(impl.MeasurementBatches) {
+		assert.Equal(t, instrumentationName, m.InstrumentationName)
+		if m.Name == "runtime.go.goroutines" {
+			assert.Greater(t, m.Number.AsInt64(), int64(0))
+		}
+	}
+}
+
+func TestMinimumReadMemStatsInterval(t *testing.T) {
+	impl, provider := oteltest.NewMeterProvider()
+	require.NoError(t, Start(WithMeterProvider(provider)))
+
+	goruntime.GC()
+	impl.RunAsyncInstruments()
+	before := measuredNames(impl)["runtime.go.gc.pause_ns"]
+
+	// The memory statistics are not read again within the interval, so
+	// no new pauses are recorded.
+	goruntime.GC()
+	impl.RunAsyncInstruments()
+	assert.Equal(t, before, measuredNames(impl)["runtime.go.gc.pause_ns"])
+}
+
+func TestComputeGCPauses(t *testing.T) {
+	impl, provider := oteltest.NewMeterProvider()
+	recorder := metric.Must(provider.Meter("test")).NewInt64ValueRecorder("pauses")
+
+	circular := make([]uint64, 256)
+	for i := range circular {
+		circular[i] = uint64(i)
+	}
+	recorded := func(last, current uint32) []int64 {
+		impl.MeasurementBatches = nil
+		computeGCPauses(context.Background(), &recorder, circular, last, current)
+		var r []int64
+		for _, m := range oteltest.AsStructs(impl.MeasurementBatches) {
+			r = append(r, m.Number.AsInt64())
+		}
+		return r
+	}
+
+	assert.Empty(t, recorded(3, 3))
+	assert.Equal(t, []int64{3, 4}, recorded(3, 5))
+	assert.Equal(t, []int64{254, 255, 0, 1}, recorded(254, 258))
+	assert.Len(t, recorded(0, 300), 256)
+}
+
+func TestConfig(t *testing.T) {
+	c := newConfig()
+	assert.Equal(t, DefaultMinimumReadMemStatsInterval, c.MinimumReadMemStatsInterval)
+	assert.Equal(t, DefaultHistogramBoundaries, c.HistogramBoundaries)
+
+	c = newConfig(
+		WithMinimumReadMemStatsInterval(-1),
+		WithHistogramBoundaries(1, 0.5, 0.1),
+	)
+	assert.Equal(t, DefaultMinimumReadMemStatsInterval, c.MinimumReadMemStatsInterval)
+	assert.Equal(t, []float64{0.1, 0.5, 1}, c.HistogramBoundaries)
+}
@@ -17,16 +17,28 @@ package baggage
 
 import (
 	"context"
+	"strings"
 
 	"go.opentelemetry.io/otel/label"
 )
 
 type rawMap map[label.Key]label.Value
+type propertiesMap map[label.Key][]Property
 type keySet map[label.Key]struct{}
 
+// Property is a metadata entry of a baggage member. In the W3C Baggage
+// header it is encoded as ";key=value", or ";key" if HasValue is false.
+type Property struct {
+	Key   string
+	Value string
+	// HasValue is false for properties that are only a key.
+	HasValue bool
+}
+
 // Map is an immutable storage for correlations.
 type Map struct {
-	m rawMap
+	m     rawMap
+	props propertiesMap
 }
 
 // MapUpdate contains information about correlation changes to be
@@ -47,17 +59,24 @@ type MapUpdate struct {
 	// MultiKV contains all the key-value pairs to be added to
 	// correlations.
 	MultiKV []label.KeyValue
+
+	// Properties contains the properties of the key-value pairs added
+	// by SingleKV and MultiKV. Added keys without an entry have no
+	// properties, even if the key they overwrite had some. Entries for
+	// keys that are not added are ignored.
+	Properties map[label.Key][]Property
 }
 
-func newMap(raw rawMap) Map {
+func newMap(raw rawMap, props propertiesMap) Map {
 	return Map{
-		m: raw,
+		m:     raw,
+		props: props,
 	}
 }
 
 // NewEmptyMap creates an empty correlations map.
 func NewEmptyMap() Map {
-	return newMap(nil)
+	return newMap(nil, nil)
 }
 
 // NewMap creates a map with the contents of the update applied. In
@@ -75,6 +94,7 @@ func (m Map) Apply(update MapUpdate) Map {
 	mapSize := getNewMapSize(m.m, delSet, addSet)
 
 	r := make(rawMap, mapSize)
+	var p propertiesMap
 	for k, v := range m.m {
 		// do not copy items we want to drop
 		if _, ok := delSet[k]; ok {
@@ -85,6 +105,12 @@ func (m Map) Apply(update MapUpdate) Map {
 			continue
 		}
 		r[k] = v
+		if props, ok := m.props[k]; ok {
+			if p == nil {
+				p = make(propertiesMap)
+			}
+			p[k] = props
+		}
 	}
 	if update.SingleKV.Key.Defined() {
 		r[update.SingleKV.Key] = update.SingleKV.Value
@@ -92,10 +118,19 @@ func (m Map) Apply(update MapUpdate) Map {
 	for _, kv := range update.MultiKV {
 		r[kv.Key] = kv.Value
 	}
+	for k, props := range update.Properties {
+		if _, ok := addSet[k]; !ok || len(props) == 0 {
+			continue
+		}
+		if p == nil {
+			p = make(propertiesMap)
+		}
+		p[k] = append([]Property(nil), props...)
+	}
 	if len(r) == 0 {
 		r = nil
 	}
-	return newMap(r)
+	return newMap(r, p)
 }
 
 func getModificationSets(update MapUpdate) (delSet, addSet keySet) {
@@ -154,6 +189,16 @@ func (m Map) Value(k label.Key) (label.Value, bool) {
 	return value, ok
 }
 
+// Properties returns a copy of the properties of the member with key k,
+// or nil if it has none.
+func (m Map) Properties(k label.Key) []Property {
+	props, ok := m.props[k]
+	if !ok {
+		return nil
+	}
+	return append([]Property(nil), props...)
+}
+
 // HasValue returns a boolean value indicating whether the key exist
 // in the map.
 func (m Map) HasValue(k label.Key) bool {
@@ -180,6 +225,24 @@ func (m Map) Foreach(f func(label.KeyValue) bool) {
 	}
 }
 
+// ValidKey returns if key is a token as defined by RFC 7230, which is
+// the only form of key allowed by the W3C Baggage specification.
+func ValidKey(key string) bool {
+	if key == "" {
+		return false
+	}
+	for i := 0; i < len(key); i++ {
+		c := key[i]
+		switch {
+		case 'a' <= c && c <= 'z', 'A' <= c && c <= 'Z', '0' <= c && c <= '9':
+		case strings.IndexByte("!#$%&'*+-.^_`|~", c) >= 0:
+		default:
+			return false
+		}
+	}
+	return true
+}
+
 type correlationsType struct{}
 
 // SetHookFunc describes a type of a callback that is called when
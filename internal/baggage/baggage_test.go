@@ -281,5 +281,54 @@ func makeTestMap(ints []int) Map {
 	for _, v := range ints {
 		r[label.Key(fmt.Sprintf("key%d", v))] = label.IntValue(v)
 	}
-	return newMap(r)
+	return newMap(r, nil)
+}
+
+func TestMapProperties(t *testing.T) {
+	flag := Property{Key: "flag"}
+	ttl := Property{Key: "ttl", Value: "30", HasValue: true}
+
+	m := NewMap(MapUpdate{
+		MultiKV: []label.KeyValue{
+			label.String("a", "1"),
+			label.String("b", "2"),
+		},
+		Properties: map[label.Key][]Property{
+			"a": {flag, ttl},
+			"c": {flag},
+		},
+	})
+	if got := m.Properties("a"); len(got) != 2 || got[0] != flag || got[1] != ttl {
+		t.Errorf("Properties(a) = %v, want [%v %v]", got, flag, ttl)
+	}
+	if got := m.Properties("b"); got != nil {
+		t.Errorf("Properties(b) = %v, want nil", got)
+	}
+	if got := m.Properties("c"); got != nil {
+		t.Errorf("properties of a key not added must be ignored, got %v", got)
+	}
+
+	// The returned slice is a copy.
+	m.Properties("a")[0].Key = "changed"
+	if got := m.Properties("a")[0]; got != flag {
+		t.Errorf("Properties(a)[0] = %v, want %v", got, flag)
+	}
+
+	// Unrelated updates keep the properties.
+	m2 := m.Apply(MapUpdate{SingleKV: label.String("d", "4")})
+	if got := m2.Properties("a"); len(got) != 2 {
+		t.Errorf("Properties(a) after unrelated update = %v", got)
+	}
+
+	// Overwriting a member replaces its properties.
+	m3 := m.Apply(MapUpdate{SingleKV: label.String("a", "5")})
+	if got := m3.Properties("a"); got != nil {
+		t.Errorf("Properties(a) after overwrite = %v, want nil", got)
+	}
+
+	// Dropping a member drops its properties.
+	m4 := m.Apply(MapUpdate{DropSingleK: "a", SingleKV: label.String("e", "5")})
+	if got := m4.Properties("a"); got != nil {
+		t.Errorf("Properties(a) after drop = %v, want nil", got)
+	}
 }
@@ -0,0 +1,71 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package global // import "go.opentelemetry.io/otel/internal/global"
+
+import (
+	"log"
+	"os"
+	"sync"
+
+	"github.com/go-logr/logr"
+	"github.com/go-logr/stdr"
+)
+
+// globalLogger is the logger used for OpenTelemetry internal diagnostics.
+// The default logger writes to STDERR with a verbosity of 0, so only
+// errors are logged.
+//
+// The verbosity levels used are:
+//
+//   - 1: Warnings, e.g. dropped data or ignored configuration.
+//   - 4: Informational messages.
+//   - 8: Debug messages, e.g. each export and retry.
+var (
+	globalLoggerMu sync.RWMutex
+	globalLogger   logr.Logger = stdr.New(log.New(os.Stderr, "", log.LstdFlags|log.Lshortfile))
+)
+
+// SetLogger overrides the globalLogger with l.
+func SetLogger(l logr.Logger) {
+	globalLoggerMu.Lock()
+	defer globalLoggerMu.Unlock()
+	globalLogger = l
+}
+
+func getLogger() logr.Logger {
+	globalLoggerMu.RLock()
+	defer globalLoggerMu.RUnlock()
+	return globalLogger
+}
+
+// Error logs err and msg with the globalLogger.
+func Error(err error, msg string, keysAndValues ...interface{}) {
+	getLogger().Error(err, msg, keysAndValues...)
+}
+
+// Warn logs msg with the globalLogger at verbosity 1.
+func Warn(msg string, keysAndValues ...interface{}) {
+	getLogger().V(1).Info(msg, keysAndValues...)
+}
+
+// Info logs msg with the globalLogger at verbosity 4.
+func Info(msg string, keysAndValues ...interface{}) {
+	getLogger().V(4).Info(msg, keysAndValues...)
+}
+
+// Debug logs msg with the globalLogger at verbosity 8.
+func Debug(msg string, keysAndValues ...interface{}) {
+	getLogger().V(8).Info(msg, keysAndValues...)
+}
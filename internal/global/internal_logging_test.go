@@ -0,0 +1,64 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package global
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/go-logr/logr"
+	"github.com/go-logr/logr/funcr"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLoggerVerbosity(t *testing.T) {
+	orig := getLogger()
+	t.Cleanup(func() { SetLogger(orig) })
+
+	for _, verbosity := range []int{0, 1, 4, 8} {
+		var got []string
+		SetLogger(funcr.New(func(prefix, args string) {
+			got = append(got, args)
+		}, funcr.Options{Verbosity: verbosity}))
+
+		Error(errors.New("err"), "error")
+		Warn("warn", "k", 1)
+		Info("info")
+		Debug("debug")
+
+		want := []string{`"msg"="error" "error"="err"`}
+		if verbosity >= 1 {
+			want = append(want, `"level"=1 "msg"="warn" "k"=1`)
+		}
+		if verbosity >= 4 {
+			want = append(want, `"level"=4 "msg"="info"`)
+		}
+		if verbosity >= 8 {
+			want = append(want, `"level"=8 "msg"="debug"`)
+		}
+		assert.Equal(t, want, got, "verbosity %d", verbosity)
+	}
+}
+
+func TestSetLoggerDiscard(t *testing.T) {
+	orig := getLogger()
+	t.Cleanup(func() { SetLogger(orig) })
+
+	SetLogger(logr.Discard())
+	assert.NotPanics(t, func() {
+		Error(errors.New("err"), "error")
+		Debug("debug")
+	})
+}
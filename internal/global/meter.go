@@ -27,9 +27,11 @@ import (
 )
 
 // This file contains the forwarding implementation of MeterProvider used as
-// the default global instance.  Metric events using instruments provided by
-// this implementation are no-ops until the first Meter implementation is set
-// as the global provider.
+// the default global instance.  Synchronous metric events using instruments
+// provided by this implementation are buffered, up to maxPendingMeasurements
+// per Meter, until the first Meter implementation is set as the global
+// provider.  The buffered events are then replayed onto the new
+// implementation's instruments.
 //
 // The implementation here uses Mutexes to maintain a list of active Meters in
 // the MeterProvider and Instruments in each Meter, under the assumption that
@@ -47,8 +49,21 @@ import (
 // Metric uniqueness checking is implemented by calling the exported
 // methods of the api/metric/registry package.
 
+// maxPendingMeasurements is the number of synchronous measurements each
+// Meter buffers before a delegate is set. Measurements beyond it are
+// dropped.
+const maxPendingMeasurements = 1024
+
 type meterKey struct {
-	Name, Version string
+	Name, Version, SchemaURL string
+}
+
+// options returns the MeterOptions that create a Meter identified by k.
+func (k meterKey) options() []metric.MeterOption {
+	return []metric.MeterOption{
+		metric.WithInstrumentationVersion(k.Version),
+		metric.WithSchemaURL(k.SchemaURL),
+	}
 }
 
 type meterProvider struct {
@@ -68,6 +83,21 @@ type meterImpl struct {
 	lock       sync.Mutex
 	syncInsts  []*syncImpl
 	asyncInsts []*asyncImpl
+
+	// pending holds the measurements recorded before a delegate
+	// was set, and dropped counts those that did not fit.
+	pending []pendingMeasurement
+	dropped int
+}
+
+// pendingMeasurement is a synchronous measurement recorded before a
+// delegate was set. Exactly one of labels and set is used.
+type pendingMeasurement struct {
+	ctx    context.Context
+	inst   *syncImpl
+	number number.Number
+	labels []label.KeyValue
+	set    *label.Set
 }
 
 type meterEntry struct {
@@ -83,6 +113,8 @@ type syncImpl struct {
 	delegate unsafe.Pointer // (*metric.SyncImpl)
 
 	instrument
+
+	meter *meterImpl
 }
 
 type asyncImpl struct {
@@ -90,6 +122,7 @@ type asyncImpl struct {
 
 	instrument
 
+	meter  *meterImpl
 	runner metric.AsyncRunner
 }
 
@@ -116,9 +149,12 @@ type syncHandle struct {
 
 var _ metric.MeterProvider = &meterProvider{}
 var _ metric.MeterImpl = &meterImpl{}
+var _ metric.LabelSetMeterImpl = &meterImpl{}
 var _ metric.InstrumentImpl = &syncImpl{}
+var _ metric.LabelSetSyncImpl = &syncImpl{}
 var _ metric.BoundSyncImpl = &syncHandle{}
 var _ metric.AsyncImpl = &asyncImpl{}
+var _ metric.UnregisterAsyncImpl = &asyncImpl{}
 
 func (inst *instrument) Descriptor() metric.Descriptor {
 	return inst.descriptor
@@ -138,7 +174,7 @@ func (p *meterProvider) setDelegate(provider metric.MeterProvider) {
 
 	p.delegate = provider
 	for key, entry := range p.meters {
-		entry.impl.setDelegate(key.Name, key.Version, provider)
+		entry.impl.setDelegate(key, provider)
 	}
 	p.meters = nil
 }
@@ -151,9 +187,11 @@ func (p *meterProvider) Meter(instrumentationName string, opts ...metric.MeterOp
 		return p.delegate.Meter(instrumentationName, opts...)
 	}
 
+	cfg := metric.NewMeterConfig(opts...)
 	key := meterKey{
-		Name:    instrumentationName,
-		Version: metric.NewMeterConfig(opts...).InstrumentationVersion,
+		Name:      instrumentationName,
+		Version:   cfg.InstrumentationVersion,
+		SchemaURL: cfg.SchemaURL,
 	}
 	entry, ok := p.meters[key]
 	if !ok {
@@ -162,17 +200,17 @@ func (p *meterProvider) Meter(instrumentationName string, opts ...metric.MeterOp
 		p.meters[key] = entry
 
 	}
-	return metric.WrapMeterImpl(entry.unique, key.Name, metric.WithInstrumentationVersion(key.Version))
+	return metric.WrapMeterImpl(entry.unique, key.Name, key.options()...)
 }
 
 // Meter interface and delegation
 
-func (m *meterImpl) setDelegate(name, version string, provider metric.MeterProvider) {
+func (m *meterImpl) setDelegate(key meterKey, provider metric.MeterProvider) {
 	m.lock.Lock()
 	defer m.lock.Unlock()
 
 	d := new(metric.MeterImpl)
-	*d = provider.Meter(name, metric.WithInstrumentationVersion(version)).MeterImpl()
+	*d = provider.Meter(key.Name, key.options()...).MeterImpl()
 	m.delegate = unsafe.Pointer(d)
 
 	for _, inst := range m.syncInsts {
@@ -183,6 +221,52 @@ func (m *meterImpl) setDelegate(name, version string, provider metric.MeterProvi
 		obs.setDelegate(*d)
 	}
 	m.asyncInsts = nil
+
+	for _, p := range m.pending {
+		p.replay()
+	}
+	if m.dropped > 0 {
+		Warn("dropped measurements recorded before a MeterProvider was set",
+			"meter", key.Name, "dropped", m.dropped)
+	}
+	m.pending = nil
+	m.dropped = 0
+}
+
+// record buffers p until a delegate is set. If one was set since the
+// caller checked, p is recorded with it instead.
+func (m *meterImpl) record(p pendingMeasurement) {
+	m.lock.Lock()
+	if atomic.LoadPointer(&m.delegate) != nil {
+		m.lock.Unlock()
+		// The instrument has no delegate if constructing it
+		// failed.
+		if atomic.LoadPointer(&p.inst.delegate) != nil {
+			p.replay()
+		}
+		return
+	}
+	defer m.lock.Unlock()
+
+	if len(m.pending) >= maxPendingMeasurements {
+		m.dropped++
+		return
+	}
+	if p.labels != nil {
+		// The caller may reuse its slice.
+		p.labels = append([]label.KeyValue(nil), p.labels...)
+	}
+	m.pending = append(m.pending, p)
+}
+
+// replay records p with the delegate of its instrument, which must be
+// set.
+func (p pendingMeasurement) replay() {
+	if p.set != nil {
+		p.inst.RecordOneWithSet(p.ctx, p.number, p.set)
+		return
+	}
+	p.inst.RecordOne(p.ctx, p.number, p.labels)
 }
 
 func (m *meterImpl) NewSyncInstrument(desc metric.Descriptor) (metric.SyncImpl, error) {
@@ -197,6 +281,7 @@ func (m *meterImpl) NewSyncInstrument(desc metric.Descriptor) (metric.SyncImpl,
 		instrument: instrument{
 			descriptor: desc,
 		},
+		meter: m,
 	}
 	m.syncInsts = append(m.syncInsts, inst)
 	return inst, nil
@@ -268,6 +353,7 @@ func (m *meterImpl) NewAsyncInstrument(
 		instrument: instrument{
 			descriptor: desc,
 		},
+		meter:  m,
 		runner: runner,
 	}
 	m.asyncInsts = append(m.asyncInsts, inst)
@@ -281,6 +367,32 @@ func (obs *asyncImpl) Implementation() interface{} {
 	return obs
 }
 
+// Unregister implements metric.UnregisterAsyncImpl. Before a delegate is
+// set, the instrument, and the other instruments of its batch callback,
+// are forgotten so that they are never created with the delegate.
+func (obs *asyncImpl) Unregister() error {
+	obs.meter.lock.Lock()
+	if implPtr := (*metric.AsyncImpl)(atomic.LoadPointer(&obs.delegate)); implPtr != nil {
+		obs.meter.lock.Unlock()
+		if impl, ok := (*implPtr).(metric.UnregisterAsyncImpl); ok {
+			return impl.Unregister()
+		}
+		return metric.ErrUnregisterUnsupported
+	}
+	defer obs.meter.lock.Unlock()
+
+	_, batch := obs.runner.(metric.AsyncBatchRunner)
+	insts := obs.meter.asyncInsts[:0]
+	for _, inst := range obs.meter.asyncInsts {
+		if inst == obs || (batch && inst.runner == obs.runner) {
+			continue
+		}
+		insts = append(insts, inst)
+	}
+	obs.meter.asyncInsts = insts
+	return nil
+}
+
 func (obs *asyncImpl) setDelegate(d metric.MeterImpl) {
 	implPtr := new(metric.AsyncImpl)
 
@@ -303,13 +415,49 @@ func (obs *asyncImpl) setDelegate(d metric.MeterImpl) {
 func (m *meterImpl) RecordBatch(ctx context.Context, labels []label.KeyValue, measurements ...metric.Measurement) {
 	if delegatePtr := (*metric.MeterImpl)(atomic.LoadPointer(&m.delegate)); delegatePtr != nil {
 		(*delegatePtr).RecordBatch(ctx, labels, measurements...)
+		return
+	}
+	for _, meas := range measurements {
+		if inst, ok := meas.SyncImpl().(*syncImpl); ok {
+			inst.meter.record(pendingMeasurement{ctx: ctx, inst: inst, number: meas.Number(), labels: labels})
+		}
+	}
+}
+
+func (m *meterImpl) RecordBatchWithSet(ctx context.Context, labels *label.Set, measurements ...metric.Measurement) {
+	if delegatePtr := (*metric.MeterImpl)(atomic.LoadPointer(&m.delegate)); delegatePtr != nil {
+		if impl, ok := (*delegatePtr).(metric.LabelSetMeterImpl); ok {
+			impl.RecordBatchWithSet(ctx, labels, measurements...)
+			return
+		}
+		(*delegatePtr).RecordBatch(ctx, labels.ToSlice(), measurements...)
+		return
+	}
+	for _, meas := range measurements {
+		if inst, ok := meas.SyncImpl().(*syncImpl); ok {
+			inst.meter.record(pendingMeasurement{ctx: ctx, inst: inst, number: meas.Number(), set: labels})
+		}
 	}
 }
 
 func (inst *syncImpl) RecordOne(ctx context.Context, number number.Number, labels []label.KeyValue) {
 	if instPtr := (*metric.SyncImpl)(atomic.LoadPointer(&inst.delegate)); instPtr != nil {
 		(*instPtr).RecordOne(ctx, number, labels)
+		return
+	}
+	inst.meter.record(pendingMeasurement{ctx: ctx, inst: inst, number: number, labels: labels})
+}
+
+func (inst *syncImpl) RecordOneWithSet(ctx context.Context, number number.Number, labels *label.Set) {
+	if instPtr := (*metric.SyncImpl)(atomic.LoadPointer(&inst.delegate)); instPtr != nil {
+		if impl, ok := (*instPtr).(metric.LabelSetSyncImpl); ok {
+			impl.RecordOneWithSet(ctx, number, labels)
+			return
+		}
+		(*instPtr).RecordOne(ctx, number, labels.ToSlice())
+		return
 	}
+	inst.meter.record(pendingMeasurement{ctx: ctx, inst: inst, number: number, set: labels})
 }
 
 // Bound instrument initialization
@@ -317,6 +465,7 @@ func (inst *syncImpl) RecordOne(ctx context.Context, number number.Number, label
 func (bound *syncHandle) RecordOne(ctx context.Context, number number.Number) {
 	instPtr := (*metric.SyncImpl)(atomic.LoadPointer(&bound.inst.delegate))
 	if instPtr == nil {
+		bound.inst.meter.record(pendingMeasurement{ctx: ctx, inst: bound.inst, number: number, labels: bound.labels})
 		return
 	}
 	var implPtr *metric.BoundSyncImpl
@@ -76,6 +76,55 @@ func TestDirect(t *testing.T) {
 	mock.RunAsyncInstruments()
 
 	measurements := oteltest.AsStructs(mock.MeasurementBatches)
+	require.Len(t, measurements, 13)
+
+	// The measurements recorded before the SDK was set are replayed
+	// when it is set, one Meter at a time.
+	require.ElementsMatch(t,
+		[]oteltest.Measured{
+			{
+				Name:                   "test.counter",
+				InstrumentationName:    "test1",
+				InstrumentationVersion: "semver:v1.0.0",
+				Labels:                 oteltest.LabelsToMap(labels1...),
+				Number:                 asInt(1),
+			},
+			{
+				Name:                   "test.counter",
+				InstrumentationName:    "test1",
+				InstrumentationVersion: "semver:v1.0.0",
+				Labels:                 oteltest.LabelsToMap(labels1...),
+				Number:                 asInt(1),
+			},
+			{
+				Name:                   "test.valuerecorder",
+				InstrumentationName:    "test1",
+				InstrumentationVersion: "semver:v1.0.0",
+				Labels:                 oteltest.LabelsToMap(labels1...),
+				Number:                 asFloat(1),
+			},
+			{
+				Name:                   "test.valuerecorder",
+				InstrumentationName:    "test1",
+				InstrumentationVersion: "semver:v1.0.0",
+				Labels:                 oteltest.LabelsToMap(labels1...),
+				Number:                 asFloat(2),
+			},
+			{
+				Name:                "test.second",
+				InstrumentationName: "test2",
+				Labels:              oteltest.LabelsToMap(labels3...),
+				Number:              asFloat(1),
+			},
+			{
+				Name:                "test.second",
+				InstrumentationName: "test2",
+				Labels:              oteltest.LabelsToMap(labels3...),
+				Number:              asFloat(2),
+			},
+		},
+		measurements[:6],
+	)
 
 	require.EqualValues(t,
 		[]oteltest.Measured{
@@ -128,7 +177,7 @@ func TestDirect(t *testing.T) {
 				Number:                 asInt(2),
 			},
 		},
-		measurements,
+		measurements[6:],
 	)
 }
 
@@ -157,8 +206,34 @@ func TestBound(t *testing.T) {
 	boundC.Add(ctx, 1)
 	boundM.Record(ctx, 3)
 
+	// The measurements recorded before the SDK was set are replayed
+	// first.
 	require.EqualValues(t,
 		[]oteltest.Measured{
+			{
+				Name:                "test.counter",
+				InstrumentationName: "test",
+				Labels:              oteltest.LabelsToMap(labels1...),
+				Number:              asFloat(1),
+			},
+			{
+				Name:                "test.counter",
+				InstrumentationName: "test",
+				Labels:              oteltest.LabelsToMap(labels1...),
+				Number:              asFloat(1),
+			},
+			{
+				Name:                "test.valuerecorder",
+				InstrumentationName: "test",
+				Labels:              oteltest.LabelsToMap(labels1...),
+				Number:              asInt(1),
+			},
+			{
+				Name:                "test.valuerecorder",
+				InstrumentationName: "test",
+				Labels:              oteltest.LabelsToMap(labels1...),
+				Number:              asInt(2),
+			},
 			{
 				Name:                "test.counter",
 				InstrumentationName: "test",
@@ -312,7 +387,7 @@ func TestRecordBatchMock(t *testing.T) {
 	mock, provider := oteltest.NewMeterProvider()
 	otel.SetMeterProvider(provider)
 
-	meter.RecordBatch(context.Background(), nil, counter.Measurement(1))
+	meter.RecordBatch(context.Background(), nil, counter.Measurement(2))
 
 	require.EqualValues(t,
 		[]oteltest.Measured{
@@ -322,6 +397,111 @@ func TestRecordBatchMock(t *testing.T) {
 				Labels:              oteltest.LabelsToMap(),
 				Number:              asInt(1),
 			},
+			{
+				Name:                "test.counter",
+				InstrumentationName: "builtin",
+				Labels:              oteltest.LabelsToMap(),
+				Number:              asInt(2),
+			},
 		},
 		oteltest.AsStructs(mock.MeasurementBatches))
 }
+
+func TestPendingMeasurementsLimit(t *testing.T) {
+	global.ResetForTest()
+
+	ctx := context.Background()
+	meter := otel.Meter("test")
+	counter := Must(meter).NewInt64Counter("test.counter")
+	labels := label.NewSet(label.String("A", "B"))
+	for i := 0; i < 1000; i++ {
+		counter.Add(ctx, 1)
+		counter.AddWithSet(ctx, 1, &labels)
+	}
+
+	mock, provider := oteltest.NewMeterProvider()
+	otel.SetMeterProvider(provider)
+
+	// Measurements beyond the limit are dropped.
+	measurements := oteltest.AsStructs(mock.MeasurementBatches)
+	require.Len(t, measurements, 1024)
+	require.Equal(t, oteltest.LabelsToMap(label.String("A", "B")), measurements[1023].Labels)
+}
+
+func TestMeterSchemaURL(t *testing.T) {
+	global.ResetForTest()
+
+	const schemaURL = "https://opentelemetry.io/schemas/1.13.0"
+	ctx := context.Background()
+	meter := otel.Meter("test", metric.WithSchemaURL(schemaURL))
+	counter := Must(meter).NewInt64Counter("test.counter")
+	counter.Add(ctx, 1)
+
+	// A Meter with the same name but without a schema URL is distinct.
+	other := Must(otel.Meter("test")).NewInt64Counter("test.counter")
+	other.Add(ctx, 2)
+
+	mock, provider := oteltest.NewMeterProvider()
+	otel.SetMeterProvider(provider)
+	counter.Add(ctx, 3)
+
+	require.ElementsMatch(t,
+		[]oteltest.Measured{
+			{
+				Name:                     "test.counter",
+				InstrumentationName:      "test",
+				InstrumentationSchemaURL: schemaURL,
+				Labels:                   oteltest.LabelsToMap(),
+				Number:                   asInt(1),
+			},
+			{
+				Name:                "test.counter",
+				InstrumentationName: "test",
+				Labels:              oteltest.LabelsToMap(),
+				Number:              asInt(2),
+			},
+			{
+				Name:                     "test.counter",
+				InstrumentationName:      "test",
+				InstrumentationSchemaURL: schemaURL,
+				Labels:                   oteltest.LabelsToMap(),
+				Number:                   asInt(3),
+			},
+		},
+		oteltest.AsStructs(mock.MeasurementBatches))
+}
+
+func TestObserverUnregister(t *testing.T) {
+	global.ResetForTest()
+
+	meter := otel.Meter("test")
+	before := Must(meter).NewInt64ValueObserver("before", func(_ context.Context, result metric.Int64ObserverResult) {
+		result.Observe(1)
+	})
+	after := Must(meter).NewInt64ValueObserver("after", func(_ context.Context, result metric.Int64ObserverResult) {
+		result.Observe(2)
+	})
+	var batchA metric.Int64ValueObserver
+	batch := Must(meter).NewBatchObserver(func(_ context.Context, result metric.BatchObserverResult) {
+		result.Observe(nil, batchA.Observation(3))
+	})
+	batchA = batch.NewInt64ValueObserver("batch.a")
+	batchB := batch.NewInt64ValueObserver("batch.b")
+
+	// Unregistered before the SDK is set, these are never created.
+	require.NoError(t, before.Unregister())
+	require.NoError(t, batchB.Unregister())
+
+	mock, provider := oteltest.NewMeterProvider()
+	otel.SetMeterProvider(provider)
+
+	mock.RunAsyncInstruments()
+	measured := oteltest.AsStructs(mock.MeasurementBatches)
+	require.Len(t, measured, 1)
+	require.Equal(t, "after", measured[0].Name)
+
+	require.NoError(t, after.Unregister())
+	mock.MeasurementBatches = nil
+	mock.RunAsyncInstruments()
+	require.Empty(t, mock.MeasurementBatches)
+}
@@ -17,13 +17,15 @@ package global
 /*
 This file contains the forwarding implementation of the TracerProvider used as
 the default global instance. Prior to initialization of an SDK, Tracers
-returned by the global TracerProvider will provide no-op functionality. This
-means that all Span created prior to initialization are no-op Spans.
+returned by the global TracerProvider start placeholder Spans that record
+nothing but buffer the operations made on them.
 
-Once an SDK has been initialized, all provided no-op Tracers are swapped for
-Tracers provided by the SDK defined TracerProvider. However, any Span started
-prior to this initialization does not change its behavior. Meaning, the Span
-remains a no-op Span.
+Once an SDK has been initialized, all provided placeholder Tracers are swapped
+for Tracers provided by the SDK defined TracerProvider. A placeholder Span that
+is still in use is started with the SDK the next time it is used, with its
+original start time, and the operations it buffered are replayed onto it.
+Placeholder Spans ended before the initialization are dropped, and so are
+operations beyond maxBufferedSpanOps.
 
 The implementation to track and swap Tracers locks all new Tracer creation
 until the swap is complete. This assumes that this operation is not
@@ -34,11 +36,18 @@ SDK prior to any Tracer creation.
 import (
 	"context"
 	"sync"
+	"sync/atomic"
+	"time"
 
-	"go.opentelemetry.io/otel/internal/trace/noop"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/label"
 	"go.opentelemetry.io/otel/trace"
 )
 
+// maxBufferedSpanOps is the number of operations a placeholder Span
+// buffers before a delegate is set. Operations beyond it are dropped.
+const maxBufferedSpanOps = 128
+
 // tracerProvider is a placeholder for a configured SDK TracerProvider.
 //
 // All TracerProvider functionality is forwarded to a delegate once
@@ -95,13 +104,13 @@ func (p *tracerProvider) Tracer(name string, opts ...trace.TracerOption) trace.T
 // tracer is a placeholder for a trace.Tracer.
 //
 // All Tracer functionality is forwarded to a delegate once configured.
-// Otherwise, all functionality is forwarded to a NoopTracer.
+// Otherwise, it starts placeholder spans.
 type tracer struct {
 	once sync.Once
 	name string
 	opts []trace.TracerOption
 
-	delegate trace.Tracer
+	delegate atomic.Value // trace.Tracer
 }
 
 // Compile-time guarantee that tracer implements the trace.Tracer interface.
@@ -115,14 +124,198 @@ var _ trace.Tracer = &tracer{}
 // Delegation only happens on the first call to this method. All subsequent
 // calls result in no delegation changes.
 func (t *tracer) setDelegate(provider trace.TracerProvider) {
-	t.once.Do(func() { t.delegate = provider.Tracer(t.name, t.opts...) })
+	t.once.Do(func() { t.delegate.Store(provider.Tracer(t.name, t.opts...)) })
+}
+
+// getDelegate returns the delegate of t, or nil if none is set.
+func (t *tracer) getDelegate() trace.Tracer {
+	d, _ := t.delegate.Load().(trace.Tracer)
+	return d
 }
 
 // Start implements trace.Tracer by forwarding the call to t.delegate if
-// set, otherwise it forwards the call to a NoopTracer.
+// set, otherwise it starts a placeholder span.
 func (t *tracer) Start(ctx context.Context, name string, opts ...trace.SpanOption) (context.Context, trace.Span) {
-	if t.delegate != nil {
-		return t.delegate.Start(ctx, name, opts...)
+	if d := t.getDelegate(); d != nil {
+		return d.Start(withStartedParent(ctx), name, opts...)
+	}
+
+	// Copy the options and pin the start time so the span can be
+	// started later as if it had been started now.
+	spanOpts := make([]trace.SpanOption, len(opts), len(opts)+1)
+	copy(spanOpts, opts)
+	c := trace.NewSpanConfig(opts...)
+	if c.Timestamp.IsZero() {
+		spanOpts = append(spanOpts, trace.WithTimestamp(time.Now()))
+	}
+
+	s := &span{
+		tracer: t,
+		ctx:    ctx,
+		name:   name,
+		kind:   trace.ValidateSpanKind(c.SpanKind),
+		opts:   spanOpts,
+	}
+	return trace.ContextWithSpan(ctx, s), s
+}
+
+// withStartedParent returns ctx with its current span replaced by the
+// delegate of the placeholder span, if the current span is one that can
+// be started.
+func withStartedParent(ctx context.Context) context.Context {
+	if parent, ok := trace.SpanFromContext(ctx).(*span); ok {
+		if d := parent.start(); d != nil {
+			return trace.ContextWithSpan(ctx, d)
+		}
+	}
+	return ctx
+}
+
+// span is a placeholder for a trace.Span started before a delegate was
+// set. It buffers the operations made on it until its Tracer has a
+// delegate, at which point it starts a delegate span and forwards all
+// operations to it.
+type span struct {
+	tracer *tracer
+
+	mtx      sync.Mutex
+	ctx      context.Context
+	name     string
+	kind     trace.SpanKind
+	opts     []trace.SpanOption
+	ops      []func(trace.Span)
+	dropped  int
+	ended    bool
+	delegate trace.Span
+}
+
+// Compile-time guarantee that span implements the trace.Span interface.
+var _ trace.Span = &span{}
+
+// start returns the delegate of s, starting it if the Tracer of s has a
+// delegate. It returns nil if s cannot be started yet or was ended before
+// it could be.
+func (s *span) start() trace.Span {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+	return s.startLocked()
+}
+
+func (s *span) startLocked() trace.Span {
+	if s.delegate != nil || s.ended {
+		return s.delegate
+	}
+	t := s.tracer.getDelegate()
+	if t == nil {
+		return nil
+	}
+
+	_, s.delegate = t.Start(withStartedParent(s.ctx), s.name, s.opts...)
+	for _, op := range s.ops {
+		op(s.delegate)
+	}
+	if s.dropped > 0 {
+		Warn("dropped operations on a span started before a TracerProvider was set",
+			"span", s.name, "dropped", s.dropped)
+	}
+	s.ctx, s.opts, s.ops = nil, nil, nil
+	return s.delegate
+}
+
+// do calls f with the delegate of s if it can be started, otherwise it
+// buffers f to be called once it is.
+func (s *span) do(f func(trace.Span)) {
+	s.mtx.Lock()
+	d := s.startLocked()
+	if d == nil && !s.ended {
+		if len(s.ops) < maxBufferedSpanOps {
+			s.ops = append(s.ops, f)
+		} else {
+			s.dropped++
+		}
+	}
+	s.mtx.Unlock()
+
+	if d != nil {
+		f(d)
+	}
+}
+
+// Tracer returns the Tracer that started s.
+func (s *span) Tracer() trace.Tracer {
+	return s.tracer
+}
+
+// End ends the delegate of s if it can be started. Otherwise s is
+// dropped.
+func (s *span) End(options ...trace.SpanOption) {
+	s.mtx.Lock()
+	d := s.startLocked()
+	s.ended = true
+	s.ctx, s.opts, s.ops = nil, nil, nil
+	s.mtx.Unlock()
+
+	if d != nil {
+		d.End(options...)
 	}
-	return noop.Tracer.Start(ctx, name, opts...)
+}
+
+// AddEvent adds an event to the delegate of s, keeping the time it was
+// added at if it is buffered.
+func (s *span) AddEvent(name string, options ...trace.EventOption) {
+	options = withEventTimestamp(options)
+	s.do(func(d trace.Span) { d.AddEvent(name, options...) })
+}
+
+// IsRecording returns whether the delegate of s is recording, or false if
+// s cannot be started yet.
+func (s *span) IsRecording() bool {
+	if d := s.start(); d != nil {
+		return d.IsRecording()
+	}
+	return false
+}
+
+// SpanKind returns the kind s was started with.
+func (s *span) SpanKind() trace.SpanKind {
+	return s.kind
+}
+
+// RecordError records err on the delegate of s, keeping the time it was
+// recorded at if it is buffered.
+func (s *span) RecordError(err error, options ...trace.EventOption) {
+	options = withEventTimestamp(options)
+	s.do(func(d trace.Span) { d.RecordError(err, options...) })
+}
+
+// SpanContext returns the span context of the delegate of s, or an empty
+// span context if s cannot be started yet.
+func (s *span) SpanContext() trace.SpanContext {
+	if d := s.start(); d != nil {
+		return d.SpanContext()
+	}
+	return trace.SpanContext{}
+}
+
+// SetStatus sets the status of the delegate of s.
+func (s *span) SetStatus(code codes.Code, msg string) {
+	s.do(func(d trace.Span) { d.SetStatus(code, msg) })
+}
+
+// SetName sets the name of the delegate of s.
+func (s *span) SetName(name string) {
+	s.do(func(d trace.Span) { d.SetName(name) })
+}
+
+// SetAttributes sets attributes on the delegate of s.
+func (s *span) SetAttributes(kv ...label.KeyValue) {
+	kv = append([]label.KeyValue(nil), kv...)
+	s.do(func(d trace.Span) { d.SetAttributes(kv...) })
+}
+
+// withEventTimestamp returns a copy of options that pins the time of the
+// event to now, unless options already set it.
+func withEventTimestamp(options []trace.EventOption) []trace.EventOption {
+	ts := trace.NewEventConfig(options...).Timestamp
+	return append(options[:len(options):len(options)], trace.WithTimestamp(ts))
 }
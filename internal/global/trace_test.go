@@ -17,11 +17,15 @@ package global_test
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 
 	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
 	"go.opentelemetry.io/otel/internal/global"
+	"go.opentelemetry.io/otel/label"
 	"go.opentelemetry.io/otel/oteltest"
 	"go.opentelemetry.io/otel/trace"
 )
@@ -32,14 +36,18 @@ func TestTraceWithSDK(t *testing.T) {
 	ctx := context.Background()
 	gtp := otel.GetTracerProvider()
 	tracer1 := gtp.Tracer("pre")
-	// This is started before an SDK was registered and should be dropped.
+	// This is started before an SDK was registered and ended after,
+	// it is expected to be started with the SDK when it is ended.
 	_, span1 := tracer1.Start(ctx, "span1")
+	// This is started and ended before an SDK was registered, it is
+	// expected to be dropped.
+	_, span0 := tracer1.Start(ctx, "span0")
+	span0.End()
 
 	sr := new(oteltest.StandardSpanRecorder)
 	tp := oteltest.NewTracerProvider(oteltest.WithSpanRecorder(sr))
 	otel.SetTracerProvider(tp)
 
-	// This span was started before initialization, it is expected to be dropped.
 	span1.End()
 
 	// The existing Tracer should have been configured to now use the configured SDK.
@@ -58,11 +66,85 @@ func TestTraceWithSDK(t *testing.T) {
 		}
 		return names
 	}
-	expected := []string{"span2", "span3"}
+	expected := []string{"span1", "span2", "span3"}
 	assert.ElementsMatch(t, expected, filterNames(sr.Started()))
 	assert.ElementsMatch(t, expected, filterNames(sr.Completed()))
 }
 
+func TestTraceReplaysBufferedSpan(t *testing.T) {
+	global.ResetForTest()
+
+	ctx := context.Background()
+	tracer := otel.GetTracerProvider().Tracer("pre")
+
+	ctx, parent := tracer.Start(ctx, "parent")
+	start := time.Now()
+	_, child := tracer.Start(ctx, "child", trace.WithTimestamp(start))
+	child.SetAttributes(label.String("A", "B"))
+	eventTime := start.Add(time.Second)
+	child.AddEvent("event", trace.WithTimestamp(eventTime))
+	child.SetStatus(codes.Error, "failed")
+	child.SetName("renamed")
+	assert.False(t, child.IsRecording())
+	assert.False(t, child.SpanContext().IsValid())
+
+	sr := new(oteltest.StandardSpanRecorder)
+	otel.SetTracerProvider(oteltest.NewTracerProvider(oteltest.WithSpanRecorder(sr)))
+
+	// The spans are started with the SDK the next time they are used.
+	assert.True(t, child.IsRecording())
+	child.End()
+	parent.End()
+
+	spans := sr.Completed()
+	require.Len(t, spans, 2)
+	gotChild, gotParent := spans[0], spans[1]
+
+	assert.Equal(t, "renamed", gotChild.Name())
+	assert.Equal(t, start, gotChild.StartTime())
+	assert.Equal(t, map[label.Key]label.Value{"A": label.StringValue("B")}, gotChild.Attributes())
+	require.Len(t, gotChild.Events(), 1)
+	assert.Equal(t, eventTime, gotChild.Events()[0].Timestamp)
+	assert.Equal(t, codes.Error, gotChild.StatusCode())
+
+	// The child is still a child of its parent.
+	assert.Equal(t, "parent", gotParent.Name())
+	assert.Equal(t, gotParent.SpanContext().TraceID, gotChild.SpanContext().TraceID)
+	assert.Equal(t, gotParent.SpanContext().SpanID, gotChild.ParentSpanID())
+}
+
+func TestTraceBufferedSpanKind(t *testing.T) {
+	global.ResetForTest()
+
+	tracer := otel.GetTracerProvider().Tracer("pre")
+	ctx, span := tracer.Start(context.Background(), "span", trace.WithSpanKind(trace.SpanKindClient))
+	defer span.End()
+	assert.Equal(t, trace.SpanKindClient, trace.SpanKindFromContext(ctx))
+	assert.False(t, trace.IsRecording(ctx))
+
+	ctx, span = tracer.Start(context.Background(), "span")
+	defer span.End()
+	assert.Equal(t, trace.SpanKindInternal, trace.SpanKindFromContext(ctx))
+}
+
+func TestTraceBufferedSpanLimit(t *testing.T) {
+	global.ResetForTest()
+
+	_, span := otel.GetTracerProvider().Tracer("pre").Start(context.Background(), "span")
+	for i := 0; i < 200; i++ {
+		span.SetAttributes(label.Int("attr", i))
+	}
+
+	sr := new(oteltest.StandardSpanRecorder)
+	otel.SetTracerProvider(oteltest.NewTracerProvider(oteltest.WithSpanRecorder(sr)))
+	span.End()
+
+	spans := sr.Completed()
+	require.Len(t, spans, 1)
+	// Operations beyond the limit are dropped.
+	assert.Equal(t, label.IntValue(127), spans[0].Attributes()["attr"])
+}
+
 type fnTracerProvider struct {
 	tracer func(string, ...trace.TracerOption) trace.Tracer
 }
@@ -61,6 +61,10 @@ type AsyncInstrumentState struct {
 	// instruments maintains the set of instruments in the order
 	// they were registered.
 	instruments []metric.AsyncImpl
+
+	// instrumentRunners maps each registered instrument to its
+	// runner so that it can be unregistered.
+	instrumentRunners map[metric.AsyncImpl]metric.AsyncRunner
 }
 
 // asyncRunnerPair is a map entry for Observer callback runners.
@@ -79,7 +83,8 @@ type asyncRunnerPair struct {
 // the correct order.
 func NewAsyncInstrumentState() *AsyncInstrumentState {
 	return &AsyncInstrumentState{
-		runnerMap: map[asyncRunnerPair]struct{}{},
+		runnerMap:         map[asyncRunnerPair]struct{}{},
+		instrumentRunners: map[metric.AsyncImpl]metric.AsyncRunner{},
 	}
 }
 
@@ -101,6 +106,7 @@ func (a *AsyncInstrumentState) Register(inst metric.AsyncImpl, runner metric.Asy
 	defer a.lock.Unlock()
 
 	a.instruments = append(a.instruments, inst)
+	a.instrumentRunners[inst] = runner
 
 	// asyncRunnerPair reflects this callback in the asyncRunners
 	// list.  If this is a batch runner, the instrument is nil.
@@ -120,6 +126,49 @@ func (a *AsyncInstrumentState) Register(inst metric.AsyncImpl, runner metric.Asy
 	}
 }
 
+// Unregister stops running the callback of inst and removes inst from
+// the instruments managed by this object. If inst was registered with
+// a batch runner, the runner and every instrument registered with it
+// are removed. Unregistering an unknown instrument has no effect.
+//
+// The runners and instruments are copied rather than modified in
+// place, so that Unregister may be called while Run is executing.
+func (a *AsyncInstrumentState) Unregister(inst metric.AsyncImpl) {
+	a.lock.Lock()
+	defer a.lock.Unlock()
+
+	runner, ok := a.instrumentRunners[inst]
+	if !ok {
+		return
+	}
+
+	rp := asyncRunnerPair{
+		runner: runner,
+	}
+	if _, ok := runner.(metric.AsyncSingleRunner); ok {
+		rp.inst = inst
+	}
+
+	instruments := make([]metric.AsyncImpl, 0, len(a.instruments))
+	for _, i := range a.instruments {
+		if i == inst || (rp.inst == nil && a.instrumentRunners[i] == runner) {
+			delete(a.instrumentRunners, i)
+			continue
+		}
+		instruments = append(instruments, i)
+	}
+	a.instruments = instruments
+
+	delete(a.runnerMap, rp)
+	runners := make([]asyncRunnerPair, 0, len(a.runners))
+	for _, r := range a.runners {
+		if r != rp {
+			runners = append(runners, r)
+		}
+	}
+	a.runners = runners
+}
+
 // Run executes the complete set of observer callbacks.
 func (a *AsyncInstrumentState) Run(ctx context.Context, collector AsyncCollector) {
 	a.lock.Lock()
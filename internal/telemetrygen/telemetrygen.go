@@ -0,0 +1,252 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package telemetrygen produces configurable synthetic span and metric
+// load against a TracerProvider or a MeterProvider.
+//
+// It is used to profile the SDK and to measure the throughput of the
+// exporters, for example from a benchmark:
+//
+//	stats := telemetrygen.GenerateSpans(ctx, tp, telemetrygen.SpanConfig{
+//		Load:          telemetrygen.Load{Workers: 4, Duration: 10 * time.Second},
+//		SpansPerTrace: 5,
+//		Attributes:    8,
+//	})
+//	fmt.Printf("%.0f spans/s\n", stats.PerSecond())
+package telemetrygen // import "go.opentelemetry.io/otel/internal/telemetrygen"
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"go.opentelemetry.io/otel/label"
+	"go.opentelemetry.io/otel/metric"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// instrumentationName is the name of the tracers and meters the load is
+// generated with.
+const instrumentationName = "go.opentelemetry.io/otel/internal/telemetrygen"
+
+// Load describes how much load is generated and how fast.
+type Load struct {
+	// Workers is the number of goroutines generating the load. It
+	// defaults to 1.
+	Workers int
+	// Iterations is the number of iterations each worker runs, an
+	// iteration being a trace or a round of measurements. The workers
+	// run until Duration elapses when it is 0.
+	Iterations int
+	// Duration bounds the time the workers run for when it is positive.
+	// A single iteration per worker is run when neither Iterations nor
+	// Duration is set.
+	Duration time.Duration
+	// Rate is the total number of iterations per second across the
+	// workers. The workers run as fast as they can when it is not
+	// positive.
+	Rate float64
+}
+
+// SpanConfig configures the spans generated by GenerateSpans.
+type SpanConfig struct {
+	Load
+	// SpansPerTrace is the number of spans of each trace: a root span and
+	// SpansPerTrace-1 children of it. It defaults to 1.
+	SpansPerTrace int
+	// Attributes is the number of attributes set on each span.
+	Attributes int
+	// Events is the number of events added to each span.
+	Events int
+}
+
+// MetricConfig configures the measurements generated by
+// GenerateMetrics.
+type MetricConfig struct {
+	Load
+	// Counters is the number of Int64Counter instruments incremented at
+	// every iteration.
+	Counters int
+	// ValueRecorders is the number of Float64ValueRecorder instruments
+	// recorded at every iteration.
+	ValueRecorders int
+	// Cardinality is the number of distinct label sets the measurements
+	// are recorded with. It defaults to 1.
+	Cardinality int
+	// Labels is the number of constant labels added to every label set.
+	Labels int
+}
+
+// Stats summarizes a generated load.
+type Stats struct {
+	// Iterations is the number of iterations run by all the workers.
+	Iterations int64
+	// Items is the number of spans or measurements generated.
+	Items int64
+	// Elapsed is the time taken to generate the load.
+	Elapsed time.Duration
+}
+
+// PerSecond returns the number of items generated per second.
+func (s Stats) PerSecond() float64 {
+	if s.Elapsed <= 0 {
+		return 0
+	}
+	return float64(s.Items) / s.Elapsed.Seconds()
+}
+
+// GenerateSpans generates traces with the tracers of tp, as configured by
+// cfg, until the load is complete or ctx is done.
+func GenerateSpans(ctx context.Context, tp trace.TracerProvider, cfg SpanConfig) Stats {
+	tracer := tp.Tracer(instrumentationName)
+	spans := cfg.SpansPerTrace
+	if spans < 1 {
+		spans = 1
+	}
+	attrs := keyValues("telemetrygen.attribute", cfg.Attributes)
+
+	return run(ctx, cfg.Load, func(ctx context.Context) int64 {
+		ctx, root := tracer.Start(ctx, "root", trace.WithAttributes(attrs...))
+		addEvents(root, cfg.Events)
+		for i := 1; i < spans; i++ {
+			_, child := tracer.Start(ctx, "child", trace.WithAttributes(attrs...))
+			addEvents(child, cfg.Events)
+			child.End()
+		}
+		root.End()
+		return int64(spans)
+	})
+}
+
+// GenerateMetrics generates measurements with the meters of mp, as
+// configured by cfg, until the load is complete or ctx is done. It
+// returns an error if the instruments cannot be created.
+func GenerateMetrics(ctx context.Context, mp metric.MeterProvider, cfg MetricConfig) (Stats, error) {
+	meter := mp.Meter(instrumentationName)
+
+	counters := make([]metric.Int64Counter, cfg.Counters)
+	for i := range counters {
+		c, err := meter.NewInt64Counter(fmt.Sprintf("telemetrygen.counter.%d", i))
+		if err != nil {
+			return Stats{}, err
+		}
+		counters[i] = c
+	}
+	recorders := make([]metric.Float64ValueRecorder, cfg.ValueRecorders)
+	for i := range recorders {
+		r, err := meter.NewFloat64ValueRecorder(fmt.Sprintf("telemetrygen.recorder.%d", i))
+		if err != nil {
+			return Stats{}, err
+		}
+		recorders[i] = r
+	}
+
+	cardinality := cfg.Cardinality
+	if cardinality < 1 {
+		cardinality = 1
+	}
+	labelSets := make([][]label.KeyValue, cardinality)
+	for i := range labelSets {
+		labelSets[i] = append(keyValues("telemetrygen.label", cfg.Labels), label.Int("telemetrygen.series", i))
+	}
+
+	var series uint64
+	items := int64(len(counters) + len(recorders))
+	stats := run(ctx, cfg.Load, func(ctx context.Context) int64 {
+		labels := labelSets[atomic.AddUint64(&series, 1)%uint64(cardinality)]
+		for _, c := range counters {
+			c.Add(ctx, 1, labels...)
+		}
+		for i, r := range recorders {
+			r.Record(ctx, float64(i), labels...)
+		}
+		return items
+	})
+	return stats, nil
+}
+
+// run runs the iteration f with the workers of load and returns the
+// resulting stats.
+func run(ctx context.Context, load Load, f func(context.Context) int64) Stats {
+	workers := load.Workers
+	if workers < 1 {
+		workers = 1
+	}
+	iterations := load.Iterations
+	if iterations == 0 && load.Duration <= 0 {
+		iterations = 1
+	}
+	if load.Duration > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, load.Duration)
+		defer cancel()
+	}
+
+	var (
+		stats Stats
+		wg    sync.WaitGroup
+	)
+	start := time.Now()
+	for w := 0; w < workers; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			var tick <-chan time.Time
+			if load.Rate > 0 {
+				ticker := time.NewTicker(time.Duration(float64(workers) * float64(time.Second) / load.Rate))
+				defer ticker.Stop()
+				tick = ticker.C
+			}
+
+			var n, items int64
+			for iterations == 0 || n < int64(iterations) {
+				if tick != nil {
+					select {
+					case <-tick:
+					case <-ctx.Done():
+					}
+				}
+				if ctx.Err() != nil {
+					break
+				}
+				items += f(ctx)
+				n++
+			}
+			atomic.AddInt64(&stats.Iterations, n)
+			atomic.AddInt64(&stats.Items, items)
+		}()
+	}
+	wg.Wait()
+	stats.Elapsed = time.Since(start)
+	return stats
+}
+
+// keyValues returns n string attributes whose keys start with prefix.
+func keyValues(prefix string, n int) []label.KeyValue {
+	kvs := make([]label.KeyValue, n)
+	for i := range kvs {
+		kvs[i] = label.String(fmt.Sprintf("%s.%d", prefix, i), "value")
+	}
+	return kvs
+}
+
+// addEvents adds n events to span.
+func addEvents(span trace.Span, n int) {
+	for i := 0; i < n; i++ {
+		span.AddEvent("event")
+	}
+}
@@ -0,0 +1,102 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package telemetrygen
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.opentelemetry.io/otel/oteltest"
+)
+
+func TestGenerateSpans(t *testing.T) {
+	sr := new(oteltest.StandardSpanRecorder)
+	tp := oteltest.NewTracerProvider(oteltest.WithSpanRecorder(sr))
+
+	stats := GenerateSpans(context.Background(), tp, SpanConfig{
+		Load:          Load{Workers: 3, Iterations: 4},
+		SpansPerTrace: 2,
+		Attributes:    5,
+		Events:        1,
+	})
+	assert.Equal(t, int64(12), stats.Iterations)
+	assert.Equal(t, int64(24), stats.Items)
+
+	spans := sr.Completed()
+	require.Len(t, spans, 24)
+	for _, s := range spans {
+		assert.Len(t, s.Attributes(), 5)
+		assert.Len(t, s.Events(), 1)
+		if s.Name() == "child" {
+			assert.True(t, s.ParentSpanID().IsValid())
+		}
+	}
+}
+
+func TestGenerateMetrics(t *testing.T) {
+	impl, mp := oteltest.NewMeterProvider()
+
+	stats, err := GenerateMetrics(context.Background(), mp, MetricConfig{
+		Load:           Load{Iterations: 6},
+		Counters:       2,
+		ValueRecorders: 1,
+		Cardinality:    3,
+		Labels:         2,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, int64(6), stats.Iterations)
+	assert.Equal(t, int64(18), stats.Items)
+
+	require.Len(t, impl.MeasurementBatches, 18)
+	series := map[int64]bool{}
+	for _, b := range impl.MeasurementBatches {
+		require.Len(t, b.Labels, 3)
+		series[b.Labels[2].Value.AsInt64()] = true
+	}
+	assert.Len(t, series, 3)
+}
+
+func TestLoadDuration(t *testing.T) {
+	tp := oteltest.NewTracerProvider()
+	stats := GenerateSpans(context.Background(), tp, SpanConfig{
+		Load: Load{Workers: 2, Duration: 50 * time.Millisecond},
+	})
+	assert.True(t, stats.Elapsed >= 50*time.Millisecond)
+	assert.Greater(t, stats.Iterations, int64(0))
+	assert.Greater(t, stats.PerSecond(), 0.0)
+}
+
+func TestLoadRate(t *testing.T) {
+	tp := oteltest.NewTracerProvider()
+	stats := GenerateSpans(context.Background(), tp, SpanConfig{
+		Load: Load{Workers: 2, Duration: 200 * time.Millisecond, Rate: 50},
+	})
+	// 10 iterations are expected, allow for slow machines.
+	assert.LessOrEqual(t, stats.Iterations, int64(10))
+	assert.Greater(t, stats.Iterations, int64(0))
+}
+
+func TestLoadCanceled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	stats := GenerateSpans(ctx, oteltest.NewTracerProvider(), SpanConfig{
+		Load: Load{Iterations: 10},
+	})
+	assert.Equal(t, int64(0), stats.Iterations)
+}
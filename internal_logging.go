@@ -0,0 +1,32 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package otel // import "go.opentelemetry.io/otel"
+
+import (
+	"github.com/go-logr/logr"
+
+	"go.opentelemetry.io/otel/internal/global"
+)
+
+// SetLogger configures the logger used internally to opentelemetry.
+//
+// OpenTelemetry logs its diagnostics with the following verbosity levels:
+// warnings, such as dropped data or ignored configuration, at V(1);
+// informational messages at V(4); and debug messages, such as each export
+// and retry, at V(8). Errors are logged regardless of verbosity. The
+// default logger writes errors to STDERR.
+func SetLogger(logger logr.Logger) {
+	global.SetLogger(logger)
+}
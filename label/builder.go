@@ -0,0 +1,89 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package label
+
+import "sync"
+
+// SetBuilder constructs Sets from labels added over one or more calls
+// to Add.  The builder keeps its label slice and sorting temporary
+// between calls to Build, so instrumentation that builds Sets with the
+// same keys on every request does not reallocate them each time.
+//
+// A SetBuilder is not safe for concurrent use.
+type SetBuilder struct {
+	kvs []KeyValue
+	tmp Sortable
+}
+
+// setBuilderPool holds released builders.  The label slices of pooled
+// builders grow to a size that most Sets will not allocate new memory.
+var setBuilderPool = sync.Pool{
+	New: func() interface{} {
+		return &SetBuilder{}
+	},
+}
+
+// NewSetBuilder returns an empty SetBuilder, reusing one that was
+// returned with Release if possible.
+func NewSetBuilder() *SetBuilder {
+	return setBuilderPool.Get().(*SetBuilder)
+}
+
+// Add appends labels to the builder.  As with NewSet, later values
+// win over earlier values for the same key.
+func (b *SetBuilder) Add(kvs ...KeyValue) {
+	b.kvs = append(b.kvs, kvs...)
+}
+
+// Len returns the number of labels added since the last call to
+// Build or Reset, including duplicates.
+func (b *SetBuilder) Len() int {
+	return len(b.kvs)
+}
+
+// Build returns a Set of the added labels and resets the builder.
+// The returned Set does not share memory with the builder.
+func (b *SetBuilder) Build() Set {
+	defer b.Reset()
+	return NewSetWithSortable(b.kvs, &b.tmp)
+}
+
+// BuildFiltered is like Build, but includes only the labels accepted
+// by filter in the Set.  Excluded labels are returned in a newly
+// allocated slice.
+func (b *SetBuilder) BuildFiltered(filter Filter) (Set, []KeyValue) {
+	defer b.Reset()
+	s, excluded := NewSetWithSortableFiltered(b.kvs, &b.tmp, filter)
+	if len(excluded) == 0 {
+		return Set{equivalent: s.equivalent}, nil
+	}
+	return Set{equivalent: s.equivalent}, append([]KeyValue(nil), excluded...)
+}
+
+// Reset discards the added labels, keeping the allocated memory for
+// the next Set.
+func (b *SetBuilder) Reset() {
+	for i := range b.kvs {
+		b.kvs[i] = KeyValue{}
+	}
+	b.kvs = b.kvs[:0]
+}
+
+// Release resets the builder and returns it to the pool used by
+// NewSetBuilder.  The builder must not be used after Release.
+func (b *SetBuilder) Release() {
+	b.Reset()
+	setBuilderPool.Put(b)
+}
@@ -0,0 +1,90 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package label_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"go.opentelemetry.io/otel/label"
+)
+
+func TestSetBuilder(t *testing.T) {
+	b := label.NewSetBuilder()
+	defer b.Release()
+
+	b.Add(label.String("A", "1"), label.String("B", "2"))
+	b.Add(label.String("A", "3"))
+	assert.Equal(t, 3, b.Len())
+
+	s := b.Build()
+	assert.Equal(t, 0, b.Len())
+	want := label.NewSet(label.String("A", "3"), label.String("B", "2"))
+	assert.Equal(t, want.Equivalent(), s.Equivalent())
+
+	// Reusing the builder must not change Sets that were already built.
+	b.Add(label.String("A", "4"), label.String("C", "5"))
+	s2 := b.Build()
+	assert.Equal(t, want.Equivalent(), s.Equivalent())
+	assert.Equal(t, "A=4,C=5", s2.Encoded(label.DefaultEncoder()))
+
+	empty := b.Build()
+	assert.Equal(t, 0, empty.Len())
+}
+
+func TestSetBuilderFiltered(t *testing.T) {
+	b := label.NewSetBuilder()
+	defer b.Release()
+
+	b.Add(label.String("A", "1"), label.String("B", "2"), label.String("C", "3"))
+	s, excluded := b.BuildFiltered(func(kv label.KeyValue) bool {
+		return kv.Key != "B"
+	})
+	assert.Equal(t, "A=1,C=3", s.Encoded(label.DefaultEncoder()))
+	assert.Equal(t, []label.KeyValue{label.String("B", "2")}, excluded)
+
+	// Excluded labels must not be overwritten by later use.
+	b.Add(label.String("D", "4"), label.String("E", "5"))
+	b.Build()
+	assert.Equal(t, []label.KeyValue{label.String("B", "2")}, excluded)
+}
+
+func BenchmarkSetBuilder(b *testing.B) {
+	builder := label.NewSetBuilder()
+	defer builder.Release()
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		builder.Add(
+			label.String("http.method", "GET"),
+			label.Int("http.status_code", 200),
+			label.String("http.route", "/users/:id"),
+		)
+		_ = builder.Build()
+	}
+}
+
+func BenchmarkNewSet(b *testing.B) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		_ = label.NewSet(
+			label.String("http.method", "GET"),
+			label.Int("http.status_code", 200),
+			label.String("http.route", "/users/:id"),
+		)
+	}
+}
@@ -0,0 +1,98 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package label // import "go.opentelemetry.io/otel/label"
+
+import (
+	"sync"
+	"sync/atomic"
+)
+
+// Handle is a compact reference to a pre-registered Set.  Instrumentation
+// that records with the same label sets on every request can register
+// them once, with NewHandle or a HandleCache, and record with the Set of
+// the Handle: implementations recognize registered Sets by their
+// HandleID and can look up the state they keep for them with it instead
+// of the Distinct value of the labels.
+//
+// The zero Handle refers to the empty set and is not registered.
+type Handle struct {
+	set *Set
+}
+
+// lastHandleID is the ID of the last Handle that was registered.
+var lastHandleID uint64
+
+// NewHandle registers a Set of the labels and returns its Handle.  Every
+// call registers a new Set with a new HandleID, Handles are meant to be
+// created for a bounded number of label sets, typically when the
+// instrumentation is set up.
+func NewHandle(kvs ...KeyValue) Handle {
+	s := NewSet(kvs...)
+	return Handle{set: &Set{
+		equivalent: s.equivalent,
+		handle:     atomic.AddUint64(&lastHandleID, 1),
+	}}
+}
+
+// Set returns the registered Set.
+func (h Handle) Set() *Set {
+	if h.set == nil {
+		return emptySet
+	}
+	return h.set
+}
+
+// ID returns the HandleID of the registered Set, zero for the zero
+// Handle.
+func (h Handle) ID() uint64 {
+	return h.set.HandleID()
+}
+
+// HandleID returns the ID of the Handle the set was registered with, or
+// zero if it was not registered.  IDs are unique in the process.
+func (l *Set) HandleID() uint64 {
+	if l == nil {
+		return 0
+	}
+	return l.handle
+}
+
+// HandleCache registers the Handles of label sets under keys provided
+// by the caller, such as the route of a request, so that they are only
+// registered the first time the key is seen.  A HandleCache is safe for
+// concurrent use, the zero value is ready to use.
+type HandleCache struct {
+	handles sync.Map
+}
+
+// Load returns the Handle registered under key, if any.
+func (c *HandleCache) Load(key interface{}) (Handle, bool) {
+	h, ok := c.handles.Load(key)
+	if !ok {
+		return Handle{}, false
+	}
+	return h.(Handle), true
+}
+
+// Register returns the Handle registered under key, registering a Set
+// of the labels if there is none.  The labels are ignored if the key
+// is already registered.
+func (c *HandleCache) Register(key interface{}, kvs ...KeyValue) Handle {
+	if h, ok := c.Load(key); ok {
+		return h
+	}
+	h, _ := c.handles.LoadOrStore(key, NewHandle(kvs...))
+	return h.(Handle)
+}
@@ -0,0 +1,60 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package label_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"go.opentelemetry.io/otel/label"
+)
+
+func TestHandle(t *testing.T) {
+	var zero label.Handle
+	assert.Equal(t, uint64(0), zero.ID())
+	assert.Equal(t, 0, zero.Set().Len())
+
+	kvs := []label.KeyValue{label.String("A", "1"), label.String("B", "2")}
+	h := label.NewHandle(kvs...)
+	assert.NotEqual(t, uint64(0), h.ID())
+	assert.Equal(t, h.ID(), h.Set().HandleID())
+
+	set := label.NewSet(kvs...)
+	assert.Equal(t, uint64(0), set.HandleID())
+	assert.True(t, set.Equals(h.Set()), "a registered set must be equivalent to the labels")
+
+	other := label.NewHandle(kvs...)
+	assert.NotEqual(t, h.ID(), other.ID())
+}
+
+func TestHandleCache(t *testing.T) {
+	var c label.HandleCache
+
+	_, ok := c.Load("/users")
+	assert.False(t, ok)
+
+	h := c.Register("/users", label.String("http.route", "/users"))
+	got, ok := c.Load("/users")
+	assert.True(t, ok)
+	assert.Equal(t, h, got)
+
+	// The labels of registered keys are ignored.
+	assert.Equal(t, h, c.Register("/users", label.String("http.route", "/other")))
+	v, _ := h.Set().Value("http.route")
+	assert.Equal(t, "/users", v.AsString())
+
+	assert.NotEqual(t, h.ID(), c.Register("/orders", label.String("http.route", "/orders")).ID())
+}
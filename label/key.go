@@ -167,3 +167,29 @@ func (k Key) Array(v interface{}) KeyValue {
 		Value: ArrayValue(v),
 	}
 }
+
+// List creates a KeyValue instance with a LIST Value.
+//
+// If creating both key and a list value at the same time, then
+// instead of calling Key(name).List(values...) consider using a
+// convenience function provided by the api/key package -
+// key.List(name, values...).
+func (k Key) List(vs ...Value) KeyValue {
+	return KeyValue{
+		Key:   k,
+		Value: ListValue(vs...),
+	}
+}
+
+// Map creates a KeyValue instance with a MAP Value.
+//
+// If creating both key and a map value at the same time, then
+// instead of calling Key(name).Map(kvs...) consider using a
+// convenience function provided by the api/key package -
+// key.Map(name, kvs...).
+func (k Key) Map(kvs ...KeyValue) KeyValue {
+	return KeyValue{
+		Key:   k,
+		Value: MapValue(kvs...),
+	}
+}
@@ -100,6 +100,18 @@ func Array(k string, v interface{}) KeyValue {
 	return Key(k).Array(v)
 }
 
+// List creates a new key-value pair with a passed name and a LIST
+// value of vs, which may be of different types.
+func List(k string, vs ...Value) KeyValue {
+	return Key(k).List(vs...)
+}
+
+// Map creates a new key-value pair with a passed name and a MAP value
+// of the nested kvs.
+func Map(k string, kvs ...KeyValue) KeyValue {
+	return Key(k).Map(kvs...)
+}
+
 // Any creates a new key-value pair instance with a passed name and
 // automatic type inference. This is slower, and not type-safe.
 func Any(k string, value interface{}) KeyValue {
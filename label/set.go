@@ -36,6 +36,10 @@ type (
 	Set struct {
 		equivalent Distinct
 
+		// handle is the ID of the Handle the set was registered
+		// with, zero if it was not registered.
+		handle uint64
+
 		lock     sync.Mutex
 		encoders [maxConcurrentEncoders]EncoderID
 		encoded  [maxConcurrentEncoders]string
@@ -246,7 +250,7 @@ func NewSet(kvs ...KeyValue) Set {
 		return empty()
 	}
 	s, _ := NewSetWithSortableFiltered(kvs, new(Sortable), nil)
-	return s //nolint
+	return Set{equivalent: s.equivalent}
 }
 
 // NewSetWithSortable returns a new `Set`.  See the documentation for
@@ -259,7 +263,7 @@ func NewSetWithSortable(kvs []KeyValue, tmp *Sortable) Set {
 		return empty()
 	}
 	s, _ := NewSetWithSortableFiltered(kvs, tmp, nil)
-	return s //nolint
+	return Set{equivalent: s.equivalent}
 }
 
 // NewSetWithFiltered returns a new `Set`.  See the documentation for
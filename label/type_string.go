@@ -18,11 +18,13 @@ func _() {
 	_ = x[FLOAT64-7]
 	_ = x[STRING-8]
 	_ = x[ARRAY-9]
+	_ = x[LIST-10]
+	_ = x[MAP-11]
 }
 
-const _Type_name = "INVALIDBOOLINT32INT64UINT32UINT64FLOAT32FLOAT64STRINGARRAY"
+const _Type_name = "INVALIDBOOLINT32INT64UINT32UINT64FLOAT32FLOAT64STRINGARRAYLISTMAP"
 
-var _Type_index = [...]uint8{0, 7, 11, 16, 21, 27, 33, 40, 47, 53, 58}
+var _Type_index = [...]uint8{0, 7, 11, 16, 21, 27, 33, 40, 47, 53, 58, 62, 65}
 
 func (i Type) String() string {
 	if i < 0 || i >= Type(len(_Type_index)-1) {
@@ -18,7 +18,9 @@ import (
 	"encoding/json"
 	"fmt"
 	"reflect"
+	"sort"
 	"strconv"
+	"strings"
 	"unsafe"
 
 	"go.opentelemetry.io/otel/internal"
@@ -62,6 +64,13 @@ const (
 	// arrays of bool, int, int32, int64, uint, uint32, uint64, float,
 	// float32, float64, or string types.
 	ARRAY
+	// LIST is a list Type Value used to store an ordered sequence of
+	// Values that may each be of a different Type, including LIST and
+	// MAP.
+	LIST
+	// MAP is a map Type Value used to store a nested set of KeyValues
+	// with unique keys.
+	MAP
 )
 
 // BoolValue creates a BOOL Value.
@@ -177,6 +186,47 @@ func ArrayValue(v interface{}) Value {
 	return Value{vtype: INVALID}
 }
 
+// ListValue creates a LIST Value from vs. Unlike an ARRAY Value, the
+// elements of a LIST Value may be of different types, and may
+// themselves be LIST or MAP Values.
+func ListValue(vs ...Value) Value {
+	frozen := reflect.New(reflect.ArrayOf(len(vs), reflect.TypeOf(Value{}))).Elem()
+	for i, v := range vs {
+		frozen.Index(i).Set(reflect.ValueOf(v))
+	}
+	return Value{
+		vtype: LIST,
+		array: frozen.Interface(),
+	}
+}
+
+// MapValue creates a MAP Value from kvs. The KeyValues are sorted by
+// key, and if a key is repeated the last value found for it is
+// preserved.
+func MapValue(kvs ...KeyValue) Value {
+	sorted := make(Sortable, len(kvs))
+	copy(sorted, kvs)
+	sort.Stable(&sorted)
+
+	// Keep the last of each run of equal keys.
+	unique := sorted[:0]
+	for i, kv := range sorted {
+		if i+1 < len(sorted) && sorted[i+1].Key == kv.Key {
+			continue
+		}
+		unique = append(unique, kv)
+	}
+
+	frozen := reflect.New(reflect.ArrayOf(len(unique), reflect.TypeOf(KeyValue{}))).Elem()
+	for i, kv := range unique {
+		frozen.Index(i).Set(reflect.ValueOf(kv))
+	}
+	return Value{
+		vtype: MAP,
+		array: frozen.Interface(),
+	}
+}
+
 // Type returns a type of the Value.
 func (v Value) Type() Type {
 	return v.vtype
@@ -235,6 +285,34 @@ func (v Value) AsArray() interface{} {
 	return v.array
 }
 
+// AsList returns a copy of the elements of a LIST Value. Make sure that
+// the Value's type is LIST.
+func (v Value) AsList() []Value {
+	rv := reflect.ValueOf(v.array)
+	if v.vtype != LIST || !rv.IsValid() {
+		return nil
+	}
+	out := make([]Value, rv.Len())
+	for i := range out {
+		out[i] = rv.Index(i).Interface().(Value)
+	}
+	return out
+}
+
+// AsMap returns a copy of the KeyValues of a MAP Value, sorted by key.
+// Make sure that the Value's type is MAP.
+func (v Value) AsMap() []KeyValue {
+	rv := reflect.ValueOf(v.array)
+	if v.vtype != MAP || !rv.IsValid() {
+		return nil
+	}
+	out := make([]KeyValue, rv.Len())
+	for i := range out {
+		out[i] = rv.Index(i).Interface().(KeyValue)
+	}
+	return out
+}
+
 type unknownValueType struct{}
 
 // AsInterface returns Value's data as interface{}.
@@ -242,6 +320,10 @@ func (v Value) AsInterface() interface{} {
 	switch v.Type() {
 	case ARRAY:
 		return v.AsArray()
+	case LIST:
+		return v.AsList()
+	case MAP:
+		return v.AsMap()
 	case BOOL:
 		return v.AsBool()
 	case INT32:
@@ -267,6 +349,20 @@ func (v Value) Emit() string {
 	switch v.Type() {
 	case ARRAY:
 		return fmt.Sprint(v.array)
+	case LIST:
+		list := v.AsList()
+		elems := make([]string, len(list))
+		for i, e := range list {
+			elems[i] = e.Emit()
+		}
+		return "[" + strings.Join(elems, " ") + "]"
+	case MAP:
+		m := v.AsMap()
+		elems := make([]string, len(m))
+		for i, kv := range m {
+			elems[i] = string(kv.Key) + ":" + kv.Value.Emit()
+		}
+		return "map[" + strings.Join(elems, " ") + "]"
 	case BOOL:
 		return strconv.FormatBool(v.AsBool())
 	case INT32:
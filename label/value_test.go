@@ -15,6 +15,7 @@
 package label_test
 
 import (
+	"encoding/json"
 	"reflect"
 	"testing"
 	"unsafe"
@@ -211,3 +212,69 @@ func TestAsArrayValue(t *testing.T) {
 		t.Errorf("AsArray() returned %T, want %T", got, want)
 	}
 }
+
+func TestListValue(t *testing.T) {
+	v := label.ListValue(
+		label.StringValue("a"),
+		label.Int64Value(1),
+		label.ListValue(label.BoolValue(true)),
+	)
+	if got, want := v.Type(), label.LIST; got != want {
+		t.Fatalf("Type() = %v, want %v", got, want)
+	}
+	want := []label.Value{
+		label.StringValue("a"),
+		label.Int64Value(1),
+		label.ListValue(label.BoolValue(true)),
+	}
+	if diff := cmp.Diff(want, v.AsList(), cmp.AllowUnexported(label.Value{})); diff != "" {
+		t.Errorf("AsList() diff (-want +got): %s", diff)
+	}
+	if got, want := v.Emit(), "[a 1 [true]]"; got != want {
+		t.Errorf("Emit() = %q, want %q", got, want)
+	}
+	if v != label.ListValue(want...) {
+		t.Error("equal LIST values are not comparable as equal")
+	}
+}
+
+func TestMapValue(t *testing.T) {
+	v := label.MapValue(
+		label.String("b", "first"),
+		label.Int("a", 1),
+		label.String("b", "last"),
+		label.Map("nested", label.Bool("ok", true)),
+	)
+	if got, want := v.Type(), label.MAP; got != want {
+		t.Fatalf("Type() = %v, want %v", got, want)
+	}
+	want := []label.KeyValue{
+		label.Int("a", 1),
+		label.String("b", "last"),
+		label.Map("nested", label.Bool("ok", true)),
+	}
+	if diff := cmp.Diff(want, v.AsMap(), cmp.AllowUnexported(label.Value{})); diff != "" {
+		t.Errorf("AsMap() diff (-want +got): %s", diff)
+	}
+	if got, want := v.Emit(), "map[a:1 b:last nested:map[ok:true]]"; got != want {
+		t.Errorf("Emit() = %q, want %q", got, want)
+	}
+
+	// MAP values can be used in Sets, which require comparable values.
+	set := label.NewSet(label.KeyValue{Key: "m", Value: v})
+	if got, ok := set.Value("m"); !ok || got != v {
+		t.Errorf("Set.Value() = %v, %v, want %v, true", got, ok, v)
+	}
+}
+
+func TestNestedValueMarshalJSON(t *testing.T) {
+	kv := label.Map("m", label.List("l", label.StringValue("a"), label.Int64Value(1)))
+	got, err := json.Marshal(kv)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := `{"Key":"m","Value":{"Type":"MAP","Value":[{"Key":"l","Value":{"Type":"LIST","Value":[{"Type":"STRING","Value":"a"},{"Type":"INT64","Value":1}]}}]}}`
+	if string(got) != want {
+		t.Errorf("json.Marshal() = %s, want %s", got, want)
+	}
+}
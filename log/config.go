@@ -0,0 +1,60 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package log // import "go.opentelemetry.io/otel/log"
+
+// LoggerConfig is a group of options for a Logger.
+type LoggerConfig struct {
+	// InstrumentationVersion is the version of the library providing
+	// instrumentation.
+	InstrumentationVersion string
+	// SchemaURL is the schema URL of the records emitted by the Logger.
+	SchemaURL string
+}
+
+// NewLoggerConfig applies all the options to a returned LoggerConfig.
+func NewLoggerConfig(options ...LoggerOption) *LoggerConfig {
+	config := new(LoggerConfig)
+	for _, option := range options {
+		option.ApplyLogger(config)
+	}
+	return config
+}
+
+// LoggerOption applies an option to a LoggerConfig.
+type LoggerOption interface {
+	ApplyLogger(*LoggerConfig)
+}
+
+// WithInstrumentationVersion sets the instrumentation version.
+func WithInstrumentationVersion(version string) LoggerOption {
+	return instrumentationVersionOption(version)
+}
+
+type instrumentationVersionOption string
+
+func (i instrumentationVersionOption) ApplyLogger(config *LoggerConfig) {
+	config.InstrumentationVersion = string(i)
+}
+
+// WithSchemaURL sets the schema URL of the records emitted by a Logger.
+func WithSchemaURL(schemaURL string) LoggerOption {
+	return schemaURLOption(schemaURL)
+}
+
+type schemaURLOption string
+
+func (s schemaURLOption) ApplyLogger(config *LoggerConfig) {
+	config.SchemaURL = string(s)
+}
@@ -0,0 +1,41 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+/*
+Package log provides an implementation of the logging part of the
+OpenTelemetry API.
+
+This package is currently in a pre-GA phase. Backwards incompatible changes
+may be introduced in subsequent minor version releases as we work to track the
+evolving OpenTelemetry specification and user feedback.
+
+This package is a bridge API: it is not meant to be used directly by
+applications to write logs, but by adapters of existing logging libraries
+(for example a log/slog Handler) to emit their records into an
+OpenTelemetry logging pipeline. An SDK, like
+go.opentelemetry.io/otel/sdk/log, provides the implementation of the
+LoggerProvider that records are emitted to.
+
+A Logger emits Records. The span context of the context a Record is
+emitted with is associated with the record by the SDK, which correlates
+logs with traces:
+
+	logger := provider.Logger("example.com/adapter")
+	logger.Emit(ctx, log.Record{
+		Timestamp: time.Now(),
+		Severity:  log.SeverityInfo,
+		Body:      label.StringValue("hello"),
+	})
+*/
+package log // import "go.opentelemetry.io/otel/log"
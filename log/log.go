@@ -0,0 +1,102 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package log // import "go.opentelemetry.io/otel/log"
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"go.opentelemetry.io/otel/label"
+)
+
+// Severity is the severity of a log record. Its values are the severity
+// numbers of the OpenTelemetry log data model: each named severity, like
+// SeverityInfo, is the first of a range of four, and greater values are
+// more severe.
+type Severity int
+
+const (
+	// SeverityUndefined is the severity of a record that has none.
+	SeverityUndefined Severity = 0
+	// SeverityTrace is the severity of fine-grained debugging events.
+	SeverityTrace Severity = 1
+	// SeverityDebug is the severity of debugging events.
+	SeverityDebug Severity = 5
+	// SeverityInfo is the severity of informational events.
+	SeverityInfo Severity = 9
+	// SeverityWarn is the severity of warning events.
+	SeverityWarn Severity = 13
+	// SeverityError is the severity of error events.
+	SeverityError Severity = 17
+	// SeverityFatal is the severity of fatal errors.
+	SeverityFatal Severity = 21
+)
+
+var severityNames = [...]string{"TRACE", "DEBUG", "INFO", "WARN", "ERROR", "FATAL"}
+
+// String returns the short name of the severity, like "INFO", followed by
+// its position in the range of the named severity if it is not the first,
+// like "INFO2".
+func (s Severity) String() string {
+	if s < SeverityTrace || s > SeverityFatal+3 {
+		return "UNDEFINED"
+	}
+	i := int(s - SeverityTrace)
+	name := severityNames[i/4]
+	if n := i % 4; n > 0 {
+		name += strconv.Itoa(n + 1)
+	}
+	return name
+}
+
+// Record is a log record emitted by a Logger.
+type Record struct {
+	// Timestamp is the time of the event the record describes. The
+	// time the record is emitted at is used if it is zero.
+	Timestamp time.Time
+	// Severity is the severity of the record.
+	Severity Severity
+	// SeverityText is the severity of the record as known by its
+	// source, for example the name of the level of a logging library.
+	SeverityText string
+	// Body is the body of the record, usually its message.
+	Body label.Value
+	// Attributes describe the event the record describes.
+	Attributes []label.KeyValue
+}
+
+// Logger emits log records.
+type Logger interface {
+	// Emit emits r. The span context of ctx, if any, is associated with
+	// the record. Emit must not retain r.Attributes.
+	Emit(ctx context.Context, r Record)
+
+	// Enabled returns whether a record with severity emitted with ctx
+	// would be recorded. Adapters use it to avoid building records that
+	// would be dropped.
+	Enabled(ctx context.Context, severity Severity) bool
+}
+
+// LoggerProvider provides access to instrumentation Loggers.
+type LoggerProvider interface {
+	// Logger creates an implementation of the Logger interface.
+	// The instrumentationName must be the name of the library providing
+	// instrumentation, like the logging adapter. This name may be the
+	// same as the instrumented code only if that code provides built-in
+	// instrumentation. If the instrumentationName is empty, then a
+	// implementation defined default name will be used instead.
+	Logger(instrumentationName string, opts ...LoggerOption) Logger
+}
@@ -0,0 +1,61 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package log
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSeverityString(t *testing.T) {
+	for _, test := range []struct {
+		severity Severity
+		want     string
+	}{
+		{SeverityUndefined, "UNDEFINED"},
+		{SeverityTrace, "TRACE"},
+		{SeverityTrace + 1, "TRACE2"},
+		{SeverityDebug, "DEBUG"},
+		{SeverityInfo, "INFO"},
+		{SeverityInfo + 3, "INFO4"},
+		{SeverityWarn, "WARN"},
+		{SeverityError, "ERROR"},
+		{SeverityFatal, "FATAL"},
+		{SeverityFatal + 3, "FATAL4"},
+		{SeverityFatal + 4, "UNDEFINED"},
+		{-1, "UNDEFINED"},
+	} {
+		assert.Equal(t, test.want, test.severity.String(), "severity %d", test.severity)
+	}
+}
+
+func TestNewLoggerConfig(t *testing.T) {
+	assert.Equal(t, &LoggerConfig{}, NewLoggerConfig())
+	assert.Equal(t, &LoggerConfig{
+		InstrumentationVersion: "v0.1.0",
+		SchemaURL:              "https://opentelemetry.io/schemas/1.0.0",
+	}, NewLoggerConfig(
+		WithInstrumentationVersion("v0.1.0"),
+		WithSchemaURL("https://opentelemetry.io/schemas/1.0.0"),
+	))
+}
+
+func TestNoopLogger(t *testing.T) {
+	logger := NewNoopLoggerProvider().Logger("test")
+	assert.False(t, logger.Enabled(context.Background(), SeverityFatal))
+	logger.Emit(context.Background(), Record{Severity: SeverityInfo})
+}
@@ -30,6 +30,12 @@ type InstrumentConfig struct {
 	// InstrumentationVersion is the version of the library providing
 	// instrumentation.
 	InstrumentationVersion string
+	// InstrumentationSchemaURL is the schema URL of the library providing
+	// instrumentation.
+	InstrumentationSchemaURL string
+	// ExplicitBucketBoundaries advises the SDK of the bucket boundaries to
+	// use if it aggregates the instrument into a histogram.
+	ExplicitBucketBoundaries []float64
 }
 
 // InstrumentOption is an interface for applying metric instrument options.
@@ -71,6 +77,20 @@ func (u unitOption) ApplyInstrument(config *InstrumentConfig) {
 	config.Unit = unit.Unit(u)
 }
 
+// WithExplicitBucketBoundaries advises the SDK to use boundaries as the
+// bucket boundaries of the histogram aggregating the instrument. It is only
+// advice: the SDK may ignore it, e.g. because the instrument is not
+// aggregated into a histogram or the user configured other boundaries.
+func WithExplicitBucketBoundaries(boundaries ...float64) InstrumentOption {
+	return explicitBucketBoundariesOption(append([]float64(nil), boundaries...))
+}
+
+type explicitBucketBoundariesOption []float64
+
+func (b explicitBucketBoundariesOption) ApplyInstrument(config *InstrumentConfig) {
+	config.ExplicitBucketBoundaries = []float64(b)
+}
+
 // WithInstrumentationName sets the instrumentation name.
 func WithInstrumentationName(name string) InstrumentOption {
 	return instrumentationNameOption(name)
@@ -87,6 +107,8 @@ type MeterConfig struct {
 	// InstrumentationVersion is the version of the library providing
 	// instrumentation.
 	InstrumentationVersion string
+	// SchemaURL is the schema URL of the telemetry emitted by the Meter.
+	SchemaURL string
 }
 
 // MeterOption is an interface for applying Meter options.
@@ -126,3 +148,18 @@ func (i instrumentationVersionOption) ApplyMeter(config *MeterConfig) {
 func (i instrumentationVersionOption) ApplyInstrument(config *InstrumentConfig) {
 	config.InstrumentationVersion = string(i)
 }
+
+// WithSchemaURL sets the schema URL of the instrumentation.
+func WithSchemaURL(schemaURL string) InstrumentationOption {
+	return schemaURLOption(schemaURL)
+}
+
+type schemaURLOption string
+
+func (s schemaURLOption) ApplyMeter(config *MeterConfig) {
+	config.SchemaURL = string(s)
+}
+
+func (s schemaURLOption) ApplyInstrument(config *InstrumentConfig) {
+	config.InstrumentationSchemaURL = string(s)
+}
@@ -37,8 +37,8 @@ type MeterProvider interface {
 //
 // An uninitialized Meter is a no-op implementation.
 type Meter struct {
-	impl          MeterImpl
-	name, version string
+	impl                     MeterImpl
+	name, version, schemaURL string
 }
 
 // RecordBatch atomically records a batch of measurements.
@@ -49,6 +49,20 @@ func (m Meter) RecordBatch(ctx context.Context, ls []label.KeyValue, ms ...Measu
 	m.impl.RecordBatch(ctx, ls, ms...)
 }
 
+// RecordBatchWithSet atomically records a batch of measurements with a
+// precomputed label set. Use it instead of RecordBatch when the same
+// labels are recorded repeatedly, to avoid sorting them on every call.
+func (m Meter) RecordBatchWithSet(ctx context.Context, labels *label.Set, ms ...Measurement) {
+	if m.impl == nil {
+		return
+	}
+	if impl, ok := m.impl.(LabelSetMeterImpl); ok {
+		impl.RecordBatchWithSet(ctx, labels, ms...)
+		return
+	}
+	m.impl.RecordBatch(ctx, labels.ToSlice(), ms...)
+}
+
 // NewBatchObserver creates a new BatchObserver that supports
 // making batches of observations for multiple instruments.
 func (m Meter) NewBatchObserver(callback BatchObserverFunc) BatchObserver {
@@ -287,6 +301,7 @@ func (m Meter) newAsync(
 	desc := NewDescriptor(name, mkind, nkind, opts...)
 	desc.config.InstrumentationName = m.name
 	desc.config.InstrumentationVersion = m.version
+	desc.config.InstrumentationSchemaURL = m.schemaURL
 	return m.impl.NewAsyncInstrument(desc, runner)
 }
 
@@ -306,6 +321,7 @@ func (m Meter) newSync(
 	desc := NewDescriptor(name, metricKind, numberKind, opts...)
 	desc.config.InstrumentationName = m.name
 	desc.config.InstrumentationVersion = m.version
+	desc.config.InstrumentationSchemaURL = m.schemaURL
 	return m.impl.NewSyncInstrument(desc)
 }
 
@@ -558,6 +574,13 @@ func (d Descriptor) Unit() unit.Unit {
 	return d.config.Unit
 }
 
+// ExplicitBucketBoundaries returns the histogram bucket boundaries advised
+// for the metric instrument, or nil if there is no such advice. The
+// returned slice must not be modified.
+func (d Descriptor) ExplicitBucketBoundaries() []float64 {
+	return d.config.ExplicitBucketBoundaries
+}
+
 // NumberKind returns whether this instrument is declared over int64,
 // float64, or uint64 values.
 func (d Descriptor) NumberKind() number.Kind {
@@ -575,3 +598,9 @@ func (d Descriptor) InstrumentationName() string {
 func (d Descriptor) InstrumentationVersion() string {
 	return d.config.InstrumentationVersion
 }
+
+// InstrumentationSchemaURL returns the schema URL of the library that
+// provided instrumentation for this instrument.
+func (d Descriptor) InstrumentationSchemaURL() string {
+	return d.config.InstrumentationSchemaURL
+}
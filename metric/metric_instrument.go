@@ -27,6 +27,31 @@ import (
 // ErrSDKReturnedNilImpl is returned when a new `MeterImpl` returns nil.
 var ErrSDKReturnedNilImpl = errors.New("SDK returned a nil implementation")
 
+// ErrUnregisterUnsupported is returned when unregistering the callback
+// of an asynchronous instrument whose SDK does not support it.
+var ErrUnregisterUnsupported = errors.New("SDK does not support unregistering callbacks")
+
+// Registration is the registration of the callback of an asynchronous
+// instrument. All the Observer instruments implement it.
+type Registration interface {
+	// Unregister stops running the callback and stops reporting the
+	// instrument. For instruments created through a BatchObserver,
+	// the batch callback and all of its instruments are unregistered.
+	//
+	// Unregister may be called from within the callback, and calling
+	// it more than once has no effect.
+	Unregister() error
+}
+
+var (
+	_ Registration = Int64ValueObserver{}
+	_ Registration = Float64ValueObserver{}
+	_ Registration = Int64SumObserver{}
+	_ Registration = Float64SumObserver{}
+	_ Registration = Int64UpDownSumObserver{}
+	_ Registration = Float64UpDownSumObserver{}
+)
+
 // InstrumentKind describes the kind of instrument.
 type InstrumentKind int8
 
@@ -437,6 +462,17 @@ func (a asyncInstrument) AsyncImpl() AsyncImpl {
 	return a.instrument
 }
 
+// Unregister implements Registration.
+func (a asyncInstrument) Unregister() error {
+	if a.instrument == nil {
+		return nil
+	}
+	if impl, ok := a.instrument.(UnregisterAsyncImpl); ok {
+		return impl.Unregister()
+	}
+	return ErrUnregisterUnsupported
+}
+
 // SyncImpl returns the implementation object for synchronous instruments.
 func (s syncInstrument) SyncImpl() SyncImpl {
 	return s.instrument
@@ -458,6 +494,14 @@ func (s syncInstrument) directRecord(ctx context.Context, number number.Number,
 	s.instrument.RecordOne(ctx, number, labels)
 }
 
+func (s syncInstrument) directRecordWithSet(ctx context.Context, number number.Number, labels *label.Set) {
+	if impl, ok := s.instrument.(LabelSetSyncImpl); ok {
+		impl.RecordOneWithSet(ctx, number, labels)
+		return
+	}
+	s.instrument.RecordOne(ctx, number, labels.ToSlice())
+}
+
 func (h syncBoundInstrument) directRecord(ctx context.Context, number number.Number) {
 	h.boundInstrument.RecordOne(ctx, number)
 }
@@ -607,12 +651,26 @@ func (c Float64Counter) Add(ctx context.Context, value float64, labels ...label.
 	c.directRecord(ctx, number.NewFloat64Number(value), labels)
 }
 
+// AddWithSet adds the value to the counter's sum using a
+// precomputed label set. Use it instead of Add when the same labels
+// are recorded repeatedly, to avoid sorting them on every call.
+func (c Float64Counter) AddWithSet(ctx context.Context, value float64, labels *label.Set) {
+	c.directRecordWithSet(ctx, number.NewFloat64Number(value), labels)
+}
+
 // Add adds the value to the counter's sum. The labels should contain
 // the keys and values to be associated with this value.
 func (c Int64Counter) Add(ctx context.Context, value int64, labels ...label.KeyValue) {
 	c.directRecord(ctx, number.NewInt64Number(value), labels)
 }
 
+// AddWithSet adds the value to the counter's sum using a
+// precomputed label set. Use it instead of Add when the same labels
+// are recorded repeatedly, to avoid sorting them on every call.
+func (c Int64Counter) AddWithSet(ctx context.Context, value int64, labels *label.Set) {
+	c.directRecordWithSet(ctx, number.NewInt64Number(value), labels)
+}
+
 // Add adds the value to the counter's sum using the labels
 // previously bound to this counter via Bind()
 func (b BoundFloat64Counter) Add(ctx context.Context, value float64) {
@@ -682,12 +740,26 @@ func (c Float64UpDownCounter) Add(ctx context.Context, value float64, labels ...
 	c.directRecord(ctx, number.NewFloat64Number(value), labels)
 }
 
+// AddWithSet adds the value to the counter's sum using a
+// precomputed label set. Use it instead of Add when the same labels
+// are recorded repeatedly, to avoid sorting them on every call.
+func (c Float64UpDownCounter) AddWithSet(ctx context.Context, value float64, labels *label.Set) {
+	c.directRecordWithSet(ctx, number.NewFloat64Number(value), labels)
+}
+
 // Add adds the value to the counter's sum. The labels should contain
 // the keys and values to be associated with this value.
 func (c Int64UpDownCounter) Add(ctx context.Context, value int64, labels ...label.KeyValue) {
 	c.directRecord(ctx, number.NewInt64Number(value), labels)
 }
 
+// AddWithSet adds the value to the counter's sum using a
+// precomputed label set. Use it instead of Add when the same labels
+// are recorded repeatedly, to avoid sorting them on every call.
+func (c Int64UpDownCounter) AddWithSet(ctx context.Context, value int64, labels *label.Set) {
+	c.directRecordWithSet(ctx, number.NewInt64Number(value), labels)
+}
+
 // Add adds the value to the counter's sum using the labels
 // previously bound to this counter via Bind()
 func (b BoundFloat64UpDownCounter) Add(ctx context.Context, value float64) {
@@ -757,6 +829,13 @@ func (c Float64ValueRecorder) Record(ctx context.Context, value float64, labels
 	c.directRecord(ctx, number.NewFloat64Number(value), labels)
 }
 
+// RecordWithSet adds a new value to the ValueRecorder's distribution using a
+// precomputed label set. Use it instead of Record when the same labels
+// are recorded repeatedly, to avoid sorting them on every call.
+func (c Float64ValueRecorder) RecordWithSet(ctx context.Context, value float64, labels *label.Set) {
+	c.directRecordWithSet(ctx, number.NewFloat64Number(value), labels)
+}
+
 // Record adds a new value to the ValueRecorder's distribution. The
 // labels should contain the keys and values to be associated with
 // this value.
@@ -764,6 +843,13 @@ func (c Int64ValueRecorder) Record(ctx context.Context, value int64, labels ...l
 	c.directRecord(ctx, number.NewInt64Number(value), labels)
 }
 
+// RecordWithSet adds a new value to the ValueRecorder's distribution using a
+// precomputed label set. Use it instead of Record when the same labels
+// are recorded repeatedly, to avoid sorting them on every call.
+func (c Int64ValueRecorder) RecordWithSet(ctx context.Context, value int64, labels *label.Set) {
+	c.directRecordWithSet(ctx, number.NewInt64Number(value), labels)
+}
+
 // Record adds a new value to the ValueRecorder's distribution using the labels
 // previously bound to the ValueRecorder via Bind().
 func (b BoundFloat64ValueRecorder) Record(ctx context.Context, value float64) {
@@ -30,8 +30,10 @@ type NoopAsync struct{ noopInstrument }
 
 var _ MeterProvider = NoopMeterProvider{}
 var _ SyncImpl = NoopSync{}
+var _ LabelSetSyncImpl = NoopSync{}
 var _ BoundSyncImpl = noopBoundInstrument{}
 var _ AsyncImpl = NoopAsync{}
+var _ UnregisterAsyncImpl = NoopAsync{}
 
 func (NoopMeterProvider) Meter(_ string, _ ...MeterOption) Meter {
 	return Meter{}
@@ -57,3 +59,10 @@ func (NoopSync) Bind([]label.KeyValue) BoundSyncImpl {
 
 func (NoopSync) RecordOne(context.Context, number.Number, []label.KeyValue) {
 }
+
+func (NoopSync) RecordOneWithSet(context.Context, number.Number, *label.Set) {
+}
+
+func (NoopAsync) Unregister() error {
+	return nil
+}
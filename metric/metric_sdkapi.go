@@ -41,6 +41,16 @@ type MeterImpl interface {
 	) (AsyncImpl, error)
 }
 
+// LabelSetMeterImpl is an optional interface a MeterImpl can implement
+// to record a batch of measurements with a precomputed label set.
+// Meters whose implementation does not support it record with the
+// labels of the set instead.
+type LabelSetMeterImpl interface {
+	// RecordBatchWithSet atomically records a batch of
+	// measurements with the given label set.
+	RecordBatchWithSet(ctx context.Context, labels *label.Set, measurement ...Measurement)
+}
+
 // InstrumentImpl is a common interface for synchronous and
 // asynchronous instruments.
 type InstrumentImpl interface {
@@ -66,6 +76,14 @@ type SyncImpl interface {
 	RecordOne(ctx context.Context, number number.Number, labels []label.KeyValue)
 }
 
+// LabelSetSyncImpl is an optional interface a SyncImpl can implement
+// to record a single metric event with a precomputed label set.
+type LabelSetSyncImpl interface {
+	// RecordOneWithSet captures a single synchronous metric event
+	// with the given label set.
+	RecordOneWithSet(ctx context.Context, number number.Number, labels *label.Set)
+}
+
 // BoundSyncImpl is the implementation-level interface to a
 // generic bound synchronous instrument
 type BoundSyncImpl interface {
@@ -84,12 +102,24 @@ type AsyncImpl interface {
 	InstrumentImpl
 }
 
+// UnregisterAsyncImpl is an optional interface an AsyncImpl can
+// implement to stop running its callback.
+type UnregisterAsyncImpl interface {
+	// Unregister stops running the callback of the instrument and
+	// stops collecting the instrument. For instruments created
+	// through a BatchObserver, this applies to the batch callback
+	// and every instrument it observes.
+	Unregister() error
+}
+
 // WrapMeterImpl constructs a `Meter` implementation from a
 // `MeterImpl` implementation.
 func WrapMeterImpl(impl MeterImpl, instrumentationName string, opts ...MeterOption) Meter {
+	cfg := NewMeterConfig(opts...)
 	return Meter{
-		impl:    impl,
-		name:    instrumentationName,
-		version: NewMeterConfig(opts...).InstrumentationVersion,
+		impl:      impl,
+		name:      instrumentationName,
+		version:   cfg.InstrumentationVersion,
+		schemaURL: cfg.SchemaURL,
 	}
 }
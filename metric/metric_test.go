@@ -148,10 +148,11 @@ func checkSyncBatches(ctx context.Context, t *testing.T, labels []label.KeyValue
 
 func TestOptions(t *testing.T) {
 	type testcase struct {
-		name string
-		opts []metric.InstrumentOption
-		desc string
-		unit unit.Unit
+		name   string
+		opts   []metric.InstrumentOption
+		desc   string
+		unit   unit.Unit
+		bounds []float64
 	}
 	testcases := []testcase{
 		{
@@ -194,18 +195,43 @@ func TestOptions(t *testing.T) {
 			desc: "",
 			unit: "h",
 		},
+		{
+			name: "explicit bucket boundaries",
+			opts: []metric.InstrumentOption{
+				metric.WithExplicitBucketBoundaries(1, 5, 10),
+			},
+			bounds: []float64{1, 5, 10},
+		},
+		{
+			name: "explicit bucket boundaries override",
+			opts: []metric.InstrumentOption{
+				metric.WithExplicitBucketBoundaries(1, 5, 10),
+				metric.WithExplicitBucketBoundaries(0.1, 0.5),
+			},
+			bounds: []float64{0.1, 0.5},
+		},
 	}
 	for idx, tt := range testcases {
 		t.Logf("Testing counter case %s (%d)", tt.name, idx)
 		if diff := cmp.Diff(metric.NewInstrumentConfig(tt.opts...), metric.InstrumentConfig{
-			Description: tt.desc,
-			Unit:        tt.unit,
+			Description:              tt.desc,
+			Unit:                     tt.unit,
+			ExplicitBucketBoundaries: tt.bounds,
 		}); diff != "" {
 			t.Errorf("Compare options: -got +want %s", diff)
 		}
 	}
 }
 
+func TestExplicitBucketBoundariesCopied(t *testing.T) {
+	bounds := []float64{1, 5, 10}
+	opt := metric.WithExplicitBucketBoundaries(bounds...)
+	bounds[0] = 100
+
+	desc := metric.NewDescriptor("h", metric.ValueRecorderInstrumentKind, number.Float64Kind, opt)
+	require.Equal(t, []float64{1, 5, 10}, desc.ExplicitBucketBoundaries())
+}
+
 func TestCounter(t *testing.T) {
 	// N.B. the API does not check for negative
 	// values, that's the SDK's responsibility.
@@ -293,6 +319,34 @@ func TestValueRecorder(t *testing.T) {
 	})
 }
 
+func TestRecordWithSet(t *testing.T) {
+	// The mock SDK does not implement the label set interfaces, so
+	// the labels of the set are recorded instead.
+	mockSDK, meter := oteltest.NewMeter()
+	c := Must(meter).NewInt64Counter("test.counter.int")
+	u := Must(meter).NewFloat64UpDownCounter("test.updowncounter.float")
+	m := Must(meter).NewInt64ValueRecorder("test.valuerecorder.int")
+	ctx := context.Background()
+	labels := []label.KeyValue{label.String("A", "B"), label.String("C", "D")}
+	set := label.NewSet(labels...)
+
+	c.AddWithSet(ctx, 1, &set)
+	u.AddWithSet(ctx, -2, &set)
+	m.RecordWithSet(ctx, 3, &set)
+	meter.RecordBatchWithSet(ctx, &set, c.Measurement(4))
+
+	recorded := oteltest.AsStructs(mockSDK.MeasurementBatches)
+	require.Len(t, recorded, 4)
+	for i, want := range []float64{1, -2, 3, 4} {
+		require.Equal(t, oteltest.LabelsToMap(labels...), recorded[i].Labels)
+		require.Equal(t, want, recorded[i].Number.CoerceToFloat64(mockSDK.MeasurementBatches[i].Measurements[0].Instrument.Descriptor().NumberKind()))
+	}
+
+	// A Meter without an implementation ignores the measurements.
+	metric.Meter{}.RecordBatchWithSet(ctx, &set, c.Measurement(5))
+	require.Len(t, mockSDK.MeasurementBatches, 4)
+}
+
 func TestObserverInstruments(t *testing.T) {
 	t.Run("float valueobserver", func(t *testing.T) {
 		labels := []label.KeyValue{label.String("O", "P")}
@@ -407,6 +461,65 @@ func TestBatchObserverInstruments(t *testing.T) {
 	require.Equal(t, 0, m2.Number.CompareNumber(number.Float64Kind, oteltest.ResolveNumberByKind(t, number.Float64Kind, 42)))
 }
 
+func TestObserverUnregister(t *testing.T) {
+	mockSDK, meter := oteltest.NewMeter()
+	observed := func() []string {
+		mockSDK.MeasurementBatches = nil
+		mockSDK.RunAsyncInstruments()
+		var names []string
+		for _, m := range oteltest.AsStructs(mockSDK.MeasurementBatches) {
+			names = append(names, m.Name)
+		}
+		return names
+	}
+
+	single := Must(meter).NewInt64ValueObserver("single", func(_ context.Context, result metric.Int64ObserverResult) {
+		result.Observe(1)
+	})
+	_ = Must(meter).NewFloat64SumObserver("other", func(_ context.Context, result metric.Float64ObserverResult) {
+		result.Observe(2)
+	})
+	var batchA metric.Int64ValueObserver
+	var batchB metric.Float64ValueObserver
+	batch := Must(meter).NewBatchObserver(func(_ context.Context, result metric.BatchObserverResult) {
+		result.Observe(nil, batchA.Observation(3), batchB.Observation(4))
+	})
+	batchA = batch.NewInt64ValueObserver("batch.a")
+	batchB = batch.NewFloat64ValueObserver("batch.b")
+
+	require.ElementsMatch(t, []string{"single", "other", "batch.a", "batch.b"}, observed())
+
+	require.NoError(t, single.Unregister())
+	require.ElementsMatch(t, []string{"other", "batch.a", "batch.b"}, observed())
+
+	// Unregistering one instrument of a batch unregisters the callback.
+	require.NoError(t, batchA.Unregister())
+	require.ElementsMatch(t, []string{"other"}, observed())
+
+	require.NoError(t, single.Unregister())
+	require.NoError(t, batchB.Unregister())
+	require.ElementsMatch(t, []string{"other"}, observed())
+}
+
+func TestObserverUnregisterUnsupported(t *testing.T) {
+	meter := metric.WrapMeterImpl(testUnregisterUnsupportedMeter{}, "test")
+	observer := Must(meter).NewInt64ValueObserver("test.observer", func(context.Context, metric.Int64ObserverResult) {})
+	require.True(t, errors.Is(observer.Unregister(), metric.ErrUnregisterUnsupported))
+
+	// Instruments without a callback are no-ops which can be unregistered.
+	observer = Must(meter).NewInt64ValueObserver("test.observer", nil)
+	require.NoError(t, observer.Unregister())
+}
+
+type testUnregisterUnsupportedMeter struct {
+	testWrappedMeter
+}
+
+func (testUnregisterUnsupportedMeter) NewAsyncInstrument(_ metric.Descriptor, _ metric.AsyncRunner) (metric.AsyncImpl, error) {
+	// Only the methods of the InstrumentImpl interface are promoted.
+	return struct{ metric.InstrumentImpl }{metric.NoopAsync{}}, nil
+}
+
 func checkObserverBatch(t *testing.T, labels []label.KeyValue, mock *oteltest.MeterImpl, nkind number.Kind, mkind metric.InstrumentKind, observer metric.AsyncImpl, expected float64) {
 	t.Helper()
 	assert.Len(t, mock.MeasurementBatches, 1)
@@ -0,0 +1,115 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package noop provides an implementation of the metric API that
+// performs no operations. It is intended to be used as a test double or
+// as a stand-in when telemetry is disabled.
+//
+// Recording a measurement with an instrument from this package does not
+// allocate. Variadic labels and batches of measurements still escape to
+// the heap on the caller's side; record with a precomputed label.Set or
+// a bound instrument to avoid that.
+package noop // import "go.opentelemetry.io/otel/metric/noop"
+
+import (
+	"context"
+
+	"go.opentelemetry.io/otel/label"
+	"go.opentelemetry.io/otel/metric"
+	"go.opentelemetry.io/otel/metric/number"
+)
+
+var (
+	_ metric.MeterProvider       = MeterProvider{}
+	_ metric.MeterImpl           = MeterImpl{}
+	_ metric.LabelSetMeterImpl   = MeterImpl{}
+	_ metric.SyncImpl            = (*syncInstrument)(nil)
+	_ metric.LabelSetSyncImpl    = (*syncInstrument)(nil)
+	_ metric.BoundSyncImpl       = boundInstrument{}
+	_ metric.AsyncImpl           = (*asyncInstrument)(nil)
+	_ metric.UnregisterAsyncImpl = (*asyncInstrument)(nil)
+)
+
+// MeterProvider is a MeterProvider that creates Meters that perform no
+// operations.
+type MeterProvider struct{}
+
+// NewMeterProvider returns a MeterProvider that performs no operations.
+func NewMeterProvider() MeterProvider {
+	return MeterProvider{}
+}
+
+// Meter returns a Meter that performs no operations.
+func (MeterProvider) Meter(instrumentationName string, opts ...metric.MeterOption) metric.Meter {
+	return metric.WrapMeterImpl(MeterImpl{}, instrumentationName, opts...)
+}
+
+// MeterImpl is a MeterImpl that creates instruments that perform no
+// operations. The instruments keep their descriptors, but the callbacks
+// of asynchronous instruments are never called.
+type MeterImpl struct{}
+
+// RecordBatch does nothing.
+func (MeterImpl) RecordBatch(context.Context, []label.KeyValue, ...metric.Measurement) {}
+
+// RecordBatchWithSet does nothing.
+func (MeterImpl) RecordBatchWithSet(context.Context, *label.Set, ...metric.Measurement) {}
+
+// NewSyncInstrument returns a synchronous instrument that performs no
+// operations.
+func (MeterImpl) NewSyncInstrument(descriptor metric.Descriptor) (metric.SyncImpl, error) {
+	return &syncInstrument{instrument{descriptor: descriptor}}, nil
+}
+
+// NewAsyncInstrument returns an asynchronous instrument that performs no
+// operations.
+func (MeterImpl) NewAsyncInstrument(descriptor metric.Descriptor, _ metric.AsyncRunner) (metric.AsyncImpl, error) {
+	return &asyncInstrument{instrument{descriptor: descriptor}}, nil
+}
+
+type instrument struct {
+	descriptor metric.Descriptor
+}
+
+type syncInstrument struct {
+	instrument
+}
+
+type asyncInstrument struct {
+	instrument
+}
+
+type boundInstrument struct{}
+
+func (i *instrument) Implementation() interface{} {
+	return i
+}
+
+func (i *instrument) Descriptor() metric.Descriptor {
+	return i.descriptor
+}
+
+func (*syncInstrument) Bind([]label.KeyValue) metric.BoundSyncImpl {
+	return boundInstrument{}
+}
+
+func (*syncInstrument) RecordOne(context.Context, number.Number, []label.KeyValue) {}
+
+func (*syncInstrument) RecordOneWithSet(context.Context, number.Number, *label.Set) {}
+
+func (*asyncInstrument) Unregister() error { return nil }
+
+func (boundInstrument) RecordOne(context.Context, number.Number) {}
+
+func (boundInstrument) Unbind() {}
@@ -0,0 +1,64 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package noop
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.opentelemetry.io/otel/label"
+	"go.opentelemetry.io/otel/metric"
+)
+
+func TestInstrumentDescriptors(t *testing.T) {
+	meter := NewMeterProvider().Meter("test", metric.WithInstrumentationVersion("v1"))
+
+	counter, err := meter.NewInt64Counter("counter", metric.WithDescription("a counter"))
+	require.NoError(t, err)
+	desc := counter.SyncImpl().Descriptor()
+	assert.Equal(t, "counter", desc.Name())
+	assert.Equal(t, "a counter", desc.Description())
+	assert.Equal(t, "test", desc.InstrumentationName())
+	assert.Equal(t, "v1", desc.InstrumentationVersion())
+
+	called := false
+	observer, err := meter.NewFloat64ValueObserver("observer", func(context.Context, metric.Float64ObserverResult) {
+		called = true
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "observer", observer.AsyncImpl().Descriptor().Name())
+	assert.False(t, called)
+}
+
+func TestRecordAllocations(t *testing.T) {
+	ctx := context.Background()
+	meter := NewMeterProvider().Meter("test")
+	counter := metric.Must(meter).NewInt64Counter("counter")
+	recorder := metric.Must(meter).NewFloat64ValueRecorder("recorder")
+	bound := counter.Bind(label.String("A", "B"))
+	defer bound.Unbind()
+	set := label.NewSet(label.String("A", "B"))
+
+	allocs := testing.AllocsPerRun(100, func() {
+		counter.Add(ctx, 1)
+		counter.AddWithSet(ctx, 1, &set)
+		recorder.RecordWithSet(ctx, 1, &set)
+		bound.Add(ctx, 1)
+	})
+	assert.Zero(t, allocs)
+}
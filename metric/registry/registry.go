@@ -40,11 +40,13 @@ type uniqueInstrumentMeterImpl struct {
 }
 
 var _ metric.MeterImpl = (*uniqueInstrumentMeterImpl)(nil)
+var _ metric.LabelSetMeterImpl = (*uniqueInstrumentMeterImpl)(nil)
 
 type key struct {
-	instrumentName         string
-	instrumentationName    string
-	InstrumentationVersion string
+	instrumentName           string
+	instrumentationName      string
+	InstrumentationVersion   string
+	InstrumentationSchemaURL string
 }
 
 // NewMeterProvider returns a new provider that implements instrument
@@ -79,11 +81,21 @@ func (u *uniqueInstrumentMeterImpl) RecordBatch(ctx context.Context, labels []la
 	u.impl.RecordBatch(ctx, labels, ms...)
 }
 
+// RecordBatchWithSet implements metric.LabelSetMeterImpl.
+func (u *uniqueInstrumentMeterImpl) RecordBatchWithSet(ctx context.Context, labels *label.Set, ms ...metric.Measurement) {
+	if impl, ok := u.impl.(metric.LabelSetMeterImpl); ok {
+		impl.RecordBatchWithSet(ctx, labels, ms...)
+		return
+	}
+	u.impl.RecordBatch(ctx, labels.ToSlice(), ms...)
+}
+
 func keyOf(descriptor metric.Descriptor) key {
 	return key{
 		descriptor.Name(),
 		descriptor.InstrumentationName(),
 		descriptor.InstrumentationVersion(),
+		descriptor.InstrumentationSchemaURL(),
 	}
 }
 
@@ -99,6 +99,22 @@ func TestRegistryDifferentNamespace(t *testing.T) {
 	}
 }
 
+func TestRegistryDifferentSchemaURL(t *testing.T) {
+	for _, nf := range allNew {
+		_, provider := oteltest.NewMeterProvider()
+
+		meter1 := provider.Meter("meter", metric.WithSchemaURL("https://opentelemetry.io/schemas/1.0.0"))
+		meter2 := provider.Meter("meter", metric.WithSchemaURL("https://opentelemetry.io/schemas/1.13.0"))
+		inst1, err1 := nf(meter1, "this")
+		inst2, err2 := nf(meter2, "this")
+
+		require.NoError(t, err1)
+		require.NoError(t, err2)
+		require.NotEqual(t, inst1, inst2)
+		require.Equal(t, "https://opentelemetry.io/schemas/1.13.0", inst2.Descriptor().InstrumentationSchemaURL())
+	}
+}
+
 func TestRegistryDiffInstruments(t *testing.T) {
 	for origName, origf := range allNew {
 		_, provider := oteltest.NewMeterProvider()
@@ -33,8 +33,10 @@ defined by the `otel` package.
 		oteltest.NewHarness(t).TestTracer(subjectFactory)
 	}
 
-Currently the Harness only provides testing of the trace portion of the
-OpenTelemetry API.
+TestTracerProvider and TestMeterProvider validate the TracerProvider and
+MeterProvider of an implementation the same way, checking the context
+propagation between its Tracers, the recording flag of its spans, and that
+its Tracers, spans and instruments can be used concurrently.
 
 Trace Testing
 
@@ -16,6 +16,7 @@ package oteltest // import "go.opentelemetry.io/otel/oteltest"
 
 import (
 	"context"
+	"strconv"
 	"sync"
 	"testing"
 	"time"
@@ -23,6 +24,7 @@ import (
 	"go.opentelemetry.io/otel/codes"
 	"go.opentelemetry.io/otel/internal/matchers"
 	"go.opentelemetry.io/otel/label"
+	"go.opentelemetry.io/otel/metric"
 	"go.opentelemetry.io/otel/trace"
 )
 
@@ -255,4 +257,190 @@ func (h *Harness) testSpan(tracerFactory func() trace.Tracer) {
 	}
 }
 
+// TestTracerProvider runs validation tests for an implementation of the
+// OpenTelemetry TracerProvider API, including the tests of TestTracer for
+// its Tracers.
+func (h *Harness) TestTracerProvider(subjectFactory func() trace.TracerProvider) {
+	h.t.Run("#Tracer", func(t *testing.T) {
+		t.Run("returns a Tracer", func(t *testing.T) {
+			t.Parallel()
+
+			e := matchers.NewExpecter(t)
+			subject := subjectFactory()
+
+			e.Expect(subject.Tracer("test")).NotToBeNil()
+			e.Expect(subject.Tracer("")).NotToBeNil()
+			e.Expect(subject.Tracer("test", trace.WithInstrumentationVersion("v1"))).NotToBeNil()
+		})
+
+		t.Run("is thread-safe", func(t *testing.T) {
+			t.Parallel()
+
+			subject := subjectFactory()
+
+			wg := &sync.WaitGroup{}
+			for i := 0; i < 10; i++ {
+				wg.Add(1)
+				go func(i int) {
+					defer wg.Done()
+
+					tracer := subject.Tracer("test")
+					_, span := tracer.Start(context.Background(), "test")
+					span.End()
+					_ = subject.Tracer("test", trace.WithInstrumentationVersion(strconv.Itoa(i)))
+				}(i)
+			}
+			wg.Wait()
+		})
+
+		t.Run("propagates parents between its Tracers", func(t *testing.T) {
+			t.Parallel()
+
+			e := matchers.NewExpecter(t)
+			subject := subjectFactory()
+
+			ctx, parent := subject.Tracer("parent").Start(context.Background(), "parent")
+			_, child := subject.Tracer("child").Start(ctx, "child")
+
+			e.Expect(child.SpanContext().TraceID).ToEqual(parent.SpanContext().TraceID)
+			e.Expect(child.SpanContext().SpanID).NotToEqual(parent.SpanContext().SpanID)
+		})
+	})
+
+	h.t.Run("Span", func(t *testing.T) {
+		t.Run("records a sampled span", func(t *testing.T) {
+			t.Parallel()
+
+			e := matchers.NewExpecter(t)
+			subject := subjectFactory()
+
+			_, span := subject.Tracer("test").Start(context.Background(), "test")
+			if span.SpanContext().IsSampled() {
+				e.Expect(span.IsRecording()).ToBeTrue()
+			}
+		})
+
+		t.Run("reads the recording flag while it is ended", func(t *testing.T) {
+			t.Parallel()
+
+			subject := subjectFactory()
+			_, span := subject.Tracer("test").Start(context.Background(), "test")
+
+			wg := &sync.WaitGroup{}
+			wg.Add(2)
+			go func() {
+				defer wg.Done()
+				_ = span.IsRecording()
+			}()
+			go func() {
+				defer wg.Done()
+				span.End()
+			}()
+			wg.Wait()
+		})
+	})
+
+	h.TestTracer(func() trace.Tracer {
+		return subjectFactory().Tracer("test")
+	})
+}
+
+// TestMeterProvider runs validation tests for an implementation of the
+// OpenTelemetry MeterProvider API.
+func (h *Harness) TestMeterProvider(subjectFactory func() metric.MeterProvider) {
+	h.t.Run("#Meter", func(t *testing.T) {
+		t.Run("creates every kind of instrument", func(t *testing.T) {
+			t.Parallel()
+
+			e := matchers.NewExpecter(t)
+			meter := subjectFactory().Meter("test")
+
+			_, err := meter.NewInt64Counter("int64.counter")
+			e.Expect(err).ToBeNil()
+			_, err = meter.NewFloat64Counter("float64.counter")
+			e.Expect(err).ToBeNil()
+			_, err = meter.NewInt64UpDownCounter("int64.updowncounter")
+			e.Expect(err).ToBeNil()
+			_, err = meter.NewFloat64UpDownCounter("float64.updowncounter")
+			e.Expect(err).ToBeNil()
+			_, err = meter.NewInt64ValueRecorder("int64.valuerecorder")
+			e.Expect(err).ToBeNil()
+			_, err = meter.NewFloat64ValueRecorder("float64.valuerecorder")
+			e.Expect(err).ToBeNil()
+			_, err = meter.NewInt64ValueObserver("int64.valueobserver", func(context.Context, metric.Int64ObserverResult) {})
+			e.Expect(err).ToBeNil()
+			_, err = meter.NewFloat64SumObserver("float64.sumobserver", func(context.Context, metric.Float64ObserverResult) {})
+			e.Expect(err).ToBeNil()
+			_, err = meter.NewInt64UpDownSumObserver("int64.updownsumobserver", func(context.Context, metric.Int64ObserverResult) {})
+			e.Expect(err).ToBeNil()
+		})
+
+		t.Run("returns the same instrument when created twice", func(t *testing.T) {
+			t.Parallel()
+
+			e := matchers.NewExpecter(t)
+			meter := subjectFactory().Meter("test")
+
+			_, err := meter.NewInt64Counter("counter")
+			e.Expect(err).ToBeNil()
+			_, err = meter.NewInt64Counter("counter")
+			e.Expect(err).ToBeNil()
+		})
+
+		t.Run("rejects instruments with the name of another kind", func(t *testing.T) {
+			t.Parallel()
+
+			e := matchers.NewExpecter(t)
+			meter := subjectFactory().Meter("test")
+
+			_, err := meter.NewInt64Counter("instrument")
+			e.Expect(err).ToBeNil()
+			_, err = meter.NewInt64ValueRecorder("instrument")
+			e.Expect(err).NotToBeNil()
+		})
+	})
+
+	h.t.Run("instruments", func(t *testing.T) {
+		t.Run("are thread-safe", func(t *testing.T) {
+			t.Parallel()
+
+			subject := subjectFactory()
+			ctx := context.Background()
+			labels := []label.KeyValue{label.String("key", "value")}
+
+			wg := &sync.WaitGroup{}
+			for i := 0; i < 4; i++ {
+				wg.Add(1)
+				go func() {
+					defer wg.Done()
+
+					meter := subject.Meter("test")
+					counter := metric.Must(meter).NewInt64Counter("counter")
+					recorder := metric.Must(meter).NewFloat64ValueRecorder("recorder")
+					bound := counter.Bind(labels...)
+					defer bound.Unbind()
+					for j := 0; j < 100; j++ {
+						counter.Add(ctx, 1, labels...)
+						bound.Add(ctx, 1)
+						recorder.Record(ctx, float64(j), labels...)
+						meter.RecordBatch(ctx, labels, counter.Measurement(1), recorder.Measurement(1))
+					}
+				}()
+			}
+			wg.Wait()
+		})
+
+		t.Run("accept a canceled context", func(t *testing.T) {
+			t.Parallel()
+
+			meter := metric.Must(subjectFactory().Meter("test"))
+			ctx, cancel := context.WithCancel(context.Background())
+			cancel()
+
+			meter.NewInt64Counter("counter").Add(ctx, 1)
+			meter.NewFloat64ValueRecorder("recorder").Record(ctx, 1)
+		})
+	})
+}
+
 type testCtxKey struct{}
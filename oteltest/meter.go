@@ -72,10 +72,11 @@ type (
 )
 
 var (
-	_ metric.SyncImpl      = &Sync{}
-	_ metric.BoundSyncImpl = &Handle{}
-	_ metric.MeterImpl     = &MeterImpl{}
-	_ metric.AsyncImpl     = &Async{}
+	_ metric.SyncImpl            = &Sync{}
+	_ metric.BoundSyncImpl       = &Handle{}
+	_ metric.MeterImpl           = &MeterImpl{}
+	_ metric.AsyncImpl           = &Async{}
+	_ metric.UnregisterAsyncImpl = &Async{}
 )
 
 func (i Instrument) Descriptor() metric.Descriptor {
@@ -86,6 +87,11 @@ func (a *Async) Implementation() interface{} {
 	return a
 }
 
+func (a *Async) Unregister() error {
+	a.meter.asyncInstruments.Unregister(a)
+	return nil
+}
+
 func (s *Sync) Implementation() interface{} {
 	return s
 }
@@ -196,11 +202,12 @@ func (m *MeterImpl) RunAsyncInstruments() {
 // Measured is the helper struct which provides flat representation of recorded measurements
 // to simplify testing
 type Measured struct {
-	Name                   string
-	InstrumentationName    string
-	InstrumentationVersion string
-	Labels                 map[label.Key]label.Value
-	Number                 number.Number
+	Name                     string
+	InstrumentationName      string
+	InstrumentationVersion   string
+	InstrumentationSchemaURL string
+	Labels                   map[label.Key]label.Value
+	Number                   number.Number
 }
 
 // LabelsToMap converts label set to keyValue map, to be easily used in tests
@@ -218,11 +225,12 @@ func AsStructs(batches []Batch) []Measured {
 	for _, batch := range batches {
 		for _, m := range batch.Measurements {
 			r = append(r, Measured{
-				Name:                   m.Instrument.Descriptor().Name(),
-				InstrumentationName:    m.Instrument.Descriptor().InstrumentationName(),
-				InstrumentationVersion: m.Instrument.Descriptor().InstrumentationVersion(),
-				Labels:                 LabelsToMap(batch.Labels...),
-				Number:                 m.Number,
+				Name:                     m.Instrument.Descriptor().Name(),
+				InstrumentationName:      m.Instrument.Descriptor().InstrumentationName(),
+				InstrumentationVersion:   m.Instrument.Descriptor().InstrumentationVersion(),
+				InstrumentationSchemaURL: m.Instrument.Descriptor().InstrumentationSchemaURL(),
+				Labels:                   LabelsToMap(batch.Labels...),
+				Number:                   m.Number,
 			})
 		}
 	}
@@ -42,7 +42,7 @@ func NewTracerProvider(options ...Option) *TracerProvider {
 }
 
 type instrumentation struct {
-	Name, Version string
+	Name, Version, SchemaURL string
 }
 
 // Tracer returns an OpenTelemetry Tracer used for testing.
@@ -50,17 +50,19 @@ func (p *TracerProvider) Tracer(instName string, opts ...trace.TracerOption) tra
 	conf := trace.NewTracerConfig(opts...)
 
 	inst := instrumentation{
-		Name:    instName,
-		Version: conf.InstrumentationVersion,
+		Name:      instName,
+		Version:   conf.InstrumentationVersion,
+		SchemaURL: conf.SchemaURL,
 	}
 	p.tracersMu.Lock()
 	defer p.tracersMu.Unlock()
 	t, ok := p.tracers[inst]
 	if !ok {
 		t = &Tracer{
-			Name:    instName,
-			Version: conf.InstrumentationVersion,
-			config:  &p.config,
+			Name:      instName,
+			Version:   conf.InstrumentationVersion,
+			SchemaURL: conf.SchemaURL,
+			config:    &p.config,
 		}
 		p.tracers[inst] = t
 	}
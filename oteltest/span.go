@@ -110,6 +110,9 @@ func (s *Span) AddEvent(name string, o ...trace.EventOption) {
 	}
 
 	c := trace.NewEventConfig(o...)
+	for _, f := range c.AttributesFuncs {
+		c.Attributes = append(c.Attributes, f()...)
+	}
 
 	var attributes map[label.Key]label.Value
 	if l := len(c.Attributes); l > 0 {
@@ -30,6 +30,8 @@ type Tracer struct {
 	Name string
 	// Version is the instrumentation version.
 	Version string
+	// SchemaURL is the schema URL of the instrumentation.
+	SchemaURL string
 
 	config *config
 }
@@ -77,6 +79,14 @@ func (t *Tracer) Start(ctx context.Context, name string, opts ...trace.SpanOptio
 			span.parentSpanID = rsc.SpanID
 		}
 	}
+	if c.SpanContext.IsValid() {
+		span.spanContext = c.SpanContext
+	}
+
+	if pending := trace.PendingLinksFromContext(ctx); len(pending) > 0 {
+		span.links = append(span.links, pending...)
+		ctx = trace.ContextWithPendingLinks(ctx)
+	}
 
 	for _, link := range c.Links {
 		for i, sl := range span.links {
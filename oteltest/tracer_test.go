@@ -28,6 +28,12 @@ import (
 	"go.opentelemetry.io/otel/trace"
 )
 
+func TestTracerProvider(t *testing.T) {
+	oteltest.NewHarness(t).TestTracerProvider(func() trace.TracerProvider {
+		return oteltest.NewTracerProvider()
+	})
+}
+
 func TestTracer(t *testing.T) {
 	tp := oteltest.NewTracerProvider()
 
@@ -247,6 +253,35 @@ func TestTracer(t *testing.T) {
 			e.Expect(links[0].Attributes).ToEqual(link1.Attributes)
 			e.Expect(links[1].Attributes).ToEqual(link2.Attributes)
 		})
+
+		t.Run("uses the pending links from context only once", func(t *testing.T) {
+			t.Parallel()
+
+			e := matchers.NewExpecter(t)
+
+			subject := tp.Tracer(t.Name())
+
+			_, span := subject.Start(context.Background(), "link")
+			link := trace.Link{
+				SpanContext: span.SpanContext(),
+				Attributes: []label.KeyValue{
+					label.String("a", "1"),
+				},
+			}
+
+			ctx := trace.ContextWithPendingLinks(context.Background(), link)
+			ctx, span = subject.Start(ctx, "batch")
+
+			testSpan, ok := span.(*oteltest.Span)
+			e.Expect(ok).ToBeTrue()
+			e.Expect(testSpan.Links()).ToEqual([]trace.Link{link})
+			e.Expect(len(trace.PendingLinksFromContext(ctx))).ToEqual(0)
+
+			_, span = subject.Start(ctx, "child")
+			testSpan, ok = span.(*oteltest.Span)
+			e.Expect(ok).ToBeTrue()
+			e.Expect(testSpan.Links()).ToEqual([]trace.Link{})
+		})
 	})
 }
 
@@ -17,6 +17,7 @@ package propagation // import "go.opentelemetry.io/otel/propagation"
 import (
 	"context"
 	"net/url"
+	"sort"
 	"strings"
 
 	"go.opentelemetry.io/otel/internal/baggage"
@@ -25,33 +26,93 @@ import (
 
 const baggageHeader = "baggage"
 
+// Limits defined by the W3C Baggage specification.
+const (
+	maxBaggageMembers      = 180
+	maxBaggageMemberBytes  = 4096
+	maxBaggageHeaderBytes  = 8192
+	baggageMemberDelimiter = ","
+)
+
+// BaggageOverflow defines how the Baggage propagator handles baggage that
+// exceeds the limits of the W3C Baggage specification.
+type BaggageOverflow int
+
+const (
+	// BaggageOverflowDrop drops the members that do not fit within the
+	// limits and propagates the rest. Members larger than the per-member
+	// limit are skipped, and members past the member count or total size
+	// limit are dropped.
+	BaggageOverflowDrop BaggageOverflow = iota
+	// BaggageOverflowDiscard discards all baggage if any limit is exceeded.
+	BaggageOverflowDiscard
+)
+
 // Baggage is a propagator that supports the W3C Baggage format.
 //
 // This propagates user-defined baggage associated with a trace. The complete
 // specification is defined at https://w3c.github.io/baggage/.
-type Baggage struct{}
+//
+// Values are percent-encoded, and the limits of the specification (180
+// members, 4096 bytes per member, and 8192 bytes in total) are enforced
+// for both injection and extraction according to Overflow. Members are
+// injected in key order.
+type Baggage struct {
+	// Overflow is the behavior when baggage exceeds the specification
+	// limits. The zero value is BaggageOverflowDrop.
+	Overflow BaggageOverflow
+}
 
 var _ TextMapPropagator = Baggage{}
 
 // Inject sets baggage key-values from ctx into the carrier.
 func (b Baggage) Inject(ctx context.Context, carrier TextMapCarrier) {
 	baggageMap := baggage.MapFromContext(ctx)
-	firstIter := true
-	var headerValueBuilder strings.Builder
+	type member struct{ key, encoded string }
+	members := make([]member, 0, baggageMap.Len())
 	baggageMap.Foreach(func(kv label.KeyValue) bool {
-		if !firstIter {
-			headerValueBuilder.WriteRune(',')
+		key := strings.TrimSpace(string(kv.Key))
+		if !baggage.ValidKey(key) {
+			return true
+		}
+		var sb strings.Builder
+		sb.WriteString(key)
+		sb.WriteByte('=')
+		sb.WriteString(encodeBaggageValue(strings.TrimSpace(kv.Value.Emit())))
+		for _, prop := range baggageMap.Properties(kv.Key) {
+			pKey := strings.TrimSpace(prop.Key)
+			if !baggage.ValidKey(pKey) {
+				continue
+			}
+			sb.WriteByte(';')
+			sb.WriteString(pKey)
+			if prop.HasValue {
+				sb.WriteByte('=')
+				sb.WriteString(encodeBaggageValue(strings.TrimSpace(prop.Value)))
+			}
 		}
-		firstIter = false
-		headerValueBuilder.WriteString(url.QueryEscape(strings.TrimSpace((string)(kv.Key))))
-		headerValueBuilder.WriteRune('=')
-		headerValueBuilder.WriteString(url.QueryEscape(strings.TrimSpace(kv.Value.Emit())))
+		members = append(members, member{key: key, encoded: sb.String()})
 		return true
 	})
-	if headerValueBuilder.Len() > 0 {
-		headerString := headerValueBuilder.String()
-		carrier.Set(baggageHeader, headerString)
+	// Serialize in key order so the same baggage always results in the
+	// same header, and which members are dropped at the limits is
+	// deterministic.
+	sort.Slice(members, func(i, j int) bool {
+		if members[i].key != members[j].key {
+			return members[i].key < members[j].key
+		}
+		return members[i].encoded < members[j].encoded
+	})
+	encoded := make([]string, len(members))
+	for i, m := range members {
+		encoded[i] = m.encoded
 	}
+
+	encoded, ok := b.limit(encoded)
+	if !ok || len(encoded) == 0 {
+		return
+	}
+	carrier.Set(baggageHeader, strings.Join(encoded, baggageMemberDelimiter))
 }
 
 // Extract returns a copy of parent with the baggage from the carrier added.
@@ -61,51 +122,135 @@ func (b Baggage) Extract(parent context.Context, carrier TextMapCarrier) context
 		return parent
 	}
 
-	baggageValues := strings.Split(bVal, ",")
+	baggageValues, ok := b.limit(strings.Split(bVal, baggageMemberDelimiter))
+	if !ok {
+		return parent
+	}
 	keyValues := make([]label.KeyValue, 0, len(baggageValues))
+	var properties map[label.Key][]baggage.Property
 	for _, baggageValue := range baggageValues {
 		valueAndProps := strings.Split(baggageValue, ";")
-		if len(valueAndProps) < 1 {
-			continue
-		}
-		nameValue := strings.Split(valueAndProps[0], "=")
+		nameValue := strings.SplitN(valueAndProps[0], "=", 2)
 		if len(nameValue) < 2 {
 			continue
 		}
-		name, err := url.QueryUnescape(nameValue[0])
-		if err != nil {
+		trimmedName := strings.TrimSpace(nameValue[0])
+		if !baggage.ValidKey(trimmedName) {
 			continue
 		}
-		trimmedName := strings.TrimSpace(name)
-		value, err := url.QueryUnescape(nameValue[1])
+		value, err := url.PathUnescape(strings.TrimSpace(nameValue[1]))
 		if err != nil {
 			continue
 		}
-		trimmedValue := strings.TrimSpace(value)
-
-		// TODO (skaris): properties defiend https://w3c.github.io/correlation-context/, are currently
-		// just put as part of the value.
-		var trimmedValueWithProps strings.Builder
-		trimmedValueWithProps.WriteString(trimmedValue)
-		for _, prop := range valueAndProps[1:] {
-			trimmedValueWithProps.WriteRune(';')
-			trimmedValueWithProps.WriteString(prop)
-		}
 
-		keyValues = append(keyValues, label.String(trimmedName, trimmedValueWithProps.String()))
+		key := label.Key(trimmedName)
+		keyValues = append(keyValues, key.String(value))
+		if props := parseBaggageProperties(valueAndProps[1:]); len(props) > 0 {
+			if properties == nil {
+				properties = make(map[label.Key][]baggage.Property)
+			}
+			properties[key] = props
+		}
 	}
 
 	if len(keyValues) > 0 {
 		// Only update the context if valid values were found
 		return baggage.ContextWithMap(parent, baggage.NewMap(baggage.MapUpdate{
-			MultiKV: keyValues,
+			MultiKV:    keyValues,
+			Properties: properties,
 		}))
 	}
 
 	return parent
 }
 
+// parseBaggageProperties returns the valid properties of a member. Invalid
+// properties are skipped.
+func parseBaggageProperties(raw []string) []baggage.Property {
+	var props []baggage.Property
+	for _, r := range raw {
+		keyValue := strings.SplitN(r, "=", 2)
+		key := strings.TrimSpace(keyValue[0])
+		if !baggage.ValidKey(key) {
+			continue
+		}
+		prop := baggage.Property{Key: key}
+		if len(keyValue) == 2 {
+			value, err := url.PathUnescape(strings.TrimSpace(keyValue[1]))
+			if err != nil {
+				continue
+			}
+			prop.Value = value
+			prop.HasValue = true
+		}
+		props = append(props, prop)
+	}
+	return props
+}
+
 // Fields returns the keys who's values are set with Inject.
 func (b Baggage) Fields() []string {
 	return []string{baggageHeader}
 }
+
+// limit returns the members that fit within the specification limits. The
+// returned bool is false if the limits were exceeded and all members must
+// be discarded.
+func (b Baggage) limit(members []string) ([]string, bool) {
+	kept := members[:0:0]
+	total := 0
+	for _, m := range members {
+		if strings.TrimSpace(m) == "" {
+			continue
+		}
+		size := len(m)
+		if len(kept) > 0 {
+			size += len(baggageMemberDelimiter)
+		}
+		if len(m) > maxBaggageMemberBytes {
+			if b.Overflow == BaggageOverflowDiscard {
+				return nil, false
+			}
+			continue
+		}
+		if len(kept) == maxBaggageMembers || total+size > maxBaggageHeaderBytes {
+			if b.Overflow == BaggageOverflowDiscard {
+				return nil, false
+			}
+			break
+		}
+		kept = append(kept, m)
+		total += size
+	}
+	return kept, true
+}
+
+// encodeBaggageValue percent-encodes every byte of v that is not a W3C
+// baggage-octet. The '%' and '=' octets are encoded as well so the value is
+// unambiguous to decode.
+func encodeBaggageValue(v string) string {
+	const hex = "0123456789ABCDEF"
+	var sb strings.Builder
+	sb.Grow(len(v))
+	for i := 0; i < len(v); i++ {
+		c := v[i]
+		if isBaggageOctet(c) && c != '%' && c != '=' {
+			sb.WriteByte(c)
+			continue
+		}
+		sb.WriteByte('%')
+		sb.WriteByte(hex[c>>4])
+		sb.WriteByte(hex[c&0xF])
+	}
+	return sb.String()
+}
+
+// isBaggageOctet returns if c is a baggage-octet: printable US-ASCII
+// excluding whitespace, '"', ',', ';', and a backslash.
+func isBaggageOctet(c byte) bool {
+	return c == 0x21 ||
+		(0x23 <= c && c <= 0x2B) ||
+		(0x2D <= c && c <= 0x3A) ||
+		(0x3C <= c && c <= 0x5B) ||
+		(0x5D <= c && c <= 0x7E)
+}
@@ -16,6 +16,7 @@ package propagation_test
 
 import (
 	"context"
+	"fmt"
 	"net/http"
 	"strings"
 	"testing"
@@ -55,7 +56,7 @@ func TestExtractValidBaggageFromHTTPReq(t *testing.T) {
 			header: "key1=val1,key2=val2;prop=1",
 			wantKVs: []label.KeyValue{
 				label.String("key1", "val1"),
-				label.String("key2", "val2;prop=1"),
+				label.String("key2", "val2"),
 			},
 		},
 		{
@@ -248,6 +249,24 @@ func TestInjectBaggageToHTTPReq(t *testing.T) {
 	}
 }
 
+func TestInjectBaggageKeyOrder(t *testing.T) {
+	kvs := []label.KeyValue{
+		label.String("key2", "val2"),
+		label.String("a-b", "val3"),
+		label.String("key1", "val1"),
+		label.String("a", "val4"),
+	}
+	want := "a=val4,a-b=val3,key1=val1,key2=val2"
+	for i := 0; i < 10; i++ {
+		req, _ := http.NewRequest("GET", "http://example.com", nil)
+		ctx := baggage.ContextWithMap(context.Background(), baggage.NewMap(baggage.MapUpdate{MultiKV: kvs}))
+		propagation.Baggage{}.Inject(ctx, req.Header)
+		if got := req.Header.Get("baggage"); got != want {
+			t.Fatalf("Inject baggage in wrong order: got %q, want %q", got, want)
+		}
+	}
+}
+
 func TestBaggagePropagatorGetAllKeys(t *testing.T) {
 	var propagator propagation.Baggage
 	want := []string{"baggage"}
@@ -256,3 +275,181 @@ func TestBaggagePropagatorGetAllKeys(t *testing.T) {
 		t.Errorf("GetAllKeys: -got +want %s", diff)
 	}
 }
+
+func TestBaggagePercentEncoding(t *testing.T) {
+	prop := propagation.Baggage{}
+	kvs := []label.KeyValue{
+		label.String("space", "a b"),
+		label.String("plus", "a+b"),
+		label.String("percent", "100%"),
+		label.String("unicode", "héllo"),
+		label.String("delims", `a;b,c"d\e`),
+	}
+	ctx := baggage.ContextWithMap(context.Background(), baggage.NewMap(baggage.MapUpdate{MultiKV: kvs}))
+	header := http.Header{}
+	prop.Inject(ctx, header)
+
+	got := header.Get("baggage")
+	for _, want := range []string{
+		"space=a%20b",
+		"plus=a+b",
+		"percent=100%25",
+		"unicode=h%C3%A9llo",
+		"delims=a%3Bb%2Cc%22d%5Ce",
+	} {
+		if !strings.Contains(got, want) {
+			t.Errorf("Inject baggage missing %s in %s", want, got)
+		}
+	}
+
+	extracted := baggage.MapFromContext(prop.Extract(context.Background(), header))
+	for _, kv := range kvs {
+		v, _ := extracted.Value(kv.Key)
+		if v.AsString() != kv.Value.AsString() {
+			t.Errorf("round trip of %s: got %q, want %q", kv.Key, v.AsString(), kv.Value.AsString())
+		}
+	}
+}
+
+func TestBaggageInvalidKeysDropped(t *testing.T) {
+	prop := propagation.Baggage{}
+	ctx := baggage.ContextWithMap(context.Background(), baggage.NewMap(baggage.MapUpdate{MultiKV: []label.KeyValue{
+		label.String("valid", "1"),
+		label.String("in valid", "2"),
+	}}))
+	header := http.Header{}
+	prop.Inject(ctx, header)
+	if got := header.Get("baggage"); got != "valid=1" {
+		t.Errorf("Inject baggage: got %q, want %q", got, "valid=1")
+	}
+
+	header.Set("baggage", "valid=1,in valid=2,(bad)=3")
+	if got := baggage.MapFromContext(prop.Extract(context.Background(), header)).Len(); got != 1 {
+		t.Errorf("Extract baggage: got %d members, want 1", got)
+	}
+}
+
+func TestBaggageLimits(t *testing.T) {
+	manyMembers := make([]string, 200)
+	for i := range manyMembers {
+		manyMembers[i] = fmt.Sprintf("k%03d=v", i)
+	}
+	tooLong := "long=" + strings.Repeat("v", 4096)
+	large := make([]string, 5)
+	for i := range large {
+		large[i] = fmt.Sprintf("k%d=%s", i, strings.Repeat("v", 2000))
+	}
+
+	tests := []struct {
+		name     string
+		overflow propagation.BaggageOverflow
+		header   string
+		want     int
+	}{
+		{
+			name:   "member count drop",
+			header: strings.Join(manyMembers, ","),
+			want:   180,
+		},
+		{
+			name:     "member count discard",
+			overflow: propagation.BaggageOverflowDiscard,
+			header:   strings.Join(manyMembers, ","),
+			want:     0,
+		},
+		{
+			name:   "member size drop",
+			header: "a=1," + tooLong + ",b=2",
+			want:   2,
+		},
+		{
+			name:     "member size discard",
+			overflow: propagation.BaggageOverflowDiscard,
+			header:   "a=1," + tooLong + ",b=2",
+			want:     0,
+		},
+		{
+			name:   "total size drop",
+			header: strings.Join(large, ","),
+			want:   4,
+		},
+		{
+			name:     "total size discard",
+			overflow: propagation.BaggageOverflowDiscard,
+			header:   strings.Join(large, ","),
+			want:     0,
+		},
+		{
+			name:     "within limits",
+			overflow: propagation.BaggageOverflowDiscard,
+			header:   strings.Join(manyMembers[:180], ","),
+			want:     180,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			prop := propagation.Baggage{Overflow: tt.overflow}
+
+			header := http.Header{}
+			header.Set("baggage", tt.header)
+			ctx := prop.Extract(context.Background(), header)
+			if got := baggage.MapFromContext(ctx).Len(); got != tt.want {
+				t.Errorf("Extract: got %d members, want %d", got, tt.want)
+			}
+
+			// Injecting the unlimited baggage must apply the same limits.
+			unlimited := baggage.ContextWithMap(context.Background(), baggage.NewMap(baggage.MapUpdate{
+				MultiKV: kvsFromHeader(tt.header),
+			}))
+			out := http.Header{}
+			prop.Inject(unlimited, out)
+			got := 0
+			if v := out.Get("baggage"); v != "" {
+				got = len(strings.Split(v, ","))
+				if len(v) > 8192 {
+					t.Errorf("Inject: header of %d bytes exceeds limit", len(v))
+				}
+			}
+			if got != tt.want {
+				t.Errorf("Inject: got %d members, want %d", got, tt.want)
+			}
+		})
+	}
+}
+
+// kvsFromHeader splits a baggage header without applying any limits.
+func kvsFromHeader(header string) []label.KeyValue {
+	var kvs []label.KeyValue
+	for _, m := range strings.Split(header, ",") {
+		parts := strings.SplitN(m, "=", 2)
+		kvs = append(kvs, label.String(parts[0], parts[1]))
+	}
+	return kvs
+}
+
+func TestBaggageProperties(t *testing.T) {
+	prop := propagation.Baggage{}
+
+	header := http.Header{}
+	header.Set("baggage", "key1=val1;flag;ttl=30%2C5;(bad)=1, key2=val2")
+	ctx := prop.Extract(context.Background(), header)
+	m := baggage.MapFromContext(ctx)
+
+	want := []baggage.Property{
+		{Key: "flag"},
+		{Key: "ttl", Value: "30,5", HasValue: true},
+	}
+	if diff := cmp.Diff(want, m.Properties("key1")); diff != "" {
+		t.Errorf("Extract properties: -want +got %s", diff)
+	}
+	if got := m.Properties("key2"); got != nil {
+		t.Errorf("Extract properties of key2: got %v, want nil", got)
+	}
+
+	out := http.Header{}
+	prop.Inject(ctx, out)
+	if got, want := out.Get("baggage"), "key1=val1;flag;ttl=30%2C5,key2=val2"; got != want {
+		t.Errorf("Inject properties: got %q, want %q", got, want)
+	}
+}
@@ -0,0 +1,121 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package propagation // import "go.opentelemetry.io/otel/propagation"
+
+import (
+	"context"
+
+	"go.opentelemetry.io/otel/trace"
+)
+
+// Binary encoding layout, matching the gRPC "grpc-trace-bin" format
+// (https://github.com/census-instrumentation/opencensus-specs/blob/master/encodings/BinaryEncoding.md).
+const (
+	binaryVersion = 0
+
+	binaryTraceIDField    = 0
+	binarySpanIDField     = 1
+	binaryTraceFlagsField = 2
+
+	binaryTraceIDLen = len(trace.TraceID{})
+	binarySpanIDLen  = len(trace.SpanID{})
+
+	// version, field ID + trace ID, field ID + span ID, field ID + flags.
+	binaryLen = 1 + 1 + binaryTraceIDLen + 1 + binarySpanIDLen + 1 + 1
+)
+
+// BinaryPropagator propagates a SpanContext as an opaque byte slice. It is
+// intended for transports, like message buses or custom framing protocols,
+// that have no notion of text headers.
+type BinaryPropagator interface {
+	// Inject returns the encoded SpanContext of ctx, or nil if ctx does
+	// not hold a valid SpanContext.
+	Inject(ctx context.Context) []byte
+	// Extract returns a copy of ctx with the remote SpanContext decoded
+	// from data. If data is not a valid encoding, ctx is returned.
+	Extract(ctx context.Context, data []byte) context.Context
+}
+
+// Binary is a propagator that supports the gRPC binary trace context
+// format, as carried in the "grpc-trace-bin" metadata key.
+//
+// The format only carries the trace ID, span ID, and the sampled trace
+// flag; the TraceState is not propagated.
+type Binary struct{}
+
+var _ BinaryPropagator = Binary{}
+
+// Inject returns the binary encoding of the SpanContext in ctx.
+func (b Binary) Inject(ctx context.Context) []byte {
+	return b.ToBytes(trace.SpanContextFromContext(ctx))
+}
+
+// Extract reads the binary encoding in data into a returned Context.
+func (b Binary) Extract(ctx context.Context, data []byte) context.Context {
+	sc, ok := b.FromBytes(data)
+	if !ok {
+		return ctx
+	}
+	return trace.ContextWithRemoteSpanContext(ctx, sc)
+}
+
+// ToBytes returns the binary encoding of sc, or nil if sc is not valid.
+func (Binary) ToBytes(sc trace.SpanContext) []byte {
+	if !sc.IsValid() {
+		return nil
+	}
+
+	buf := make([]byte, 0, binaryLen)
+	buf = append(buf, binaryVersion)
+	buf = append(buf, binaryTraceIDField)
+	buf = append(buf, sc.TraceID[:]...)
+	buf = append(buf, binarySpanIDField)
+	buf = append(buf, sc.SpanID[:]...)
+	buf = append(buf, binaryTraceFlagsField, encodeTraceFlags(sc.TraceFlags))
+	return buf
+}
+
+// FromBytes decodes a SpanContext from its binary encoding. The returned
+// bool is false if data is not a valid encoding of a valid SpanContext.
+//
+// Fields are decoded in order and unknown trailing fields are ignored so
+// encodings from newer writers remain readable.
+func (Binary) FromBytes(data []byte) (trace.SpanContext, bool) {
+	if len(data) == 0 || data[0] != binaryVersion {
+		return trace.SpanContext{}, false
+	}
+	data = data[1:]
+
+	var sc trace.SpanContext
+	if len(data) < 1+binaryTraceIDLen || data[0] != binaryTraceIDField {
+		return trace.SpanContext{}, false
+	}
+	copy(sc.TraceID[:], data[1:1+binaryTraceIDLen])
+	data = data[1+binaryTraceIDLen:]
+
+	if len(data) >= 1+binarySpanIDLen && data[0] == binarySpanIDField {
+		copy(sc.SpanID[:], data[1:1+binarySpanIDLen])
+		data = data[1+binarySpanIDLen:]
+	}
+
+	if len(data) >= 2 && data[0] == binaryTraceFlagsField {
+		sc.TraceFlags = decodeTraceFlags(data[1])
+	}
+
+	if !sc.IsValid() {
+		return trace.SpanContext{}, false
+	}
+	return sc, true
+}
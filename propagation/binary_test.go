@@ -0,0 +1,120 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package propagation_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"go.opentelemetry.io/otel/oteltest"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/trace"
+)
+
+func TestBinaryToBytes(t *testing.T) {
+	b := propagation.Binary{}
+
+	sc := trace.SpanContext{TraceID: traceID, SpanID: spanID, TraceFlags: trace.FlagsSampled | trace.FlagsDebug}
+	want := []byte{
+		0,
+		0, 0x4b, 0xf9, 0x2f, 0x35, 0x77, 0xb3, 0x4d, 0xa6, 0xa3, 0xce, 0x92, 0x9d, 0x0e, 0x0e, 0x47, 0x36,
+		1, 0x00, 0xf0, 0x67, 0xaa, 0x0b, 0xa9, 0x02, 0xb7,
+		2, 1,
+	}
+	assert.Equal(t, want, b.ToBytes(sc))
+	assert.Nil(t, b.ToBytes(trace.SpanContext{}))
+
+	// The random flag is kept.
+	sc.TraceFlags = trace.FlagsRandom
+	got, ok := b.FromBytes(b.ToBytes(sc))
+	assert.True(t, ok)
+	assert.Equal(t, sc, got)
+}
+
+func TestBinaryFromBytes(t *testing.T) {
+	b := propagation.Binary{}
+	valid := b.ToBytes(trace.SpanContext{TraceID: traceID, SpanID: spanID, TraceFlags: trace.FlagsSampled})
+
+	tests := []struct {
+		name   string
+		data   []byte
+		want   trace.SpanContext
+		wantOK bool
+	}{
+		{
+			name:   "valid",
+			data:   valid,
+			want:   trace.SpanContext{TraceID: traceID, SpanID: spanID, TraceFlags: trace.FlagsSampled},
+			wantOK: true,
+		},
+		{
+			name:   "missing flags",
+			data:   valid[:len(valid)-2],
+			want:   trace.SpanContext{TraceID: traceID, SpanID: spanID},
+			wantOK: true,
+		},
+		{
+			name:   "trailing unknown field",
+			data:   append(append([]byte{}, valid...), 3, 0xff),
+			want:   trace.SpanContext{TraceID: traceID, SpanID: spanID, TraceFlags: trace.FlagsSampled},
+			wantOK: true,
+		},
+		{
+			name: "empty",
+		},
+		{
+			name: "unknown version",
+			data: append([]byte{1}, valid[1:]...),
+		},
+		{
+			name: "truncated trace ID",
+			data: valid[:10],
+		},
+		{
+			name: "missing span ID",
+			data: valid[:18],
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := b.FromBytes(tt.data)
+			assert.Equal(t, tt.wantOK, ok)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestBinaryInjectExtract(t *testing.T) {
+	var b propagation.BinaryPropagator = propagation.Binary{}
+
+	ctx := trace.ContextWithRemoteSpanContext(context.Background(), trace.SpanContext{
+		TraceID:    traceID,
+		SpanID:     spanID,
+		TraceFlags: trace.FlagsSampled,
+	})
+	ctx, span := oteltest.DefaultTracer().Start(ctx, "inject")
+	data := b.Inject(ctx)
+
+	got := trace.RemoteSpanContextFromContext(b.Extract(context.Background(), data))
+	assert.Equal(t, span.SpanContext().TraceID, got.TraceID)
+	assert.Equal(t, span.SpanContext().SpanID, got.SpanID)
+
+	assert.Nil(t, b.Inject(context.Background()))
+	ctx = context.Background()
+	assert.Equal(t, ctx, b.Extract(ctx, []byte{0xff}))
+}
@@ -0,0 +1,146 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package propagation // import "go.opentelemetry.io/otel/propagation"
+
+import (
+	"context"
+	"net/textproto"
+	"net/url"
+	"strings"
+)
+
+// MapCarrier is a TextMapCarrier that uses a map of strings, like the
+// attributes of a queue message or a job payload, as a storage medium.
+// Converting an existing map, MapCarrier(m), allocates nothing.
+type MapCarrier map[string]string
+
+var _ TextMapCarrier = MapCarrier{}
+
+// Get returns the value associated with the passed key.
+func (mc MapCarrier) Get(key string) string {
+	return mc[key]
+}
+
+// Set stores the key-value pair.
+func (mc MapCarrier) Set(key string, value string) {
+	mc[key] = value
+}
+
+// Keys lists the keys stored in this carrier.
+func (mc MapCarrier) Keys() []string {
+	keys := make([]string, 0, len(mc))
+	for k := range mc {
+		keys = append(keys, k)
+	}
+	return keys
+}
+
+// InjectMap returns a new map holding the cross-cutting concerns of ctx
+// injected by p. To inject into an existing map instead, pass
+// MapCarrier(m) to p.Inject.
+func InjectMap(ctx context.Context, p TextMapPropagator) map[string]string {
+	m := make(map[string]string, len(p.Fields()))
+	p.Inject(ctx, MapCarrier(m))
+	return m
+}
+
+// ExtractMap returns a copy of ctx with the cross-cutting concerns in m
+// extracted by p. The map is not modified.
+func ExtractMap(ctx context.Context, p TextMapPropagator, m map[string]string) context.Context {
+	return p.Extract(ctx, MapCarrier(m))
+}
+
+// MetadataCarrier is a TextMapCarrier that uses gRPC metadata as a storage
+// medium. A metadata.MD from google.golang.org/grpc/metadata can be
+// converted to it directly: MetadataCarrier(md).
+//
+// Keys are lowercased, as gRPC metadata keys are case insensitive and
+// stored in lowercase.
+type MetadataCarrier map[string][]string
+
+var _ TextMapCarrier = MetadataCarrier{}
+
+// Get returns the first value associated with the passed key.
+func (mc MetadataCarrier) Get(key string) string {
+	values := mc[strings.ToLower(key)]
+	if len(values) == 0 {
+		return ""
+	}
+	return values[0]
+}
+
+// Set stores the key-value pair, replacing any existing values.
+func (mc MetadataCarrier) Set(key string, value string) {
+	mc[strings.ToLower(key)] = []string{value}
+}
+
+// Keys lists the keys stored in this carrier.
+func (mc MetadataCarrier) Keys() []string {
+	keys := make([]string, 0, len(mc))
+	for k := range mc {
+		keys = append(keys, k)
+	}
+	return keys
+}
+
+// MIMEHeaderCarrier is a TextMapCarrier that uses a textproto.MIMEHeader,
+// like the headers of a mail or multipart message, as a storage medium.
+type MIMEHeaderCarrier textproto.MIMEHeader
+
+var _ TextMapCarrier = MIMEHeaderCarrier{}
+
+// Get returns the first value associated with the passed key.
+func (hc MIMEHeaderCarrier) Get(key string) string {
+	return textproto.MIMEHeader(hc).Get(key)
+}
+
+// Set stores the key-value pair, replacing any existing values.
+func (hc MIMEHeaderCarrier) Set(key string, value string) {
+	textproto.MIMEHeader(hc).Set(key, value)
+}
+
+// Keys lists the keys stored in this carrier.
+func (hc MIMEHeaderCarrier) Keys() []string {
+	keys := make([]string, 0, len(hc))
+	for k := range hc {
+		keys = append(keys, k)
+	}
+	return keys
+}
+
+// URLValuesCarrier is a TextMapCarrier that uses url.Values, like the query
+// parameters of a URL, as a storage medium.
+type URLValuesCarrier url.Values
+
+var _ TextMapCarrier = URLValuesCarrier{}
+
+// Get returns the first value associated with the passed key.
+func (vc URLValuesCarrier) Get(key string) string {
+	return url.Values(vc).Get(key)
+}
+
+// Set stores the key-value pair, replacing any existing values.
+func (vc URLValuesCarrier) Set(key string, value string) {
+	url.Values(vc).Set(key, value)
+}
+
+// Keys lists the keys stored in this carrier.
+func (vc URLValuesCarrier) Keys() []string {
+	keys := make([]string, 0, len(vc))
+	for k := range vc {
+		keys = append(keys, k)
+	}
+	return keys
+}
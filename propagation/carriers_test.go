@@ -0,0 +1,135 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package propagation_test
+
+import (
+	"context"
+	"net/textproto"
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"go.opentelemetry.io/otel/oteltest"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/trace"
+)
+
+func TestMapCarrier(t *testing.T) {
+	m := map[string]string{"existing": "a"}
+	c := propagation.MapCarrier(m)
+
+	assert.Equal(t, "a", c.Get("existing"))
+	assert.Equal(t, "", c.Get("Existing"))
+
+	c.Set("traceparent", "value")
+	assert.Equal(t, "value", m["traceparent"])
+	assert.ElementsMatch(t, []string{"existing", "traceparent"}, c.Keys())
+}
+
+func TestInjectExtractMap(t *testing.T) {
+	prop := propagation.NewCompositeTextMapPropagator(propagation.TraceContext{}, propagation.Baggage{})
+	ctx := trace.ContextWithRemoteSpanContext(context.Background(), trace.SpanContext{
+		TraceID:    traceID,
+		SpanID:     spanID,
+		TraceFlags: trace.FlagsSampled,
+	})
+	ctx, span := oteltest.DefaultTracer().Start(ctx, "inject")
+
+	m := propagation.InjectMap(ctx, prop)
+	assert.Contains(t, m, "traceparent")
+
+	got := trace.RemoteSpanContextFromContext(propagation.ExtractMap(context.Background(), prop, m))
+	assert.Equal(t, span.SpanContext().TraceID, got.TraceID)
+	assert.Equal(t, span.SpanContext().SpanID, got.SpanID)
+
+	assert.Empty(t, propagation.InjectMap(context.Background(), prop))
+	ctx = context.Background()
+	assert.Equal(t, ctx, propagation.ExtractMap(ctx, prop, nil))
+}
+
+func BenchmarkInjectMapCarrier(b *testing.B) {
+	prop := propagation.TraceContext{}
+	ctx := trace.ContextWithRemoteSpanContext(context.Background(), trace.SpanContext{
+		TraceID: traceID,
+		SpanID:  spanID,
+	})
+	ctx, _ = oteltest.DefaultTracer().Start(ctx, "inject")
+	m := make(map[string]string, 2)
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		prop.Inject(ctx, propagation.MapCarrier(m))
+	}
+}
+
+func TestMetadataCarrier(t *testing.T) {
+	md := map[string][]string{"existing": {"a", "b"}}
+	c := propagation.MetadataCarrier(md)
+
+	assert.Equal(t, "a", c.Get("Existing"))
+	assert.Equal(t, "", c.Get("missing"))
+
+	c.Set("TraceParent", "value")
+	assert.Equal(t, []string{"value"}, md["traceparent"])
+	assert.Equal(t, "value", c.Get("traceparent"))
+	assert.ElementsMatch(t, []string{"existing", "traceparent"}, c.Keys())
+}
+
+func TestMIMEHeaderCarrier(t *testing.T) {
+	h := textproto.MIMEHeader{}
+	c := propagation.MIMEHeaderCarrier(h)
+
+	c.Set("traceparent", "value")
+	assert.Equal(t, []string{"value"}, h["Traceparent"])
+	assert.Equal(t, "value", c.Get("TRACEPARENT"))
+	assert.Equal(t, []string{"Traceparent"}, c.Keys())
+}
+
+func TestURLValuesCarrier(t *testing.T) {
+	v := url.Values{}
+	c := propagation.URLValuesCarrier(v)
+
+	c.Set("traceparent", "value")
+	assert.Equal(t, "traceparent=value", v.Encode())
+	assert.Equal(t, "value", c.Get("traceparent"))
+	assert.Equal(t, "", c.Get("Traceparent"), "url.Values keys are case sensitive")
+	assert.Equal(t, []string{"traceparent"}, c.Keys())
+}
+
+func TestCarriersRoundTrip(t *testing.T) {
+	prop := propagation.TraceContext{}
+	ctx := trace.ContextWithRemoteSpanContext(context.Background(), trace.SpanContext{
+		TraceID:    traceID,
+		SpanID:     spanID,
+		TraceFlags: trace.FlagsSampled,
+	})
+	ctx, span := oteltest.DefaultTracer().Start(ctx, "inject")
+
+	carriers := map[string]propagation.TextMapCarrier{
+		"metadata":    propagation.MetadataCarrier{},
+		"mime header": propagation.MIMEHeaderCarrier{},
+		"url values":  propagation.URLValuesCarrier{},
+	}
+	for name, c := range carriers {
+		t.Run(name, func(t *testing.T) {
+			prop.Inject(ctx, c)
+			got := trace.RemoteSpanContextFromContext(prop.Extract(context.Background(), c))
+			assert.Equal(t, span.SpanContext().TraceID, got.TraceID)
+			assert.Equal(t, span.SpanContext().SpanID, got.SpanID)
+		})
+	}
+}
@@ -23,6 +23,7 @@ OpenTelemetry propagators are used to extract and inject context data from and
 into messages exchanged by applications. The propagator supported by this
 package is the W3C Trace Context encoding
 (https://www.w3.org/TR/trace-context/), and W3C Baggage
-(https://w3c.github.io/baggage/).
+(https://w3c.github.io/baggage/). For transports without text headers, the
+Binary propagator supports the gRPC binary trace context format.
 */
 package propagation // import "go.opentelemetry.io/otel/propagation"
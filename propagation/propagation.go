@@ -14,7 +14,11 @@
 
 package propagation // import "go.opentelemetry.io/otel/propagation"
 
-import "context"
+import (
+	"context"
+
+	"go.opentelemetry.io/otel/trace"
+)
 
 // TextMapCarrier is the storage medium used by a TextMapPropagator.
 type TextMapCarrier interface {
@@ -35,24 +39,81 @@ type TextMapPropagator interface {
 	Fields() []string
 }
 
-type compositeTextMapPropagator []TextMapPropagator
+// ExtractPrecedence defines which span context a composite TextMapPropagator
+// keeps when more than one of its propagators extracts one.
+type ExtractPrecedence int
+
+const (
+	// ExtractLastWins keeps the span context extracted by the last
+	// propagator that found one. This is the default.
+	ExtractLastWins ExtractPrecedence = iota
+	// ExtractFirstWins keeps the span context extracted by the first
+	// propagator that found one. Later propagators still extract other
+	// cross-cutting concerns, like baggage.
+	ExtractFirstWins
+)
+
+// CompositeOption configures a composite TextMapPropagator.
+type CompositeOption func(*compositeTextMapPropagator)
+
+// WithExtractPrecedence sets which propagator's span context is kept when
+// several extract one from the same carrier.
+func WithExtractPrecedence(p ExtractPrecedence) CompositeOption {
+	return func(c *compositeTextMapPropagator) {
+		c.precedence = p
+	}
+}
+
+type compositeTextMapPropagator struct {
+	propagators []TextMapPropagator
+	precedence  ExtractPrecedence
+}
 
 func (p compositeTextMapPropagator) Inject(ctx context.Context, carrier TextMapCarrier) {
-	for _, i := range p {
+	for _, i := range p.propagators {
 		i.Inject(ctx, carrier)
 	}
 }
 
 func (p compositeTextMapPropagator) Extract(ctx context.Context, carrier TextMapCarrier) context.Context {
-	for _, i := range p {
+	if p.precedence != ExtractFirstWins {
+		for _, i := range p.propagators {
+			ctx = i.Extract(ctx, carrier)
+		}
+		return ctx
+	}
+
+	orig := trace.RemoteSpanContextFromContext(ctx)
+	var (
+		first   trace.SpanContext
+		matched bool
+	)
+	for _, i := range p.propagators {
 		ctx = i.Extract(ctx, carrier)
+		sc := trace.RemoteSpanContextFromContext(ctx)
+		if matched {
+			if !sameSpanContext(sc, first) {
+				ctx = trace.ContextWithRemoteSpanContext(ctx, first)
+			}
+			continue
+		}
+		if sc.IsValid() && !sameSpanContext(sc, orig) {
+			first, matched = sc, true
+		}
 	}
 	return ctx
 }
 
+func sameSpanContext(a, b trace.SpanContext) bool {
+	return a.TraceID == b.TraceID &&
+		a.SpanID == b.SpanID &&
+		a.TraceFlags == b.TraceFlags &&
+		a.TraceState.String() == b.TraceState.String()
+}
+
 func (p compositeTextMapPropagator) Fields() []string {
 	unique := make(map[string]struct{})
-	for _, i := range p {
+	for _, i := range p.propagators {
 		for _, k := range i.Fields() {
 			unique[k] = struct{}{}
 		}
@@ -74,5 +135,39 @@ func (p compositeTextMapPropagator) Fields() []string {
 // the Fields method will return a de-duplicated slice of the keys that are
 // set with the Inject method.
 func NewCompositeTextMapPropagator(p ...TextMapPropagator) TextMapPropagator {
-	return compositeTextMapPropagator(p)
+	return compositeTextMapPropagator{propagators: p}
+}
+
+// NewCompositeTextMapPropagatorWithOptions returns a unified
+// TextMapPropagator from p, the same as NewCompositeTextMapPropagator, that
+// is configured with opts.
+func NewCompositeTextMapPropagatorWithOptions(p []TextMapPropagator, opts ...CompositeOption) TextMapPropagator {
+	c := compositeTextMapPropagator{propagators: p}
+	for _, opt := range opts {
+		opt(&c)
+	}
+	return c
+}
+
+// FieldOwners returns the propagators that set each field injected by p.
+// Composite propagators, including nested ones, are expanded into the
+// propagators they group, in injection order. A field owned by more than one
+// propagator is written more than once during injection, with the last
+// owner's value taking effect.
+func FieldOwners(p TextMapPropagator) map[string][]TextMapPropagator {
+	owners := make(map[string][]TextMapPropagator)
+	addFieldOwners(owners, p)
+	return owners
+}
+
+func addFieldOwners(owners map[string][]TextMapPropagator, p TextMapPropagator) {
+	if c, ok := p.(compositeTextMapPropagator); ok {
+		for _, i := range c.propagators {
+			addFieldOwners(owners, i)
+		}
+		return
+	}
+	for _, f := range p.Fields() {
+		owners[f] = append(owners[f], p)
+	}
 }
@@ -20,6 +20,7 @@ import (
 	"testing"
 
 	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/trace"
 )
 
 type ctxKeyType uint
@@ -100,3 +101,84 @@ func TestCompositeTextMapPropagatorExtract(t *testing.T) {
 		t.Errorf("invalid extract order: %s", got)
 	}
 }
+
+// scPropagator extracts a fixed remote span context and injects field.
+type scPropagator struct {
+	field string
+	sc    trace.SpanContext
+}
+
+func (p scPropagator) Inject(context.Context, propagation.TextMapCarrier) {}
+
+func (p scPropagator) Extract(ctx context.Context, _ propagation.TextMapCarrier) context.Context {
+	if !p.sc.IsValid() {
+		return ctx
+	}
+	return trace.ContextWithRemoteSpanContext(ctx, p.sc)
+}
+
+func (p scPropagator) Fields() []string { return []string{p.field} }
+
+func TestCompositeTextMapPropagatorExtractPrecedence(t *testing.T) {
+	scA := trace.SpanContext{TraceID: trace.TraceID{1}, SpanID: trace.SpanID{1}}
+	scB := trace.SpanContext{TraceID: trace.TraceID{2}, SpanID: trace.SpanID{2}}
+	none := scPropagator{field: "none"}
+	a := scPropagator{field: "a", sc: scA}
+	b := scPropagator{field: "b", sc: scB}
+	baggage := propagator{"baggage"}
+
+	tests := []struct {
+		name       string
+		precedence propagation.ExtractPrecedence
+		props      []propagation.TextMapPropagator
+		want       trace.SpanContext
+	}{
+		{"last wins", propagation.ExtractLastWins, []propagation.TextMapPropagator{a, b}, scB},
+		{"first wins", propagation.ExtractFirstWins, []propagation.TextMapPropagator{a, b}, scA},
+		{"first wins skips empty", propagation.ExtractFirstWins, []propagation.TextMapPropagator{none, b, a}, scB},
+		{"first wins keeps other concerns", propagation.ExtractFirstWins, []propagation.TextMapPropagator{a, baggage, b}, scA},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p := propagation.NewCompositeTextMapPropagatorWithOptions(tt.props, propagation.WithExtractPrecedence(tt.precedence))
+			ctx := p.Extract(context.Background(), nil)
+			got := trace.RemoteSpanContextFromContext(ctx)
+			if got.TraceID != tt.want.TraceID || got.SpanID != tt.want.SpanID {
+				t.Errorf("extracted %v, want %v", got, tt.want)
+			}
+			if tt.props[1] == baggage && ctx.Value(ctxKey) == nil {
+				t.Error("other propagators were not extracted")
+			}
+		})
+	}
+}
+
+func TestFieldOwners(t *testing.T) {
+	a, b := propagator{"a"}, propagator{"b"}
+	shared := scPropagator{field: "a"}
+	isSCPropagator := func(p propagation.TextMapPropagator) bool {
+		_, ok := p.(scPropagator)
+		return ok
+	}
+	p := propagation.NewCompositeTextMapPropagator(
+		a,
+		propagation.NewCompositeTextMapPropagator(b, shared),
+	)
+
+	owners := propagation.FieldOwners(p)
+	if len(owners) != 2 {
+		t.Fatalf("got owners for %d fields, want 2: %v", len(owners), owners)
+	}
+	if got := owners["a"]; len(got) != 2 || got[0] != a || !isSCPropagator(got[1]) {
+		t.Errorf("owners of a: %v", got)
+	}
+	if got := owners["b"]; len(got) != 1 || got[0] != b {
+		t.Errorf("owners of b: %v", got)
+	}
+
+	single := propagation.FieldOwners(propagation.TraceContext{})
+	if len(single["traceparent"]) != 1 || len(single["tracestate"]) != 1 {
+		t.Errorf("owners of TraceContext: %v", single)
+	}
+}
@@ -0,0 +1,127 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package propagation // import "go.opentelemetry.io/otel/propagation"
+
+import (
+	"context"
+	"net/http"
+	"strings"
+)
+
+// SanitizeFunc validates or rewrites the value of field read from a carrier
+// during extraction. It returns the value the propagator will see.
+// Returning an empty string hides the field from the propagator, rejecting
+// the value.
+type SanitizeFunc func(field, value string) string
+
+// keysCarrier is a TextMapCarrier that can list the keys it holds.
+type keysCarrier interface {
+	TextMapCarrier
+	Keys() []string
+}
+
+type sanitizedTextMapPropagator struct {
+	TextMapPropagator
+	sanitizers []SanitizeFunc
+}
+
+// NewSanitizedTextMapPropagator returns a TextMapPropagator that extracts
+// with p from a view of the carrier in which every value is passed through
+// sanitizers, in order. This is intended for trust boundaries, where
+// inbound context must be validated before it is used. Injection is not
+// affected.
+func NewSanitizedTextMapPropagator(p TextMapPropagator, sanitizers ...SanitizeFunc) TextMapPropagator {
+	return sanitizedTextMapPropagator{
+		TextMapPropagator: p,
+		sanitizers:        sanitizers,
+	}
+}
+
+// Extract reads cross-cutting concerns from the sanitized carrier into a
+// Context.
+func (p sanitizedTextMapPropagator) Extract(ctx context.Context, carrier TextMapCarrier) context.Context {
+	return p.TextMapPropagator.Extract(ctx, sanitizedCarrier{
+		carrier:    carrier,
+		sanitizers: p.sanitizers,
+	})
+}
+
+// sanitizedCarrier applies sanitizers to the values read from carrier. It
+// is read-only: propagators only read from the carrier during extraction.
+type sanitizedCarrier struct {
+	carrier    TextMapCarrier
+	sanitizers []SanitizeFunc
+}
+
+var _ keysCarrier = sanitizedCarrier{}
+
+func (c sanitizedCarrier) Get(key string) string {
+	value := c.carrier.Get(key)
+	for _, s := range c.sanitizers {
+		if value == "" {
+			break
+		}
+		value = s(key, value)
+	}
+	return value
+}
+
+func (c sanitizedCarrier) Set(string, string) {}
+
+// Keys lists the keys of the wrapped carrier, if it is able to.
+func (c sanitizedCarrier) Keys() []string {
+	switch carrier := c.carrier.(type) {
+	case http.Header:
+		keys := make([]string, 0, len(carrier))
+		for k := range carrier {
+			keys = append(keys, k)
+		}
+		return keys
+	case keysCarrier:
+		return carrier.Keys()
+	default:
+		return nil
+	}
+}
+
+// MaxValueLength returns a SanitizeFunc that rejects values of field longer
+// than n bytes. Field names are matched case-insensitively.
+func MaxValueLength(field string, n int) SanitizeFunc {
+	return func(f, value string) string {
+		if strings.EqualFold(f, field) && len(value) > n {
+			return ""
+		}
+		return value
+	}
+}
+
+// FilterBaggageKeys returns a SanitizeFunc that removes the members of the
+// W3C baggage header whose key is not allowed.
+func FilterBaggageKeys(allow func(key string) bool) SanitizeFunc {
+	return func(field, value string) string {
+		if !strings.EqualFold(field, baggageHeader) {
+			return value
+		}
+		members := strings.Split(value, baggageMemberDelimiter)
+		kept := members[:0]
+		for _, m := range members {
+			key := strings.TrimSpace(strings.SplitN(strings.SplitN(m, ";", 2)[0], "=", 2)[0])
+			if allow(key) {
+				kept = append(kept, m)
+			}
+		}
+		return strings.Join(kept, baggageMemberDelimiter)
+	}
+}
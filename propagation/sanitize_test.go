@@ -0,0 +1,97 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package propagation_test
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"go.opentelemetry.io/otel/internal/baggage"
+	"go.opentelemetry.io/otel/label"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/trace"
+)
+
+func TestSanitizedTextMapPropagatorMaxValueLength(t *testing.T) {
+	prop := propagation.NewSanitizedTextMapPropagator(
+		propagation.TraceContext{},
+		propagation.MaxValueLength("tracestate", 16),
+	)
+
+	header := http.Header{}
+	header.Set("traceparent", "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01")
+	header.Set("tracestate", "key1=val1")
+	sc := trace.RemoteSpanContextFromContext(prop.Extract(context.Background(), header))
+	assert.True(t, sc.IsValid())
+	assert.Equal(t, "key1=val1", sc.TraceState.String())
+
+	header.Set("tracestate", "key1=val1,key2=val2")
+	sc = trace.RemoteSpanContextFromContext(prop.Extract(context.Background(), header))
+	assert.True(t, sc.IsValid(), "traceparent must not be affected")
+	assert.True(t, sc.TraceState.IsEmpty(), "oversized tracestate must be rejected")
+}
+
+func TestSanitizedTextMapPropagatorFilterBaggageKeys(t *testing.T) {
+	prop := propagation.NewSanitizedTextMapPropagator(
+		propagation.Baggage{},
+		propagation.FilterBaggageKeys(func(key string) bool {
+			return !strings.HasPrefix(key, "internal.")
+		}),
+	)
+
+	header := http.Header{}
+	header.Set("baggage", "user=alice;prop=1,internal.role=admin, tenant=acme")
+	m := baggage.MapFromContext(prop.Extract(context.Background(), header))
+	assert.Equal(t, 2, m.Len())
+	assert.True(t, m.HasValue("user"))
+	assert.True(t, m.HasValue("tenant"))
+	assert.False(t, m.HasValue("internal.role"))
+}
+
+func TestSanitizedTextMapPropagatorOrder(t *testing.T) {
+	var seen []string
+	record := func(name string) propagation.SanitizeFunc {
+		return func(field, value string) string {
+			seen = append(seen, name+":"+value)
+			return strings.ToUpper(value)
+		}
+	}
+	reject := func(string, string) string { return "" }
+
+	header := http.Header{}
+	header.Set("baggage", "a=b")
+	prop := propagation.NewSanitizedTextMapPropagator(propagation.Baggage{}, record("first"), record("second"), reject, record("third"))
+	ctx := prop.Extract(context.Background(), header)
+
+	assert.Equal(t, []string{"first:a=b", "second:A=B"}, seen)
+	assert.Equal(t, 0, baggage.MapFromContext(ctx).Len())
+}
+
+func TestSanitizedTextMapPropagatorInject(t *testing.T) {
+	prop := propagation.NewSanitizedTextMapPropagator(
+		propagation.Baggage{},
+		func(string, string) string { return "" },
+	)
+	ctx := baggage.NewContext(context.Background(), label.String("key", "value"))
+
+	header := http.Header{}
+	prop.Inject(ctx, header)
+	assert.Equal(t, "key=value", header.Get("baggage"))
+	assert.Equal(t, []string{"baggage"}, prop.Fields())
+}
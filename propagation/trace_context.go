@@ -19,9 +19,7 @@ import (
 	"encoding/hex"
 	"fmt"
 	"regexp"
-	"strings"
 
-	"go.opentelemetry.io/otel/label"
 	"go.opentelemetry.io/otel/trace"
 )
 
@@ -30,6 +28,9 @@ const (
 	maxVersion        = 254
 	traceparentHeader = "traceparent"
 	tracestateHeader  = "tracestate"
+
+	// traceFlagRandom is the trace-flag of the random bit.
+	traceFlagRandom = byte(0x02)
 )
 
 // TraceContext is a propagator that supports the W3C Trace Context format
@@ -58,10 +59,30 @@ func (tc TraceContext) Inject(ctx context.Context, carrier TextMapCarrier) {
 		supportedVersion,
 		sc.TraceID,
 		sc.SpanID,
-		sc.TraceFlags&trace.FlagsSampled)
+		encodeTraceFlags(sc.TraceFlags))
 	carrier.Set(traceparentHeader, h)
 }
 
+// encodeTraceFlags returns the trace-context trace-flags of flags. Only
+// the sampled and random bits are supported.
+func encodeTraceFlags(flags byte) byte {
+	encoded := flags & trace.FlagsSampled
+	if flags&trace.FlagsRandom != 0 {
+		encoded |= traceFlagRandom
+	}
+	return encoded
+}
+
+// decodeTraceFlags returns the trace flags of the trace-context
+// trace-flags encoded, clearing all but the sampled and random bits.
+func decodeTraceFlags(encoded byte) byte {
+	flags := encoded & trace.FlagsSampled
+	if encoded&traceFlagRandom != 0 {
+		flags |= trace.FlagsRandom
+	}
+	return flags
+}
+
 // Extract reads tracecontext from the carrier into a returned Context.
 func (tc TraceContext) Extract(ctx context.Context, carrier TextMapCarrier) context.Context {
 	sc := tc.extract(carrier)
@@ -72,7 +93,17 @@ func (tc TraceContext) Extract(ctx context.Context, carrier TextMapCarrier) cont
 }
 
 func (tc TraceContext) extract(carrier TextMapCarrier) trace.SpanContext {
-	h := carrier.Get(traceparentHeader)
+	sc := parseTraceparent(carrier.Get(traceparentHeader))
+	if !sc.IsValid() {
+		return trace.SpanContext{}
+	}
+	sc.TraceState = parseTraceState(carrier.Get(tracestateHeader))
+	return sc
+}
+
+// parseTraceparent returns the span context encoded in h with the
+// traceparent format, or an invalid span context.
+func parseTraceparent(h string) trace.SpanContext {
 	if h == "" {
 		return trace.SpanContext{}
 	}
@@ -126,13 +157,10 @@ func (tc TraceContext) extract(carrier TextMapCarrier) trace.SpanContext {
 		return trace.SpanContext{}
 	}
 	opts, err := hex.DecodeString(matches[4])
-	if err != nil || len(opts) < 1 || (version == 0 && opts[0] > 2) {
+	if err != nil || len(opts) < 1 || (version == 0 && opts[0] > trace.FlagsSampled|traceFlagRandom) {
 		return trace.SpanContext{}
 	}
-	// Clear all flags other than the trace-context supported sampling bit.
-	sc.TraceFlags = opts[0] & trace.FlagsSampled
-
-	sc.TraceState = parseTraceState(carrier.Get(tracestateHeader))
+	sc.TraceFlags = decodeTraceFlags(opts[0])
 
 	if !sc.IsValid() {
 		return trace.SpanContext{}
@@ -147,23 +175,9 @@ func (tc TraceContext) Fields() []string {
 }
 
 func parseTraceState(in string) trace.TraceState {
-	if in == "" {
-		return trace.TraceState{}
-	}
-
-	kvs := []label.KeyValue{}
-	for _, entry := range strings.Split(in, ",") {
-		parts := strings.SplitN(entry, "=", 2)
-		if len(parts) != 2 {
-			// Parse failure, abort!
-			return trace.TraceState{}
-		}
-		kvs = append(kvs, label.String(parts[0], parts[1]))
-	}
-
 	// Ignoring error here as "failure to parse tracestate MUST NOT
 	// affect the parsing of traceparent."
 	// https://www.w3.org/TR/trace-context/#tracestate-header
-	ts, _ := trace.TraceStateFromKeyValues(kvs...)
+	ts, _ := trace.ParseTraceState(in)
 	return ts
 }
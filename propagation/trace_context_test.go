@@ -51,6 +51,24 @@ func TestExtractValidTraceContextFromHTTPReq(t *testing.T) {
 				TraceFlags: trace.FlagsSampled,
 			},
 		},
+		{
+			name:   "valid w3cHeader, sampled and random",
+			header: "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-03",
+			wantSc: trace.SpanContext{
+				TraceID:    traceID,
+				SpanID:     spanID,
+				TraceFlags: trace.FlagsSampled | trace.FlagsRandom,
+			},
+		},
+		{
+			name:   "valid w3cHeader and random",
+			header: "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-02",
+			wantSc: trace.SpanContext{
+				TraceID:    traceID,
+				SpanID:     spanID,
+				TraceFlags: trace.FlagsRandom,
+			},
+		},
 		{
 			name:   "future version",
 			header: "02-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01",
@@ -234,6 +252,15 @@ func TestInjectTraceContextToHTTPReq(t *testing.T) {
 			},
 			wantHeader: "00-4bf92f3577b34da6a3ce929d0e0e4736-0000000000000003-00",
 		},
+		{
+			name: "valid spancontext, random",
+			sc: trace.SpanContext{
+				TraceID:    traceID,
+				SpanID:     spanID,
+				TraceFlags: trace.FlagsRandom,
+			},
+			wantHeader: "00-4bf92f3577b34da6a3ce929d0e0e4736-0000000000000004-02",
+		},
 		{
 			name: "valid spancontext, with unsupported bit set in traceflags",
 			sc: trace.SpanContext{
@@ -241,7 +268,7 @@ func TestInjectTraceContextToHTTPReq(t *testing.T) {
 				SpanID:     spanID,
 				TraceFlags: 0xff,
 			},
-			wantHeader: "00-4bf92f3577b34da6a3ce929d0e0e4736-0000000000000004-01",
+			wantHeader: "00-4bf92f3577b34da6a3ce929d0e0e4736-0000000000000005-03",
 		},
 		{
 			name:       "invalid spancontext",
@@ -0,0 +1,70 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package propagation // import "go.opentelemetry.io/otel/propagation"
+
+import (
+	"context"
+	"fmt"
+
+	"go.opentelemetry.io/otel/trace"
+)
+
+const traceresponseHeader = "traceresponse"
+
+// TraceResponse writes the span context of the span handling a request
+// back to the caller in the traceresponse header of the W3C Trace Context
+// Level 2 format (https://w3c.github.io/trace-context/#traceresponse-header),
+// so that the caller, like the browser of a real user monitoring agent,
+// learns the trace ID and sampling decision of the server.
+//
+// Unlike TraceContext, TraceResponse is not a TextMapPropagator: servers
+// Inject the span context of their response, and clients Extract it from
+// the response, typically to correlate it with their own telemetry.
+//
+// An HTTP server writes the header before the response status:
+//
+//	ctx, span := tracer.Start(r.Context(), "handler")
+//	defer span.End()
+//	propagation.TraceResponse{}.Inject(ctx, w.Header())
+//
+// Browsers only read the header of cross-origin responses listed in their
+// Access-Control-Expose-Headers header.
+type TraceResponse struct{}
+
+// Inject sets the traceresponse header of the carrier to the span context
+// of ctx, if it is valid.
+func (TraceResponse) Inject(ctx context.Context, carrier TextMapCarrier) {
+	sc := trace.SpanContextFromContext(ctx)
+	if !sc.IsValid() {
+		return
+	}
+	carrier.Set(traceresponseHeader, fmt.Sprintf("%.2x-%s-%s-%.2x",
+		supportedVersion,
+		sc.TraceID,
+		sc.SpanID,
+		encodeTraceFlags(sc.TraceFlags)))
+}
+
+// Extract returns the span context of the traceresponse header of the
+// carrier, or an invalid span context if the header is missing or
+// malformed.
+func (TraceResponse) Extract(carrier TextMapCarrier) trace.SpanContext {
+	return parseTraceparent(carrier.Get(traceresponseHeader))
+}
+
+// Fields returns the keys who's values are set with Inject.
+func (TraceResponse) Fields() []string {
+	return []string{traceresponseHeader}
+}
@@ -0,0 +1,83 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package propagation_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"go.opentelemetry.io/otel/oteltest"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/trace"
+)
+
+func TestTraceResponseInject(t *testing.T) {
+	prop := propagation.TraceResponse{}
+	var sc trace.SpanContext
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := trace.ContextWithRemoteSpanContext(r.Context(), trace.SpanContext{
+			TraceID:    traceID,
+			SpanID:     spanID,
+			TraceFlags: trace.FlagsSampled,
+		})
+		ctx, span := oteltest.DefaultTracer().Start(ctx, "handler")
+		defer span.End()
+		sc = span.SpanContext()
+		prop.Inject(ctx, w.Header())
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	w := httptest.NewRecorder()
+	handler.ServeHTTP(w, httptest.NewRequest("GET", "/", nil))
+	want := fmt.Sprintf("00-%s-%s-01", traceIDStr, sc.SpanID)
+	assert.Equal(t, want, w.Header().Get("traceresponse"))
+	assert.Equal(t, sc, prop.Extract(w.Header()))
+
+	h := http.Header{}
+	prop.Inject(context.Background(), h)
+	assert.Empty(t, h)
+	assert.Equal(t, []string{"traceresponse"}, prop.Fields())
+}
+
+func TestTraceResponseExtract(t *testing.T) {
+	tests := []struct {
+		header string
+		want   trace.SpanContext
+	}{
+		{
+			header: "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01",
+			want:   trace.SpanContext{TraceID: traceID, SpanID: spanID, TraceFlags: trace.FlagsSampled},
+		},
+		{
+			header: "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-00",
+			want:   trace.SpanContext{TraceID: traceID, SpanID: spanID},
+		},
+		{header: ""},
+		{header: "00-00000000000000000000000000000000-00f067aa0ba902b7-01"},
+		{header: "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.header, func(t *testing.T) {
+			h := http.Header{}
+			h.Set("traceresponse", tt.header)
+			assert.Equal(t, tt.want, propagation.TraceResponse{}.Extract(h))
+		})
+	}
+}
@@ -0,0 +1,156 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package autoprop // import "go.opentelemetry.io/otel/propagators/autoprop"
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+	"sync"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/internal/global"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/propagators/b3"
+	"go.opentelemetry.io/otel/propagators/jaeger"
+	"go.opentelemetry.io/otel/propagators/ot"
+	"go.opentelemetry.io/otel/propagators/xray"
+)
+
+// envVar is the environment variable name the propagators are configured
+// with.
+const envVar = "OTEL_PROPAGATORS"
+
+// none is the name that disables all propagation. It is only valid as the
+// sole value of OTEL_PROPAGATORS.
+const none = "none"
+
+var (
+	// errUnknownPropagator is returned when a propagator name is not
+	// registered.
+	errUnknownPropagator = errors.New("unknown propagator")
+	// errDuplicateRegistration is returned when a propagator name is
+	// already registered.
+	errDuplicateRegistration = errors.New("duplicate propagator registration")
+	// errInvalidName is returned when a propagator name cannot be
+	// registered.
+	errInvalidName = errors.New("invalid propagator name")
+)
+
+// registry holds the known TextMapPropagators by name.
+type registry struct {
+	mu    sync.Mutex
+	names map[string]propagation.TextMapPropagator
+}
+
+func (r *registry) load(name string) (propagation.TextMapPropagator, bool) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	p, ok := r.names[name]
+	return p, ok
+}
+
+func (r *registry) store(name string, p propagation.TextMapPropagator) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if _, ok := r.names[name]; ok {
+		return fmt.Errorf("%w: %q", errDuplicateRegistration, name)
+	}
+	r.names[name] = p
+	return nil
+}
+
+var envRegistry = &registry{
+	names: map[string]propagation.TextMapPropagator{
+		"tracecontext": propagation.TraceContext{},
+		"baggage":      propagation.Baggage{},
+		"b3":           b3.New(b3.WithInjectEncoding(b3.B3SingleHeader)),
+		"b3multi":      b3.New(b3.WithInjectEncoding(b3.B3MultipleHeader)),
+		"jaeger":       jaeger.Jaeger{},
+		"xray":         xray.Propagator{},
+		"ottrace":      ot.OT{},
+	},
+}
+
+// RegisterTextMapPropagator sets the TextMapPropagator p to be used when
+// the OTEL_PROPAGATORS environment variable contains the propagator name.
+// An error is returned if name is empty, is "none", or is already
+// registered.
+func RegisterTextMapPropagator(name string, p propagation.TextMapPropagator) error {
+	name = strings.ToLower(strings.TrimSpace(name))
+	if name == "" || name == none || p == nil {
+		return fmt.Errorf("%w: %q", errInvalidName, name)
+	}
+	return envRegistry.store(name, p)
+}
+
+// TextMapPropagator returns a composite TextMapPropagator of the
+// propagators registered with names, in the order they are passed. A single
+// "none" name returns a TextMapPropagator that does not propagate anything.
+// An error is returned if any name is not registered.
+func TextMapPropagator(names ...string) (propagation.TextMapPropagator, error) {
+	props := make([]propagation.TextMapPropagator, 0, len(names))
+	var unknown []string
+	for _, name := range names {
+		name = strings.ToLower(strings.TrimSpace(name))
+		if name == none {
+			if len(names) != 1 {
+				return nil, fmt.Errorf("%w: %q must be the only propagator", errInvalidName, none)
+			}
+			break
+		}
+		p, ok := envRegistry.load(name)
+		if !ok {
+			unknown = append(unknown, name)
+			continue
+		}
+		props = append(props, p)
+	}
+	if len(unknown) > 0 {
+		return nil, fmt.Errorf("%w: %s", errUnknownPropagator, strings.Join(unknown, ", "))
+	}
+	return propagation.NewCompositeTextMapPropagator(props...), nil
+}
+
+// NewTextMapPropagator returns the TextMapPropagator configured by the
+// OTEL_PROPAGATORS environment variable.
+//
+// If the variable is not set, a composite of props is returned, or of the
+// W3C Trace Context and Baggage propagators if props is empty. If the
+// variable is invalid, the error is sent to the global ErrorHandler and the
+// same fallback is returned.
+func NewTextMapPropagator(props ...propagation.TextMapPropagator) propagation.TextMapPropagator {
+	if len(props) == 0 {
+		props = []propagation.TextMapPropagator{
+			propagation.TraceContext{},
+			propagation.Baggage{},
+		}
+	}
+	fallback := propagation.NewCompositeTextMapPropagator(props...)
+
+	value := strings.TrimSpace(os.Getenv(envVar))
+	if value == "" {
+		return fallback
+	}
+
+	p, err := TextMapPropagator(strings.Split(value, ",")...)
+	if err != nil {
+		otel.Handle(fmt.Errorf("%s: %w", envVar, err))
+		return fallback
+	}
+	global.Info("using propagators from environment", envVar, value)
+	return p
+}
@@ -0,0 +1,146 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package autoprop
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.opentelemetry.io/otel/propagation"
+)
+
+// headerPropagator injects a fixed header.
+type headerPropagator string
+
+func (p headerPropagator) Inject(_ context.Context, carrier propagation.TextMapCarrier) {
+	carrier.Set(string(p), "1")
+}
+
+func (p headerPropagator) Extract(ctx context.Context, _ propagation.TextMapCarrier) context.Context {
+	return ctx
+}
+
+func (p headerPropagator) Fields() []string { return []string{string(p)} }
+
+func setEnv(t *testing.T, value string) {
+	orig, ok := os.LookupEnv(envVar)
+	require.NoError(t, os.Setenv(envVar, value))
+	t.Cleanup(func() {
+		if ok {
+			_ = os.Setenv(envVar, orig)
+		} else {
+			_ = os.Unsetenv(envVar)
+		}
+	})
+}
+
+func TestTextMapPropagatorFields(t *testing.T) {
+	tests := []struct {
+		names []string
+		want  []string
+	}{
+		{[]string{"tracecontext"}, []string{"traceparent", "tracestate"}},
+		{[]string{"baggage"}, []string{"baggage"}},
+		{[]string{"b3"}, []string{"b3"}},
+		{[]string{"b3multi"}, []string{"x-b3-traceid", "x-b3-spanid", "x-b3-sampled", "x-b3-flags"}},
+		{[]string{"jaeger"}, []string{"uber-trace-id"}},
+		{[]string{"xray"}, []string{"X-Amzn-Trace-Id"}},
+		{[]string{"ottrace"}, []string{"ot-tracer-traceid", "ot-tracer-spanid", "ot-tracer-sampled"}},
+		{[]string{" TraceContext ", "baggage"}, []string{"traceparent", "tracestate", "baggage"}},
+		{[]string{"none"}, []string{}},
+	}
+
+	for _, tt := range tests {
+		p, err := TextMapPropagator(tt.names...)
+		require.NoError(t, err, tt.names)
+		assert.ElementsMatch(t, tt.want, p.Fields(), tt.names)
+	}
+}
+
+func TestTextMapPropagatorErrors(t *testing.T) {
+	_, err := TextMapPropagator("tracecontext", "unknown", "other")
+	assert.True(t, errors.Is(err, errUnknownPropagator))
+	assert.Contains(t, err.Error(), "unknown, other")
+
+	_, err = TextMapPropagator("none", "tracecontext")
+	assert.True(t, errors.Is(err, errInvalidName))
+}
+
+func TestRegisterTextMapPropagator(t *testing.T) {
+	require.NoError(t, RegisterTextMapPropagator("Custom", headerPropagator("x-custom")))
+	t.Cleanup(func() {
+		envRegistry.mu.Lock()
+		delete(envRegistry.names, "custom")
+		envRegistry.mu.Unlock()
+	})
+
+	err := RegisterTextMapPropagator("custom", headerPropagator("x-other"))
+	assert.True(t, errors.Is(err, errDuplicateRegistration))
+	err = RegisterTextMapPropagator("tracecontext", headerPropagator("x-other"))
+	assert.True(t, errors.Is(err, errDuplicateRegistration))
+	assert.True(t, errors.Is(RegisterTextMapPropagator("none", headerPropagator("x")), errInvalidName))
+	assert.True(t, errors.Is(RegisterTextMapPropagator("", headerPropagator("x")), errInvalidName))
+
+	p, err := TextMapPropagator("custom")
+	require.NoError(t, err)
+	header := http.Header{}
+	p.Inject(context.Background(), header)
+	assert.Equal(t, "1", header.Get("x-custom"))
+}
+
+func TestNewTextMapPropagator(t *testing.T) {
+	t.Run("default", func(t *testing.T) {
+		setEnv(t, "")
+		assert.ElementsMatch(t,
+			[]string{"traceparent", "tracestate", "baggage"},
+			NewTextMapPropagator().Fields(),
+		)
+	})
+
+	t.Run("default with props", func(t *testing.T) {
+		setEnv(t, "")
+		assert.ElementsMatch(t,
+			[]string{"x-custom"},
+			NewTextMapPropagator(headerPropagator("x-custom")).Fields(),
+		)
+	})
+
+	t.Run("environment", func(t *testing.T) {
+		setEnv(t, "b3,jaeger")
+		assert.ElementsMatch(t,
+			[]string{"b3", "uber-trace-id"},
+			NewTextMapPropagator(headerPropagator("x-custom")).Fields(),
+		)
+	})
+
+	t.Run("none", func(t *testing.T) {
+		setEnv(t, "none")
+		assert.Empty(t, NewTextMapPropagator().Fields())
+	})
+
+	t.Run("invalid falls back", func(t *testing.T) {
+		setEnv(t, "tracecontext,bogus")
+		assert.ElementsMatch(t,
+			[]string{"traceparent", "tracestate", "baggage"},
+			NewTextMapPropagator().Fields(),
+		)
+	})
+}
@@ -0,0 +1,32 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package autoprop configures the TextMapPropagator used by an application
+// from the OTEL_PROPAGATORS environment variable.
+//
+// The variable holds a comma-separated list of propagator names. The
+// propagators are combined, in order, into a single composite propagator.
+// The following names are supported by default:
+//
+//   tracecontext: W3C Trace Context
+//   baggage:      W3C Baggage
+//   b3:           B3 single header
+//   b3multi:      B3 multiple header
+//   jaeger:       Jaeger uber-trace-id
+//   xray:         AWS X-Ray
+//   ottrace:      OpenTracing ot-tracer-*
+//   none:         no propagation
+//
+// Additional names can be added with RegisterTextMapPropagator.
+package autoprop // import "go.opentelemetry.io/otel/propagators/autoprop"
@@ -0,0 +1,298 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package b3_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"go.opentelemetry.io/otel/oteltest"
+	"go.opentelemetry.io/otel/propagators/b3"
+	"go.opentelemetry.io/otel/trace"
+)
+
+var (
+	traceID    = trace.TraceID{0x4b, 0xf9, 0x2f, 0x35, 0x77, 0xb3, 0x4d, 0xa6, 0xa3, 0xce, 0x92, 0x9d, 0x0e, 0x0e, 0x47, 0x36}
+	traceIDStr = "4bf92f3577b34da6a3ce929d0e0e4736"
+	spanID     = trace.SpanID{0x00, 0xf0, 0x67, 0xaa, 0x0b, 0xa9, 0x02, 0xb7}
+	spanIDStr  = "00f067aa0ba902b7"
+
+	traceID64Bit    = trace.TraceID{0, 0, 0, 0, 0, 0, 0, 0, 0xa3, 0xce, 0x92, 0x9d, 0x0e, 0x0e, 0x47, 0x36}
+	traceID64BitStr = "a3ce929d0e0e4736"
+)
+
+// withSpanContext returns a context with a current span started from the
+// remote sc. The span keeps sc as its own SpanContext.
+func withSpanContext(sc trace.SpanContext) context.Context {
+	tracer := oteltest.NewTracerProvider(oteltest.WithSpanContextFunc(trace.RemoteSpanContextFromContext)).Tracer("")
+	ctx, _ := tracer.Start(trace.ContextWithRemoteSpanContext(context.Background(), sc), "inject")
+	return ctx
+}
+
+func TestExtract(t *testing.T) {
+	tests := []struct {
+		name    string
+		headers map[string]string
+		want    trace.SpanContext
+	}{
+		{
+			name:    "multiple sampled",
+			headers: map[string]string{"x-b3-traceid": traceIDStr, "x-b3-spanid": spanIDStr, "x-b3-sampled": "1"},
+			want:    trace.SpanContext{TraceID: traceID, SpanID: spanID, TraceFlags: trace.FlagsSampled},
+		},
+		{
+			name:    "multiple not sampled",
+			headers: map[string]string{"x-b3-traceid": traceIDStr, "x-b3-spanid": spanIDStr, "x-b3-sampled": "0"},
+			want:    trace.SpanContext{TraceID: traceID, SpanID: spanID},
+		},
+		{
+			name:    "multiple legacy sampled",
+			headers: map[string]string{"x-b3-traceid": traceIDStr, "x-b3-spanid": spanIDStr, "x-b3-sampled": "true"},
+			want:    trace.SpanContext{TraceID: traceID, SpanID: spanID, TraceFlags: trace.FlagsSampled},
+		},
+		{
+			name:    "multiple deferred",
+			headers: map[string]string{"x-b3-traceid": traceIDStr, "x-b3-spanid": spanIDStr},
+			want:    trace.SpanContext{TraceID: traceID, SpanID: spanID, TraceFlags: trace.FlagsDeferred},
+		},
+		{
+			name:    "multiple debug",
+			headers: map[string]string{"x-b3-traceid": traceIDStr, "x-b3-spanid": spanIDStr, "x-b3-sampled": "1", "x-b3-flags": "1"},
+			want:    trace.SpanContext{TraceID: traceID, SpanID: spanID, TraceFlags: trace.FlagsDebug},
+		},
+		{
+			name:    "multiple 64 bit trace ID",
+			headers: map[string]string{"x-b3-traceid": traceID64BitStr, "x-b3-spanid": spanIDStr, "x-b3-sampled": "1"},
+			want:    trace.SpanContext{TraceID: traceID64Bit, SpanID: spanID, TraceFlags: trace.FlagsSampled},
+		},
+		{
+			name:    "multiple with parent",
+			headers: map[string]string{"x-b3-traceid": traceIDStr, "x-b3-spanid": spanIDStr, "x-b3-parentspanid": "00f067aa0ba90200", "x-b3-sampled": "1"},
+			want:    trace.SpanContext{TraceID: traceID, SpanID: spanID, TraceFlags: trace.FlagsSampled},
+		},
+		{
+			name:    "multiple missing span ID",
+			headers: map[string]string{"x-b3-traceid": traceIDStr, "x-b3-sampled": "1"},
+		},
+		{
+			name:    "multiple invalid sampled",
+			headers: map[string]string{"x-b3-traceid": traceIDStr, "x-b3-spanid": spanIDStr, "x-b3-sampled": "2"},
+		},
+		{
+			name:    "multiple invalid parent",
+			headers: map[string]string{"x-b3-traceid": traceIDStr, "x-b3-spanid": spanIDStr, "x-b3-parentspanid": "zz"},
+		},
+		{
+			name:    "single sampled",
+			headers: map[string]string{"b3": traceIDStr + "-" + spanIDStr + "-1"},
+			want:    trace.SpanContext{TraceID: traceID, SpanID: spanID, TraceFlags: trace.FlagsSampled},
+		},
+		{
+			name:    "single not sampled",
+			headers: map[string]string{"b3": traceIDStr + "-" + spanIDStr + "-0"},
+			want:    trace.SpanContext{TraceID: traceID, SpanID: spanID},
+		},
+		{
+			name:    "single deferred",
+			headers: map[string]string{"b3": traceIDStr + "-" + spanIDStr},
+			want:    trace.SpanContext{TraceID: traceID, SpanID: spanID, TraceFlags: trace.FlagsDeferred},
+		},
+		{
+			name:    "single debug",
+			headers: map[string]string{"b3": traceIDStr + "-" + spanIDStr + "-d"},
+			want:    trace.SpanContext{TraceID: traceID, SpanID: spanID, TraceFlags: trace.FlagsDebug},
+		},
+		{
+			name:    "single with parent",
+			headers: map[string]string{"b3": traceIDStr + "-" + spanIDStr + "-1-00f067aa0ba90200"},
+			want:    trace.SpanContext{TraceID: traceID, SpanID: spanID, TraceFlags: trace.FlagsSampled},
+		},
+		{
+			name:    "single 64 bit trace ID",
+			headers: map[string]string{"b3": traceID64BitStr + "-" + spanIDStr + "-1"},
+			want:    trace.SpanContext{TraceID: traceID64Bit, SpanID: spanID, TraceFlags: trace.FlagsSampled},
+		},
+		{
+			name:    "single sampling only",
+			headers: map[string]string{"b3": "1"},
+		},
+		{
+			name:    "single trace ID only",
+			headers: map[string]string{"b3": traceIDStr},
+		},
+		{
+			name:    "single trailing separator",
+			headers: map[string]string{"b3": traceIDStr + "-" + spanIDStr + "-"},
+		},
+		{
+			name:    "single missing sampling with parent",
+			headers: map[string]string{"b3": traceIDStr + "-" + spanIDStr + "-00f067aa0ba90200"},
+		},
+		{
+			name:    "single invalid sampling",
+			headers: map[string]string{"b3": traceIDStr + "-" + spanIDStr + "-x"},
+		},
+		{
+			name: "single preferred over multiple",
+			headers: map[string]string{
+				"b3":           traceIDStr + "-" + spanIDStr + "-1",
+				"x-b3-traceid": traceID64BitStr,
+				"x-b3-spanid":  spanIDStr,
+				"x-b3-sampled": "0",
+			},
+			want: trace.SpanContext{TraceID: traceID, SpanID: spanID, TraceFlags: trace.FlagsSampled},
+		},
+		{
+			name: "invalid single falls back to multiple",
+			headers: map[string]string{
+				"b3":           "invalid",
+				"x-b3-traceid": traceIDStr,
+				"x-b3-spanid":  spanIDStr,
+				"x-b3-sampled": "1",
+			},
+			want: trace.SpanContext{TraceID: traceID, SpanID: spanID, TraceFlags: trace.FlagsSampled},
+		},
+	}
+
+	prop := b3.New()
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			header := http.Header{}
+			for k, v := range tt.headers {
+				header.Set(k, v)
+			}
+			ctx := prop.Extract(context.Background(), header)
+			got := trace.RemoteSpanContextFromContext(ctx)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestInject(t *testing.T) {
+	tests := []struct {
+		name     string
+		encoding b3.Encoding
+		sc       trace.SpanContext
+		want     map[string]string
+	}{
+		{
+			name: "default sampled",
+			sc:   trace.SpanContext{TraceID: traceID, SpanID: spanID, TraceFlags: trace.FlagsSampled},
+			want: map[string]string{"x-b3-traceid": traceIDStr, "x-b3-spanid": spanIDStr, "x-b3-sampled": "1"},
+		},
+		{
+			name:     "multiple not sampled",
+			encoding: b3.B3MultipleHeader,
+			sc:       trace.SpanContext{TraceID: traceID, SpanID: spanID},
+			want:     map[string]string{"x-b3-traceid": traceIDStr, "x-b3-spanid": spanIDStr, "x-b3-sampled": "0"},
+		},
+		{
+			name:     "multiple deferred",
+			encoding: b3.B3MultipleHeader,
+			sc:       trace.SpanContext{TraceID: traceID, SpanID: spanID, TraceFlags: trace.FlagsDeferred},
+			want:     map[string]string{"x-b3-traceid": traceIDStr, "x-b3-spanid": spanIDStr},
+		},
+		{
+			name:     "multiple debug",
+			encoding: b3.B3MultipleHeader,
+			sc:       trace.SpanContext{TraceID: traceID, SpanID: spanID, TraceFlags: trace.FlagsDebug},
+			want:     map[string]string{"x-b3-traceid": traceIDStr, "x-b3-spanid": spanIDStr, "x-b3-flags": "1"},
+		},
+		{
+			name:     "single sampled",
+			encoding: b3.B3SingleHeader,
+			sc:       trace.SpanContext{TraceID: traceID, SpanID: spanID, TraceFlags: trace.FlagsSampled},
+			want:     map[string]string{"b3": traceIDStr + "-" + spanIDStr + "-1"},
+		},
+		{
+			name:     "single deferred",
+			encoding: b3.B3SingleHeader,
+			sc:       trace.SpanContext{TraceID: traceID, SpanID: spanID, TraceFlags: trace.FlagsDeferred},
+			want:     map[string]string{"b3": traceIDStr + "-" + spanIDStr},
+		},
+		{
+			name:     "single debug",
+			encoding: b3.B3SingleHeader,
+			sc:       trace.SpanContext{TraceID: traceID, SpanID: spanID, TraceFlags: trace.FlagsDebug},
+			want:     map[string]string{"b3": traceIDStr + "-" + spanIDStr + "-d"},
+		},
+		{
+			name:     "single and multiple",
+			encoding: b3.B3SingleHeader | b3.B3MultipleHeader,
+			sc:       trace.SpanContext{TraceID: traceID, SpanID: spanID},
+			want: map[string]string{
+				"b3":           traceIDStr + "-" + spanIDStr + "-0",
+				"x-b3-traceid": traceIDStr,
+				"x-b3-spanid":  spanIDStr,
+				"x-b3-sampled": "0",
+			},
+		},
+		{
+			name: "invalid span context",
+			want: map[string]string{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			prop := b3.New(b3.WithInjectEncoding(tt.encoding))
+			header := http.Header{}
+			ctx := withSpanContext(tt.sc)
+			prop.Inject(ctx, header)
+
+			got := map[string]string{}
+			for k := range header {
+				got[k] = header.Get(k)
+			}
+			want := map[string]string{}
+			for k, v := range tt.want {
+				want[http.CanonicalHeaderKey(k)] = v
+			}
+			assert.Equal(t, want, got)
+		})
+	}
+}
+
+func TestRoundTrip(t *testing.T) {
+	for _, enc := range []b3.Encoding{b3.B3SingleHeader, b3.B3MultipleHeader} {
+		for _, flags := range []byte{0, trace.FlagsSampled, trace.FlagsDeferred, trace.FlagsDebug} {
+			prop := b3.New(b3.WithInjectEncoding(enc))
+			sc := trace.SpanContext{TraceID: traceID, SpanID: spanID, TraceFlags: flags}
+
+			header := http.Header{}
+			prop.Inject(withSpanContext(sc), header)
+			got := trace.RemoteSpanContextFromContext(prop.Extract(context.Background(), header))
+			assert.Equal(t, sc, got, "encoding %d, flags %x", enc, flags)
+		}
+	}
+}
+
+func TestFields(t *testing.T) {
+	assert.ElementsMatch(t,
+		[]string{"x-b3-traceid", "x-b3-spanid", "x-b3-sampled", "x-b3-flags"},
+		b3.New().Fields(),
+	)
+	assert.ElementsMatch(t,
+		[]string{"b3"},
+		b3.New(b3.WithInjectEncoding(b3.B3SingleHeader)).Fields(),
+	)
+	assert.ElementsMatch(t,
+		[]string{"b3", "x-b3-traceid", "x-b3-spanid", "x-b3-sampled", "x-b3-flags"},
+		b3.New(b3.WithInjectEncoding(b3.B3SingleHeader|b3.B3MultipleHeader)).Fields(),
+	)
+}
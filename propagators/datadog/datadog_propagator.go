@@ -0,0 +1,159 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datadog // import "go.opentelemetry.io/otel/propagators/datadog"
+
+import (
+	"context"
+	"encoding/hex"
+	"errors"
+	"strconv"
+	"strings"
+
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const (
+	traceIDHeader          = "x-datadog-trace-id"
+	parentIDHeader         = "x-datadog-parent-id"
+	samplingPriorityHeader = "x-datadog-sampling-priority"
+	tagsHeader             = "x-datadog-tags"
+
+	// upperTraceIDTag is the propagation tag holding the hex encoded upper
+	// 64 bits of a 128 bit trace ID.
+	upperTraceIDTag = "_dd.p.tid"
+
+	// Sampling priorities set by the Datadog sampler.
+	priorityAutoReject = 0
+	priorityAutoKeep   = 1
+)
+
+var (
+	empty = trace.SpanContext{}
+
+	errInvalidTraceID          = errors.New("invalid Datadog trace ID header found")
+	errInvalidParentID         = errors.New("invalid Datadog parent ID header found")
+	errInvalidSamplingPriority = errors.New("invalid Datadog sampling priority header found")
+)
+
+// Datadog propagator serializes SpanContext to/from Datadog headers.
+//
+// Datadog format:
+//
+//   x-datadog-trace-id: {decimal lower 64 bits of the trace ID}
+//   x-datadog-parent-id: {decimal span ID}
+//   x-datadog-sampling-priority: {priority}
+//   x-datadog-tags: _dd.p.tid={hex upper 64 bits of the trace ID}
+//
+// A positive sampling priority is propagated as sampled, zero or negative
+// as not sampled, and a missing priority as deferred.
+type Datadog struct{}
+
+var _ propagation.TextMapPropagator = Datadog{}
+
+// Inject injects a context into the carrier as Datadog headers.
+func (dd Datadog) Inject(ctx context.Context, carrier propagation.TextMapCarrier) {
+	sc := trace.SpanContextFromContext(ctx)
+	if !sc.IsValid() {
+		return
+	}
+
+	carrier.Set(traceIDHeader, strconv.FormatUint(sc.TraceID.Low64(), 10))
+	carrier.Set(parentIDHeader, strconv.FormatUint(sc.SpanID.Uint64(), 10))
+	if !sc.TraceID.Is64Bit() {
+		carrier.Set(tagsHeader, upperTraceIDTag+"="+hex.EncodeToString(sc.TraceID[:8]))
+	}
+
+	if !sc.IsDeferred() || sc.IsSampled() {
+		priority := priorityAutoReject
+		if sc.IsSampled() {
+			priority = priorityAutoKeep
+		}
+		carrier.Set(samplingPriorityHeader, strconv.Itoa(priority))
+	}
+}
+
+// Extract extracts a context from the carrier if it contains Datadog
+// headers.
+func (dd Datadog) Extract(ctx context.Context, carrier propagation.TextMapCarrier) context.Context {
+	sc, err := extract(
+		carrier.Get(traceIDHeader),
+		carrier.Get(parentIDHeader),
+		carrier.Get(samplingPriorityHeader),
+		carrier.Get(tagsHeader),
+	)
+	if err != nil || !sc.IsValid() {
+		return ctx
+	}
+	return trace.ContextWithRemoteSpanContext(ctx, sc)
+}
+
+// Fields returns the keys who's values are set with Inject.
+func (dd Datadog) Fields() []string {
+	return []string{traceIDHeader, parentIDHeader, samplingPriorityHeader, tagsHeader}
+}
+
+func extract(traceID, parentID, priority, tags string) (trace.SpanContext, error) {
+	var sc trace.SpanContext
+
+	lower, err := strconv.ParseUint(traceID, 10, 64)
+	if err != nil {
+		return empty, errInvalidTraceID
+	}
+	sc.TraceID = trace.TraceIDFromUint64(lower)
+	if upper, ok := upperTraceID(tags); ok {
+		copy(sc.TraceID[:8], upper)
+	}
+
+	spanID, err := strconv.ParseUint(parentID, 10, 64)
+	if err != nil {
+		return empty, errInvalidParentID
+	}
+	sc.SpanID = trace.SpanIDFromUint64(spanID)
+
+	if priority == "" {
+		sc.TraceFlags = trace.FlagsDeferred
+	} else {
+		p, err := strconv.Atoi(priority)
+		if err != nil {
+			return empty, errInvalidSamplingPriority
+		}
+		if p > priorityAutoReject {
+			sc.TraceFlags = trace.FlagsSampled
+		}
+	}
+
+	return sc, nil
+}
+
+// upperTraceID returns the upper 64 bits of the trace ID from the
+// propagation tags, if present and valid.
+func upperTraceID(tags string) ([]byte, bool) {
+	for _, tag := range strings.Split(tags, ",") {
+		kv := strings.SplitN(strings.TrimSpace(tag), "=", 2)
+		if len(kv) != 2 || kv[0] != upperTraceIDTag {
+			continue
+		}
+		if len(kv[1]) != 16 {
+			return nil, false
+		}
+		b, err := hex.DecodeString(kv[1])
+		if err != nil {
+			return nil, false
+		}
+		return b, true
+	}
+	return nil, false
+}
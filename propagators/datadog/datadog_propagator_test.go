@@ -0,0 +1,181 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datadog_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"go.opentelemetry.io/otel/oteltest"
+	"go.opentelemetry.io/otel/propagators/datadog"
+	"go.opentelemetry.io/otel/trace"
+)
+
+var (
+	// Lower 64 bits 9532221613074888604, upper 64 bits 0x4bf92f3577b34da6.
+	traceID128 = trace.TraceID{0x4b, 0xf9, 0x2f, 0x35, 0x77, 0xb3, 0x4d, 0xa6, 0x84, 0x49, 0x41, 0x0d, 0x9f, 0xa1, 0x37, 0x9c}
+	traceID64  = trace.TraceID{0, 0, 0, 0, 0, 0, 0, 0, 0x84, 0x49, 0x41, 0x0d, 0x9f, 0xa1, 0x37, 0x9c}
+	// 67667974448284343
+	spanID = trace.SpanID{0x00, 0xf0, 0x67, 0xaa, 0x0b, 0xa9, 0x02, 0xb7}
+)
+
+const (
+	traceIDDec = "9532221613074888604"
+	spanIDDec  = "67667974448284343"
+)
+
+func TestExtract(t *testing.T) {
+	tests := []struct {
+		name    string
+		headers map[string]string
+		want    trace.SpanContext
+	}{
+		{
+			name:    "keep",
+			headers: map[string]string{"x-datadog-trace-id": traceIDDec, "x-datadog-parent-id": spanIDDec, "x-datadog-sampling-priority": "1"},
+			want:    trace.SpanContext{TraceID: traceID64, SpanID: spanID, TraceFlags: trace.FlagsSampled},
+		},
+		{
+			name:    "user keep",
+			headers: map[string]string{"x-datadog-trace-id": traceIDDec, "x-datadog-parent-id": spanIDDec, "x-datadog-sampling-priority": "2"},
+			want:    trace.SpanContext{TraceID: traceID64, SpanID: spanID, TraceFlags: trace.FlagsSampled},
+		},
+		{
+			name:    "user reject",
+			headers: map[string]string{"x-datadog-trace-id": traceIDDec, "x-datadog-parent-id": spanIDDec, "x-datadog-sampling-priority": "-1"},
+			want:    trace.SpanContext{TraceID: traceID64, SpanID: spanID},
+		},
+		{
+			name:    "deferred",
+			headers: map[string]string{"x-datadog-trace-id": traceIDDec, "x-datadog-parent-id": spanIDDec},
+			want:    trace.SpanContext{TraceID: traceID64, SpanID: spanID, TraceFlags: trace.FlagsDeferred},
+		},
+		{
+			name: "128 bit trace ID",
+			headers: map[string]string{
+				"x-datadog-trace-id":          traceIDDec,
+				"x-datadog-parent-id":         spanIDDec,
+				"x-datadog-sampling-priority": "1",
+				"x-datadog-tags":              "_dd.p.dm=-0,_dd.p.tid=4bf92f3577b34da6",
+			},
+			want: trace.SpanContext{TraceID: traceID128, SpanID: spanID, TraceFlags: trace.FlagsSampled},
+		},
+		{
+			name: "invalid upper trace ID ignored",
+			headers: map[string]string{
+				"x-datadog-trace-id":          traceIDDec,
+				"x-datadog-parent-id":         spanIDDec,
+				"x-datadog-sampling-priority": "1",
+				"x-datadog-tags":              "_dd.p.tid=xyz",
+			},
+			want: trace.SpanContext{TraceID: traceID64, SpanID: spanID, TraceFlags: trace.FlagsSampled},
+		},
+		{
+			name:    "invalid trace ID",
+			headers: map[string]string{"x-datadog-trace-id": "abc", "x-datadog-parent-id": spanIDDec},
+		},
+		{
+			name:    "zero trace ID",
+			headers: map[string]string{"x-datadog-trace-id": "0", "x-datadog-parent-id": spanIDDec},
+		},
+		{
+			name:    "missing parent ID",
+			headers: map[string]string{"x-datadog-trace-id": traceIDDec},
+		},
+		{
+			name:    "invalid priority",
+			headers: map[string]string{"x-datadog-trace-id": traceIDDec, "x-datadog-parent-id": spanIDDec, "x-datadog-sampling-priority": "yes"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			header := http.Header{}
+			for k, v := range tt.headers {
+				header.Set(k, v)
+			}
+			ctx := datadog.Datadog{}.Extract(context.Background(), header)
+			assert.Equal(t, tt.want, trace.RemoteSpanContextFromContext(ctx))
+		})
+	}
+}
+
+func TestInject(t *testing.T) {
+	tests := []struct {
+		name string
+		sc   trace.SpanContext
+		want map[string]string
+	}{
+		{
+			name: "sampled",
+			sc:   trace.SpanContext{TraceID: traceID64, SpanID: spanID, TraceFlags: trace.FlagsSampled},
+			want: map[string]string{"X-Datadog-Trace-Id": traceIDDec, "X-Datadog-Parent-Id": spanIDDec, "X-Datadog-Sampling-Priority": "1"},
+		},
+		{
+			name: "not sampled",
+			sc:   trace.SpanContext{TraceID: traceID64, SpanID: spanID},
+			want: map[string]string{"X-Datadog-Trace-Id": traceIDDec, "X-Datadog-Parent-Id": spanIDDec, "X-Datadog-Sampling-Priority": "0"},
+		},
+		{
+			name: "deferred",
+			sc:   trace.SpanContext{TraceID: traceID64, SpanID: spanID, TraceFlags: trace.FlagsDeferred},
+			want: map[string]string{"X-Datadog-Trace-Id": traceIDDec, "X-Datadog-Parent-Id": spanIDDec},
+		},
+		{
+			name: "128 bit trace ID",
+			sc:   trace.SpanContext{TraceID: traceID128, SpanID: spanID, TraceFlags: trace.FlagsSampled},
+			want: map[string]string{
+				"X-Datadog-Trace-Id":          traceIDDec,
+				"X-Datadog-Parent-Id":         spanIDDec,
+				"X-Datadog-Sampling-Priority": "1",
+				"X-Datadog-Tags":              "_dd.p.tid=4bf92f3577b34da6",
+			},
+		},
+		{
+			name: "invalid",
+			want: map[string]string{},
+		},
+	}
+
+	mockTracer := oteltest.NewTracerProvider(oteltest.WithSpanContextFunc(trace.RemoteSpanContextFromContext)).Tracer("")
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := trace.ContextWithRemoteSpanContext(context.Background(), tt.sc)
+			ctx, _ = mockTracer.Start(ctx, "inject")
+			header := http.Header{}
+			datadog.Datadog{}.Inject(ctx, header)
+			got := map[string]string{}
+			for k := range header {
+				got[k] = header.Get(k)
+			}
+			assert.Equal(t, tt.want, got)
+
+			if tt.sc.IsValid() {
+				extracted := trace.RemoteSpanContextFromContext(datadog.Datadog{}.Extract(context.Background(), header))
+				assert.Equal(t, tt.sc, extracted, "round trip")
+			}
+		})
+	}
+}
+
+func TestFields(t *testing.T) {
+	assert.Equal(t,
+		[]string{"x-datadog-trace-id", "x-datadog-parent-id", "x-datadog-sampling-priority", "x-datadog-tags"},
+		datadog.Datadog{}.Fields(),
+	)
+}
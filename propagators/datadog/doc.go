@@ -0,0 +1,22 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package datadog implements the header propagation format of the Datadog
+// tracing libraries.
+//
+// Datadog identifies traces with 64 bit IDs. When a trace ID has non-zero
+// upper 64 bits they are carried in the _dd.p.tid entry of the
+// x-datadog-tags header, as done by Datadog tracers with 128 bit trace ID
+// support. Otherwise the upper bits of an extracted trace ID are zero.
+package datadog // import "go.opentelemetry.io/otel/propagators/datadog"
@@ -0,0 +1,21 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package jaeger implements the Jaeger propagator specification as defined at
+// https://www.jaegertracing.io/docs/1.18/client-libraries/#propagation-format
+//
+// The span context is carried in the uber-trace-id header and baggage is
+// carried in headers prefixed with uberctx-, matching the defaults of
+// jaeger-client-go.
+package jaeger // import "go.opentelemetry.io/otel/propagators/jaeger"
@@ -0,0 +1,229 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package jaeger // import "go.opentelemetry.io/otel/propagators/jaeger"
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"go.opentelemetry.io/otel/baggage"
+	"go.opentelemetry.io/otel/label"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const (
+	jaegerHeader        = "uber-trace-id"
+	jaegerBaggagePrefix = "uberctx-"
+	separator           = ":"
+	traceID128bitsWidth = 32
+	spanIDWidth         = 16
+
+	flagsSampled = 0x01
+	flagsDebug   = 0x02
+)
+
+var (
+	empty = trace.SpanContext{}
+
+	errMalformedTraceContextVal = errors.New("header value of uber-trace-id should contain four different part separated by : ")
+	errInvalidTraceIDLength     = errors.New("invalid trace id length, must be either 16 or 32")
+	errMalformedTraceID         = errors.New("cannot decode trace id from header")
+	errInvalidSpanIDLength      = errors.New("invalid span id length, must be 16")
+	errMalformedSpanID          = errors.New("cannot decode span id from header")
+	errMalformedFlag            = errors.New("cannot decode flag")
+)
+
+// keyLister is implemented by carriers that can enumerate the keys they
+// hold. It is required to extract baggage, which is spread over an open set
+// of uberctx- prefixed keys.
+type keyLister interface {
+	Keys() []string
+}
+
+// Jaeger propagator serializes SpanContext to/from Jaeger Headers.
+//
+// Jaeger format:
+//
+// uber-trace-id: {trace-id}:{span-id}:{parent-span-id}:{flags}
+//
+// Baggage is serialized as one uberctx-{key}: {value} header per member.
+type Jaeger struct{}
+
+var _ propagation.TextMapPropagator = Jaeger{}
+
+// Inject injects a context into the carrier as Jaeger headers. The parent
+// span ID is not tracked by the SpanContext and is always sent as 0.
+func (jaeger Jaeger) Inject(ctx context.Context, carrier propagation.TextMapCarrier) {
+	sc := trace.SpanContextFromContext(ctx)
+	if sc.IsValid() {
+		var flags byte
+		if sc.IsSampled() {
+			flags |= flagsSampled
+		}
+		if sc.IsDebug() {
+			// Jaeger debug traces are always sampled.
+			flags |= flagsSampled | flagsDebug
+		}
+
+		carrier.Set(jaegerHeader, strings.Join([]string{
+			sc.TraceID.String(),
+			sc.SpanID.String(),
+			"0",
+			fmt.Sprintf("%x", flags),
+		}, separator))
+	}
+
+	bags := baggage.Set(ctx)
+	iter := bags.Iter()
+	for iter.Next() {
+		kv := iter.Label()
+		carrier.Set(jaegerBaggagePrefix+string(kv.Key), url.QueryEscape(kv.Value.Emit()))
+	}
+}
+
+// Extract extracts a context from the carrier if it contains Jaeger headers.
+//
+// Baggage is only extracted from carriers that can enumerate their keys:
+// http.Header and any carrier with a Keys() []string method.
+func (jaeger Jaeger) Extract(ctx context.Context, carrier propagation.TextMapCarrier) context.Context {
+	if kvs := extractBaggage(carrier); len(kvs) > 0 {
+		ctx = baggage.ContextWithValues(ctx, kvs...)
+	}
+
+	// extract tracing information
+	if h := carrier.Get(jaegerHeader); h != "" {
+		sc, err := extract(h)
+		if err == nil && sc.IsValid() {
+			return trace.ContextWithRemoteSpanContext(ctx, sc)
+		}
+	}
+
+	return ctx
+}
+
+// Fields returns the keys who's values are set with Inject. Baggage keys
+// are not known ahead of time and are therefore not included.
+func (jaeger Jaeger) Fields() []string {
+	return []string{jaegerHeader}
+}
+
+func extract(headerVal string) (trace.SpanContext, error) {
+	var (
+		sc  = trace.SpanContext{}
+		err error
+	)
+
+	// jaeger-client-go URL encodes the header value when it is sent over
+	// HTTP, so ':' may arrive as "%3A".
+	if unescaped, err := url.QueryUnescape(headerVal); err == nil {
+		headerVal = unescaped
+	}
+
+	parts := strings.Split(headerVal, separator)
+	if len(parts) != 4 {
+		return empty, errMalformedTraceContextVal
+	}
+
+	// extract trace ID
+	if parts[0] != "" {
+		id := parts[0]
+		if len(id) > traceID128bitsWidth {
+			return empty, errInvalidTraceIDLength
+		}
+		// padding when length is less than 32
+		if len(id) < traceID128bitsWidth {
+			id = strings.Repeat("0", traceID128bitsWidth-len(id)) + id
+		}
+		sc.TraceID, err = trace.TraceIDFromHex(id)
+		if err != nil {
+			return empty, errMalformedTraceID
+		}
+	}
+
+	// extract span ID
+	if parts[1] != "" {
+		id := parts[1]
+		if len(id) > spanIDWidth {
+			return empty, errInvalidSpanIDLength
+		}
+		// padding when length is less than 16
+		if len(id) < spanIDWidth {
+			id = strings.Repeat("0", spanIDWidth-len(id)) + id
+		}
+		sc.SpanID, err = trace.SpanIDFromHex(id)
+		if err != nil {
+			return empty, errMalformedSpanID
+		}
+	}
+
+	// skip third part as it is deprecated
+
+	// extract flag
+	if parts[3] != "" {
+		flagStr := parts[3]
+		flag, err := strconv.ParseInt(flagStr, 16, 64)
+		if err != nil {
+			return empty, errMalformedFlag
+		}
+		if flag&flagsDebug == flagsDebug {
+			sc.TraceFlags = trace.FlagsDebug
+		} else if flag&flagsSampled == flagsSampled {
+			sc.TraceFlags = trace.FlagsSampled
+		}
+	}
+
+	return sc, nil
+}
+
+// extractBaggage returns the uberctx- prefixed members of carrier as
+// baggage key-values. Values that fail to unescape are skipped.
+func extractBaggage(carrier propagation.TextMapCarrier) []label.KeyValue {
+	var keys []string
+	switch c := carrier.(type) {
+	case http.Header:
+		keys = make([]string, 0, len(c))
+		for k := range c {
+			keys = append(keys, k)
+		}
+	case keyLister:
+		keys = c.Keys()
+	default:
+		return nil
+	}
+
+	var kvs []label.KeyValue
+	for _, k := range keys {
+		lower := strings.ToLower(k)
+		if !strings.HasPrefix(lower, jaegerBaggagePrefix) {
+			continue
+		}
+		name := lower[len(jaegerBaggagePrefix):]
+		if name == "" {
+			continue
+		}
+		value, err := url.QueryUnescape(carrier.Get(k))
+		if err != nil {
+			continue
+		}
+		kvs = append(kvs, label.String(name, value))
+	}
+	return kvs
+}
@@ -0,0 +1,193 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package jaeger_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"go.opentelemetry.io/otel/baggage"
+	"go.opentelemetry.io/otel/label"
+	"go.opentelemetry.io/otel/oteltest"
+	"go.opentelemetry.io/otel/propagators/jaeger"
+	"go.opentelemetry.io/otel/trace"
+)
+
+var (
+	traceID    = trace.TraceID{0x4b, 0xf9, 0x2f, 0x35, 0x77, 0xb3, 0x4d, 0xa6, 0xa3, 0xce, 0x92, 0x9d, 0x0e, 0x0e, 0x47, 0x36}
+	traceIDStr = "4bf92f3577b34da6a3ce929d0e0e4736"
+	spanID     = trace.SpanID{0x00, 0xf0, 0x67, 0xaa, 0x0b, 0xa9, 0x02, 0xb7}
+	spanIDStr  = "00f067aa0ba902b7"
+
+	traceID64Bit = trace.TraceID{0, 0, 0, 0, 0, 0, 0, 0, 0xa3, 0xce, 0x92, 0x9d, 0x0e, 0x0e, 0x47, 0x36}
+)
+
+// mapCarrier is a TextMapCarrier able to list its keys.
+type mapCarrier map[string]string
+
+func (c mapCarrier) Get(key string) string        { return c[key] }
+func (c mapCarrier) Set(key string, value string) { c[key] = value }
+func (c mapCarrier) Keys() []string {
+	keys := make([]string, 0, len(c))
+	for k := range c {
+		keys = append(keys, k)
+	}
+	return keys
+}
+
+func TestExtract(t *testing.T) {
+	tests := []struct {
+		name   string
+		header string
+		want   trace.SpanContext
+	}{
+		{
+			name:   "sampled",
+			header: traceIDStr + ":" + spanIDStr + ":0:1",
+			want:   trace.SpanContext{TraceID: traceID, SpanID: spanID, TraceFlags: trace.FlagsSampled},
+		},
+		{
+			name:   "not sampled",
+			header: traceIDStr + ":" + spanIDStr + ":0:0",
+			want:   trace.SpanContext{TraceID: traceID, SpanID: spanID},
+		},
+		{
+			name:   "debug",
+			header: traceIDStr + ":" + spanIDStr + ":0:3",
+			want:   trace.SpanContext{TraceID: traceID, SpanID: spanID, TraceFlags: trace.FlagsDebug},
+		},
+		{
+			name:   "short IDs are padded",
+			header: "a3ce929d0e0e4736:f067aa0ba902b7:0:1",
+			want:   trace.SpanContext{TraceID: traceID64Bit, SpanID: spanID, TraceFlags: trace.FlagsSampled},
+		},
+		{
+			name:   "url encoded",
+			header: traceIDStr + "%3A" + spanIDStr + "%3A0%3A1",
+			want:   trace.SpanContext{TraceID: traceID, SpanID: spanID, TraceFlags: trace.FlagsSampled},
+		},
+		{
+			name:   "missing parts",
+			header: traceIDStr + ":" + spanIDStr + ":1",
+		},
+		{
+			name:   "trace ID too long",
+			header: traceIDStr + "00:" + spanIDStr + ":0:1",
+		},
+		{
+			name:   "invalid span ID",
+			header: traceIDStr + ":zzf067aa0ba902b7:0:1",
+		},
+		{
+			name:   "invalid flags",
+			header: traceIDStr + ":" + spanIDStr + ":0:x",
+		},
+		{
+			name:   "zero trace ID",
+			header: "0:" + spanIDStr + ":0:1",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			header := http.Header{}
+			header.Set("uber-trace-id", tt.header)
+			ctx := jaeger.Jaeger{}.Extract(context.Background(), header)
+			assert.Equal(t, tt.want, trace.RemoteSpanContextFromContext(ctx))
+		})
+	}
+}
+
+func TestExtractBaggage(t *testing.T) {
+	header := http.Header{}
+	header.Set("uberctx-user", "alice%20smith")
+	header.Set("Uberctx-Tenant", "acme")
+	header.Set("x-other", "ignored")
+
+	ctx := jaeger.Jaeger{}.Extract(context.Background(), header)
+	assert.Equal(t, "alice smith", baggage.Value(ctx, "user").AsString())
+	assert.Equal(t, "acme", baggage.Value(ctx, "tenant").AsString())
+	bags := baggage.Set(ctx)
+	assert.Equal(t, 2, bags.Len())
+
+	carrier := mapCarrier{"uberctx-user": "bob"}
+	ctx = jaeger.Jaeger{}.Extract(context.Background(), carrier)
+	assert.Equal(t, "bob", baggage.Value(ctx, "user").AsString())
+}
+
+func TestInject(t *testing.T) {
+	tests := []struct {
+		name  string
+		flags byte
+		want  string
+	}{
+		{
+			name:  "sampled",
+			flags: trace.FlagsSampled,
+			want:  traceIDStr + ":" + spanIDStr + ":0:1",
+		},
+		{
+			name: "not sampled",
+			want: traceIDStr + ":" + spanIDStr + ":0:0",
+		},
+		{
+			name:  "debug",
+			flags: trace.FlagsDebug,
+			want:  traceIDStr + ":" + spanIDStr + ":0:3",
+		},
+	}
+
+	mockTracer := oteltest.NewTracerProvider(oteltest.WithSpanContextFunc(trace.RemoteSpanContextFromContext)).Tracer("")
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sc := trace.SpanContext{TraceID: traceID, SpanID: spanID, TraceFlags: tt.flags}
+			ctx := trace.ContextWithRemoteSpanContext(context.Background(), sc)
+			ctx, _ = mockTracer.Start(ctx, "inject")
+			header := http.Header{}
+			jaeger.Jaeger{}.Inject(ctx, header)
+			assert.Equal(t, tt.want, header.Get("uber-trace-id"))
+		})
+	}
+}
+
+func TestInjectInvalidSpanContext(t *testing.T) {
+	header := http.Header{}
+	jaeger.Jaeger{}.Inject(context.Background(), header)
+	assert.Empty(t, header)
+}
+
+func TestBaggageRoundTrip(t *testing.T) {
+	ctx := baggage.ContextWithValues(context.Background(),
+		label.String("user", "alice smith"),
+		label.Int("attempt", 2),
+	)
+	carrier := mapCarrier{}
+	jaeger.Jaeger{}.Inject(ctx, carrier)
+	assert.Equal(t, mapCarrier{
+		"uberctx-user":    "alice+smith",
+		"uberctx-attempt": "2",
+	}, carrier)
+
+	got := jaeger.Jaeger{}.Extract(context.Background(), carrier)
+	assert.Equal(t, "alice smith", baggage.Value(got, "user").AsString())
+	assert.Equal(t, "2", baggage.Value(got, "attempt").AsString())
+}
+
+func TestFields(t *testing.T) {
+	assert.Equal(t, []string{"uber-trace-id"}, jaeger.Jaeger{}.Fields())
+}
@@ -0,0 +1,21 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package ot implements the ot-tracer-* propagator used by the default
+// Lightstep and basictracer-go OpenTracing tracers.
+//
+// This propagator is useful while migrating services from OpenTracing to
+// OpenTelemetry, as it keeps traces connected across both generations of
+// instrumentation.
+package ot // import "go.opentelemetry.io/otel/propagators/ot"
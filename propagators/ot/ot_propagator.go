@@ -0,0 +1,178 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ot // import "go.opentelemetry.io/otel/propagators/ot"
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strings"
+
+	"go.opentelemetry.io/otel/baggage"
+	"go.opentelemetry.io/otel/label"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const (
+	traceIDHeader = "ot-tracer-traceid"
+	spanIDHeader  = "ot-tracer-spanid"
+	sampledHeader = "ot-tracer-sampled"
+	baggagePrefix = "ot-baggage-"
+)
+
+var (
+	empty = trace.SpanContext{}
+
+	errInvalidSampledHeader = errors.New("invalid OT Sampled header found")
+	errInvalidTraceIDHeader = errors.New("invalid OT traceID header found")
+	errInvalidSpanIDHeader  = errors.New("invalid OT spanID header found")
+)
+
+// keyLister is implemented by carriers that can enumerate the keys they
+// hold. It is required to extract baggage, which is spread over an open set
+// of ot-baggage- prefixed keys.
+type keyLister interface {
+	Keys() []string
+}
+
+// OT propagator serializes SpanContext to/from ot-trace-* headers.
+//
+// OT format:
+//
+//   ot-tracer-traceid: {lower 64 bits of the trace ID}
+//   ot-tracer-spanid: {span ID}
+//   ot-tracer-sampled: {true|false}
+//   ot-baggage-{key}: {value}
+type OT struct{}
+
+var _ propagation.TextMapPropagator = OT{}
+
+// Inject injects a context into the carrier as OT headers. Only the lower 64
+// bits of the trace ID are sent, as basictracer-go only supports 64 bit
+// trace IDs.
+func (o OT) Inject(ctx context.Context, carrier propagation.TextMapCarrier) {
+	sc := trace.SpanContextFromContext(ctx)
+	if !sc.IsValid() {
+		return
+	}
+
+	bags := baggage.Set(ctx)
+	iter := bags.Iter()
+	for iter.Next() {
+		kv := iter.Label()
+		carrier.Set(baggagePrefix+string(kv.Key), kv.Value.Emit())
+	}
+
+	if sc.IsSampled() {
+		carrier.Set(sampledHeader, "true")
+	} else {
+		carrier.Set(sampledHeader, "false")
+	}
+	carrier.Set(traceIDHeader, sc.TraceID.Hex64())
+	carrier.Set(spanIDHeader, sc.SpanID.String())
+}
+
+// Extract extracts a context from the carrier if it contains OT headers.
+//
+// Baggage is only extracted alongside a valid span context and only from
+// carriers that can enumerate their keys: http.Header and any carrier with
+// a Keys() []string method.
+func (o OT) Extract(ctx context.Context, carrier propagation.TextMapCarrier) context.Context {
+	var (
+		traceID = carrier.Get(traceIDHeader)
+		spanID  = carrier.Get(spanIDHeader)
+		sampled = carrier.Get(sampledHeader)
+	)
+	sc, err := extract(traceID, spanID, sampled)
+	if err != nil || !sc.IsValid() {
+		return ctx
+	}
+
+	if kvs := extractBaggage(carrier); len(kvs) > 0 {
+		ctx = baggage.ContextWithValues(ctx, kvs...)
+	}
+	return trace.ContextWithRemoteSpanContext(ctx, sc)
+}
+
+// Fields returns the keys who's values are set with Inject. Baggage keys
+// are not known ahead of time and are therefore not included.
+func (o OT) Fields() []string {
+	return []string{traceIDHeader, spanIDHeader, sampledHeader}
+}
+
+// extract reconstructs a SpanContext from header values based on OT
+// headers.
+func extract(traceID, spanID, sampled string) (trace.SpanContext, error) {
+	var (
+		err error
+		sc  = trace.SpanContext{}
+	)
+
+	switch strings.ToLower(sampled) {
+	case "0", "false":
+		// Zero value for TraceFlags sample bit is unset.
+	case "1", "true":
+		sc.TraceFlags = trace.FlagsSampled
+	default:
+		return empty, errInvalidSampledHeader
+	}
+
+	if traceID == "" || spanID == "" {
+		return empty, errInvalidTraceIDHeader
+	}
+
+	// 64-bit trace IDs are padded.
+	if sc.TraceID, err = trace.TraceIDFromHex64(traceID); err != nil {
+		return empty, errInvalidTraceIDHeader
+	}
+
+	if sc.SpanID, err = trace.SpanIDFromHex(spanID); err != nil {
+		return empty, errInvalidSpanIDHeader
+	}
+
+	return sc, nil
+}
+
+// extractBaggage returns the ot-baggage- prefixed members of carrier as
+// baggage key-values.
+func extractBaggage(carrier propagation.TextMapCarrier) []label.KeyValue {
+	var keys []string
+	switch c := carrier.(type) {
+	case http.Header:
+		keys = make([]string, 0, len(c))
+		for k := range c {
+			keys = append(keys, k)
+		}
+	case keyLister:
+		keys = c.Keys()
+	default:
+		return nil
+	}
+
+	var kvs []label.KeyValue
+	for _, k := range keys {
+		lower := strings.ToLower(k)
+		if !strings.HasPrefix(lower, baggagePrefix) {
+			continue
+		}
+		name := lower[len(baggagePrefix):]
+		if name == "" {
+			continue
+		}
+		kvs = append(kvs, label.String(name, carrier.Get(k)))
+	}
+	return kvs
+}
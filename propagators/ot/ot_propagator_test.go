@@ -0,0 +1,132 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ot_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"go.opentelemetry.io/otel/baggage"
+	"go.opentelemetry.io/otel/label"
+	"go.opentelemetry.io/otel/oteltest"
+	"go.opentelemetry.io/otel/propagators/ot"
+	"go.opentelemetry.io/otel/trace"
+)
+
+var (
+	traceID    = trace.TraceID{0x4b, 0xf9, 0x2f, 0x35, 0x77, 0xb3, 0x4d, 0xa6, 0xa3, 0xce, 0x92, 0x9d, 0x0e, 0x0e, 0x47, 0x36}
+	traceIDStr = "4bf92f3577b34da6a3ce929d0e0e4736"
+	spanID     = trace.SpanID{0x00, 0xf0, 0x67, 0xaa, 0x0b, 0xa9, 0x02, 0xb7}
+	spanIDStr  = "00f067aa0ba902b7"
+
+	traceID64Bit    = trace.TraceID{0, 0, 0, 0, 0, 0, 0, 0, 0xa3, 0xce, 0x92, 0x9d, 0x0e, 0x0e, 0x47, 0x36}
+	traceID64BitStr = "a3ce929d0e0e4736"
+)
+
+func TestExtract(t *testing.T) {
+	tests := []struct {
+		name    string
+		headers map[string]string
+		want    trace.SpanContext
+	}{
+		{
+			name:    "sampled",
+			headers: map[string]string{"ot-tracer-traceid": traceIDStr, "ot-tracer-spanid": spanIDStr, "ot-tracer-sampled": "true"},
+			want:    trace.SpanContext{TraceID: traceID, SpanID: spanID, TraceFlags: trace.FlagsSampled},
+		},
+		{
+			name:    "not sampled",
+			headers: map[string]string{"ot-tracer-traceid": traceIDStr, "ot-tracer-spanid": spanIDStr, "ot-tracer-sampled": "false"},
+			want:    trace.SpanContext{TraceID: traceID, SpanID: spanID},
+		},
+		{
+			name:    "64 bit trace ID",
+			headers: map[string]string{"ot-tracer-traceid": traceID64BitStr, "ot-tracer-spanid": spanIDStr, "ot-tracer-sampled": "1"},
+			want:    trace.SpanContext{TraceID: traceID64Bit, SpanID: spanID, TraceFlags: trace.FlagsSampled},
+		},
+		{
+			name:    "missing sampled",
+			headers: map[string]string{"ot-tracer-traceid": traceIDStr, "ot-tracer-spanid": spanIDStr},
+		},
+		{
+			name:    "missing span ID",
+			headers: map[string]string{"ot-tracer-traceid": traceIDStr, "ot-tracer-sampled": "true"},
+		},
+		{
+			name:    "invalid trace ID",
+			headers: map[string]string{"ot-tracer-traceid": "xyz", "ot-tracer-spanid": spanIDStr, "ot-tracer-sampled": "true"},
+		},
+		{
+			name:    "invalid span ID",
+			headers: map[string]string{"ot-tracer-traceid": traceIDStr, "ot-tracer-spanid": "xyz", "ot-tracer-sampled": "true"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			header := http.Header{}
+			for k, v := range tt.headers {
+				header.Set(k, v)
+			}
+			ctx := ot.OT{}.Extract(context.Background(), header)
+			assert.Equal(t, tt.want, trace.RemoteSpanContextFromContext(ctx))
+		})
+	}
+}
+
+func TestExtractBaggage(t *testing.T) {
+	header := http.Header{}
+	header.Set("ot-tracer-traceid", traceIDStr)
+	header.Set("ot-tracer-spanid", spanIDStr)
+	header.Set("ot-tracer-sampled", "true")
+	header.Set("ot-baggage-user", "alice")
+
+	ctx := ot.OT{}.Extract(context.Background(), header)
+	assert.Equal(t, "alice", baggage.Value(ctx, "user").AsString())
+
+	// Baggage without a span context is dropped.
+	header.Del("ot-tracer-traceid")
+	ctx = ot.OT{}.Extract(context.Background(), header)
+	bags := baggage.Set(ctx)
+	assert.Equal(t, 0, bags.Len())
+}
+
+func TestInject(t *testing.T) {
+	sc := trace.SpanContext{TraceID: traceID, SpanID: spanID, TraceFlags: trace.FlagsSampled}
+	ctx := trace.ContextWithRemoteSpanContext(context.Background(), sc)
+	mockTracer := oteltest.NewTracerProvider(oteltest.WithSpanContextFunc(trace.RemoteSpanContextFromContext)).Tracer("")
+	ctx, _ = mockTracer.Start(ctx, "inject")
+	ctx = baggage.ContextWithValues(ctx, label.String("user", "alice"))
+
+	header := http.Header{}
+	ot.OT{}.Inject(ctx, header)
+	assert.Equal(t, http.Header{
+		"Ot-Tracer-Traceid": []string{traceID64BitStr},
+		"Ot-Tracer-Spanid":  []string{spanIDStr},
+		"Ot-Tracer-Sampled": []string{"true"},
+		"Ot-Baggage-User":   []string{"alice"},
+	}, header)
+
+	header = http.Header{}
+	ot.OT{}.Inject(baggage.ContextWithValues(context.Background(), label.String("user", "alice")), header)
+	assert.Empty(t, header, "nothing is injected without a valid span context")
+}
+
+func TestFields(t *testing.T) {
+	assert.Equal(t, []string{"ot-tracer-traceid", "ot-tracer-spanid", "ot-tracer-sampled"}, ot.OT{}.Fields())
+}
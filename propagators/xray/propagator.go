@@ -0,0 +1,177 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package xray // import "go.opentelemetry.io/otel/propagators/xray"
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const (
+	traceHeaderKey       = "X-Amzn-Trace-Id"
+	traceHeaderDelimiter = ";"
+	kvDelimiter          = "="
+	traceIDKey           = "Root"
+	parentIDKey          = "Parent"
+	sampleFlagKey        = "Sampled"
+
+	traceIDVersion         = "1"
+	traceIDDelimiter       = "-"
+	traceIDLength          = 35
+	traceIDDelimiterIndex1 = 1
+	traceIDDelimiterIndex2 = 10
+	traceIDFirstPartLength = 8
+
+	isSampled    = "1"
+	notSampled   = "0"
+	deferSampled = "?"
+)
+
+var (
+	empty = trace.SpanContext{}
+
+	errInvalidTraceHeader    = errors.New("invalid X-Amzn-Trace-Id header value, should contain 3 different part separated by ;")
+	errMalformedTraceID      = errors.New("cannot decode trace ID from header")
+	errLengthTraceIDHeader   = errors.New("incorrect length of X-Ray trace ID found, 35 character length expected")
+	errInvalidTraceIDVersion = errors.New("invalid X-Ray trace ID header found, does not have valid trace ID version")
+	errInvalidSpanIDLength   = errors.New("invalid span ID length, must be 16")
+	errMalformedSpanID       = errors.New("cannot decode span ID from header")
+	errInvalidSampledValue   = errors.New("invalid Sampled value found in header")
+)
+
+// Propagator serializes SpanContext to/from the X-Ray trace header.
+//
+// X-Ray format:
+//
+//	X-Amzn-Trace-Id: Root={traceId};Parent={parentId};Sampled={samplingFlag}
+//
+// where the trace ID is encoded as 1-{first 8 hex}-{last 24 hex}.
+type Propagator struct{}
+
+var _ propagation.TextMapPropagator = Propagator{}
+
+// Inject injects a context into the carrier as an X-Ray trace header.
+func (xray Propagator) Inject(ctx context.Context, carrier propagation.TextMapCarrier) {
+	sc := trace.SpanContextFromContext(ctx)
+	if !sc.IsValid() {
+		return
+	}
+
+	otTraceID := sc.TraceID.String()
+	xrayTraceID := traceIDVersion + traceIDDelimiter + otTraceID[:traceIDFirstPartLength] +
+		traceIDDelimiter + otTraceID[traceIDFirstPartLength:]
+
+	samplingFlag := notSampled
+	if sc.IsSampled() {
+		samplingFlag = isSampled
+	} else if sc.IsDeferred() {
+		samplingFlag = deferSampled
+	}
+
+	headers := []string{
+		traceIDKey, kvDelimiter, xrayTraceID, traceHeaderDelimiter,
+		parentIDKey, kvDelimiter, sc.SpanID.String(), traceHeaderDelimiter,
+		sampleFlagKey, kvDelimiter, samplingFlag,
+	}
+	carrier.Set(traceHeaderKey, strings.Join(headers, ""))
+}
+
+// Extract extracts a context from the carrier if it contains an X-Ray trace
+// header.
+func (xray Propagator) Extract(ctx context.Context, carrier propagation.TextMapCarrier) context.Context {
+	// extract tracing information
+	if header := carrier.Get(traceHeaderKey); header != "" {
+		sc, err := extract(header)
+		if err == nil && sc.IsValid() {
+			return trace.ContextWithRemoteSpanContext(ctx, sc)
+		}
+	}
+	return ctx
+}
+
+// Fields returns the keys who's values are set with Inject.
+func (xray Propagator) Fields() []string {
+	return []string{traceHeaderKey}
+}
+
+// extract extracts Span Context from the X-Ray trace header. Fields other
+// than Root, Parent, and Sampled are ignored.
+func extract(headerVal string) (trace.SpanContext, error) {
+	var (
+		sc  = trace.SpanContext{TraceFlags: trace.FlagsDeferred}
+		err error
+	)
+
+	for _, part := range strings.Split(headerVal, traceHeaderDelimiter) {
+		part = strings.TrimSpace(part)
+		equalsIndex := strings.Index(part, kvDelimiter)
+		if equalsIndex < 0 {
+			return empty, errInvalidTraceHeader
+		}
+		value := part[equalsIndex+1:]
+		switch part[:equalsIndex] {
+		case traceIDKey:
+			if sc.TraceID, err = parseTraceID(value); err != nil {
+				return empty, err
+			}
+		case parentIDKey:
+			if len(value) != 16 {
+				return empty, errInvalidSpanIDLength
+			}
+			if sc.SpanID, err = trace.SpanIDFromHex(value); err != nil {
+				return empty, errMalformedSpanID
+			}
+		case sampleFlagKey:
+			switch value {
+			case isSampled:
+				sc.TraceFlags = trace.FlagsSampled
+			case notSampled:
+				sc.TraceFlags = 0
+			case deferSampled:
+				sc.TraceFlags = trace.FlagsDeferred
+			default:
+				return empty, errInvalidSampledValue
+			}
+		}
+	}
+	return sc, nil
+}
+
+// parseTraceID returns the trace ID encoded as 1-{8 hex}-{24 hex}.
+func parseTraceID(xrayTraceID string) (trace.TraceID, error) {
+	if len(xrayTraceID) != traceIDLength {
+		return trace.TraceID{}, errLengthTraceIDHeader
+	}
+	if !strings.HasPrefix(xrayTraceID, traceIDVersion) {
+		return trace.TraceID{}, errInvalidTraceIDVersion
+	}
+	if xrayTraceID[traceIDDelimiterIndex1:traceIDDelimiterIndex1+1] != traceIDDelimiter ||
+		xrayTraceID[traceIDDelimiterIndex2:traceIDDelimiterIndex2+1] != traceIDDelimiter {
+		return trace.TraceID{}, errMalformedTraceID
+	}
+
+	epochPart := xrayTraceID[traceIDDelimiterIndex1+1 : traceIDDelimiterIndex2]
+	uniquePart := xrayTraceID[traceIDDelimiterIndex2+1:]
+
+	id, err := trace.TraceIDFromHex(epochPart + uniquePart)
+	if err != nil {
+		return trace.TraceID{}, errMalformedTraceID
+	}
+	return id, nil
+}
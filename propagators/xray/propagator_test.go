@@ -0,0 +1,123 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package xray_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"go.opentelemetry.io/otel/oteltest"
+	"go.opentelemetry.io/otel/propagators/xray"
+	"go.opentelemetry.io/otel/trace"
+)
+
+var (
+	traceID = trace.TraceID{0x57, 0x59, 0xe9, 0x88, 0xbd, 0x86, 0x2e, 0x3f, 0xe1, 0xbe, 0x46, 0xa9, 0x94, 0x27, 0x27, 0x93}
+	spanID  = trace.SpanID{0x53, 0x99, 0x5c, 0x3f, 0x42, 0xcd, 0x8a, 0xd8}
+)
+
+func TestExtract(t *testing.T) {
+	tests := []struct {
+		name   string
+		header string
+		want   trace.SpanContext
+	}{
+		{
+			name:   "sampled",
+			header: "Root=1-5759e988-bd862e3fe1be46a994272793;Parent=53995c3f42cd8ad8;Sampled=1",
+			want:   trace.SpanContext{TraceID: traceID, SpanID: spanID, TraceFlags: trace.FlagsSampled},
+		},
+		{
+			name:   "not sampled",
+			header: "Root=1-5759e988-bd862e3fe1be46a994272793;Parent=53995c3f42cd8ad8;Sampled=0",
+			want:   trace.SpanContext{TraceID: traceID, SpanID: spanID},
+		},
+		{
+			name:   "deferred",
+			header: "Root=1-5759e988-bd862e3fe1be46a994272793;Parent=53995c3f42cd8ad8;Sampled=?",
+			want:   trace.SpanContext{TraceID: traceID, SpanID: spanID, TraceFlags: trace.FlagsDeferred},
+		},
+		{
+			name:   "missing sampled",
+			header: "Root=1-5759e988-bd862e3fe1be46a994272793;Parent=53995c3f42cd8ad8",
+			want:   trace.SpanContext{TraceID: traceID, SpanID: spanID, TraceFlags: trace.FlagsDeferred},
+		},
+		{
+			name:   "extra fields ignored",
+			header: "Root=1-5759e988-bd862e3fe1be46a994272793; Parent=53995c3f42cd8ad8; Sampled=1; Self=1-abc",
+			want:   trace.SpanContext{TraceID: traceID, SpanID: spanID, TraceFlags: trace.FlagsSampled},
+		},
+		{
+			name:   "wrong version",
+			header: "Root=2-5759e988-bd862e3fe1be46a994272793;Parent=53995c3f42cd8ad8;Sampled=1",
+		},
+		{
+			name:   "bad trace ID length",
+			header: "Root=1-5759e988-bd862e3fe1be46a99427279;Parent=53995c3f42cd8ad8;Sampled=1",
+		},
+		{
+			name:   "bad span ID",
+			header: "Root=1-5759e988-bd862e3fe1be46a994272793;Parent=53995c3f42cd8ad;Sampled=1",
+		},
+		{
+			name:   "bad sampled",
+			header: "Root=1-5759e988-bd862e3fe1be46a994272793;Parent=53995c3f42cd8ad8;Sampled=2",
+		},
+		{
+			name:   "malformed",
+			header: "Root;Parent=53995c3f42cd8ad8;Sampled=1",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			header := http.Header{}
+			header.Set("X-Amzn-Trace-Id", tt.header)
+			ctx := xray.Propagator{}.Extract(context.Background(), header)
+			assert.Equal(t, tt.want, trace.RemoteSpanContextFromContext(ctx))
+		})
+	}
+}
+
+func TestInject(t *testing.T) {
+	tests := []struct {
+		flags byte
+		want  string
+	}{
+		{trace.FlagsSampled, "Root=1-5759e988-bd862e3fe1be46a994272793;Parent=53995c3f42cd8ad8;Sampled=1"},
+		{0, "Root=1-5759e988-bd862e3fe1be46a994272793;Parent=53995c3f42cd8ad8;Sampled=0"},
+		{trace.FlagsDeferred, "Root=1-5759e988-bd862e3fe1be46a994272793;Parent=53995c3f42cd8ad8;Sampled=?"},
+	}
+
+	mockTracer := oteltest.NewTracerProvider(oteltest.WithSpanContextFunc(trace.RemoteSpanContextFromContext)).Tracer("")
+	for _, tt := range tests {
+		sc := trace.SpanContext{TraceID: traceID, SpanID: spanID, TraceFlags: tt.flags}
+		ctx, _ := mockTracer.Start(trace.ContextWithRemoteSpanContext(context.Background(), sc), "inject")
+		header := http.Header{}
+		xray.Propagator{}.Inject(ctx, header)
+		assert.Equal(t, tt.want, header.Get("X-Amzn-Trace-Id"))
+	}
+
+	header := http.Header{}
+	xray.Propagator{}.Inject(context.Background(), header)
+	assert.Empty(t, header)
+}
+
+func TestFields(t *testing.T) {
+	assert.Equal(t, []string{"X-Amzn-Trace-Id"}, xray.Propagator{}.Fields())
+}
@@ -0,0 +1,118 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package baggagecopy_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.opentelemetry.io/otel/baggage"
+	"go.opentelemetry.io/otel/label"
+	"go.opentelemetry.io/otel/metric"
+	"go.opentelemetry.io/otel/metric/registry"
+	"go.opentelemetry.io/otel/oteltest"
+	"go.opentelemetry.io/otel/sdk/baggagecopy"
+	export "go.opentelemetry.io/otel/sdk/export/trace"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+)
+
+type testExporter struct {
+	spans []*export.SpanSnapshot
+}
+
+func (e *testExporter) ExportSpans(_ context.Context, spans []*export.SpanSnapshot) error {
+	e.spans = append(e.spans, spans...)
+	return nil
+}
+
+func (e *testExporter) Shutdown(context.Context) error { return nil }
+
+func baggageContext() context.Context {
+	return baggage.ContextWithValues(context.Background(),
+		label.String("tenant", "acme"),
+		label.String("user", "alice"),
+		label.String("secret", "s3cr3t"),
+	)
+}
+
+func TestKeyValues(t *testing.T) {
+	ctx := baggageContext()
+
+	assert.Empty(t, baggagecopy.New().KeyValues(ctx))
+
+	c := baggagecopy.New(baggagecopy.WithKeys("tenant", "missing", "tenant"), baggagecopy.WithKeys("user"))
+	assert.Equal(t, []label.KeyValue{
+		label.String("tenant", "acme"),
+		label.String("user", "alice"),
+	}, c.KeyValues(ctx))
+
+	c = baggagecopy.New(baggagecopy.WithKeys("tenant"), baggagecopy.WithPrefix("baggage."))
+	assert.Equal(t, []label.KeyValue{
+		label.String("baggage.tenant", "acme"),
+	}, c.KeyValues(ctx))
+}
+
+func TestSpanProcessor(t *testing.T) {
+	c := baggagecopy.New(baggagecopy.WithKeys("tenant", "user"))
+	exp := &testExporter{}
+	tp := sdktrace.NewTracerProvider(
+		sdktrace.WithSpanProcessor(c.SpanProcessor()),
+		sdktrace.WithSyncer(exp),
+	)
+
+	_, span := tp.Tracer("test").Start(baggageContext(), "span")
+	span.End()
+
+	require.Len(t, exp.spans, 1)
+	attrs := map[label.Key]label.Value{}
+	for _, kv := range exp.spans[0].Attributes {
+		attrs[kv.Key] = kv.Value
+	}
+	assert.Equal(t, map[label.Key]label.Value{
+		"tenant": label.StringValue("acme"),
+		"user":   label.StringValue("alice"),
+	}, attrs)
+}
+
+func TestMeterImpl(t *testing.T) {
+	c := baggagecopy.New(baggagecopy.WithKeys("tenant", "user"))
+	impl, _ := oteltest.NewMeter()
+	meter := registry.NewMeterProvider(c.MeterImpl(impl)).Meter("test")
+
+	counter := metric.Must(meter).NewInt64Counter("counter")
+	ctx := baggageContext()
+
+	counter.Add(ctx, 1, label.String("user", "explicit"))
+	meter.RecordBatch(ctx, []label.KeyValue{label.String("batch", "true")}, counter.Measurement(2))
+	counter.Bind(label.String("bound", "true")).Add(ctx, 3)
+
+	got := oteltest.AsStructs(impl.MeasurementBatches)
+	require.Len(t, got, 3)
+	assert.Equal(t, oteltest.LabelsToMap(
+		label.String("tenant", "acme"),
+		label.String("user", "explicit"),
+	), got[0].Labels, "explicit labels take precedence")
+	assert.Equal(t, oteltest.LabelsToMap(
+		label.String("tenant", "acme"),
+		label.String("user", "alice"),
+		label.String("batch", "true"),
+	), got[1].Labels)
+	assert.Equal(t, oteltest.LabelsToMap(
+		label.String("bound", "true"),
+	), got[2].Labels, "bound instruments are not enriched")
+}
@@ -0,0 +1,124 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package baggagecopy // import "go.opentelemetry.io/otel/sdk/baggagecopy"
+
+import (
+	"context"
+
+	"go.opentelemetry.io/otel/baggage"
+	"go.opentelemetry.io/otel/label"
+)
+
+// config contains configuration for a Copier.
+type config struct {
+	// keys are the allow-listed baggage keys, without duplicates.
+	keys []label.Key
+	// prefix is prepended to the key of copied members.
+	prefix string
+}
+
+// Option is the interface that applies a configuration option.
+type Option interface {
+	// Apply sets the Option value of a config.
+	Apply(*config)
+}
+
+// WithKeys adds keys to the baggage keys that are copied. Members with
+// other keys are never copied.
+func WithKeys(keys ...label.Key) Option {
+	return keysOption(keys)
+}
+
+type keysOption []label.Key
+
+func (o keysOption) Apply(cfg *config) {
+	for _, k := range o {
+		if !hasKey(cfg.keys, k) {
+			cfg.keys = append(cfg.keys, k)
+		}
+	}
+}
+
+func hasKey(keys []label.Key, key label.Key) bool {
+	for _, k := range keys {
+		if k == key {
+			return true
+		}
+	}
+	return false
+}
+
+// WithPrefix sets a prefix prepended to the key of copied members, for
+// example "baggage.", to keep them apart from other attributes and labels.
+func WithPrefix(prefix string) Option {
+	return prefixOption(prefix)
+}
+
+type prefixOption string
+
+func (o prefixOption) Apply(cfg *config) {
+	cfg.prefix = string(o)
+}
+
+// Copier copies allow-listed baggage members from a context.
+type Copier struct {
+	cfg config
+}
+
+// New returns a Copier configured with opts. Without WithKeys, the
+// Copier copies nothing.
+func New(opts ...Option) *Copier {
+	var cfg config
+	for _, opt := range opts {
+		opt.Apply(&cfg)
+	}
+	return &Copier{cfg: cfg}
+}
+
+// KeyValues returns the allow-listed baggage members of ctx.
+func (c *Copier) KeyValues(ctx context.Context) []label.KeyValue {
+	if len(c.cfg.keys) == 0 {
+		return nil
+	}
+	return c.appendKeyValues(ctx, nil)
+}
+
+func (c *Copier) appendKeyValues(ctx context.Context, dst []label.KeyValue) []label.KeyValue {
+	for _, k := range c.cfg.keys {
+		v := baggage.Value(ctx, k)
+		if v.Type() == label.INVALID {
+			continue
+		}
+		key := k
+		if c.cfg.prefix != "" {
+			key = label.Key(c.cfg.prefix + string(k))
+		}
+		dst = append(dst, label.KeyValue{Key: key, Value: v})
+	}
+	return dst
+}
+
+// labels returns the allow-listed baggage members of ctx followed by
+// labels, so the explicit labels take precedence on conflicts.
+func (c *Copier) labels(ctx context.Context, labels []label.KeyValue) []label.KeyValue {
+	if len(c.cfg.keys) == 0 {
+		return labels
+	}
+	kvs := c.appendKeyValues(ctx, make([]label.KeyValue, 0, len(c.cfg.keys)+len(labels)))
+	if len(kvs) == 0 {
+		return labels
+	}
+	return append(kvs, labels...)
+}
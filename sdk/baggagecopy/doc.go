@@ -0,0 +1,22 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package baggagecopy copies allow-listed baggage members into telemetry.
+//
+// A Copier is configured once with the baggage keys to copy and is then
+// used for both signals: its SpanProcessor adds the members as attributes
+// of started spans, and its MeterImpl adds them as labels of recorded
+// measurements. Wrap the MeterImpl of an SDK, for example with
+// registry.NewMeterProvider(copier.MeterImpl(impl)), to enrich metrics.
+package baggagecopy // import "go.opentelemetry.io/otel/sdk/baggagecopy"
@@ -0,0 +1,69 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package baggagecopy // import "go.opentelemetry.io/otel/sdk/baggagecopy"
+
+import (
+	"context"
+
+	"go.opentelemetry.io/otel/label"
+	"go.opentelemetry.io/otel/metric"
+	"go.opentelemetry.io/otel/metric/number"
+)
+
+// meterImpl adds baggage members as labels of synchronous measurements.
+type meterImpl struct {
+	metric.MeterImpl
+	copier *Copier
+}
+
+var _ metric.MeterImpl = meterImpl{}
+
+// MeterImpl returns a MeterImpl that adds the allow-listed baggage members
+// of the measurement context as labels to every synchronous measurement
+// recorded with impl. Labels passed with the measurement take precedence
+// over baggage labels with the same key.
+//
+// Bound instruments and asynchronous instruments have no measurement
+// context to read baggage from and are not enriched.
+func (c *Copier) MeterImpl(impl metric.MeterImpl) metric.MeterImpl {
+	return meterImpl{MeterImpl: impl, copier: c}
+}
+
+// RecordBatch records the batch with baggage labels added.
+func (m meterImpl) RecordBatch(ctx context.Context, labels []label.KeyValue, ms ...metric.Measurement) {
+	m.MeterImpl.RecordBatch(ctx, m.copier.labels(ctx, labels), ms...)
+}
+
+// NewSyncInstrument returns a synchronous instrument that adds baggage
+// labels when recording.
+func (m meterImpl) NewSyncInstrument(descriptor metric.Descriptor) (metric.SyncImpl, error) {
+	inst, err := m.MeterImpl.NewSyncInstrument(descriptor)
+	if err != nil {
+		return nil, err
+	}
+	return syncImpl{SyncImpl: inst, copier: m.copier}, nil
+}
+
+// syncImpl adds baggage labels to recorded measurements. Implementation
+// is delegated so the SDK still recognizes its own instrument in batches.
+type syncImpl struct {
+	metric.SyncImpl
+	copier *Copier
+}
+
+// RecordOne records a measurement with baggage labels added.
+func (s syncImpl) RecordOne(ctx context.Context, n number.Number, labels []label.KeyValue) {
+	s.SyncImpl.RecordOne(ctx, n, s.copier.labels(ctx, labels))
+}
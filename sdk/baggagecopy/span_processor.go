@@ -0,0 +1,58 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package baggagecopy // import "go.opentelemetry.io/otel/sdk/baggagecopy"
+
+import (
+	"context"
+
+	"go.opentelemetry.io/otel/trace"
+
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+)
+
+// spanProcessor adds baggage members as span attributes when a span starts.
+type spanProcessor struct {
+	copier *Copier
+}
+
+var (
+	_ sdktrace.SpanProcessor   = spanProcessor{}
+	_ sdktrace.EndedSpanFilter = spanProcessor{}
+)
+
+// SpanProcessor returns a SpanProcessor that sets the allow-listed baggage
+// members of the parent context as attributes of every started span.
+func (c *Copier) SpanProcessor() sdktrace.SpanProcessor {
+	return spanProcessor{copier: c}
+}
+
+// OnStart sets the baggage attributes on s.
+func (p spanProcessor) OnStart(parent context.Context, s sdktrace.ReadWriteSpan) {
+	if kvs := p.copier.KeyValues(parent); len(kvs) > 0 {
+		s.SetAttributes(kvs...)
+	}
+}
+
+// OnEnd does nothing.
+func (spanProcessor) OnEnd(sdktrace.ReadOnlySpan) {}
+
+// ProcessesEndedSpan returns false, no ended span is processed.
+func (spanProcessor) ProcessesEndedSpan(trace.SpanContext) bool { return false }
+
+// Shutdown does nothing.
+func (spanProcessor) Shutdown(context.Context) error { return nil }
+
+// ForceFlush does nothing.
+func (spanProcessor) ForceFlush() {}
@@ -0,0 +1,195 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package config // import "go.opentelemetry.io/otel/sdk/config"
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"strconv"
+	"time"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Config is the configuration of the telemetry of an application.
+type Config struct {
+	// Resource describes the entity producing the telemetry. The
+	// default resource of the SDK is used when it is nil.
+	Resource *Resource `json:"resource"`
+	// Propagators are the names of the propagators known to
+	// go.opentelemetry.io/otel/propagators/autoprop. The propagators
+	// configured by the OTEL_PROPAGATORS environment variable, or W3C
+	// Trace Context and Baggage, are used when it is empty.
+	Propagators []string `json:"propagators"`
+	// TracerProvider configures the TracerProvider. A TracerProvider
+	// without span processors is created when it is nil.
+	TracerProvider *TracerProvider `json:"tracer_provider"`
+	// MeterProvider configures the MeterProvider. No MeterProvider is
+	// created when it is nil.
+	MeterProvider *MeterProvider `json:"meter_provider"`
+}
+
+// Resource describes the entity producing the telemetry.
+type Resource struct {
+	// Attributes are the attributes of the resource. Their values are
+	// strings, booleans or numbers.
+	Attributes map[string]interface{} `json:"attributes"`
+	// Detectors are the names of the resource detectors, as accepted by
+	// resource.DetectorsByName, whose attributes are added to the
+	// resource.
+	Detectors []string `json:"detectors"`
+	// SchemaURL is the schema URL of the resource.
+	SchemaURL string `json:"schema_url"`
+}
+
+// TracerProvider configures a TracerProvider.
+type TracerProvider struct {
+	// Sampler is the default sampler of the TracerProvider. The SDK
+	// default is used when it is nil.
+	Sampler *Sampler `json:"sampler"`
+	// Limits are the span limits of the TracerProvider.
+	Limits *SpanLimits `json:"limits"`
+	// Processors are the span processors of the TracerProvider, in the
+	// order they are registered.
+	Processors []SpanProcessor `json:"processors"`
+}
+
+// Sampler configures a sampler.
+type Sampler struct {
+	// Type is the kind of sampler, with the same names as the
+	// OTEL_TRACES_SAMPLER environment variable: always_on, always_off,
+	// traceidratio, parentbased_always_on, parentbased_always_off or
+	// parentbased_traceidratio.
+	Type string `json:"type"`
+	// Ratio is the sampling ratio of the traceidratio samplers. It
+	// defaults to 1.
+	Ratio *float64 `json:"ratio"`
+}
+
+// SpanLimits configures the limits of the spans. The SDK defaults are
+// used for the zero limits.
+type SpanLimits struct {
+	AttributeCount int `json:"attribute_count"`
+	EventCount     int `json:"event_count"`
+	LinkCount      int `json:"link_count"`
+}
+
+// SpanProcessor configures a span processor.
+type SpanProcessor struct {
+	// Type is the kind of span processor: batch or simple.
+	Type string `json:"type"`
+	// Exporter is the exporter the spans are sent to.
+	Exporter Exporter `json:"exporter"`
+
+	// The options of the batch span processors. The SDK defaults are
+	// used for the zero values.
+	ScheduleDelay      Duration `json:"schedule_delay"`
+	ExportTimeout      Duration `json:"export_timeout"`
+	MaxQueueSize       int      `json:"max_queue_size"`
+	MaxExportBatchSize int      `json:"max_export_batch_size"`
+}
+
+// MeterProvider configures a MeterProvider exporting its metrics
+// periodically.
+type MeterProvider struct {
+	// Exporter is the exporter the metrics are sent to.
+	Exporter Exporter `json:"exporter"`
+	// ExportInterval is the interval between two exports. The SDK
+	// default is used when it is zero.
+	ExportInterval Duration `json:"export_interval"`
+	// ExportTimeout is the timeout of the exports. The SDK default is
+	// used when it is zero.
+	ExportTimeout Duration `json:"export_timeout"`
+}
+
+// Exporter configures an exporter.
+type Exporter struct {
+	// Type is the kind of exporter: otlp or stdout.
+	Type string `json:"type"`
+
+	// Protocol is the protocol of the otlp exporter: grpc, the
+	// default, or http/protobuf.
+	Protocol string `json:"protocol"`
+	// Endpoint is the address of the collector of the otlp exporter.
+	// The default of the protocol is used when it is empty.
+	Endpoint string `json:"endpoint"`
+	// Insecure disables the transport security of the otlp exporter.
+	Insecure bool `json:"insecure"`
+	// Headers are sent with the requests of the otlp exporter.
+	Headers map[string]string `json:"headers"`
+
+	// PrettyPrint indents the output of the stdout exporter.
+	PrettyPrint bool `json:"pretty_print"`
+}
+
+// Duration is a time.Duration read from a Go duration string, like
+// "1m30s", or from a number of milliseconds.
+type Duration time.Duration
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (d *Duration) UnmarshalJSON(data []byte) error {
+	if ms, err := strconv.ParseInt(string(data), 10, 64); err == nil {
+		*d = Duration(time.Duration(ms) * time.Millisecond)
+		return nil
+	}
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return fmt.Errorf("invalid duration %s", data)
+	}
+	v, err := time.ParseDuration(s)
+	if err != nil {
+		return err
+	}
+	*d = Duration(v)
+	return nil
+}
+
+// Parse parses a YAML or JSON configuration. Unknown fields are
+// reported as errors.
+func Parse(data []byte) (*Config, error) {
+	// YAML is a superset of JSON: the document is decoded as YAML and
+	// re-encoded as JSON, so the configuration types only need to
+	// handle JSON.
+	var doc interface{}
+	if err := yaml.Unmarshal(data, &doc); err != nil {
+		return nil, fmt.Errorf("config: %w", err)
+	}
+	j, err := json.Marshal(doc)
+	if err != nil {
+		return nil, fmt.Errorf("config: %w", err)
+	}
+
+	cfg := new(Config)
+	if doc == nil {
+		return cfg, nil
+	}
+	dec := json.NewDecoder(bytes.NewReader(j))
+	dec.DisallowUnknownFields()
+	if err := dec.Decode(cfg); err != nil {
+		return nil, fmt.Errorf("config: %w", err)
+	}
+	return cfg, nil
+}
+
+// Load reads and parses the YAML or JSON configuration file at path.
+func Load(path string) (*Config, error) {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("config: %w", err)
+	}
+	return Parse(data)
+}
@@ -0,0 +1,181 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package config
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.opentelemetry.io/otel/label"
+	"go.opentelemetry.io/otel/propagation"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/trace"
+)
+
+func TestLoad(t *testing.T) {
+	half := 0.5
+	want := &Config{
+		Resource: &Resource{
+			Attributes: map[string]interface{}{
+				"service.name":           "checkout",
+				"service.instance.count": 3.0,
+				"debug":                  true,
+			},
+			Detectors: []string{"telemetry.sdk"},
+		},
+		Propagators: []string{"tracecontext", "b3"},
+		TracerProvider: &TracerProvider{
+			Sampler: &Sampler{Type: "parentbased_traceidratio", Ratio: &half},
+			Limits:  &SpanLimits{AttributeCount: 16},
+			Processors: []SpanProcessor{
+				{
+					Type:          "batch",
+					ScheduleDelay: Duration(2 * time.Second),
+					MaxQueueSize:  100,
+					Exporter:      Exporter{Type: "stdout"},
+				},
+				{
+					Type:     "simple",
+					Exporter: Exporter{Type: "stdout", PrettyPrint: true},
+				},
+			},
+		},
+		MeterProvider: &MeterProvider{
+			ExportInterval: Duration(time.Minute),
+			Exporter:       Exporter{Type: "stdout"},
+		},
+	}
+
+	for _, path := range []string{"testdata/config.yaml", "testdata/config.json"} {
+		t.Run(path, func(t *testing.T) {
+			cfg, err := Load(path)
+			require.NoError(t, err)
+			assert.Equal(t, want, cfg)
+		})
+	}
+
+	_, err := Load("testdata/missing.yaml")
+	assert.Error(t, err)
+}
+
+func TestParseErrors(t *testing.T) {
+	for _, doc := range []string{
+		"resource: [",
+		"tracer_provider: {processors: [{type: batch, unknown: 1}]}",
+		"meter_provider: {export_interval: soon}",
+	} {
+		_, err := Parse([]byte(doc))
+		assert.Error(t, err, doc)
+	}
+
+	cfg, err := Parse(nil)
+	require.NoError(t, err)
+	assert.Equal(t, &Config{}, cfg)
+}
+
+func TestNewSDK(t *testing.T) {
+	ctx := context.Background()
+	cfg, err := Load("testdata/config.yaml")
+	require.NoError(t, err)
+
+	sdk, err := cfg.NewSDK(ctx)
+	require.NoError(t, err)
+	defer func() { assert.NoError(t, sdk.Shutdown(ctx)) }()
+
+	assert.Contains(t, sdk.Resource.Attributes(), label.String("service.name", "checkout"))
+	assert.Contains(t, sdk.Resource.Attributes(), label.Int64("service.instance.count", 3))
+	assert.Contains(t, sdk.Resource.Attributes(), label.Bool("debug", true))
+	assert.Contains(t, sdk.Resource.Attributes(), label.String("telemetry.sdk.language", "go"))
+
+	assert.ElementsMatch(t,
+		[]string{"traceparent", "tracestate", "b3"},
+		sdk.Propagator.Fields(),
+	)
+	require.NotNil(t, sdk.Controller)
+
+	// A sampled remote parent is followed by the parentbased sampler.
+	sc := trace.SpanContext{TraceID: trace.TraceID{1}, SpanID: trace.SpanID{1}, TraceFlags: trace.FlagsSampled}
+	_, span := sdk.TracerProvider.Tracer("test").Start(trace.ContextWithRemoteSpanContext(ctx, sc), "span")
+	assert.True(t, span.IsRecording())
+	span.End()
+}
+
+func TestNewSDKDefaults(t *testing.T) {
+	ctx := context.Background()
+	sdk, err := new(Config).NewSDK(ctx)
+	require.NoError(t, err)
+	defer func() { assert.NoError(t, sdk.Shutdown(ctx)) }()
+
+	assert.Nil(t, sdk.Controller)
+	assert.NotNil(t, sdk.MeterProvider())
+	assert.ElementsMatch(t,
+		propagation.NewCompositeTextMapPropagator(propagation.TraceContext{}, propagation.Baggage{}).Fields(),
+		sdk.Propagator.Fields(),
+	)
+}
+
+func TestNewSDKErrors(t *testing.T) {
+	for _, test := range []struct {
+		name string
+		cfg  Config
+		err  error
+	}{
+		{
+			name: "sampler",
+			cfg:  Config{TracerProvider: &TracerProvider{Sampler: &Sampler{Type: "sometimes"}}},
+			err:  errUnknownSampler,
+		},
+		{
+			name: "processor",
+			cfg: Config{TracerProvider: &TracerProvider{Processors: []SpanProcessor{
+				{Type: "simple", Exporter: Exporter{Type: "stdout"}},
+				{Type: "eager", Exporter: Exporter{Type: "stdout"}},
+			}}},
+			err: errUnknownProcessor,
+		},
+		{
+			name: "exporter",
+			cfg:  Config{MeterProvider: &MeterProvider{Exporter: Exporter{Type: "zipkin"}}},
+			err:  errUnknownExporter,
+		},
+		{
+			name: "protocol",
+			cfg:  Config{MeterProvider: &MeterProvider{Exporter: Exporter{Type: "otlp", Protocol: "http/json"}}},
+			err:  errUnknownProtocol,
+		},
+		{
+			name: "attribute",
+			cfg:  Config{Resource: &Resource{Attributes: map[string]interface{}{"list": []interface{}{1}}}},
+			err:  errInvalidAttribute,
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			_, err := test.cfg.NewSDK(context.Background())
+			assert.True(t, errors.Is(err, test.err), "%v", err)
+		})
+	}
+}
+
+func TestSampler(t *testing.T) {
+	quarter := 0.25
+	s, err := (&Sampler{Type: "TraceIDRatio", Ratio: &quarter}).newSampler()
+	require.NoError(t, err)
+	assert.Equal(t, sdktrace.TraceIDRatioBased(0.25), s)
+}
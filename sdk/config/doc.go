@@ -0,0 +1,61 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+/*
+Package config builds the TracerProvider, the MeterProvider and the
+propagators of an application from a YAML or JSON configuration file, so
+the telemetry setup of services can be standardized without code.
+
+A configuration looks like:
+
+	resource:
+	  attributes:
+	    service.name: checkout
+	    service.version: 1.2.0
+	  detectors: [env, host, process]
+	propagators: [tracecontext, baggage]
+	tracer_provider:
+	  sampler:
+	    type: parentbased_traceidratio
+	    ratio: 0.1
+	  limits:
+	    attribute_count: 128
+	  processors:
+	    - type: batch
+	      schedule_delay: 5s
+	      exporter:
+	        type: otlp
+	        endpoint: collector:4317
+	        insecure: true
+	meter_provider:
+	  export_interval: 30s
+	  exporter:
+	    type: otlp
+	    protocol: http/protobuf
+	    endpoint: collector:4318
+
+and is used as:
+
+	cfg, err := config.Load("otel.yaml")
+	if err != nil {
+		log.Fatal(err)
+	}
+	sdk, err := cfg.NewSDK(ctx)
+	if err != nil {
+		log.Fatal(err)
+	}
+	defer sdk.Shutdown(ctx)
+	sdk.Install()
+*/
+package config // import "go.opentelemetry.io/otel/sdk/config"
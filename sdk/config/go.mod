@@ -0,0 +1,19 @@
+module go.opentelemetry.io/otel/sdk/config
+
+go 1.14
+
+replace (
+	go.opentelemetry.io/otel => ../..
+	go.opentelemetry.io/otel/exporters/otlp => ../../exporters/otlp
+	go.opentelemetry.io/otel/exporters/stdout => ../../exporters/stdout
+	go.opentelemetry.io/otel/sdk => ../
+)
+
+require (
+	github.com/stretchr/testify v1.7.0
+	go.opentelemetry.io/otel v0.16.0
+	go.opentelemetry.io/otel/exporters/otlp v0.16.0
+	go.opentelemetry.io/otel/exporters/stdout v0.16.0
+	go.opentelemetry.io/otel/sdk v0.16.0
+	gopkg.in/yaml.v3 v3.0.1
+)
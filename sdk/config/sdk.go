@@ -0,0 +1,337 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package config // import "go.opentelemetry.io/otel/sdk/config"
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+	"time"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/exporters/otlp"
+	"go.opentelemetry.io/otel/exporters/otlp/otlpgrpc"
+	"go.opentelemetry.io/otel/exporters/otlp/otlphttp"
+	"go.opentelemetry.io/otel/exporters/stdout"
+	"go.opentelemetry.io/otel/label"
+	"go.opentelemetry.io/otel/metric"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/propagators/autoprop"
+	exportmetric "go.opentelemetry.io/otel/sdk/export/metric"
+	exporttrace "go.opentelemetry.io/otel/sdk/export/trace"
+	controller "go.opentelemetry.io/otel/sdk/metric/controller/basic"
+	processor "go.opentelemetry.io/otel/sdk/metric/processor/basic"
+	"go.opentelemetry.io/otel/sdk/metric/selector/simple"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+)
+
+var (
+	errUnknownSampler   = errors.New("unknown sampler")
+	errUnknownProcessor = errors.New("unknown span processor")
+	errUnknownExporter  = errors.New("unknown exporter")
+	errUnknownProtocol  = errors.New("unknown otlp protocol")
+	errInvalidAttribute = errors.New("invalid resource attribute")
+)
+
+// SDK holds the providers and propagator built from a Config.
+type SDK struct {
+	// Resource is the resource of the providers.
+	Resource *resource.Resource
+	// TracerProvider is the configured TracerProvider.
+	TracerProvider *sdktrace.TracerProvider
+	// Controller is the controller of the configured MeterProvider. It
+	// is nil if no MeterProvider is configured.
+	Controller *controller.Controller
+	// Propagator is the configured propagator.
+	Propagator propagation.TextMapPropagator
+
+	// metricExporter is the exporter of the Controller, shut down with
+	// it.
+	metricExporter exporter
+}
+
+// exporter is implemented by the exporters of the configuration.
+type exporter interface {
+	exporttrace.SpanExporter
+	exportmetric.Exporter
+}
+
+// NewSDK builds the providers and propagator of c. The Controller of the
+// MeterProvider is started.
+func (c *Config) NewSDK(ctx context.Context) (*SDK, error) {
+	sdk := new(SDK)
+
+	var err error
+	if sdk.Resource, err = c.newResource(ctx); err != nil {
+		return nil, err
+	}
+
+	if len(c.Propagators) == 0 {
+		sdk.Propagator = autoprop.NewTextMapPropagator()
+	} else if sdk.Propagator, err = autoprop.TextMapPropagator(c.Propagators...); err != nil {
+		return nil, fmt.Errorf("config: propagators: %w", err)
+	}
+
+	tpCfg := c.TracerProvider
+	if tpCfg == nil {
+		tpCfg = &TracerProvider{}
+	}
+	if sdk.TracerProvider, err = tpCfg.newTracerProvider(ctx, sdk.Resource); err != nil {
+		return nil, err
+	}
+
+	if mp := c.MeterProvider; mp != nil {
+		if sdk.metricExporter, err = mp.Exporter.newExporter(ctx); err != nil {
+			_ = sdk.TracerProvider.Shutdown(ctx)
+			return nil, fmt.Errorf("config: meter_provider: %w", err)
+		}
+		opts := []controller.Option{
+			controller.WithResource(sdk.Resource),
+			controller.WithPusher(sdk.metricExporter),
+		}
+		if mp.ExportInterval > 0 {
+			opts = append(opts, controller.WithCollectPeriod(time.Duration(mp.ExportInterval)))
+		}
+		if mp.ExportTimeout > 0 {
+			opts = append(opts, controller.WithPushTimeout(time.Duration(mp.ExportTimeout)))
+		}
+		sdk.Controller = controller.New(
+			processor.New(simple.NewWithInexpensiveDistribution(), sdk.metricExporter),
+			opts...,
+		)
+		if err := sdk.Controller.Start(ctx); err != nil {
+			_ = sdk.Shutdown(ctx)
+			return nil, fmt.Errorf("config: meter_provider: %w", err)
+		}
+	}
+	return sdk, nil
+}
+
+// MeterProvider returns the MeterProvider of the Controller, or a no-op
+// MeterProvider if no MeterProvider is configured.
+func (s *SDK) MeterProvider() metric.MeterProvider {
+	if s.Controller == nil {
+		return metric.NoopMeterProvider{}
+	}
+	return s.Controller.MeterProvider()
+}
+
+// Install sets the providers and propagator of s as the global ones.
+func (s *SDK) Install() {
+	otel.SetTracerProvider(s.TracerProvider)
+	otel.SetMeterProvider(s.MeterProvider())
+	otel.SetTextMapPropagator(s.Propagator)
+}
+
+// Shutdown stops the Controller, exporting the last metrics, and shuts
+// down the TracerProvider and the exporters.
+func (s *SDK) Shutdown(ctx context.Context) error {
+	var errs []string
+	if s.Controller != nil {
+		if err := s.Controller.Stop(ctx); err != nil {
+			errs = append(errs, err.Error())
+		}
+	}
+	if s.metricExporter != nil {
+		if err := s.metricExporter.Shutdown(ctx); err != nil {
+			errs = append(errs, err.Error())
+		}
+	}
+	if err := s.TracerProvider.Shutdown(ctx); err != nil {
+		errs = append(errs, err.Error())
+	}
+	if len(errs) > 0 {
+		return fmt.Errorf("config: shutdown: %s", strings.Join(errs, "; "))
+	}
+	return nil
+}
+
+func (c *Config) newResource(ctx context.Context) (*resource.Resource, error) {
+	if c.Resource == nil {
+		return resource.New(ctx)
+	}
+
+	keys := make([]string, 0, len(c.Resource.Attributes))
+	for k := range c.Resource.Attributes {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	attrs := make([]label.KeyValue, 0, len(keys))
+	for _, k := range keys {
+		switch v := c.Resource.Attributes[k].(type) {
+		case string:
+			attrs = append(attrs, label.String(k, v))
+		case bool:
+			attrs = append(attrs, label.Bool(k, v))
+		case float64:
+			if v == math.Trunc(v) && math.Abs(v) < 1<<53 {
+				attrs = append(attrs, label.Int64(k, int64(v)))
+			} else {
+				attrs = append(attrs, label.Float64(k, v))
+			}
+		default:
+			return nil, fmt.Errorf("config: resource: %w: %q has a %T value", errInvalidAttribute, k, v)
+		}
+	}
+
+	detectors, err := resource.DetectorsByName(c.Resource.Detectors...)
+	if err != nil {
+		return nil, fmt.Errorf("config: resource: %w", err)
+	}
+	opts := []resource.Option{
+		resource.WithDetectors(detectors...),
+		resource.WithAttributes(attrs...),
+	}
+	if c.Resource.SchemaURL != "" {
+		opts = append(opts, resource.WithSchemaURL(c.Resource.SchemaURL))
+	}
+	return resource.New(ctx, opts...)
+}
+
+func (c *TracerProvider) newTracerProvider(ctx context.Context, res *resource.Resource) (*sdktrace.TracerProvider, error) {
+	cfg := sdktrace.Config{Resource: res}
+	if c.Sampler != nil {
+		s, err := c.Sampler.newSampler()
+		if err != nil {
+			return nil, err
+		}
+		cfg.DefaultSampler = s
+	}
+	if l := c.Limits; l != nil {
+		cfg.MaxAttributesPerSpan = l.AttributeCount
+		cfg.MaxEventsPerSpan = l.EventCount
+		cfg.MaxLinksPerSpan = l.LinkCount
+	}
+
+	opts := []sdktrace.TracerProviderOption{sdktrace.WithConfig(cfg)}
+	var processors []sdktrace.SpanProcessor
+	for i, p := range c.Processors {
+		sp, err := p.newSpanProcessor(ctx)
+		if err != nil {
+			for _, sp := range processors {
+				_ = sp.Shutdown(ctx)
+			}
+			return nil, fmt.Errorf("config: tracer_provider: processors[%d]: %w", i, err)
+		}
+		processors = append(processors, sp)
+		opts = append(opts, sdktrace.WithSpanProcessor(sp))
+	}
+	return sdktrace.NewTracerProvider(opts...), nil
+}
+
+func (c *Sampler) newSampler() (sdktrace.Sampler, error) {
+	ratio := 1.0
+	if c.Ratio != nil {
+		ratio = *c.Ratio
+	}
+	switch strings.ToLower(c.Type) {
+	case "always_on":
+		return sdktrace.AlwaysSample(), nil
+	case "always_off":
+		return sdktrace.NeverSample(), nil
+	case "traceidratio":
+		return sdktrace.TraceIDRatioBased(ratio), nil
+	case "parentbased_always_on":
+		return sdktrace.ParentBased(sdktrace.AlwaysSample()), nil
+	case "parentbased_always_off":
+		return sdktrace.ParentBased(sdktrace.NeverSample()), nil
+	case "parentbased_traceidratio":
+		return sdktrace.ParentBased(sdktrace.TraceIDRatioBased(ratio)), nil
+	}
+	return nil, fmt.Errorf("config: tracer_provider: %w: %q", errUnknownSampler, c.Type)
+}
+
+func (c *SpanProcessor) newSpanProcessor(ctx context.Context) (sdktrace.SpanProcessor, error) {
+	switch strings.ToLower(c.Type) {
+	case "batch", "simple":
+	default:
+		return nil, fmt.Errorf("%w: %q", errUnknownProcessor, c.Type)
+	}
+
+	e, err := c.Exporter.newExporter(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if strings.ToLower(c.Type) == "simple" {
+		return sdktrace.NewSimpleSpanProcessor(e), nil
+	}
+
+	var opts []sdktrace.BatchSpanProcessorOption
+	if c.ScheduleDelay > 0 {
+		opts = append(opts, sdktrace.WithBatchTimeout(time.Duration(c.ScheduleDelay)))
+	}
+	if c.ExportTimeout > 0 {
+		opts = append(opts, sdktrace.WithExportTimeout(time.Duration(c.ExportTimeout)))
+	}
+	if c.MaxQueueSize > 0 {
+		opts = append(opts, sdktrace.WithMaxQueueSize(c.MaxQueueSize))
+	}
+	if c.MaxExportBatchSize > 0 {
+		opts = append(opts, sdktrace.WithMaxExportBatchSize(c.MaxExportBatchSize))
+	}
+	return sdktrace.NewBatchSpanProcessor(e, opts...), nil
+}
+
+func (c *Exporter) newExporter(ctx context.Context) (exporter, error) {
+	switch strings.ToLower(c.Type) {
+	case "stdout":
+		var opts []stdout.Option
+		if c.PrettyPrint {
+			opts = append(opts, stdout.WithPrettyPrint())
+		}
+		return stdout.NewExporter(opts...)
+	case "otlp":
+		driver, err := c.newDriver()
+		if err != nil {
+			return nil, err
+		}
+		return otlp.NewExporter(ctx, driver)
+	}
+	return nil, fmt.Errorf("%w: %q", errUnknownExporter, c.Type)
+}
+
+func (c *Exporter) newDriver() (otlp.ProtocolDriver, error) {
+	switch strings.ToLower(c.Protocol) {
+	case "", "grpc":
+		var opts []otlpgrpc.Option
+		if c.Endpoint != "" {
+			opts = append(opts, otlpgrpc.WithEndpoint(c.Endpoint))
+		}
+		if c.Insecure {
+			opts = append(opts, otlpgrpc.WithInsecure())
+		}
+		if len(c.Headers) > 0 {
+			opts = append(opts, otlpgrpc.WithHeaders(c.Headers))
+		}
+		return otlpgrpc.NewDriver(opts...), nil
+	case "http/protobuf":
+		var opts []otlphttp.Option
+		if c.Endpoint != "" {
+			opts = append(opts, otlphttp.WithEndpoint(c.Endpoint))
+		}
+		if c.Insecure {
+			opts = append(opts, otlphttp.WithInsecure())
+		}
+		if len(c.Headers) > 0 {
+			opts = append(opts, otlphttp.WithHeaders(c.Headers))
+		}
+		return otlphttp.NewDriver(opts...), nil
+	}
+	return nil, fmt.Errorf("%w: %q", errUnknownProtocol, c.Protocol)
+}
@@ -0,0 +1,77 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package log // import "go.opentelemetry.io/otel/sdk/export/log"
+
+import (
+	"context"
+	"time"
+
+	"go.opentelemetry.io/otel/label"
+	"go.opentelemetry.io/otel/log"
+	"go.opentelemetry.io/otel/trace"
+
+	"go.opentelemetry.io/otel/sdk/instrumentation"
+	"go.opentelemetry.io/otel/sdk/resource"
+)
+
+// Exporter handles the delivery of Records to external receivers. This is
+// the final component in the log export pipeline.
+type Exporter interface {
+	// ExportLogs exports a batch of Records.
+	//
+	// This function is called synchronously, so there is no concurrency
+	// safety requirement. However, due to the synchronous calling pattern,
+	// it is critical that all timeouts and cancellations contained in the
+	// passed context must be honored.
+	//
+	// Any retry logic must be contained in this function. The SDK that
+	// calls this function will not implement any retry logic. All errors
+	// returned by this function are considered unrecoverable and will be
+	// reported to a configured error Handler.
+	ExportLogs(ctx context.Context, records []*Record) error
+	// Shutdown notifies the exporter of a pending halt to operations. The
+	// exporter is expected to preform any cleanup or synchronization it
+	// requires while honoring all timeouts and cancellations contained in
+	// the passed context.
+	Shutdown(ctx context.Context) error
+}
+
+// Record is a log record emitted with the SDK, along with the context it
+// was emitted in. Records should be treated as immutable.
+type Record struct {
+	// Timestamp is the time of the event the record describes.
+	Timestamp time.Time
+	// ObservedTimestamp is the time the record was emitted at.
+	ObservedTimestamp time.Time
+	Severity          log.Severity
+	SeverityText      string
+	Body              label.Value
+	Attributes        []label.KeyValue
+	// DroppedAttributeCount is the number of attributes dropped from
+	// the record because of the limits of the SDK.
+	DroppedAttributeCount int
+
+	// SpanContext is the span context of the context the record was
+	// emitted with. It is empty if there was no span in the context.
+	SpanContext trace.SpanContext
+
+	// Resource contains attributes representing an entity that produced
+	// this record.
+	Resource *resource.Resource
+
+	// InstrumentationLibrary defines the instrumentation library used to
+	// emit the record.
+	InstrumentationLibrary instrumentation.Library
+}
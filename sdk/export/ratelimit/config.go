@@ -0,0 +1,128 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ratelimit // import "go.opentelemetry.io/otel/sdk/export/ratelimit"
+
+import (
+	"time"
+
+	export "go.opentelemetry.io/otel/sdk/export/metric"
+	exporttrace "go.opentelemetry.io/otel/sdk/export/trace"
+)
+
+// DefaultBurstDuration is the period whose worth of the configured
+// rates can be exported at once if no other duration is configured.
+const DefaultBurstDuration = time.Second
+
+// SpanSizer estimates the encoded size in bytes of a span.
+type SpanSizer func(*exporttrace.SpanSnapshot) int
+
+// RecordSizer estimates the encoded size in bytes of a metric record.
+type RecordSizer func(export.Record) int
+
+type config struct {
+	itemsPerSecond float64
+	bytesPerSecond float64
+	burstDuration  time.Duration
+	spanSizer      SpanSizer
+	recordSizer    RecordSizer
+}
+
+func newConfig(opts []Option) config {
+	cfg := config{
+		burstDuration: DefaultBurstDuration,
+		spanSizer:     EstimateSpanSize,
+		recordSizer:   EstimateRecordSize,
+	}
+	for _, opt := range opts {
+		opt.Apply(&cfg)
+	}
+	if cfg.burstDuration <= 0 {
+		cfg.burstDuration = DefaultBurstDuration
+	}
+	return cfg
+}
+
+// Option configures a rate limiting exporter.
+type Option interface {
+	// Apply sets the Option value of a config.
+	Apply(*config)
+}
+
+type itemsPerSecondOption float64
+
+func (o itemsPerSecondOption) Apply(cfg *config) {
+	cfg.itemsPerSecond = float64(o)
+}
+
+// WithMaxItemsPerSecond limits the number of spans or metric records
+// exported per second. A rate that is not positive means no limit,
+// which is the default.
+func WithMaxItemsPerSecond(rate float64) Option {
+	return itemsPerSecondOption(rate)
+}
+
+type bytesPerSecondOption float64
+
+func (o bytesPerSecondOption) Apply(cfg *config) {
+	cfg.bytesPerSecond = float64(o)
+}
+
+// WithMaxBytesPerSecond limits the estimated number of bytes exported
+// per second. A rate that is not positive means no limit, which is
+// the default.
+func WithMaxBytesPerSecond(rate float64) Option {
+	return bytesPerSecondOption(rate)
+}
+
+type burstDurationOption time.Duration
+
+func (o burstDurationOption) Apply(cfg *config) {
+	cfg.burstDuration = time.Duration(o)
+}
+
+// WithBurstDuration sets the period whose worth of the configured
+// rates may be exported at once after the exporter was idle. If
+// unset or not positive, DefaultBurstDuration is used.
+func WithBurstDuration(d time.Duration) Option {
+	return burstDurationOption(d)
+}
+
+type spanSizerOption SpanSizer
+
+func (o spanSizerOption) Apply(cfg *config) {
+	if o != nil {
+		cfg.spanSizer = SpanSizer(o)
+	}
+}
+
+// WithSpanSizer sets the function estimating the size of spans. If
+// unset, EstimateSpanSize is used.
+func WithSpanSizer(sizer SpanSizer) Option {
+	return spanSizerOption(sizer)
+}
+
+type recordSizerOption RecordSizer
+
+func (o recordSizerOption) Apply(cfg *config) {
+	if o != nil {
+		cfg.recordSizer = RecordSizer(o)
+	}
+}
+
+// WithRecordSizer sets the function estimating the size of metric
+// records. If unset, EstimateRecordSize is used.
+func WithRecordSizer(sizer RecordSizer) Option {
+	return recordSizerOption(sizer)
+}
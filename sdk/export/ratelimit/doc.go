@@ -0,0 +1,25 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package ratelimit provides span and metric exporters that wrap
+// another exporter and cap the amount of telemetry passed to it.
+//
+// Both the number of items (spans or metric records) and their
+// estimated encoded size in bytes can be limited per second. The
+// limits are enforced with token buckets that allow bursts of up to
+// the configured burst duration worth of the rate. Items exceeding a
+// limit are dropped rather than delayed, so a constrained network
+// link is never saturated by telemetry; the dropped amount is
+// accounted for in the exporter's Stats.
+package ratelimit // import "go.opentelemetry.io/otel/sdk/export/ratelimit"
@@ -0,0 +1,132 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ratelimit // import "go.opentelemetry.io/otel/sdk/export/ratelimit"
+
+import (
+	"sync"
+	"time"
+)
+
+// Stats holds the amount of telemetry passed to and dropped by a rate
+// limiting exporter since it was created.
+type Stats struct {
+	// ExportedItems is the number of spans or metric records
+	// passed to the wrapped exporter.
+	ExportedItems uint64
+	// ExportedBytes is the estimated size of the exported items.
+	ExportedBytes uint64
+	// DroppedItems is the number of spans or metric records
+	// dropped because a limit was exceeded.
+	DroppedItems uint64
+	// DroppedBytes is the estimated size of the dropped items.
+	DroppedBytes uint64
+}
+
+// bucket is a token bucket refilled at a constant rate up to its
+// capacity. A bucket with a zero rate is unlimited.
+type bucket struct {
+	rate     float64
+	capacity float64
+	tokens   float64
+	last     time.Time
+}
+
+func newBucket(rate float64, burst time.Duration, now time.Time) bucket {
+	if rate <= 0 {
+		return bucket{}
+	}
+	capacity := rate * burst.Seconds()
+	return bucket{
+		rate:     rate,
+		capacity: capacity,
+		tokens:   capacity,
+		last:     now,
+	}
+}
+
+func (b *bucket) unlimited() bool {
+	return b.rate == 0
+}
+
+func (b *bucket) refill(now time.Time) {
+	if b.unlimited() || !now.After(b.last) {
+		return
+	}
+	b.tokens += now.Sub(b.last).Seconds() * b.rate
+	if b.tokens > b.capacity {
+		b.tokens = b.capacity
+	}
+	b.last = now
+}
+
+func (b *bucket) has(n float64) bool {
+	return b.unlimited() || b.tokens >= n
+}
+
+func (b *bucket) take(n float64) {
+	if !b.unlimited() {
+		b.tokens -= n
+	}
+}
+
+// limiter admits items as long as both its item and byte buckets
+// hold enough tokens, and accounts for admitted and dropped items.
+type limiter struct {
+	mu    sync.Mutex
+	items bucket
+	bytes bucket
+	stats Stats
+
+	// now is replaced in tests.
+	now func() time.Time
+}
+
+func newLimiter(cfg config, now func() time.Time) *limiter {
+	t := now()
+	return &limiter{
+		items: newBucket(cfg.itemsPerSecond, cfg.burstDuration, t),
+		bytes: newBucket(cfg.bytesPerSecond, cfg.burstDuration, t),
+		now:   now,
+	}
+}
+
+// admit reports whether an item of the given size may be exported.
+// The caller must export exactly the admitted items.
+func (l *limiter) admit(size int) bool {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	now := l.now()
+	l.items.refill(now)
+	l.bytes.refill(now)
+
+	n := float64(size)
+	if !l.items.has(1) || !l.bytes.has(n) {
+		l.stats.DroppedItems++
+		l.stats.DroppedBytes += uint64(size)
+		return false
+	}
+	l.items.take(1)
+	l.bytes.take(n)
+	l.stats.ExportedItems++
+	l.stats.ExportedBytes += uint64(size)
+	return true
+}
+
+func (l *limiter) Stats() Stats {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return l.stats
+}
@@ -0,0 +1,118 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ratelimit // import "go.opentelemetry.io/otel/sdk/export/ratelimit"
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"go.opentelemetry.io/otel/label"
+	"go.opentelemetry.io/otel/metric"
+	export "go.opentelemetry.io/otel/sdk/export/metric"
+	"go.opentelemetry.io/otel/sdk/export/metric/aggregation"
+)
+
+// MetricExporter is a metric Exporter that passes records to another
+// exporter as long as the configured rate limits are not exceeded.
+type MetricExporter struct {
+	exporter export.Exporter
+	sizer    RecordSizer
+	limiter  *limiter
+}
+
+var _ export.Exporter = (*MetricExporter)(nil)
+
+// NewMetricExporter returns a MetricExporter limiting the records
+// passed to exporter.
+func NewMetricExporter(exporter export.Exporter, opts ...Option) *MetricExporter {
+	cfg := newConfig(opts)
+	return &MetricExporter{
+		exporter: exporter,
+		sizer:    cfg.recordSizer,
+		limiter:  newLimiter(cfg, time.Now),
+	}
+}
+
+// Export passes a CheckpointSet to the wrapped exporter that skips
+// the records exceeding the rate limits. Each record is admitted at most
+// once per Export, however many times the wrapped exporter iterates over
+// the CheckpointSet.
+func (e *MetricExporter) Export(ctx context.Context, checkpointSet export.CheckpointSet) error {
+	return e.exporter.Export(ctx, &limitedCheckpointSet{
+		CheckpointSet: checkpointSet,
+		exporter:      e,
+		admitted:      make(map[recordKey]bool),
+	})
+}
+
+// ExportKindFor implements export.ExportKindSelector by deferring to
+// the wrapped exporter.
+func (e *MetricExporter) ExportKindFor(desc *metric.Descriptor, kind aggregation.Kind) export.ExportKind {
+	return e.exporter.ExportKindFor(desc, kind)
+}
+
+// Stats returns the amount of records exported and dropped so far.
+func (e *MetricExporter) Stats() Stats {
+	return e.limiter.Stats()
+}
+
+// recordKey identifies a record of a CheckpointSet.
+type recordKey struct {
+	descriptor *metric.Descriptor
+	labels     label.Distinct
+	resource   label.Distinct
+}
+
+// limitedCheckpointSet filters the records of a CheckpointSet through
+// the limiter of an exporter.
+type limitedCheckpointSet struct {
+	export.CheckpointSet
+	exporter *MetricExporter
+
+	// admitted holds the decision of the limiter for the records
+	// already iterated over.
+	admittedMu sync.Mutex
+	admitted   map[recordKey]bool
+}
+
+// ForEach implements export.CheckpointSet.
+func (c *limitedCheckpointSet) ForEach(kindSelector export.ExportKindSelector, recordFunc func(export.Record) error) error {
+	return c.CheckpointSet.ForEach(kindSelector, func(r export.Record) error {
+		if !c.admit(r) {
+			return nil
+		}
+		return recordFunc(r)
+	})
+}
+
+// admit returns whether r is admitted by the limiter, consulting it
+// only the first time r is seen.
+func (c *limitedCheckpointSet) admit(r export.Record) bool {
+	key := recordKey{
+		descriptor: r.Descriptor(),
+		labels:     r.Labels().Equivalent(),
+		resource:   r.Resource().Equivalent(),
+	}
+
+	c.admittedMu.Lock()
+	defer c.admittedMu.Unlock()
+	ok, seen := c.admitted[key]
+	if !seen {
+		ok = c.exporter.limiter.admit(c.exporter.sizer(r))
+		c.admitted[key] = ok
+	}
+	return ok
+}
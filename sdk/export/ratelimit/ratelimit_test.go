@@ -0,0 +1,198 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ratelimit
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.opentelemetry.io/otel/label"
+	"go.opentelemetry.io/otel/metric"
+	"go.opentelemetry.io/otel/metric/number"
+	export "go.opentelemetry.io/otel/sdk/export/metric"
+	"go.opentelemetry.io/otel/sdk/export/metric/aggregation"
+	"go.opentelemetry.io/otel/sdk/export/metric/metrictest"
+	exporttrace "go.opentelemetry.io/otel/sdk/export/trace"
+	"go.opentelemetry.io/otel/sdk/export/trace/tracetest"
+	"go.opentelemetry.io/otel/sdk/metric/aggregator/sum"
+	"go.opentelemetry.io/otel/sdk/resource"
+)
+
+type fakeClock struct {
+	now time.Time
+}
+
+func (c *fakeClock) Now() time.Time {
+	return c.now
+}
+
+func (c *fakeClock) Add(d time.Duration) {
+	c.now = c.now.Add(d)
+}
+
+func newFakeClock() *fakeClock {
+	return &fakeClock{now: time.Date(2021, time.January, 1, 0, 0, 0, 0, time.UTC)}
+}
+
+func spans(n int) []*exporttrace.SpanSnapshot {
+	ss := make([]*exporttrace.SpanSnapshot, n)
+	for i := range ss {
+		ss[i] = &exporttrace.SpanSnapshot{Name: "span"}
+	}
+	return ss
+}
+
+func TestSpanExporterItemRate(t *testing.T) {
+	clock := newFakeClock()
+	inner := tracetest.NewInMemoryExporter()
+	cfg := newConfig([]Option{WithMaxItemsPerSecond(10)})
+	exp := &SpanExporter{exporter: inner, sizer: cfg.spanSizer, limiter: newLimiter(cfg, clock.Now)}
+	ctx := context.Background()
+
+	require.NoError(t, exp.ExportSpans(ctx, spans(15)))
+	assert.Len(t, inner.GetSpans(), 10)
+	assert.Equal(t, uint64(10), exp.Stats().ExportedItems)
+	assert.Equal(t, uint64(5), exp.Stats().DroppedItems)
+
+	// The bucket is empty, so nothing is passed to the wrapped
+	// exporter until it is refilled.
+	require.NoError(t, exp.ExportSpans(ctx, spans(1)))
+	assert.Len(t, inner.GetSpans(), 10)
+
+	clock.Add(300 * time.Millisecond)
+	require.NoError(t, exp.ExportSpans(ctx, spans(5)))
+	assert.Len(t, inner.GetSpans(), 13)
+
+	// The bucket never holds more than a burst worth of tokens.
+	clock.Add(time.Hour)
+	require.NoError(t, exp.ExportSpans(ctx, spans(20)))
+	assert.Len(t, inner.GetSpans(), 23)
+
+	stats := exp.Stats()
+	assert.Equal(t, uint64(23), stats.ExportedItems)
+	assert.Equal(t, uint64(18), stats.DroppedItems)
+	size := uint64(EstimateSpanSize(spans(1)[0]))
+	assert.Equal(t, 23*size, stats.ExportedBytes)
+	assert.Equal(t, 18*size, stats.DroppedBytes)
+}
+
+func TestSpanExporterByteRate(t *testing.T) {
+	clock := newFakeClock()
+	inner := tracetest.NewInMemoryExporter()
+	cfg := newConfig([]Option{
+		WithMaxBytesPerSecond(100),
+		WithBurstDuration(2 * time.Second),
+		WithSpanSizer(func(s *exporttrace.SpanSnapshot) int { return len(s.Name) }),
+	})
+	exp := &SpanExporter{exporter: inner, sizer: cfg.spanSizer, limiter: newLimiter(cfg, clock.Now)}
+	ctx := context.Background()
+
+	big := &exporttrace.SpanSnapshot{Name: string(make([]byte, 150))}
+	small := &exporttrace.SpanSnapshot{Name: string(make([]byte, 40))}
+	require.NoError(t, exp.ExportSpans(ctx, []*exporttrace.SpanSnapshot{big, big, small}))
+	assert.Equal(t, []*exporttrace.SpanSnapshot{big, small}, inner.GetSpans())
+	assert.Equal(t, Stats{
+		ExportedItems: 2,
+		ExportedBytes: 190,
+		DroppedItems:  1,
+		DroppedBytes:  150,
+	}, exp.Stats())
+}
+
+func TestSpanExporterUnlimited(t *testing.T) {
+	inner := tracetest.NewInMemoryExporter()
+	exp := NewSpanExporter(inner)
+	require.NoError(t, exp.ExportSpans(context.Background(), spans(1000)))
+	assert.Len(t, inner.GetSpans(), 1000)
+	assert.Zero(t, exp.Stats().DroppedItems)
+	assert.NoError(t, exp.Shutdown(context.Background()))
+}
+
+type recordingExporter struct {
+	names []string
+}
+
+func (e *recordingExporter) Export(_ context.Context, cps export.CheckpointSet) error {
+	return cps.ForEach(e, func(r export.Record) error {
+		e.names = append(e.names, r.Descriptor().Name())
+		return nil
+	})
+}
+
+func (e *recordingExporter) ExportKindFor(*metric.Descriptor, aggregation.Kind) export.ExportKind {
+	return export.DeltaExportKind
+}
+
+func TestMetricExporter(t *testing.T) {
+	clock := newFakeClock()
+	inner := &recordingExporter{}
+	cfg := newConfig([]Option{WithMaxItemsPerSecond(2)})
+	exp := &MetricExporter{exporter: inner, sizer: cfg.recordSizer, limiter: newLimiter(cfg, clock.Now)}
+
+	cps := metrictest.NewCheckpointSet(resource.Empty())
+	for _, name := range []string{"a", "b", "c"} {
+		desc := metric.NewDescriptor(name, metric.CounterInstrumentKind, number.Int64Kind)
+		agg := sum.New(1)
+		require.NoError(t, agg[0].Update(context.Background(), number.NewInt64Number(1), &desc))
+		cps.Add(&desc, &agg[0], label.String("k", "v"))
+	}
+
+	require.NoError(t, exp.Export(context.Background(), cps))
+	assert.Equal(t, []string{"a", "b"}, inner.names)
+	assert.Equal(t, uint64(1), exp.Stats().DroppedItems)
+	assert.Equal(t, export.DeltaExportKind, exp.ExportKindFor(nil, aggregation.SumKind))
+}
+
+// twoPassExporter iterates over the CheckpointSet twice, counting the
+// records before recording them.
+type twoPassExporter struct {
+	recordingExporter
+	count int
+}
+
+func (e *twoPassExporter) Export(ctx context.Context, cps export.CheckpointSet) error {
+	if err := cps.ForEach(e, func(export.Record) error {
+		e.count++
+		return nil
+	}); err != nil {
+		return err
+	}
+	return e.recordingExporter.Export(ctx, cps)
+}
+
+func TestMetricExporterForEachTwice(t *testing.T) {
+	clock := newFakeClock()
+	inner := &twoPassExporter{}
+	cfg := newConfig([]Option{WithMaxItemsPerSecond(2)})
+	exp := &MetricExporter{exporter: inner, sizer: cfg.recordSizer, limiter: newLimiter(cfg, clock.Now)}
+
+	cps := metrictest.NewCheckpointSet(resource.Empty())
+	for _, name := range []string{"a", "b", "c"} {
+		desc := metric.NewDescriptor(name, metric.CounterInstrumentKind, number.Int64Kind)
+		agg := sum.New(1)
+		require.NoError(t, agg[0].Update(context.Background(), number.NewInt64Number(1), &desc))
+		cps.Add(&desc, &agg[0], label.String("k", "v"))
+	}
+
+	require.NoError(t, exp.Export(context.Background(), cps))
+	assert.Equal(t, 2, inner.count)
+	assert.Equal(t, []string{"a", "b"}, inner.names)
+	assert.Equal(t, uint64(2), exp.Stats().ExportedItems)
+	assert.Equal(t, uint64(1), exp.Stats().DroppedItems)
+}
@@ -0,0 +1,91 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ratelimit // import "go.opentelemetry.io/otel/sdk/export/ratelimit"
+
+import (
+	"go.opentelemetry.io/otel/label"
+	export "go.opentelemetry.io/otel/sdk/export/metric"
+	"go.opentelemetry.io/otel/sdk/export/metric/aggregation"
+	exporttrace "go.opentelemetry.io/otel/sdk/export/trace"
+)
+
+const (
+	// spanOverhead approximates the size of the fixed-size fields
+	// of a span: IDs, timestamps, kind and status code.
+	spanOverhead = 64
+	// recordOverhead approximates the size of the fixed-size
+	// fields of a metric data point: timestamps and a value.
+	recordOverhead = 32
+)
+
+// EstimateSpanSize returns a rough estimate of the encoded size of a
+// span: the lengths of its strings and label values plus a constant
+// overhead for its fixed-size fields. The resource and
+// instrumentation library are not included since exporters usually
+// encode them once per batch.
+func EstimateSpanSize(s *exporttrace.SpanSnapshot) int {
+	if s == nil {
+		return 0
+	}
+	size := spanOverhead + len(s.Name) + len(s.StatusMessage) + labelsSize(s.Attributes)
+	for _, e := range s.MessageEvents {
+		size += 8 + len(e.Name) + labelsSize(e.Attributes)
+	}
+	for _, l := range s.Links {
+		size += 24 + labelsSize(l.Attributes)
+	}
+	return size
+}
+
+// EstimateRecordSize returns a rough estimate of the encoded size of
+// a metric record: the lengths of its name and labels plus a constant
+// overhead for every data point it holds.
+func EstimateRecordSize(r export.Record) int {
+	size := recordOverhead + len(r.Descriptor().Name())
+	iter := r.Labels().Iter()
+	for iter.Next() {
+		size += labelSize(iter.Label())
+	}
+	switch agg := r.Aggregation().(type) {
+	case aggregation.Points:
+		if points, err := agg.Points(); err == nil && len(points) > 1 {
+			size += (len(points) - 1) * recordOverhead
+		}
+	case aggregation.Histogram:
+		if buckets, err := agg.Histogram(); err == nil {
+			size += 8 * (len(buckets.Boundaries) + len(buckets.Counts))
+		}
+	}
+	return size
+}
+
+func labelsSize(kvs []label.KeyValue) int {
+	var size int
+	for _, kv := range kvs {
+		size += labelSize(kv)
+	}
+	return size
+}
+
+func labelSize(kv label.KeyValue) int {
+	switch kv.Value.Type() {
+	case label.STRING:
+		return len(kv.Key) + len(kv.Value.AsString())
+	case label.ARRAY:
+		return len(kv.Key) + len(kv.Value.Emit())
+	default:
+		return len(kv.Key) + 8
+	}
+}
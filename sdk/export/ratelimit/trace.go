@@ -0,0 +1,72 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ratelimit // import "go.opentelemetry.io/otel/sdk/export/ratelimit"
+
+import (
+	"context"
+	"time"
+
+	exporttrace "go.opentelemetry.io/otel/sdk/export/trace"
+)
+
+// SpanExporter is a SpanExporter that passes spans to another
+// exporter as long as the configured rate limits are not exceeded.
+type SpanExporter struct {
+	exporter exporttrace.SpanExporter
+	sizer    SpanSizer
+	limiter  *limiter
+}
+
+var _ exporttrace.SpanExporter = (*SpanExporter)(nil)
+
+// NewSpanExporter returns a SpanExporter limiting the spans passed to
+// exporter.
+func NewSpanExporter(exporter exporttrace.SpanExporter, opts ...Option) *SpanExporter {
+	cfg := newConfig(opts)
+	return &SpanExporter{
+		exporter: exporter,
+		sizer:    cfg.spanSizer,
+		limiter:  newLimiter(cfg, time.Now),
+	}
+}
+
+// ExportSpans passes the spans that do not exceed the rate limits to
+// the wrapped exporter and drops the rest. The wrapped exporter is
+// not called if all spans are dropped.
+func (e *SpanExporter) ExportSpans(ctx context.Context, ss []*exporttrace.SpanSnapshot) error {
+	admitted := make([]*exporttrace.SpanSnapshot, 0, len(ss))
+	for _, s := range ss {
+		if s == nil {
+			continue
+		}
+		if e.limiter.admit(e.sizer(s)) {
+			admitted = append(admitted, s)
+		}
+	}
+	if len(admitted) == 0 {
+		return nil
+	}
+	return e.exporter.ExportSpans(ctx, admitted)
+}
+
+// Shutdown shuts down the wrapped exporter.
+func (e *SpanExporter) Shutdown(ctx context.Context) error {
+	return e.exporter.Shutdown(ctx)
+}
+
+// Stats returns the amount of spans exported and dropped so far.
+func (e *SpanExporter) Stats() Stats {
+	return e.limiter.Stats()
+}
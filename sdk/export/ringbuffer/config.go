@@ -0,0 +1,75 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ringbuffer // import "go.opentelemetry.io/otel/sdk/export/ringbuffer"
+
+import (
+	export "go.opentelemetry.io/otel/sdk/export/metric"
+)
+
+// DefaultCapacity is the number of spans or metric records retained
+// if no other capacity is configured.
+const DefaultCapacity = 1024
+
+type config struct {
+	capacity           int
+	exportKindSelector export.ExportKindSelector
+}
+
+func newConfig(opts []Option) config {
+	cfg := config{
+		capacity:           DefaultCapacity,
+		exportKindSelector: export.CumulativeExportKindSelector(),
+	}
+	for _, opt := range opts {
+		opt.Apply(&cfg)
+	}
+	if cfg.capacity <= 0 {
+		cfg.capacity = DefaultCapacity
+	}
+	return cfg
+}
+
+// Option configures a ring buffer exporter.
+type Option interface {
+	// Apply sets the Option value of a config.
+	Apply(*config)
+}
+
+type capacityOption int
+
+func (o capacityOption) Apply(cfg *config) {
+	cfg.capacity = int(o)
+}
+
+// WithCapacity sets the number of spans or metric records retained
+// by the exporter. A capacity that is not positive means
+// DefaultCapacity.
+func WithCapacity(capacity int) Option {
+	return capacityOption(capacity)
+}
+
+type exportKindSelectorOption struct {
+	selector export.ExportKindSelector
+}
+
+func (o exportKindSelectorOption) Apply(cfg *config) {
+	cfg.exportKindSelector = o.selector
+}
+
+// WithExportKindSelector sets the ExportKindSelector of the metric
+// exporter. It defaults to export.CumulativeExportKindSelector().
+func WithExportKindSelector(selector export.ExportKindSelector) Option {
+	return exportKindSelectorOption{selector: selector}
+}
@@ -0,0 +1,23 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package ringbuffer provides span and metric exporters that retain
+// the most recently exported telemetry in memory.
+//
+// The exporters hold up to a fixed number of spans or metric records,
+// overwriting the oldest ones when full, and can be queried by trace
+// ID, name and time range. This makes them suitable both for
+// asserting on telemetry in tests and for serving the latest
+// telemetry from an embedded debugging endpoint.
+package ringbuffer // import "go.opentelemetry.io/otel/sdk/export/ringbuffer"
@@ -0,0 +1,260 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ringbuffer // import "go.opentelemetry.io/otel/sdk/export/ringbuffer"
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	"go.opentelemetry.io/otel/label"
+	"go.opentelemetry.io/otel/metric"
+	"go.opentelemetry.io/otel/metric/number"
+	export "go.opentelemetry.io/otel/sdk/export/metric"
+	"go.opentelemetry.io/otel/sdk/export/metric/aggregation"
+	"go.opentelemetry.io/otel/sdk/resource"
+)
+
+// Record is a copy of an exported metric record. Unlike an
+// export.Record, it remains valid after the export has returned.
+//
+// Only the value fields supported by the aggregation of the record
+// are set.
+type Record struct {
+	Descriptor metric.Descriptor
+	Labels     []label.KeyValue
+	Resource   *resource.Resource
+	StartTime  time.Time
+	EndTime    time.Time
+
+	// Aggregation is the kind of aggregation the values are read
+	// from.
+	Aggregation aggregation.Kind
+
+	Sum       number.Number
+	Count     uint64
+	Min       number.Number
+	Max       number.Number
+	LastValue number.Number
+	Buckets   aggregation.Buckets
+	Points    []aggregation.Point
+}
+
+// newRecord copies the values of the aggregation of r.
+func newRecord(r export.Record) (Record, error) {
+	rec := Record{
+		Descriptor: *r.Descriptor(),
+		Labels:     r.Labels().ToSlice(),
+		Resource:   r.Resource(),
+		StartTime:  r.StartTime(),
+		EndTime:    r.EndTime(),
+	}
+	agg := r.Aggregation()
+	rec.Aggregation = agg.Kind()
+
+	var err error
+	if a, ok := agg.(aggregation.Sum); ok {
+		rec.Sum, err = a.Sum()
+		if !ignoreNoData(&err) {
+			return Record{}, err
+		}
+	}
+	if a, ok := agg.(aggregation.Count); ok {
+		rec.Count, err = a.Count()
+		if !ignoreNoData(&err) {
+			return Record{}, err
+		}
+	}
+	if a, ok := agg.(aggregation.Min); ok {
+		rec.Min, err = a.Min()
+		if !ignoreNoData(&err) {
+			return Record{}, err
+		}
+	}
+	if a, ok := agg.(aggregation.Max); ok {
+		rec.Max, err = a.Max()
+		if !ignoreNoData(&err) {
+			return Record{}, err
+		}
+	}
+	if a, ok := agg.(aggregation.LastValue); ok {
+		rec.LastValue, _, err = a.LastValue()
+		if !ignoreNoData(&err) {
+			return Record{}, err
+		}
+	}
+	if a, ok := agg.(aggregation.Histogram); ok {
+		var buckets aggregation.Buckets
+		buckets, err = a.Histogram()
+		if !ignoreNoData(&err) {
+			return Record{}, err
+		}
+		rec.Buckets = aggregation.Buckets{
+			Boundaries: append([]float64(nil), buckets.Boundaries...),
+			Counts:     append([]uint64(nil), buckets.Counts...),
+		}
+	}
+	if a, ok := agg.(aggregation.Points); ok {
+		var points []aggregation.Point
+		points, err = a.Points()
+		if !ignoreNoData(&err) {
+			return Record{}, err
+		}
+		rec.Points = append([]aggregation.Point(nil), points...)
+	}
+	return rec, nil
+}
+
+// ignoreNoData clears an aggregation.ErrNoData error and reports
+// whether there is no error left.
+func ignoreNoData(err *error) bool {
+	if errors.Is(*err, aggregation.ErrNoData) {
+		*err = nil
+	}
+	return *err == nil
+}
+
+// MetricQuery selects records retained by a MetricExporter. Zero
+// fields match all records.
+type MetricQuery struct {
+	// Name selects the records of the instrument with this name.
+	Name string
+	// Start selects the records whose collection interval ended at
+	// or after this time.
+	Start time.Time
+	// End selects the records whose collection interval started at
+	// or before this time.
+	End time.Time
+	// Limit is the maximum number of records returned. If more
+	// records match, the most recently exported ones are returned.
+	Limit int
+}
+
+func (q MetricQuery) matches(r *Record) bool {
+	switch {
+	case q.Name != "" && r.Descriptor.Name() != q.Name:
+		return false
+	case !q.Start.IsZero() && r.EndTime.Before(q.Start):
+		return false
+	case !q.End.IsZero() && r.StartTime.After(q.End):
+		return false
+	}
+	return true
+}
+
+// MetricExporter is a metric Exporter retaining copies of the most
+// recently exported records in memory.
+type MetricExporter struct {
+	selector export.ExportKindSelector
+
+	mu      sync.RWMutex
+	ring    ring
+	records []Record
+}
+
+var _ export.Exporter = (*MetricExporter)(nil)
+
+// NewMetricExporter returns a MetricExporter retaining up to the
+// configured capacity of records.
+func NewMetricExporter(opts ...Option) *MetricExporter {
+	cfg := newConfig(opts)
+	return &MetricExporter{
+		selector: cfg.exportKindSelector,
+		ring:     newRing(cfg.capacity),
+		records:  make([]Record, cfg.capacity),
+	}
+}
+
+// Export retains copies of the records of the CheckpointSet,
+// overwriting the oldest retained ones when the capacity is exceeded.
+func (e *MetricExporter) Export(_ context.Context, checkpointSet export.CheckpointSet) error {
+	var records []Record
+	err := checkpointSet.ForEach(e, func(r export.Record) error {
+		rec, err := newRecord(r)
+		if err != nil {
+			return err
+		}
+		records = append(records, rec)
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	for _, rec := range records {
+		e.records[e.ring.push()] = rec
+	}
+	return nil
+}
+
+// ExportKindFor implements export.ExportKindSelector.
+func (e *MetricExporter) ExportKindFor(desc *metric.Descriptor, kind aggregation.Kind) export.ExportKind {
+	return e.selector.ExportKindFor(desc, kind)
+}
+
+// Reset drops all retained records.
+func (e *MetricExporter) Reset() {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	for i := range e.records {
+		e.records[i] = Record{}
+	}
+	e.ring.reset()
+}
+
+// Records returns all retained records, oldest first.
+func (e *MetricExporter) Records() []Record {
+	return e.Query(MetricQuery{})
+}
+
+// RecordsByName returns the retained records of the instrument with
+// the name, oldest first.
+func (e *MetricExporter) RecordsByName(name string) []Record {
+	return e.Query(MetricQuery{Name: name})
+}
+
+// RecordsInTimeRange returns the retained records whose collection
+// interval overlaps the time range from start to end, oldest first.
+func (e *MetricExporter) RecordsInTimeRange(start, end time.Time) []Record {
+	return e.Query(MetricQuery{Start: start, End: end})
+}
+
+// Query returns the retained records matching q, oldest first.
+func (e *MetricExporter) Query(q MetricQuery) []Record {
+	e.mu.RLock()
+	defer e.mu.RUnlock()
+
+	var result []Record
+	// Walk from the newest record so a limit keeps the most recent.
+	for i := e.ring.len - 1; i >= 0; i-- {
+		if q.Limit > 0 && len(result) == q.Limit {
+			break
+		}
+		if r := &e.records[e.ring.index(i)]; q.matches(r) {
+			result = append(result, *r)
+		}
+	}
+	reverseRecords(result)
+	return result
+}
+
+func reverseRecords(rs []Record) {
+	for i, j := 0, len(rs)-1; i < j; i, j = i+1, j-1 {
+		rs[i], rs[j] = rs[j], rs[i]
+	}
+}
@@ -0,0 +1,51 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ringbuffer // import "go.opentelemetry.io/otel/sdk/export/ringbuffer"
+
+// ring tracks the positions of the items held in a fixed size ring
+// buffer. The items themselves are stored by its user in a slice of
+// the ring capacity.
+type ring struct {
+	// next is the position the next item is written to.
+	next int
+	// len is the number of items held.
+	len int
+	cap int
+}
+
+func newRing(capacity int) ring {
+	return ring{cap: capacity}
+}
+
+// push returns the position to write a new item to, which holds the
+// oldest item if the ring is full.
+func (r *ring) push() int {
+	i := r.next
+	r.next = (r.next + 1) % r.cap
+	if r.len < r.cap {
+		r.len++
+	}
+	return i
+}
+
+// index returns the position of the i-th oldest item held.
+func (r *ring) index(i int) int {
+	return (r.next - r.len + i + r.cap) % r.cap
+}
+
+// reset forgets all held items.
+func (r *ring) reset() {
+	r.next, r.len = 0, 0
+}
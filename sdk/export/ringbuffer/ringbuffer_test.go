@@ -0,0 +1,187 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ringbuffer
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.opentelemetry.io/otel/label"
+	"go.opentelemetry.io/otel/metric"
+	"go.opentelemetry.io/otel/metric/number"
+	export "go.opentelemetry.io/otel/sdk/export/metric"
+	"go.opentelemetry.io/otel/sdk/export/metric/aggregation"
+	"go.opentelemetry.io/otel/sdk/export/metric/metrictest"
+	exporttrace "go.opentelemetry.io/otel/sdk/export/trace"
+	"go.opentelemetry.io/otel/sdk/metric/aggregator/histogram"
+	"go.opentelemetry.io/otel/sdk/metric/aggregator/lastvalue"
+	"go.opentelemetry.io/otel/sdk/metric/aggregator/sum"
+	"go.opentelemetry.io/otel/sdk/resource"
+	"go.opentelemetry.io/otel/trace"
+)
+
+var epoch = time.Date(2021, time.January, 1, 0, 0, 0, 0, time.UTC)
+
+func span(traceID byte, name string, start int) *exporttrace.SpanSnapshot {
+	return &exporttrace.SpanSnapshot{
+		SpanContext: trace.SpanContext{TraceID: trace.TraceID{traceID}},
+		Name:        name,
+		StartTime:   epoch.Add(time.Duration(start) * time.Second),
+		EndTime:     epoch.Add(time.Duration(start+1) * time.Second),
+	}
+}
+
+func names(ss []*exporttrace.SpanSnapshot) []string {
+	var result []string
+	for _, s := range ss {
+		result = append(result, s.Name)
+	}
+	return result
+}
+
+func TestRing(t *testing.T) {
+	r := newRing(3)
+	var got []int
+	for i := 0; i < 5; i++ {
+		got = append(got, r.push())
+	}
+	assert.Equal(t, []int{0, 1, 2, 0, 1}, got)
+	assert.Equal(t, 3, r.len)
+	// The oldest item is the third one pushed, at position 2.
+	assert.Equal(t, []int{2, 0, 1}, []int{r.index(0), r.index(1), r.index(2)})
+
+	r.reset()
+	assert.Equal(t, 0, r.len)
+	assert.Equal(t, 0, r.push())
+}
+
+func TestSpanExporter(t *testing.T) {
+	exp := NewSpanExporter(WithCapacity(4))
+	ctx := context.Background()
+	require.NoError(t, exp.ExportSpans(ctx, []*exporttrace.SpanSnapshot{
+		span(1, "a", 0),
+		nil,
+		span(1, "b", 1),
+		span(2, "a", 2),
+	}))
+	assert.Equal(t, []string{"a", "b", "a"}, names(exp.Spans()))
+
+	// Overwrite the oldest span.
+	require.NoError(t, exp.ExportSpans(ctx, []*exporttrace.SpanSnapshot{
+		span(2, "c", 3),
+		span(3, "d", 4),
+	}))
+	assert.Equal(t, []string{"b", "a", "c", "d"}, names(exp.Spans()))
+
+	assert.Equal(t, []string{"a", "c"}, names(exp.SpansByTraceID(trace.TraceID{2})))
+	assert.Equal(t, []string{"a"}, names(exp.SpansByName("a")))
+	assert.Equal(t, []string{"a", "c"}, names(exp.SpansInTimeRange(
+		epoch.Add(2500*time.Millisecond),
+		epoch.Add(3500*time.Millisecond),
+	)))
+	assert.Equal(t, []string{"c", "d"}, names(exp.Query(SpanQuery{Limit: 2})))
+	assert.Empty(t, exp.SpansByName("unknown"))
+
+	// The exported spans are copied, the caller may reuse them.
+	ss := []*exporttrace.SpanSnapshot{span(3, "e", 5)}
+	require.NoError(t, exp.ExportSpans(ctx, ss))
+	ss[0].Name = "reused"
+	assert.Equal(t, []string{"a", "c", "d", "e"}, names(exp.Spans()))
+
+	require.NoError(t, exp.Shutdown(ctx))
+	assert.Len(t, exp.Spans(), 4, "spans must be retained after shutdown")
+
+	exp.Reset()
+	assert.Empty(t, exp.Spans())
+}
+
+func TestSpanExporterDefaultCapacity(t *testing.T) {
+	exp := NewSpanExporter(WithCapacity(0))
+	ss := make([]*exporttrace.SpanSnapshot, DefaultCapacity+1)
+	for i := range ss {
+		ss[i] = span(1, fmt.Sprint(i), i)
+	}
+	require.NoError(t, exp.ExportSpans(context.Background(), ss))
+	got := exp.Spans()
+	require.Len(t, got, DefaultCapacity)
+	assert.Equal(t, "1", got[0].Name)
+}
+
+func TestMetricExporter(t *testing.T) {
+	ctx := context.Background()
+	exp := NewMetricExporter(WithCapacity(3))
+	assert.Equal(t, export.CumulativeExportKind, exp.ExportKindFor(nil, aggregation.SumKind))
+
+	counter := metric.NewDescriptor("counter", metric.CounterInstrumentKind, number.Int64Kind)
+	sumAgg := sum.New(1)
+	require.NoError(t, sumAgg[0].Update(ctx, number.NewInt64Number(3), &counter))
+
+	recorder := metric.NewDescriptor("recorder", metric.ValueRecorderInstrumentKind, number.Float64Kind)
+	histAgg := histogram.New(1, &recorder, []float64{1, 10})
+	require.NoError(t, histAgg[0].Update(ctx, number.NewFloat64Number(5), &recorder))
+
+	observer := metric.NewDescriptor("observer", metric.ValueObserverInstrumentKind, number.Int64Kind)
+	lvAgg := lastvalue.New(1)
+
+	res := resource.NewWithAttributes(label.String("service.name", "test"))
+	cps := metrictest.NewCheckpointSet(res)
+	cps.Add(&counter, &sumAgg[0], label.String("k", "v"))
+	cps.Add(&recorder, &histAgg[0])
+	cps.Add(&observer, &lvAgg[0])
+	require.NoError(t, exp.Export(ctx, cps))
+
+	// Records must not change with the aggregators after the export.
+	require.NoError(t, histAgg[0].Update(ctx, number.NewFloat64Number(50), &recorder))
+
+	records := exp.Records()
+	require.Len(t, records, 3)
+
+	assert.Equal(t, "counter", records[0].Descriptor.Name())
+	assert.Equal(t, []label.KeyValue{label.String("k", "v")}, records[0].Labels)
+	assert.Equal(t, res, records[0].Resource)
+	assert.Equal(t, aggregation.SumKind, records[0].Aggregation)
+	assert.Equal(t, number.NewInt64Number(3), records[0].Sum)
+
+	hist := exp.RecordsByName("recorder")
+	require.Len(t, hist, 1)
+	assert.Equal(t, aggregation.HistogramKind, hist[0].Aggregation)
+	assert.Equal(t, uint64(1), hist[0].Count)
+	assert.Equal(t, []uint64{0, 1, 0}, hist[0].Buckets.Counts)
+
+	assert.Equal(t, aggregation.LastValueKind, records[2].Aggregation)
+	assert.Equal(t, number.Number(0), records[2].LastValue)
+
+	// Overwrite the two oldest records.
+	cps.Reset()
+	cps.Add(&counter, &sumAgg[0], label.String("k", "w"))
+	cps.Add(&observer, &lvAgg[0])
+	require.NoError(t, exp.Export(ctx, cps))
+	records = exp.Records()
+	require.Len(t, records, 3)
+	assert.Equal(t, "observer", records[0].Descriptor.Name())
+	assert.Equal(t, []label.KeyValue{label.String("k", "w")}, records[1].Labels)
+
+	assert.Len(t, exp.Query(MetricQuery{Name: "observer"}), 2)
+	assert.Len(t, exp.Query(MetricQuery{Name: "observer", Limit: 1}), 1)
+	assert.Len(t, exp.RecordsInTimeRange(epoch, epoch.Add(time.Hour)), 0)
+
+	exp.Reset()
+	assert.Empty(t, exp.Records())
+}
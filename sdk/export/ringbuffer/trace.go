@@ -0,0 +1,151 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ringbuffer // import "go.opentelemetry.io/otel/sdk/export/ringbuffer"
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	exporttrace "go.opentelemetry.io/otel/sdk/export/trace"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// SpanQuery selects spans retained by a SpanExporter. Zero fields
+// match all spans.
+type SpanQuery struct {
+	// TraceID selects the spans of a trace.
+	TraceID trace.TraceID
+	// Name selects the spans with this name.
+	Name string
+	// Start selects the spans that ended at or after this time.
+	Start time.Time
+	// End selects the spans that started at or before this time.
+	End time.Time
+	// Limit is the maximum number of spans returned. If more spans
+	// match, the most recently exported ones are returned.
+	Limit int
+}
+
+func (q SpanQuery) matches(s *exporttrace.SpanSnapshot) bool {
+	switch {
+	case q.TraceID.IsValid() && s.SpanContext.TraceID != q.TraceID:
+		return false
+	case q.Name != "" && s.Name != q.Name:
+		return false
+	case !q.Start.IsZero() && s.EndTime.Before(q.Start):
+		return false
+	case !q.End.IsZero() && s.StartTime.After(q.End):
+		return false
+	}
+	return true
+}
+
+// SpanExporter is a SpanExporter retaining the most recently exported
+// spans in memory.
+type SpanExporter struct {
+	mu    sync.RWMutex
+	ring  ring
+	spans []*exporttrace.SpanSnapshot
+}
+
+var _ exporttrace.SpanExporter = (*SpanExporter)(nil)
+
+// NewSpanExporter returns a SpanExporter retaining up to the
+// configured capacity of spans.
+func NewSpanExporter(opts ...Option) *SpanExporter {
+	cfg := newConfig(opts)
+	return &SpanExporter{
+		ring:  newRing(cfg.capacity),
+		spans: make([]*exporttrace.SpanSnapshot, cfg.capacity),
+	}
+}
+
+// ExportSpans retains copies of the spans, overwriting the oldest
+// retained ones when the capacity is exceeded.
+func (e *SpanExporter) ExportSpans(_ context.Context, ss []*exporttrace.SpanSnapshot) error {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	for _, s := range ss {
+		if s != nil {
+			sd := *s
+			e.spans[e.ring.push()] = &sd
+		}
+	}
+	return nil
+}
+
+// Shutdown does nothing. The retained spans can still be queried
+// afterwards.
+func (e *SpanExporter) Shutdown(context.Context) error {
+	return nil
+}
+
+// Reset drops all retained spans.
+func (e *SpanExporter) Reset() {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	for i := range e.spans {
+		e.spans[i] = nil
+	}
+	e.ring.reset()
+}
+
+// Spans returns all retained spans, oldest first.
+func (e *SpanExporter) Spans() []*exporttrace.SpanSnapshot {
+	return e.Query(SpanQuery{})
+}
+
+// SpansByTraceID returns the retained spans of the trace, oldest
+// first.
+func (e *SpanExporter) SpansByTraceID(traceID trace.TraceID) []*exporttrace.SpanSnapshot {
+	return e.Query(SpanQuery{TraceID: traceID})
+}
+
+// SpansByName returns the retained spans with the name, oldest first.
+func (e *SpanExporter) SpansByName(name string) []*exporttrace.SpanSnapshot {
+	return e.Query(SpanQuery{Name: name})
+}
+
+// SpansInTimeRange returns the retained spans overlapping the time
+// range from start to end, oldest first.
+func (e *SpanExporter) SpansInTimeRange(start, end time.Time) []*exporttrace.SpanSnapshot {
+	return e.Query(SpanQuery{Start: start, End: end})
+}
+
+// Query returns the retained spans matching q, oldest first.
+func (e *SpanExporter) Query(q SpanQuery) []*exporttrace.SpanSnapshot {
+	e.mu.RLock()
+	defer e.mu.RUnlock()
+
+	var result []*exporttrace.SpanSnapshot
+	// Walk from the newest span so a limit keeps the most recent.
+	for i := e.ring.len - 1; i >= 0; i-- {
+		if q.Limit > 0 && len(result) == q.Limit {
+			break
+		}
+		if s := e.spans[e.ring.index(i)]; q.matches(s) {
+			result = append(result, s)
+		}
+	}
+	reverseSpans(result)
+	return result
+}
+
+func reverseSpans(ss []*exporttrace.SpanSnapshot) {
+	for i, j := 0, len(ss)-1; i < j; i, j = i+1, j-1 {
+		ss[i], ss[j] = ss[j], ss[i]
+	}
+}
@@ -40,6 +40,11 @@ type SpanExporter interface {
 	// calls this function will not implement any retry logic. All errors
 	// returned by this function are considered unrecoverable and will be
 	// reported to a configured error Handler.
+	//
+	// The passed slice and the SpanSnapshots it holds are only valid
+	// until this function returns: span processors may reuse them for
+	// later batches. Implementations that retain spans after returning,
+	// or that export them asynchronously, must copy them.
 	ExportSpans(ctx context.Context, ss []*SpanSnapshot) error
 	// Shutdown notifies the exporter of a pending halt to operations. The
 	// exporter is expected to preform any cleanup or synchronization it
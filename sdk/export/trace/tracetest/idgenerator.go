@@ -0,0 +1,61 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tracetest // import "go.opentelemetry.io/otel/sdk/export/trace/tracetest"
+
+import (
+	"context"
+	"encoding/binary"
+	"sync/atomic"
+
+	apitrace "go.opentelemetry.io/otel/trace"
+)
+
+// SequenceIDGenerator generates trace and span IDs from counters, so that
+// the spans of a test get the same IDs each time it runs, for example to
+// compare the output of an exporter with a golden file.  It implements
+// the IDGenerator interface of the "go.opentelemetry.io/otel/sdk/trace"
+// package.
+//
+// The trace IDs are made of the seed of the generator followed by the
+// number of trace IDs generated so far, the span IDs of the number of
+// span IDs generated so far, both starting at one.  The IDs of spans
+// started concurrently depend on the order they are started in.
+type SequenceIDGenerator struct {
+	seed    uint64
+	traceID uint64 // access atomically
+	spanID  uint64 // access atomically
+}
+
+// NewSequenceIDGenerator returns a SequenceIDGenerator whose trace IDs
+// start with seed.  Generators with different seeds generate different
+// trace IDs.
+func NewSequenceIDGenerator(seed uint64) *SequenceIDGenerator {
+	return &SequenceIDGenerator{seed: seed}
+}
+
+// NewIDs returns the next trace ID and span ID of the sequence.
+func (g *SequenceIDGenerator) NewIDs(ctx context.Context) (apitrace.TraceID, apitrace.SpanID) {
+	var tid apitrace.TraceID
+	binary.BigEndian.PutUint64(tid[:8], g.seed)
+	binary.BigEndian.PutUint64(tid[8:], atomic.AddUint64(&g.traceID, 1))
+	return tid, g.NewSpanID(ctx, tid)
+}
+
+// NewSpanID returns the next span ID of the sequence.
+func (g *SequenceIDGenerator) NewSpanID(context.Context, apitrace.TraceID) apitrace.SpanID {
+	var sid apitrace.SpanID
+	binary.BigEndian.PutUint64(sid[:], atomic.AddUint64(&g.spanID, 1))
+	return sid
+}
@@ -0,0 +1,73 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tracetest
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.opentelemetry.io/otel/sdk/export/trace"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	apitrace "go.opentelemetry.io/otel/trace"
+)
+
+var _ sdktrace.IDGenerator = (*SequenceIDGenerator)(nil)
+
+func TestSequenceIDGenerator(t *testing.T) {
+	g := NewSequenceIDGenerator(0)
+	tid, sid := g.NewIDs(context.Background())
+	assert.Equal(t, apitrace.TraceID{15: 1}, tid)
+	assert.Equal(t, apitrace.SpanID{7: 1}, sid)
+	assert.Equal(t, apitrace.SpanID{7: 2}, g.NewSpanID(context.Background(), tid))
+
+	tid, sid = g.NewIDs(context.Background())
+	assert.Equal(t, apitrace.TraceID{15: 2}, tid)
+	assert.Equal(t, apitrace.SpanID{7: 3}, sid)
+
+	tid, _ = NewSequenceIDGenerator(0x0102).NewIDs(context.Background())
+	assert.Equal(t, apitrace.TraceID{6: 1, 7: 2, 15: 1}, tid)
+}
+
+func TestSequenceIDGeneratorStable(t *testing.T) {
+	run := func() []*trace.SpanSnapshot {
+		imsb := NewInMemoryExporter()
+		tp := sdktrace.NewTracerProvider(
+			sdktrace.WithSyncer(imsb),
+			sdktrace.WithIDGenerator(NewSequenceIDGenerator(42)),
+		)
+		tracer := tp.Tracer("stable")
+		ctx, parent := tracer.Start(context.Background(), "parent")
+		_, child := tracer.Start(ctx, "child")
+		child.End()
+		parent.End()
+		_, other := tracer.Start(context.Background(), "other")
+		other.End()
+		return imsb.GetSpans()
+	}
+
+	first, second := run(), run()
+	require.Len(t, first, 3)
+	require.Len(t, second, 3)
+	for i := range first {
+		assert.Equal(t, first[i].SpanContext.TraceID, second[i].SpanContext.TraceID)
+		assert.Equal(t, first[i].SpanContext.SpanID, second[i].SpanContext.SpanID)
+		assert.Equal(t, first[i].ParentSpanID, second[i].ParentSpanID)
+	}
+	assert.Equal(t, first[1].SpanContext.SpanID, first[0].ParentSpanID)
+	assert.NotEqual(t, first[1].SpanContext.TraceID, first[2].SpanContext.TraceID)
+}
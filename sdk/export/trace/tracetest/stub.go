@@ -0,0 +1,268 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tracetest // import "go.opentelemetry.io/otel/sdk/export/trace/tracetest"
+
+import (
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/label"
+	"go.opentelemetry.io/otel/sdk/export/trace"
+	"go.opentelemetry.io/otel/sdk/instrumentation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	apitrace "go.opentelemetry.io/otel/trace"
+)
+
+// SpanStub describes an ended span.  It is used to build the spans
+// expected from exporters and instrumentation in tests, and to stand in
+// for the spans of the SDK with ReadOnlySpan.
+type SpanStub struct {
+	Name                     string
+	SpanContext              apitrace.SpanContext
+	Parent                   apitrace.SpanContext
+	SpanKind                 apitrace.SpanKind
+	StartTime                time.Time
+	EndTime                  time.Time
+	Attributes               []label.KeyValue
+	Events                   []apitrace.Event
+	Links                    []apitrace.Link
+	StatusCode               codes.Code
+	StatusMessage            string
+	HasRemoteParent          bool
+	DroppedAttributeCount    int
+	DroppedMessageEventCount int
+	DroppedLinkCount         int
+	ChildSpanCount           int
+	Resource                 *resource.Resource
+	InstrumentationLibrary   instrumentation.Library
+}
+
+// SpanStubFromSnapshot returns a SpanStub describing the exported span
+// sd.
+func SpanStubFromSnapshot(sd *trace.SpanSnapshot) SpanStub {
+	if sd == nil {
+		return SpanStub{}
+	}
+	s := SpanStub{
+		Name:                     sd.Name,
+		SpanContext:              sd.SpanContext,
+		SpanKind:                 sd.SpanKind,
+		StartTime:                sd.StartTime,
+		EndTime:                  sd.EndTime,
+		Attributes:               sd.Attributes,
+		Events:                   sd.MessageEvents,
+		Links:                    sd.Links,
+		StatusCode:               sd.StatusCode,
+		StatusMessage:            sd.StatusMessage,
+		HasRemoteParent:          sd.HasRemoteParent,
+		DroppedAttributeCount:    sd.DroppedAttributeCount,
+		DroppedMessageEventCount: sd.DroppedMessageEventCount,
+		DroppedLinkCount:         sd.DroppedLinkCount,
+		ChildSpanCount:           sd.ChildSpanCount,
+		Resource:                 sd.Resource,
+		InstrumentationLibrary:   sd.InstrumentationLibrary,
+	}
+	if sd.ParentSpanID.IsValid() {
+		s.Parent = apitrace.SpanContext{
+			TraceID: sd.SpanContext.TraceID,
+			SpanID:  sd.ParentSpanID,
+		}
+	}
+	return s
+}
+
+// Snapshot returns the SpanSnapshot exported for the span described by
+// s.
+func (s SpanStub) Snapshot() *trace.SpanSnapshot {
+	return &trace.SpanSnapshot{
+		SpanContext:              s.SpanContext,
+		ParentSpanID:             s.Parent.SpanID,
+		SpanKind:                 s.SpanKind,
+		Name:                     s.Name,
+		StartTime:                s.StartTime,
+		EndTime:                  s.EndTime,
+		Attributes:               s.Attributes,
+		MessageEvents:            s.Events,
+		Links:                    s.Links,
+		StatusCode:               s.StatusCode,
+		StatusMessage:            s.StatusMessage,
+		HasRemoteParent:          s.HasRemoteParent,
+		DroppedAttributeCount:    s.DroppedAttributeCount,
+		DroppedMessageEventCount: s.DroppedMessageEventCount,
+		DroppedLinkCount:         s.DroppedLinkCount,
+		ChildSpanCount:           s.ChildSpanCount,
+		Resource:                 s.Resource,
+		InstrumentationLibrary:   s.InstrumentationLibrary,
+	}
+}
+
+// ChildOf returns a copy of s that is a child of parent: it is in the
+// trace of parent and its Parent has the trace and span IDs of parent,
+// like the SpanStubs returned by SpanStubFromSnapshot.
+func (s SpanStub) ChildOf(parent SpanStub) SpanStub {
+	s.SpanContext.TraceID = parent.SpanContext.TraceID
+	s.Parent = apitrace.SpanContext{
+		TraceID: parent.SpanContext.TraceID,
+		SpanID:  parent.SpanContext.SpanID,
+	}
+	return s
+}
+
+// WithAttributes returns a copy of s with kvs added to its Attributes.
+func (s SpanStub) WithAttributes(kvs ...label.KeyValue) SpanStub {
+	s.Attributes = append(s.Attributes[:len(s.Attributes):len(s.Attributes)], kvs...)
+	return s
+}
+
+// WithEvents returns a copy of s with events added to its Events.
+func (s SpanStub) WithEvents(events ...apitrace.Event) SpanStub {
+	s.Events = append(s.Events[:len(s.Events):len(s.Events)], events...)
+	return s
+}
+
+// WithLinks returns a copy of s with links added to its Links.
+func (s SpanStub) WithLinks(links ...apitrace.Link) SpanStub {
+	s.Links = append(s.Links[:len(s.Links):len(s.Links)], links...)
+	return s
+}
+
+// WithStatus returns a copy of s with the given status.
+func (s SpanStub) WithStatus(code codes.Code, msg string) SpanStub {
+	s.StatusCode = code
+	s.StatusMessage = msg
+	return s
+}
+
+// ReadOnlySpan returns a ReadOnlySpanStub reading from s.
+func (s SpanStub) ReadOnlySpan() ReadOnlySpanStub {
+	return ReadOnlySpanStub{stub: s}
+}
+
+// SpanStubs is a list of SpanStubs.
+type SpanStubs []SpanStub
+
+// SpanStubsFromSnapshots returns the SpanStubs describing the exported
+// spans ss.
+func SpanStubsFromSnapshots(ss []*trace.SpanSnapshot) SpanStubs {
+	stubs := make(SpanStubs, len(ss))
+	for i, sd := range ss {
+		stubs[i] = SpanStubFromSnapshot(sd)
+	}
+	return stubs
+}
+
+// Snapshots returns the SpanSnapshots of the spans described by the
+// SpanStubs.
+func (s SpanStubs) Snapshots() []*trace.SpanSnapshot {
+	ss := make([]*trace.SpanSnapshot, len(s))
+	for i, stub := range s {
+		ss[i] = stub.Snapshot()
+	}
+	return ss
+}
+
+// ReadOnlySpanStub implements the ReadOnlySpan interface of the
+// "go.opentelemetry.io/otel/sdk/trace" package for an ended span
+// described by a SpanStub, to test SpanProcessors without starting
+// spans.
+type ReadOnlySpanStub struct {
+	stub SpanStub
+}
+
+func (s ReadOnlySpanStub) Name() string                      { return s.stub.Name }
+func (s ReadOnlySpanStub) SpanContext() apitrace.SpanContext { return s.stub.SpanContext }
+func (s ReadOnlySpanStub) Parent() apitrace.SpanContext      { return s.stub.Parent }
+func (s ReadOnlySpanStub) SpanKind() apitrace.SpanKind       { return s.stub.SpanKind }
+func (s ReadOnlySpanStub) StartTime() time.Time              { return s.stub.StartTime }
+func (s ReadOnlySpanStub) EndTime() time.Time                { return s.stub.EndTime }
+func (s ReadOnlySpanStub) Attributes() []label.KeyValue      { return s.stub.Attributes }
+func (s ReadOnlySpanStub) Links() []apitrace.Link            { return s.stub.Links }
+func (s ReadOnlySpanStub) Events() []apitrace.Event          { return s.stub.Events }
+func (s ReadOnlySpanStub) StatusCode() codes.Code            { return s.stub.StatusCode }
+func (s ReadOnlySpanStub) StatusMessage() string             { return s.stub.StatusMessage }
+func (s ReadOnlySpanStub) IsRecording() bool                 { return false }
+func (s ReadOnlySpanStub) Resource() *resource.Resource      { return s.stub.Resource }
+func (s ReadOnlySpanStub) Snapshot() *trace.SpanSnapshot     { return s.stub.Snapshot() }
+
+func (s ReadOnlySpanStub) InstrumentationLibrary() instrumentation.Library {
+	return s.stub.InstrumentationLibrary
+}
+
+// Tracer returns a no-op Tracer named after the instrumentation library
+// of the span.
+func (s ReadOnlySpanStub) Tracer() apitrace.Tracer {
+	return apitrace.NewNoopTracerProvider().Tracer(s.stub.InstrumentationLibrary.Name)
+}
+
+// CompareOption configures the options returned by CmpOptions.
+type CompareOption func(*compareConfig)
+
+type compareConfig struct {
+	ignoreTimestamps bool
+	ignoreIDs        bool
+}
+
+// IgnoreTimestamps ignores the start and end times of the spans and the
+// times of their events.
+func IgnoreTimestamps() CompareOption {
+	return func(c *compareConfig) {
+		c.ignoreTimestamps = true
+	}
+}
+
+// IgnoreIDs ignores the trace and span IDs of the spans, of their parents
+// and of their links.
+func IgnoreIDs() CompareOption {
+	return func(c *compareConfig) {
+		c.ignoreIDs = true
+	}
+}
+
+// CmpOptions returns the options to compare SpanStubs and SpanSnapshots
+// with the "github.com/google/go-cmp/cmp" package, ignoring the fields
+// selected by opts.
+func CmpOptions(opts ...CompareOption) cmp.Options {
+	var c compareConfig
+	for _, opt := range opts {
+		opt(&c)
+	}
+
+	options := cmp.Options{
+		cmp.AllowUnexported(label.Value{}, apitrace.TraceState{}),
+	}
+	if c.ignoreTimestamps {
+		options = append(options,
+			cmpopts.IgnoreFields(SpanStub{}, "StartTime", "EndTime"),
+			cmpopts.IgnoreFields(trace.SpanSnapshot{}, "StartTime", "EndTime"),
+			cmpopts.IgnoreFields(apitrace.Event{}, "Time"),
+		)
+	}
+	if c.ignoreIDs {
+		options = append(options,
+			cmpopts.IgnoreFields(SpanStub{},
+				"SpanContext.TraceID", "SpanContext.SpanID",
+				"Parent.TraceID", "Parent.SpanID",
+			),
+			cmpopts.IgnoreFields(trace.SpanSnapshot{},
+				"SpanContext.TraceID", "SpanContext.SpanID", "ParentSpanID",
+			),
+			cmpopts.IgnoreFields(apitrace.Link{}, "SpanContext.TraceID", "SpanContext.SpanID"),
+		)
+	}
+	return options
+}
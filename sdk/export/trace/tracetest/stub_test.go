@@ -0,0 +1,136 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tracetest
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/label"
+	"go.opentelemetry.io/otel/sdk/export/trace"
+	"go.opentelemetry.io/otel/sdk/instrumentation"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	apitrace "go.opentelemetry.io/otel/trace"
+)
+
+var _ sdktrace.ReadOnlySpan = ReadOnlySpanStub{}
+
+func TestSpanStubSnapshot(t *testing.T) {
+	parent := SpanStub{
+		Name: "parent",
+		SpanContext: apitrace.SpanContext{
+			TraceID: apitrace.TraceID{1},
+			SpanID:  apitrace.SpanID{1},
+		},
+	}
+	child := SpanStub{
+		Name:        "child",
+		SpanContext: apitrace.SpanContext{SpanID: apitrace.SpanID{2}},
+	}.ChildOf(parent).WithAttributes(label.String("k", "v")).WithStatus(codes.Error, "failed")
+
+	sd := child.Snapshot()
+	assert.Equal(t, apitrace.TraceID{1}, sd.SpanContext.TraceID)
+	assert.Equal(t, apitrace.SpanID{1}, sd.ParentSpanID)
+	assert.Equal(t, []label.KeyValue{label.String("k", "v")}, sd.Attributes)
+	assert.Equal(t, codes.Error, sd.StatusCode)
+	assert.Equal(t, "failed", sd.StatusMessage)
+
+	assert.Equal(t, child, SpanStubFromSnapshot(sd))
+	assert.Equal(t, SpanStubs{parent, child}, SpanStubsFromSnapshots(SpanStubs{parent, child}.Snapshots()))
+}
+
+func TestSpanStubBuildersCopy(t *testing.T) {
+	base := SpanStub{Name: "span"}.WithAttributes(label.Int("a", 1))
+	first := base.WithAttributes(label.Int("b", 2))
+	second := base.WithAttributes(label.Int("c", 3))
+
+	assert.Equal(t, []label.KeyValue{label.Int("a", 1)}, base.Attributes)
+	assert.Equal(t, []label.KeyValue{label.Int("a", 1), label.Int("b", 2)}, first.Attributes)
+	assert.Equal(t, []label.KeyValue{label.Int("a", 1), label.Int("c", 3)}, second.Attributes)
+}
+
+func TestReadOnlySpanStub(t *testing.T) {
+	stub := SpanStub{
+		Name:                   "span",
+		SpanContext:            apitrace.SpanContext{TraceFlags: apitrace.FlagsSampled},
+		SpanKind:               apitrace.SpanKindServer,
+		Events:                 []apitrace.Event{{Name: "event"}},
+		InstrumentationLibrary: instrumentation.Library{Name: "stub"},
+	}
+
+	imsb := NewInMemoryExporter()
+	sp := sdktrace.NewSimpleSpanProcessor(imsb)
+	sp.OnEnd(stub.ReadOnlySpan())
+	assert.Equal(t, []*trace.SpanSnapshot{stub.Snapshot()}, imsb.GetSpans())
+	require.NoError(t, sp.Shutdown(context.Background()))
+
+	ro := stub.ReadOnlySpan()
+	assert.False(t, ro.IsRecording())
+	assert.Equal(t, "span", ro.Name())
+	assert.Equal(t, stub.Events, ro.Events())
+	assert.NotNil(t, ro.Tracer())
+	assert.Equal(t, stub.Snapshot(), ro.Snapshot())
+}
+
+func TestCmpOptions(t *testing.T) {
+	tp := sdktrace.NewTracerProvider()
+	imsb := NewInMemoryExporter()
+	tp.RegisterSpanProcessor(sdktrace.NewSimpleSpanProcessor(imsb))
+	tracer := tp.Tracer("cmp")
+
+	ctx, parent := tracer.Start(context.Background(), "parent")
+	_, child := tracer.Start(ctx, "child")
+	child.AddEvent("event")
+	child.End()
+	parent.End()
+
+	lib := instrumentation.Library{Name: "cmp"}
+	sc := apitrace.SpanContext{TraceFlags: parent.SpanContext().TraceFlags}
+	parentStub := SpanStub{
+		Name:                   "parent",
+		SpanContext:            sc,
+		SpanKind:               apitrace.SpanKindInternal,
+		ChildSpanCount:         1,
+		InstrumentationLibrary: lib,
+	}
+	want := SpanStubs{
+		SpanStub{
+			Name:                   "child",
+			SpanContext:            sc,
+			SpanKind:               apitrace.SpanKindInternal,
+			Events:                 []apitrace.Event{{Name: "event"}},
+			InstrumentationLibrary: lib,
+		}.ChildOf(parentStub),
+		parentStub,
+	}
+	got := SpanStubsFromSnapshots(imsb.GetSpans())
+
+	assert.NotEmpty(t, cmp.Diff(want, got, CmpOptions()))
+	assert.NotEmpty(t, cmp.Diff(want, got, CmpOptions(IgnoreIDs())))
+	assert.NotEmpty(t, cmp.Diff(want, got, CmpOptions(IgnoreTimestamps())))
+	assert.Empty(t, cmp.Diff(want, got, CmpOptions(IgnoreIDs(), IgnoreTimestamps())))
+	assert.Empty(t, cmp.Diff(want.Snapshots(), imsb.GetSpans(), CmpOptions(IgnoreIDs(), IgnoreTimestamps())))
+
+	// The timestamps of the spans are compared, unless ignored.
+	got[0].EndTime = got[0].EndTime.Add(time.Second)
+	assert.NotEmpty(t, cmp.Diff(SpanStubsFromSnapshots(imsb.GetSpans()), got, CmpOptions(IgnoreIDs())))
+	assert.Empty(t, cmp.Diff(SpanStubsFromSnapshots(imsb.GetSpans()), got, CmpOptions(IgnoreTimestamps())))
+}
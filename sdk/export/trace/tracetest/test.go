@@ -18,9 +18,13 @@ package tracetest // import "go.opentelemetry.io/otel/sdk/export/trace/tracetest
 
 import (
 	"context"
+	"fmt"
 	"sync"
+	"time"
 
+	"go.opentelemetry.io/otel/label"
 	"go.opentelemetry.io/otel/sdk/export/trace"
+	apitrace "go.opentelemetry.io/otel/trace"
 )
 
 var _ trace.SpanExporter = (*NoopExporter)(nil)
@@ -51,13 +55,29 @@ func NewInMemoryExporter() *InMemoryExporter {
 type InMemoryExporter struct {
 	mu sync.Mutex
 	ss []*trace.SpanSnapshot
+
+	// exported is closed and cleared by ExportSpans to wake up the
+	// callers of WaitForSpans.
+	exported chan struct{}
 }
 
-// ExportSpans handles export of SpanSnapshots by storing them in memory.
+// ExportSpans handles export of SpanSnapshots by storing copies of them in
+// memory.
 func (imsb *InMemoryExporter) ExportSpans(_ context.Context, ss []*trace.SpanSnapshot) error {
 	imsb.mu.Lock()
 	defer imsb.mu.Unlock()
-	imsb.ss = append(imsb.ss, ss...)
+	for _, s := range ss {
+		if s == nil {
+			imsb.ss = append(imsb.ss, nil)
+			continue
+		}
+		sd := *s
+		imsb.ss = append(imsb.ss, &sd)
+	}
+	if imsb.exported != nil {
+		close(imsb.exported)
+		imsb.exported = nil
+	}
 	return nil
 }
 
@@ -82,3 +102,121 @@ func (imsb *InMemoryExporter) GetSpans() []*trace.SpanSnapshot {
 	copy(ret, imsb.ss)
 	return ret
 }
+
+// WaitForSpans waits until at least n spans are stored in memory and
+// returns them, like GetSpans.  It returns the spans stored so far and an
+// error if there are still less than n spans after timeout.
+func (imsb *InMemoryExporter) WaitForSpans(n int, timeout time.Duration) ([]*trace.SpanSnapshot, error) {
+	timer := time.NewTimer(timeout)
+	defer timer.Stop()
+	for {
+		imsb.mu.Lock()
+		if len(imsb.ss) >= n {
+			imsb.mu.Unlock()
+			return imsb.GetSpans(), nil
+		}
+		if imsb.exported == nil {
+			imsb.exported = make(chan struct{})
+		}
+		exported := imsb.exported
+		imsb.mu.Unlock()
+
+		select {
+		case <-exported:
+		case <-timer.C:
+			ss := imsb.GetSpans()
+			return ss, fmt.Errorf("got %d spans after %v, want %d", len(ss), timeout, n)
+		}
+	}
+}
+
+// FindSpans returns the spans stored in memory for which f returns true,
+// in the order they were exported.
+func (imsb *InMemoryExporter) FindSpans(f func(*trace.SpanSnapshot) bool) []*trace.SpanSnapshot {
+	var found []*trace.SpanSnapshot
+	for _, sd := range imsb.GetSpans() {
+		if sd != nil && f(sd) {
+			found = append(found, sd)
+		}
+	}
+	return found
+}
+
+// SpansByName returns the spans stored in memory with the given name.
+func (imsb *InMemoryExporter) SpansByName(name string) []*trace.SpanSnapshot {
+	return imsb.FindSpans(func(sd *trace.SpanSnapshot) bool {
+		return sd.Name == name
+	})
+}
+
+// SpansWithAttribute returns the spans stored in memory having the
+// attribute kv.
+func (imsb *InMemoryExporter) SpansWithAttribute(kv label.KeyValue) []*trace.SpanSnapshot {
+	return imsb.FindSpans(func(sd *trace.SpanSnapshot) bool {
+		for _, attr := range sd.Attributes {
+			if attr == kv {
+				return true
+			}
+		}
+		return false
+	})
+}
+
+// SpanTree returns the trees of the spans stored in memory, see
+// NewSpanTree.
+func (imsb *InMemoryExporter) SpanTree() []*SpanNode {
+	return NewSpanTree(imsb.GetSpans())
+}
+
+// SpanNode is a span of a tree reconstructed from the parent span IDs of
+// exported spans.
+type SpanNode struct {
+	Span     *trace.SpanSnapshot
+	Children []*SpanNode
+}
+
+// Find returns the first node named name found in a depth-first walk
+// of the tree rooted at n, or nil if there is none.
+func (n *SpanNode) Find(name string) *SpanNode {
+	if n.Span.Name == name {
+		return n
+	}
+	for _, child := range n.Children {
+		if found := child.Find(name); found != nil {
+			return found
+		}
+	}
+	return nil
+}
+
+// NewSpanTree reconstructs the parent-child relationships of the spans
+// in ss and returns the roots of the resulting trees.  The roots are the
+// spans whose parent is not in ss, like the spans with a remote parent.
+// Roots and children are in the order of ss, nil spans are ignored.
+func NewSpanTree(ss []*trace.SpanSnapshot) []*SpanNode {
+	type spanKey struct {
+		traceID apitrace.TraceID
+		spanID  apitrace.SpanID
+	}
+	nodes := make(map[spanKey]*SpanNode, len(ss))
+	ordered := make([]*SpanNode, 0, len(ss))
+	for _, sd := range ss {
+		if sd == nil {
+			continue
+		}
+		n := &SpanNode{Span: sd}
+		nodes[spanKey{sd.SpanContext.TraceID, sd.SpanContext.SpanID}] = n
+		ordered = append(ordered, n)
+	}
+
+	var roots []*SpanNode
+	for _, n := range ordered {
+		parent, ok := nodes[spanKey{n.Span.SpanContext.TraceID, n.Span.ParentSpanID}]
+		if !ok || !n.Span.ParentSpanID.IsValid() || parent == n {
+			roots = append(roots, n)
+			continue
+		}
+		parent.Children = append(parent.Children, n)
+	}
+	return roots
+}
@@ -16,12 +16,16 @@ package tracetest
 
 import (
 	"context"
+	"fmt"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
+	"go.opentelemetry.io/otel/label"
 	"go.opentelemetry.io/otel/sdk/export/trace"
+	apitrace "go.opentelemetry.io/otel/trace"
 )
 
 // TestNoop tests only that the no-op does not crash in different scenarios.
@@ -41,13 +45,14 @@ func TestNewInMemoryExporter(t *testing.T) {
 
 	input := make([]*trace.SpanSnapshot, 10)
 	for i := 0; i < 10; i++ {
-		input[i] = new(trace.SpanSnapshot)
+		input[i] = &trace.SpanSnapshot{Name: fmt.Sprint(i)}
 	}
 	require.NoError(t, imsb.ExportSpans(context.Background(), input))
 	sds := imsb.GetSpans()
 	assert.Len(t, sds, 10)
 	for i, sd := range sds {
-		assert.Same(t, input[i], sd)
+		assert.Equal(t, input[i], sd)
+		assert.NotSame(t, input[i], sd, "exported spans must be copied")
 	}
 	imsb.Reset()
 	// Ensure that operations on the internal storage does not change the previously returned value.
@@ -57,5 +62,91 @@ func TestNewInMemoryExporter(t *testing.T) {
 	require.NoError(t, imsb.ExportSpans(context.Background(), input[0:1]))
 	sds = imsb.GetSpans()
 	assert.Len(t, sds, 1)
-	assert.Same(t, input[0], sds[0])
+	assert.Equal(t, input[0], sds[0])
+
+	// Exporters reuse the exported spans once ExportSpans returns.
+	input[0].Name = "reused"
+	assert.Equal(t, "0", sds[0].Name)
+}
+
+func TestInMemoryExporterWaitForSpans(t *testing.T) {
+	imsb := NewInMemoryExporter()
+
+	ss, err := imsb.WaitForSpans(0, 0)
+	require.NoError(t, err)
+	assert.Len(t, ss, 0)
+
+	ss, err = imsb.WaitForSpans(1, time.Millisecond)
+	assert.Error(t, err)
+	assert.Len(t, ss, 0)
+
+	go func() {
+		for i := 0; i < 3; i++ {
+			sd := &trace.SpanSnapshot{Name: fmt.Sprint(i)}
+			assert.NoError(t, imsb.ExportSpans(context.Background(), []*trace.SpanSnapshot{sd}))
+		}
+	}()
+	ss, err = imsb.WaitForSpans(3, time.Minute)
+	require.NoError(t, err)
+	require.Len(t, ss, 3)
+	assert.Equal(t, "2", ss[2].Name)
+}
+
+func TestInMemoryExporterFindSpans(t *testing.T) {
+	imsb := NewInMemoryExporter()
+	input := []*trace.SpanSnapshot{
+		{Name: "a", Attributes: []label.KeyValue{label.String("k", "v")}},
+		nil,
+		{Name: "b", Attributes: []label.KeyValue{label.Int("k", 1)}},
+		{Name: "a"},
+	}
+	require.NoError(t, imsb.ExportSpans(context.Background(), input))
+
+	byName := imsb.SpansByName("a")
+	require.Len(t, byName, 2)
+	assert.Equal(t, input[0], byName[0])
+	assert.Equal(t, input[3], byName[1])
+	assert.Len(t, imsb.SpansByName("c"), 0)
+
+	withAttr := imsb.SpansWithAttribute(label.Int("k", 1))
+	require.Len(t, withAttr, 1)
+	assert.Equal(t, "b", withAttr[0].Name)
+	assert.Len(t, imsb.SpansWithAttribute(label.String("k", "w")), 0)
+}
+
+func TestNewSpanTree(t *testing.T) {
+	tid := apitrace.TraceID{1}
+	span := func(name string, id, parent byte) *trace.SpanSnapshot {
+		return &trace.SpanSnapshot{
+			Name:         name,
+			SpanContext:  apitrace.SpanContext{TraceID: tid, SpanID: apitrace.SpanID{id}},
+			ParentSpanID: apitrace.SpanID{parent},
+		}
+	}
+	imsb := NewInMemoryExporter()
+	// Children usually end, and are exported, before their parents.
+	require.NoError(t, imsb.ExportSpans(context.Background(), []*trace.SpanSnapshot{
+		span("grandchild", 3, 2),
+		span("child1", 2, 1),
+		span("child2", 4, 1),
+		nil,
+		span("root", 1, 0),
+		span("remote child", 5, 9),
+	}))
+
+	roots := imsb.SpanTree()
+	require.Len(t, roots, 2)
+	assert.Equal(t, "root", roots[0].Span.Name)
+	assert.Equal(t, "remote child", roots[1].Span.Name)
+	assert.Len(t, roots[1].Children, 0)
+
+	root := roots[0]
+	require.Len(t, root.Children, 2)
+	assert.Equal(t, "child1", root.Children[0].Span.Name)
+	assert.Equal(t, "child2", root.Children[1].Span.Name)
+
+	grandchild := root.Find("grandchild")
+	require.NotNil(t, grandchild)
+	assert.Same(t, root.Children[0].Children[0], grandchild)
+	assert.Nil(t, root.Find("remote child"))
 }
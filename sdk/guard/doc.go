@@ -0,0 +1,43 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package guard protects a process from the memory used by its telemetry
+// when the telemetry is produced faster than it can be exported.
+//
+// A Guard periodically compares the memory attributable to the SDK, like
+// the number of spans waiting in the queue of a BatchSpanProcessor or the
+// number of records held by a metric Controller, with limits.  Once a
+// limit is exceeded, the Guard is under pressure: its Sampler drops all
+// new spans and the Controllers record the measurements with new label
+// sets without labels.  Telemetry is restored once all the watched values
+// fall below a fraction of their limits.
+//
+//	g := guard.New(
+//		guard.WithSpanQueueLimit(bsp, 1500),
+//		guard.WithRecordLimit(cont, 10000),
+//	)
+//	tp := sdktrace.NewTracerProvider(
+//		sdktrace.WithSpanProcessor(bsp),
+//		sdktrace.WithConfig(sdktrace.Config{
+//			DefaultSampler: g.Sampler(sdktrace.ParentBased(sdktrace.AlwaysSample())),
+//		}),
+//	)
+//	g.Start()
+//	defer g.Stop()
+//
+// This package is currently in a pre-GA phase. Backwards incompatible
+// changes may be introduced in subsequent minor version releases as we
+// work to track the evolving OpenTelemetry specification and user
+// feedback.
+package guard // import "go.opentelemetry.io/otel/sdk/guard"
@@ -0,0 +1,248 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package guard // import "go.opentelemetry.io/otel/sdk/guard"
+
+import (
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"go.opentelemetry.io/otel/internal/global"
+	controller "go.opentelemetry.io/otel/sdk/metric/controller/basic"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+)
+
+const (
+	// DefaultInterval is the default time between two checks of a
+	// Guard.
+	DefaultInterval = time.Second
+	// DefaultRecoveryRatio is the default fraction of their limits the
+	// watched values must fall below for a Guard to leave pressure.
+	DefaultRecoveryRatio = 0.8
+)
+
+// gauge is a value watched by a Guard.
+type gauge struct {
+	name  string
+	limit int
+	value func() int
+}
+
+// config holds the configuration of a Guard.
+type config struct {
+	interval      time.Duration
+	recoveryRatio float64
+	gauges        []gauge
+	controllers   []*controller.Controller
+}
+
+// Option configures a Guard.
+type Option func(*config)
+
+// WithInterval sets the time between two checks of the Guard started
+// with Start.  The default is DefaultInterval.
+func WithInterval(d time.Duration) Option {
+	return func(c *config) {
+		if d > 0 {
+			c.interval = d
+		}
+	}
+}
+
+// WithRecoveryRatio sets the fraction of their limits all the watched
+// values must fall below for the Guard to leave pressure, so that it does
+// not flap around the limits.  Ratios outside of (0, 1] are ignored.  The
+// default is DefaultRecoveryRatio.
+func WithRecoveryRatio(ratio float64) Option {
+	return func(c *config) {
+		if ratio > 0 && ratio <= 1 {
+			c.recoveryRatio = ratio
+		}
+	}
+}
+
+// WithSpanQueueLimit makes the Guard watch the number of spans waiting
+// in the queue of bsp.  The Guard is under pressure when it exceeds
+// limit.
+func WithSpanQueueLimit(bsp *sdktrace.BatchSpanProcessor, limit int) Option {
+	return func(c *config) {
+		c.gauges = append(c.gauges, gauge{
+			name:  "span queue",
+			limit: limit,
+			value: func() int { return bsp.Stats().QueuedSpans },
+		})
+	}
+}
+
+// WithRecordLimit makes the Guard watch the number of records held by
+// the Accumulator of cont.  The Guard is under pressure when it exceeds
+// limit, cont then records the measurements with new label sets without
+// labels until the pressure subsides.
+func WithRecordLimit(cont *controller.Controller, limit int) Option {
+	return func(c *config) {
+		c.gauges = append(c.gauges, gauge{
+			name:  "metric records",
+			limit: limit,
+			value: cont.RecordCount,
+		})
+		c.controllers = append(c.controllers, cont)
+	}
+}
+
+// Guard watches the memory attributable to the SDK and degrades the
+// telemetry while it exceeds the configured limits.
+type Guard struct {
+	config
+
+	// pressure is 1 while the Guard is under pressure.  It is
+	// accessed atomically.
+	pressure int32
+
+	// checkMu serializes the checks.
+	checkMu sync.Mutex
+
+	lock   sync.Mutex
+	stopCh chan struct{}
+	wg     sync.WaitGroup
+}
+
+// New returns a Guard configured with opts.  It does not check anything
+// until Start or Check is called.
+func New(opts ...Option) *Guard {
+	c := config{
+		interval:      DefaultInterval,
+		recoveryRatio: DefaultRecoveryRatio,
+	}
+	for _, opt := range opts {
+		opt(&c)
+	}
+	return &Guard{config: c}
+}
+
+// Start checks the watched values every configured interval in the
+// background, until Stop is called.  Starting a started Guard does
+// nothing.
+func (g *Guard) Start() {
+	g.lock.Lock()
+	defer g.lock.Unlock()
+	if g.stopCh != nil {
+		return
+	}
+	g.stopCh = make(chan struct{})
+	g.wg.Add(1)
+	go g.run(g.stopCh)
+}
+
+// Stop stops the checks started by Start and restores the telemetry if
+// the Guard is under pressure.
+func (g *Guard) Stop() {
+	g.lock.Lock()
+	defer g.lock.Unlock()
+	if g.stopCh != nil {
+		close(g.stopCh)
+		g.stopCh = nil
+		g.wg.Wait()
+	}
+
+	g.checkMu.Lock()
+	defer g.checkMu.Unlock()
+	if g.UnderPressure() {
+		g.setPressure(false)
+	}
+}
+
+func (g *Guard) run(stopCh chan struct{}) {
+	defer g.wg.Done()
+	ticker := time.NewTicker(g.interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-stopCh:
+			return
+		case <-ticker.C:
+			g.Check()
+		}
+	}
+}
+
+// Check compares the watched values with their limits now, entering or
+// leaving pressure accordingly, and reports whether the Guard is under
+// pressure.  It is called periodically once the Guard is started.
+func (g *Guard) Check() bool {
+	g.checkMu.Lock()
+	defer g.checkMu.Unlock()
+
+	if !g.UnderPressure() {
+		for _, gg := range g.gauges {
+			if v := gg.value(); v > gg.limit {
+				global.Warn("degrading telemetry under memory pressure",
+					"reason", fmt.Sprintf("%s %d exceeds %d", gg.name, v, gg.limit))
+				g.setPressure(true)
+				return true
+			}
+		}
+		return false
+	}
+
+	for _, gg := range g.gauges {
+		if float64(gg.value()) > g.recoveryRatio*float64(gg.limit) {
+			return true
+		}
+	}
+	global.Info("restoring telemetry after memory pressure")
+	g.setPressure(false)
+	return false
+}
+
+// setPressure is called with checkMu held.
+func (g *Guard) setPressure(pressure bool) {
+	var v int32
+	if pressure {
+		v = 1
+	}
+	atomic.StoreInt32(&g.pressure, v)
+	for _, c := range g.controllers {
+		c.ShedLabelSets(pressure)
+	}
+}
+
+// UnderPressure reports whether the Guard is under pressure, as of its
+// last check.
+func (g *Guard) UnderPressure() bool {
+	return atomic.LoadInt32(&g.pressure) != 0
+}
+
+// Sampler returns a Sampler that drops all spans while the Guard is under
+// pressure, and otherwise delegates to base.
+func (g *Guard) Sampler(base sdktrace.Sampler) sdktrace.Sampler {
+	return sampler{guard: g, base: base}
+}
+
+type sampler struct {
+	guard *Guard
+	base  sdktrace.Sampler
+}
+
+func (s sampler) ShouldSample(p sdktrace.SamplingParameters) sdktrace.SamplingResult {
+	if s.guard.UnderPressure() {
+		return sdktrace.SamplingResult{Decision: sdktrace.Drop}
+	}
+	return s.base.ShouldSample(p)
+}
+
+func (s sampler) Description() string {
+	return fmt.Sprintf("Guarded{%s}", s.base.Description())
+}
@@ -0,0 +1,141 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package guard_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.opentelemetry.io/otel/label"
+	"go.opentelemetry.io/otel/metric"
+	export "go.opentelemetry.io/otel/sdk/export/metric"
+	exporttrace "go.opentelemetry.io/otel/sdk/export/trace"
+	"go.opentelemetry.io/otel/sdk/guard"
+	controller "go.opentelemetry.io/otel/sdk/metric/controller/basic"
+	processor "go.opentelemetry.io/otel/sdk/metric/processor/basic"
+	"go.opentelemetry.io/otel/sdk/metric/selector/simple"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// blockingExporter blocks the exports until unblock is closed.
+type blockingExporter struct {
+	unblock chan struct{}
+}
+
+func (e blockingExporter) ExportSpans(ctx context.Context, _ []*exporttrace.SpanSnapshot) error {
+	select {
+	case <-e.unblock:
+	case <-ctx.Done():
+	}
+	return nil
+}
+
+func (blockingExporter) Shutdown(context.Context) error {
+	return nil
+}
+
+func TestSpanQueueLimit(t *testing.T) {
+	ctx := context.Background()
+	exp := blockingExporter{unblock: make(chan struct{})}
+	bsp := sdktrace.NewBatchSpanProcessor(exp, sdktrace.WithMaxExportBatchSize(1))
+	g := guard.New(guard.WithSpanQueueLimit(bsp, 5), guard.WithRecoveryRatio(0.5))
+	tp := sdktrace.NewTracerProvider(
+		sdktrace.WithSpanProcessor(bsp),
+		sdktrace.WithConfig(sdktrace.Config{DefaultSampler: g.Sampler(sdktrace.AlwaysSample())}),
+	)
+	tracer := tp.Tracer("test")
+	assert.Equal(t, "Guarded{AlwaysOnSampler}", g.Sampler(sdktrace.AlwaysSample()).Description())
+
+	// The first span blocks the exporter, the others wait in the queue.
+	for i := 0; i < 7; i++ {
+		_, span := tracer.Start(ctx, "span")
+		span.End()
+	}
+	require.Eventually(t, func() bool {
+		return bsp.Stats().QueuedSpans == 6
+	}, time.Second, time.Millisecond)
+
+	assert.True(t, g.Check())
+	assert.True(t, g.UnderPressure())
+	_, span := tracer.Start(ctx, "dropped")
+	assert.False(t, span.SpanContext().IsSampled())
+	span.End()
+
+	close(exp.unblock)
+	require.Eventually(t, func() bool {
+		return bsp.Stats().QueuedSpans == 0
+	}, time.Second, time.Millisecond)
+	assert.False(t, g.Check())
+	_, span = tracer.Start(ctx, "sampled")
+	assert.True(t, span.SpanContext().IsSampled())
+	span.End()
+
+	require.NoError(t, tp.Shutdown(ctx))
+}
+
+func TestRecordLimit(t *testing.T) {
+	ctx := context.Background()
+	cont := controller.New(
+		processor.New(simple.NewWithInexpensiveDistribution(), export.CumulativeExportKindSelector()),
+		controller.WithCollectPeriod(0),
+	)
+	g := guard.New(guard.WithRecordLimit(cont, 3))
+	counter := metric.Must(cont.MeterProvider().Meter("test")).NewInt64Counter("requests.sum")
+
+	for i := 0; i < 4; i++ {
+		counter.Add(ctx, 1, label.Int("id", i))
+	}
+	assert.True(t, g.Check())
+
+	// New label sets share the record without labels.
+	for i := 4; i < 8; i++ {
+		counter.Add(ctx, 1, label.Int("id", i))
+	}
+	assert.Equal(t, 5, cont.RecordCount())
+
+	// The records of the label sets that were not updated since the
+	// last collection are removed by the next one.
+	require.NoError(t, cont.Collect(ctx))
+	require.NoError(t, cont.Collect(ctx))
+	assert.Equal(t, 0, cont.RecordCount())
+	assert.False(t, g.Check())
+
+	// New label sets have their own record again.
+	counter.Add(ctx, 1, label.Int("id", 8))
+	assert.Equal(t, 1, cont.RecordCount())
+}
+
+func TestStop(t *testing.T) {
+	cont := controller.New(
+		processor.New(simple.NewWithInexpensiveDistribution(), export.CumulativeExportKindSelector()),
+	)
+	counter := metric.Must(cont.MeterProvider().Meter("test")).NewInt64Counter("requests.sum")
+	counter.Add(context.Background(), 1, label.String("k", "v"))
+
+	g := guard.New(guard.WithRecordLimit(cont, 0), guard.WithInterval(time.Millisecond))
+	g.Start()
+	require.Eventually(t, g.UnderPressure, time.Second, time.Millisecond)
+	g.Stop()
+	assert.False(t, g.UnderPressure())
+	sampler := g.Sampler(sdktrace.AlwaysSample())
+	assert.Equal(t, sdktrace.RecordAndSample, sampler.ShouldSample(sdktrace.SamplingParameters{
+		TraceID: trace.TraceID{1},
+	}).Decision)
+}
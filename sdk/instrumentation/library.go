@@ -25,6 +25,17 @@ For more information see
 */
 package instrumentation // import "go.opentelemetry.io/otel/sdk/instrumentation"
 
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"unicode"
+)
+
+// ErrInvalidName is returned by ValidateName for an instrumentation library
+// name that cannot reliably identify the library.
+var ErrInvalidName = errors.New("invalid instrumentation library name")
+
 // Library represents the instrumentation library.
 type Library struct {
 	// Name is the name of the instrumentation library. This should be the
@@ -32,4 +43,26 @@ type Library struct {
 	Name string
 	// Version is the version of the instrumentation library.
 	Version string
+	// SchemaURL is the schema URL of the telemetry emitted by the
+	// instrumentation library.
+	SchemaURL string
+}
+
+// ValidateName returns an error wrapping ErrInvalidName if name is empty,
+// has leading or trailing whitespace, or contains non-printable characters.
+// Names are expected to be the import path of the instrumentation library,
+// e.g. "go.opentelemetry.io/contrib/instrumentation/net/http/otelhttp".
+func ValidateName(name string) error {
+	if name == "" {
+		return fmt.Errorf("%w: empty name", ErrInvalidName)
+	}
+	if strings.TrimSpace(name) != name {
+		return fmt.Errorf("%w: %q", ErrInvalidName, name)
+	}
+	for _, r := range name {
+		if !unicode.IsPrint(r) {
+			return fmt.Errorf("%w: %q", ErrInvalidName, name)
+		}
+	}
+	return nil
 }
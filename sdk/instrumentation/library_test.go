@@ -0,0 +1,45 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package instrumentation
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestValidateName(t *testing.T) {
+	for _, test := range []struct {
+		name  string
+		valid bool
+	}{
+		{"go.opentelemetry.io/contrib/instrumentation/net/http/otelhttp", true},
+		{"github.com/org/lib", true},
+		{"mylib", true},
+		{"my lib", true},
+		{"", false},
+		{" mylib", false},
+		{"mylib\n", false},
+		{"my\tlib", false},
+		{"my\x00lib", false},
+	} {
+		err := ValidateName(test.name)
+		if test.valid && err != nil {
+			t.Errorf("ValidateName(%q) = %v, want nil", test.name, err)
+		}
+		if !test.valid && !errors.Is(err, ErrInvalidName) {
+			t.Errorf("ValidateName(%q) = %v, want %v", test.name, err, ErrInvalidName)
+		}
+	}
+}
@@ -0,0 +1,87 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package internal
+
+import (
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// DefaultCoarseClockGranularity is the interval at which a CoarseClock
+// refreshes its time when it is created with a non-positive granularity.
+const DefaultCoarseClockGranularity = time.Millisecond
+
+// CoarseClock caches the current time and refreshes it in the background
+// at a fixed granularity, so that reading the time is a single atomic
+// load instead of a call to time.Now.  The times it returns lag behind
+// the wall clock by up to its granularity.
+type CoarseClock struct {
+	// now holds the cached time.Time, it holds the zero time.Time once
+	// the clock is stopped.
+	now atomic.Value
+
+	stopOnce sync.Once
+	stopCh   chan struct{}
+	doneCh   chan struct{}
+}
+
+// NewCoarseClock returns a started CoarseClock refreshing its time every
+// granularity.
+func NewCoarseClock(granularity time.Duration) *CoarseClock {
+	if granularity <= 0 {
+		granularity = DefaultCoarseClockGranularity
+	}
+	c := &CoarseClock{
+		stopCh: make(chan struct{}),
+		doneCh: make(chan struct{}),
+	}
+	c.now.Store(time.Now())
+	go c.run(granularity)
+	return c
+}
+
+func (c *CoarseClock) run(granularity time.Duration) {
+	defer close(c.doneCh)
+	ticker := time.NewTicker(granularity)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-c.stopCh:
+			return
+		case <-ticker.C:
+			c.now.Store(time.Now())
+		}
+	}
+}
+
+// Now returns the cached time, or the current time if the clock is
+// stopped.
+func (c *CoarseClock) Now() time.Time {
+	if now := c.now.Load().(time.Time); !now.IsZero() {
+		return now
+	}
+	return time.Now()
+}
+
+// Stop stops refreshing the cached time.  Once stopped, Now returns the
+// current time.
+func (c *CoarseClock) Stop() {
+	c.stopOnce.Do(func() {
+		close(c.stopCh)
+		<-c.doneCh
+		c.now.Store(time.Time{})
+	})
+}
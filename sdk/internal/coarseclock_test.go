@@ -0,0 +1,70 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package internal
+
+import (
+	"testing"
+	"time"
+)
+
+func TestCoarseClock(t *testing.T) {
+	c := NewCoarseClock(time.Millisecond)
+	defer c.Stop()
+
+	before := time.Now()
+	deadline := before.Add(time.Second)
+	for !c.Now().After(before) {
+		if time.Now().After(deadline) {
+			t.Fatalf("Now() = %v, not refreshed after %v", c.Now(), before)
+		}
+		time.Sleep(time.Millisecond)
+	}
+
+	first := c.Now()
+	if second := c.Now(); second.Before(first) {
+		t.Errorf("Now() went back from %v to %v", first, second)
+	}
+}
+
+func TestCoarseClockStop(t *testing.T) {
+	c := NewCoarseClock(time.Hour)
+	cached := c.Now()
+	time.Sleep(time.Millisecond)
+	if got := c.Now(); !got.Equal(cached) {
+		t.Errorf("Now() = %v, want the cached %v", got, cached)
+	}
+
+	c.Stop()
+	c.Stop()
+	if got := c.Now(); !got.After(cached) {
+		t.Errorf("Now() after Stop() = %v, want the current time", got)
+	}
+}
+
+func BenchmarkCoarseClockNow(b *testing.B) {
+	c := NewCoarseClock(DefaultCoarseClockGranularity)
+	defer c.Stop()
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		_ = c.Now()
+	}
+}
+
+func BenchmarkTimeNow(b *testing.B) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		_ = time.Now()
+	}
+}
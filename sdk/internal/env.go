@@ -0,0 +1,56 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package internal // import "go.opentelemetry.io/otel/sdk/internal"
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"go.opentelemetry.io/otel"
+)
+
+// ErrInvalidEnv is wrapped by the errors reported for an environment
+// variable whose value cannot be used.
+var ErrInvalidEnv = errors.New("invalid environment variable value")
+
+// IntEnv sets *v to the positive integer value of the environment
+// variable key if it is set. An invalid value is reported to the global
+// error handler and ignored.
+func IntEnv(key string, v *int) {
+	value := strings.TrimSpace(os.Getenv(key))
+	if value == "" {
+		return
+	}
+	i, err := strconv.Atoi(value)
+	if err != nil || i <= 0 {
+		otel.Handle(fmt.Errorf("%w: %s=%q is not a positive integer", ErrInvalidEnv, key, value))
+		return
+	}
+	*v = i
+}
+
+// DurationEnv sets *d to the value in milliseconds of the environment
+// variable key if it is set. An invalid value is reported to the global
+// error handler and ignored.
+func DurationEnv(key string, d *time.Duration) {
+	ms := 0
+	if IntEnv(key, &ms); ms > 0 {
+		*d = time.Duration(ms) * time.Millisecond
+	}
+}
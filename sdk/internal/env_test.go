@@ -0,0 +1,52 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package internal
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	ottest "go.opentelemetry.io/otel/internal/internaltest"
+)
+
+func TestEnv(t *testing.T) {
+	store, err := ottest.SetEnvVariables(map[string]string{
+		"TEST_INT":      "10",
+		"TEST_NEGATIVE": "-1",
+		"TEST_INVALID":  "ten",
+		"TEST_DURATION": " 1500 ",
+	})
+	require.NoError(t, err)
+	defer func() { require.NoError(t, store.Restore()) }()
+
+	for _, key := range []string{"TEST_NEGATIVE", "TEST_INVALID", "TEST_UNSET"} {
+		v, d := 1, time.Second
+		IntEnv(key, &v)
+		DurationEnv(key, &d)
+		assert.Equal(t, 1, v, key)
+		assert.Equal(t, time.Second, d, key)
+	}
+
+	v := 1
+	IntEnv("TEST_INT", &v)
+	assert.Equal(t, 10, v)
+
+	d := time.Second
+	DurationEnv("TEST_DURATION", &d)
+	assert.Equal(t, 1500*time.Millisecond, d)
+}
@@ -0,0 +1,264 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package log // import "go.opentelemetry.io/otel/sdk/log"
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/internal/global"
+	export "go.opentelemetry.io/otel/sdk/export/log"
+)
+
+const (
+	DefaultMaxQueueSize       = 2048
+	DefaultBatchTimeout       = 1000 * time.Millisecond
+	DefaultMaxExportBatchSize = 512
+	DefaultExportTimeout      = 30000 * time.Millisecond
+)
+
+// BatchProcessorOption configures a BatchProcessor.
+type BatchProcessorOption func(o *BatchProcessorOptions)
+
+// BatchProcessorOptions are the options of a BatchProcessor.
+type BatchProcessorOptions struct {
+	// MaxQueueSize is the maximum number of records buffered for export.
+	// Records emitted while the queue is full are dropped.
+	// The default value of MaxQueueSize is 2048.
+	MaxQueueSize int
+
+	// BatchTimeout is the maximum duration for constructing a batch. The
+	// processor exports the records it has when it is reached.
+	// The default value of BatchTimeout is 1000 msec.
+	BatchTimeout time.Duration
+
+	// ExportTimeout specifies the maximum duration for exporting a batch.
+	// If zero, no timeout is applied.
+	// The default value of ExportTimeout is 30000 msec.
+	ExportTimeout time.Duration
+
+	// MaxExportBatchSize is the maximum number of records exported in a
+	// single batch.
+	// The default value of MaxExportBatchSize is 512.
+	MaxExportBatchSize int
+}
+
+// WithMaxQueueSize sets the MaxQueueSize of a BatchProcessor.
+func WithMaxQueueSize(size int) BatchProcessorOption {
+	return func(o *BatchProcessorOptions) {
+		o.MaxQueueSize = size
+	}
+}
+
+// WithMaxExportBatchSize sets the MaxExportBatchSize of a BatchProcessor.
+func WithMaxExportBatchSize(size int) BatchProcessorOption {
+	return func(o *BatchProcessorOptions) {
+		o.MaxExportBatchSize = size
+	}
+}
+
+// WithBatchTimeout sets the BatchTimeout of a BatchProcessor.
+func WithBatchTimeout(delay time.Duration) BatchProcessorOption {
+	return func(o *BatchProcessorOptions) {
+		o.BatchTimeout = delay
+	}
+}
+
+// WithExportTimeout sets the ExportTimeout of a BatchProcessor.
+func WithExportTimeout(timeout time.Duration) BatchProcessorOption {
+	return func(o *BatchProcessorOptions) {
+		o.ExportTimeout = timeout
+	}
+}
+
+// BatchProcessor is a Processor that buffers records and exports them
+// asynchronously in batches.
+type BatchProcessor struct {
+	e export.Exporter
+	o BatchProcessorOptions
+
+	queue   chan *export.Record
+	flushCh chan chan struct{}
+	dropped uint32
+	// reportedDropped is the value of dropped when it was last logged.
+	// It is only accessed by the processing goroutine.
+	reportedDropped uint32
+
+	stopOnce sync.Once
+	stopCh   chan struct{}
+	done     chan struct{}
+}
+
+var _ Processor = (*BatchProcessor)(nil)
+
+// NewBatchProcessor returns a new BatchProcessor exporting records with
+// exporter. If the exporter is nil, the processor drops all records.
+//
+// The default options are read from the OTEL_BLRP_SCHEDULE_DELAY,
+// OTEL_BLRP_EXPORT_TIMEOUT, OTEL_BLRP_MAX_QUEUE_SIZE and
+// OTEL_BLRP_MAX_EXPORT_BATCH_SIZE environment variables when they are
+// set. The options take precedence over them.
+func NewBatchProcessor(exporter export.Exporter, options ...BatchProcessorOption) *BatchProcessor {
+	o := BatchProcessorOptions{
+		BatchTimeout:       DefaultBatchTimeout,
+		ExportTimeout:      DefaultExportTimeout,
+		MaxQueueSize:       DefaultMaxQueueSize,
+		MaxExportBatchSize: DefaultMaxExportBatchSize,
+	}
+	applyEnvBatchProcessorOptions(&o)
+	for _, opt := range options {
+		opt(&o)
+	}
+	p := &BatchProcessor{
+		e:       exporter,
+		o:       o,
+		queue:   make(chan *export.Record, o.MaxQueueSize),
+		flushCh: make(chan chan struct{}),
+		stopCh:  make(chan struct{}),
+		done:    make(chan struct{}),
+	}
+	go p.processQueue()
+	return p
+}
+
+// OnEmit enqueues r for export, or drops it if the queue is full.
+func (p *BatchProcessor) OnEmit(r *export.Record) {
+	if p.e == nil {
+		return
+	}
+	select {
+	case <-p.stopCh:
+		return
+	default:
+	}
+	select {
+	case p.queue <- r:
+	default:
+		atomic.AddUint32(&p.dropped, 1)
+	}
+}
+
+// ForceFlush exports all the queued records and waits until they are
+// exported or ctx is done.
+func (p *BatchProcessor) ForceFlush(ctx context.Context) error {
+	flushed := make(chan struct{})
+	select {
+	case p.flushCh <- flushed:
+	case <-p.done:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+	select {
+	case <-flushed:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// Shutdown exports all the queued records and shuts down the exporter. It
+// only executes once. Subsequent calls do nothing.
+func (p *BatchProcessor) Shutdown(ctx context.Context) error {
+	var err error
+	p.stopOnce.Do(func() {
+		close(p.stopCh)
+		select {
+		case <-p.done:
+		case <-ctx.Done():
+			err = ctx.Err()
+			return
+		}
+		if p.e != nil {
+			err = p.e.Shutdown(ctx)
+		}
+	})
+	return err
+}
+
+// processQueue exports the queued records in batches of up to
+// MaxExportBatchSize, waiting up to BatchTimeout to form a batch, until
+// the processor is shut down.
+func (p *BatchProcessor) processQueue() {
+	defer close(p.done)
+	ticker := time.NewTicker(p.o.BatchTimeout)
+	defer ticker.Stop()
+
+	batch := make([]*export.Record, 0, p.o.MaxExportBatchSize)
+	exportBatch := func() {
+		if len(batch) == 0 {
+			return
+		}
+		if err := p.export(batch); err != nil {
+			otel.Handle(err)
+		}
+		// The exporter may retain the batch.
+		batch = make([]*export.Record, 0, p.o.MaxExportBatchSize)
+	}
+	drain := func() {
+		for {
+			select {
+			case r := <-p.queue:
+				batch = append(batch, r)
+				if len(batch) == p.o.MaxExportBatchSize {
+					exportBatch()
+				}
+			default:
+				exportBatch()
+				return
+			}
+		}
+	}
+
+	for {
+		select {
+		case <-p.stopCh:
+			drain()
+			return
+		case flushed := <-p.flushCh:
+			drain()
+			close(flushed)
+		case <-ticker.C:
+			exportBatch()
+		case r := <-p.queue:
+			batch = append(batch, r)
+			if len(batch) == p.o.MaxExportBatchSize {
+				exportBatch()
+			}
+		}
+	}
+}
+
+// export calls the exporter with batch, applying the configured export
+// timeout.
+func (p *BatchProcessor) export(batch []*export.Record) error {
+	ctx := context.Background()
+	if p.o.ExportTimeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, p.o.ExportTimeout)
+		defer cancel()
+	}
+
+	if dropped := atomic.LoadUint32(&p.dropped); dropped != p.reportedDropped {
+		global.Warn("dropped log records because the queue is full",
+			"count", dropped-p.reportedDropped, "total", dropped)
+		p.reportedDropped = dropped
+	}
+	global.Debug("exporting log records", "count", len(batch))
+	return p.e.ExportLogs(ctx, batch)
+}
@@ -0,0 +1,93 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package log
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	export "go.opentelemetry.io/otel/sdk/export/log"
+)
+
+func TestBatchProcessorBatches(t *testing.T) {
+	exp := new(testExporter)
+	p := NewBatchProcessor(exp, WithMaxExportBatchSize(2), WithBatchTimeout(time.Hour))
+
+	for i := 0; i < 5; i++ {
+		p.OnEmit(&export.Record{})
+	}
+	require.NoError(t, p.ForceFlush(context.Background()))
+
+	exp.mu.Lock()
+	var sizes []int
+	for _, b := range exp.batches {
+		sizes = append(sizes, len(b))
+	}
+	exp.mu.Unlock()
+	assert.Equal(t, []int{2, 2, 1}, sizes)
+
+	require.NoError(t, p.Shutdown(context.Background()))
+	assert.True(t, exp.shutdown)
+}
+
+func TestBatchProcessorTimeout(t *testing.T) {
+	exp := new(testExporter)
+	p := NewBatchProcessor(exp, WithBatchTimeout(time.Millisecond))
+	defer func() { require.NoError(t, p.Shutdown(context.Background())) }()
+
+	p.OnEmit(&export.Record{})
+	assert.Eventually(t, func() bool {
+		return len(exp.records()) == 1
+	}, time.Second, time.Millisecond)
+}
+
+func TestBatchProcessorShutdownExportsQueue(t *testing.T) {
+	exp := new(testExporter)
+	p := NewBatchProcessor(exp, WithBatchTimeout(time.Hour))
+
+	for i := 0; i < 3; i++ {
+		p.OnEmit(&export.Record{})
+	}
+	require.NoError(t, p.Shutdown(context.Background()))
+	assert.Len(t, exp.records(), 3)
+
+	// Records emitted after shutdown are dropped.
+	p.OnEmit(&export.Record{})
+	require.NoError(t, p.ForceFlush(context.Background()))
+	assert.Len(t, exp.records(), 3)
+	require.NoError(t, p.Shutdown(context.Background()))
+}
+
+func TestBatchProcessorDropsWhenFull(t *testing.T) {
+	exp := new(testExporter)
+	p := NewBatchProcessor(exp, WithMaxQueueSize(1), WithMaxExportBatchSize(1), WithBatchTimeout(time.Hour))
+	for i := 0; i < 100; i++ {
+		p.OnEmit(&export.Record{})
+	}
+	require.NoError(t, p.Shutdown(context.Background()))
+	assert.NotEmpty(t, exp.records())
+	assert.True(t, len(exp.records()) < 100, "no record was dropped")
+}
+
+func TestBatchProcessorNilExporter(t *testing.T) {
+	p := NewBatchProcessor(nil)
+	p.OnEmit(&export.Record{})
+	require.NoError(t, p.ForceFlush(context.Background()))
+	require.NoError(t, p.Shutdown(context.Background()))
+}
@@ -0,0 +1,36 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package log contains the OpenTelemetry SDK implementation of the logging
+// bridge API of go.opentelemetry.io/otel/log.
+//
+// A LoggerProvider passes the records emitted by its Loggers to its
+// Processors, which export them with an Exporter of
+// go.opentelemetry.io/otel/sdk/export/log:
+//
+//	provider := log.NewLoggerProvider(
+//		log.WithResource(res),
+//		log.WithBatcher(exporter),
+//	)
+//	defer provider.Shutdown(ctx)
+//
+// Wrapping a Processor in a TraceBasedProcessor drops the records of the
+// spans that are not sampled, keeping the volume of the logs proportional
+// to the sampling of the traces:
+//
+//	processor := log.NewTraceBasedProcessor(
+//		log.NewBatchProcessor(exporter),
+//		log.WithSeverityThreshold(otellog.SeverityError),
+//	)
+package log // import "go.opentelemetry.io/otel/sdk/log"
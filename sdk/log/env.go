@@ -0,0 +1,50 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package log // import "go.opentelemetry.io/otel/sdk/log"
+
+import "go.opentelemetry.io/otel/sdk/internal"
+
+// Environment variable names
+const (
+	// The BatchTimeout of the BatchProcessor in milliseconds.
+	envBLRPScheduleDelay = "OTEL_BLRP_SCHEDULE_DELAY"
+	// The ExportTimeout of the BatchProcessor in milliseconds.
+	envBLRPExportTimeout = "OTEL_BLRP_EXPORT_TIMEOUT"
+	// The MaxQueueSize of the BatchProcessor.
+	envBLRPMaxQueueSize = "OTEL_BLRP_MAX_QUEUE_SIZE"
+	// The MaxExportBatchSize of the BatchProcessor.
+	envBLRPMaxExportBatchSize = "OTEL_BLRP_MAX_EXPORT_BATCH_SIZE"
+
+	// The maximum number of attributes per record.
+	envAttributeCountLimit = "OTEL_LOGRECORD_ATTRIBUTE_COUNT_LIMIT"
+	// The maximum length of the string attribute values of a record.
+	envAttributeValueLengthLimit = "OTEL_LOGRECORD_ATTRIBUTE_VALUE_LENGTH_LIMIT"
+)
+
+// applyEnvRecordLimits sets the limits of l configured by environment
+// variables.
+func applyEnvRecordLimits(l *RecordLimits) {
+	internal.IntEnv(envAttributeCountLimit, &l.AttributeCountLimit)
+	internal.IntEnv(envAttributeValueLengthLimit, &l.AttributeValueLengthLimit)
+}
+
+// applyEnvBatchProcessorOptions sets the options of o configured by
+// environment variables.
+func applyEnvBatchProcessorOptions(o *BatchProcessorOptions) {
+	internal.DurationEnv(envBLRPScheduleDelay, &o.BatchTimeout)
+	internal.DurationEnv(envBLRPExportTimeout, &o.ExportTimeout)
+	internal.IntEnv(envBLRPMaxQueueSize, &o.MaxQueueSize)
+	internal.IntEnv(envBLRPMaxExportBatchSize, &o.MaxExportBatchSize)
+}
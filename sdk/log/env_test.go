@@ -0,0 +1,69 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package log
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	ottest "go.opentelemetry.io/otel/internal/internaltest"
+)
+
+func setEnv(t *testing.T, env map[string]string) func() {
+	store, err := ottest.SetEnvVariables(env)
+	require.NoError(t, err)
+	return func() { require.NoError(t, store.Restore()) }
+}
+
+func TestLoggerProviderFromEnv(t *testing.T) {
+	defer setEnv(t, map[string]string{
+		envAttributeCountLimit:       "10",
+		envAttributeValueLengthLimit: "invalid",
+	})()
+
+	limits := NewLoggerProvider().limits
+	assert.Equal(t, 10, limits.AttributeCountLimit)
+	assert.Equal(t, DefaultAttributeValueLengthLimit, limits.AttributeValueLengthLimit)
+
+	// Options take precedence over the environment.
+	limits = NewLoggerProvider(WithRecordLimits(RecordLimits{AttributeValueLengthLimit: 5})).limits
+	assert.Equal(t, 10, limits.AttributeCountLimit)
+	assert.Equal(t, 5, limits.AttributeValueLengthLimit)
+}
+
+func TestBatchProcessorFromEnv(t *testing.T) {
+	defer setEnv(t, map[string]string{
+		envBLRPScheduleDelay:      "100",
+		envBLRPExportTimeout:      "-1",
+		envBLRPMaxQueueSize:       "10",
+		envBLRPMaxExportBatchSize: "5",
+	})()
+
+	p := NewBatchProcessor(nil)
+	defer func() { require.NoError(t, p.Shutdown(context.Background())) }()
+	assert.Equal(t, 100*time.Millisecond, p.o.BatchTimeout)
+	assert.Equal(t, DefaultExportTimeout, p.o.ExportTimeout)
+	assert.Equal(t, 10, p.o.MaxQueueSize)
+	assert.Equal(t, 5, p.o.MaxExportBatchSize)
+
+	p2 := NewBatchProcessor(nil, WithMaxQueueSize(50))
+	defer func() { require.NoError(t, p2.Shutdown(context.Background())) }()
+	assert.Equal(t, 50, p2.o.MaxQueueSize)
+	assert.Equal(t, 5, p2.o.MaxExportBatchSize)
+}
@@ -0,0 +1,92 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package log // import "go.opentelemetry.io/otel/sdk/log"
+
+import (
+	"go.opentelemetry.io/otel/label"
+)
+
+const (
+	// DefaultAttributeCountLimit is the default maximum number of
+	// attributes of a record.
+	DefaultAttributeCountLimit = 128
+	// DefaultAttributeValueLengthLimit is the default maximum length of
+	// the string attribute values of a record. It is negative as they are
+	// not truncated by default.
+	DefaultAttributeValueLengthLimit = -1
+)
+
+// RecordLimits bounds the size of the records emitted by the Loggers of a
+// LoggerProvider. A zero field uses the default value, and a negative one
+// removes the limit.
+type RecordLimits struct {
+	// AttributeCountLimit is the maximum number of attributes of a
+	// record. The attributes past the limit are dropped and counted in
+	// the DroppedAttributeCount of the record.
+	// The default value of AttributeCountLimit is 128.
+	AttributeCountLimit int
+
+	// AttributeValueLengthLimit is the maximum number of characters of
+	// the string attribute values of a record. Longer values are
+	// truncated.
+	// By default the values are not truncated.
+	AttributeValueLengthLimit int
+}
+
+// merge sets the non-zero fields of l in c.
+func (c *RecordLimits) merge(l RecordLimits) {
+	if l.AttributeCountLimit != 0 {
+		c.AttributeCountLimit = l.AttributeCountLimit
+	}
+	if l.AttributeValueLengthLimit != 0 {
+		c.AttributeValueLengthLimit = l.AttributeValueLengthLimit
+	}
+}
+
+// apply returns a copy of attrs within the limits, along with the number
+// of attributes dropped.
+func (c RecordLimits) apply(attrs []label.KeyValue) ([]label.KeyValue, int) {
+	n := len(attrs)
+	if c.AttributeCountLimit >= 0 && n > c.AttributeCountLimit {
+		n = c.AttributeCountLimit
+	}
+	if n == 0 {
+		return nil, len(attrs)
+	}
+	limited := make([]label.KeyValue, n)
+	copy(limited, attrs)
+	if c.AttributeValueLengthLimit >= 0 {
+		for i, kv := range limited {
+			if kv.Value.Type() == label.STRING {
+				limited[i].Value = label.StringValue(truncate(kv.Value.AsString(), c.AttributeValueLengthLimit))
+			}
+		}
+	}
+	return limited, len(attrs) - n
+}
+
+// truncate returns the first limit characters of s.
+func truncate(s string, limit int) string {
+	if len(s) <= limit {
+		return s
+	}
+	for i := range s {
+		if limit == 0 {
+			return s[:i]
+		}
+		limit--
+	}
+	return s
+}
@@ -0,0 +1,86 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package log
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"go.opentelemetry.io/otel/label"
+)
+
+func TestRecordLimitsApply(t *testing.T) {
+	attrs := []label.KeyValue{
+		label.String("a", "héllo"),
+		label.Int("b", 12345),
+		label.String("c", "world"),
+	}
+	tests := []struct {
+		name    string
+		limits  RecordLimits
+		want    []label.KeyValue
+		dropped int
+	}{
+		{
+			name:   "unlimited",
+			limits: RecordLimits{AttributeCountLimit: -1, AttributeValueLengthLimit: -1},
+			want:   attrs,
+		},
+		{
+			name:    "count",
+			limits:  RecordLimits{AttributeCountLimit: 2, AttributeValueLengthLimit: -1},
+			want:    attrs[:2],
+			dropped: 1,
+		},
+		{
+			name:    "none",
+			limits:  RecordLimits{AttributeCountLimit: 0, AttributeValueLengthLimit: -1},
+			dropped: 3,
+		},
+		{
+			name:   "length",
+			limits: RecordLimits{AttributeCountLimit: -1, AttributeValueLengthLimit: 2},
+			want: []label.KeyValue{
+				label.String("a", "hé"),
+				label.Int("b", 12345),
+				label.String("c", "wo"),
+			},
+		},
+		{
+			name:   "empty values",
+			limits: RecordLimits{AttributeCountLimit: -1, AttributeValueLengthLimit: 0},
+			want: []label.KeyValue{
+				label.String("a", ""),
+				label.Int("b", 12345),
+				label.String("c", ""),
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got, dropped := test.limits.apply(attrs)
+			assert.Equal(t, test.want, got)
+			assert.Equal(t, test.dropped, dropped)
+		})
+	}
+	assert.Equal(t, "héllo", attrs[0].Value.AsString(), "attributes modified")
+}
+
+func TestRecordLimitsMerge(t *testing.T) {
+	l := RecordLimits{AttributeCountLimit: 10, AttributeValueLengthLimit: 20}
+	l.merge(RecordLimits{AttributeValueLengthLimit: -1})
+	assert.Equal(t, RecordLimits{AttributeCountLimit: 10, AttributeValueLengthLimit: -1}, l)
+}
@@ -0,0 +1,76 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package log // import "go.opentelemetry.io/otel/sdk/log"
+
+import (
+	"context"
+
+	"go.opentelemetry.io/otel"
+	export "go.opentelemetry.io/otel/sdk/export/log"
+)
+
+// Processor is the interface a LoggerProvider uses to hook into the
+// records emitted by its Loggers.
+type Processor interface {
+	// OnEmit is called synchronously when a record is emitted. The record
+	// must not be modified.
+	OnEmit(r *export.Record)
+
+	// ForceFlush exports all the records that have not been exported
+	// yet.
+	ForceFlush(ctx context.Context) error
+
+	// Shutdown is called when the LoggerProvider is shut down. It exports
+	// all the records that have not been exported yet, then shuts down
+	// the exporter of the processor.
+	Shutdown(ctx context.Context) error
+}
+
+// SimpleProcessor is a Processor that synchronously exports every record
+// when it is emitted.
+type SimpleProcessor struct {
+	e export.Exporter
+}
+
+var _ Processor = (*SimpleProcessor)(nil)
+
+// NewSimpleProcessor returns a new SimpleProcessor exporting records with
+// exporter.
+func NewSimpleProcessor(exporter export.Exporter) *SimpleProcessor {
+	return &SimpleProcessor{e: exporter}
+}
+
+// OnEmit exports r.
+func (p *SimpleProcessor) OnEmit(r *export.Record) {
+	if p.e == nil {
+		return
+	}
+	if err := p.e.ExportLogs(context.Background(), []*export.Record{r}); err != nil {
+		otel.Handle(err)
+	}
+}
+
+// ForceFlush does nothing as there is no data to flush.
+func (p *SimpleProcessor) ForceFlush(context.Context) error {
+	return nil
+}
+
+// Shutdown shuts down the exporter of p.
+func (p *SimpleProcessor) Shutdown(ctx context.Context) error {
+	if p.e == nil {
+		return nil
+	}
+	return p.e.Shutdown(ctx)
+}
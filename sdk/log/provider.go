@@ -0,0 +1,206 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package log // import "go.opentelemetry.io/otel/sdk/log"
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/log"
+	"go.opentelemetry.io/otel/trace"
+
+	export "go.opentelemetry.io/otel/sdk/export/log"
+	"go.opentelemetry.io/otel/sdk/instrumentation"
+	"go.opentelemetry.io/otel/sdk/resource"
+)
+
+const defaultLoggerName = "go.opentelemetry.io/otel/sdk/logger"
+
+type loggerProviderConfig struct {
+	resource   *resource.Resource
+	processors []Processor
+	limits     RecordLimits
+}
+
+// LoggerProviderOption configures a LoggerProvider.
+type LoggerProviderOption func(*loggerProviderConfig)
+
+// WithResource sets the resource of the records emitted by the Loggers of
+// a LoggerProvider.
+func WithResource(r *resource.Resource) LoggerProviderOption {
+	return func(c *loggerProviderConfig) {
+		c.resource = r
+	}
+}
+
+// WithRecordLimits sets the limits of the records emitted by the Loggers
+// of a LoggerProvider. The zero fields of l keep their default value.
+func WithRecordLimits(l RecordLimits) LoggerProviderOption {
+	return func(c *loggerProviderConfig) {
+		c.limits.merge(l)
+	}
+}
+
+// WithProcessor registers p with a LoggerProvider.
+func WithProcessor(p Processor) LoggerProviderOption {
+	return func(c *loggerProviderConfig) {
+		c.processors = append(c.processors, p)
+	}
+}
+
+// WithSyncer registers a SimpleProcessor exporting records with e as soon
+// as they are emitted. It is meant for testing and debugging, use
+// WithBatcher in production.
+func WithSyncer(e export.Exporter) LoggerProviderOption {
+	return WithProcessor(NewSimpleProcessor(e))
+}
+
+// WithBatcher registers a BatchProcessor exporting records with e in
+// batches.
+func WithBatcher(e export.Exporter, opts ...BatchProcessorOption) LoggerProviderOption {
+	return WithProcessor(NewBatchProcessor(e, opts...))
+}
+
+// LoggerProvider is the SDK implementation of log.LoggerProvider. It
+// passes the records emitted by its Loggers to its Processors.
+type LoggerProvider struct {
+	resource   *resource.Resource
+	processors []Processor
+	limits     RecordLimits
+
+	mu          sync.Mutex
+	namedLogger map[instrumentation.Library]*logger
+	isShutdown  int32 // accessed atomically
+}
+
+var _ log.LoggerProvider = &LoggerProvider{}
+
+// NewLoggerProvider returns a LoggerProvider configured with opts.
+//
+// The default record limits are read from the
+// OTEL_LOGRECORD_ATTRIBUTE_COUNT_LIMIT and
+// OTEL_LOGRECORD_ATTRIBUTE_VALUE_LENGTH_LIMIT environment variables when
+// they are set. The options take precedence over them.
+func NewLoggerProvider(opts ...LoggerProviderOption) *LoggerProvider {
+	c := loggerProviderConfig{
+		limits: RecordLimits{
+			AttributeCountLimit:       DefaultAttributeCountLimit,
+			AttributeValueLengthLimit: DefaultAttributeValueLengthLimit,
+		},
+	}
+	applyEnvRecordLimits(&c.limits)
+	for _, opt := range opts {
+		opt(&c)
+	}
+	return &LoggerProvider{
+		resource:    c.resource,
+		processors:  c.processors,
+		limits:      c.limits,
+		namedLogger: make(map[instrumentation.Library]*logger),
+	}
+}
+
+// Logger returns the Logger of the instrumentation library with name and
+// the version and schema URL of opts, creating it if needed.
+func (p *LoggerProvider) Logger(name string, opts ...log.LoggerOption) log.Logger {
+	c := log.NewLoggerConfig(opts...)
+
+	if err := instrumentation.ValidateName(name); err != nil {
+		otel.Handle(err)
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if name == "" {
+		name = defaultLoggerName
+	}
+	il := instrumentation.Library{
+		Name:      name,
+		Version:   c.InstrumentationVersion,
+		SchemaURL: c.SchemaURL,
+	}
+	l, ok := p.namedLogger[il]
+	if !ok {
+		l = &logger{provider: p, instrumentationLibrary: il}
+		p.namedLogger[il] = l
+	}
+	return l
+}
+
+// ForceFlush exports the records buffered by all the processors of p.
+func (p *LoggerProvider) ForceFlush(ctx context.Context) error {
+	for _, proc := range p.processors {
+		if err := proc.ForceFlush(ctx); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Shutdown shuts down all the processors of p, after which its Loggers
+// drop all records. Only the first call shuts down the processors.
+func (p *LoggerProvider) Shutdown(ctx context.Context) error {
+	if !atomic.CompareAndSwapInt32(&p.isShutdown, 0, 1) {
+		return nil
+	}
+	for _, proc := range p.processors {
+		if err := proc.Shutdown(ctx); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+type logger struct {
+	provider               *LoggerProvider
+	instrumentationLibrary instrumentation.Library
+}
+
+var _ log.Logger = &logger{}
+
+// Emit passes r to the processors of the provider of l, along with the
+// span context of ctx.
+func (l *logger) Emit(ctx context.Context, r log.Record) {
+	if !l.Enabled(ctx, r.Severity) {
+		return
+	}
+	now := time.Now()
+	rec := &export.Record{
+		Timestamp:              r.Timestamp,
+		ObservedTimestamp:      now,
+		Severity:               r.Severity,
+		SeverityText:           r.SeverityText,
+		Body:                   r.Body,
+		SpanContext:            trace.SpanContextFromContext(ctx),
+		Resource:               l.provider.resource,
+		InstrumentationLibrary: l.instrumentationLibrary,
+	}
+	if rec.Timestamp.IsZero() {
+		rec.Timestamp = now
+	}
+	rec.Attributes, rec.DroppedAttributeCount = l.provider.limits.apply(r.Attributes)
+	for _, p := range l.provider.processors {
+		p.OnEmit(rec)
+	}
+}
+
+// Enabled returns whether the provider of l has processors and is not
+// shut down.
+func (l *logger) Enabled(context.Context, log.Severity) bool {
+	return len(l.provider.processors) > 0 && atomic.LoadInt32(&l.provider.isShutdown) == 0
+}
@@ -0,0 +1,189 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package log
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/label"
+	"go.opentelemetry.io/otel/log"
+
+	export "go.opentelemetry.io/otel/sdk/export/log"
+	"go.opentelemetry.io/otel/sdk/instrumentation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+)
+
+type storingHandler struct {
+	mu   sync.Mutex
+	errs []error
+}
+
+func (h *storingHandler) Handle(err error) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.errs = append(h.errs, err)
+}
+
+func (h *storingHandler) Reset() {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.errs = nil
+}
+
+var handler = &storingHandler{}
+
+func init() {
+	otel.SetErrorHandler(handler)
+}
+
+type testExporter struct {
+	mu       sync.Mutex
+	batches  [][]*export.Record
+	shutdown bool
+}
+
+func (e *testExporter) ExportLogs(_ context.Context, records []*export.Record) error {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	e.batches = append(e.batches, records)
+	return nil
+}
+
+func (e *testExporter) Shutdown(context.Context) error {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	e.shutdown = true
+	return nil
+}
+
+func (e *testExporter) records() []*export.Record {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	var records []*export.Record
+	for _, b := range e.batches {
+		records = append(records, b...)
+	}
+	return records
+}
+
+func TestLoggerEmit(t *testing.T) {
+	exp := new(testExporter)
+	res := resource.NewWithAttributes(label.String("service.name", "test"))
+	provider := NewLoggerProvider(WithResource(res), WithSyncer(exp))
+	logger := provider.Logger("test", log.WithInstrumentationVersion("v0.1.0"))
+	assert.True(t, logger.Enabled(context.Background(), log.SeverityInfo))
+
+	ctx, span := sdktrace.NewTracerProvider().Tracer("test").Start(context.Background(), "span")
+	defer span.End()
+	sc := span.SpanContext()
+	ts := time.Unix(100, 0)
+	attrs := []label.KeyValue{label.String("k", "v")}
+	logger.Emit(ctx, log.Record{
+		Timestamp:    ts,
+		Severity:     log.SeverityWarn,
+		SeverityText: "WARNING",
+		Body:         label.StringValue("message"),
+		Attributes:   attrs,
+	})
+	// The attributes are not retained.
+	attrs[0] = label.String("k", "changed")
+
+	records := exp.records()
+	require.Len(t, records, 1)
+	r := records[0]
+	assert.Equal(t, ts, r.Timestamp)
+	assert.False(t, r.ObservedTimestamp.IsZero())
+	assert.Equal(t, log.SeverityWarn, r.Severity)
+	assert.Equal(t, "WARNING", r.SeverityText)
+	assert.Equal(t, "message", r.Body.AsString())
+	assert.Equal(t, []label.KeyValue{label.String("k", "v")}, r.Attributes)
+	assert.Equal(t, sc, r.SpanContext)
+	assert.Equal(t, res, r.Resource)
+	assert.Equal(t, instrumentation.Library{Name: "test", Version: "v0.1.0"}, r.InstrumentationLibrary)
+}
+
+func TestLoggerEmitLimits(t *testing.T) {
+	exp := new(testExporter)
+	logger := NewLoggerProvider(
+		WithSyncer(exp),
+		WithRecordLimits(RecordLimits{AttributeCountLimit: 1, AttributeValueLengthLimit: 3}),
+	).Logger("test")
+
+	logger.Emit(context.Background(), log.Record{
+		Body:       label.StringValue("a body longer than the limit"),
+		Attributes: []label.KeyValue{label.String("k1", "value"), label.String("k2", "value")},
+	})
+
+	records := exp.records()
+	require.Len(t, records, 1)
+	assert.Equal(t, "a body longer than the limit", records[0].Body.AsString())
+	assert.Equal(t, []label.KeyValue{label.String("k1", "val")}, records[0].Attributes)
+	assert.Equal(t, 1, records[0].DroppedAttributeCount)
+}
+
+func TestLoggerEmitDefaultTimestamp(t *testing.T) {
+	exp := new(testExporter)
+	logger := NewLoggerProvider(WithSyncer(exp)).Logger("test")
+
+	before := time.Now()
+	logger.Emit(context.Background(), log.Record{Body: label.StringValue("message")})
+
+	records := exp.records()
+	require.Len(t, records, 1)
+	assert.False(t, records[0].Timestamp.Before(before))
+	assert.Equal(t, records[0].ObservedTimestamp, records[0].Timestamp)
+	assert.False(t, records[0].SpanContext.IsValid())
+}
+
+func TestLoggerProviderSameLogger(t *testing.T) {
+	provider := NewLoggerProvider()
+	assert.Same(t, provider.Logger("test"), provider.Logger("test"))
+	assert.NotSame(t, provider.Logger("test"), provider.Logger("test", log.WithInstrumentationVersion("v1")))
+	// Loggers without processors are disabled.
+	assert.False(t, provider.Logger("test").Enabled(context.Background(), log.SeverityFatal))
+}
+
+func TestLoggerProviderInvalidName(t *testing.T) {
+	provider := NewLoggerProvider()
+	for _, name := range []string{"", " padded ", "new\nline"} {
+		handler.Reset()
+		assert.NotNil(t, provider.Logger(name))
+		require.Len(t, handler.errs, 1, name)
+		assert.True(t, errors.Is(handler.errs[0], instrumentation.ErrInvalidName))
+	}
+}
+
+func TestLoggerProviderShutdown(t *testing.T) {
+	exp := new(testExporter)
+	provider := NewLoggerProvider(WithSyncer(exp))
+	logger := provider.Logger("test")
+
+	require.NoError(t, provider.Shutdown(context.Background()))
+	assert.True(t, exp.shutdown)
+	assert.False(t, logger.Enabled(context.Background(), log.SeverityInfo))
+
+	logger.Emit(context.Background(), log.Record{Body: label.StringValue("dropped")})
+	assert.Empty(t, exp.records())
+	require.NoError(t, provider.Shutdown(context.Background()))
+}
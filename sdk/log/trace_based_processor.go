@@ -0,0 +1,121 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package log // import "go.opentelemetry.io/otel/sdk/log"
+
+import (
+	"context"
+	"encoding/binary"
+
+	"go.opentelemetry.io/otel/log"
+	export "go.opentelemetry.io/otel/sdk/export/log"
+)
+
+// TraceBasedProcessorOption configures a TraceBasedProcessor.
+type TraceBasedProcessorOption func(o *TraceBasedProcessorOptions)
+
+// TraceBasedProcessorOptions are the options of a TraceBasedProcessor.
+type TraceBasedProcessorOptions struct {
+	// SeverityThreshold is the severity from which records are passed on
+	// even if their span is not sampled, so that errors are never lost.
+	// No record is passed on because of its severity if it is zero.
+	// The default value of SeverityThreshold is zero.
+	SeverityThreshold log.Severity
+
+	// UnsampledRatio is the fraction of the traces not sampled whose
+	// records are passed on anyway. The decision only depends on the
+	// trace ID, so all the records of a trace are passed on or dropped
+	// together.
+	// The default value of UnsampledRatio is zero.
+	UnsampledRatio float64
+}
+
+// WithSeverityThreshold sets the SeverityThreshold of a
+// TraceBasedProcessor.
+func WithSeverityThreshold(severity log.Severity) TraceBasedProcessorOption {
+	return func(o *TraceBasedProcessorOptions) {
+		o.SeverityThreshold = severity
+	}
+}
+
+// WithUnsampledRatio sets the UnsampledRatio of a TraceBasedProcessor.
+func WithUnsampledRatio(fraction float64) TraceBasedProcessorOption {
+	return func(o *TraceBasedProcessorOptions) {
+		o.UnsampledRatio = fraction
+	}
+}
+
+// TraceBasedProcessor is a Processor passing on to the next Processor the
+// records emitted in the context of a sampled span, and dropping most of
+// the others, so that the volume of the logs follows the sampling of the
+// traces. The records emitted outside of any span are always passed on.
+type TraceBasedProcessor struct {
+	next Processor
+	o    TraceBasedProcessorOptions
+
+	// traceIDUpperBound is the bound of the trace IDs whose records are
+	// passed on when they are not sampled.
+	traceIDUpperBound uint64
+}
+
+var _ Processor = (*TraceBasedProcessor)(nil)
+
+// NewTraceBasedProcessor returns a new TraceBasedProcessor passing on
+// records to next.
+func NewTraceBasedProcessor(next Processor, options ...TraceBasedProcessorOption) *TraceBasedProcessor {
+	var o TraceBasedProcessorOptions
+	for _, opt := range options {
+		opt(&o)
+	}
+	p := &TraceBasedProcessor{next: next, o: o}
+	switch {
+	case o.UnsampledRatio >= 1:
+		p.traceIDUpperBound = 1 << 63
+	case o.UnsampledRatio > 0:
+		p.traceIDUpperBound = uint64(o.UnsampledRatio * (1 << 63))
+	}
+	return p
+}
+
+// OnEmit passes r on to the next processor if it is kept.
+func (p *TraceBasedProcessor) OnEmit(r *export.Record) {
+	if p.keep(r) {
+		p.next.OnEmit(r)
+	}
+}
+
+// keep returns whether r is passed on to the next processor.
+func (p *TraceBasedProcessor) keep(r *export.Record) bool {
+	sc := r.SpanContext
+	if !sc.IsValid() || sc.IsSampled() {
+		return true
+	}
+	if p.o.SeverityThreshold != 0 && r.Severity >= p.o.SeverityThreshold {
+		return true
+	}
+	// The first half of the trace ID decides the sampling of the
+	// trace with TraceIDRatioBased samplers, the ratio is applied to
+	// the second half to be independent of it.
+	return binary.BigEndian.Uint64(sc.TraceID[8:16])>>1 < p.traceIDUpperBound
+}
+
+// ForceFlush flushes the next processor.
+func (p *TraceBasedProcessor) ForceFlush(ctx context.Context) error {
+	return p.next.ForceFlush(ctx)
+}
+
+// Shutdown shuts down the next processor.
+func (p *TraceBasedProcessor) Shutdown(ctx context.Context) error {
+	return p.next.Shutdown(ctx)
+}
@@ -0,0 +1,97 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package log
+
+import (
+	"context"
+	"encoding/binary"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.opentelemetry.io/otel/log"
+	"go.opentelemetry.io/otel/trace"
+
+	export "go.opentelemetry.io/otel/sdk/export/log"
+)
+
+func record(severity log.Severity, flags byte, traceID uint64) *export.Record {
+	sc := trace.SpanContext{SpanID: trace.SpanID{1}, TraceFlags: flags}
+	binary.BigEndian.PutUint64(sc.TraceID[:8], 1)
+	binary.BigEndian.PutUint64(sc.TraceID[8:], traceID)
+	return &export.Record{Severity: severity, SpanContext: sc}
+}
+
+func TestTraceBasedProcessor(t *testing.T) {
+	sampled := record(log.SeverityInfo, trace.FlagsSampled, 1)
+	unsampled := record(log.SeverityInfo, 0, 1)
+	unsampledError := record(log.SeverityError, 0, 1)
+	noSpan := &export.Record{Severity: log.SeverityDebug}
+
+	tests := []struct {
+		name    string
+		options []TraceBasedProcessorOption
+		want    []*export.Record
+	}{
+		{
+			name: "default",
+			want: []*export.Record{sampled, noSpan},
+		},
+		{
+			name:    "threshold",
+			options: []TraceBasedProcessorOption{WithSeverityThreshold(log.SeverityError)},
+			want:    []*export.Record{sampled, unsampledError, noSpan},
+		},
+		{
+			name:    "all unsampled",
+			options: []TraceBasedProcessorOption{WithUnsampledRatio(1)},
+			want:    []*export.Record{sampled, unsampled, unsampledError, noSpan},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			exp := new(testExporter)
+			p := NewTraceBasedProcessor(NewSimpleProcessor(exp), test.options...)
+			for _, r := range []*export.Record{sampled, unsampled, unsampledError, noSpan} {
+				p.OnEmit(r)
+			}
+			require.NoError(t, p.ForceFlush(context.Background()))
+			require.NoError(t, p.Shutdown(context.Background()))
+			assert.Equal(t, test.want, exp.records())
+			assert.True(t, exp.shutdown)
+		})
+	}
+}
+
+func TestTraceBasedProcessorUnsampledRatio(t *testing.T) {
+	exp := new(testExporter)
+	p := NewTraceBasedProcessor(NewSimpleProcessor(exp), WithUnsampledRatio(0.25))
+
+	const traces = 1000
+	for i := uint64(0); i < traces; i++ {
+		// Spread the trace IDs evenly.
+		id := i * (^uint64(0) / traces)
+		p.OnEmit(record(log.SeverityInfo, 0, id))
+		p.OnEmit(record(log.SeverityWarn, 0, id))
+	}
+	assert.InDelta(t, 2*traces/4, len(exp.records()), 4)
+
+	// The records of a trace are kept together.
+	records := exp.records()
+	for i := 0; i < len(records); i += 2 {
+		assert.Equal(t, records[i].SpanContext.TraceID, records[i+1].SpanContext.TraceID)
+	}
+}
@@ -0,0 +1,300 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package aggregatortest // import "go.opentelemetry.io/otel/sdk/metric/aggregator/aggregatortest"
+
+import (
+	"context"
+	"errors"
+	"math/rand"
+	"runtime"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"go.opentelemetry.io/otel/metric"
+	"go.opentelemetry.io/otel/metric/number"
+	export "go.opentelemetry.io/otel/sdk/export/metric"
+	"go.opentelemetry.io/otel/sdk/export/metric/aggregation"
+	"go.opentelemetry.io/otel/sdk/metric/aggregator"
+)
+
+// FuzzConfig configures FuzzTest.
+type FuzzConfig struct {
+	// Seed seeds the random values and operations. A random seed is
+	// used when it is 0, it is logged so failures can be reproduced.
+	Seed int64
+	// Updaters is the number of goroutines updating the aggregator.
+	// It defaults to 4.
+	Updaters int
+	// Updates is the number of updates made by each updater. It
+	// defaults to 1000.
+	Updates int
+}
+
+// FuzzTest checks that the aggregators made by nf aggregate correctly
+// when they are concurrently updated, moved and merged.
+//
+// For each number kind, the updaters of the configuration update an
+// aggregator with random values, while a collector repeatedly moves its
+// content with SynchronizedMove into new aggregators, that it merges in
+// random order into the checkpoint it accumulates.  Once the updaters
+// are done, the remaining values are moved and the checkpoint is
+// checked against the values that passed the range test of the SDK: the
+// Count, Sum, Min, Max, Points and Histogram of the aggregations
+// implementing them must match the values, and the LastValue must be one
+// of them.  The aggregator must be empty after its final move.
+//
+// It is exported for the authors of custom aggregators to check them
+// with, for example:
+//
+//	func TestFuzz(t *testing.T) {
+//		aggregatortest.FuzzTest(t, metric.ValueRecorderInstrumentKind,
+//			func(desc *metric.Descriptor) export.Aggregator {
+//				return &New(1, desc)[0]
+//			},
+//			aggregatortest.FuzzConfig{},
+//		)
+//	}
+func FuzzTest(t *testing.T, mkind metric.InstrumentKind, nf func(*metric.Descriptor) export.Aggregator, cfg FuzzConfig) {
+	if cfg.Seed == 0 {
+		cfg.Seed = time.Now().UnixNano()
+	}
+	if cfg.Updaters <= 0 {
+		cfg.Updaters = 4
+	}
+	if cfg.Updates <= 0 {
+		cfg.Updates = 1000
+	}
+	t.Logf("fuzzing with seed %d", cfg.Seed)
+
+	for _, nkind := range []number.Kind{number.Int64Kind, number.Float64Kind} {
+		t.Run(nkind.String(), func(t *testing.T) {
+			fuzz(t, NewAggregatorTest(mkind, nkind), nf, cfg)
+		})
+	}
+}
+
+// fuzz runs FuzzTest for the instrument described by desc.
+func fuzz(t *testing.T, desc *metric.Descriptor, nf func(*metric.Descriptor) export.Aggregator, cfg FuzzConfig) {
+	ctx := context.Background()
+	agg := nf(desc)
+
+	// The values recorded by each updater, and the errors of all the
+	// goroutines, are only read once they are done.
+	recorded := make([]Numbers, cfg.Updaters)
+	errs := make(chan error, cfg.Updaters+1)
+
+	var updaters sync.WaitGroup
+	for u := 0; u < cfg.Updaters; u++ {
+		updaters.Add(1)
+		go func(u int) {
+			defer updaters.Done()
+			rnd := rand.New(rand.NewSource(cfg.Seed + int64(u)))
+			recorded[u] = NewNumbers(desc.NumberKind())
+			for i := 0; i < cfg.Updates; i++ {
+				n := randomNumber(rnd, desc.NumberKind())
+				if aggregator.RangeTest(n, desc) != nil {
+					continue
+				}
+				if err := agg.Update(ctx, n, desc); err != nil {
+					errs <- err
+					return
+				}
+				recorded[u].Append(n)
+				if rnd.Intn(10) == 0 {
+					runtime.Gosched()
+				}
+			}
+		}(u)
+	}
+
+	done := make(chan struct{})
+	go func() {
+		updaters.Wait()
+		close(done)
+	}()
+
+	// The collector moves the content of agg into new aggregators and
+	// merges them into total, either directly or through pending, in
+	// random order.
+	rnd := rand.New(rand.NewSource(cfg.Seed - 1))
+	total := nf(desc)
+	var pending []export.Aggregator
+	collect := func() error {
+		ckpt := nf(desc)
+		if err := agg.SynchronizedMove(ckpt, desc); err != nil {
+			return err
+		}
+		pending = append(pending, ckpt)
+		for len(pending) > 0 && rnd.Intn(2) == 0 {
+			i := rnd.Intn(len(pending))
+			into := total
+			if j := rnd.Intn(len(pending)); j != i && rnd.Intn(2) == 0 {
+				into = pending[j]
+			}
+			if err := into.Merge(pending[i], desc); err != nil {
+				return err
+			}
+			pending = append(pending[:i], pending[i+1:]...)
+		}
+		return nil
+	}
+
+	for running := true; running; {
+		select {
+		case <-done:
+			running = false
+		default:
+			if err := collect(); err != nil {
+				errs <- err
+				running = false
+			}
+			runtime.Gosched()
+		}
+	}
+	updaters.Wait()
+	if err := collect(); err != nil {
+		errs <- err
+	}
+	for _, ckpt := range pending {
+		if err := total.Merge(ckpt, desc); err != nil {
+			errs <- err
+		}
+	}
+
+	close(errs)
+	for err := range errs {
+		require.NoError(t, err)
+	}
+
+	all := NewNumbers(desc.NumberKind())
+	for _, r := range recorded {
+		for _, n := range r.Points() {
+			all.Append(n)
+		}
+	}
+	all.Sort()
+	checkAggregation(t, desc, total, &all)
+	checkEmpty(t, desc, agg)
+}
+
+// randomNumber returns a random number of kind nkind, of either sign.
+func randomNumber(rnd *rand.Rand, nkind number.Kind) number.Number {
+	sign := rnd.Intn(2)*2 - 1
+	if nkind == number.Int64Kind {
+		return number.NewInt64Number(int64(sign) * int64(rnd.Intn(Magnitude+1)))
+	}
+	return number.NewFloat64Number(float64(sign) * rnd.Float64() * Magnitude)
+}
+
+// checkAggregation checks that the aggregation of agg matches the sorted
+// values of all.
+func checkAggregation(t *testing.T, desc *metric.Descriptor, agg export.Aggregator, all *Numbers) {
+	kind := desc.NumberKind()
+	a := agg.Aggregation()
+
+	if count, ok := a.(aggregation.Count); ok {
+		c, err := count.Count()
+		require.NoError(t, err)
+		require.Equal(t, all.Count(), c, "Count")
+	}
+
+	if sum, ok := a.(aggregation.Sum); ok {
+		s, err := sum.Sum()
+		require.NoError(t, err)
+		if want := all.Sum(); kind == number.Int64Kind {
+			require.Equal(t, want.AsInt64(), s.AsInt64(), "Sum")
+		} else {
+			require.InDelta(t, want.AsFloat64(), s.AsFloat64(), 1e-6*Magnitude, "Sum")
+		}
+	}
+
+	if min, ok := a.(aggregation.Min); ok {
+		m, err := min.Min()
+		if all.Len() == 0 {
+			require.True(t, errors.Is(err, aggregation.ErrNoData), "Min")
+		} else {
+			require.NoError(t, err)
+			require.Equal(t, all.Min(), m, "Min")
+		}
+	}
+
+	if max, ok := a.(aggregation.Max); ok {
+		m, err := max.Max()
+		if all.Len() == 0 {
+			require.True(t, errors.Is(err, aggregation.ErrNoData), "Max")
+		} else {
+			require.NoError(t, err)
+			require.Equal(t, all.Max(), m, "Max")
+		}
+	}
+
+	if points, ok := a.(aggregation.Points); ok {
+		pts, err := points.Points()
+		require.NoError(t, err)
+		got := NewNumbers(kind)
+		for _, p := range pts {
+			got.Append(p.Number)
+		}
+		got.Sort()
+		require.Equal(t, all.Points(), got.Points(), "Points")
+	}
+
+	if histogram, ok := a.(aggregation.Histogram); ok {
+		buckets, err := histogram.Histogram()
+		require.NoError(t, err)
+		require.Len(t, buckets.Counts, len(buckets.Boundaries)+1)
+		var total uint64
+		for i, c := range buckets.Counts {
+			var want uint64
+			for _, n := range all.Points() {
+				if bucketOf(buckets.Boundaries, n.CoerceToFloat64(kind)) == i {
+					want++
+				}
+			}
+			require.Equal(t, want, c, "Histogram bucket %d", i)
+			total += c
+		}
+		require.Equal(t, all.Count(), total, "Histogram total")
+	}
+
+	if lastValue, ok := a.(aggregation.LastValue); ok {
+		v, _, err := lastValue.LastValue()
+		if all.Len() == 0 {
+			require.True(t, errors.Is(err, aggregation.ErrNoData), "LastValue")
+		} else {
+			require.NoError(t, err)
+			require.Contains(t, all.Points(), v, "LastValue")
+		}
+	}
+}
+
+// checkEmpty checks that agg holds no value.
+func checkEmpty(t *testing.T, desc *metric.Descriptor, agg export.Aggregator) {
+	empty := NewNumbers(desc.NumberKind())
+	checkAggregation(t, desc, agg, &empty)
+}
+
+// bucketOf returns the index of the histogram bucket of v.
+func bucketOf(boundaries []float64, v float64) int {
+	for i, b := range boundaries {
+		if v < b {
+			return i
+		}
+	}
+	return len(boundaries)
+}
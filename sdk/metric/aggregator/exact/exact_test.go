@@ -299,6 +299,17 @@ func TestSynchronizedMoveReset(t *testing.T) {
 	)
 }
 
+func TestFuzz(t *testing.T) {
+	aggregatortest.FuzzTest(
+		t,
+		metric.ValueRecorderInstrumentKind,
+		func(desc *metric.Descriptor) export.Aggregator {
+			return &New(1)[0]
+		},
+		aggregatortest.FuzzConfig{},
+	)
+}
+
 func TestMergeBehavior(t *testing.T) {
 	aggregatortest.RunProfiles(t, func(t *testing.T, profile aggregatortest.Profile) {
 		for _, forward := range []bool{false, true} {
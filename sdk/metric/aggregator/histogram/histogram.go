@@ -164,26 +164,7 @@ func (c *Aggregator) clearState() {
 // Update adds the recorded measurement to the current data set.
 func (c *Aggregator) Update(_ context.Context, number number.Number, desc *metric.Descriptor) error {
 	kind := desc.NumberKind()
-	asFloat := number.CoerceToFloat64(kind)
-
-	bucketID := len(c.boundaries)
-	for i, boundary := range c.boundaries {
-		if asFloat < boundary {
-			bucketID = i
-			break
-		}
-	}
-	// Note: Binary-search was compared using the benchmarks. The following
-	// code is equivalent to the linear search above:
-	//
-	//     bucketID := sort.Search(len(c.boundaries), func(i int) bool {
-	//         return asFloat < c.boundaries[i]
-	//     })
-	//
-	// The binary search wins for very large boundary sets, but
-	// the linear search performs better up through arrays between
-	// 256 and 512 elements, which is a relatively large histogram, so we
-	// continue to prefer linear search.
+	bucketID := bucketIndex(c.boundaries, number.CoerceToFloat64(kind))
 
 	c.lock.Lock()
 	defer c.lock.Unlock()
@@ -195,6 +176,38 @@ func (c *Aggregator) Update(_ context.Context, number number.Number, desc *metri
 	return nil
 }
 
+// linearSearchMaxBoundaries is the number of boundaries up to which
+// bucketIndex scans the boundaries linearly.  Past it, a binary search
+// performs better in the benchmarks.
+const linearSearchMaxBoundaries = 64
+
+// bucketIndex returns the index of the bucket counting the value: the
+// index of the first boundary greater than the value, or the number of
+// boundaries if there is none.  The boundaries must be sorted.
+func bucketIndex(boundaries []float64, value float64) int {
+	if len(boundaries) <= linearSearchMaxBoundaries {
+		for i, boundary := range boundaries {
+			if value < boundary {
+				return i
+			}
+		}
+		return len(boundaries)
+	}
+
+	// This is sort.Search without the indirect call of its
+	// predicate.
+	lo, hi := 0, len(boundaries)
+	for lo < hi {
+		mid := int(uint(lo+hi) >> 1)
+		if value < boundaries[mid] {
+			hi = mid
+		} else {
+			lo = mid + 1
+		}
+	}
+	return lo
+}
+
 // Merge combines two histograms that have the same buckets into a single one.
 func (c *Aggregator) Merge(oa export.Aggregator, desc *metric.Descriptor) error {
 	o, _ := oa.(*Aggregator)
@@ -15,6 +15,7 @@
 package histogram_test
 
 import (
+	"context"
 	"math"
 	"math/rand"
 	"sort"
@@ -176,6 +177,38 @@ func TestHistogramMerge(t *testing.T) {
 	})
 }
 
+func TestHistogramManyBoundaries(t *testing.T) {
+	aggregatortest.RunProfiles(t, func(t *testing.T, profile aggregatortest.Profile) {
+		descriptor := aggregatortest.NewAggregatorTest(metric.ValueRecorderInstrumentKind, profile.NumberKind)
+
+		// Enough boundaries for the buckets to be searched with a
+		// binary search.
+		many := make([]float64, 1000)
+		for i := range many {
+			many[i] = float64(i * 10)
+		}
+		agg := &histogram.New(1, descriptor, many)[0]
+		ctx := context.Background()
+
+		values := []float64{-1, 0, 5, 10, 4995, 9990, 10000}
+		for _, v := range values {
+			n := number.NewFloat64Number(v)
+			if profile.NumberKind == number.Int64Kind {
+				n = number.NewInt64Number(int64(v))
+			}
+			require.NoError(t, agg.Update(ctx, n, descriptor))
+		}
+
+		buckets, err := agg.Histogram()
+		require.NoError(t, err)
+		want := make([]uint64, len(many)+1)
+		for _, v := range values {
+			want[sort.Search(len(many), func(i int) bool { return v < many[i] })]++
+		}
+		require.Equal(t, want, buckets.Counts)
+	})
+}
+
 func TestHistogramNotSet(t *testing.T) {
 	aggregatortest.RunProfiles(t, func(t *testing.T, profile aggregatortest.Profile) {
 		descriptor := aggregatortest.NewAggregatorTest(metric.ValueRecorderInstrumentKind, profile.NumberKind)
@@ -244,3 +277,14 @@ func TestSynchronizedMoveReset(t *testing.T) {
 		},
 	)
 }
+
+func TestFuzz(t *testing.T) {
+	aggregatortest.FuzzTest(
+		t,
+		metric.ValueRecorderInstrumentKind,
+		func(desc *metric.Descriptor) export.Aggregator {
+			return &histogram.New(1, desc, boundaries)[0]
+		},
+		aggregatortest.FuzzConfig{},
+	)
+}
@@ -246,3 +246,14 @@ func TestSynchronizedMoveReset(t *testing.T) {
 		},
 	)
 }
+
+func TestFuzz(t *testing.T) {
+	aggregatortest.FuzzTest(
+		t,
+		metric.ValueRecorderInstrumentKind,
+		func(desc *metric.Descriptor) export.Aggregator {
+			return &New(1, desc)[0]
+		},
+		aggregatortest.FuzzConfig{},
+	)
+}
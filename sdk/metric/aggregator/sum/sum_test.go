@@ -152,3 +152,14 @@ func TestSynchronizedMoveReset(t *testing.T) {
 		},
 	)
 }
+
+func TestFuzz(t *testing.T) {
+	aggregatortest.FuzzTest(
+		t,
+		metric.UpDownCounterInstrumentKind,
+		func(desc *metric.Descriptor) export.Aggregator {
+			return &New(1)[0]
+		},
+		aggregatortest.FuzzConfig{},
+	)
+}
@@ -18,14 +18,17 @@ import (
 	"context"
 	"fmt"
 	"math/rand"
+	"sync/atomic"
 	"testing"
 
 	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/internal/telemetrygen"
 	"go.opentelemetry.io/otel/label"
 	"go.opentelemetry.io/otel/metric"
 	export "go.opentelemetry.io/otel/sdk/export/metric"
 	sdk "go.opentelemetry.io/otel/sdk/metric"
 	"go.opentelemetry.io/otel/sdk/metric/processor/processortest"
+	"go.opentelemetry.io/otel/sdk/metric/selector/simple"
 )
 
 type benchFixture struct {
@@ -240,6 +243,74 @@ func BenchmarkInt64CounterAdd(b *testing.B) {
 	}
 }
 
+func BenchmarkInt64CounterAddParallel(b *testing.B) {
+	ctx := context.Background()
+	fix := newFixture(b)
+	labs := makeLabels(1)
+	cnt := fix.meterMust().NewInt64Counter("int64.sum")
+
+	b.ResetTimer()
+
+	b.RunParallel(func(pb *testing.PB) {
+		for pb.Next() {
+			cnt.Add(ctx, 1, labs...)
+		}
+	})
+}
+
+func BenchmarkGeneratedLoad(b *testing.B) {
+	fix := newFixture(b)
+	fix.AggregatorSelector = simple.NewWithInexpensiveDistribution()
+
+	b.ResetTimer()
+	stats, err := telemetrygen.GenerateMetrics(context.Background(), fix, telemetrygen.MetricConfig{
+		Load:           telemetrygen.Load{Workers: 4, Iterations: (b.N + 3) / 4},
+		Counters:       4,
+		ValueRecorders: 4,
+		Cardinality:    100,
+		Labels:         4,
+	})
+	if err != nil {
+		b.Fatal(err)
+	}
+	b.ReportMetric(stats.PerSecond(), "measurements/s")
+}
+
+func BenchmarkInt64CounterAddParallelCollect(b *testing.B) {
+	ctx := context.Background()
+	fix := newFixture(b)
+	cnt := fix.meterMust().NewInt64Counter("int64.sum")
+
+	stop := make(chan struct{})
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		for {
+			select {
+			case <-stop:
+				return
+			default:
+				fix.accumulator.Collect(ctx)
+			}
+		}
+	}()
+
+	b.ResetTimer()
+
+	var goroutines int64
+	b.RunParallel(func(pb *testing.PB) {
+		// Every goroutine records to its own stable label set.
+		labs := []label.KeyValue{label.Int64("g", atomic.AddInt64(&goroutines, 1))}
+		for pb.Next() {
+			cnt.Add(ctx, 1, labs...)
+		}
+	})
+
+	b.StopTimer()
+	close(stop)
+	<-done
+}
+
 func BenchmarkInt64CounterHandleAdd(b *testing.B) {
 	ctx := context.Background()
 	fix := newFixture(b)
@@ -513,6 +584,60 @@ func BenchmarkBatchRecord_8Labels_8Instruments(b *testing.B) {
 	benchmarkBatchRecord8Labels(b, 8)
 }
 
+func benchmarkBatchRecordWithSet8Labels(b *testing.B, numInst int) {
+	const numLabels = 8
+	ctx := context.Background()
+	fix := newFixture(b)
+	labs := label.NewSet(makeLabels(numLabels)...)
+	var meas []metric.Measurement
+
+	for i := 0; i < numInst; i++ {
+		inst := fix.meterMust().NewInt64Counter(fmt.Sprintf("int64.%d.sum", i))
+		meas = append(meas, inst.Measurement(1))
+	}
+
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		fix.accumulator.RecordBatchWithSet(ctx, &labs, meas...)
+	}
+}
+
+func BenchmarkBatchRecordWithSet_8Labels_1Instrument(b *testing.B) {
+	benchmarkBatchRecordWithSet8Labels(b, 1)
+}
+
+func BenchmarkBatchRecordWithSet_8Labels_8Instruments(b *testing.B) {
+	benchmarkBatchRecordWithSet8Labels(b, 8)
+}
+
+func benchmarkBatchRecordWithHandle8Labels(b *testing.B, numInst int) {
+	const numLabels = 8
+	ctx := context.Background()
+	fix := newFixture(b)
+	h := label.NewHandle(makeLabels(numLabels)...)
+	var meas []metric.Measurement
+
+	for i := 0; i < numInst; i++ {
+		inst := fix.meterMust().NewInt64Counter(fmt.Sprintf("int64.%d.sum", i))
+		meas = append(meas, inst.Measurement(1))
+	}
+
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		fix.accumulator.RecordBatchWithSet(ctx, h.Set(), meas...)
+	}
+}
+
+func BenchmarkBatchRecordWithHandle_8Labels_1Instrument(b *testing.B) {
+	benchmarkBatchRecordWithHandle8Labels(b, 1)
+}
+
+func BenchmarkBatchRecordWithHandle_8Labels_8Instruments(b *testing.B) {
+	benchmarkBatchRecordWithHandle8Labels(b, 8)
+}
+
 // Record creation
 
 func BenchmarkRepeatedDirectCalls(b *testing.B) {
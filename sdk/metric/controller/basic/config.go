@@ -18,6 +18,8 @@ import (
 	"time"
 
 	export "go.opentelemetry.io/otel/sdk/export/metric"
+	"go.opentelemetry.io/otel/sdk/internal"
+	controllerTime "go.opentelemetry.io/otel/sdk/metric/controller/time"
 	"go.opentelemetry.io/otel/sdk/resource"
 )
 
@@ -56,6 +58,22 @@ type Config struct {
 	//
 	// Default value is 10s.  If zero, no Export timeout is applied.
 	PushTimeout time.Duration
+
+	// CoarseClockGranularity is the granularity of the coarse clock
+	// used for the collection timestamps of the Controller, which
+	// caches the current time instead of calling time.Now each time
+	// it is read.
+	//
+	// Default value is 0, the Controller does not use a coarse clock.
+	CoarseClockGranularity time.Duration
+
+	// Clock provides the collection timestamps and the ticker of the
+	// Controller.  It can be replaced by a mock clock to advance time
+	// manually in tests.  It takes precedence over
+	// CoarseClockGranularity.
+	//
+	// Default value is nil, the Controller uses the real clock.
+	Clock controllerTime.Clock
 }
 
 // Option is the interface that applies the value to a configuration option.
@@ -118,3 +136,30 @@ type pushTimeoutOption time.Duration
 func (o pushTimeoutOption) Apply(config *Config) {
 	config.PushTimeout = time.Duration(o)
 }
+
+// WithCoarseClock sets the CoarseClockGranularity configuration option
+// of a Config.  A non-positive granularity is replaced by one
+// millisecond.
+func WithCoarseClock(granularity time.Duration) Option {
+	if granularity <= 0 {
+		granularity = internal.DefaultCoarseClockGranularity
+	}
+	return coarseClockOption(granularity)
+}
+
+type coarseClockOption time.Duration
+
+func (o coarseClockOption) Apply(config *Config) {
+	config.CoarseClockGranularity = time.Duration(o)
+}
+
+// WithClock sets the Clock configuration option of a Config.
+func WithClock(clock controllerTime.Clock) Option {
+	return clockOption{clock}
+}
+
+type clockOption struct{ controllerTime.Clock }
+
+func (o clockOption) Apply(config *Config) {
+	config.Clock = o.Clock
+}
@@ -24,6 +24,7 @@ import (
 	"go.opentelemetry.io/otel/metric"
 	"go.opentelemetry.io/otel/metric/registry"
 	export "go.opentelemetry.io/otel/sdk/export/metric"
+	"go.opentelemetry.io/otel/sdk/instrumentation"
 	sdk "go.opentelemetry.io/otel/sdk/metric"
 	controllerTime "go.opentelemetry.io/otel/sdk/metric/controller/time"
 )
@@ -64,6 +65,10 @@ type Controller struct {
 	clock        controllerTime.Clock
 	ticker       controllerTime.Ticker
 
+	// stopClock stops the coarse clock created for the controller, it
+	// is nil if the controller did not create its clock.
+	stopClock func()
+
 	collectPeriod  time.Duration
 	collectTimeout time.Duration
 	pushTimeout    time.Duration
@@ -71,17 +76,25 @@ type Controller struct {
 	// collectedTime is used only in configurations with no
 	// pusher, when ticker != nil.
 	collectedTime time.Time
+
+	stats pipelineStats
 }
 
 // New constructs a Controller using the provided checkpointer and
 // options (including optional Pusher) to configure a metric
 // export pipeline.
+//
+// The default CollectPeriod and PushTimeout are read in milliseconds
+// from the OTEL_METRIC_EXPORT_INTERVAL and OTEL_METRIC_EXPORT_TIMEOUT
+// environment variables when they are set.  The options take precedence
+// over them.
 func New(checkpointer export.Checkpointer, opts ...Option) *Controller {
 	c := &Config{
 		CollectPeriod:  DefaultPeriod,
 		CollectTimeout: DefaultPeriod,
 		PushTimeout:    DefaultPeriod,
 	}
+	applyEnv(c)
 	for _, opt := range opts {
 		opt.Apply(c)
 	}
@@ -90,13 +103,23 @@ func New(checkpointer export.Checkpointer, opts ...Option) *Controller {
 		checkpointer,
 		c.Resource,
 	)
+	var clock controllerTime.Clock = controllerTime.RealClock{}
+	var stopClock func()
+	switch {
+	case c.Clock != nil:
+		clock = c.Clock
+	case c.CoarseClockGranularity > 0:
+		cc := controllerTime.NewCoarseClock(c.CoarseClockGranularity)
+		clock, stopClock = cc, cc.Stop
+	}
 	return &Controller{
 		provider:     registry.NewMeterProvider(impl),
 		accumulator:  impl,
 		checkpointer: checkpointer,
 		pusher:       c.Pusher,
 		stopCh:       nil,
-		clock:        controllerTime.RealClock{},
+		clock:        clock,
+		stopClock:    stopClock,
 
 		collectPeriod:  c.CollectPeriod,
 		collectTimeout: c.CollectTimeout,
@@ -105,7 +128,8 @@ func New(checkpointer export.Checkpointer, opts ...Option) *Controller {
 }
 
 // SetClock supports setting a mock clock for testing.  This must be
-// called before Start().
+// called before Start().  Prefer the WithClock option, which sets the
+// clock as the Controller is created.
 func (c *Controller) SetClock(clock controllerTime.Clock) {
 	c.lock.Lock()
 	defer c.lock.Unlock()
@@ -114,7 +138,21 @@ func (c *Controller) SetClock(clock controllerTime.Clock) {
 
 // MeterProvider returns a MeterProvider instance for this controller.
 func (c *Controller) MeterProvider() metric.MeterProvider {
-	return c.provider
+	return meterProvider{c.provider}
+}
+
+// meterProvider reports invalid instrumentation library names to the global
+// error handler before returning a Meter.
+type meterProvider struct {
+	*registry.MeterProvider
+}
+
+// Meter implements metric.MeterProvider.
+func (p meterProvider) Meter(instrumentationName string, opts ...metric.MeterOption) metric.Meter {
+	if err := instrumentation.ValidateName(instrumentationName); err != nil {
+		otel.Handle(err)
+	}
+	return p.MeterProvider.Meter(instrumentationName, opts...)
 }
 
 // Start begins a ticker that periodically collects and exports
@@ -147,13 +185,19 @@ func (c *Controller) Start(ctx context.Context) error {
 // Stop waits for the background goroutine to return and then collects
 // and exports metrics one last time before returning.  The passed
 // context is passed to the final Collect() and subsequently to the
-// final asynchronous instruments.
+// final asynchronous instruments.  Stop also stops the coarse clock
+// created for the controller by the CoarseClockGranularity option,
+// whether it was started or not.
 //
 // Note that Stop() will not cancel an ongoing collection or export.
 func (c *Controller) Stop(ctx context.Context) error {
 	c.lock.Lock()
 	defer c.lock.Unlock()
 
+	if c.stopClock != nil {
+		defer c.stopClock()
+	}
+
 	if c.stopCh == nil {
 		return nil
 	}
@@ -220,7 +264,9 @@ func (c *Controller) checkpoint(ctx context.Context, cond func() bool) error {
 		defer cancel()
 	}
 
-	_ = c.accumulator.Collect(ctx)
+	start := c.clock.Now()
+	records := c.accumulator.Collect(ctx)
+	c.stats.collected(start, c.clock.Now().Sub(start), records)
 
 	var err error
 	select {
@@ -255,7 +301,10 @@ func (c *Controller) export(ctx context.Context) error {
 		defer cancel()
 	}
 
-	return c.pusher.Export(ctx, ckpt)
+	start := c.clock.Now()
+	err := c.pusher.Export(ctx, ckpt)
+	c.stats.exported(start, c.clock.Now().Sub(start), err)
+	return err
 }
 
 // Foreach gives the caller read-locked access to the current
@@ -24,12 +24,15 @@ import (
 
 	"go.opentelemetry.io/otel/label"
 	"go.opentelemetry.io/otel/metric"
+	"go.opentelemetry.io/otel/oteltest"
 	export "go.opentelemetry.io/otel/sdk/export/metric"
 	"go.opentelemetry.io/otel/sdk/export/metric/aggregation"
+	"go.opentelemetry.io/otel/sdk/instrumentation"
 	controller "go.opentelemetry.io/otel/sdk/metric/controller/basic"
 	"go.opentelemetry.io/otel/sdk/metric/controller/controllertest"
 	processor "go.opentelemetry.io/otel/sdk/metric/processor/basic"
 	"go.opentelemetry.io/otel/sdk/metric/processor/processortest"
+	"go.opentelemetry.io/otel/sdk/metric/selector/simple"
 )
 
 func getMap(t *testing.T, cont *controller.Controller) map[string]float64 {
@@ -55,6 +58,16 @@ func checkTestContext(t *testing.T, ctx context.Context) {
 	require.Equal(t, "B", ctx.Value(testContextKey("A")))
 }
 
+func TestMeterProviderFollowsExpectedAPIBehaviour(t *testing.T) {
+	oteltest.NewHarness(t).TestMeterProvider(func() metric.MeterProvider {
+		cont := controller.New(processor.New(
+			simple.NewWithInexpensiveDistribution(),
+			export.CumulativeExportKindSelector(),
+		))
+		return cont.MeterProvider()
+	})
+}
+
 func TestStartNoExporter(t *testing.T) {
 	cont := controller.New(
 		processor.New(
@@ -350,3 +363,20 @@ func TestCollectAfterStopThenStartAgain(t *testing.T) {
 		"one.lastvalue//": 6,
 	}, exp.Values())
 }
+
+func TestMeterProviderInvalidName(t *testing.T) {
+	cont := controller.New(newCheckpointer())
+	require.NoError(t, testHandler.Flush())
+
+	_ = cont.MeterProvider().Meter("go.opentelemetry.io/otel/sdk/metric/controller/basic")
+	require.NoError(t, testHandler.Flush())
+
+	meter := cont.MeterProvider().Meter("invalid\nname")
+	require.True(t, errors.Is(testHandler.Flush(), instrumentation.ErrInvalidName))
+
+	// The Meter is still usable.
+	counter := metric.Must(meter).NewInt64Counter("counter.sum")
+	counter.Add(context.Background(), 1)
+	require.NoError(t, cont.Collect(context.Background()))
+	require.EqualValues(t, map[string]float64{"counter.sum//": 1}, getMap(t, cont))
+}
@@ -0,0 +1,176 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package basic // import "go.opentelemetry.io/otel/sdk/metric/controller/basic"
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+	"sync"
+
+	"go.opentelemetry.io/otel"
+	export "go.opentelemetry.io/otel/sdk/export/metric"
+	"go.opentelemetry.io/otel/sdk/internal"
+)
+
+// Environment variable names
+const (
+	// The CollectPeriod of the Controller in milliseconds.
+	envExportInterval = "OTEL_METRIC_EXPORT_INTERVAL"
+	// The PushTimeout of the Controller in milliseconds.
+	envExportTimeout = "OTEL_METRIC_EXPORT_TIMEOUT"
+	// The name of the exporter used by WithPusherFromEnv.
+	envExporter = "OTEL_METRICS_EXPORTER"
+)
+
+const (
+	// none is the exporter name that disables exporting.
+	none = "none"
+	// defaultExporter is the exporter name used when OTEL_METRICS_EXPORTER
+	// is not set. The go.opentelemetry.io/otel/exporters/autoexport
+	// module registers it.
+	defaultExporter = "otlp"
+)
+
+var (
+	// errUnknownExporter is returned when an exporter name is not
+	// registered.
+	errUnknownExporter = errors.New("unknown metric exporter")
+	// errDuplicateExporter is returned when an exporter name is
+	// already registered.
+	errDuplicateExporter = errors.New("duplicate metric exporter registration")
+	// errInvalidExporter is returned when an exporter cannot be
+	// registered.
+	errInvalidExporter = errors.New("invalid metric exporter registration")
+)
+
+// ExporterFactory creates the exporter registered with RegisterExporter.
+type ExporterFactory func(context.Context) (export.Exporter, error)
+
+// exporterRegistry holds the known ExporterFactories by name.
+type exporterRegistry struct {
+	mu    sync.Mutex
+	names map[string]ExporterFactory
+}
+
+func (r *exporterRegistry) load(name string) (ExporterFactory, bool) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	f, ok := r.names[name]
+	return f, ok
+}
+
+func (r *exporterRegistry) store(name string, f ExporterFactory) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if _, ok := r.names[name]; ok {
+		return fmt.Errorf("%w: %q", errDuplicateExporter, name)
+	}
+	r.names[name] = f
+	return nil
+}
+
+var exporters = &exporterRegistry{names: map[string]ExporterFactory{}}
+
+// RegisterExporter makes the exporter created by f available under name
+// to ExporterFromEnv and WithPusherFromEnv. Names are case-insensitive.
+// An error is returned if name is empty, is "none", or is already
+// registered, or f is nil.
+//
+// For example, an application allowing its metrics to be printed with
+// OTEL_METRICS_EXPORTER=logging would register:
+//
+//	basic.RegisterExporter("logging", func(context.Context) (export.Exporter, error) {
+//		return stdout.NewExporter(stdout.WithPrettyPrint())
+//	})
+func RegisterExporter(name string, f ExporterFactory) error {
+	name = strings.ToLower(strings.TrimSpace(name))
+	if name == "" || name == none || f == nil {
+		return fmt.Errorf("%w: %q", errInvalidExporter, name)
+	}
+	return exporters.store(name, f)
+}
+
+// exporterName returns the normalized value of OTEL_METRICS_EXPORTER.
+func exporterName() string {
+	return strings.ToLower(strings.TrimSpace(os.Getenv(envExporter)))
+}
+
+// ExporterFromEnv returns a new exporter of the kind named by the
+// OTEL_METRICS_EXPORTER environment variable, otlp if it is not set. It
+// returns nil if the variable is "none", and an error if the exporter is
+// not registered or cannot be created.
+func ExporterFromEnv(ctx context.Context) (export.Exporter, error) {
+	name := exporterName()
+	if name == "" {
+		name = defaultExporter
+	}
+	if name == none {
+		return nil, nil
+	}
+	f, ok := exporters.load(name)
+	if !ok {
+		return nil, fmt.Errorf("%s: %w: %q", envExporter, errUnknownExporter, name)
+	}
+	return f(ctx)
+}
+
+// WithPusherFromEnv sets the Pusher configuration option of a Config to
+// the exporter returned by ExporterFromEnv, when it returns one. Errors
+// are sent to the global ErrorHandler and leave the Pusher unchanged.
+//
+// The default otlp exporter is registered by importing the
+// go.opentelemetry.io/otel/exporters/autoexport module. When
+// OTEL_METRICS_EXPORTER is not set and no exporter is registered under
+// the default name, the Pusher is left unchanged without error.
+//
+// The exporter is created when the option is applied. The ExportKind of
+// the checkpointer of the Controller must suit it.
+func WithPusherFromEnv() Option {
+	return pusherFromEnvOption{}
+}
+
+type pusherFromEnvOption struct{}
+
+// pusherFromEnv returns the exporter of WithPusherFromEnv, nil if none is
+// configured.
+func pusherFromEnv(ctx context.Context) (export.Exporter, error) {
+	if exporterName() == "" {
+		if _, ok := exporters.load(defaultExporter); !ok {
+			return nil, nil
+		}
+	}
+	return ExporterFromEnv(ctx)
+}
+
+func (pusherFromEnvOption) Apply(config *Config) {
+	e, err := pusherFromEnv(context.Background())
+	if err != nil {
+		otel.Handle(err)
+		return
+	}
+	if e != nil {
+		config.Pusher = e
+	}
+}
+
+// applyEnv sets the options of config configured by environment
+// variables.
+func applyEnv(config *Config) {
+	internal.DurationEnv(envExportInterval, &config.CollectPeriod)
+	internal.DurationEnv(envExportTimeout, &config.PushTimeout)
+}
@@ -0,0 +1,118 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package basic
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	ottest "go.opentelemetry.io/otel/internal/internaltest"
+	export "go.opentelemetry.io/otel/sdk/export/metric"
+	processor "go.opentelemetry.io/otel/sdk/metric/processor/basic"
+	"go.opentelemetry.io/otel/sdk/metric/selector/simple"
+)
+
+type testExporter struct {
+	export.ExportKindSelector
+}
+
+func (testExporter) Export(context.Context, export.CheckpointSet) error { return nil }
+
+func setEnv(t *testing.T, env map[string]string) func() {
+	store, err := ottest.SetEnvVariables(env)
+	require.NoError(t, err)
+	return func() { require.NoError(t, store.Restore()) }
+}
+
+func newEnvController(opts ...Option) *Controller {
+	return New(processor.New(
+		simple.NewWithInexpensiveDistribution(),
+		export.CumulativeExportKindSelector(),
+	), opts...)
+}
+
+func TestControllerFromEnv(t *testing.T) {
+	defer setEnv(t, map[string]string{
+		envExportInterval: "2000",
+		envExportTimeout:  "invalid",
+	})()
+
+	c := newEnvController()
+	assert.Equal(t, 2*time.Second, c.collectPeriod)
+	assert.Equal(t, DefaultPeriod, c.pushTimeout)
+
+	// Options take precedence over the environment.
+	c = newEnvController(WithCollectPeriod(time.Second))
+	assert.Equal(t, time.Second, c.collectPeriod)
+}
+
+func TestRegisterExporter(t *testing.T) {
+	factory := func(context.Context) (export.Exporter, error) {
+		return testExporter{export.CumulativeExportKindSelector()}, nil
+	}
+	assert.Error(t, RegisterExporter("", factory))
+	assert.Error(t, RegisterExporter("none", factory))
+	assert.Error(t, RegisterExporter("nil", nil))
+	require.NoError(t, RegisterExporter("Test", factory))
+	assert.True(t, errors.Is(RegisterExporter("test", factory), errDuplicateExporter))
+	errFailing := errors.New("failing")
+	require.NoError(t, RegisterExporter("failing", func(context.Context) (export.Exporter, error) {
+		return nil, errFailing
+	}))
+
+	for _, test := range []struct {
+		name    string
+		want    export.Exporter
+		wantErr error
+	}{
+		// The default exporter, otlp, is registered by the autoexport
+		// module only.
+		{name: "", wantErr: errUnknownExporter},
+		{name: "none"},
+		{name: " TEST ", want: testExporter{export.CumulativeExportKindSelector()}},
+		{name: "unknown", wantErr: errUnknownExporter},
+		{name: "failing", wantErr: errFailing},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			defer setEnv(t, map[string]string{envExporter: test.name})()
+
+			got, err := ExporterFromEnv(context.Background())
+			assert.True(t, errors.Is(err, test.wantErr), "%v", err)
+			assert.Equal(t, test.want, got)
+
+			c := newEnvController(WithPusherFromEnv())
+			assert.Equal(t, test.want, c.pusher)
+		})
+	}
+}
+
+func TestPusherFromEnvWithoutDefaultExporter(t *testing.T) {
+	defer setEnv(t, map[string]string{envExporter: ""})()
+
+	// The default exporter is not registered in this package, leaving
+	// the Pusher unchanged without error.
+	got, err := pusherFromEnv(context.Background())
+	assert.NoError(t, err)
+	assert.Nil(t, got)
+
+	defer setEnv(t, map[string]string{envExporter: "unknown"})()
+	_, err = pusherFromEnv(context.Background())
+	assert.True(t, errors.Is(err, errUnknownExporter), "%v", err)
+}
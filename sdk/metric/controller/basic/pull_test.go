@@ -116,3 +116,47 @@ func TestPullWithCollect(t *testing.T) {
 	}, records.Map())
 
 }
+
+func TestPullWithCoarseClock(t *testing.T) {
+	puller := controller.New(
+		processor.New(
+			processortest.AggregatorSelector(),
+			export.CumulativeExportKindSelector(),
+			processor.WithMemory(true),
+		),
+		controller.WithCollectPeriod(time.Millisecond),
+		controller.WithCoarseClock(time.Hour),
+	)
+
+	ctx := context.Background()
+	meter := puller.MeterProvider().Meter("coarse")
+	counter := metric.Must(meter).NewInt64Counter("counter.sum")
+
+	counter.Add(ctx, 10, label.String("A", "B"))
+
+	// The first collection is never cached.
+	require.NoError(t, puller.Collect(ctx))
+
+	counter.Add(ctx, 10, label.String("A", "B"))
+	time.Sleep(2 * time.Millisecond)
+
+	// Cached value, the coarse clock has not advanced.
+	require.NoError(t, puller.Collect(ctx))
+	records := processortest.NewOutput(label.DefaultEncoder())
+	require.NoError(t, puller.ForEach(export.CumulativeExportKindSelector(), records.AddRecord))
+
+	require.EqualValues(t, map[string]float64{
+		"counter.sum/A=B/": 10,
+	}, records.Map())
+
+	// Stopping the controller stops its coarse clock, which then
+	// reads the current time.
+	require.NoError(t, puller.Stop(ctx))
+	require.NoError(t, puller.Collect(ctx))
+	records = processortest.NewOutput(label.DefaultEncoder())
+	require.NoError(t, puller.ForEach(export.CumulativeExportKindSelector(), records.AddRecord))
+
+	require.EqualValues(t, map[string]float64{
+		"counter.sum/A=B/": 20,
+	}, records.Map())
+}
@@ -104,6 +104,7 @@ func TestPushDoubleStart(t *testing.T) {
 }
 
 func TestPushTicker(t *testing.T) {
+	mock := controllertest.NewMockClock()
 	exporter := newExporter()
 	checkpointer := newCheckpointer()
 	p := controller.New(
@@ -111,12 +112,10 @@ func TestPushTicker(t *testing.T) {
 		controller.WithPusher(exporter),
 		controller.WithCollectPeriod(time.Second),
 		controller.WithResource(testResource),
+		controller.WithClock(mock),
 	)
 	meter := p.MeterProvider().Meter("name")
 
-	mock := controllertest.NewMockClock()
-	p.SetClock(mock)
-
 	ctx := context.Background()
 
 	counter := metric.Must(meter).NewInt64Counter("counter.sum")
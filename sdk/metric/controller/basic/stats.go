@@ -0,0 +1,125 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package basic // import "go.opentelemetry.io/otel/sdk/metric/controller/basic"
+
+import (
+	"sync"
+	"time"
+
+	sdk "go.opentelemetry.io/otel/sdk/metric"
+)
+
+// maxExportErrors is the number of the most recent export errors kept by
+// a Controller.
+const maxExportErrors = 10
+
+// ExportError is an error returned by the exporter of a Controller.
+type ExportError struct {
+	// Time is the time the export started at.
+	Time time.Time
+	Err  error
+}
+
+// PipelineStats describes the recent activity of the export pipeline of
+// a Controller.  The zero times mean that nothing happened yet.
+type PipelineStats struct {
+	// Collections is the number of collections of the Controller.
+	Collections int64
+	// LastCollection is the time the last collection started at.
+	LastCollection time.Time
+	// LastCollectionDuration is the duration of the last collection.
+	LastCollectionDuration time.Duration
+	// LastCollectionRecords is the number of records checkpointed by
+	// the last collection.
+	LastCollectionRecords int
+
+	// Exports is the number of exports of the Controller.
+	Exports int64
+	// LastExport is the time the last export started at.
+	LastExport time.Time
+	// LastExportDuration is the duration of the last export.
+	LastExportDuration time.Duration
+	// LastSuccessfulExport is the time the last export that succeeded
+	// started at.
+	LastSuccessfulExport time.Time
+	// ExportErrors holds the most recent export errors, the oldest
+	// first.
+	ExportErrors []ExportError
+}
+
+// pipelineStats records the PipelineStats of a Controller.
+type pipelineStats struct {
+	mu    sync.Mutex
+	stats PipelineStats
+}
+
+func (s *pipelineStats) collected(start time.Time, d time.Duration, records int) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.stats.Collections++
+	s.stats.LastCollection = start
+	s.stats.LastCollectionDuration = d
+	s.stats.LastCollectionRecords = records
+}
+
+func (s *pipelineStats) exported(start time.Time, d time.Duration, err error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.stats.Exports++
+	s.stats.LastExport = start
+	s.stats.LastExportDuration = d
+	if err == nil {
+		s.stats.LastSuccessfulExport = start
+		return
+	}
+	errs := append(s.stats.ExportErrors, ExportError{Time: start, Err: err})
+	if len(errs) > maxExportErrors {
+		errs = errs[len(errs)-maxExportErrors:]
+	}
+	s.stats.ExportErrors = errs
+}
+
+func (s *pipelineStats) load() PipelineStats {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	stats := s.stats
+	stats.ExportErrors = append([]ExportError(nil), s.stats.ExportErrors...)
+	return stats
+}
+
+// Stats returns the recent activity of the export pipeline of the
+// Controller, for introspection.
+func (c *Controller) Stats() PipelineStats {
+	return c.stats.load()
+}
+
+// Instruments returns the instruments created with the MeterProvider of
+// the Controller, sorted by name, along with their current cardinality.
+func (c *Controller) Instruments() []sdk.InstrumentStats {
+	return c.accumulator.Instruments()
+}
+
+// RecordCount returns the number of records held by the Accumulator of
+// the Controller, see sdk.Accumulator.RecordCount.
+func (c *Controller) RecordCount() int {
+	return c.accumulator.RecordCount()
+}
+
+// ShedLabelSets makes the Accumulator of the Controller record the
+// measurements with new label sets without labels, or stop doing so,
+// see sdk.Accumulator.ShedLabelSets.
+func (c *Controller) ShedLabelSets(shed bool) {
+	c.accumulator.ShedLabelSets(shed)
+}
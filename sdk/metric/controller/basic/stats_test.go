@@ -0,0 +1,93 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package basic_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.opentelemetry.io/otel/label"
+	"go.opentelemetry.io/otel/metric"
+	export "go.opentelemetry.io/otel/sdk/export/metric"
+	controller "go.opentelemetry.io/otel/sdk/metric/controller/basic"
+	"go.opentelemetry.io/otel/sdk/metric/controller/controllertest"
+)
+
+type failingExporter struct {
+	export.Exporter
+	err error
+}
+
+func (e *failingExporter) Export(ctx context.Context, cps export.CheckpointSet) error {
+	if e.err != nil {
+		return e.err
+	}
+	return e.Exporter.Export(ctx, cps)
+}
+
+func TestControllerStats(t *testing.T) {
+	exporter := &failingExporter{Exporter: newExporter()}
+	cont := controller.New(newCheckpointer(), controller.WithPusher(exporter))
+	mock := controllertest.NewMockClock()
+	cont.SetClock(mock)
+
+	meter := metric.Must(cont.MeterProvider().Meter("test"))
+	counter := meter.NewInt64Counter("counter.sum")
+	_ = meter.NewInt64ValueObserver("observer.lastvalue", func(_ context.Context, result metric.Int64ObserverResult) {
+		result.Observe(1, label.String("k", "1"))
+		result.Observe(1, label.String("k", "2"))
+		result.Observe(1, label.String("k", "3"))
+	})
+	_ = meter.NewInt64ValueRecorder("unused.histogram")
+	ctx := context.Background()
+	counter.Add(ctx, 1, label.String("k", "1"))
+	counter.Add(ctx, 1, label.String("k", "2"))
+
+	assert.Equal(t, controller.PipelineStats{}, cont.Stats())
+
+	require.NoError(t, cont.Start(ctx))
+	require.NoError(t, cont.Stop(ctx))
+
+	stats := cont.Stats()
+	assert.Equal(t, int64(1), stats.Collections)
+	assert.Equal(t, mock.Now(), stats.LastCollection)
+	assert.Equal(t, 5, stats.LastCollectionRecords)
+	assert.Equal(t, int64(1), stats.Exports)
+	assert.Equal(t, mock.Now(), stats.LastSuccessfulExport)
+	assert.Empty(t, stats.ExportErrors)
+
+	var cardinality []string
+	for _, inst := range cont.Instruments() {
+		cardinality = append(cardinality, fmt.Sprintf("%s=%d", inst.Descriptor.Name(), inst.LabelSets))
+	}
+	assert.Equal(t, []string{"counter.sum=2", "observer.lastvalue=3", "unused.histogram=0"}, cardinality)
+
+	for i := 0; i < 12; i++ {
+		exporter.err = fmt.Errorf("error %d", i)
+		require.NoError(t, cont.Start(ctx))
+		require.Error(t, cont.Stop(ctx))
+	}
+	stats = cont.Stats()
+	assert.Equal(t, int64(13), stats.Exports)
+	require.Len(t, stats.ExportErrors, 10)
+	assert.Equal(t, errors.New("error 2"), stats.ExportErrors[0].Err)
+	assert.Equal(t, errors.New("error 11"), stats.ExportErrors[9].Err)
+	assert.Equal(t, mock.Now(), stats.LastSuccessfulExport)
+}
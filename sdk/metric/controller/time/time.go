@@ -17,6 +17,8 @@ package time // import "go.opentelemetry.io/otel/sdk/metric/controller/time"
 import (
 	"time"
 	lib "time"
+
+	"go.opentelemetry.io/otel/sdk/internal"
 )
 
 // Several types below are created to match "github.com/benbjohnson/clock"
@@ -57,3 +59,33 @@ func (t RealTicker) Stop() {
 func (t RealTicker) C() <-chan time.Time {
 	return t.ticker.C
 }
+
+// CoarseClock is a Clock whose Now returns a time cached in the background
+// and refreshed at a fixed granularity, avoiding a call to time.Now each
+// time it is read.  Its Tickers are the same as the ones of RealClock.
+type CoarseClock struct {
+	clock *internal.CoarseClock
+}
+
+var _ Clock = CoarseClock{}
+
+// NewCoarseClock returns a CoarseClock refreshing its time every
+// granularity, or every millisecond if granularity is not positive.
+// The clock must be stopped with Stop to release its goroutine.
+func NewCoarseClock(granularity time.Duration) CoarseClock {
+	return CoarseClock{internal.NewCoarseClock(granularity)}
+}
+
+func (c CoarseClock) Now() time.Time {
+	return c.clock.Now()
+}
+
+func (CoarseClock) Ticker(period time.Duration) Ticker {
+	return RealTicker{time.NewTicker(period)}
+}
+
+// Stop stops refreshing the time of the clock.  Once stopped, Now
+// returns the current time.
+func (c CoarseClock) Stop() {
+	c.clock.Stop()
+}
@@ -375,6 +375,56 @@ func TestObserverCollection(t *testing.T) {
 	}
 }
 
+func TestObserverUnregister(t *testing.T) {
+	ctx := context.Background()
+	meter, sdk, processor := newSDK(t)
+
+	collect := func() map[string]float64 {
+		processor.accumulations = nil
+		collected := sdk.Collect(ctx)
+		require.Equal(t, collected, len(processor.accumulations))
+
+		out := processortest.NewOutput(label.DefaultEncoder())
+		for _, rec := range processor.accumulations {
+			require.NoError(t, out.AddAccumulation(rec))
+		}
+		return out.Map()
+	}
+
+	calls := 0
+	var once metric.Int64SumObserver
+	once = Must(meter).NewInt64SumObserver("once.sum", func(_ context.Context, result metric.Int64ObserverResult) {
+		calls++
+		result.Observe(1)
+		// Callbacks may unregister themselves.
+		require.NoError(t, once.Unregister())
+	})
+	other := Must(meter).NewInt64SumObserver("other.sum", func(_ context.Context, result metric.Int64ObserverResult) {
+		result.Observe(2)
+	})
+	var batchA metric.Int64SumObserver
+	batch := Must(meter).NewBatchObserver(func(_ context.Context, result metric.BatchObserverResult) {
+		result.Observe(nil, batchA.Observation(3))
+	})
+	batchA = batch.NewInt64SumObserver("batch.sum")
+
+	require.EqualValues(t, map[string]float64{
+		"other.sum//R=V": 2,
+		"batch.sum//R=V": 3,
+	}, collect())
+	require.Equal(t, 1, calls)
+
+	require.NoError(t, batchA.Unregister())
+	require.EqualValues(t, map[string]float64{
+		"other.sum//R=V": 2,
+	}, collect())
+	require.Equal(t, 1, calls)
+
+	require.NoError(t, other.Unregister())
+	require.NoError(t, other.Unregister())
+	require.Empty(t, collect())
+}
+
 func TestSumObserverInputRange(t *testing.T) {
 	ctx := context.Background()
 	meter, sdk, processor := newSDK(t)
@@ -513,6 +563,79 @@ func TestRecordBatch(t *testing.T) {
 	}, out.Map())
 }
 
+func TestRecordWithSet(t *testing.T) {
+	ctx := context.Background()
+	meter, sdk, processor := newSDK(t)
+
+	counter := Must(meter).NewInt64Counter("int64.sum")
+	updowncounter := Must(meter).NewFloat64UpDownCounter("float64.sum")
+	valuerecorder := Must(meter).NewFloat64ValueRecorder("float64.exact")
+
+	labels := label.NewSet(
+		label.String("C", "D"),
+		label.String("A", "B"),
+	)
+
+	meter.RecordBatchWithSet(ctx, &labels,
+		counter.Measurement(1),
+		updowncounter.Measurement(-2),
+		valuerecorder.Measurement(3),
+	)
+	counter.AddWithSet(ctx, 10, &labels)
+	updowncounter.AddWithSet(ctx, -20, &labels)
+	valuerecorder.RecordWithSet(ctx, 30, &labels)
+
+	// Recording with the equivalent labels updates the same records.
+	counter.Add(ctx, 100, label.String("A", "B"), label.String("C", "D"))
+
+	sdk.Collect(ctx)
+
+	out := processortest.NewOutput(label.DefaultEncoder())
+	for _, rec := range processor.accumulations {
+		require.NoError(t, out.AddAccumulation(rec))
+	}
+	require.EqualValues(t, map[string]float64{
+		"int64.sum/A=B,C=D/R=V":     111,
+		"float64.sum/A=B,C=D/R=V":   -22,
+		"float64.exact/A=B,C=D/R=V": 33,
+	}, out.Map())
+}
+
+func TestRecordWithHandle(t *testing.T) {
+	ctx := context.Background()
+	meter, sdk, processor := newSDK(t)
+
+	counter := Must(meter).NewInt64Counter("int64.sum")
+	var handles label.HandleCache
+	h := handles.Register("route", label.String("A", "B"))
+
+	collect := func() map[string]float64 {
+		processor.accumulations = nil
+		sdk.Collect(ctx)
+		out := processortest.NewOutput(label.DefaultEncoder())
+		for _, rec := range processor.accumulations {
+			require.NoError(t, out.AddAccumulation(rec))
+		}
+		return out.Map()
+	}
+
+	counter.AddWithSet(ctx, 1, h.Set())
+	meter.RecordBatchWithSet(ctx, h.Set(), counter.Measurement(10))
+	// Recording with the equivalent labels updates the same record.
+	counter.Add(ctx, 100, label.String("A", "B"))
+	require.EqualValues(t, map[string]float64{
+		"int64.sum/A=B/R=V": 111,
+	}, collect())
+
+	// The idle record is removed by the next collection, recording
+	// with the handle afterwards must not use the cached record.
+	require.Empty(t, collect())
+	counter.AddWithSet(ctx, 1000, h.Set())
+	require.EqualValues(t, map[string]float64{
+		"int64.sum/A=B/R=V": 1000,
+	}, collect())
+}
+
 // TestRecordPersistence ensures that a direct-called instrument that
 // is repeatedly used each interval results in a persistent record, so
 // that its encoded labels will be cached across collection intervals.
@@ -0,0 +1,81 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metrictest // import "go.opentelemetry.io/otel/sdk/metric/metrictest"
+
+import (
+	"reflect"
+	"testing"
+)
+
+// RequireSum fails the test if the sum of the Records of the instrument
+// named name whose labels match all the matchers is not want, or if
+// there are no such Records or they have no sum.
+func RequireSum(t testing.TB, records Records, name string, want float64, matchers ...LabelMatcher) {
+	t.Helper()
+	found := requireFind(t, records, name, matchers)
+
+	var got float64
+	for _, r := range found {
+		if !r.hasSum {
+			t.Fatalf("metrictest: the %s aggregation of %q has no sum", r.AggregationKind, name)
+			return
+		}
+		got += r.Sum.CoerceToFloat64(r.Descriptor.NumberKind())
+	}
+	if got != want {
+		t.Fatalf("metrictest: sum of %q is %v, want %v", name, got, want)
+	}
+}
+
+// RequireHistogramBuckets fails the test if the histograms of the
+// Records of the instrument named name whose labels match all the
+// matchers do not have the given boundaries, or if the sum of their
+// counts is not counts.  It also fails if there are no such Records or
+// they are not histograms.
+func RequireHistogramBuckets(t testing.TB, records Records, name string, boundaries []float64, counts []uint64, matchers ...LabelMatcher) {
+	t.Helper()
+	found := requireFind(t, records, name, matchers)
+
+	got := make([]uint64, len(counts))
+	for _, r := range found {
+		if r.Buckets.Counts == nil {
+			t.Fatalf("metrictest: the %s aggregation of %q is not a histogram", r.AggregationKind, name)
+			return
+		}
+		if !reflect.DeepEqual(r.Buckets.Boundaries, boundaries) {
+			t.Fatalf("metrictest: boundaries of %q are %v, want %v", name, r.Buckets.Boundaries, boundaries)
+			return
+		}
+		if len(r.Buckets.Counts) != len(counts) {
+			t.Fatalf("metrictest: %q has %d buckets, want %d", name, len(r.Buckets.Counts), len(counts))
+			return
+		}
+		for i, c := range r.Buckets.Counts {
+			got[i] += c
+		}
+	}
+	if !reflect.DeepEqual(got, counts) {
+		t.Fatalf("metrictest: bucket counts of %q are %v, want %v", name, got, counts)
+	}
+}
+
+func requireFind(t testing.TB, records Records, name string, matchers []LabelMatcher) Records {
+	t.Helper()
+	found := records.Find(name, matchers...)
+	if len(found) == 0 {
+		t.Fatalf("metrictest: no records of %q match", name)
+	}
+	return found
+}
@@ -0,0 +1,230 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package metrictest provides a Reader collecting the measurements
+// recorded with its MeterProvider on demand, and assertion helpers to
+// check them, so that instrumentation can be tested without setting up a
+// controller and an exporter.
+//
+//	reader := metrictest.NewReader()
+//	meter := reader.MeterProvider().Meter("instrumentation")
+//	// ... record measurements with meter ...
+//	records, err := reader.Collect(ctx)
+//	require.NoError(t, err)
+//	metrictest.RequireSum(t, records, "requests", 3, metrictest.WithLabel(label.String("method", "GET")))
+package metrictest // import "go.opentelemetry.io/otel/sdk/metric/metrictest"
+
+import (
+	"context"
+	"errors"
+
+	"go.opentelemetry.io/otel/label"
+	"go.opentelemetry.io/otel/metric"
+	"go.opentelemetry.io/otel/metric/number"
+	export "go.opentelemetry.io/otel/sdk/export/metric"
+	"go.opentelemetry.io/otel/sdk/export/metric/aggregation"
+	controller "go.opentelemetry.io/otel/sdk/metric/controller/basic"
+	processor "go.opentelemetry.io/otel/sdk/metric/processor/basic"
+	"go.opentelemetry.io/otel/sdk/metric/selector/simple"
+	"go.opentelemetry.io/otel/sdk/resource"
+)
+
+// DefaultHistogramBoundaries are the boundaries of the histograms of the
+// ValueRecorder instruments of a Reader, unless it is configured with
+// another AggregatorSelector or the instruments advise other boundaries.
+var DefaultHistogramBoundaries = []float64{0.005, 0.01, 0.025, 0.05, 0.1, 0.25, 0.5, 1, 2.5, 5, 10}
+
+// Option configures a Reader.
+type Option func(*config)
+
+type config struct {
+	aggregatorSelector export.AggregatorSelector
+	exportKindSelector export.ExportKindSelector
+	resource           *resource.Resource
+}
+
+// WithAggregatorSelector sets the AggregatorSelector of the Reader,
+// a histogram selector using DefaultHistogramBoundaries by default.
+func WithAggregatorSelector(s export.AggregatorSelector) Option {
+	return func(c *config) {
+		c.aggregatorSelector = s
+	}
+}
+
+// WithExportKindSelector sets the ExportKindSelector of the Reader, the
+// collected records are cumulative by default.
+func WithExportKindSelector(s export.ExportKindSelector) Option {
+	return func(c *config) {
+		c.exportKindSelector = s
+	}
+}
+
+// WithResource sets the Resource of the Meters of the Reader.
+func WithResource(r *resource.Resource) Option {
+	return func(c *config) {
+		c.resource = r
+	}
+}
+
+// Reader collects the measurements recorded with the Meters of its
+// MeterProvider each time Collect is called.
+type Reader struct {
+	controller *controller.Controller
+	exportKind export.ExportKindSelector
+}
+
+// NewReader returns a Reader configured with opts.
+func NewReader(opts ...Option) *Reader {
+	c := config{
+		aggregatorSelector: simple.NewWithHistogramDistribution(DefaultHistogramBoundaries),
+		exportKindSelector: export.CumulativeExportKindSelector(),
+	}
+	for _, opt := range opts {
+		opt(&c)
+	}
+	return &Reader{
+		controller: controller.New(
+			processor.New(c.aggregatorSelector, c.exportKindSelector, processor.WithMemory(true)),
+			controller.WithCollectPeriod(0),
+			controller.WithResource(c.resource),
+		),
+		exportKind: c.exportKindSelector,
+	}
+}
+
+// MeterProvider returns the MeterProvider whose measurements are
+// collected by the Reader.
+func (r *Reader) MeterProvider() metric.MeterProvider {
+	return r.controller.MeterProvider()
+}
+
+// Collect collects the measurements recorded since the Reader was
+// created, or since the last collection for delta ExportKinds, and
+// returns a Record for each instrument and label set.
+func (r *Reader) Collect(ctx context.Context) (Records, error) {
+	if err := r.controller.Collect(ctx); err != nil {
+		return nil, err
+	}
+	var records Records
+	err := r.controller.ForEach(r.exportKind, func(rec export.Record) error {
+		record, err := newRecord(rec)
+		if err != nil {
+			return err
+		}
+		records = append(records, record)
+		return nil
+	})
+	return records, err
+}
+
+// Record is the state of the aggregation of an instrument and a label set
+// at the time of a collection.  Only the fields supported by the
+// aggregation are set.
+type Record struct {
+	Descriptor      metric.Descriptor
+	Labels          *label.Set
+	Resource        *resource.Resource
+	AggregationKind aggregation.Kind
+
+	Sum       number.Number
+	Count     uint64
+	LastValue number.Number
+	Buckets   aggregation.Buckets
+
+	// hasSum is true if the aggregation supports Sum.
+	hasSum bool
+}
+
+func newRecord(rec export.Record) (Record, error) {
+	r := Record{
+		Descriptor: *rec.Descriptor(),
+		Labels:     rec.Labels(),
+		Resource:   rec.Resource(),
+	}
+	agg := rec.Aggregation()
+	r.AggregationKind = agg.Kind()
+
+	var err error
+	if s, ok := agg.(aggregation.Sum); ok {
+		r.hasSum = true
+		r.Sum, err = s.Sum()
+	}
+	if c, ok := agg.(aggregation.Count); ok && err == nil {
+		r.Count, err = c.Count()
+	}
+	if lv, ok := agg.(aggregation.LastValue); ok && err == nil {
+		r.LastValue, _, err = lv.LastValue()
+	}
+	if h, ok := agg.(aggregation.Histogram); ok && err == nil {
+		var b aggregation.Buckets
+		b, err = h.Histogram()
+		// The aggregation is updated by the next collections.
+		r.Buckets.Boundaries = append([]float64(nil), b.Boundaries...)
+		r.Buckets.Counts = append([]uint64(nil), b.Counts...)
+	}
+	if errors.Is(err, aggregation.ErrNoData) {
+		err = nil
+	}
+	return r, err
+}
+
+// Records are the Records collected by a Reader.
+type Records []Record
+
+// Find returns the Records of the instrument named name whose labels
+// match all the matchers.
+func (rs Records) Find(name string, matchers ...LabelMatcher) Records {
+	var found Records
+	for _, r := range rs {
+		if r.Descriptor.Name() == name && r.matches(matchers) {
+			found = append(found, r)
+		}
+	}
+	return found
+}
+
+func (r Record) matches(matchers []LabelMatcher) bool {
+	for _, m := range matchers {
+		if !m(r.Labels) {
+			return false
+		}
+	}
+	return true
+}
+
+// LabelMatcher matches the label set of a Record.
+type LabelMatcher func(*label.Set) bool
+
+// WithLabel matches the label sets containing kv.
+func WithLabel(kv label.KeyValue) LabelMatcher {
+	return func(s *label.Set) bool {
+		v, ok := s.Value(kv.Key)
+		return ok && v == kv.Value
+	}
+}
+
+// WithLabelKey matches the label sets having a label with key k.
+func WithLabelKey(k label.Key) LabelMatcher {
+	return func(s *label.Set) bool {
+		return s.HasValue(k)
+	}
+}
+
+// WithLabels matches the label sets made of exactly kvs.
+func WithLabels(kvs ...label.KeyValue) LabelMatcher {
+	want := label.NewSet(kvs...)
+	return func(s *label.Set) bool {
+		return s.Equals(&want)
+	}
+}
@@ -0,0 +1,133 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metrictest_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.opentelemetry.io/otel/label"
+	"go.opentelemetry.io/otel/metric"
+	export "go.opentelemetry.io/otel/sdk/export/metric"
+	"go.opentelemetry.io/otel/sdk/metric/metrictest"
+)
+
+// fatalT records the failures of the assertion helpers.
+type fatalT struct {
+	testing.TB
+	failed bool
+}
+
+func (t *fatalT) Helper() {}
+
+func (t *fatalT) Fatalf(string, ...interface{}) {
+	t.failed = true
+}
+
+func TestReaderSum(t *testing.T) {
+	ctx := context.Background()
+	reader := metrictest.NewReader()
+	meter := metric.Must(reader.MeterProvider().Meter("test"))
+
+	counter := meter.NewInt64Counter("requests")
+	counter.Add(ctx, 1, label.String("method", "GET"), label.Int("status", 200))
+	counter.Add(ctx, 2, label.String("method", "GET"), label.Int("status", 404))
+	counter.Add(ctx, 4, label.String("method", "POST"), label.Int("status", 200))
+
+	records, err := reader.Collect(ctx)
+	require.NoError(t, err)
+	require.Len(t, records, 3)
+
+	metrictest.RequireSum(t, records, "requests", 7)
+	metrictest.RequireSum(t, records, "requests", 3, metrictest.WithLabel(label.String("method", "GET")))
+	metrictest.RequireSum(t, records, "requests", 5, metrictest.WithLabel(label.Int("status", 200)))
+	metrictest.RequireSum(t, records, "requests", 4, metrictest.WithLabels(label.Int("status", 200), label.String("method", "POST")))
+	metrictest.RequireSum(t, records, "requests", 7, metrictest.WithLabelKey("status"))
+
+	for _, tc := range []struct {
+		name     string
+		want     float64
+		matchers []metrictest.LabelMatcher
+	}{
+		{name: "requests", want: 6},
+		{name: "responses", want: 7},
+		{name: "requests", want: 1, matchers: []metrictest.LabelMatcher{metrictest.WithLabels(label.String("method", "GET"))}},
+		{name: "requests", want: 7, matchers: []metrictest.LabelMatcher{metrictest.WithLabelKey("host")}},
+	} {
+		ft := &fatalT{TB: t}
+		metrictest.RequireSum(ft, records, tc.name, tc.want, tc.matchers...)
+		assert.True(t, ft.failed, "RequireSum(%q, %v) did not fail", tc.name, tc.want)
+	}
+
+	// The records are cumulative by default.
+	counter.Add(ctx, 1, label.String("method", "GET"), label.Int("status", 200))
+	records, err = reader.Collect(ctx)
+	require.NoError(t, err)
+	metrictest.RequireSum(t, records, "requests", 8)
+}
+
+func TestReaderDelta(t *testing.T) {
+	ctx := context.Background()
+	reader := metrictest.NewReader(metrictest.WithExportKindSelector(export.DeltaExportKindSelector()))
+	counter := metric.Must(reader.MeterProvider().Meter("test")).NewFloat64Counter("bytes")
+
+	counter.Add(ctx, 1.5)
+	records, err := reader.Collect(ctx)
+	require.NoError(t, err)
+	metrictest.RequireSum(t, records, "bytes", 1.5)
+
+	counter.Add(ctx, 2)
+	records, err = reader.Collect(ctx)
+	require.NoError(t, err)
+	metrictest.RequireSum(t, records, "bytes", 2)
+}
+
+func TestReaderHistogram(t *testing.T) {
+	ctx := context.Background()
+	reader := metrictest.NewReader()
+	meter := metric.Must(reader.MeterProvider().Meter("test"))
+
+	boundaries := []float64{1, 10}
+	recorder := meter.NewFloat64ValueRecorder("latency", metric.WithExplicitBucketBoundaries(boundaries...))
+	recorder.Record(ctx, 0.5, label.String("route", "/a"))
+	recorder.Record(ctx, 5, label.String("route", "/a"))
+	recorder.Record(ctx, 50, label.String("route", "/b"))
+	meter.NewInt64Counter("requests").Add(ctx, 1)
+
+	records, err := reader.Collect(ctx)
+	require.NoError(t, err)
+
+	metrictest.RequireHistogramBuckets(t, records, "latency", boundaries, []uint64{1, 1, 1})
+	metrictest.RequireHistogramBuckets(t, records, "latency", boundaries, []uint64{1, 1, 0}, metrictest.WithLabel(label.String("route", "/a")))
+	metrictest.RequireSum(t, records, "latency", 55.5)
+
+	for _, tc := range []struct {
+		name       string
+		boundaries []float64
+		counts     []uint64
+	}{
+		{name: "latency", boundaries: boundaries, counts: []uint64{1, 2, 0}},
+		{name: "latency", boundaries: []float64{1, 5}, counts: []uint64{1, 1, 1}},
+		{name: "latency", boundaries: boundaries, counts: []uint64{1, 1}},
+		{name: "requests", boundaries: boundaries, counts: []uint64{1, 1, 1}},
+	} {
+		ft := &fatalT{TB: t}
+		metrictest.RequireHistogramBuckets(ft, records, tc.name, tc.boundaries, tc.counts)
+		assert.True(t, ft.failed, "RequireHistogramBuckets(%q, %v, %v) did not fail", tc.name, tc.boundaries, tc.counts)
+	}
+}
@@ -0,0 +1,154 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metric // import "go.opentelemetry.io/otel/sdk/metric"
+
+import (
+	"sync"
+	"sync/atomic"
+)
+
+// recordMap maps the `mapkey` of every active record to the record.
+//
+// Looking up an existing record is lock-free: the entries are read from
+// an immutable copy of the map which is loaded atomically.  Entries added
+// or removed since that copy was made are found in the `dirty` map,
+// which is protected by a mutex, and the copy is refreshed once the
+// lookups missing it have cost as much as copying it.  Records with
+// stable label sets are thus recorded to without any lock once they
+// are in the copy, the same way as with a sync.Map, but without
+// allocating to pass the key through an interface{}.
+type recordMap struct {
+	// read holds a map[mapkey]*record that is never modified once
+	// stored.  It may still contain records removed from `dirty`,
+	// which are unmapped.
+	read atomic.Value
+
+	mu sync.Mutex
+	// dirty holds all the current entries.  It is guarded by mu.
+	dirty map[mapkey]*record
+	// misses counts the lookups that were not satisfied by read
+	// since it was last refreshed.  It is guarded by mu.
+	misses int
+}
+
+// load returns the record stored for mk, if any.  The record may be
+// unmapped if it was removed since read was refreshed.
+func (m *recordMap) load(mk mapkey) (*record, bool) {
+	read, _ := m.read.Load().(map[mapkey]*record)
+	if rec, ok := read[mk]; ok {
+		return rec, true
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	rec, ok := m.dirty[mk]
+	m.missLocked()
+	return rec, ok
+}
+
+// loadOrStore returns the record stored for mk with a new reference
+// held on it if there is one that is still mapped.  Otherwise it stores
+// rec, replacing any unmapped record, and returns it.
+func (m *recordMap) loadOrStore(mk mapkey, rec *record) *record {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.missLocked()
+	if old, ok := m.dirty[mk]; ok && old.refMapped.ref() {
+		return old
+	}
+	if m.dirty == nil {
+		m.dirty = make(map[mapkey]*record)
+	}
+	m.dirty[mk] = rec
+	return rec
+}
+
+// delete removes the entry of mk if it is still rec.
+func (m *recordMap) delete(mk mapkey, rec *record) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.dirty[mk] == rec {
+		delete(m.dirty, mk)
+	}
+}
+
+// rangeRecords calls f for every record stored when it is called.  f
+// may call delete, records stored concurrently may not be visited.
+func (m *recordMap) rangeRecords(f func(*record)) {
+	m.mu.Lock()
+	read := m.refreshLocked()
+	m.mu.Unlock()
+
+	for _, rec := range read {
+		f(rec)
+	}
+}
+
+// len returns the number of records stored.
+func (m *recordMap) len() int {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return len(m.dirty)
+}
+
+func (m *recordMap) missLocked() {
+	m.misses++
+	if m.misses >= len(m.dirty) {
+		m.refreshLocked()
+	}
+}
+
+// refreshLocked replaces read with a copy of dirty and returns it.
+func (m *recordMap) refreshLocked() map[mapkey]*record {
+	read := make(map[mapkey]*record, len(m.dirty))
+	for mk, rec := range m.dirty {
+		read[mk] = rec
+	}
+	m.read.Store(read)
+	m.misses = 0
+	return read
+}
+
+// handleRecords caches the records of an instrument for the label sets
+// registered with a label.Handle, by their HandleID.  Lookups are
+// lock-free, storing copies the cache, which is bounded by the number
+// of registered label sets the instrument records with.
+type handleRecords struct {
+	// read holds a map[uint64]*record that is never modified once
+	// stored.  Its records may have been unmapped since.
+	read atomic.Value
+
+	// mu serializes the stores.
+	mu sync.Mutex
+}
+
+// load returns the record cached for the HandleID, or nil.
+func (h *handleRecords) load(id uint64) *record {
+	read, _ := h.read.Load().(map[uint64]*record)
+	return read[id]
+}
+
+// store caches the record of the HandleID.
+func (h *handleRecords) store(id uint64, rec *record) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	old, _ := h.read.Load().(map[uint64]*record)
+	read := make(map[uint64]*record, len(old)+1)
+	for k, v := range old {
+		read[k] = v
+	}
+	read[id] = rec
+	h.read.Store(read)
+}
@@ -0,0 +1,75 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metric
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"go.opentelemetry.io/otel/label"
+	"go.opentelemetry.io/otel/metric"
+)
+
+func TestRecordMap(t *testing.T) {
+	var m recordMap
+	desc := metric.NewDescriptor("test", metric.CounterInstrumentKind, 0)
+	mk := func(v string) mapkey {
+		set := label.NewSet(label.String("k", v))
+		return mapkey{descriptor: &desc, ordered: set.Equivalent()}
+	}
+	newRecord := func() *record {
+		return &record{refMapped: refcountMapped{value: 2}}
+	}
+	collected := func() []*record {
+		var recs []*record
+		m.rangeRecords(func(rec *record) {
+			recs = append(recs, rec)
+		})
+		return recs
+	}
+
+	_, ok := m.load(mk("a"))
+	assert.False(t, ok)
+
+	a := newRecord()
+	assert.Same(t, a, m.loadOrStore(mk("a"), a))
+	got, ok := m.load(mk("a"))
+	assert.True(t, ok)
+	assert.Same(t, a, got)
+
+	// A mapped record is returned with a new reference instead of
+	// storing another one.
+	assert.Same(t, a, m.loadOrStore(mk("a"), newRecord()))
+	assert.Equal(t, int64(4), a.refMapped.value)
+
+	b := newRecord()
+	assert.Same(t, b, m.loadOrStore(mk("b"), b))
+	assert.ElementsMatch(t, []*record{a, b}, collected())
+
+	// An unmapped record is replaced, and deleting it afterwards
+	// does not remove its replacement.
+	b.refMapped.value = 0
+	assert.True(t, b.refMapped.tryUnmap())
+	c := newRecord()
+	assert.Same(t, c, m.loadOrStore(mk("b"), c))
+	m.delete(mk("b"), b)
+	assert.ElementsMatch(t, []*record{a, c}, collected())
+
+	m.delete(mk("a"), a)
+	assert.ElementsMatch(t, []*record{c}, collected())
+	_, ok = m.load(mk("a"))
+	assert.False(t, ok, "the lookup copy must be refreshed by rangeRecords")
+}
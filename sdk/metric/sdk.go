@@ -17,7 +17,6 @@ package metric // import "go.opentelemetry.io/otel/sdk/metric"
 import (
 	"context"
 	"fmt"
-	"runtime"
 	"sync"
 	"sync/atomic"
 
@@ -43,7 +42,7 @@ type (
 	// will call Collect() when a pull request arrives.
 	Accumulator struct {
 		// current maps `mapkey` to *record.
-		current sync.Map
+		current recordMap
 
 		// asyncInstruments is a set of
 		// `*asyncInstrument` instances
@@ -67,10 +66,24 @@ type (
 
 		// resource is applied to all records in this Accumulator.
 		resource *resource.Resource
+
+		// syncInstruments lists the synchronous instruments
+		// created with the Accumulator, for introspection.
+		syncLock        sync.Mutex
+		syncInstruments []*syncInstrument
+
+		// shedding is 1 while the measurements of new label
+		// sets are recorded without labels.  It is accessed
+		// atomically.
+		shedding int32
 	}
 
 	syncInstrument struct {
 		instrument
+
+		// handles caches the records of the label sets
+		// registered with a label.Handle.
+		handles handleRecords
 	}
 
 	// mapkey uniquely describes a metric instrument in terms of
@@ -141,11 +154,16 @@ type (
 	}
 )
 
+var emptyDistinct = label.EmptySet().Equivalent()
+
 var (
-	_ metric.MeterImpl     = &Accumulator{}
-	_ metric.AsyncImpl     = &asyncInstrument{}
-	_ metric.SyncImpl      = &syncInstrument{}
-	_ metric.BoundSyncImpl = &record{}
+	_ metric.MeterImpl           = &Accumulator{}
+	_ metric.LabelSetMeterImpl   = &Accumulator{}
+	_ metric.AsyncImpl           = &asyncInstrument{}
+	_ metric.UnregisterAsyncImpl = &asyncInstrument{}
+	_ metric.SyncImpl            = &syncInstrument{}
+	_ metric.LabelSetSyncImpl    = &syncInstrument{}
+	_ metric.BoundSyncImpl       = &record{}
 
 	ErrUninitializedInstrument = fmt.Errorf("use of an uninitialized instrument")
 )
@@ -162,6 +180,14 @@ func (s *syncInstrument) Implementation() interface{} {
 	return s
 }
 
+// Unregister implements metric.UnregisterAsyncImpl. It does not acquire
+// the Accumulator's asyncLock, which is held while callbacks run, so that
+// callbacks can unregister themselves.
+func (a *asyncInstrument) Unregister() error {
+	a.meter.asyncInstruments.Unregister(a)
+	return nil
+}
+
 func (a *asyncInstrument) observe(num number.Number, labels *label.Set) {
 	if err := aggregator.RangeTest(num, &a.descriptor); err != nil {
 		otel.Handle(err)
@@ -207,8 +233,9 @@ func (a *asyncInstrument) getRecorder(labels *label.Set) export.Aggregator {
 // acquireHandle gets or creates a `*record` corresponding to `kvs`,
 // the input labels.  The second argument `labels` is passed in to
 // support re-use of the orderedLabels computed by a previous
-// measurement in the same batch.   This performs two allocations
-// in the common case.
+// measurement in the same batch, or a label set precomputed by the
+// caller, in which case `kvs` is ignored.   This performs two
+// allocations in the common case.
 func (s *syncInstrument) acquireHandle(kvs []label.KeyValue, labelPtr *label.Set) *record {
 	var rec *record
 	var equiv label.Distinct
@@ -225,16 +252,13 @@ func (s *syncInstrument) acquireHandle(kvs []label.KeyValue, labelPtr *label.Set
 		equiv = labelPtr.Equivalent()
 	}
 
-	// Create lookup key for sync.Map (one allocation, as this
-	// passes through an interface{})
 	mk := mapkey{
 		descriptor: &s.descriptor,
 		ordered:    equiv,
 	}
 
-	if actual, ok := s.meter.current.Load(mk); ok {
+	if existingRec, ok := s.meter.current.load(mk); ok {
 		// Existing record case.
-		existingRec := actual.(*record)
 		if existingRec.refMapped.ref() {
 			// At this moment it is guaranteed that the entry is in
 			// the map and will not be removed.
@@ -243,6 +267,12 @@ func (s *syncInstrument) acquireHandle(kvs []label.KeyValue, labelPtr *label.Set
 		// This entry is no longer mapped, try to add a new entry.
 	}
 
+	if atomic.LoadInt32(&s.meter.shedding) != 0 && equiv != emptyDistinct {
+		// Shed the new label set, record the measurement
+		// without labels.
+		return s.acquireHandle(nil, label.EmptySet())
+	}
+
 	if rec == nil {
 		rec = &record{}
 		rec.labels = labelPtr
@@ -252,34 +282,10 @@ func (s *syncInstrument) acquireHandle(kvs []label.KeyValue, labelPtr *label.Set
 
 	s.meter.processor.AggregatorFor(&s.descriptor, &rec.current, &rec.checkpoint)
 
-	for {
-		// Load/Store: there's a memory allocation to place `mk` into
-		// an interface here.
-		if actual, loaded := s.meter.current.LoadOrStore(mk, rec); loaded {
-			// Existing record case. Cannot change rec here because if fail
-			// will try to add rec again to avoid new allocations.
-			oldRec := actual.(*record)
-			if oldRec.refMapped.ref() {
-				// At this moment it is guaranteed that the entry is in
-				// the map and will not be removed.
-				return oldRec
-			}
-			// This loaded entry is marked as unmapped (so Collect will remove
-			// it from the map immediately), try again - this is a busy waiting
-			// strategy to wait until Collect() removes this entry from the map.
-			//
-			// This can be improved by having a list of "Unmapped" entries for
-			// one time only usages, OR we can make this a blocking path and use
-			// a Mutex that protects the delete operation (delete only if the old
-			// record is associated with the key).
-
-			// Let collector get work done to remove the entry from the map.
-			runtime.Gosched()
-			continue
-		}
-		// The new entry was added to the map, good to go.
-		return rec
-	}
+	// Either an existing record that is still mapped is returned,
+	// in which case it is guaranteed that the entry is in the map and
+	// will not be removed, or rec replaces the unmapped one, if any.
+	return s.meter.current.loadOrStore(mk, rec)
 }
 
 func (s *syncInstrument) Bind(kvs []label.KeyValue) metric.BoundSyncImpl {
@@ -292,6 +298,35 @@ func (s *syncInstrument) RecordOne(ctx context.Context, num number.Number, kvs [
 	h.RecordOne(ctx, num)
 }
 
+// acquireSetHandle gets or creates the `*record` of a precomputed
+// label set.  The records of sets registered with a label.Handle are
+// looked up by their HandleID first, which does not compare labels.
+func (s *syncInstrument) acquireSetHandle(labels *label.Set) *record {
+	id := labels.HandleID()
+	if id == 0 {
+		return s.acquireHandle(nil, labels)
+	}
+	if rec := s.handles.load(id); rec != nil && rec.refMapped.ref() {
+		// The cached record is still mapped, it will not be
+		// removed while the reference is held.
+		return rec
+	}
+	rec := s.acquireHandle(nil, labels)
+	if rec.labels.Equivalent() == labels.Equivalent() {
+		// The records of shed label sets are not cached, they
+		// are used again once shedding stops.
+		s.handles.store(id, rec)
+	}
+	return rec
+}
+
+// RecordOneWithSet implements metric.LabelSetSyncImpl.
+func (s *syncInstrument) RecordOneWithSet(ctx context.Context, num number.Number, labels *label.Set) {
+	h := s.acquireSetHandle(labels)
+	defer h.Unbind()
+	h.RecordOne(ctx, num)
+}
+
 // NewAccumulator constructs a new Accumulator for the given
 // processor.  This Accumulator supports only a single processor.
 //
@@ -311,12 +346,16 @@ func NewAccumulator(processor export.Processor, resource *resource.Resource) *Ac
 
 // NewSyncInstrument implements metric.MetricImpl.
 func (m *Accumulator) NewSyncInstrument(descriptor metric.Descriptor) (metric.SyncImpl, error) {
-	return &syncInstrument{
+	s := &syncInstrument{
 		instrument: instrument{
 			descriptor: descriptor,
 			meter:      m,
 		},
-	}, nil
+	}
+	m.syncLock.Lock()
+	defer m.syncLock.Unlock()
+	m.syncInstruments = append(m.syncInstruments, s)
+	return s, nil
 }
 
 // NewAsyncInstrument implements metric.MetricImpl.
@@ -355,11 +394,7 @@ func (m *Accumulator) Collect(ctx context.Context) int {
 func (m *Accumulator) collectSyncInstruments() int {
 	checkpointed := 0
 
-	m.current.Range(func(key interface{}, value interface{}) bool {
-		// Note: always continue to iterate over the entire
-		// map by returning `true` in this function.
-		inuse := value.(*record)
-
+	m.current.rangeRecords(func(inuse *record) {
 		mods := atomic.LoadInt64(&inuse.updateCount)
 		coll := inuse.collectedCount
 
@@ -368,19 +403,18 @@ func (m *Accumulator) collectSyncInstruments() int {
 			// checkpoint and continue.
 			checkpointed += m.checkpointRecord(inuse)
 			inuse.collectedCount = mods
-			return true
+			return
 		}
 
 		// Having no updates since last collection, try to unmap:
 		if unmapped := inuse.refMapped.tryUnmap(); !unmapped {
 			// The record is referenced by a binding, continue.
-			return true
+			return
 		}
 
-		// If any other goroutines are now trying to re-insert this
-		// entry in the map, they are busy calling Gosched() awaiting
-		// this deletion:
-		m.current.Delete(inuse.mapkey())
+		// Other goroutines trying to re-insert this entry in the
+		// map replace it, in which case this does nothing.
+		m.current.delete(inuse.mapkey(), inuse)
 
 		// There's a potential race between `LoadInt64` and
 		// `tryUnmap` in this function.  Since this is the
@@ -389,7 +423,6 @@ func (m *Accumulator) collectSyncInstruments() int {
 		if mods != coll {
 			checkpointed += m.checkpointRecord(inuse)
 		}
-		return true
 	})
 
 	return checkpointed
@@ -495,6 +528,19 @@ func (m *Accumulator) RecordBatch(ctx context.Context, kvs []label.KeyValue, mea
 	}
 }
 
+// RecordBatchWithSet implements metric.LabelSetMeterImpl.
+func (m *Accumulator) RecordBatchWithSet(ctx context.Context, labels *label.Set, measurements ...metric.Measurement) {
+	for _, meas := range measurements {
+		s := m.fromSync(meas.SyncImpl())
+		if s == nil {
+			continue
+		}
+		h := s.acquireSetHandle(labels)
+		defer h.Unbind()
+		h.RecordOne(ctx, meas.Number())
+	}
+}
+
 // RecordOne implements metric.SyncImpl.
 func (r *record) RecordOne(ctx context.Context, num number.Number) {
 	if r.current == nil {
@@ -59,6 +59,9 @@ func NewWithExactDistribution() export.AggregatorSelector {
 // NewWithHistogramDistribution returns a simple aggregator selector
 // that uses histogram aggregators for `ValueRecorder` instruments.
 // This selector is a good default choice for most metric exporters.
+//
+// Instruments created with metric.WithExplicitBucketBoundaries use the
+// advised boundaries instead of the passed ones.
 func NewWithHistogramDistribution(boundaries []float64) export.AggregatorSelector {
 	return selectorHistogram{boundaries: boundaries}
 }
@@ -110,7 +113,11 @@ func (s selectorHistogram) AggregatorFor(descriptor *metric.Descriptor, aggPtrs
 	case metric.ValueObserverInstrumentKind:
 		lastValueAggs(aggPtrs)
 	case metric.ValueRecorderInstrumentKind:
-		aggs := histogram.New(len(aggPtrs), descriptor, s.boundaries)
+		boundaries := s.boundaries
+		if advised := descriptor.ExplicitBucketBoundaries(); len(advised) > 0 {
+			boundaries = advised
+		}
+		aggs := histogram.New(len(aggPtrs), descriptor, boundaries)
 		for i := range aggPtrs {
 			*aggPtrs[i] = &aggs[i]
 		}
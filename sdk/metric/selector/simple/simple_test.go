@@ -70,3 +70,20 @@ func TestHistogramDistribution(t *testing.T) {
 	require.IsType(t, (*histogram.Aggregator)(nil), oneAgg(hist, &testValueRecorderDesc))
 	testFixedSelectors(t, hist)
 }
+
+func TestHistogramDistributionAdvice(t *testing.T) {
+	hist := simple.NewWithHistogramDistribution([]float64{1, 2, 3})
+
+	buckets, err := oneAgg(hist, &testValueRecorderDesc).(*histogram.Aggregator).Histogram()
+	require.NoError(t, err)
+	require.Equal(t, []float64{1, 2, 3}, buckets.Boundaries)
+
+	advised := metric.NewDescriptor("advised", metric.ValueRecorderInstrumentKind, number.Int64Kind,
+		metric.WithExplicitBucketBoundaries(10, 100))
+	buckets, err = oneAgg(hist, &advised).(*histogram.Aggregator).Histogram()
+	require.NoError(t, err)
+	require.Equal(t, []float64{10, 100}, buckets.Boundaries)
+
+	// Selectors that do not aggregate into histograms ignore the advice.
+	require.IsType(t, (*exact.Aggregator)(nil), oneAgg(simple.NewWithExactDistribution(), &advised))
+}
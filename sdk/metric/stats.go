@@ -0,0 +1,89 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metric // import "go.opentelemetry.io/otel/sdk/metric"
+
+import (
+	"sort"
+	"sync/atomic"
+
+	"go.opentelemetry.io/otel/metric"
+)
+
+// InstrumentStats describes an instrument of an Accumulator.
+type InstrumentStats struct {
+	// Descriptor describes the instrument.
+	Descriptor metric.Descriptor
+	// LabelSets is the number of label sets the Accumulator holds a
+	// record of the instrument for, its current cardinality.
+	LabelSets int
+}
+
+// Instruments returns the instruments created with the Accumulator,
+// sorted by name, along with their current cardinality.  It is meant for
+// introspection, it locks the Accumulator while it counts the records of
+// the instruments.
+func (m *Accumulator) Instruments() []InstrumentStats {
+	labelSets := make(map[*syncInstrument]int)
+	m.current.rangeRecords(func(r *record) {
+		labelSets[r.inst]++
+	})
+
+	var stats []InstrumentStats
+	m.syncLock.Lock()
+	for _, inst := range m.syncInstruments {
+		stats = append(stats, InstrumentStats{
+			Descriptor: inst.descriptor,
+			LabelSets:  labelSets[inst],
+		})
+	}
+	m.syncLock.Unlock()
+
+	m.asyncLock.Lock()
+	for _, impl := range m.asyncInstruments.Instruments() {
+		if a := m.fromAsync(impl); a != nil {
+			stats = append(stats, InstrumentStats{
+				Descriptor: a.descriptor,
+				LabelSets:  len(a.recorders),
+			})
+		}
+	}
+	m.asyncLock.Unlock()
+
+	sort.SliceStable(stats, func(i, j int) bool {
+		return stats[i].Descriptor.Name() < stats[j].Descriptor.Name()
+	})
+	return stats
+}
+
+// RecordCount returns the number of records of synchronous instruments
+// the Accumulator holds, one per instrument and label set recorded since
+// the last collection or bound.  It is meant to monitor the memory used
+// by the Accumulator.
+func (m *Accumulator) RecordCount() int {
+	return m.current.len()
+}
+
+// ShedLabelSets makes the Accumulator record the measurements of the
+// synchronous instruments with new label sets without labels, if shed is
+// true, to bound its memory usage.  The label sets that already have a
+// record are not affected.  Instruments bound to a new label set while
+// it sheds them stay bound to the record without labels.
+func (m *Accumulator) ShedLabelSets(shed bool) {
+	var v int32
+	if shed {
+		v = 1
+	}
+	atomic.StoreInt32(&m.shedding, v)
+}
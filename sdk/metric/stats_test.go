@@ -0,0 +1,71 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metric_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.opentelemetry.io/otel/label"
+	"go.opentelemetry.io/otel/sdk/export/metric/aggregation"
+)
+
+func TestShedLabelSets(t *testing.T) {
+	ctx := context.Background()
+	meter, sdk, processor := newSDK(t)
+	counter := Must(meter).NewInt64Counter("name.sum")
+
+	counter.Add(ctx, 1, label.String("k", "1"))
+	assert.Equal(t, 1, sdk.RecordCount())
+
+	sdk.ShedLabelSets(true)
+	counter.Add(ctx, 1, label.String("k", "1"))
+	counter.Add(ctx, 1, label.String("k", "2"))
+	counter.Add(ctx, 1, label.String("k", "3"))
+	// The new label sets share the record without labels.
+	assert.Equal(t, 2, sdk.RecordCount())
+
+	require.Equal(t, 2, sdk.Collect(ctx))
+	sums := map[string]int64{}
+	for _, a := range processor.accumulations {
+		sum, err := a.Aggregator().(aggregation.Sum).Sum()
+		require.NoError(t, err)
+		sums[a.Labels().Encoded(label.DefaultEncoder())] = sum.AsInt64()
+	}
+	assert.Equal(t, map[string]int64{"k=1": 2, "": 2}, sums)
+
+	sdk.ShedLabelSets(false)
+	counter.Add(ctx, 1, label.String("k", "2"))
+	assert.Equal(t, 3, sdk.RecordCount())
+}
+
+func TestShedLabelSetsHandles(t *testing.T) {
+	ctx := context.Background()
+	meter, sdk, _ := newSDK(t)
+	counter := Must(meter).NewInt64Counter("name.sum")
+	handle := label.NewHandle(label.String("k", "1"))
+
+	sdk.ShedLabelSets(true)
+	counter.AddWithSet(ctx, 1, handle.Set())
+	assert.Equal(t, 1, sdk.RecordCount())
+
+	// The record without labels is not cached for the handle.
+	sdk.ShedLabelSets(false)
+	counter.AddWithSet(ctx, 1, handle.Set())
+	assert.Equal(t, 2, sdk.RecordCount())
+}
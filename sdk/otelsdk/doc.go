@@ -0,0 +1,38 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package otelsdk sets up the OpenTelemetry SDK of an application with a
+// single call.
+//
+// Setup detects the resource, creates the propagators, the span and
+// metric exporters, the TracerProvider and the MeterProvider, and
+// registers them globally. Each of them follows the environment
+// variables of the specification, OTEL_TRACES_EXPORTER,
+// OTEL_PROPAGATORS or OTEL_BSP_SCHEDULE_DELAY for example, and can be
+// replaced by an option:
+//
+//	func main() {
+//		ctx := context.Background()
+//		shutdown, err := otelsdk.Setup(ctx)
+//		if err != nil {
+//			log.Fatal(err)
+//		}
+//		defer func() {
+//			if err := shutdown(ctx); err != nil {
+//				log.Print(err)
+//			}
+//		}()
+//		// ...
+//	}
+package otelsdk // import "go.opentelemetry.io/otel/sdk/otelsdk"
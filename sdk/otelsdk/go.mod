@@ -0,0 +1,21 @@
+module go.opentelemetry.io/otel/sdk/otelsdk
+
+go 1.14
+
+replace (
+	go.opentelemetry.io/otel => ../..
+	go.opentelemetry.io/otel/exporters/autoexport => ../../exporters/autoexport
+	go.opentelemetry.io/otel/exporters/metric/prometheus => ../../exporters/metric/prometheus
+	go.opentelemetry.io/otel/exporters/otlp => ../../exporters/otlp
+	go.opentelemetry.io/otel/exporters/stdout => ../../exporters/stdout
+	go.opentelemetry.io/otel/exporters/trace/jaeger => ../../exporters/trace/jaeger
+	go.opentelemetry.io/otel/exporters/trace/zipkin => ../../exporters/trace/zipkin
+	go.opentelemetry.io/otel/sdk => ../
+)
+
+require (
+	github.com/stretchr/testify v1.7.0
+	go.opentelemetry.io/otel v0.16.0
+	go.opentelemetry.io/otel/exporters/autoexport v0.16.0
+	go.opentelemetry.io/otel/sdk v0.16.0
+)
@@ -0,0 +1,236 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package otelsdk // import "go.opentelemetry.io/otel/sdk/otelsdk"
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/exporters/autoexport"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/propagators/autoprop"
+	exportmetric "go.opentelemetry.io/otel/sdk/export/metric"
+	exporttrace "go.opentelemetry.io/otel/sdk/export/trace"
+	controller "go.opentelemetry.io/otel/sdk/metric/controller/basic"
+	processor "go.opentelemetry.io/otel/sdk/metric/processor/basic"
+	"go.opentelemetry.io/otel/sdk/metric/selector/simple"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+)
+
+// config holds the options of Setup.
+type config struct {
+	resource          *resource.Resource
+	resourceOptions   []resource.Option
+	propagator        propagation.TextMapPropagator
+	spanExporter      exporttrace.SpanExporter
+	metricExporter    exportmetric.Exporter
+	tracerOptions     []sdktrace.TracerProviderOption
+	controllerOptions []controller.Option
+	disableTraces     bool
+	disableMetrics    bool
+}
+
+// Option configures Setup.
+type Option func(*config)
+
+// WithResource sets the resource of the providers, instead of the one
+// detected with resource.New.
+func WithResource(r *resource.Resource) Option {
+	return func(c *config) {
+		c.resource = r
+	}
+}
+
+// WithResourceOptions adds options to the detection of the resource of
+// the providers with resource.New. They are ignored if WithResource is
+// used.
+func WithResourceOptions(opts ...resource.Option) Option {
+	return func(c *config) {
+		c.resourceOptions = append(c.resourceOptions, opts...)
+	}
+}
+
+// WithPropagator sets the global propagator, instead of the one
+// configured by the OTEL_PROPAGATORS environment variable.
+func WithPropagator(p propagation.TextMapPropagator) Option {
+	return func(c *config) {
+		c.propagator = p
+	}
+}
+
+// WithSpanExporter sets the span exporter, instead of the one named by
+// the OTEL_TRACES_EXPORTER environment variable.
+func WithSpanExporter(e exporttrace.SpanExporter) Option {
+	return func(c *config) {
+		c.spanExporter = e
+	}
+}
+
+// WithMetricExporter sets the metric exporter, instead of the one named
+// by the OTEL_METRICS_EXPORTER environment variable.
+func WithMetricExporter(e exportmetric.Exporter) Option {
+	return func(c *config) {
+		c.metricExporter = e
+	}
+}
+
+// WithTracerProviderOptions adds options to the TracerProvider.
+func WithTracerProviderOptions(opts ...sdktrace.TracerProviderOption) Option {
+	return func(c *config) {
+		c.tracerOptions = append(c.tracerOptions, opts...)
+	}
+}
+
+// WithControllerOptions adds options to the controller of the
+// MeterProvider.
+func WithControllerOptions(opts ...controller.Option) Option {
+	return func(c *config) {
+		c.controllerOptions = append(c.controllerOptions, opts...)
+	}
+}
+
+// WithoutTraces leaves the global TracerProvider unchanged.
+func WithoutTraces() Option {
+	return func(c *config) {
+		c.disableTraces = true
+	}
+}
+
+// WithoutMetrics leaves the global MeterProvider unchanged.
+func WithoutMetrics() Option {
+	return func(c *config) {
+		c.disableMetrics = true
+	}
+}
+
+// ShutdownFunc flushes the telemetry and releases the resources set up by
+// Setup.
+type ShutdownFunc func(context.Context) error
+
+// Setup sets up the SDK and registers its propagator, TracerProvider and
+// MeterProvider globally. Spans are exported in batches and metrics are
+// collected periodically.
+//
+// The returned function stops the MeterProvider, exporting the last
+// metrics, and shuts down the TracerProvider and the exporters. It must
+// be called before the application exits. Nothing is registered if an
+// error is returned.
+func Setup(ctx context.Context, opts ...Option) (ShutdownFunc, error) {
+	var c config
+	for _, opt := range opts {
+		opt(&c)
+	}
+
+	var s setup
+	if err := s.start(ctx, &c); err != nil {
+		_ = s.shutdown(ctx)
+		return nil, fmt.Errorf("otelsdk: %w", err)
+	}
+
+	otel.SetTextMapPropagator(c.propagator)
+	if s.tracerProvider != nil {
+		otel.SetTracerProvider(s.tracerProvider)
+	}
+	if s.controller != nil {
+		otel.SetMeterProvider(s.controller.MeterProvider())
+	}
+	return s.shutdown, nil
+}
+
+// setup holds what Setup creates.
+type setup struct {
+	tracerProvider *sdktrace.TracerProvider
+	controller     *controller.Controller
+	metricExporter exportmetric.Exporter
+}
+
+func (s *setup) start(ctx context.Context, c *config) error {
+	res := c.resource
+	if res == nil {
+		var err error
+		if res, err = resource.New(ctx, c.resourceOptions...); err != nil {
+			return err
+		}
+	}
+
+	if c.propagator == nil {
+		c.propagator = autoprop.NewTextMapPropagator()
+	}
+
+	if !c.disableTraces {
+		exp := c.spanExporter
+		if exp == nil {
+			var err error
+			if exp, err = autoexport.NewSpanExporter(ctx); err != nil {
+				return err
+			}
+		}
+		s.tracerProvider = sdktrace.NewTracerProvider(append([]sdktrace.TracerProviderOption{
+			sdktrace.WithResource(res),
+			sdktrace.WithBatcher(exp),
+		}, c.tracerOptions...)...)
+	}
+
+	if !c.disableMetrics {
+		exp := c.metricExporter
+		if exp == nil {
+			var err error
+			if exp, err = autoexport.NewMetricExporter(ctx); err != nil {
+				return err
+			}
+		}
+		s.metricExporter = exp
+		s.controller = controller.New(
+			processor.New(simple.NewWithInexpensiveDistribution(), exp),
+			append([]controller.Option{
+				controller.WithResource(res),
+				controller.WithPusher(exp),
+			}, c.controllerOptions...)...,
+		)
+		if err := s.controller.Start(ctx); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// shutdown stops and shuts down what s holds.
+func (s *setup) shutdown(ctx context.Context) error {
+	var errs []string
+	if s.controller != nil {
+		if err := s.controller.Stop(ctx); err != nil {
+			errs = append(errs, err.Error())
+		}
+	}
+	if sd, ok := s.metricExporter.(interface {
+		Shutdown(context.Context) error
+	}); ok {
+		if err := sd.Shutdown(ctx); err != nil {
+			errs = append(errs, err.Error())
+		}
+	}
+	if s.tracerProvider != nil {
+		if err := s.tracerProvider.Shutdown(ctx); err != nil {
+			errs = append(errs, err.Error())
+		}
+	}
+	if len(errs) > 0 {
+		return fmt.Errorf("otelsdk: shutdown: %s", strings.Join(errs, "; "))
+	}
+	return nil
+}
@@ -0,0 +1,109 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package otelsdk
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.opentelemetry.io/otel"
+	ottest "go.opentelemetry.io/otel/internal/internaltest"
+	"go.opentelemetry.io/otel/label"
+	"go.opentelemetry.io/otel/metric"
+	"go.opentelemetry.io/otel/propagation"
+	exportmetric "go.opentelemetry.io/otel/sdk/export/metric"
+	"go.opentelemetry.io/otel/sdk/export/trace/tracetest"
+	"go.opentelemetry.io/otel/sdk/metric/processor/processortest"
+	"go.opentelemetry.io/otel/sdk/resource"
+)
+
+// spanExporter keeps the exported spans after it is shut down.
+type spanExporter struct {
+	*tracetest.InMemoryExporter
+	shutdown bool
+}
+
+func (e *spanExporter) Shutdown(context.Context) error {
+	e.shutdown = true
+	return nil
+}
+
+func TestSetup(t *testing.T) {
+	ctx := context.Background()
+	spans := &spanExporter{InMemoryExporter: tracetest.NewInMemoryExporter()}
+	metrics := processortest.NewExporter(exportmetric.CumulativeExportKindSelector(), label.DefaultEncoder())
+
+	shutdown, err := Setup(ctx,
+		WithResource(resource.NewWithAttributes(label.String("service.name", "test"))),
+		WithPropagator(propagation.TraceContext{}),
+		WithSpanExporter(spans),
+		WithMetricExporter(metrics),
+	)
+	require.NoError(t, err)
+
+	_, span := otel.Tracer("test").Start(ctx, "span")
+	span.End()
+	metric.Must(otel.Meter("test")).NewInt64Counter("requests.sum").Add(ctx, 1)
+	assert.Equal(t, propagation.TraceContext{}.Fields(), otel.GetTextMapPropagator().Fields())
+
+	require.NoError(t, shutdown(ctx))
+
+	assert.True(t, spans.shutdown)
+	require.Len(t, spans.GetSpans(), 1)
+	assert.Equal(t, "span", spans.GetSpans()[0].Name)
+	assert.Contains(t, spans.GetSpans()[0].Resource.Attributes(), label.String("service.name", "test"))
+	assert.Equal(t, map[string]float64{"requests.sum//service.name=test": 1}, metrics.Values())
+}
+
+func TestSetupFromEnv(t *testing.T) {
+	store, err := ottest.SetEnvVariables(map[string]string{
+		"OTEL_TRACES_EXPORTER":  "none",
+		"OTEL_METRICS_EXPORTER": "none",
+		"OTEL_PROPAGATORS":      "b3",
+	})
+	require.NoError(t, err)
+	defer func() { require.NoError(t, store.Restore()) }()
+
+	ctx := context.Background()
+	shutdown, err := Setup(ctx, WithResourceOptions(resource.WithAttributes(label.String("k", "v"))))
+	require.NoError(t, err)
+	assert.Equal(t, []string{"b3"}, otel.GetTextMapPropagator().Fields())
+	assert.NoError(t, shutdown(ctx))
+}
+
+func TestSetupError(t *testing.T) {
+	store, err := ottest.SetEnvVariables(map[string]string{
+		"OTEL_TRACES_EXPORTER":  "none",
+		"OTEL_METRICS_EXPORTER": "unknown",
+	})
+	require.NoError(t, err)
+	defer func() { require.NoError(t, store.Restore()) }()
+
+	shutdown, err := Setup(context.Background())
+	assert.Error(t, err)
+	assert.Nil(t, shutdown)
+}
+
+func TestSetupWithout(t *testing.T) {
+	ctx := context.Background()
+	var s setup
+	require.NoError(t, s.start(ctx, &config{disableTraces: true, disableMetrics: true}))
+	assert.Nil(t, s.tracerProvider)
+	assert.Nil(t, s.controller)
+	assert.NoError(t, s.shutdown(ctx))
+}
@@ -18,6 +18,8 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"sync"
+	"time"
 )
 
 var (
@@ -40,20 +42,85 @@ type Detector interface {
 // Detect calls all input detectors sequentially and merges each result with the previous one.
 // It returns the merged error too.
 func Detect(ctx context.Context, detectors ...Detector) (*Resource, error) {
-	var autoDetectedRes *Resource
-	var errInfo []string
-	for _, detector := range detectors {
+	results := make([]detectResult, len(detectors))
+	for i, detector := range detectors {
 		if detector == nil {
 			continue
 		}
 		res, err := detector.Detect(ctx)
-		if err != nil {
-			errInfo = append(errInfo, err.Error())
-			if !errors.Is(err, ErrPartialResource) {
+		results[i] = detectResult{res: res, err: err}
+	}
+	return mergeResults(results)
+}
+
+// detectResult is the outcome of a single Detector.
+type detectResult struct {
+	res *Resource
+	err error
+}
+
+// detectConcurrently calls all detectors concurrently, each bounded by
+// timeout if it is positive, and merges their results in the order of
+// detectors. A detector that does not return within its timeout is
+// reported as an error and its result, if any, is discarded.
+func detectConcurrently(ctx context.Context, timeout time.Duration, detectors ...Detector) (*Resource, error) {
+	results := make([]detectResult, len(detectors))
+	var wg sync.WaitGroup
+	for i, detector := range detectors {
+		if detector == nil {
+			continue
+		}
+		wg.Add(1)
+		go func(i int, detector Detector) {
+			defer wg.Done()
+			results[i] = detectWithTimeout(ctx, timeout, detector)
+		}(i, detector)
+	}
+	wg.Wait()
+	return mergeResults(results)
+}
+
+func detectWithTimeout(ctx context.Context, timeout time.Duration, detector Detector) detectResult {
+	if timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, timeout)
+		defer cancel()
+	}
+
+	// Buffered so a detector ignoring ctx can finish after it was
+	// abandoned without leaking the goroutine.
+	done := make(chan detectResult, 1)
+	go func() {
+		res, err := detector.Detect(ctx)
+		done <- detectResult{res: res, err: err}
+	}()
+
+	select {
+	case r := <-done:
+		return r
+	case <-ctx.Done():
+		return detectResult{err: fmt.Errorf("%T: %w", detector, ctx.Err())}
+	}
+}
+
+// mergeResults merges the resources of results in order, earlier
+// results taking precedence. Resources of failed detectors are only
+// included if the error is an ErrPartialResource. All errors are
+// aggregated into the returned error.
+func mergeResults(results []detectResult) (*Resource, error) {
+	var autoDetectedRes *Resource
+	var errInfo []string
+	for _, r := range results {
+		if r.err != nil {
+			errInfo = append(errInfo, r.err.Error())
+			if !errors.Is(r.err, ErrPartialResource) {
 				continue
 			}
 		}
-		autoDetectedRes = Merge(autoDetectedRes, res)
+		var err error
+		if autoDetectedRes, err = Merge(autoDetectedRes, r.res); err != nil {
+			errInfo = append(errInfo, err.Error())
+		}
 	}
 
 	var aggregatedError error
@@ -39,6 +39,18 @@ type (
 	// disable them.
 	Host struct{}
 
+	// Distro is a Detector that identifies a distribution of the
+	// OpenTelemetry SDK, such as a vendor wrapper, with the
+	// `telemetry.distro.*` attributes. Distributions can include it in
+	// every Resource created with New using the WithDistro option.
+	Distro struct {
+		// Name is the name of the distribution.
+		Name string
+		// Version is the version of the distribution. It is omitted
+		// if empty.
+		Version string
+	}
+
 	stringDetector struct {
 		K label.Key
 		F func() (string, error)
@@ -48,6 +60,7 @@ type (
 var (
 	_ Detector = TelemetrySDK{}
 	_ Detector = Host{}
+	_ Detector = Distro{}
 	_ Detector = stringDetector{}
 )
 
@@ -60,6 +73,19 @@ func (TelemetrySDK) Detect(context.Context) (*Resource, error) {
 	), nil
 }
 
+// Detect returns a *Resource that identifies the SDK distribution. An
+// empty Resource is returned if the distribution has no name.
+func (d Distro) Detect(context.Context) (*Resource, error) {
+	if d.Name == "" {
+		return Empty(), nil
+	}
+	attrs := []label.KeyValue{semconv.TelemetryDistroNameKey.String(d.Name)}
+	if d.Version != "" {
+		attrs = append(attrs, semconv.TelemetryDistroVersionKey.String(d.Version))
+	}
+	return NewWithAttributes(attrs...), nil
+}
+
 // Detect returns a *Resource that describes the host being run on.
 func (Host) Detect(ctx context.Context) (*Resource, error) {
 	return StringDetector(semconv.HostNameKey, os.Hostname).Detect(ctx)
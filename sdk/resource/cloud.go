@@ -0,0 +1,83 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package resource // import "go.opentelemetry.io/otel/sdk/resource"
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"time"
+)
+
+// imdsTimeout bounds the time a cloud detector waits for the instance
+// metadata service. Off-cloud the metadata address is usually not
+// routable, so this is kept short.
+const imdsTimeout = time.Second
+
+// errNotOnPlatform is returned by imdsRequest when the metadata service
+// of a cloud platform is not reachable or does not recognize the
+// request, meaning the process is not running on that platform.
+var errNotOnPlatform = errors.New("not running on platform")
+
+// imdsClient is the HTTP client used to query metadata services. It
+// does not use a proxy as metadata services are only available on the
+// link-local address of the instance.
+var imdsClient = &http.Client{
+	Transport: &http.Transport{Proxy: nil},
+}
+
+// imdsRequest sends a request to a metadata service and returns the
+// response body. It returns errNotOnPlatform if the service cannot be
+// reached or responds with a status other than 200 OK.
+func imdsRequest(ctx context.Context, method, url string, header http.Header) ([]byte, http.Header, error) {
+	req, err := http.NewRequest(method, url, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+	req = req.WithContext(ctx)
+	for k, v := range header {
+		req.Header[k] = v
+	}
+
+	resp, err := imdsClient.Do(req)
+	if err != nil {
+		return nil, nil, errNotOnPlatform
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, nil, errNotOnPlatform
+	}
+
+	body, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, nil, fmt.Errorf("reading metadata response: %w", err)
+	}
+	return body, resp.Header, nil
+}
+
+// detectCloud runs detect bounded by imdsTimeout. An empty Resource is
+// returned if detect reports it is not running on the platform.
+func detectCloud(ctx context.Context, detect func(context.Context) (*Resource, error)) (*Resource, error) {
+	ctx, cancel := context.WithTimeout(ctx, imdsTimeout)
+	defer cancel()
+
+	res, err := detect(ctx)
+	if errors.Is(err, errNotOnPlatform) {
+		return Empty(), nil
+	}
+	return res, err
+}
@@ -0,0 +1,79 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package resource // import "go.opentelemetry.io/otel/sdk/resource"
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"go.opentelemetry.io/otel/label"
+	"go.opentelemetry.io/otel/semconv"
+)
+
+// Azure is a Detector that provides information about the Azure virtual
+// machine being run on, as reported by the instance metadata service.
+// An empty Resource is returned if the process is not running on an
+// Azure VM.
+type Azure struct{}
+
+var _ Detector = Azure{}
+
+// azureEndpoint is the address of the Azure instance metadata service.
+var azureEndpoint = "http://169.254.169.254"
+
+// azureCompute is the subset of the Azure compute metadata used.
+type azureCompute struct {
+	Location       string `json:"location"`
+	Zone           string `json:"zone"`
+	SubscriptionID string `json:"subscriptionId"`
+	VMID           string `json:"vmId"`
+	Name           string `json:"name"`
+	VMSize         string `json:"vmSize"`
+}
+
+// Detect returns a *Resource that describes the Azure VM being run on.
+func (Azure) Detect(ctx context.Context) (*Resource, error) {
+	return detectCloud(ctx, detectAzure)
+}
+
+func detectAzure(ctx context.Context) (*Resource, error) {
+	body, _, err := imdsRequest(ctx, http.MethodGet, azureEndpoint+"/metadata/instance/compute?api-version=2021-02-01&format=json", http.Header{
+		"Metadata": {"true"},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var compute azureCompute
+	if err := json.Unmarshal(body, &compute); err != nil {
+		return nil, fmt.Errorf("decoding Azure compute metadata: %w", err)
+	}
+
+	attrs := []label.KeyValue{
+		semconv.CloudProviderAzure,
+		semconv.CloudPlatformAzureVM,
+	}
+	attrs = appendNonEmpty(attrs,
+		semconv.CloudRegionKey.String(compute.Location),
+		semconv.CloudAvailabilityZoneKey.String(compute.Zone),
+		semconv.CloudAccountIDKey.String(compute.SubscriptionID),
+		semconv.HostIDKey.String(compute.VMID),
+		semconv.HostNameKey.String(compute.Name),
+		semconv.HostTypeKey.String(compute.VMSize),
+	)
+	return NewWithAttributes(attrs...), nil
+}
@@ -0,0 +1,97 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package resource // import "go.opentelemetry.io/otel/sdk/resource"
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"go.opentelemetry.io/otel/label"
+	"go.opentelemetry.io/otel/semconv"
+)
+
+// EC2 is a Detector that provides information about the Amazon EC2
+// instance being run on, as reported by the instance metadata service.
+// An empty Resource is returned if the process is not running on EC2.
+type EC2 struct{}
+
+var _ Detector = EC2{}
+
+// ec2Endpoint is the address of the EC2 instance metadata service.
+var ec2Endpoint = "http://169.254.169.254"
+
+// ec2Identity is the subset of the EC2 instance identity document used.
+type ec2Identity struct {
+	AccountID        string `json:"accountId"`
+	AvailabilityZone string `json:"availabilityZone"`
+	Region           string `json:"region"`
+	InstanceID       string `json:"instanceId"`
+	InstanceType     string `json:"instanceType"`
+	ImageID          string `json:"imageId"`
+}
+
+// Detect returns a *Resource that describes the EC2 instance being run
+// on.
+func (EC2) Detect(ctx context.Context) (*Resource, error) {
+	return detectCloud(ctx, detectEC2)
+}
+
+func detectEC2(ctx context.Context) (*Resource, error) {
+	// Prefer IMDSv2, which requires a session token, and fall back to
+	// IMDSv1 if no token is issued.
+	header := http.Header{}
+	token, _, err := imdsRequest(ctx, http.MethodPut, ec2Endpoint+"/latest/api/token", http.Header{
+		"X-Aws-Ec2-Metadata-Token-Ttl-Seconds": {"60"},
+	})
+	if err == nil {
+		header.Set("X-Aws-Ec2-Metadata-Token", string(token))
+	}
+
+	body, _, err := imdsRequest(ctx, http.MethodGet, ec2Endpoint+"/latest/dynamic/instance-identity/document", header)
+	if err != nil {
+		return nil, err
+	}
+
+	var doc ec2Identity
+	if err := json.Unmarshal(body, &doc); err != nil {
+		return nil, fmt.Errorf("decoding EC2 instance identity: %w", err)
+	}
+
+	attrs := []label.KeyValue{
+		semconv.CloudProviderAWS,
+		semconv.CloudPlatformAWSEC2,
+	}
+	attrs = appendNonEmpty(attrs,
+		semconv.CloudRegionKey.String(doc.Region),
+		semconv.CloudAvailabilityZoneKey.String(doc.AvailabilityZone),
+		semconv.CloudAccountIDKey.String(doc.AccountID),
+		semconv.HostIDKey.String(doc.InstanceID),
+		semconv.HostTypeKey.String(doc.InstanceType),
+		semconv.HostImageIDKey.String(doc.ImageID),
+	)
+	return NewWithAttributes(attrs...), nil
+}
+
+// appendNonEmpty appends the kvs with a non-empty string value to attrs.
+func appendNonEmpty(attrs []label.KeyValue, kvs ...label.KeyValue) []label.KeyValue {
+	for _, kv := range kvs {
+		if kv.Value.AsString() != "" {
+			attrs = append(attrs, kv)
+		}
+	}
+	return attrs
+}
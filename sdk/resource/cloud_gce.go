@@ -0,0 +1,100 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package resource // import "go.opentelemetry.io/otel/sdk/resource"
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"go.opentelemetry.io/otel/label"
+	"go.opentelemetry.io/otel/semconv"
+)
+
+// GCE is a Detector that provides information about the Google Compute
+// Engine instance being run on, as reported by the metadata server. An
+// empty Resource is returned if the process is not running on GCE.
+type GCE struct{}
+
+var _ Detector = GCE{}
+
+// gceEndpoint is the address of the GCE metadata server.
+var gceEndpoint = "http://169.254.169.254"
+
+// gceMetadata is the subset of the GCE metadata used.
+type gceMetadata struct {
+	Project struct {
+		ProjectID string `json:"projectId"`
+	} `json:"project"`
+	Instance struct {
+		ID          json.Number `json:"id"`
+		Name        string      `json:"name"`
+		Zone        string      `json:"zone"`
+		MachineType string      `json:"machineType"`
+	} `json:"instance"`
+}
+
+// Detect returns a *Resource that describes the GCE instance being run
+// on.
+func (GCE) Detect(ctx context.Context) (*Resource, error) {
+	return detectCloud(ctx, detectGCE)
+}
+
+func detectGCE(ctx context.Context) (*Resource, error) {
+	body, header, err := imdsRequest(ctx, http.MethodGet, gceEndpoint+"/computeMetadata/v1/?recursive=true", http.Header{
+		"Metadata-Flavor": {"Google"},
+	})
+	if err != nil {
+		return nil, err
+	}
+	// Other metadata services on the same address ignore the request
+	// header, only the GCE metadata server sets it on responses.
+	if header.Get("Metadata-Flavor") != "Google" {
+		return nil, errNotOnPlatform
+	}
+
+	var md gceMetadata
+	if err := json.Unmarshal(body, &md); err != nil {
+		return nil, fmt.Errorf("decoding GCE metadata: %w", err)
+	}
+
+	// The zone and machine type are given as resource paths, for example
+	// projects/123/zones/us-central1-a.
+	zone := lastPathSegment(md.Instance.Zone)
+	var region string
+	if i := strings.LastIndex(zone, "-"); i > 0 {
+		region = zone[:i]
+	}
+
+	attrs := []label.KeyValue{
+		semconv.CloudProviderGCP,
+		semconv.CloudPlatformGCPComputeEngine,
+	}
+	attrs = appendNonEmpty(attrs,
+		semconv.CloudRegionKey.String(region),
+		semconv.CloudAvailabilityZoneKey.String(zone),
+		semconv.CloudAccountIDKey.String(md.Project.ProjectID),
+		semconv.HostIDKey.String(md.Instance.ID.String()),
+		semconv.HostNameKey.String(md.Instance.Name),
+		semconv.HostTypeKey.String(lastPathSegment(md.Instance.MachineType)),
+	)
+	return NewWithAttributes(attrs...), nil
+}
+
+func lastPathSegment(p string) string {
+	return p[strings.LastIndex(p, "/")+1:]
+}
@@ -0,0 +1,174 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package resource
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.opentelemetry.io/otel/semconv"
+)
+
+func withEndpoint(t *testing.T, endpoint *string, h http.HandlerFunc) {
+	srv := httptest.NewServer(h)
+	orig := *endpoint
+	*endpoint = srv.URL
+	t.Cleanup(func() {
+		*endpoint = orig
+		srv.Close()
+	})
+}
+
+func TestEC2Detect(t *testing.T) {
+	withEndpoint(t, &ec2Endpoint, func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/latest/api/token":
+			assert.Equal(t, http.MethodPut, r.Method)
+			_, _ = w.Write([]byte("token"))
+		case "/latest/dynamic/instance-identity/document":
+			assert.Equal(t, "token", r.Header.Get("X-Aws-Ec2-Metadata-Token"))
+			_, _ = w.Write([]byte(`{
+				"accountId": "123456789012",
+				"availabilityZone": "us-west-2b",
+				"region": "us-west-2",
+				"instanceId": "i-1234567890abcdef0",
+				"instanceType": "t2.micro",
+				"imageId": "ami-5fb8c835"
+			}`))
+		default:
+			http.NotFound(w, r)
+		}
+	})
+
+	res, err := EC2{}.Detect(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, NewWithAttributes(
+		semconv.CloudProviderAWS,
+		semconv.CloudPlatformAWSEC2,
+		semconv.CloudRegionKey.String("us-west-2"),
+		semconv.CloudAvailabilityZoneKey.String("us-west-2b"),
+		semconv.CloudAccountIDKey.String("123456789012"),
+		semconv.HostIDKey.String("i-1234567890abcdef0"),
+		semconv.HostTypeKey.String("t2.micro"),
+		semconv.HostImageIDKey.String("ami-5fb8c835"),
+	), res)
+}
+
+func TestEC2DetectIMDSv1(t *testing.T) {
+	withEndpoint(t, &ec2Endpoint, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/latest/dynamic/instance-identity/document" {
+			http.NotFound(w, r)
+			return
+		}
+		assert.Empty(t, r.Header.Get("X-Aws-Ec2-Metadata-Token"))
+		_, _ = w.Write([]byte(`{"region": "eu-west-1"}`))
+	})
+
+	res, err := EC2{}.Detect(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, NewWithAttributes(
+		semconv.CloudProviderAWS,
+		semconv.CloudPlatformAWSEC2,
+		semconv.CloudRegionKey.String("eu-west-1"),
+	), res)
+}
+
+func TestGCEDetect(t *testing.T) {
+	withEndpoint(t, &gceEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "Google", r.Header.Get("Metadata-Flavor"))
+		w.Header().Set("Metadata-Flavor", "Google")
+		_, _ = w.Write([]byte(`{
+			"project": {"projectId": "my-project"},
+			"instance": {
+				"id": 4520031799277581759,
+				"name": "instance-1",
+				"zone": "projects/123/zones/us-central1-a",
+				"machineType": "projects/123/machineTypes/n1-standard-1"
+			}
+		}`))
+	})
+
+	res, err := GCE{}.Detect(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, NewWithAttributes(
+		semconv.CloudProviderGCP,
+		semconv.CloudPlatformGCPComputeEngine,
+		semconv.CloudRegionKey.String("us-central1"),
+		semconv.CloudAvailabilityZoneKey.String("us-central1-a"),
+		semconv.CloudAccountIDKey.String("my-project"),
+		semconv.HostIDKey.String("4520031799277581759"),
+		semconv.HostNameKey.String("instance-1"),
+		semconv.HostTypeKey.String("n1-standard-1"),
+	), res)
+}
+
+func TestGCEDetectWrongFlavor(t *testing.T) {
+	withEndpoint(t, &gceEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{}`))
+	})
+
+	res, err := GCE{}.Detect(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, Empty(), res)
+}
+
+func TestAzureDetect(t *testing.T) {
+	withEndpoint(t, &azureEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "true", r.Header.Get("Metadata"))
+		_, _ = w.Write([]byte(`{
+			"location": "westeurope",
+			"zone": "1",
+			"subscriptionId": "8d10da13-8125-4ba9-a717-bf7490507b3d",
+			"vmId": "02aab8a4-74ef-476e-8182-f6d2ba4166a6",
+			"name": "examplevmname",
+			"vmSize": "Standard_A3"
+		}`))
+	})
+
+	res, err := Azure{}.Detect(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, NewWithAttributes(
+		semconv.CloudProviderAzure,
+		semconv.CloudPlatformAzureVM,
+		semconv.CloudRegionKey.String("westeurope"),
+		semconv.CloudAvailabilityZoneKey.String("1"),
+		semconv.CloudAccountIDKey.String("8d10da13-8125-4ba9-a717-bf7490507b3d"),
+		semconv.HostIDKey.String("02aab8a4-74ef-476e-8182-f6d2ba4166a6"),
+		semconv.HostNameKey.String("examplevmname"),
+		semconv.HostTypeKey.String("Standard_A3"),
+	), res)
+}
+
+func TestCloudDetectNotOnPlatform(t *testing.T) {
+	withEndpoint(t, &azureEndpoint, http.NotFound)
+
+	res, err := Azure{}.Detect(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, Empty(), res)
+}
+
+func TestCloudDetectInvalidResponse(t *testing.T) {
+	withEndpoint(t, &azureEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`not json`))
+	})
+
+	_, err := Azure{}.Detect(context.Background())
+	assert.Error(t, err)
+}
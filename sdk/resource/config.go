@@ -16,6 +16,7 @@ package resource // import "go.opentelemetry.io/otel/sdk/resource"
 
 import (
 	"context"
+	"time"
 
 	"go.opentelemetry.io/otel/label"
 )
@@ -29,6 +30,9 @@ type config struct {
 	// `telemetry.sdk.*` attributes.
 	telemetrySDK Detector
 
+	// distro is used to specify the `telemetry.distro.*` attributes.
+	distro Detector
+
 	// HostResource is used to specify non-default `host.*`
 	// attributes.
 	host Detector
@@ -36,6 +40,18 @@ type config struct {
 	// FromEnv is used to specify non-default OTEL_RESOURCE_ATTRIBUTES
 	// attributes.
 	fromEnv Detector
+
+	// schemaURL is the schema URL of the created Resource.
+	schemaURL string
+
+	// detectorTimeout bounds the time each detector may take. No
+	// bound other than the context passed to New applies if it is not
+	// positive.
+	detectorTimeout time.Duration
+
+	// filters are applied to the detected attributes. Only attributes
+	// all filters return true for are kept.
+	filters []label.Filter
 }
 
 // Option is the interface that applies a configuration option.
@@ -57,6 +73,74 @@ func (d detectAttributes) Detect(context.Context) (*Resource, error) {
 	return NewWithAttributes(d.attributes...), nil
 }
 
+// WithSchemaURL sets the schema URL of the configured Resource. An
+// error wrapping ErrSchemaURLConflict is returned from New if a
+// detector returns a Resource with a different schema URL.
+func WithSchemaURL(schemaURL string) Option {
+	return schemaURLOption(schemaURL)
+}
+
+type schemaURLOption string
+
+// Apply implements Option.
+func (o schemaURLOption) Apply(cfg *config) {
+	cfg.schemaURL = string(o)
+}
+
+// WithDetectorTimeout bounds the time each detector may take to
+// timeout. A detector that does not return in time is reported in the
+// error returned from New and its attributes are omitted, while the
+// attributes of all other detectors are still returned.
+func WithDetectorTimeout(timeout time.Duration) Option {
+	return detectorTimeoutOption(timeout)
+}
+
+type detectorTimeoutOption time.Duration
+
+// Apply implements Option.
+func (o detectorTimeoutOption) Apply(cfg *config) {
+	cfg.detectorTimeout = time.Duration(o)
+}
+
+// WithFilter restricts the attributes of the configured Resource to
+// keys. It is applied after all detectors have run, so it also applies
+// to builtin detectors. If WithFilter is used multiple times, only
+// attributes with a key passed to every use are kept.
+func WithFilter(keys ...label.Key) Option {
+	allowed := keySet(keys)
+	return filterOption(func(kv label.KeyValue) bool {
+		_, ok := allowed[kv.Key]
+		return ok
+	})
+}
+
+// WithDenyList removes the attributes with one of keys from the
+// configured Resource. It is applied after all detectors have run, so
+// it can be used to exclude sensitive attributes, like
+// process.command_args, whichever detector provides them.
+func WithDenyList(keys ...label.Key) Option {
+	denied := keySet(keys)
+	return filterOption(func(kv label.KeyValue) bool {
+		_, ok := denied[kv.Key]
+		return !ok
+	})
+}
+
+func keySet(keys []label.Key) map[label.Key]struct{} {
+	set := make(map[label.Key]struct{}, len(keys))
+	for _, k := range keys {
+		set[k] = struct{}{}
+	}
+	return set
+}
+
+type filterOption label.Filter
+
+// Apply implements Option.
+func (o filterOption) Apply(cfg *config) {
+	cfg.filters = append(cfg.filters, label.Filter(o))
+}
+
 // WithDetectors adds detectors to be evaluated for the configured resource.
 func WithDetectors(detectors ...Detector) Option {
 	return detectorsOption{detectors}
@@ -86,10 +170,46 @@ func (o telemetrySDKOption) Apply(cfg *config) {
 	cfg.telemetrySDK = o.Detector
 }
 
+// WithDistro adds the `telemetry.distro.*` attributes identifying a
+// distribution of the SDK. Distributions wrapping New can pass it so
+// backends can tell their builds apart from the upstream SDK. It is
+// disabled by WithoutBuiltin if passed after WithDistro.
+func WithDistro(name, version string) Option {
+	return distroOption{Distro{Name: name, Version: version}}
+}
+
+type distroOption struct {
+	Detector
+}
+
+// Apply implements Option.
+func (o distroOption) Apply(cfg *config) {
+	cfg.distro = o.Detector
+}
+
 // WithHost overrides the builtin `host.*` attributes.  Use nil to
-// disable these attributes entirely.
-func WithHost(d Detector) Option {
-	return hostOption{d}
+// disable these attributes entirely. Without arguments the builtin
+// Host detector is used along with a detector of the `host.arch` and,
+// where the platform provides a machine ID, `host.id` attributes. These
+// are not part of the builtin attributes, the machine ID is considered
+// confidential on some platforms. Multiple detectors are evaluated in
+// order and merged.
+func WithHost(d ...Detector) Option {
+	switch len(d) {
+	case 0:
+		return hostOption{multiDetector{Host{}, hostDetails{}}}
+	case 1:
+		return hostOption{d[0]}
+	}
+	return hostOption{multiDetector(d)}
+}
+
+// multiDetector evaluates all of its detectors as a single Detector.
+type multiDetector []Detector
+
+// Detect implements Detector.
+func (m multiDetector) Detect(ctx context.Context) (*Resource, error) {
+	return Detect(ctx, m...)
 }
 
 type hostOption struct {
@@ -101,6 +221,35 @@ func (o hostOption) Apply(cfg *config) {
 	cfg.host = o.Detector
 }
 
+// WithProcess adds the Process detector, providing the `process.*`
+// attributes of the running process.
+func WithProcess() Option {
+	return WithDetectors(Process{})
+}
+
+// WithOS adds the OS detector, providing the `os.type` and
+// `os.description` attributes.
+func WithOS() Option {
+	return WithDetectors(OS{})
+}
+
+// WithContainer adds the Container detector, providing the
+// `container.id` and `container.runtime` attributes.
+func WithContainer() Option {
+	return WithDetectors(Container{})
+}
+
+// WithCloud adds detectors for the cloud platform being run on. If no
+// detectors are passed, the EC2, GCE and Azure detectors are added.
+// Each of them returns an empty Resource when not running on its
+// platform.
+func WithCloud(detectors ...Detector) Option {
+	if len(detectors) == 0 {
+		detectors = []Detector{EC2{}, GCE{}, Azure{}}
+	}
+	return WithDetectors(detectors...)
+}
+
 // WithFromEnv overrides the builtin detector for
 // OTEL_RESOURCE_ATTRIBUTES.  Use nil to disable environment checking.
 func WithFromEnv(d Detector) Option {
@@ -117,7 +266,7 @@ func (o fromEnvOption) Apply(cfg *config) {
 }
 
 // WithoutBuiltin disables all the builtin detectors, including the
-// telemetry.sdk.*, host.*, and the environment detector.
+// telemetry.sdk.*, telemetry.distro.*, host.*, and the environment detector.
 func WithoutBuiltin() Option {
 	return noBuiltinOption{}
 }
@@ -128,11 +277,19 @@ type noBuiltinOption struct{}
 func (o noBuiltinOption) Apply(cfg *config) {
 	cfg.host = nil
 	cfg.telemetrySDK = nil
+	cfg.distro = nil
 	cfg.fromEnv = nil
 }
 
 // New returns a Resource combined from the provided attributes,
 // user-provided detectors and builtin detectors.
+//
+// The detectors are run concurrently, and the resources they return
+// are merged in order: builtin detectors first, followed by the
+// user-provided detectors in the order they were added. Attributes of
+// earlier detectors take precedence. If any detector fails, the merged
+// resource of the remaining detectors is returned along with an error
+// describing all failures.
 func New(ctx context.Context, opts ...Option) (*Resource, error) {
 	cfg := config{
 		telemetrySDK: TelemetrySDK{},
@@ -143,8 +300,42 @@ func New(ctx context.Context, opts ...Option) (*Resource, error) {
 		opt.Apply(&cfg)
 	}
 	detectors := append(
-		[]Detector{cfg.telemetrySDK, cfg.host, cfg.fromEnv},
+		[]Detector{cfg.telemetrySDK, cfg.distro, cfg.host, cfg.fromEnv},
 		cfg.detectors...,
 	)
-	return Detect(ctx, detectors...)
+	if cfg.schemaURL != "" {
+		detectors = append([]Detector{detectSchemaURL(cfg.schemaURL)}, detectors...)
+	}
+	res, err := detectConcurrently(ctx, cfg.detectorTimeout, detectors...)
+	return filter(res, cfg.filters), err
+}
+
+// filter returns res with only the attributes all filters return true
+// for.
+func filter(res *Resource, filters []label.Filter) *Resource {
+	if len(filters) == 0 || res == nil {
+		return res
+	}
+	var kept []label.KeyValue
+	for iter := res.Iter(); iter.Next(); {
+		kv := iter.Label()
+		keep := true
+		for _, f := range filters {
+			if !f(kv) {
+				keep = false
+				break
+			}
+		}
+		if keep {
+			kept = append(kept, kv)
+		}
+	}
+	return NewWithSchemaURL(res.SchemaURL(), kept...)
+}
+
+// detectSchemaURL returns an empty Resource with a schema URL.
+type detectSchemaURL string
+
+func (d detectSchemaURL) Detect(context.Context) (*Resource, error) {
+	return NewWithSchemaURL(string(d)), nil
 }
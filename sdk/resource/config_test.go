@@ -19,6 +19,7 @@ import (
 	"fmt"
 	"os"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 
@@ -26,6 +27,7 @@ import (
 	ottest "go.opentelemetry.io/otel/internal/internaltest"
 	"go.opentelemetry.io/otel/label"
 	"go.opentelemetry.io/otel/sdk/resource"
+	"go.opentelemetry.io/otel/semconv"
 )
 
 const envVar = "OTEL_RESOURCE_ATTRIBUTES"
@@ -137,3 +139,137 @@ func hostname() string {
 	}
 	return hn
 }
+
+func TestWithHostAndOS(t *testing.T) {
+	ctx := context.Background()
+	res, err := resource.New(
+		ctx,
+		resource.WithoutBuiltin(),
+		resource.WithHost(),
+		resource.WithOS(),
+	)
+	require.NoError(t, err)
+	m := toMap(res)
+	require.Contains(t, m, "host.name")
+	require.Contains(t, m, "host.arch")
+	require.Contains(t, m, "os.type")
+	require.NotContains(t, m, "telemetry.sdk.name")
+}
+
+type sleepDetector struct {
+	d   time.Duration
+	res *resource.Resource
+}
+
+func (s sleepDetector) Detect(context.Context) (*resource.Resource, error) {
+	time.Sleep(s.d)
+	return s.res, nil
+}
+
+func TestDetectorTimeout(t *testing.T) {
+	ctx := context.Background()
+	start := time.Now()
+	res, err := resource.New(
+		ctx,
+		resource.WithoutBuiltin(),
+		resource.WithDetectorTimeout(10*time.Millisecond),
+		resource.WithDetectors(
+			sleepDetector{d: time.Second, res: resource.NewWithAttributes(label.String("slow", "v"))},
+			sleepDetector{res: resource.NewWithAttributes(label.String("fast", "v"))},
+		),
+	)
+	require.Less(t, int64(time.Since(start)), int64(time.Second))
+	require.Error(t, err)
+	require.Contains(t, err.Error(), context.DeadlineExceeded.Error())
+	require.EqualValues(t, map[string]string{"fast": "v"}, toMap(res))
+}
+
+func TestConcurrentDetectorsMergeInOrder(t *testing.T) {
+	ctx := context.Background()
+	res, err := resource.New(
+		ctx,
+		resource.WithoutBuiltin(),
+		resource.WithDetectors(
+			sleepDetector{d: 20 * time.Millisecond, res: resource.NewWithAttributes(label.String("k", "first"))},
+			sleepDetector{res: resource.NewWithAttributes(label.String("k", "second"), label.String("other", "v"))},
+		),
+	)
+	require.NoError(t, err)
+	require.EqualValues(t, map[string]string{
+		"k":     "first",
+		"other": "v",
+	}, toMap(res))
+}
+
+func TestWithFilter(t *testing.T) {
+	ctx := context.Background()
+	res, err := resource.New(
+		ctx,
+		resource.WithAttributes(
+			label.String("keep", "v"),
+			label.String("drop", "v"),
+		),
+		resource.WithFilter("keep", "telemetry.sdk.name"),
+	)
+	require.NoError(t, err)
+	require.EqualValues(t, map[string]string{
+		"keep":               "v",
+		"telemetry.sdk.name": "opentelemetry",
+	}, toMap(res))
+}
+
+func TestWithDenyList(t *testing.T) {
+	ctx := context.Background()
+	res, err := resource.New(
+		ctx,
+		resource.WithoutBuiltin(),
+		resource.WithProcess(),
+		resource.WithAttributes(label.String("keep", "v")),
+		resource.WithDenyList(semconv.ProcessCommandArgsKey, semconv.ProcessOwnerKey),
+	)
+	require.NoError(t, err)
+	m := toMap(res)
+	require.NotContains(t, m, string(semconv.ProcessCommandArgsKey))
+	require.NotContains(t, m, string(semconv.ProcessOwnerKey))
+	require.Contains(t, m, string(semconv.ProcessPIDKey))
+	require.Contains(t, m, "keep")
+}
+
+func TestWithFilterAndDenyList(t *testing.T) {
+	ctx := context.Background()
+	res, err := resource.New(
+		ctx,
+		resource.WithoutBuiltin(),
+		resource.WithSchemaURL("https://opentelemetry.io/schemas/1.0.0"),
+		resource.WithAttributes(
+			label.String("a", "v"),
+			label.String("b", "v"),
+			label.String("c", "v"),
+		),
+		resource.WithFilter("a", "b"),
+		resource.WithDenyList("b"),
+	)
+	require.NoError(t, err)
+	require.EqualValues(t, map[string]string{"a": "v"}, toMap(res))
+	require.Equal(t, "https://opentelemetry.io/schemas/1.0.0", res.SchemaURL())
+}
+
+func TestWithDistro(t *testing.T) {
+	ctx := context.Background()
+	res, err := resource.New(ctx, resource.WithDistro("my-distro", "1.2.3"))
+	require.NoError(t, err)
+	m := toMap(res)
+	require.Equal(t, "my-distro", m["telemetry.distro.name"])
+	require.Equal(t, "1.2.3", m["telemetry.distro.version"])
+	require.Equal(t, "opentelemetry", m["telemetry.sdk.name"])
+
+	res, err = resource.New(ctx, resource.WithDistro("my-distro", ""), resource.WithoutBuiltin())
+	require.NoError(t, err)
+	require.Equal(t, 0, res.Len())
+
+	res, err = resource.New(ctx, resource.WithoutBuiltin(), resource.WithDistro("my-distro", ""))
+	require.NoError(t, err)
+	require.EqualValues(t, map[string]string{
+		"telemetry.distro.name": "my-distro",
+	}, toMap(res))
+}
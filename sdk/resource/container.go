@@ -0,0 +1,163 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package resource // import "go.opentelemetry.io/otel/sdk/resource"
+
+import (
+	"bufio"
+	"context"
+	"io"
+	"os"
+	"regexp"
+	"strings"
+
+	"go.opentelemetry.io/otel/label"
+	"go.opentelemetry.io/otel/semconv"
+)
+
+// Container is a Detector that provides information about the container
+// the process is running in, if any. The container ID is read from the
+// cgroup (v1 and v2) and mount information of the process, and the
+// runtime is derived from the cgroup path or well-known marker files and
+// environment variables. It is not included as a builtin; use the
+// WithContainer option to add it.
+type Container struct{}
+
+var _ Detector = Container{}
+
+// Paths read by the Container detector. They are variables so they can
+// be replaced in tests.
+var (
+	cgroupPath    = "/proc/self/cgroup"
+	mountInfoPath = "/proc/self/mountinfo"
+	dockerEnvPath = "/.dockerenv"
+	podmanEnvPath = "/run/.containerenv"
+)
+
+var (
+	// containerIDRe matches a cgroup path segment naming a container by
+	// its 64 character hex ID, optionally prefixed by the runtime that
+	// created it.
+	containerIDRe = regexp.MustCompile(`^(?:(docker|crio|cri-containerd|libpod)-)?([0-9a-f]{64})(?:\.scope)?$`)
+
+	// mountInfoIDRe matches the container ID in the per-container files
+	// that runtimes bind mount into a container with a cgroup v2 host.
+	mountInfoIDRe = regexp.MustCompile(`/(?:docker/containers|containers/storage/overlay-containers|sandboxes)/([0-9a-f]{64})/`)
+)
+
+// cgroupRuntimes maps the runtime prefixes used in cgroup paths to
+// `container.runtime` values.
+var cgroupRuntimes = map[string]string{
+	"docker":         "docker",
+	"crio":           "cri-o",
+	"cri-containerd": "containerd",
+	"libpod":         "podman",
+}
+
+// Detect returns a *Resource that describes the container the process
+// is running in. An empty Resource is returned if the process does not
+// appear to be running in a container.
+func (Container) Detect(context.Context) (*Resource, error) {
+	id, runtime := containerFromCgroup()
+	if id == "" {
+		id = containerIDFromMountInfo()
+	}
+	if runtime == "" {
+		runtime = containerRuntimeFromEnv()
+	}
+
+	var attrs []label.KeyValue
+	if id != "" {
+		attrs = append(attrs, semconv.ContainerIDKey.String(id))
+	}
+	if runtime != "" {
+		attrs = append(attrs, semconv.ContainerRuntimeKey.String(runtime))
+	}
+	return NewWithAttributes(attrs...), nil
+}
+
+// containerFromCgroup returns the container ID and, when the cgroup path
+// identifies it, the runtime from the cgroup file of the process.
+func containerFromCgroup() (id, runtime string) {
+	f, err := os.Open(cgroupPath)
+	if err != nil {
+		return "", ""
+	}
+	defer f.Close()
+	return parseCgroup(f)
+}
+
+// parseCgroup parses a cgroup(7) file. Lines are of the form
+// `hierarchy-ID:controller-list:cgroup-path`, where the hierarchy ID is 0
+// and the controller list is empty for cgroup v2.
+func parseCgroup(r io.Reader) (id, runtime string) {
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		parts := strings.SplitN(scanner.Text(), ":", 3)
+		if len(parts) != 3 {
+			continue
+		}
+		for _, segment := range strings.Split(strings.TrimSpace(parts[2]), "/") {
+			if m := containerIDRe.FindStringSubmatch(segment); m != nil {
+				return m[2], cgroupRuntimes[m[1]]
+			}
+		}
+	}
+	return "", ""
+}
+
+// containerIDFromMountInfo returns the container ID found in the mount
+// information of the process. This is needed on cgroup v2 hosts where
+// the cgroup namespace hides the container cgroup path.
+func containerIDFromMountInfo() string {
+	f, err := os.Open(mountInfoPath)
+	if err != nil {
+		return ""
+	}
+	defer f.Close()
+	return parseMountInfo(f)
+}
+
+// parseMountInfo returns the first container ID referenced by the
+// proc(5) mountinfo formatted r.
+func parseMountInfo(r io.Reader) string {
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		if m := mountInfoIDRe.FindStringSubmatch(scanner.Text()); m != nil {
+			return m[1]
+		}
+	}
+	return ""
+}
+
+// containerRuntimeFromEnv returns the container runtime based on marker
+// files and environment variables the runtimes set.
+func containerRuntimeFromEnv() string {
+	if v := os.Getenv("container"); v != "" {
+		// Set by podman, systemd-nspawn and LXC.
+		return v
+	}
+	if fileExists(podmanEnvPath) {
+		return "podman"
+	}
+	if fileExists(dockerEnvPath) {
+		return "docker"
+	}
+	return ""
+}
+
+func fileExists(path string) bool {
+	_, err := os.Stat(path)
+	return err == nil
+}
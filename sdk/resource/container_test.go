@@ -0,0 +1,133 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package resource
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.opentelemetry.io/otel/label"
+	"go.opentelemetry.io/otel/semconv"
+)
+
+const testContainerID = "ac679f8a8319c8cf7d38e1adf263bc08d23e6cd2b1d2c9bdba8a5c1d6a7e1ec1"
+
+func TestParseCgroup(t *testing.T) {
+	tests := []struct {
+		name        string
+		input       string
+		wantID      string
+		wantRuntime string
+	}{
+		{
+			name:        "docker v1",
+			input:       "12:pids:/docker/" + testContainerID + "\n11:cpu:/docker/" + testContainerID + "\n",
+			wantID:      testContainerID,
+			wantRuntime: "",
+		},
+		{
+			name:        "docker systemd",
+			input:       "0::/system.slice/docker-" + testContainerID + ".scope\n",
+			wantID:      testContainerID,
+			wantRuntime: "docker",
+		},
+		{
+			name:        "kubernetes cri-o",
+			input:       "1:name=systemd:/kubepods.slice/kubepods-burstable.slice/crio-" + testContainerID + ".scope\n",
+			wantID:      testContainerID,
+			wantRuntime: "cri-o",
+		},
+		{
+			name:        "kubernetes containerd",
+			input:       "0::/kubepods/besteffort/pod1/cri-containerd-" + testContainerID + ".scope\n",
+			wantID:      testContainerID,
+			wantRuntime: "containerd",
+		},
+		{
+			name:        "podman",
+			input:       "0::/machine.slice/libpod-" + testContainerID + ".scope/container\n",
+			wantID:      testContainerID,
+			wantRuntime: "podman",
+		},
+		{
+			name:  "cgroup v2 namespaced",
+			input: "0::/\n",
+		},
+		{
+			name:  "host",
+			input: "0::/user.slice/user-1000.slice/session-2.scope\n",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			id, runtime := parseCgroup(strings.NewReader(tc.input))
+			assert.Equal(t, tc.wantID, id)
+			assert.Equal(t, tc.wantRuntime, runtime)
+		})
+	}
+}
+
+func TestParseMountInfo(t *testing.T) {
+	input := "1 0 0:1 / / rw - overlay overlay rw\n" +
+		"2 1 8:1 /var/lib/docker/containers/" + testContainerID + "/hostname /etc/hostname rw - ext4 /dev/sda1 rw\n"
+	assert.Equal(t, testContainerID, parseMountInfo(strings.NewReader(input)))
+	assert.Equal(t, "", parseMountInfo(strings.NewReader("1 0 0:1 / / rw - ext4 /dev/sda1 rw\n")))
+}
+
+func TestContainerDetect(t *testing.T) {
+	dir, err := ioutil.TempDir("", "container")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	write := func(name, content string) string {
+		path := filepath.Join(dir, name)
+		require.NoError(t, ioutil.WriteFile(path, []byte(content), 0600))
+		return path
+	}
+
+	orig := []string{cgroupPath, mountInfoPath, dockerEnvPath, podmanEnvPath}
+	defer func() {
+		cgroupPath, mountInfoPath, dockerEnvPath, podmanEnvPath = orig[0], orig[1], orig[2], orig[3]
+	}()
+	require.NoError(t, os.Unsetenv("container"))
+
+	cgroupPath = write("cgroup", "0::/\n")
+	mountInfoPath = write("mountinfo", "2 1 8:1 /var/lib/docker/containers/"+testContainerID+"/hostname /etc/hostname rw - ext4 /dev/sda1 rw\n")
+	dockerEnvPath = write("dockerenv", "")
+	podmanEnvPath = filepath.Join(dir, "missing")
+
+	res, err := Container{}.Detect(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, NewWithAttributes(
+		semconv.ContainerIDKey.String(testContainerID),
+		semconv.ContainerRuntimeKey.String("docker"),
+	), res)
+
+	cgroupPath = filepath.Join(dir, "missing")
+	mountInfoPath = filepath.Join(dir, "missing")
+	dockerEnvPath = filepath.Join(dir, "missing")
+
+	res, err = Container{}.Detect(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, []label.KeyValue(nil), res.Attributes())
+}
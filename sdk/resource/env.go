@@ -17,18 +17,28 @@ package resource // import "go.opentelemetry.io/otel/sdk/resource"
 import (
 	"context"
 	"fmt"
+	"net/url"
 	"os"
 	"strings"
 
 	"go.opentelemetry.io/otel/label"
+	"go.opentelemetry.io/otel/semconv"
 )
 
 // envVar is the environment variable name OpenTelemetry Resource information can be assigned to.
 const envVar = "OTEL_RESOURCE_ATTRIBUTES"
 
+// svcNameVar is the environment variable name the Service Name can be
+// assigned to. It takes precedence over a service.name set with envVar.
+const svcNameVar = "OTEL_SERVICE_NAME"
+
 var (
 	// errMissingValue is returned when a resource value is missing.
 	errMissingValue = fmt.Errorf("%w: missing value", ErrPartialResource)
+
+	// errInvalidValue is returned when a resource value cannot be
+	// URL-decoded.
+	errInvalidValue = fmt.Errorf("%w: invalid value", ErrPartialResource)
 )
 
 // FromEnv is a Detector that implements the Detector and collects
@@ -36,6 +46,11 @@ var (
 // builtin.  If these resource attributes are not wanted, use the
 // WithFromEnv(nil) or WithoutBuiltin() options to explicitly disable
 // them.
+//
+// Attributes are read from OTEL_RESOURCE_ATTRIBUTES as a comma-separated
+// list of key=value pairs, where values are URL-decoded. The
+// service.name attribute is read from OTEL_SERVICE_NAME when set, taking
+// precedence over OTEL_RESOURCE_ATTRIBUTES.
 type FromEnv struct{}
 
 // compile time assertion that FromEnv implements Detector interface
@@ -44,29 +59,45 @@ var _ Detector = FromEnv{}
 // Detect collects resources from environment
 func (FromEnv) Detect(context.Context) (*Resource, error) {
 	labels := strings.TrimSpace(os.Getenv(envVar))
+	svcName := strings.TrimSpace(os.Getenv(svcNameVar))
 
-	if labels == "" {
-		return Empty(), nil
+	res, err := Empty(), error(nil)
+	if labels != "" {
+		res, err = constructOTResources(labels)
+	}
+	if svcName != "" {
+		// Merging a resource without a schema URL cannot conflict.
+		res, _ = Merge(NewWithAttributes(semconv.ServiceNameKey.String(svcName)), res)
 	}
-	return constructOTResources(labels)
+	return res, err
 }
 
 func constructOTResources(s string) (*Resource, error) {
 	pairs := strings.Split(s, ",")
 	labels := []label.KeyValue{}
-	var invalid []string
+	var missing, invalid []string
 	for _, p := range pairs {
 		field := strings.SplitN(p, "=", 2)
-		if len(field) != 2 {
-			invalid = append(invalid, p)
+		if len(field) != 2 || strings.TrimSpace(field[0]) == "" {
+			missing = append(missing, p)
 			continue
 		}
 		k, v := strings.TrimSpace(field[0]), strings.TrimSpace(field[1])
-		labels = append(labels, label.String(k, v))
+		decoded, err := url.PathUnescape(v)
+		if err != nil {
+			invalid = append(invalid, p)
+			continue
+		}
+		labels = append(labels, label.String(k, decoded))
 	}
 	var err error
-	if len(invalid) > 0 {
-		err = fmt.Errorf("%w: %v", errMissingValue, invalid)
+	switch {
+	case len(missing) > 0 && len(invalid) > 0:
+		err = fmt.Errorf("%w: %v, invalid value: %v", errMissingValue, missing, invalid)
+	case len(missing) > 0:
+		err = fmt.Errorf("%w: %v", errMissingValue, missing)
+	case len(invalid) > 0:
+		err = fmt.Errorf("%w: %v", errInvalidValue, invalid)
 	}
 	return NewWithAttributes(labels...), err
 }
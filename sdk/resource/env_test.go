@@ -16,6 +16,7 @@ package resource
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"testing"
 
@@ -24,6 +25,7 @@ import (
 
 	ottest "go.opentelemetry.io/otel/internal/internaltest"
 	"go.opentelemetry.io/otel/label"
+	"go.opentelemetry.io/otel/semconv"
 )
 
 func TestDetectOnePair(t *testing.T) {
@@ -86,3 +88,65 @@ func TestMissingKeyError(t *testing.T) {
 		label.String("key", "value"),
 	))
 }
+
+func TestDetectURLDecodedValues(t *testing.T) {
+	store, err := ottest.SetEnvVariables(map[string]string{
+		envVar: "key=a%2Cb%3Dc, space=hello%20world, plus=a+b",
+	})
+	require.NoError(t, err)
+	defer func() { require.NoError(t, store.Restore()) }()
+
+	detector := &FromEnv{}
+	res, err := detector.Detect(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, NewWithAttributes(
+		label.String("key", "a,b=c"),
+		label.String("space", "hello world"),
+		label.String("plus", "a+b"),
+	), res)
+}
+
+func TestInvalidValueError(t *testing.T) {
+	store, err := ottest.SetEnvVariables(map[string]string{
+		envVar: "key=value,bad=%zz",
+	})
+	require.NoError(t, err)
+	defer func() { require.NoError(t, store.Restore()) }()
+
+	detector := &FromEnv{}
+	res, err := detector.Detect(context.Background())
+	assert.True(t, errors.Is(err, ErrPartialResource))
+	assert.Equal(t, err, fmt.Errorf("%w: %v", errInvalidValue, "[bad=%zz]"))
+	assert.Equal(t, NewWithAttributes(label.String("key", "value")), res)
+}
+
+func TestDetectServiceName(t *testing.T) {
+	store, err := ottest.SetEnvVariables(map[string]string{
+		envVar:     "service.name=from-attributes,key=value",
+		svcNameVar: "from-service-name",
+	})
+	require.NoError(t, err)
+	defer func() { require.NoError(t, store.Restore()) }()
+
+	detector := &FromEnv{}
+	res, err := detector.Detect(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, NewWithAttributes(
+		semconv.ServiceNameKey.String("from-service-name"),
+		label.String("key", "value"),
+	), res)
+}
+
+func TestDetectServiceNameOnly(t *testing.T) {
+	store, err := ottest.SetEnvVariables(map[string]string{
+		envVar:     "",
+		svcNameVar: "svc",
+	})
+	require.NoError(t, err)
+	defer func() { require.NoError(t, store.Restore()) }()
+
+	detector := &FromEnv{}
+	res, err := detector.Detect(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, NewWithAttributes(semconv.ServiceNameKey.String("svc")), res)
+}
@@ -0,0 +1,92 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package resource // import "go.opentelemetry.io/otel/sdk/resource"
+
+import (
+	"context"
+	"io/ioutil"
+	"runtime"
+	"strings"
+
+	"go.opentelemetry.io/otel/label"
+	"go.opentelemetry.io/otel/semconv"
+)
+
+// machineIDPaths are the files, by GOOS, that may contain a stable
+// identifier of the host. The first readable, non-empty file is used.
+var machineIDPaths = map[string][]string{
+	"linux":     {"/etc/machine-id", "/var/lib/dbus/machine-id"},
+	"freebsd":   {"/etc/hostid"},
+	"netbsd":    {"/etc/machine-id"},
+	"openbsd":   {"/etc/machine-id"},
+	"dragonfly": {"/etc/hostid"},
+}
+
+// hostDetails is a Detector of the `host.arch` and `host.id` attributes.
+// It is added by WithHost, but not included as a builtin.
+type hostDetails struct{}
+
+var _ Detector = hostDetails{}
+
+// Detect returns a *Resource with the architecture of the host and, where
+// the platform provides a machine ID, its ID.
+func (hostDetails) Detect(context.Context) (*Resource, error) {
+	attrs := []label.KeyValue{hostArch()}
+	if id := hostID(); id != "" {
+		attrs = append(attrs, semconv.HostIDKey.String(id))
+	}
+	return NewWithAttributes(attrs...), nil
+}
+
+// hostArch returns the `host.arch` attribute for the architecture the
+// process was compiled for. Architectures without a semantic convention
+// value are reported using their GOARCH name.
+func hostArch() label.KeyValue {
+	switch runtime.GOARCH {
+	case "amd64":
+		return semconv.HostArchAMD64
+	case "arm":
+		return semconv.HostArchARM32
+	case "arm64":
+		return semconv.HostArchARM64
+	case "386":
+		return semconv.HostArchX86
+	case "ppc64", "ppc64le":
+		return semconv.HostArchPPC64
+	default:
+		return semconv.HostArchKey.String(runtime.GOARCH)
+	}
+}
+
+// hostID returns the machine ID of the host, or an empty string if it
+// is not available on this platform.
+func hostID() string {
+	for _, path := range machineIDPaths[runtime.GOOS] {
+		if id := readTrimmed(path); id != "" {
+			return id
+		}
+	}
+	return ""
+}
+
+// readTrimmed returns the whitespace trimmed contents of the file at
+// path. An empty string is returned if the file cannot be read.
+func readTrimmed(path string) string {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return ""
+	}
+	return strings.TrimSpace(string(data))
+}
@@ -0,0 +1,115 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package resource // import "go.opentelemetry.io/otel/sdk/resource"
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"runtime"
+	"strconv"
+	"strings"
+
+	"go.opentelemetry.io/otel/label"
+	"go.opentelemetry.io/otel/semconv"
+)
+
+// OS is a Detector that provides information about the operating system
+// being run on. It is not included as a builtin; use the WithOS option
+// to add it.
+type OS struct{}
+
+var _ Detector = OS{}
+
+// Detect returns a *Resource that describes the operating system being
+// run on.
+func (OS) Detect(context.Context) (*Resource, error) {
+	attrs := []label.KeyValue{osType()}
+	if desc := osDescription(); desc != "" {
+		attrs = append(attrs, semconv.OSDescriptionKey.String(desc))
+	}
+	return NewWithAttributes(attrs...), nil
+}
+
+// osType returns the `os.type` attribute for the operating system the
+// process was compiled for.
+func osType() label.KeyValue {
+	switch runtime.GOOS {
+	case "dragonfly":
+		return semconv.OSTypeDragonflyBSD
+	case "zos":
+		return semconv.OSTypeZOS
+	default:
+		return semconv.OSTypeKey.String(runtime.GOOS)
+	}
+}
+
+// osDescription returns a human readable description of the operating
+// system. On Linux the distribution name from os-release(5) is combined
+// with the kernel release, elsewhere GOOS and GOARCH are used.
+func osDescription() string {
+	if runtime.GOOS != "linux" {
+		return fmt.Sprintf("%s %s", runtime.GOOS, runtime.GOARCH)
+	}
+
+	name := "Linux"
+	for _, path := range []string{"/etc/os-release", "/usr/lib/os-release"} {
+		f, err := os.Open(path)
+		if err != nil {
+			continue
+		}
+		if n := parseOSRelease(f); n != "" {
+			name = n
+		}
+		f.Close()
+		break
+	}
+
+	if kernel := readTrimmed("/proc/sys/kernel/osrelease"); kernel != "" {
+		return fmt.Sprintf("%s (Linux %s %s)", name, kernel, runtime.GOARCH)
+	}
+	return name
+}
+
+// parseOSRelease returns the PRETTY_NAME, or NAME and VERSION if that
+// is not set, from the os-release(5) formatted r.
+func parseOSRelease(r io.Reader) string {
+	values := map[string]string{}
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		kv := strings.SplitN(line, "=", 2)
+		if len(kv) != 2 {
+			continue
+		}
+		v := kv[1]
+		if unquoted, err := strconv.Unquote(v); err == nil {
+			v = unquoted
+		} else {
+			v = strings.Trim(v, `'"`)
+		}
+		values[kv[0]] = v
+	}
+
+	if pretty := values["PRETTY_NAME"]; pretty != "" {
+		return pretty
+	}
+	return strings.TrimSpace(values["NAME"] + " " + values["VERSION"])
+}
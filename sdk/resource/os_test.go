@@ -0,0 +1,95 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package resource
+
+import (
+	"context"
+	"runtime"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.opentelemetry.io/otel/semconv"
+)
+
+func TestParseOSRelease(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		want  string
+	}{
+		{
+			name:  "pretty name",
+			input: "NAME=\"Debian GNU/Linux\"\nVERSION=\"12 (bookworm)\"\nPRETTY_NAME=\"Debian GNU/Linux 12 (bookworm)\"\n",
+			want:  "Debian GNU/Linux 12 (bookworm)",
+		},
+		{
+			name:  "name and version",
+			input: "# comment\nNAME=Alpine\nVERSION='3.13'\n",
+			want:  "Alpine 3.13",
+		},
+		{
+			name:  "escaped",
+			input: `PRETTY_NAME="Distro \"Quoted\""`,
+			want:  `Distro "Quoted"`,
+		},
+		{
+			name:  "empty",
+			input: "garbage\n",
+			want:  "",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.want, parseOSRelease(strings.NewReader(tc.input)))
+		})
+	}
+}
+
+func TestOSDetect(t *testing.T) {
+	res, err := OS{}.Detect(context.Background())
+	require.NoError(t, err)
+
+	v, ok := res.LabelSet().Value(semconv.OSTypeKey)
+	require.True(t, ok)
+	if runtime.GOOS == "linux" {
+		assert.Equal(t, "linux", v.AsString())
+	}
+	assert.True(t, res.LabelSet().HasValue(semconv.OSDescriptionKey))
+}
+
+func TestHostDetect(t *testing.T) {
+	res, err := Host{}.Detect(context.Background())
+	require.NoError(t, err)
+	assert.True(t, res.LabelSet().HasValue(semconv.HostNameKey))
+	assert.False(t, res.LabelSet().HasValue(semconv.HostArchKey))
+	assert.False(t, res.LabelSet().HasValue(semconv.HostIDKey))
+}
+
+func TestHostDetailsDetect(t *testing.T) {
+	res, err := hostDetails{}.Detect(context.Background())
+	require.NoError(t, err)
+
+	v, ok := res.LabelSet().Value(semconv.HostArchKey)
+	require.True(t, ok)
+	if runtime.GOARCH == "amd64" {
+		assert.Equal(t, "amd64", v.AsString())
+	}
+	assert.False(t, res.LabelSet().HasValue(semconv.HostNameKey))
+	assert.Equal(t, hostID() != "", res.LabelSet().HasValue(semconv.HostIDKey))
+}
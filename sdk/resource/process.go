@@ -0,0 +1,77 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package resource // import "go.opentelemetry.io/otel/sdk/resource"
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"os/user"
+	"path/filepath"
+	"runtime"
+
+	"go.opentelemetry.io/otel/label"
+	"go.opentelemetry.io/otel/semconv"
+)
+
+// Process is a Detector that provides information about the running
+// process: its PID, executable, command arguments, owner, and the Go
+// runtime it was built with. It is not included as a builtin because
+// command arguments may contain sensitive values; use the WithProcess
+// option to add it.
+type Process struct{}
+
+var _ Detector = Process{}
+
+// Detect returns a *Resource that describes the running process. If
+// the executable path or the owner of the process cannot be determined
+// the remaining attributes are returned along with a wrapped
+// ErrPartialResource error.
+func (Process) Detect(context.Context) (*Resource, error) {
+	attrs := []label.KeyValue{
+		semconv.ProcessPIDKey.Int(os.Getpid()),
+		semconv.ProcessCommandArgsKey.Array(os.Args),
+		semconv.ProcessRuntimeNameKey.String(runtime.Compiler),
+		semconv.ProcessRuntimeVersionKey.String(runtime.Version()),
+		semconv.ProcessRuntimeDescriptionKey.String(runtimeDescription()),
+	}
+
+	var errs []string
+	if path, err := os.Executable(); err != nil {
+		errs = append(errs, fmt.Sprintf("%s: %s", semconv.ProcessExecutablePathKey, err))
+	} else {
+		attrs = append(attrs,
+			semconv.ProcessExecutableNameKey.String(filepath.Base(path)),
+			semconv.ProcessExecutablePathKey.String(path),
+		)
+	}
+	if u, err := user.Current(); err != nil {
+		errs = append(errs, fmt.Sprintf("%s: %s", semconv.ProcessOwnerKey, err))
+	} else {
+		attrs = append(attrs, semconv.ProcessOwnerKey.String(u.Username))
+	}
+
+	res := NewWithAttributes(attrs...)
+	if len(errs) > 0 {
+		return res, fmt.Errorf("%w: %v", ErrPartialResource, errs)
+	}
+	return res, nil
+}
+
+// runtimeDescription returns the Go runtime description in the same
+// form as the `go version` command.
+func runtimeDescription() string {
+	return fmt.Sprintf("go version %s %s/%s", runtime.Version(), runtime.GOOS, runtime.GOARCH)
+}
@@ -0,0 +1,76 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package resource_test
+
+import (
+	"context"
+	"os"
+	"os/user"
+	"path/filepath"
+	"reflect"
+	"runtime"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.opentelemetry.io/otel/label"
+	"go.opentelemetry.io/otel/sdk/resource"
+	"go.opentelemetry.io/otel/semconv"
+)
+
+func TestProcessDetect(t *testing.T) {
+	res, err := resource.Process{}.Detect(context.Background())
+	require.NoError(t, err)
+
+	set := res.LabelSet()
+	value := func(k label.Key) label.Value {
+		v, ok := set.Value(k)
+		require.True(t, ok, "missing %s", k)
+		return v
+	}
+
+	assert.Equal(t, int64(os.Getpid()), value(semconv.ProcessPIDKey).AsInt64())
+	assert.Equal(t, runtime.Compiler, value(semconv.ProcessRuntimeNameKey).AsString())
+	assert.Equal(t, runtime.Version(), value(semconv.ProcessRuntimeVersionKey).AsString())
+	assert.Contains(t, value(semconv.ProcessRuntimeDescriptionKey).AsString(), runtime.Version())
+
+	exe, err := os.Executable()
+	require.NoError(t, err)
+	assert.Equal(t, exe, value(semconv.ProcessExecutablePathKey).AsString())
+	assert.Equal(t, filepath.Base(exe), value(semconv.ProcessExecutableNameKey).AsString())
+
+	args := value(semconv.ProcessCommandArgsKey)
+	assert.Equal(t, label.ARRAY, args.Type())
+	rArgs := reflect.ValueOf(args.AsArray())
+	require.Equal(t, len(os.Args), rArgs.Len())
+	for i, arg := range os.Args {
+		assert.Equal(t, arg, rArgs.Index(i).String())
+	}
+
+	u, err := user.Current()
+	require.NoError(t, err)
+	assert.Equal(t, u.Username, value(semconv.ProcessOwnerKey).AsString())
+}
+
+func TestWithProcess(t *testing.T) {
+	res, err := resource.New(context.Background(),
+		resource.WithoutBuiltin(),
+		resource.WithProcess(),
+	)
+	require.NoError(t, err)
+	assert.True(t, res.LabelSet().HasValue(semconv.ProcessPIDKey))
+	assert.True(t, res.LabelSet().HasValue(semconv.ProcessRuntimeVersionKey))
+}
@@ -0,0 +1,76 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package resource // import "go.opentelemetry.io/otel/sdk/resource"
+
+import (
+	"context"
+	"sync"
+)
+
+// Refreshable holds a Resource whose attributes can be re-detected on
+// demand, for example to pick up dynamic metadata like the lifecycle
+// state of a spot instance. It is safe for concurrent use.
+type Refreshable struct {
+	opts []Option
+
+	mu        sync.RWMutex
+	res       *Resource
+	callbacks []func(*Resource)
+}
+
+// NewRefreshable returns a Refreshable holding the Resource returned by
+// New(ctx, opts...). The same options are used to re-detect the Resource
+// when Refresh is called. As with New, a non-nil Refreshable is
+// returned along with any detection error.
+func NewRefreshable(ctx context.Context, opts ...Option) (*Refreshable, error) {
+	res, err := New(ctx, opts...)
+	return &Refreshable{opts: opts, res: res}, err
+}
+
+// Resource returns the most recently detected Resource.
+func (r *Refreshable) Resource() *Resource {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	return r.res
+}
+
+// Refresh re-detects the Resource and, if it changed, notifies all
+// callbacks registered with OnRefresh. If detection fails the partial
+// Resource that was detected is still used and the error is returned.
+func (r *Refreshable) Refresh(ctx context.Context) error {
+	res, err := New(ctx, r.opts...)
+
+	r.mu.Lock()
+	changed := !r.res.Equal(res)
+	r.res = res
+	callbacks := r.callbacks
+	r.mu.Unlock()
+
+	if changed {
+		for _, f := range callbacks {
+			f(res)
+		}
+	}
+	return err
+}
+
+// OnRefresh registers f to be called with the new Resource whenever a
+// Refresh changes it. Callbacks are called synchronously by Refresh in
+// the order they were registered.
+func (r *Refreshable) OnRefresh(f func(*Resource)) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.callbacks = append(r.callbacks, f)
+}
@@ -0,0 +1,73 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package resource_test
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"go.opentelemetry.io/otel/label"
+	"go.opentelemetry.io/otel/sdk/resource"
+)
+
+type counterDetector struct {
+	n   *int64
+	err error
+}
+
+func (d counterDetector) Detect(context.Context) (*resource.Resource, error) {
+	n := atomic.AddInt64(d.n, 1)
+	return resource.NewWithAttributes(label.Int64("n", n/2)), d.err
+}
+
+func TestRefreshable(t *testing.T) {
+	ctx := context.Background()
+	var n int64
+	r, err := resource.NewRefreshable(ctx,
+		resource.WithoutBuiltin(),
+		resource.WithDetectors(counterDetector{n: &n}),
+	)
+	require.NoError(t, err)
+	require.Equal(t, resource.NewWithAttributes(label.Int64("n", 0)), r.Resource())
+
+	var got []*resource.Resource
+	r.OnRefresh(func(res *resource.Resource) { got = append(got, res) })
+
+	// n is 2: the resource changes and callbacks are notified.
+	require.NoError(t, r.Refresh(ctx))
+	require.Equal(t, resource.NewWithAttributes(label.Int64("n", 1)), r.Resource())
+	require.Len(t, got, 1)
+	require.Equal(t, r.Resource(), got[0])
+
+	// n is 3: the resource is unchanged and callbacks are not notified.
+	require.NoError(t, r.Refresh(ctx))
+	require.Len(t, got, 1)
+}
+
+func TestRefreshableError(t *testing.T) {
+	ctx := context.Background()
+	var n int64
+	r, err := resource.NewRefreshable(ctx,
+		resource.WithoutBuiltin(),
+		resource.WithDetectors(counterDetector{n: &n, err: errors.New("boom")}),
+	)
+	require.Error(t, err)
+	require.NotNil(t, r)
+	require.Error(t, r.Refresh(ctx))
+}
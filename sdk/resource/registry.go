@@ -0,0 +1,151 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package resource // import "go.opentelemetry.io/otel/sdk/resource"
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+	"sync"
+)
+
+// detectorsEnvVar is the environment variable name the detectors used by
+// WithDetectorsFromEnv are configured with.
+const detectorsEnvVar = "OTEL_RESOURCE_DETECTORS"
+
+var (
+	// errUnknownDetector is returned when a detector name is not
+	// registered.
+	errUnknownDetector = errors.New("unknown resource detector")
+	// errDuplicateDetector is returned when a detector name is already
+	// registered.
+	errDuplicateDetector = errors.New("duplicate resource detector registration")
+	// errInvalidDetector is returned when a detector cannot be
+	// registered.
+	errInvalidDetector = errors.New("invalid resource detector registration")
+)
+
+// detectorRegistry holds the known Detectors by name.
+type detectorRegistry struct {
+	mu    sync.Mutex
+	names map[string]Detector
+}
+
+func (r *detectorRegistry) load(name string) (Detector, bool) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	d, ok := r.names[name]
+	return d, ok
+}
+
+func (r *detectorRegistry) store(name string, d Detector) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if _, ok := r.names[name]; ok {
+		return fmt.Errorf("%w: %q", errDuplicateDetector, name)
+	}
+	r.names[name] = d
+	return nil
+}
+
+var registry = &detectorRegistry{
+	names: map[string]Detector{
+		"env":           FromEnv{},
+		"telemetry.sdk": TelemetrySDK{},
+		"host":          Host{},
+		"os":            OS{},
+		"process":       Process{},
+		"container":     Container{},
+		"ec2":           EC2{},
+		"gce":           GCE{},
+		"azure":         Azure{},
+	},
+}
+
+// RegisterDetector makes the Detector d available under name to
+// DetectorsByName and WithDetectorsFromEnv. Names are case-insensitive.
+// An error is returned if name is empty or already registered, or d is
+// nil.
+func RegisterDetector(name string, d Detector) error {
+	name = strings.ToLower(strings.TrimSpace(name))
+	if name == "" || d == nil {
+		return fmt.Errorf("%w: %q", errInvalidDetector, name)
+	}
+	return registry.store(name, d)
+}
+
+// DetectorsByName returns the Detectors registered with names, in the
+// order they are passed. The builtin names are env, telemetry.sdk, host,
+// os, process, container, ec2, gce and azure. The known detectors are
+// returned along with an error if any name is not registered.
+func DetectorsByName(names ...string) ([]Detector, error) {
+	detectors := make([]Detector, 0, len(names))
+	var unknown []string
+	for _, name := range names {
+		name = strings.ToLower(strings.TrimSpace(name))
+		if name == "" {
+			continue
+		}
+		d, ok := registry.load(name)
+		if !ok {
+			unknown = append(unknown, name)
+			continue
+		}
+		detectors = append(detectors, d)
+	}
+	if len(unknown) > 0 {
+		return detectors, fmt.Errorf("%w: %s", errUnknownDetector, strings.Join(unknown, ", "))
+	}
+	return detectors, nil
+}
+
+// WithDetectorsFromEnv adds the detectors named by the comma-separated
+// OTEL_RESOURCE_DETECTORS environment variable, for example
+// "env,host,process,container". The variable is read when the Resource
+// is created. Unknown names are reported in the error returned from New
+// while the known detectors are still run.
+func WithDetectorsFromEnv() Option {
+	return WithDetectors(envDetectors{})
+}
+
+// envDetectors is a Detector that runs the detectors named by the
+// OTEL_RESOURCE_DETECTORS environment variable.
+type envDetectors struct{}
+
+func (envDetectors) Detect(ctx context.Context) (*Resource, error) {
+	value := strings.TrimSpace(os.Getenv(detectorsEnvVar))
+	if value == "" {
+		return Empty(), nil
+	}
+
+	detectors, lookupErr := DetectorsByName(strings.Split(value, ",")...)
+	res, err := Detect(ctx, detectors...)
+
+	// Report failures as partial so the attributes of the detectors
+	// that succeeded are kept.
+	var errs []string
+	if lookupErr != nil {
+		errs = append(errs, fmt.Sprintf("%s: %v", detectorsEnvVar, lookupErr))
+	}
+	if err != nil {
+		errs = append(errs, err.Error())
+	}
+	if len(errs) > 0 {
+		return res, fmt.Errorf("%w: %s", ErrPartialResource, strings.Join(errs, "; "))
+	}
+	return res, nil
+}
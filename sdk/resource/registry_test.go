@@ -0,0 +1,80 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package resource
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	ottest "go.opentelemetry.io/otel/internal/internaltest"
+	"go.opentelemetry.io/otel/label"
+	"go.opentelemetry.io/otel/semconv"
+)
+
+func TestRegisterDetector(t *testing.T) {
+	d := detectAttributes{[]label.KeyValue{label.String("custom", "v")}}
+	require.NoError(t, RegisterDetector("Custom-Test", d))
+	defer func() {
+		registry.mu.Lock()
+		delete(registry.names, "custom-test")
+		registry.mu.Unlock()
+	}()
+
+	err := RegisterDetector("custom-test", d)
+	assert.True(t, errors.Is(err, errDuplicateDetector))
+	assert.True(t, errors.Is(RegisterDetector(" ", d), errInvalidDetector))
+	assert.True(t, errors.Is(RegisterDetector("nil", nil), errInvalidDetector))
+
+	got, err := DetectorsByName("custom-test", "HOST")
+	require.NoError(t, err)
+	assert.Equal(t, []Detector{d, Host{}}, got)
+}
+
+func TestDetectorsByNameUnknown(t *testing.T) {
+	got, err := DetectorsByName("host", "unknown")
+	assert.True(t, errors.Is(err, errUnknownDetector))
+	assert.Equal(t, []Detector{Host{}}, got)
+}
+
+func TestWithDetectorsFromEnv(t *testing.T) {
+	store, err := ottest.SetEnvVariables(map[string]string{
+		detectorsEnvVar: "process, os",
+	})
+	require.NoError(t, err)
+	defer func() { require.NoError(t, store.Restore()) }()
+
+	res, err := New(context.Background(), WithoutBuiltin(), WithDetectorsFromEnv())
+	require.NoError(t, err)
+	assert.True(t, res.LabelSet().HasValue(semconv.ProcessPIDKey))
+	assert.True(t, res.LabelSet().HasValue(semconv.OSTypeKey))
+	assert.False(t, res.LabelSet().HasValue(semconv.HostNameKey))
+}
+
+func TestWithDetectorsFromEnvUnknown(t *testing.T) {
+	store, err := ottest.SetEnvVariables(map[string]string{
+		detectorsEnvVar: "os,bogus",
+	})
+	require.NoError(t, err)
+	defer func() { require.NoError(t, store.Restore()) }()
+
+	res, err := New(context.Background(), WithoutBuiltin(), WithDetectorsFromEnv())
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "bogus")
+	assert.True(t, res.LabelSet().HasValue(semconv.OSTypeKey))
+}
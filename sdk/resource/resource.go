@@ -15,6 +15,9 @@
 package resource // import "go.opentelemetry.io/otel/sdk/resource"
 
 import (
+	"errors"
+	"fmt"
+
 	"go.opentelemetry.io/otel/label"
 )
 
@@ -26,17 +29,33 @@ import (
 // (`*resource.Resource`).  The `nil` value is equivalent to an empty
 // Resource.
 type Resource struct {
-	labels label.Set
+	labels    label.Set
+	schemaURL string
 }
 
-var emptyResource Resource
+var (
+	emptyResource Resource
+
+	// ErrSchemaURLConflict is returned by Merge when the resources
+	// being merged have different, non-empty schema URLs.
+	ErrSchemaURLConflict = errors.New("cannot merge resource due to conflicting Schema URL")
+)
 
 // NewWithAttributes creates a resource from a set of attributes.  If there are
 // duplicate keys present in the list of attributes, then the last
 // value found for the key is preserved.
 func NewWithAttributes(kvs ...label.KeyValue) *Resource {
+	return NewWithSchemaURL("", kvs...)
+}
+
+// NewWithSchemaURL creates a resource from a set of attributes that
+// conform to the semantic conventions identified by schemaURL.  If
+// there are duplicate keys present in the list of attributes, then
+// the last value found for the key is preserved.
+func NewWithSchemaURL(schemaURL string, kvs ...label.KeyValue) *Resource {
 	return &Resource{
-		labels: label.NewSet(kvs...),
+		labels:    label.NewSet(kvs...),
+		schemaURL: schemaURL,
 	}
 }
 
@@ -70,7 +89,18 @@ func (r *Resource) Iter() label.Iterator {
 	return r.labels.Iter()
 }
 
+// SchemaURL returns the schema URL of the semantic conventions the
+// Resource attributes conform to, or an empty string if it is unknown.
+func (r *Resource) SchemaURL() string {
+	if r == nil {
+		return ""
+	}
+	return r.schemaURL
+}
+
 // Equal returns true when a Resource is equivalent to this Resource.
+// Resources are equivalent if they have the same attributes and schema
+// URL.
 func (r *Resource) Equal(eq *Resource) bool {
 	if r == nil {
 		r = Empty()
@@ -78,22 +108,37 @@ func (r *Resource) Equal(eq *Resource) bool {
 	if eq == nil {
 		eq = Empty()
 	}
-	return r.Equivalent() == eq.Equivalent()
+	return r.Equivalent() == eq.Equivalent() && r.SchemaURL() == eq.SchemaURL()
 }
 
 // Merge creates a new resource by combining resource a and b.
 //
 // If there are common keys between resource a and b, then the value
 // from resource a is preserved.
-func Merge(a, b *Resource) *Resource {
+//
+// The schema URL of the merged resource is the schema URL of a or b,
+// whichever is non-empty. If both resources have different non-empty
+// schema URLs the attributes are still merged, but the returned
+// resource has no schema URL and ErrSchemaURLConflict is returned.
+func Merge(a, b *Resource) (*Resource, error) {
 	if a == nil && b == nil {
-		return Empty()
+		return Empty(), nil
 	}
 	if a == nil {
-		return b
+		return b, nil
 	}
 	if b == nil {
-		return a
+		return a, nil
+	}
+
+	var err error
+	schemaURL := a.schemaURL
+	switch {
+	case schemaURL == "":
+		schemaURL = b.schemaURL
+	case b.schemaURL != "" && b.schemaURL != schemaURL:
+		schemaURL = ""
+		err = fmt.Errorf("%w: %q and %q", ErrSchemaURLConflict, a.schemaURL, b.schemaURL)
 	}
 
 	// Note: 'a' labels will overwrite 'b' with last-value-wins in label.Key()
@@ -103,7 +148,7 @@ func Merge(a, b *Resource) *Resource {
 	for mi.Next() {
 		combine = append(combine, mi.Label())
 	}
-	return NewWithAttributes(combine...)
+	return NewWithSchemaURL(schemaURL, combine...), err
 }
 
 // Empty returns an instance of Resource with no attributes.  It is
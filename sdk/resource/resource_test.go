@@ -15,8 +15,11 @@
 package resource_test
 
 import (
+	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"strings"
 	"testing"
 
 	"github.com/google/go-cmp/cmp"
@@ -138,7 +141,8 @@ func TestMerge(t *testing.T) {
 	}
 	for _, c := range cases {
 		t.Run(fmt.Sprintf("case-%s", c.name), func(t *testing.T) {
-			res := resource.Merge(c.a, c.b)
+			res, err := resource.Merge(c.a, c.b)
+			require.NoError(t, err)
 			if diff := cmp.Diff(
 				res.Attributes(),
 				c.want,
@@ -149,6 +153,95 @@ func TestMerge(t *testing.T) {
 	}
 }
 
+func TestMergeSchemaURL(t *testing.T) {
+	const (
+		schema1 = "https://opentelemetry.io/schemas/1.0.0"
+		schema2 = "https://opentelemetry.io/schemas/1.1.0"
+	)
+	cases := []struct {
+		name    string
+		a, b    *resource.Resource
+		want    string
+		wantErr bool
+	}{
+		{
+			name: "Both empty",
+			a:    resource.NewWithAttributes(kv11),
+			b:    resource.NewWithAttributes(kv21),
+			want: "",
+		},
+		{
+			name: "First set",
+			a:    resource.NewWithSchemaURL(schema1, kv11),
+			b:    resource.NewWithAttributes(kv21),
+			want: schema1,
+		},
+		{
+			name: "Second set",
+			a:    resource.NewWithAttributes(kv11),
+			b:    resource.NewWithSchemaURL(schema1, kv21),
+			want: schema1,
+		},
+		{
+			name: "Same",
+			a:    resource.NewWithSchemaURL(schema1, kv11),
+			b:    resource.NewWithSchemaURL(schema1, kv21),
+			want: schema1,
+		},
+		{
+			name:    "Conflict",
+			a:       resource.NewWithSchemaURL(schema1, kv11),
+			b:       resource.NewWithSchemaURL(schema2, kv21),
+			want:    "",
+			wantErr: true,
+		},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			res, err := resource.Merge(c.a, c.b)
+			if c.wantErr {
+				require.True(t, errors.Is(err, resource.ErrSchemaURLConflict))
+			} else {
+				require.NoError(t, err)
+			}
+			require.Equal(t, c.want, res.SchemaURL())
+			require.Equal(t, []label.KeyValue{kv11, kv21}, res.Attributes())
+		})
+	}
+}
+
+func TestEqualSchemaURL(t *testing.T) {
+	a := resource.NewWithSchemaURL("https://opentelemetry.io/schemas/1.0.0", kv11)
+	require.True(t, a.Equal(resource.NewWithSchemaURL("https://opentelemetry.io/schemas/1.0.0", kv11)))
+	require.False(t, a.Equal(resource.NewWithAttributes(kv11)))
+}
+
+func TestNewWithSchemaURL(t *testing.T) {
+	const schema = "https://opentelemetry.io/schemas/1.0.0"
+	res, err := resource.New(context.Background(),
+		resource.WithoutBuiltin(),
+		resource.WithSchemaURL(schema),
+		resource.WithAttributes(kv11),
+	)
+	require.NoError(t, err)
+	require.Equal(t, schema, res.SchemaURL())
+
+	_, err = resource.New(context.Background(),
+		resource.WithoutBuiltin(),
+		resource.WithSchemaURL(schema),
+		resource.WithDetectors(detectorFunc(func() *resource.Resource {
+			return resource.NewWithSchemaURL("https://opentelemetry.io/schemas/2.0.0", kv21)
+		})),
+	)
+	require.True(t, strings.Contains(fmt.Sprint(err), resource.ErrSchemaURLConflict.Error()))
+}
+
+type detectorFunc func() *resource.Resource
+
+func (f detectorFunc) Detect(context.Context) (*resource.Resource, error) {
+	return f(), nil
+}
+
 func TestString(t *testing.T) {
 	for _, test := range []struct {
 		kvs  []label.KeyValue
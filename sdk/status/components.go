@@ -0,0 +1,74 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package status // import "go.opentelemetry.io/otel/sdk/status"
+
+import (
+	"context"
+
+	controller "go.opentelemetry.io/otel/sdk/metric/controller/basic"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+)
+
+// BatchSpanProcessor returns a Component reporting the status of bsp.
+// It is not healthy when its last export failed.
+func BatchSpanProcessor(bsp *sdktrace.BatchSpanProcessor) Component {
+	return ComponentFunc(func(context.Context) Status {
+		stats := bsp.Stats()
+		s := Status{
+			Healthy:     stats.LastExportError == nil,
+			LastSuccess: stats.LastSuccessfulExport,
+			Dropped:     stats.DroppedSpans,
+		}
+		if stats.LastExportError != nil {
+			s.Message = stats.LastExportError.Error()
+		}
+		return s
+	})
+}
+
+// Controller returns a Component reporting the status of the exports of
+// c. It is not healthy when its last export failed.
+func Controller(c *controller.Controller) Component {
+	return ComponentFunc(func(context.Context) Status {
+		stats := c.Stats()
+		s := Status{
+			Healthy:     stats.LastExport.Equal(stats.LastSuccessfulExport),
+			LastSuccess: stats.LastSuccessfulExport,
+		}
+		if n := len(stats.ExportErrors); !s.Healthy && n > 0 {
+			s.Message = stats.ExportErrors[n-1].Err.Error()
+		}
+		return s
+	})
+}
+
+// ReadinessChecker is implemented by the exporters able to tell whether
+// they can deliver telemetry, like the OTLP exporter.
+type ReadinessChecker interface {
+	// Ready returns nil if telemetry can be delivered, otherwise an
+	// error describing why it cannot.
+	Ready(ctx context.Context) error
+}
+
+// Readiness returns a Component reporting the readiness of rc. It is not
+// healthy when rc is not ready.
+func Readiness(rc ReadinessChecker) Component {
+	return ComponentFunc(func(ctx context.Context) Status {
+		if err := rc.Ready(ctx); err != nil {
+			return Status{Message: err.Error()}
+		}
+		return Status{Healthy: true}
+	})
+}
@@ -0,0 +1,89 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package status_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	export "go.opentelemetry.io/otel/sdk/export/metric"
+	exporttrace "go.opentelemetry.io/otel/sdk/export/trace"
+	controller "go.opentelemetry.io/otel/sdk/metric/controller/basic"
+	processor "go.opentelemetry.io/otel/sdk/metric/processor/basic"
+	"go.opentelemetry.io/otel/sdk/metric/selector/simple"
+	"go.opentelemetry.io/otel/sdk/status"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+)
+
+type failingExporter struct {
+	export.ExportKindSelector
+}
+
+func (failingExporter) Export(context.Context, export.CheckpointSet) error {
+	return errors.New("connection refused")
+}
+
+func (failingExporter) ExportSpans(context.Context, []*exporttrace.SpanSnapshot) error {
+	return errors.New("connection refused")
+}
+
+func (failingExporter) Shutdown(context.Context) error {
+	return nil
+}
+
+func TestBatchSpanProcessor(t *testing.T) {
+	ctx := context.Background()
+	bsp := sdktrace.NewBatchSpanProcessor(failingExporter{})
+	c := status.BatchSpanProcessor(bsp)
+	assert.Equal(t, status.Status{Healthy: true}, c.Status(ctx))
+
+	tp := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(bsp))
+	_, span := tp.Tracer("test").Start(ctx, "span")
+	span.End()
+	require.NoError(t, tp.Shutdown(ctx))
+	assert.Equal(t, status.Status{Message: "connection refused"}, c.Status(ctx))
+}
+
+func TestController(t *testing.T) {
+	ctx := context.Background()
+	cont := controller.New(
+		processor.New(simple.NewWithInexpensiveDistribution(), export.CumulativeExportKindSelector()),
+		controller.WithPusher(failingExporter{export.CumulativeExportKindSelector()}),
+	)
+	c := status.Controller(cont)
+	assert.Equal(t, status.Status{Healthy: true}, c.Status(ctx))
+
+	require.NoError(t, cont.Start(ctx))
+	require.Error(t, cont.Stop(ctx))
+	assert.Equal(t, status.Status{Message: "connection refused"}, c.Status(ctx))
+}
+
+type readiness struct {
+	err error
+}
+
+func (r readiness) Ready(context.Context) error {
+	return r.err
+}
+
+func TestReadiness(t *testing.T) {
+	ctx := context.Background()
+	assert.Equal(t, status.Status{Healthy: true}, status.Readiness(readiness{}).Status(ctx))
+	assert.Equal(t, status.Status{Message: "not started"}, status.Readiness(readiness{errors.New("not started")}).Status(ctx))
+}
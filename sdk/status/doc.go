@@ -0,0 +1,35 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package status gathers the health of the components of the telemetry
+// pipelines of a process, like span processors, metric controllers and
+// exporters, so that services can include it in their own health checks.
+//
+// Components are registered under a name with a Registry, usually the
+// default one of the package:
+//
+//	status.Register("traces", status.BatchSpanProcessor(bsp))
+//	status.Register("metrics", status.Controller(cont))
+//	status.Register("otlp", status.Readiness(exporter))
+//
+// Their status is then queried with Reports and Healthy, or served as
+// JSON by Handler:
+//
+//	http.Handle("/healthz/telemetry", status.Handler())
+//
+// This package is currently in a pre-GA phase. Backwards incompatible
+// changes may be introduced in subsequent minor version releases as we
+// work to track the evolving OpenTelemetry specification and user
+// feedback.
+package status // import "go.opentelemetry.io/otel/sdk/status"
@@ -0,0 +1,189 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package status // import "go.opentelemetry.io/otel/sdk/status"
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+var (
+	// errDuplicateComponent is returned when a component name is
+	// already registered.
+	errDuplicateComponent = errors.New("duplicate status component registration")
+	// errInvalidComponent is returned when a component cannot be
+	// registered.
+	errInvalidComponent = errors.New("invalid status component registration")
+)
+
+// Status is the status of a component of a telemetry pipeline.
+type Status struct {
+	// Healthy reports whether the component delivers telemetry.
+	Healthy bool `json:"healthy"`
+	// Message describes why the component is not healthy.
+	Message string `json:"message,omitempty"`
+	// LastSuccess is the time the component last delivered telemetry
+	// at. It is zero if it never did or does not know.
+	LastSuccess time.Time `json:"last_success"`
+	// Dropped is the number of items the component dropped.
+	Dropped uint64 `json:"dropped"`
+}
+
+// Component is a component of a telemetry pipeline reporting its status.
+type Component interface {
+	// Status returns the current status of the component. It is called
+	// for every query of the status and must be fast.
+	Status(ctx context.Context) Status
+}
+
+// ComponentFunc is a function implementing Component.
+type ComponentFunc func(ctx context.Context) Status
+
+// Status returns f(ctx).
+func (f ComponentFunc) Status(ctx context.Context) Status {
+	return f(ctx)
+}
+
+// Report is the status of a named component.
+type Report struct {
+	Name string `json:"name"`
+	Status
+}
+
+// Registry holds components by name.
+type Registry struct {
+	mu         sync.Mutex
+	components map[string]Component
+}
+
+// NewRegistry returns an empty Registry.
+func NewRegistry() *Registry {
+	return &Registry{components: make(map[string]Component)}
+}
+
+// Register adds c to r under name. An error is returned if name is empty
+// or already registered, or c is nil.
+func (r *Registry) Register(name string, c Component) error {
+	name = strings.TrimSpace(name)
+	if name == "" || c == nil {
+		return fmt.Errorf("%w: %q", errInvalidComponent, name)
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if _, ok := r.components[name]; ok {
+		return fmt.Errorf("%w: %q", errDuplicateComponent, name)
+	}
+	r.components[name] = c
+	return nil
+}
+
+// Unregister removes the component registered under name, if any.
+func (r *Registry) Unregister(name string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	delete(r.components, strings.TrimSpace(name))
+}
+
+// Reports returns the status of the components of r, sorted by name.
+func (r *Registry) Reports(ctx context.Context) []Report {
+	r.mu.Lock()
+	reports := make([]Report, 0, len(r.components))
+	components := make([]Component, 0, len(r.components))
+	for name, c := range r.components {
+		reports = append(reports, Report{Name: name})
+		components = append(components, c)
+	}
+	r.mu.Unlock()
+
+	// The components are not called with the lock held, they may take
+	// locks of their own.
+	for i, c := range components {
+		reports[i].Status = c.Status(ctx)
+	}
+	sort.Slice(reports, func(i, j int) bool {
+		return reports[i].Name < reports[j].Name
+	})
+	return reports
+}
+
+// Healthy returns whether all the components of r are healthy.
+func (r *Registry) Healthy(ctx context.Context) bool {
+	return healthy(r.Reports(ctx))
+}
+
+func healthy(reports []Report) bool {
+	for _, report := range reports {
+		if !report.Healthy {
+			return false
+		}
+	}
+	return true
+}
+
+// ServeHTTP writes the status of the components of r as JSON. The
+// response status is 503 Service Unavailable if one of them is not
+// healthy.
+func (r *Registry) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	reports := r.Reports(req.Context())
+	body, err := json.Marshal(struct {
+		Healthy    bool     `json:"healthy"`
+		Components []Report `json:"components"`
+	}{healthy(reports), reports})
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	if !healthy(reports) {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}
+	_, _ = w.Write(body)
+}
+
+var defaultRegistry = NewRegistry()
+
+// Register adds c to the default Registry under name.
+func Register(name string, c Component) error {
+	return defaultRegistry.Register(name, c)
+}
+
+// Unregister removes the component registered under name from the
+// default Registry.
+func Unregister(name string) {
+	defaultRegistry.Unregister(name)
+}
+
+// Reports returns the status of the components of the default Registry.
+func Reports(ctx context.Context) []Report {
+	return defaultRegistry.Reports(ctx)
+}
+
+// Healthy returns whether all the components of the default Registry are
+// healthy.
+func Healthy(ctx context.Context) bool {
+	return defaultRegistry.Healthy(ctx)
+}
+
+// Handler returns the default Registry as an http.Handler.
+func Handler() http.Handler {
+	return defaultRegistry
+}
@@ -0,0 +1,83 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package status
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func static(s Status) Component {
+	return ComponentFunc(func(context.Context) Status { return s })
+}
+
+func TestRegistry(t *testing.T) {
+	r := NewRegistry()
+	ctx := context.Background()
+	assert.Empty(t, r.Reports(ctx))
+	assert.True(t, r.Healthy(ctx))
+
+	last := time.Unix(100, 0)
+	require.NoError(t, r.Register("traces", static(Status{Healthy: true, LastSuccess: last, Dropped: 2})))
+	require.NoError(t, r.Register(" metrics ", static(Status{Healthy: true})))
+	assert.Equal(t, []Report{
+		{Name: "metrics", Status: Status{Healthy: true}},
+		{Name: "traces", Status: Status{Healthy: true, LastSuccess: last, Dropped: 2}},
+	}, r.Reports(ctx))
+	assert.True(t, r.Healthy(ctx))
+
+	err := r.Register("traces", static(Status{}))
+	assert.True(t, errors.Is(err, errDuplicateComponent))
+	assert.True(t, errors.Is(r.Register("", static(Status{})), errInvalidComponent))
+	assert.True(t, errors.Is(r.Register("nil", nil), errInvalidComponent))
+
+	require.NoError(t, r.Register("logs", static(Status{Message: "export failed"})))
+	assert.False(t, r.Healthy(ctx))
+	r.Unregister("logs")
+	assert.True(t, r.Healthy(ctx))
+}
+
+func TestRegistryServeHTTP(t *testing.T) {
+	r := NewRegistry()
+	require.NoError(t, r.Register("traces", static(Status{Healthy: true, LastSuccess: time.Unix(100, 0).UTC()})))
+
+	serve := func() *httptest.ResponseRecorder {
+		rec := httptest.NewRecorder()
+		r.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/healthz", nil))
+		return rec
+	}
+
+	rec := serve()
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.Equal(t, "application/json", rec.Header().Get("Content-Type"))
+	assert.JSONEq(t, `{"healthy":true,"components":[
+		{"name":"traces","healthy":true,"last_success":"1970-01-01T00:01:40Z","dropped":0}
+	]}`, rec.Body.String())
+
+	require.NoError(t, r.Register("metrics", static(Status{Message: "connection refused"})))
+	rec = serve()
+	assert.Equal(t, http.StatusServiceUnavailable, rec.Code)
+	assert.JSONEq(t, `{"healthy":false,"components":[
+		{"name":"metrics","healthy":false,"message":"connection refused","last_success":"0001-01-01T00:00:00Z","dropped":0},
+		{"name":"traces","healthy":true,"last_success":"1970-01-01T00:01:40Z","dropped":0}
+	]}`, rec.Body.String())
+}
@@ -22,7 +22,8 @@ import (
 
 // attributesMap is a capped map of attributes, holding the most recent attributes.
 // Eviction is done via a LRU method, the oldest entry is removed to create room for a new entry.
-// Updates are allowed and they refresh the usage of the key.
+// Updates are allowed and they refresh the usage of the key, unless the map
+// keeps the first value of the keys: updates are then ignored.
 //
 // This is based from https://github.com/hashicorp/golang-lru/blob/master/simplelru/lru.go
 // With a subset of the its operations and specific for holding label.KeyValue
@@ -31,13 +32,15 @@ type attributesMap struct {
 	evictList    *list.List
 	droppedCount int
 	capacity     int
+	keepFirst    bool
 }
 
-func newAttributesMap(capacity int) *attributesMap {
+func newAttributesMap(capacity int, policy DuplicateAttributePolicy) *attributesMap {
 	lm := &attributesMap{
 		attributes: make(map[label.Key]*list.Element),
 		evictList:  list.New(),
 		capacity:   capacity,
+		keepFirst:  policy == FirstAttributeValueWins,
 	}
 	return lm
 }
@@ -45,6 +48,9 @@ func newAttributesMap(capacity int) *attributesMap {
 func (am *attributesMap) add(kv label.KeyValue) {
 	// Check for existing item
 	if ent, ok := am.attributes[kv.Key]; ok {
+		if am.keepFirst {
+			return
+		}
 		am.evictList.MoveToFront(ent)
 		ent.Value = &kv
 		return
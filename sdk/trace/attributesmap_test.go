@@ -16,6 +16,7 @@ package trace
 
 import (
 	"fmt"
+	"reflect"
 	"testing"
 
 	"go.opentelemetry.io/otel/label"
@@ -25,7 +26,7 @@ const testKeyFmt = "test-key-%d"
 
 func TestAttributesMap(t *testing.T) {
 	wantCapacity := 128
-	attrMap := newAttributesMap(wantCapacity)
+	attrMap := newAttributesMap(wantCapacity, LastAttributeValueWins)
 
 	for i := 0; i < 256; i++ {
 		attrMap.add(label.Int(fmt.Sprintf(testKeyFmt, i), i))
@@ -55,7 +56,7 @@ func TestAttributesMap(t *testing.T) {
 }
 
 func TestAttributesMapGetOldestRemoveOldest(t *testing.T) {
-	attrMap := newAttributesMap(128)
+	attrMap := newAttributesMap(128, LastAttributeValueWins)
 
 	for i := 0; i < 128; i++ {
 		attrMap.add(label.Int(fmt.Sprintf(testKeyFmt, i), i))
@@ -75,7 +76,7 @@ func TestAttributesMapGetOldestRemoveOldest(t *testing.T) {
 }
 
 func TestAttributesMapToKeyValue(t *testing.T) {
-	attrMap := newAttributesMap(128)
+	attrMap := newAttributesMap(128, LastAttributeValueWins)
 
 	for i := 0; i < 128; i++ {
 		attrMap.add(label.Int(fmt.Sprintf(testKeyFmt, i), i))
@@ -90,8 +91,30 @@ func TestAttributesMapToKeyValue(t *testing.T) {
 	}
 }
 
+func TestAttributesMapDuplicates(t *testing.T) {
+	for _, test := range []struct {
+		policy DuplicateAttributePolicy
+		want   []label.KeyValue
+	}{
+		{LastAttributeValueWins, []label.KeyValue{label.Int("b", 1), label.Int("a", 2)}},
+		{FirstAttributeValueWins, []label.KeyValue{label.Int("a", 1), label.Int("b", 1)}},
+	} {
+		attrMap := newAttributesMap(2, test.policy)
+		attrMap.add(label.Int("a", 1))
+		attrMap.add(label.Int("b", 1))
+		attrMap.add(label.Int("a", 2))
+
+		if got := attrMap.toKeyValue(); !reflect.DeepEqual(got, test.want) {
+			t.Errorf("policy %d: got %v; want %v", test.policy, got, test.want)
+		}
+		if attrMap.droppedCount != 0 {
+			t.Errorf("policy %d: attrMap.droppedCount: got '%d'; want '0'", test.policy, attrMap.droppedCount)
+		}
+	}
+}
+
 func BenchmarkAttributesMapToKeyValue(b *testing.B) {
-	attrMap := newAttributesMap(128)
+	attrMap := newAttributesMap(128, LastAttributeValueWins)
 
 	for i := 0; i < 128; i++ {
 		attrMap.add(label.Int(fmt.Sprintf(testKeyFmt, i), i))
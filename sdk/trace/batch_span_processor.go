@@ -22,13 +22,18 @@ import (
 	"time"
 
 	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/internal/global"
+	"go.opentelemetry.io/otel/trace"
+
 	export "go.opentelemetry.io/otel/sdk/export/trace"
+	controllerTime "go.opentelemetry.io/otel/sdk/metric/controller/time"
 )
 
 const (
 	DefaultMaxQueueSize       = 2048
 	DefaultBatchTimeout       = 5000 * time.Millisecond
 	DefaultMaxExportBatchSize = 512
+	DefaultExportTimeout      = 30000 * time.Millisecond
 )
 
 type BatchSpanProcessorOption func(o *BatchSpanProcessorOptions)
@@ -44,6 +49,13 @@ type BatchSpanProcessorOptions struct {
 	// The default value of BatchTimeout is 5000 msec.
 	BatchTimeout time.Duration
 
+	// ExportTimeout specifies the maximum duration for exporting a batch.
+	// The context passed to the exporter is canceled when the timeout is
+	// reached, so a hung backend cannot block the processor indefinitely.
+	// If zero, no timeout is applied.
+	// The default value of ExportTimeout is 30000 msec.
+	ExportTimeout time.Duration
+
 	// MaxExportBatchSize is the maximum number of spans to process in a single batch.
 	// If there are more than one batch worth of spans then it processes multiple batches
 	// of spans one batch after the other without any delay.
@@ -55,26 +67,67 @@ type BatchSpanProcessorOptions struct {
 	// Blocking option should be used carefully as it can severely affect the performance of an
 	// application.
 	BlockOnQueueFull bool
+
+	// Clock provides the ticker measuring the BatchTimeout and the export
+	// times reported by Stats. It can be replaced by a mock clock to
+	// advance time manually in tests.
+	// The default value of Clock is the real clock.
+	Clock controllerTime.Clock
+}
+
+// BatchSpanProcessorStats describes the activity of a BatchSpanProcessor.
+// The zero times mean that nothing was exported yet.
+type BatchSpanProcessorStats struct {
+	// Exports is the number of calls to the exporter.
+	Exports uint64
+	// ExportedSpans is the number of spans exported successfully.
+	ExportedSpans uint64
+	// DroppedSpans is the number of spans dropped because the queue
+	// was full.
+	DroppedSpans uint64
+	// QueuedSpans is the number of spans waiting in the queue.
+	QueuedSpans int
+	// LastExport is the time the last export started at.
+	LastExport time.Time
+	// LastSuccessfulExport is the time the last export that succeeded
+	// started at.
+	LastSuccessfulExport time.Time
+	// LastExportError is the error returned by the last export, nil if
+	// it succeeded.
+	LastExportError error
 }
 
 // BatchSpanProcessor is a SpanProcessor that batches asynchronously-received
 // SpanSnapshots and sends them to a trace.Exporter when complete.
+//
+// The SpanSnapshots and the slice holding them are reused once ExportSpans
+// returns, exporters must copy any of them they retain.
 type BatchSpanProcessor struct {
 	e export.SpanExporter
 	o BatchSpanProcessorOptions
 
 	queue   chan *export.SpanSnapshot
 	dropped uint32
+	// reportedDropped is the value of dropped when it was last
+	// logged. It is guarded by batchMutex.
+	reportedDropped uint32
 
 	batch      []*export.SpanSnapshot
 	batchMutex sync.Mutex
-	timer      *time.Timer
-	stopWait   sync.WaitGroup
-	stopOnce   sync.Once
-	stopCh     chan struct{}
+
+	statsMutex sync.Mutex
+	stats      BatchSpanProcessorStats // guarded by statsMutex
+
+	ticker   controllerTime.Ticker // only used by processQueue
+	stopWait sync.WaitGroup
+	stopOnce sync.Once
+	stopCh   chan struct{}
 }
 
-var _ SpanProcessor = (*BatchSpanProcessor)(nil)
+var (
+	_ SpanProcessor   = (*BatchSpanProcessor)(nil)
+	_ EndedSpanFilter = (*BatchSpanProcessor)(nil)
+)
 
 // NewBatchSpanProcessor creates a new BatchSpanProcessor that will send
 // SpanSnapshot batches to the exporters with the supplied options.
@@ -83,12 +136,20 @@ var _ SpanProcessor = (*BatchSpanProcessor)(nil)
 // the RegisterSpanProcessor method for it to process spans.
 //
 // If the exporter is nil, the span processor will preform no action.
+//
+// The default options are read from the OTEL_BSP_SCHEDULE_DELAY,
+// OTEL_BSP_EXPORT_TIMEOUT (both in milliseconds), OTEL_BSP_MAX_QUEUE_SIZE
+// and OTEL_BSP_MAX_EXPORT_BATCH_SIZE environment variables when they are
+// set. The options take precedence over them.
 func NewBatchSpanProcessor(exporter export.SpanExporter, options ...BatchSpanProcessorOption) *BatchSpanProcessor {
 	o := BatchSpanProcessorOptions{
 		BatchTimeout:       DefaultBatchTimeout,
+		ExportTimeout:      DefaultExportTimeout,
 		MaxQueueSize:       DefaultMaxQueueSize,
 		MaxExportBatchSize: DefaultMaxExportBatchSize,
+		Clock:              controllerTime.RealClock{},
 	}
+	applyEnvBatchSpanProcessorOptions(&o)
 	for _, opt := range options {
 		opt(&o)
 	}
@@ -96,7 +157,7 @@ func NewBatchSpanProcessor(exporter export.SpanExporter, options ...BatchSpanPro
 		e:      exporter,
 		o:      o,
 		batch:  make([]*export.SpanSnapshot, 0, o.MaxExportBatchSize),
-		timer:  time.NewTimer(o.BatchTimeout),
+		ticker: o.Clock.Ticker(o.BatchTimeout),
 		queue:  make(chan *export.SpanSnapshot, o.MaxQueueSize),
 		stopCh: make(chan struct{}),
 	}
@@ -117,10 +178,23 @@ func (bsp *BatchSpanProcessor) OnStart(parent context.Context, s ReadWriteSpan)
 // OnEnd method enqueues a ReadOnlySpan for later processing.
 func (bsp *BatchSpanProcessor) OnEnd(s ReadOnlySpan) {
 	// Do not enqueue spans if we are just going to drop them.
-	if bsp.e == nil {
+	if !bsp.ProcessesEndedSpan(s.SpanContext()) {
 		return
 	}
-	bsp.enqueue(s.Snapshot())
+	var sd *export.SpanSnapshot
+	if sp, ok := s.(*span); ok {
+		sd = snapshotPool.Get().(*export.SpanSnapshot)
+		sp.snapshotTo(sd)
+	} else {
+		sd = s.Snapshot()
+	}
+	bsp.enqueue(sd)
+}
+
+// ProcessesEndedSpan reports whether the span with the SpanContext is
+// enqueued when it ends: it is if it is sampled and there is an exporter.
+func (bsp *BatchSpanProcessor) ProcessesEndedSpan(sc trace.SpanContext) bool {
+	return bsp.e != nil && sc.IsSampled()
 }
 
 // Shutdown flushes the queue and waits until all spans are processed.
@@ -172,38 +246,97 @@ func WithBatchTimeout(delay time.Duration) BatchSpanProcessorOption {
 	}
 }
 
+func WithExportTimeout(timeout time.Duration) BatchSpanProcessorOption {
+	return func(o *BatchSpanProcessorOptions) {
+		o.ExportTimeout = timeout
+	}
+}
+
 func WithBlocking() BatchSpanProcessorOption {
 	return func(o *BatchSpanProcessorOptions) {
 		o.BlockOnQueueFull = true
 	}
 }
 
+// WithClock sets the clock providing the ticker that measures the
+// BatchTimeout, for example a mock clock advanced manually in tests.
+func WithClock(clock controllerTime.Clock) BatchSpanProcessorOption {
+	return func(o *BatchSpanProcessorOptions) {
+		o.Clock = clock
+	}
+}
+
 // exportSpans is a subroutine of processing and draining the queue.
 func (bsp *BatchSpanProcessor) exportSpans() {
-	bsp.timer.Reset(bsp.o.BatchTimeout)
-
 	bsp.batchMutex.Lock()
 	defer bsp.batchMutex.Unlock()
 
 	if len(bsp.batch) > 0 {
-		if err := bsp.e.ExportSpans(context.Background(), bsp.batch); err != nil {
+		if err := bsp.export(context.Background()); err != nil {
 			otel.Handle(err)
 		}
+		// The exporter is done with the batch, reuse its snapshots and
+		// its backing array for the next one.
+		for i, sd := range bsp.batch {
+			releaseSnapshot(sd)
+			bsp.batch[i] = nil
+		}
 		bsp.batch = bsp.batch[:0]
 	}
 }
 
+// export calls the exporter with the current batch, applying the
+// configured export timeout.
+func (bsp *BatchSpanProcessor) export(ctx context.Context) error {
+	if bsp.o.ExportTimeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, bsp.o.ExportTimeout)
+		defer cancel()
+	}
+
+	if dropped := atomic.LoadUint32(&bsp.dropped); dropped != bsp.reportedDropped {
+		global.Warn("dropped spans because the queue is full",
+			"count", dropped-bsp.reportedDropped, "total", dropped)
+		bsp.reportedDropped = dropped
+	}
+	global.Debug("exporting spans", "count", len(bsp.batch))
+	start := bsp.o.Clock.Now()
+	err := bsp.e.ExportSpans(ctx, bsp.batch)
+
+	bsp.statsMutex.Lock()
+	defer bsp.statsMutex.Unlock()
+	bsp.stats.Exports++
+	bsp.stats.LastExport = start
+	bsp.stats.LastExportError = err
+	if err == nil {
+		bsp.stats.ExportedSpans += uint64(len(bsp.batch))
+		bsp.stats.LastSuccessfulExport = start
+	}
+	return err
+}
+
+// Stats returns the activity of the BatchSpanProcessor, for health checks
+// and introspection.
+func (bsp *BatchSpanProcessor) Stats() BatchSpanProcessorStats {
+	bsp.statsMutex.Lock()
+	stats := bsp.stats
+	bsp.statsMutex.Unlock()
+	stats.DroppedSpans = uint64(atomic.LoadUint32(&bsp.dropped))
+	stats.QueuedSpans = len(bsp.queue)
+	return stats
+}
+
 // processQueue removes spans from the `queue` channel until processor
 // is shut down. It calls the exporter in batches of up to MaxExportBatchSize
 // waiting up to BatchTimeout to form a batch.
 func (bsp *BatchSpanProcessor) processQueue() {
-	defer bsp.timer.Stop()
+	defer func() { bsp.ticker.Stop() }()
 
 	for {
 		select {
 		case <-bsp.stopCh:
 			return
-		case <-bsp.timer.C:
+		case <-bsp.ticker.C():
 			bsp.exportSpans()
 		case sd := <-bsp.queue:
 			bsp.batchMutex.Lock()
@@ -211,10 +344,10 @@ func (bsp *BatchSpanProcessor) processQueue() {
 			shouldExport := len(bsp.batch) == bsp.o.MaxExportBatchSize
 			bsp.batchMutex.Unlock()
 			if shouldExport {
-				if !bsp.timer.Stop() {
-					<-bsp.timer.C
-				}
+				// Give the next batch the whole BatchTimeout.
+				bsp.ticker.Stop()
 				bsp.exportSpans()
+				bsp.ticker = bsp.o.Clock.Ticker(bsp.o.BatchTimeout)
 			}
 		}
 	}
@@ -247,6 +380,7 @@ func (bsp *BatchSpanProcessor) drainQueue() {
 
 func (bsp *BatchSpanProcessor) enqueue(sd *export.SpanSnapshot) {
 	if !sd.SpanContext.IsSampled() {
+		releaseSnapshot(sd)
 		return
 	}
 
@@ -267,6 +401,7 @@ func (bsp *BatchSpanProcessor) enqueue(sd *export.SpanSnapshot) {
 
 	select {
 	case <-bsp.stopCh:
+		releaseSnapshot(sd)
 		return
 	default:
 	}
@@ -280,5 +415,21 @@ func (bsp *BatchSpanProcessor) enqueue(sd *export.SpanSnapshot) {
 	case bsp.queue <- sd:
 	default:
 		atomic.AddUint32(&bsp.dropped, 1)
+		releaseSnapshot(sd)
 	}
 }
+
+// snapshotPool holds the SpanSnapshots of exported batches so the next
+// spans ending can reuse them instead of allocating new ones.
+var snapshotPool = sync.Pool{
+	New: func() interface{} {
+		return new(export.SpanSnapshot)
+	},
+}
+
+// releaseSnapshot returns sd to snapshotPool. sd must not be used
+// afterwards.
+func releaseSnapshot(sd *export.SpanSnapshot) {
+	*sd = export.SpanSnapshot{}
+	snapshotPool.Put(sd)
+}
@@ -26,6 +26,8 @@ import (
 	"go.opentelemetry.io/otel/trace"
 
 	export "go.opentelemetry.io/otel/sdk/export/trace"
+	"go.opentelemetry.io/otel/sdk/export/trace/tracetest"
+	"go.opentelemetry.io/otel/sdk/metric/controller/controllertest"
 	sdktrace "go.opentelemetry.io/otel/sdk/trace"
 )
 
@@ -41,7 +43,10 @@ func (t *testBatchExporter) ExportSpans(ctx context.Context, ss []*export.SpanSn
 	t.mu.Lock()
 	defer t.mu.Unlock()
 
-	t.spans = append(t.spans, ss...)
+	for _, s := range ss {
+		sd := *s
+		t.spans = append(t.spans, &sd)
+	}
 	t.sizes = append(t.sizes, len(ss))
 	t.batchCount++
 	return nil
@@ -85,6 +90,24 @@ func TestNewBatchSpanProcessorWithNilExporter(t *testing.T) {
 	}
 }
 
+func TestBatchSpanProcessorProcessesEndedSpan(t *testing.T) {
+	sampled := trace.SpanContext{TraceFlags: trace.FlagsSampled}
+	bsp := sdktrace.NewBatchSpanProcessor(&testBatchExporter{})
+	defer func() { _ = bsp.Shutdown(context.Background()) }()
+	if !bsp.ProcessesEndedSpan(sampled) {
+		t.Error("sampled span not processed")
+	}
+	if bsp.ProcessesEndedSpan(trace.SpanContext{}) {
+		t.Error("unsampled span processed")
+	}
+
+	nilBSP := sdktrace.NewBatchSpanProcessor(nil)
+	defer func() { _ = nilBSP.Shutdown(context.Background()) }()
+	if nilBSP.ProcessesEndedSpan(sampled) {
+		t.Error("span processed without exporter")
+	}
+}
+
 type testOption struct {
 	name           string
 	o              []sdktrace.BatchSpanProcessorOption
@@ -236,6 +259,109 @@ func getSpanContext() trace.SpanContext {
 	}
 }
 
+type blockingExporter struct {
+	deadlines chan bool
+}
+
+func (e *blockingExporter) ExportSpans(ctx context.Context, ss []*export.SpanSnapshot) error {
+	_, ok := ctx.Deadline()
+	<-ctx.Done()
+	e.deadlines <- ok
+	return ctx.Err()
+}
+
+func (e *blockingExporter) Shutdown(context.Context) error {
+	return nil
+}
+
+func TestBatchSpanProcessorExportTimeout(t *testing.T) {
+	exp := &blockingExporter{deadlines: make(chan bool, 1)}
+	bsp := sdktrace.NewBatchSpanProcessor(
+		exp,
+		sdktrace.WithExportTimeout(time.Millisecond),
+		sdktrace.WithMaxExportBatchSize(1),
+	)
+	tp := basicTracerProvider(t)
+	tp.RegisterSpanProcessor(bsp)
+
+	_, span := tp.Tracer("BatchSpanProcessorWithExportTimeout").Start(context.Background(), "hung")
+	span.End()
+
+	select {
+	case hasDeadline := <-exp.deadlines:
+		assert.True(t, hasDeadline, "export context has no deadline")
+	case <-time.After(10 * time.Second):
+		t.Fatal("export was not canceled after the export timeout")
+	}
+	assert.NoError(t, bsp.Shutdown(context.Background()))
+}
+
+func TestBatchSpanProcessorWithClock(t *testing.T) {
+	mock := controllertest.NewMockClock()
+	te := testBatchExporter{}
+	bsp := sdktrace.NewBatchSpanProcessor(
+		&te,
+		sdktrace.WithBatchTimeout(time.Hour),
+		sdktrace.WithClock(mock),
+	)
+	tp := basicTracerProvider(t)
+	tp.RegisterSpanProcessor(bsp)
+
+	tr := tp.Tracer("BatchSpanProcessorWithClock")
+	for i := 0; i < 2; i++ {
+		_, span := tr.Start(context.Background(), "span")
+		span.End()
+	}
+	assert.Equal(t, 0, te.len())
+
+	// The ticker may fire before the spans reach the batch, advance the
+	// clock until they are exported.
+	assert.Eventually(t, func() bool {
+		mock.Add(time.Hour)
+		return te.len() == 2
+	}, 10*time.Second, time.Millisecond)
+	assert.NoError(t, bsp.Shutdown(context.Background()))
+}
+
+func TestBatchSpanProcessorStats(t *testing.T) {
+	mock := controllertest.NewMockClock()
+	te := testBatchExporter{}
+	bsp := sdktrace.NewBatchSpanProcessor(&te, sdktrace.WithClock(mock))
+	tp := basicTracerProvider(t)
+	tp.RegisterSpanProcessor(bsp)
+	assert.Equal(t, sdktrace.BatchSpanProcessorStats{}, bsp.Stats())
+
+	tr := tp.Tracer("BatchSpanProcessorStats")
+	for i := 0; i < 3; i++ {
+		_, span := tr.Start(context.Background(), "span")
+		span.End()
+	}
+	// Shutting down drains the queue.
+	assert.NoError(t, bsp.Shutdown(context.Background()))
+
+	stats := bsp.Stats()
+	assert.Equal(t, uint64(1), stats.Exports)
+	assert.Equal(t, uint64(3), stats.ExportedSpans)
+	assert.Zero(t, stats.DroppedSpans)
+	assert.Equal(t, mock.Now(), stats.LastExport)
+	assert.Equal(t, mock.Now(), stats.LastSuccessfulExport)
+	assert.NoError(t, stats.LastExportError)
+
+	blocking := &blockingExporter{deadlines: make(chan bool, 1)}
+	bsp2 := sdktrace.NewBatchSpanProcessor(blocking, sdktrace.WithExportTimeout(time.Millisecond))
+	tp.RegisterSpanProcessor(bsp2)
+	_, span := tr.Start(context.Background(), "span")
+	span.End()
+	assert.NoError(t, bsp2.Shutdown(context.Background()))
+	<-blocking.deadlines
+
+	stats = bsp2.Stats()
+	assert.Equal(t, uint64(1), stats.Exports)
+	assert.Zero(t, stats.ExportedSpans)
+	assert.True(t, stats.LastSuccessfulExport.IsZero())
+	assert.Equal(t, context.DeadlineExceeded, stats.LastExportError)
+}
+
 func TestBatchSpanProcessorShutdown(t *testing.T) {
 	var bp testBatchExporter
 	bsp := sdktrace.NewBatchSpanProcessor(&bp)
@@ -253,3 +379,23 @@ func TestBatchSpanProcessorShutdown(t *testing.T) {
 	}
 	assert.Equal(t, 1, bp.shutdownCount)
 }
+
+func BenchmarkBatchSpanProcessorOnEnd(b *testing.B) {
+	bsp := sdktrace.NewBatchSpanProcessor(
+		tracetest.NewNoopExporter(),
+		sdktrace.WithMaxExportBatchSize(64),
+		sdktrace.WithBlocking(),
+	)
+	tp := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(bsp))
+	tr := tp.Tracer("BenchmarkBatchSpanProcessorOnEnd")
+	ctx := context.Background()
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_, span := tr.Start(ctx, "/foo")
+		span.End()
+	}
+	b.StopTimer()
+	assert.NoError(b, bsp.Shutdown(ctx))
+}
@@ -18,9 +18,11 @@ import (
 	"context"
 	"testing"
 
+	"go.opentelemetry.io/otel/internal/telemetrygen"
 	"go.opentelemetry.io/otel/label"
 	"go.opentelemetry.io/otel/trace"
 
+	"go.opentelemetry.io/otel/sdk/export/trace/tracetest"
 	sdktrace "go.opentelemetry.io/otel/sdk/trace"
 )
 
@@ -133,6 +135,21 @@ func BenchmarkSpanWithAttributes_all_2x(b *testing.B) {
 	})
 }
 
+func BenchmarkBatchSpanProcessorGeneratedLoad(b *testing.B) {
+	tp := sdktrace.NewTracerProvider(sdktrace.WithBatcher(tracetest.NewNoopExporter()))
+	defer func() { _ = tp.Shutdown(context.Background()) }()
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	stats := telemetrygen.GenerateSpans(context.Background(), tp, telemetrygen.SpanConfig{
+		Load:          telemetrygen.Load{Workers: 4, Iterations: (b.N + 3) / 4},
+		SpansPerTrace: 4,
+		Attributes:    8,
+		Events:        1,
+	})
+	b.ReportMetric(stats.PerSecond(), "spans/s")
+}
+
 func BenchmarkTraceID_DotString(b *testing.B) {
 	t, _ := trace.TraceIDFromHex("0000000000000001000000000000002a")
 	sc := trace.SpanContext{TraceID: t}
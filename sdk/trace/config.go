@@ -29,12 +29,24 @@ type Config struct {
 	// MaxEventsPerSpan is max number of message events per span
 	MaxEventsPerSpan int
 
-	// MaxAnnotationEventsPerSpan is max number of attributes per span
+	// MaxAnnotationEventsPerSpan is max number of attributes per span.
+	// Attributes set more than once with the same key count once.
 	MaxAttributesPerSpan int
 
 	// MaxLinksPerSpan is max number of links per span
 	MaxLinksPerSpan int
 
+	// DisableEvents disables the recording of span events, including
+	// the events of RecordError.  The spans still record their
+	// attributes, links and status.  Once disabled, events cannot be
+	// enabled again with ApplyConfig.
+	DisableEvents bool
+
+	// DuplicateAttributes decides which value of an attribute set
+	// more than once on a span is kept.  Once set to
+	// FirstAttributeValueWins, it cannot be reset with ApplyConfig.
+	DuplicateAttributes DuplicateAttributePolicy
+
 	// Resource contains attributes representing an entity that produces telemetry.
 	Resource *resource.Resource
 }
@@ -49,3 +61,18 @@ const (
 	// DefaultMaxLinksPerSpan is default max number of links per span
 	DefaultMaxLinksPerSpan = 1000
 )
+
+// DuplicateAttributePolicy decides which value of an attribute set more
+// than once on a span is kept.  A span holds one value per key whatever
+// the policy.
+type DuplicateAttributePolicy int
+
+const (
+	// LastAttributeValueWins keeps the value set last, which also
+	// counts as the most recently used attribute once the span evicts
+	// attributes to respect MaxAttributesPerSpan.  It is the default.
+	LastAttributeValueWins DuplicateAttributePolicy = iota
+	// FirstAttributeValueWins keeps the value set first, including the
+	// attributes added by the sampler, and ignores the later ones.
+	FirstAttributeValueWins
+)
@@ -0,0 +1,124 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package trace // import "go.opentelemetry.io/otel/sdk/trace"
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/sdk/internal"
+)
+
+// Environment variable names
+const (
+	// The sampler of the TracerProvider: always_on, always_off,
+	// traceidratio, parentbased_always_on, parentbased_always_off or
+	// parentbased_traceidratio.
+	envSampler = "OTEL_TRACES_SAMPLER"
+	// The argument of the sampler, the ratio of the traceidratio
+	// samplers (default 1.0).
+	envSamplerArg = "OTEL_TRACES_SAMPLER_ARG"
+
+	// The BatchTimeout of the BatchSpanProcessor in milliseconds.
+	envBSPScheduleDelay = "OTEL_BSP_SCHEDULE_DELAY"
+	// The ExportTimeout of the BatchSpanProcessor in milliseconds.
+	envBSPExportTimeout = "OTEL_BSP_EXPORT_TIMEOUT"
+	// The MaxQueueSize of the BatchSpanProcessor.
+	envBSPMaxQueueSize = "OTEL_BSP_MAX_QUEUE_SIZE"
+	// The MaxExportBatchSize of the BatchSpanProcessor.
+	envBSPMaxExportBatchSize = "OTEL_BSP_MAX_EXPORT_BATCH_SIZE"
+
+	// The maximum number of attributes per span.
+	envSpanAttributeCountLimit = "OTEL_SPAN_ATTRIBUTE_COUNT_LIMIT"
+	// The maximum number of events per span.
+	envSpanEventCountLimit = "OTEL_SPAN_EVENT_COUNT_LIMIT"
+	// The maximum number of links per span.
+	envSpanLinkCountLimit = "OTEL_SPAN_LINK_COUNT_LIMIT"
+)
+
+var errUnknownSampler = errors.New("unknown sampler")
+
+// samplerFromEnv returns the sampler configured by OTEL_TRACES_SAMPLER and
+// OTEL_TRACES_SAMPLER_ARG, or nil if none is. An error is returned if
+// the sampler or its argument is invalid.
+func samplerFromEnv() (Sampler, error) {
+	name := strings.ToLower(strings.TrimSpace(os.Getenv(envSampler)))
+	if name == "" {
+		return nil, nil
+	}
+
+	ratio := func() (float64, error) {
+		arg := strings.TrimSpace(os.Getenv(envSamplerArg))
+		if arg == "" {
+			return 1.0, nil
+		}
+		r, err := strconv.ParseFloat(arg, 64)
+		if err != nil || r < 0 || r > 1 {
+			return 0, fmt.Errorf("%w: %s=%q is not a ratio between 0 and 1", internal.ErrInvalidEnv, envSamplerArg, arg)
+		}
+		return r, nil
+	}
+
+	switch name {
+	case "always_on":
+		return AlwaysSample(), nil
+	case "always_off":
+		return NeverSample(), nil
+	case "traceidratio":
+		r, err := ratio()
+		if err != nil {
+			return nil, err
+		}
+		return TraceIDRatioBased(r), nil
+	case "parentbased_always_on":
+		return ParentBased(AlwaysSample()), nil
+	case "parentbased_always_off":
+		return ParentBased(NeverSample()), nil
+	case "parentbased_traceidratio":
+		r, err := ratio()
+		if err != nil {
+			return nil, err
+		}
+		return ParentBased(TraceIDRatioBased(r)), nil
+	}
+	return nil, fmt.Errorf("%w: %s=%q", errUnknownSampler, envSampler, name)
+}
+
+// applyEnvConfig sets the sampler and span limits of c configured by
+// environment variables.
+func applyEnvConfig(c *Config) {
+	sampler, err := samplerFromEnv()
+	if err != nil {
+		otel.Handle(err)
+	} else if sampler != nil {
+		c.DefaultSampler = sampler
+	}
+	internal.IntEnv(envSpanAttributeCountLimit, &c.MaxAttributesPerSpan)
+	internal.IntEnv(envSpanEventCountLimit, &c.MaxEventsPerSpan)
+	internal.IntEnv(envSpanLinkCountLimit, &c.MaxLinksPerSpan)
+}
+
+// applyEnvBatchSpanProcessorOptions sets the options of o configured by
+// environment variables.
+func applyEnvBatchSpanProcessorOptions(o *BatchSpanProcessorOptions) {
+	internal.DurationEnv(envBSPScheduleDelay, &o.BatchTimeout)
+	internal.DurationEnv(envBSPExportTimeout, &o.ExportTimeout)
+	internal.IntEnv(envBSPMaxQueueSize, &o.MaxQueueSize)
+	internal.IntEnv(envBSPMaxExportBatchSize, &o.MaxExportBatchSize)
+}
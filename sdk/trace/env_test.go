@@ -0,0 +1,110 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package trace
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	ottest "go.opentelemetry.io/otel/internal/internaltest"
+)
+
+func setEnv(t *testing.T, env map[string]string) func() {
+	store, err := ottest.SetEnvVariables(env)
+	require.NoError(t, err)
+	return func() { require.NoError(t, store.Restore()) }
+}
+
+func TestSamplerFromEnv(t *testing.T) {
+	tests := []struct {
+		sampler, arg string
+		want         Sampler
+		wantErr      bool
+	}{
+		{sampler: "", want: nil},
+		{sampler: "always_on", want: AlwaysSample()},
+		{sampler: "ALWAYS_OFF", want: NeverSample()},
+		{sampler: "traceidratio", want: TraceIDRatioBased(1)},
+		{sampler: "traceidratio", arg: "0.25", want: TraceIDRatioBased(0.25)},
+		{sampler: "traceidratio", arg: "1.5", wantErr: true},
+		{sampler: "traceidratio", arg: "half", wantErr: true},
+		{sampler: "parentbased_always_on", want: ParentBased(AlwaysSample())},
+		{sampler: "parentbased_always_off", want: ParentBased(NeverSample())},
+		{sampler: "parentbased_traceidratio", arg: "0.5", want: ParentBased(TraceIDRatioBased(0.5))},
+		{sampler: "jaeger_remote", wantErr: true},
+	}
+	for _, test := range tests {
+		t.Run(test.sampler+"/"+test.arg, func(t *testing.T) {
+			defer setEnv(t, map[string]string{envSampler: test.sampler, envSamplerArg: test.arg})()
+
+			got, err := samplerFromEnv()
+			if test.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, test.want, got)
+		})
+	}
+}
+
+func TestTracerProviderFromEnv(t *testing.T) {
+	defer setEnv(t, map[string]string{
+		envSampler:                 "always_off",
+		envSpanAttributeCountLimit: "10",
+		envSpanEventCountLimit:     "20",
+		envSpanLinkCountLimit:      "invalid",
+	})()
+
+	cfg := NewTracerProvider().config.Load().(*Config)
+	assert.Equal(t, NeverSample(), cfg.DefaultSampler)
+	assert.Equal(t, 10, cfg.MaxAttributesPerSpan)
+	assert.Equal(t, 20, cfg.MaxEventsPerSpan)
+	assert.Equal(t, DefaultMaxLinksPerSpan, cfg.MaxLinksPerSpan)
+
+	// Options take precedence over the environment.
+	cfg = NewTracerProvider(WithConfig(Config{
+		DefaultSampler:       AlwaysSample(),
+		MaxAttributesPerSpan: 5,
+	})).config.Load().(*Config)
+	assert.Equal(t, AlwaysSample(), cfg.DefaultSampler)
+	assert.Equal(t, 5, cfg.MaxAttributesPerSpan)
+	assert.Equal(t, 20, cfg.MaxEventsPerSpan)
+}
+
+func TestBatchSpanProcessorFromEnv(t *testing.T) {
+	defer setEnv(t, map[string]string{
+		envBSPScheduleDelay:      "100",
+		envBSPExportTimeout:      "-1",
+		envBSPMaxQueueSize:       "10",
+		envBSPMaxExportBatchSize: "5",
+	})()
+
+	bsp := NewBatchSpanProcessor(nil)
+	defer func() { require.NoError(t, bsp.Shutdown(context.Background())) }()
+	assert.Equal(t, 100*time.Millisecond, bsp.o.BatchTimeout)
+	assert.Equal(t, DefaultExportTimeout, bsp.o.ExportTimeout)
+	assert.Equal(t, 10, bsp.o.MaxQueueSize)
+	assert.Equal(t, 5, bsp.o.MaxExportBatchSize)
+
+	bsp2 := NewBatchSpanProcessor(nil, WithMaxQueueSize(50))
+	defer func() { require.NoError(t, bsp2.Shutdown(context.Background())) }()
+	assert.Equal(t, 50, bsp2.o.MaxQueueSize)
+	assert.Equal(t, 5, bsp2.o.MaxExportBatchSize)
+}
@@ -17,10 +17,11 @@ package trace // import "go.opentelemetry.io/otel/sdk/trace"
 import (
 	"context"
 	crand "crypto/rand"
-	"encoding/binary"
-	"math/rand"
+	"io"
+	mrand "math/rand"
 	"sync"
 
+	"go.opentelemetry.io/otel"
 	"go.opentelemetry.io/otel/trace"
 )
 
@@ -30,38 +31,97 @@ type IDGenerator interface {
 	NewSpanID(ctx context.Context, traceID trace.TraceID) trace.SpanID
 }
 
+// RandomTraceIDGenerator is an optional interface an IDGenerator can
+// implement to report that the rightmost 7 bytes of the trace IDs it
+// generates are random. The random trace flag is set on the root spans
+// of the traces it generates IDs for when RandomTraceIDs returns true.
+type RandomTraceIDGenerator interface {
+	RandomTraceIDs() bool
+}
+
+// randomIDGenerator generates IDs from crypto/rand.  The random bytes
+// are read in chunks into buffers held by a sync.Pool, which caches
+// them per P: concurrent span creation neither contends on a lock nor
+// reads from the system for every ID.
 type randomIDGenerator struct {
-	sync.Mutex
-	randSource *rand.Rand
+	buffers sync.Pool
+}
+
+// randomBufferSize is the number of random bytes read at once, enough
+// for 170 trace and span ID pairs.
+const randomBufferSize = 4096
+
+// randomBuffer holds random bytes that have not been used yet.
+type randomBuffer struct {
+	buf [randomBufferSize]byte
+	off int
+}
+
+// read fills p with random bytes, reading more when the buffer is
+// exhausted.
+func (b *randomBuffer) read(p []byte) {
+	for len(p) > 0 {
+		if b.off == len(b.buf) {
+			b.fill()
+		}
+		n := copy(p, b.buf[b.off:])
+		b.off += n
+		p = p[n:]
+	}
+}
+
+func (b *randomBuffer) fill() {
+	if _, err := io.ReadFull(crand.Reader, b.buf[:]); err != nil {
+		otel.Handle(err)
+		// Generating unique IDs matters more than their
+		// unpredictability.
+		_, _ = mrand.Read(b.buf[:])
+	}
+	b.off = 0
 }
 
 var _ IDGenerator = &randomIDGenerator{}
+var _ RandomTraceIDGenerator = &randomIDGenerator{}
 
-// NewSpanID returns a non-zero span ID from a randomly-chosen sequence.
+// RandomTraceIDs returns true, all the bytes of the trace IDs are random.
+func (gen *randomIDGenerator) RandomTraceIDs() bool {
+	return true
+}
+
+// NewSpanID returns a non-zero random span ID.
 func (gen *randomIDGenerator) NewSpanID(ctx context.Context, traceID trace.TraceID) trace.SpanID {
-	gen.Lock()
-	defer gen.Unlock()
-	sid := trace.SpanID{}
-	gen.randSource.Read(sid[:])
+	b := gen.buffers.Get().(*randomBuffer)
+	sid := newSpanID(b)
+	gen.buffers.Put(b)
 	return sid
 }
 
-// NewIDs returns a non-zero trace ID and a non-zero span ID from a
-// randomly-chosen sequence.
+// NewIDs returns a non-zero random trace ID and a non-zero random span
+// ID.
 func (gen *randomIDGenerator) NewIDs(ctx context.Context) (trace.TraceID, trace.SpanID) {
-	gen.Lock()
-	defer gen.Unlock()
+	b := gen.buffers.Get().(*randomBuffer)
 	tid := trace.TraceID{}
-	gen.randSource.Read(tid[:])
-	sid := trace.SpanID{}
-	gen.randSource.Read(sid[:])
+	for !tid.IsValid() {
+		b.read(tid[:])
+	}
+	sid := newSpanID(b)
+	gen.buffers.Put(b)
 	return tid, sid
 }
 
+func newSpanID(b *randomBuffer) trace.SpanID {
+	sid := trace.SpanID{}
+	for !sid.IsValid() {
+		b.read(sid[:])
+	}
+	return sid
+}
+
 func defaultIDGenerator() IDGenerator {
 	gen := &randomIDGenerator{}
-	var rngSeed int64
-	_ = binary.Read(crand.Reader, binary.LittleEndian, &rngSeed)
-	gen.randSource = rand.New(rand.NewSource(rngSeed))
+	gen.buffers.New = func() interface{} {
+		// The buffer is filled when it is first read.
+		return &randomBuffer{off: randomBufferSize}
+	}
 	return gen
 }
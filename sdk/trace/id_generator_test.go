@@ -0,0 +1,74 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package trace
+
+import (
+	"context"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"go.opentelemetry.io/otel/trace"
+)
+
+func TestRandomIDGenerator(t *testing.T) {
+	idg := defaultIDGenerator()
+	ctx := context.Background()
+
+	const goroutines, ids = 8, 1000
+	var (
+		mu       sync.Mutex
+		traceIDs = make(map[trace.TraceID]bool)
+		spanIDs  = make(map[trace.SpanID]bool)
+		wg       sync.WaitGroup
+	)
+	for g := 0; g < goroutines; g++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			// Enough IDs for every buffer to be refilled.
+			for i := 0; i < ids; i++ {
+				tid, sid := idg.NewIDs(ctx)
+				child := idg.NewSpanID(ctx, tid)
+
+				mu.Lock()
+				traceIDs[tid] = true
+				spanIDs[sid] = true
+				spanIDs[child] = true
+				mu.Unlock()
+			}
+		}()
+	}
+	wg.Wait()
+
+	assert.Len(t, traceIDs, goroutines*ids, "trace IDs must be unique")
+	assert.Len(t, spanIDs, 2*goroutines*ids, "span IDs must be unique")
+	assert.False(t, traceIDs[trace.TraceID{}], "trace IDs must be valid")
+	assert.False(t, spanIDs[trace.SpanID{}], "span IDs must be valid")
+}
+
+func BenchmarkRandomIDGenerator(b *testing.B) {
+	idg := defaultIDGenerator()
+	ctx := context.Background()
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	b.RunParallel(func(pb *testing.PB) {
+		for pb.Next() {
+			idg.NewIDs(ctx)
+		}
+	})
+}
@@ -0,0 +1,75 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package trace // import "go.opentelemetry.io/otel/sdk/trace"
+
+import (
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/label"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// nonRecordingSpan is the span of the SDK returned for the spans the
+// sampler drops. It carries the span context to propagate to children and
+// across process boundaries, and nothing else: unlike a span, none of the
+// state needed to record a span is allocated for it.
+type nonRecordingSpan struct {
+	// spanContext holds the SpanContext of this span.
+	spanContext trace.SpanContext
+
+	// spanKind represents the kind of this span as a trace.SpanKind.
+	spanKind trace.SpanKind
+
+	// executionTracerTaskEnd ends the execution tracer span.
+	executionTracerTaskEnd func()
+
+	// tracer is the SDK tracer that created this span.
+	tracer *tracer
+}
+
+var _ trace.Span = &nonRecordingSpan{}
+
+// SpanContext returns the span context of s.
+func (s *nonRecordingSpan) SpanContext() trace.SpanContext { return s.spanContext }
+
+// SpanKind returns the kind of s.
+func (s *nonRecordingSpan) SpanKind() trace.SpanKind { return s.spanKind }
+
+// IsRecording always returns false.
+func (*nonRecordingSpan) IsRecording() bool { return false }
+
+// SetStatus does nothing.
+func (*nonRecordingSpan) SetStatus(codes.Code, string) {}
+
+// SetAttributes does nothing.
+func (*nonRecordingSpan) SetAttributes(...label.KeyValue) {}
+
+// End ends the execution tracer task of s.
+func (s *nonRecordingSpan) End(...trace.SpanOption) {
+	if s.executionTracerTaskEnd != nil {
+		s.executionTracerTaskEnd()
+	}
+}
+
+// RecordError does nothing.
+func (*nonRecordingSpan) RecordError(error, ...trace.EventOption) {}
+
+// Tracer returns the tracer that created s.
+func (s *nonRecordingSpan) Tracer() trace.Tracer { return s.tracer }
+
+// AddEvent does nothing.
+func (*nonRecordingSpan) AddEvent(string, ...trace.EventOption) {}
+
+// SetName does nothing.
+func (*nonRecordingSpan) SetName(string) {}
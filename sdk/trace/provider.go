@@ -18,12 +18,14 @@ import (
 	"context"
 	"sync"
 	"sync/atomic"
+	"time"
 
 	"go.opentelemetry.io/otel"
 	"go.opentelemetry.io/otel/trace"
 
 	export "go.opentelemetry.io/otel/sdk/export/trace"
 	"go.opentelemetry.io/otel/sdk/instrumentation"
+	"go.opentelemetry.io/otel/sdk/internal"
 	"go.opentelemetry.io/otel/sdk/resource"
 )
 
@@ -36,8 +38,12 @@ const (
 
 // TracerProviderConfig
 type TracerProviderConfig struct {
-	processors []SpanProcessor
-	config     Config
+	processors  []SpanProcessor
+	config      Config
+	refreshable *resource.Refreshable
+
+	coarseClock            bool
+	coarseClockGranularity time.Duration
 }
 
 type TracerProviderOption func(*TracerProviderConfig)
@@ -47,6 +53,10 @@ type TracerProvider struct {
 	namedTracer    map[instrumentation.Library]*tracer
 	spanProcessors atomic.Value
 	config         atomic.Value // access atomically
+
+	// clock provides the start and end times of the spans when it is
+	// not nil, otherwise they are read from time.Now.
+	clock *internal.CoarseClock
 }
 
 var _ trace.TracerProvider = &TracerProvider{}
@@ -54,6 +64,12 @@ var _ trace.TracerProvider = &TracerProvider{}
 // NewTracerProvider creates an instance of trace provider. Optional
 // parameter configures the provider with common options applicable
 // to all tracer instances that will be created by this provider.
+//
+// The default sampler and span limits are read from the
+// OTEL_TRACES_SAMPLER, OTEL_TRACES_SAMPLER_ARG,
+// OTEL_SPAN_ATTRIBUTE_COUNT_LIMIT, OTEL_SPAN_EVENT_COUNT_LIMIT and
+// OTEL_SPAN_LINK_COUNT_LIMIT environment variables when they are set.
+// The options take precedence over them.
 func NewTracerProvider(opts ...TracerProviderOption) *TracerProvider {
 	o := &TracerProviderConfig{}
 
@@ -64,13 +80,18 @@ func NewTracerProvider(opts ...TracerProviderOption) *TracerProvider {
 	tp := &TracerProvider{
 		namedTracer: make(map[instrumentation.Library]*tracer),
 	}
-	tp.config.Store(&Config{
+	if o.coarseClock {
+		tp.clock = internal.NewCoarseClock(o.coarseClockGranularity)
+	}
+	defaults := &Config{
 		DefaultSampler:       ParentBased(AlwaysSample()),
 		IDGenerator:          defaultIDGenerator(),
 		MaxAttributesPerSpan: DefaultMaxAttributesPerSpan,
 		MaxEventsPerSpan:     DefaultMaxEventsPerSpan,
 		MaxLinksPerSpan:      DefaultMaxLinksPerSpan,
-	})
+	}
+	applyEnvConfig(defaults)
+	tp.config.Store(defaults)
 
 	for _, sp := range o.processors {
 		tp.RegisterSpanProcessor(sp)
@@ -78,6 +99,12 @@ func NewTracerProvider(opts ...TracerProviderOption) *TracerProvider {
 
 	tp.ApplyConfig(o.config)
 
+	if o.refreshable != nil {
+		o.refreshable.OnRefresh(func(r *resource.Resource) {
+			tp.ApplyConfig(Config{Resource: r})
+		})
+	}
+
 	return tp
 }
 
@@ -86,14 +113,19 @@ func NewTracerProvider(opts ...TracerProviderOption) *TracerProvider {
 func (p *TracerProvider) Tracer(name string, opts ...trace.TracerOption) trace.Tracer {
 	c := trace.NewTracerConfig(opts...)
 
+	if err := instrumentation.ValidateName(name); err != nil {
+		otel.Handle(err)
+	}
+
 	p.mu.Lock()
 	defer p.mu.Unlock()
 	if name == "" {
 		name = defaultTracerName
 	}
 	il := instrumentation.Library{
-		Name:    name,
-		Version: c.InstrumentationVersion,
+		Name:      name,
+		Version:   c.InstrumentationVersion,
+		SchemaURL: c.SchemaURL,
 	}
 	t, ok := p.namedTracer[il]
 	if !ok {
@@ -118,6 +150,7 @@ func (p *TracerProvider) RegisterSpanProcessor(s SpanProcessor) {
 		sp:    s,
 		state: &sync.Once{},
 	}
+	newSpanSync.filter, _ = s.(EndedSpanFilter)
 	new = append(new, newSpanSync)
 	p.spanProcessors.Store(new)
 }
@@ -182,11 +215,42 @@ func (p *TracerProvider) ApplyConfig(cfg Config) {
 	if cfg.Resource != nil {
 		c.Resource = cfg.Resource
 	}
+	if cfg.DisableEvents {
+		c.DisableEvents = true
+	}
+	if cfg.DuplicateAttributes != LastAttributeValueWins {
+		c.DuplicateAttributes = cfg.DuplicateAttributes
+	}
 	p.config.Store(&c)
 }
 
-// Shutdown shuts down the span processors in the order they were registered
+// now returns the current time from the clock of the provider.
+func (p *TracerProvider) now() time.Time {
+	if p.clock != nil {
+		return p.clock.Now()
+	}
+	return time.Now()
+}
+
+// endTime returns the end time of a span started at start.
+func (p *TracerProvider) endTime(start time.Time) time.Time {
+	if p.clock != nil {
+		if now := p.clock.Now(); now.After(start) {
+			return now
+		}
+		return start
+	}
+	return internal.MonotonicEndTime(start)
+}
+
+// Shutdown shuts down the span processors in the order they were
+// registered, and then stops the coarse clock of the provider if it
+// has one.
 func (p *TracerProvider) Shutdown(ctx context.Context) error {
+	if p.clock != nil {
+		defer p.clock.Stop()
+	}
+
 	spss, ok := p.spanProcessors.Load().(spanProcessorStates)
 	if !ok || len(spss) == 0 {
 		return nil
@@ -236,6 +300,48 @@ func WithResource(r *resource.Resource) TracerProviderOption {
 	}
 }
 
+// WithRefreshableResource option attaches the Resource held by r to the
+// provider, like WithResource, and updates the provider every time r
+// is refreshed. Spans started after a refresh use the new Resource.
+func WithRefreshableResource(r *resource.Refreshable) TracerProviderOption {
+	return func(opts *TracerProviderConfig) {
+		opts.config.Resource = r.Resource()
+		opts.refreshable = r
+	}
+}
+
+// WithoutSpanEvents option disables the recording of span events by the
+// spans of the TracerProvider, for services where the cost of storing
+// them matters more than their content.  Calls to AddEvent and
+// RecordError only update the status of the spans.
+func WithoutSpanEvents() TracerProviderOption {
+	return func(opts *TracerProviderConfig) {
+		opts.config.DisableEvents = true
+	}
+}
+
+// WithDuplicateAttributePolicy option sets which value of an attribute
+// set more than once on the spans of the TracerProvider is kept.  The
+// last one is kept by default.
+func WithDuplicateAttributePolicy(policy DuplicateAttributePolicy) TracerProviderOption {
+	return func(opts *TracerProviderConfig) {
+		opts.config.DuplicateAttributes = policy
+	}
+}
+
+// WithCoarseClock option makes the TracerProvider read the start and
+// end times of its spans from a clock cached in the background and
+// refreshed every granularity, instead of calling time.Now for each
+// span.  The timestamps are then only accurate to the granularity, which
+// is one millisecond when it is not positive.  The clock is stopped when
+// the TracerProvider is shut down.
+func WithCoarseClock(granularity time.Duration) TracerProviderOption {
+	return func(opts *TracerProviderConfig) {
+		opts.coarseClock = true
+		opts.coarseClockGranularity = granularity
+	}
+}
+
 // WithIDGenerator option registers an IDGenerator with the TracerProvider.
 func WithIDGenerator(g IDGenerator) TracerProviderOption {
 	return func(opts *TracerProviderConfig) {
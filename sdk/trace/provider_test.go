@@ -18,8 +18,15 @@ import (
 	"context"
 	"errors"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.opentelemetry.io/otel/label"
+	"go.opentelemetry.io/otel/sdk/instrumentation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	"go.opentelemetry.io/otel/trace"
 )
 
 type basicSpanProcesor struct {
@@ -82,3 +89,89 @@ func TestFailedProcessorShutdownInUnregister(t *testing.T) {
 	_ = stp.Shutdown(context.Background())
 	assert.Empty(t, handler.errs)
 }
+
+func TestTracerInstrumentationLibrary(t *testing.T) {
+	handler.Reset()
+	te := NewTestExporter()
+	tp := NewTracerProvider(WithSyncer(te))
+
+	tr := tp.Tracer(
+		"go.opentelemetry.io/otel/sdk/trace/test",
+		trace.WithInstrumentationVersion("v0.1.0"),
+		trace.WithSchemaURL("https://opentelemetry.io/schemas/1.13.0"),
+	)
+	assert.Same(t, tr, tp.Tracer(
+		"go.opentelemetry.io/otel/sdk/trace/test",
+		trace.WithInstrumentationVersion("v0.1.0"),
+		trace.WithSchemaURL("https://opentelemetry.io/schemas/1.13.0"),
+	))
+	assert.NotSame(t, tr, tp.Tracer(
+		"go.opentelemetry.io/otel/sdk/trace/test",
+		trace.WithInstrumentationVersion("v0.1.0"),
+	))
+
+	want := instrumentation.Library{
+		Name:      "go.opentelemetry.io/otel/sdk/trace/test",
+		Version:   "v0.1.0",
+		SchemaURL: "https://opentelemetry.io/schemas/1.13.0",
+	}
+	_, span := tr.Start(context.Background(), "span")
+	assert.Equal(t, want, span.(ReadOnlySpan).InstrumentationLibrary())
+	span.End()
+	require.Equal(t, 1, te.Len())
+	assert.Equal(t, want, te.Spans()[0].InstrumentationLibrary)
+	assert.Empty(t, handler.errs)
+}
+
+func TestTracerInvalidName(t *testing.T) {
+	tp := NewTracerProvider()
+	for _, name := range []string{"", " padded ", "new\nline"} {
+		handler.Reset()
+		assert.NotNil(t, tp.Tracer(name))
+		require.Len(t, handler.errs, 1, name)
+		assert.True(t, errors.Is(handler.errs[0], instrumentation.ErrInvalidName))
+	}
+}
+
+type valueDetector struct{ v *string }
+
+func (d valueDetector) Detect(context.Context) (*resource.Resource, error) {
+	return resource.NewWithAttributes(label.String("lifecycle", *d.v)), nil
+}
+
+func TestRefreshableResource(t *testing.T) {
+	ctx := context.Background()
+	v := "running"
+	r, err := resource.NewRefreshable(ctx, resource.WithoutBuiltin(), resource.WithDetectors(valueDetector{&v}))
+	require.NoError(t, err)
+
+	tp := NewTracerProvider(WithRefreshableResource(r))
+	tr := tp.Tracer("test")
+
+	_, before := tr.Start(ctx, "before")
+	v = "terminating"
+	require.NoError(t, r.Refresh(ctx))
+	_, after := tr.Start(ctx, "after")
+
+	assert.Equal(t, resource.NewWithAttributes(label.String("lifecycle", "running")), before.(ReadOnlySpan).Resource())
+	assert.Equal(t, resource.NewWithAttributes(label.String("lifecycle", "terminating")), after.(ReadOnlySpan).Resource())
+}
+
+func TestCoarseClock(t *testing.T) {
+	ctx := context.Background()
+	tp := NewTracerProvider(WithCoarseClock(time.Hour))
+	tr := tp.Tracer("test")
+
+	_, first := tr.Start(ctx, "first")
+	time.Sleep(time.Millisecond)
+	_, second := tr.Start(ctx, "second")
+	first.End()
+
+	start := first.(ReadOnlySpan).StartTime()
+	assert.Equal(t, start, second.(ReadOnlySpan).StartTime())
+	assert.Equal(t, start, first.(ReadOnlySpan).EndTime())
+
+	require.NoError(t, tp.Shutdown(ctx))
+	second.End()
+	assert.True(t, second.(ReadOnlySpan).EndTime().After(start))
+}
@@ -30,13 +30,20 @@ type Sampler interface {
 
 // SamplingParameters contains the values passed to a Sampler.
 type SamplingParameters struct {
+	// ParentContext is the SpanContext of the parent of the span,
+	// local or remote, including its TraceState.  It is empty for
+	// root spans.
 	ParentContext   trace.SpanContext
 	TraceID         trace.TraceID
 	Name            string
 	HasRemoteParent bool
 	Kind            trace.SpanKind
 	Attributes      []label.KeyValue
-	Links           []trace.Link
+	// Links are all the links the span starts with, including the
+	// ones to the span contexts of the passed context of a span
+	// started with trace.WithNewRoot and the pending links of the
+	// context.
+	Links []trace.Link
 }
 
 // SamplingDecision indicates whether a span is dropped, recorded and/or sampled.
@@ -60,7 +67,13 @@ const (
 type SamplingResult struct {
 	Decision   SamplingDecision
 	Attributes []label.KeyValue
+	// Tracestate is the TraceState of the span, whatever the
+	// decision, if TracestateSet is true.  Otherwise the span keeps
+	// the TraceState of the ParentContext.
 	Tracestate trace.TraceState
+	// TracestateSet reports whether Tracestate replaces the TraceState
+	// of the ParentContext.
+	TracestateSet bool
 }
 
 type traceIDRatioSampler struct {
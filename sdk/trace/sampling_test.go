@@ -234,3 +234,90 @@ func TestTracestateIsPassed(t *testing.T) {
 		})
 	}
 }
+
+// traceStateSampler samples all spans and records the value of the "p"
+// TraceState entry it was passed in a "s" entry.
+type traceStateSampler struct {
+	params []SamplingParameters
+}
+
+func (s *traceStateSampler) ShouldSample(p SamplingParameters) SamplingResult {
+	s.params = append(s.params, p)
+	ts, err := p.ParentContext.TraceState.Insert(label.String("s", p.ParentContext.TraceState.Get("p").AsString()+"1"))
+	if err != nil {
+		panic(err)
+	}
+	return SamplingResult{Decision: RecordAndSample, Tracestate: ts, TracestateSet: true}
+}
+
+func (s *traceStateSampler) Description() string {
+	return "traceStateSampler"
+}
+
+func TestSamplingResultTraceState(t *testing.T) {
+	sampler := &traceStateSampler{}
+	tr := NewTracerProvider(WithConfig(Config{DefaultSampler: sampler})).Tracer("test")
+
+	ts, err := trace.TraceStateFromKeyValues(label.String("p", "v"))
+	require.NoError(t, err)
+	parent := trace.SpanContext{
+		TraceID:    [16]byte{1},
+		SpanID:     [8]byte{1},
+		TraceFlags: trace.FlagsSampled,
+		TraceState: ts,
+	}
+	ctx := trace.ContextWithRemoteSpanContext(context.Background(), parent)
+	ctx, span := tr.Start(ctx, "span")
+	require.Len(t, sampler.params, 1)
+	require.Equal(t, parent, sampler.params[0].ParentContext)
+	require.Equal(t, "v1", span.SpanContext().TraceState.Get("s").AsString())
+	require.Equal(t, "v", span.SpanContext().TraceState.Get("p").AsString())
+
+	// The parent of a renamed span is passed to the sampler again.
+	span.SetName("renamed")
+	require.Len(t, sampler.params, 2)
+	require.Equal(t, parent, sampler.params[1].ParentContext)
+	require.Equal(t, "v1", span.SpanContext().TraceState.Get("s").AsString())
+
+	// The span contexts of the context of a new root are passed as
+	// links, along with the links of the span.
+	link := trace.Link{SpanContext: trace.SpanContext{TraceID: [16]byte{2}, SpanID: [8]byte{2}}}
+	_, root := tr.Start(ctx, "root", trace.WithNewRoot(), trace.WithLinks(link))
+	require.Len(t, sampler.params, 3)
+	require.False(t, sampler.params[2].ParentContext.IsValid())
+	links := sampler.params[2].Links
+	require.Len(t, links, 3)
+	require.Equal(t, span.SpanContext(), links[0].SpanContext)
+	require.Equal(t, parent, links[1].SpanContext)
+	require.Equal(t, link, links[2])
+	require.Equal(t, "1", root.SpanContext().TraceState.Get("s").AsString())
+}
+
+// decisionSampler samples all spans without setting the TraceState.
+type decisionSampler struct{}
+
+func (decisionSampler) ShouldSample(SamplingParameters) SamplingResult {
+	return SamplingResult{Decision: RecordAndSample}
+}
+
+func (decisionSampler) Description() string {
+	return "decisionSampler"
+}
+
+func TestSamplingResultKeepsParentTraceState(t *testing.T) {
+	tr := NewTracerProvider(WithConfig(Config{DefaultSampler: decisionSampler{}})).Tracer("test")
+
+	ts, err := trace.TraceStateFromKeyValues(label.String("p", "v"))
+	require.NoError(t, err)
+	ctx := trace.ContextWithRemoteSpanContext(context.Background(), trace.SpanContext{
+		TraceID:    [16]byte{1},
+		SpanID:     [8]byte{1},
+		TraceFlags: trace.FlagsSampled,
+		TraceState: ts,
+	})
+	_, span := tr.Start(ctx, "span")
+	require.Equal(t, ts, span.SpanContext().TraceState)
+
+	span.SetName("renamed")
+	require.Equal(t, ts, span.SpanContext().TraceState)
+}
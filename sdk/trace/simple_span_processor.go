@@ -18,6 +18,8 @@ import (
 	"context"
 
 	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/trace"
+
 	export "go.opentelemetry.io/otel/sdk/export/trace"
 )
 
@@ -27,7 +29,10 @@ type SimpleSpanProcessor struct {
 	e export.SpanExporter
 }
 
-var _ SpanProcessor = (*SimpleSpanProcessor)(nil)
+var (
+	_ SpanProcessor   = (*SimpleSpanProcessor)(nil)
+	_ EndedSpanFilter = (*SimpleSpanProcessor)(nil)
+)
 
 // NewSimpleSpanProcessor returns a new SimpleSpanProcessor that will
 // synchronously send SpanSnapshots to the exporter.
@@ -44,7 +49,7 @@ func (ssp *SimpleSpanProcessor) OnStart(parent context.Context, s ReadWriteSpan)
 
 // OnEnd method exports a ReadOnlySpan using the associated exporter.
 func (ssp *SimpleSpanProcessor) OnEnd(s ReadOnlySpan) {
-	if ssp.e != nil && s.SpanContext().IsSampled() {
+	if ssp.ProcessesEndedSpan(s.SpanContext()) {
 		ss := s.Snapshot()
 		if err := ssp.e.ExportSpans(context.Background(), []*export.SpanSnapshot{ss}); err != nil {
 			otel.Handle(err)
@@ -52,6 +57,12 @@ func (ssp *SimpleSpanProcessor) OnEnd(s ReadOnlySpan) {
 	}
 }
 
+// ProcessesEndedSpan reports whether the span with the SpanContext is
+// exported when it ends: it is if it is sampled and there is an exporter.
+func (ssp *SimpleSpanProcessor) ProcessesEndedSpan(sc trace.SpanContext) bool {
+	return ssp.e != nil && sc.IsSampled()
+}
+
 // Shutdown method does nothing. There is no data to cleanup.
 func (ssp *SimpleSpanProcessor) Shutdown(_ context.Context) error {
 	return nil
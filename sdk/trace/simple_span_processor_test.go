@@ -79,6 +79,19 @@ func TestSimpleSpanProcessorOnEnd(t *testing.T) {
 	}
 }
 
+func TestSimpleSpanProcessorProcessesEndedSpan(t *testing.T) {
+	sampled := trace.SpanContext{TraceFlags: trace.FlagsSampled}
+	if !sdktrace.NewSimpleSpanProcessor(&testExporter{}).ProcessesEndedSpan(sampled) {
+		t.Error("sampled span not processed")
+	}
+	if sdktrace.NewSimpleSpanProcessor(&testExporter{}).ProcessesEndedSpan(trace.SpanContext{}) {
+		t.Error("unsampled span processed")
+	}
+	if sdktrace.NewSimpleSpanProcessor(nil).ProcessesEndedSpan(sampled) {
+		t.Error("span processed without exporter")
+	}
+}
+
 func TestSimpleSpanProcessorShutdown(t *testing.T) {
 	ssp := sdktrace.NewSimpleSpanProcessor(&testExporter{})
 	if ssp == nil {
@@ -21,13 +21,13 @@ import (
 	"sync"
 	"time"
 
+	"go.opentelemetry.io/otel"
 	"go.opentelemetry.io/otel/codes"
 	"go.opentelemetry.io/otel/label"
 	"go.opentelemetry.io/otel/trace"
 
 	export "go.opentelemetry.io/otel/sdk/export/trace"
 	"go.opentelemetry.io/otel/sdk/instrumentation"
-	"go.opentelemetry.io/otel/sdk/internal"
 	"go.opentelemetry.io/otel/sdk/resource"
 )
 
@@ -123,6 +123,7 @@ type span struct {
 	attributes *attributesMap
 
 	// messageEvents are stored in FIFO queue capped by configured limit.
+	// It is nil if the provider does not record events.
 	messageEvents *evictedQueue
 
 	// links are stored in FIFO queue capped by configured limit.
@@ -150,7 +151,8 @@ func (s *span) IsRecording() bool {
 	}
 	s.mu.Lock()
 	defer s.mu.Unlock()
-	return s.endTime.IsZero()
+	// Only recording spans are given a start time.
+	return !s.startTime.IsZero() && s.endTime.IsZero()
 }
 
 func (s *span) SetStatus(code codes.Code, msg string) {
@@ -176,7 +178,8 @@ func (s *span) SetAttributes(attributes ...label.KeyValue) {
 // End ends the span.
 //
 // The only SpanOption currently supported is WithTimestamp which will set the
-// end time for a Span's life-cycle.
+// end time for a Span's life-cycle. An end time before the start time of the
+// Span is reported to the global ErrorHandler and replaced by the start time.
 //
 // If this method is called while panicking an error event is added to the
 // Span before ending it and the panic is continued.
@@ -187,7 +190,7 @@ func (s *span) End(options ...trace.SpanOption) {
 
 	// Store the end time as soon as possible to avoid artificially increasing
 	// the span's duration in case some operation below takes a while.
-	et := internal.MonotonicEndTime(s.startTime)
+	et := s.tracer.provider.endTime(s.startTime)
 
 	if recovered := recover(); recovered != nil {
 		// Record but don't stop the panic.
@@ -212,19 +215,26 @@ func (s *span) End(options ...trace.SpanOption) {
 	config := trace.NewSpanConfig(options...)
 
 	s.mu.Lock()
-	if config.Timestamp.IsZero() {
+	switch {
+	case config.Timestamp.IsZero():
 		s.endTime = et
-	} else {
+	case config.Timestamp.Before(s.startTime):
+		otel.HandleSeverity("sdk/trace", otel.SeverityWarning, fmt.Errorf(
+			"span %q end time %v is before its start time %v, using the start time",
+			s.name, config.Timestamp, s.startTime,
+		))
+		s.endTime = s.startTime
+	default:
 		s.endTime = config.Timestamp
 	}
 	s.mu.Unlock()
 
-	sps, ok := s.tracer.provider.spanProcessors.Load().(spanProcessorStates)
-	mustExportOrProcess := ok && len(sps) > 0
-	if mustExportOrProcess {
-		for _, sp := range sps {
-			sp.sp.OnEnd(s)
+	sps, _ := s.tracer.provider.spanProcessors.Load().(spanProcessorStates)
+	for _, sp := range sps {
+		if sp.filter != nil && !sp.filter.ProcessesEndedSpan(s.spanContext) {
+			continue
 		}
+		sp.sp.OnEnd(s)
 	}
 }
 
@@ -262,13 +272,22 @@ func (s *span) AddEvent(name string, o ...trace.EventOption) {
 }
 
 func (s *span) addEvent(name string, o ...trace.EventOption) {
+	if s.messageEvents == nil {
+		// Events are disabled, messageEvents is never set after
+		// the span is created.
+		return
+	}
 	c := trace.NewEventConfig(o...)
+	attributes := c.Attributes
+	for _, f := range c.AttributesFuncs {
+		attributes = append(attributes, f()...)
+	}
 
 	s.mu.Lock()
 	defer s.mu.Unlock()
 	s.messageEvents.add(trace.Event{
 		Name:       name,
-		Attributes: c.Attributes,
+		Attributes: attributes,
 		Time:       c.Timestamp,
 	})
 }
@@ -279,26 +298,17 @@ func (s *span) SetName(name string) {
 
 	s.name = name
 	// SAMPLING
-	noParent := !s.parent.SpanID.IsValid()
-	var ctx trace.SpanContext
-	if noParent {
-		ctx = trace.SpanContext{}
-	} else {
-		// FIXME: Where do we get the parent context from?
-		ctx = s.spanContext
-	}
 	data := samplingData{
-		noParent:     noParent,
+		noParent:     !s.parent.SpanID.IsValid(),
 		remoteParent: s.hasRemoteParent,
-		parent:       ctx,
+		parent:       s.parent,
 		name:         name,
 		cfg:          s.tracer.provider.config.Load().(*Config),
-		span:         s,
 		attributes:   s.attributes.toKeyValue(),
 		links:        s.interfaceArrayToLinksArray(),
 		kind:         s.spanKind,
 	}
-	sampled := makeSamplingDecision(data)
+	sampled := makeSamplingDecision(data, &s.spanContext)
 
 	// Adding attributes directly rather than using s.SetAttributes()
 	// as s.mu is already locked and attempting to do so would deadlock.
@@ -358,7 +368,7 @@ func (s *span) Links() []trace.Link {
 func (s *span) Events() []trace.Event {
 	s.mu.Lock()
 	defer s.mu.Unlock()
-	if len(s.messageEvents.queue) == 0 {
+	if s.messageEvents == nil || len(s.messageEvents.queue) == 0 {
 		return []trace.Event{}
 	}
 	return s.interfaceArrayToMessageEventArray()
@@ -401,9 +411,18 @@ func (s *span) addLink(link trace.Link) {
 // export.SpanSnapshot and returns a pointer to it.
 func (s *span) Snapshot() *export.SpanSnapshot {
 	var sd export.SpanSnapshot
+	s.snapshotTo(&sd)
+	return &sd
+}
+
+// snapshotTo fills sd with the current state of the span. All fields of sd
+// are overwritten, so it can be a reused export.SpanSnapshot.
+func (s *span) snapshotTo(sd *export.SpanSnapshot) {
 	s.mu.Lock()
 	defer s.mu.Unlock()
 
+	*sd = export.SpanSnapshot{}
+
 	sd.ChildSpanCount = s.childSpanCount
 	sd.EndTime = s.endTime
 	sd.HasRemoteParent = s.hasRemoteParent
@@ -421,7 +440,7 @@ func (s *span) Snapshot() *export.SpanSnapshot {
 		sd.Attributes = s.attributes.toKeyValue()
 		sd.DroppedAttributeCount = s.attributes.droppedCount
 	}
-	if len(s.messageEvents.queue) > 0 {
+	if s.messageEvents != nil && len(s.messageEvents.queue) > 0 {
 		sd.MessageEvents = s.interfaceArrayToMessageEventArray()
 		sd.DroppedMessageEventCount = s.messageEvents.droppedCount
 	}
@@ -429,7 +448,6 @@ func (s *span) Snapshot() *export.SpanSnapshot {
 		sd.Links = s.interfaceArrayToLinksArray()
 		sd.DroppedLinkCount = s.links.droppedCount
 	}
-	return &sd
 }
 
 func (s *span) interfaceArrayToLinksArray() []trace.Link {
@@ -467,60 +485,102 @@ func (s *span) addChild() {
 	s.mu.Unlock()
 }
 
-func startSpanInternal(ctx context.Context, tr *tracer, name string, parent trace.SpanContext, remoteParent bool, o *trace.SpanConfig) *span {
-	span := &span{}
-	span.spanContext = parent
+// newSpanContext returns the span context of a new span, a child of parent,
+// along with the decision of the sampler about it. The sampler is not
+// consulted for the valid span context passed with trace.WithSpanContext,
+// which keeps its sampling decision.
+//
+// The sampler is passed links followed by the links of o, all the links
+// the span starts with.
+func newSpanContext(ctx context.Context, cfg *Config, name string, parent trace.SpanContext, remoteParent bool, links []trace.Link, o *trace.SpanConfig) (trace.SpanContext, SamplingResult) {
+	sc := parent
+
+	recreated := !hasEmptySpanContext(o.SpanContext)
+	if recreated {
+		if err := validateSpanContext(o.SpanContext, parent); err != nil {
+			otel.HandleSeverity("sdk/trace", otel.SeverityWarning, err)
+			recreated = false
+		}
+	}
 
-	cfg := tr.provider.config.Load().(*Config)
+	if recreated {
+		// The span was recorded elsewhere, keep its identity.
+		return o.SpanContext, SamplingResult{}
+	}
 
 	if hasEmptySpanContext(parent) {
 		// Generate both TraceID and SpanID
-		span.spanContext.TraceID, span.spanContext.SpanID = cfg.IDGenerator.NewIDs(ctx)
+		sc.TraceID, sc.SpanID = cfg.IDGenerator.NewIDs(ctx)
+		if gen, ok := cfg.IDGenerator.(RandomTraceIDGenerator); ok && gen.RandomTraceIDs() {
+			sc.TraceFlags |= trace.FlagsRandom
+		}
 	} else {
 		// TraceID already exists, just generate a SpanID
-		span.spanContext.SpanID = cfg.IDGenerator.NewSpanID(ctx, parent.TraceID)
+		sc.SpanID = cfg.IDGenerator.NewSpanID(ctx, parent.TraceID)
 	}
 
-	span.attributes = newAttributesMap(cfg.MaxAttributesPerSpan)
-	span.messageEvents = newEvictedQueue(cfg.MaxEventsPerSpan)
-	span.links = newEvictedQueue(cfg.MaxLinksPerSpan)
+	if len(links) > 0 {
+		links = append(links[:len(links):len(links)], o.Links...)
+	} else {
+		links = o.Links
+	}
 
-	data := samplingData{
+	sampled := makeSamplingDecision(samplingData{
 		noParent:     hasEmptySpanContext(parent),
 		remoteParent: remoteParent,
 		parent:       parent,
 		name:         name,
 		cfg:          cfg,
-		span:         span,
 		attributes:   o.Attributes,
-		links:        o.Links,
+		links:        links,
 		kind:         o.SpanKind,
-	}
-	sampled := makeSamplingDecision(data)
-
-	if !span.spanContext.IsSampled() && !o.Record {
-		return span
-	}
+	}, &sc)
+	return sc, sampled
+}
 
+// startSpanInternal returns a new recording span with the span context sc
+// and the attributes added by the sampler.
+func startSpanInternal(tr *tracer, cfg *Config, name string, sc, parent trace.SpanContext, remoteParent bool, sampled SamplingResult, o *trace.SpanConfig) *span {
 	startTime := o.Timestamp
 	if startTime.IsZero() {
-		startTime = time.Now()
+		startTime = tr.provider.now()
 	}
-	span.startTime = startTime
-
-	span.spanKind = trace.ValidateSpanKind(o.SpanKind)
-	span.name = name
-	span.hasRemoteParent = remoteParent
-	span.resource = cfg.Resource
-	span.instrumentationLibrary = tr.instrumentationLibrary
 
+	span := &span{
+		spanContext:            sc,
+		parent:                 parent,
+		startTime:              startTime,
+		spanKind:               trace.ValidateSpanKind(o.SpanKind),
+		name:                   name,
+		hasRemoteParent:        remoteParent,
+		resource:               cfg.Resource,
+		instrumentationLibrary: tr.instrumentationLibrary,
+		attributes:             newAttributesMap(cfg.MaxAttributesPerSpan, cfg.DuplicateAttributes),
+		links:                  newEvictedQueue(cfg.MaxLinksPerSpan),
+		tracer:                 tr,
+	}
+	if !cfg.DisableEvents {
+		span.messageEvents = newEvictedQueue(cfg.MaxEventsPerSpan)
+	}
 	span.SetAttributes(sampled.Attributes...)
 
-	span.parent = parent
-
 	return span
 }
 
+// validateSpanContext returns an error if sc, passed with
+// trace.WithSpanContext, cannot be used as the identity of a child of parent.
+func validateSpanContext(sc, parent trace.SpanContext) error {
+	switch {
+	case !sc.IsValid():
+		return fmt.Errorf("ignoring invalid span context %s-%s", sc.TraceID, sc.SpanID)
+	case parent.HasTraceID() && parent.TraceID != sc.TraceID:
+		return fmt.Errorf("ignoring span context with trace ID %s that differs from parent trace ID %s", sc.TraceID, parent.TraceID)
+	case parent.SpanID == sc.SpanID:
+		return fmt.Errorf("ignoring span context with the span ID %s of its parent", sc.SpanID)
+	}
+	return nil
+}
+
 func hasEmptySpanContext(parent trace.SpanContext) bool {
 	return parent.SpanID == emptySpanContext.SpanID &&
 		parent.TraceID == emptySpanContext.TraceID &&
@@ -534,15 +594,16 @@ type samplingData struct {
 	parent       trace.SpanContext
 	name         string
 	cfg          *Config
-	span         *span
 	attributes   []label.KeyValue
 	links        []trace.Link
 	kind         trace.SpanKind
 }
 
-func makeSamplingDecision(data samplingData) SamplingResult {
+// makeSamplingDecision samples the span with the spanContext, setting its
+// sampled flag according to the decision of the sampler and its TraceState
+// to the one returned by the sampler when the sampler sets it.
+func makeSamplingDecision(data samplingData, spanContext *trace.SpanContext) SamplingResult {
 	sampler := data.cfg.DefaultSampler
-	spanContext := &data.span.spanContext
 	sampled := sampler.ShouldSample(SamplingParameters{
 		ParentContext:   data.parent,
 		TraceID:         spanContext.TraceID,
@@ -557,5 +618,8 @@ func makeSamplingDecision(data samplingData) SamplingResult {
 	} else {
 		spanContext.TraceFlags &^= trace.FlagsSampled
 	}
+	if sampled.TracestateSet {
+		spanContext.TraceState = sampled.Tracestate
+	}
 	return sampled
 }
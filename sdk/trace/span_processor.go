@@ -17,6 +17,8 @@ package trace // import "go.opentelemetry.io/otel/sdk/trace"
 import (
 	"context"
 	"sync"
+
+	"go.opentelemetry.io/otel/trace"
 )
 
 // SpanProcessor is interface to add hooks to start and end method invocations.
@@ -42,8 +44,23 @@ type SpanProcessor interface {
 	ForceFlush()
 }
 
+// EndedSpanFilter is an optional interface implemented by the
+// SpanProcessors that only process some of the ended spans, like the ones
+// exporting sampled spans only. The SDK does not call the OnEnd method of
+// such a SpanProcessor for the spans it would ignore, sparing the snapshot
+// of their data. When no registered SpanProcessor processes an ended span,
+// ending it does not involve any SpanProcessor.
+type EndedSpanFilter interface {
+	// ProcessesEndedSpan reports whether the OnEnd method processes the
+	// ended span with the SpanContext. It is called for every ended span
+	// and must be fast.
+	ProcessesEndedSpan(sc trace.SpanContext) bool
+}
+
 type spanProcessorState struct {
 	sp    SpanProcessor
 	state *sync.Once
+	// filter is sp if it implements EndedSpanFilter.
+	filter EndedSpanFilter
 }
 type spanProcessorStates []*spanProcessorState
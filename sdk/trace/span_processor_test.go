@@ -248,6 +248,48 @@ func TestMultipleUnregisterSpanProcessorCalls(t *testing.T) {
 	}
 }
 
+// sampledOnlySpanProcessor is a testSpanProcessor only processing the
+// sampled ended spans.
+type sampledOnlySpanProcessor struct {
+	testSpanProcessor
+}
+
+func (*sampledOnlySpanProcessor) ProcessesEndedSpan(sc trace.SpanContext) bool {
+	return sc.IsSampled()
+}
+
+func TestEndedSpanFilter(t *testing.T) {
+	tp := sdktrace.NewTracerProvider(sdktrace.WithConfig(sdktrace.Config{DefaultSampler: sdktrace.NeverSample()}))
+	filtering := &sampledOnlySpanProcessor{}
+	all := NewTestSpanProcessor("all")
+	tp.RegisterSpanProcessor(filtering)
+	tp.RegisterSpanProcessor(all)
+
+	tr := tp.Tracer("EndedSpanFilter")
+	// Recorded spans are processed when they start, sampled or not.
+	_, unsampled := tr.Start(context.Background(), "unsampled", trace.WithRecord())
+	unsampled.End()
+	_, sampled := tr.Start(context.Background(), "sampled", trace.WithRecord(), trace.WithSpanContext(trace.SpanContext{
+		TraceID:    trace.TraceID{0x01},
+		SpanID:     trace.SpanID{0x01},
+		TraceFlags: trace.FlagsSampled,
+	}))
+	sampled.End()
+
+	if got, want := len(filtering.spansStarted), 2; got != want {
+		t.Errorf("filtering processor: started count: got %d, want %d", got, want)
+	}
+	if got, want := len(filtering.spansEnded), 1; got != want {
+		t.Fatalf("filtering processor: ended count: got %d, want %d", got, want)
+	}
+	if got, want := filtering.spansEnded[0].Name(), "sampled"; got != want {
+		t.Errorf("filtering processor: ended span: got %s, want %s", got, want)
+	}
+	if got, want := len(all.spansEnded), 2; got != want {
+		t.Errorf("processor: ended count: got %d, want %d", got, want)
+	}
+}
+
 func NewTestSpanProcessor(name string) *testSpanProcessor {
 	return &testSpanProcessor{name: name}
 }
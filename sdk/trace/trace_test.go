@@ -77,6 +77,12 @@ func TestTracerFollowsExpectedAPIBehaviour(t *testing.T) {
 	harness.TestTracer(subjectFactory)
 }
 
+func TestTracerProviderFollowsExpectedAPIBehaviour(t *testing.T) {
+	oteltest.NewHarness(t).TestTracerProvider(func() trace.TracerProvider {
+		return NewTracerProvider()
+	})
+}
+
 type testExporter struct {
 	mu    sync.RWMutex
 	idx   map[string]int
@@ -451,7 +457,7 @@ func TestSamplerAttributesLocalChildSpan(t *testing.T) {
 		{
 			SpanContext: trace.SpanContext{
 				TraceID:    tid,
-				TraceFlags: 0x1,
+				TraceFlags: trace.FlagsSampled | trace.FlagsRandom,
 			},
 			ParentSpanID:           sid,
 			Name:                   "span1",
@@ -463,7 +469,7 @@ func TestSamplerAttributesLocalChildSpan(t *testing.T) {
 		{
 			SpanContext: trace.SpanContext{
 				TraceID:    tid,
-				TraceFlags: 0x1,
+				TraceFlags: trace.FlagsSampled | trace.FlagsRandom,
 			},
 			ParentSpanID:           pid,
 			Name:                   "span0",
@@ -518,6 +524,48 @@ func TestSetSpanAttributesOverLimit(t *testing.T) {
 	}
 }
 
+func TestSetSpanAttributesFirstValueWins(t *testing.T) {
+	te := NewTestExporter()
+	cfg := Config{MaxAttributesPerSpan: 2}
+	tp := NewTracerProvider(
+		WithConfig(cfg),
+		WithDuplicateAttributePolicy(FirstAttributeValueWins),
+		WithSyncer(te),
+	)
+
+	span := startSpan(tp, "SpanAttributesFirstValueWins")
+	span.SetAttributes(
+		label.Bool("key1", true),
+		label.String("key2", "value2"),
+		label.Bool("key1", false), // Ignored, key1 stays the oldest.
+		label.Int64("key4", 4),    // Remove key1 and add key4
+	)
+	got, err := endSpan(te, span)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := &export.SpanSnapshot{
+		SpanContext: trace.SpanContext{
+			TraceID:    tid,
+			TraceFlags: 0x1,
+		},
+		ParentSpanID: sid,
+		Name:         "span0",
+		Attributes: []label.KeyValue{
+			label.String("key2", "value2"),
+			label.Int64("key4", 4),
+		},
+		SpanKind:               trace.SpanKindInternal,
+		HasRemoteParent:        true,
+		DroppedAttributeCount:  1,
+		InstrumentationLibrary: instrumentation.Library{Name: "SpanAttributesFirstValueWins"},
+	}
+	if diff := cmpDiff(got, want); diff != "" {
+		t.Errorf("SetSpanAttributesFirstValueWins: -got +want %s", diff)
+	}
+}
+
 func TestEvents(t *testing.T) {
 	te := NewTestExporter()
 	tp := NewTracerProvider(WithSyncer(te))
@@ -563,6 +611,43 @@ func TestEvents(t *testing.T) {
 	}
 }
 
+func TestEventAttributesFunc(t *testing.T) {
+	called := 0
+	f := func() []label.KeyValue {
+		called++
+		return []label.KeyValue{label.String("key2", "value2")}
+	}
+
+	// The function is not called for spans that are not recording.
+	unsampled := NewTracerProvider(WithConfig(Config{DefaultSampler: NeverSample()}))
+	_, span := unsampled.Tracer("EventAttributesFunc").Start(context.Background(), "span")
+	span.AddEvent("foo", trace.WithAttributesFunc(f))
+	span.End()
+	if called != 0 {
+		t.Errorf("attributes function called %d times for a span that is not recording", called)
+	}
+
+	te := NewTestExporter()
+	tp := NewTracerProvider(WithSyncer(te))
+	span = startSpan(tp, "EventAttributesFunc")
+	span.AddEvent("foo", trace.WithAttributesFunc(f), trace.WithAttributes(label.String("key1", "value1")))
+	got, err := endSpan(te, span)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if called != 1 {
+		t.Errorf("attributes function called %d times, want 1", called)
+	}
+	want := []label.KeyValue{
+		label.String("key1", "value1"),
+		label.String("key2", "value2"),
+	}
+	if diff := cmpDiff(got.MessageEvents[0].Attributes, want); diff != "" {
+		t.Errorf("Event attributes: -got +want %s", diff)
+	}
+}
+
 func TestEventsOverLimit(t *testing.T) {
 	te := NewTestExporter()
 	cfg := Config{MaxEventsPerSpan: 2}
@@ -615,6 +700,53 @@ func TestEventsOverLimit(t *testing.T) {
 	}
 }
 
+func TestEventsDisabled(t *testing.T) {
+	te := NewTestExporter()
+	tp := NewTracerProvider(WithoutSpanEvents(), WithSyncer(te))
+
+	span := startSpan(tp, "EventsDisabled")
+	span.SetAttributes(label.String("key1", "value1"))
+	span.AddEvent("foo", trace.WithAttributes(label.String("key2", "value2")))
+	span.RecordError(errors.New("test error"))
+	got, err := endSpan(te, span)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := &export.SpanSnapshot{
+		SpanContext: trace.SpanContext{
+			TraceID:    tid,
+			TraceFlags: 0x1,
+		},
+		ParentSpanID:           sid,
+		Name:                   "span0",
+		Attributes:             []label.KeyValue{label.String("key1", "value1")},
+		StatusCode:             codes.Error,
+		HasRemoteParent:        true,
+		SpanKind:               trace.SpanKindInternal,
+		InstrumentationLibrary: instrumentation.Library{Name: "EventsDisabled"},
+	}
+	if diff := cmpDiff(got, want); diff != "" {
+		t.Errorf("Message Events disabled: -got +want %s", diff)
+	}
+	if events := span.(ReadOnlySpan).Events(); len(events) != 0 {
+		t.Errorf("Events() = %v, want none", events)
+	}
+
+	// Applying a config must not enable the events again.
+	te.Reset()
+	tp.ApplyConfig(Config{MaxEventsPerSpan: 2})
+	span = startSpan(tp, "EventsDisabled")
+	span.AddEvent("foo")
+	got, err = endSpan(te, span)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(got.MessageEvents) != 0 {
+		t.Errorf("MessageEvents = %v, want none", got.MessageEvents)
+	}
+}
+
 func TestLinks(t *testing.T) {
 	te := NewTestExporter()
 	tp := NewTracerProvider(WithSyncer(te))
@@ -654,6 +786,34 @@ func TestLinks(t *testing.T) {
 	}
 }
 
+func TestPendingLinks(t *testing.T) {
+	te := NewTestExporter()
+	tp := NewTracerProvider(WithSyncer(te))
+	tr := tp.Tracer("PendingLinks")
+
+	sc1 := trace.SpanContext{TraceID: trace.TraceID{1, 1}, SpanID: trace.SpanID{3}}
+	sc2 := trace.SpanContext{TraceID: trace.TraceID{2, 2}, SpanID: trace.SpanID{4}}
+	sc3 := trace.SpanContext{TraceID: trace.TraceID{3, 3}, SpanID: trace.SpanID{5}}
+	pending := []trace.Link{
+		{SpanContext: sc1, Attributes: []label.KeyValue{label.String("msg", "1")}},
+		{SpanContext: sc2, Attributes: []label.KeyValue{label.String("msg", "2")}},
+	}
+	explicit := trace.Link{SpanContext: sc3}
+
+	ctx := trace.ContextWithPendingLinks(context.Background(), pending[0])
+	ctx = trace.ContextWithPendingLinks(ctx, pending[1])
+	ctx, batch := tr.Start(ctx, "batch", trace.WithLinks(explicit))
+	assert.Empty(t, trace.PendingLinksFromContext(ctx))
+	_, child := tr.Start(ctx, "child")
+	child.End()
+	batch.End()
+
+	spans := te.Spans()
+	require.Len(t, spans, 2)
+	assert.Empty(t, spans[0].Links)
+	assert.Equal(t, append(pending, explicit), spans[1].Links)
+}
+
 func TestLinksOverLimit(t *testing.T) {
 	te := NewTestExporter()
 	cfg := Config{MaxLinksPerSpan: 2}
@@ -989,6 +1149,57 @@ func TestNilSpanEnd(t *testing.T) {
 	span.End()
 }
 
+func TestNonRecordingSpan(t *testing.T) {
+	te := NewTestExporter()
+	tp := NewTracerProvider(
+		WithConfig(Config{DefaultSampler: NeverSample()}),
+		WithSyncer(te),
+	)
+	tr := tp.Tracer("NonRecordingSpan")
+
+	ctx, s := tr.Start(context.Background(), "span", trace.WithAttributes(label.String("k", "v")))
+	require.IsType(t, &nonRecordingSpan{}, s)
+	assert.False(t, s.IsRecording())
+	assert.True(t, s.SpanContext().IsValid())
+	assert.False(t, s.SpanContext().IsSampled())
+	assert.Same(t, tr, s.Tracer())
+
+	_, child := tr.Start(ctx, "child")
+	assert.Equal(t, s.SpanContext().TraceID, child.SpanContext().TraceID)
+	assert.NotEqual(t, s.SpanContext().SpanID, child.SpanContext().SpanID)
+
+	child.End()
+	s.End()
+	assert.Empty(t, te.Spans())
+
+	ctx, s = tr.Start(context.Background(), "server", trace.WithSpanKind(trace.SpanKindServer))
+	require.IsType(t, &nonRecordingSpan{}, s)
+	assert.Equal(t, trace.SpanKindServer, trace.SpanKindFromContext(ctx))
+	s.End()
+
+	// Spans started with trace.WithRecord are recorded even when dropped
+	// by the sampler.
+	_, recorded := tr.Start(context.Background(), "recorded", trace.WithRecord())
+	assert.IsType(t, &span{}, recorded)
+	assert.True(t, recorded.IsRecording())
+	recorded.End()
+}
+
+func TestNonRecordingSpanAllocations(t *testing.T) {
+	tr := NewTracerProvider(WithConfig(Config{DefaultSampler: NeverSample()})).Tracer("NonRecordingSpan")
+	ctx := context.Background()
+	attrs := []label.KeyValue{label.String("k", "v"), label.Int("n", 1)}
+
+	allocs := testing.AllocsPerRun(100, func() {
+		_, s := tr.Start(ctx, "span")
+		s.SetAttributes(attrs...)
+		s.AddEvent("event")
+		s.End()
+	})
+	// The only allocations are the span and the context holding it.
+	assert.LessOrEqual(t, allocs, float64(2))
+}
+
 func TestExecutionTracerTaskEnd(t *testing.T) {
 	var n uint64
 	tp := NewTracerProvider(WithConfig(Config{DefaultSampler: NeverSample()}))
@@ -998,9 +1209,10 @@ func TestExecutionTracerTaskEnd(t *testing.T) {
 		atomic.AddUint64(&n, 1)
 	}
 
-	var spans []*span
+	// The spans dropped by the sampler are nonRecordingSpans.
+	var spans []*nonRecordingSpan
 	_, apiSpan := tr.Start(context.Background(), "foo")
-	s := apiSpan.(*span)
+	s := apiSpan.(*nonRecordingSpan)
 
 	s.executionTracerTaskEnd = executionTracerTaskEnd
 	spans = append(spans, s) // never sample
@@ -1020,20 +1232,26 @@ func TestExecutionTracerTaskEnd(t *testing.T) {
 		ctx,
 		"foo",
 	)
-	s = apiSpan.(*span)
+	s = apiSpan.(*nonRecordingSpan)
 	s.executionTracerTaskEnd = executionTracerTaskEnd
 	spans = append(spans, s) // parent not sampled
 
 	//tp.ApplyConfig(Config{DefaultSampler: AlwaysSample()})
 	_, apiSpan = tr.Start(context.Background(), "foo")
-	s = apiSpan.(*span)
+	s = apiSpan.(*nonRecordingSpan)
 	s.executionTracerTaskEnd = executionTracerTaskEnd
-	spans = append(spans, s) // always sample
+	spans = append(spans, s) // never sample
+
+	sampled := NewTracerProvider(WithConfig(Config{DefaultSampler: AlwaysSample()})).Tracer("Execution Tracer Task End")
+	_, apiSpan = sampled.Start(context.Background(), "foo")
+	recording := apiSpan.(*span)
+	recording.executionTracerTaskEnd = executionTracerTaskEnd // always sample
 
 	for _, span := range spans {
 		span.End()
 	}
-	if got, want := n, uint64(len(spans)); got != want {
+	recording.End()
+	if got, want := n, uint64(len(spans)+1); got != want {
 		t.Fatalf("Execution tracer task ended for %v spans; want %v", got, want)
 	}
 }
@@ -1063,6 +1281,85 @@ func TestCustomStartEndTime(t *testing.T) {
 	}
 }
 
+func TestEndTimeBeforeStartTime(t *testing.T) {
+	handler.Reset()
+	te := NewTestExporter()
+	tp := NewTracerProvider(WithSyncer(te), WithConfig(Config{DefaultSampler: AlwaysSample()}))
+
+	startTime := time.Date(2019, time.August, 27, 14, 42, 0, 0, time.UTC)
+	_, span := tp.Tracer("End before start").Start(
+		context.Background(),
+		"testspan",
+		trace.WithTimestamp(startTime),
+	)
+	span.End(trace.WithTimestamp(startTime.Add(-time.Second)))
+
+	require.Equal(t, 1, te.Len())
+	assert.Equal(t, startTime, te.Spans()[0].EndTime)
+	require.Len(t, handler.errs, 1)
+	assert.Equal(t, otel.SeverityWarning, otel.SeverityOf(handler.errs[0]))
+}
+
+func TestStartSpanWithSpanContext(t *testing.T) {
+	handler.Reset()
+	te := NewTestExporter()
+	tp := NewTracerProvider(WithSyncer(te), WithConfig(Config{DefaultSampler: NeverSample()}))
+	tr := tp.Tracer("Re-created spans")
+
+	ts, err := trace.TraceStateFromKeyValues(label.String("k", "v"))
+	require.NoError(t, err)
+	parent := trace.SpanContext{TraceID: tid, SpanID: sid, TraceFlags: trace.FlagsSampled}
+	recorded := trace.SpanContext{
+		TraceID:    tid,
+		SpanID:     trace.SpanID{1, 2, 3, 4, 5, 6, 7, 8},
+		TraceFlags: trace.FlagsSampled,
+		TraceState: ts,
+	}
+	startTime := time.Date(2019, time.August, 27, 14, 42, 0, 0, time.UTC)
+	ctx := trace.ContextWithRemoteSpanContext(context.Background(), parent)
+	_, span := tr.Start(ctx, "recreated",
+		trace.WithSpanContext(recorded),
+		trace.WithTimestamp(startTime),
+	)
+	span.End(trace.WithTimestamp(startTime.Add(time.Second)))
+
+	// The span keeps its sampling decision even though the sampler
+	// would drop it.
+	require.Equal(t, 1, te.Len())
+	got := te.Spans()[0]
+	assert.Equal(t, recorded, got.SpanContext)
+	assert.Equal(t, sid, got.ParentSpanID)
+	assert.True(t, got.HasRemoteParent)
+	assert.Equal(t, startTime, got.StartTime)
+	assert.Empty(t, handler.errs)
+}
+
+func TestStartSpanWithInvalidSpanContext(t *testing.T) {
+	otherTID := trace.TraceID{1}
+	tests := []struct {
+		name string
+		sc   trace.SpanContext
+	}{
+		{"invalid", trace.SpanContext{TraceID: tid}},
+		{"different trace", trace.SpanContext{TraceID: otherTID, SpanID: trace.SpanID{1}}},
+		{"parent span ID", trace.SpanContext{TraceID: tid, SpanID: sid}},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			handler.Reset()
+			tp := NewTracerProvider()
+			parent := trace.SpanContext{TraceID: tid, SpanID: sid, TraceFlags: trace.FlagsSampled}
+			ctx := trace.ContextWithRemoteSpanContext(context.Background(), parent)
+			_, span := tp.Tracer("Re-created spans").Start(ctx, "span", trace.WithSpanContext(test.sc))
+
+			// The option is ignored and a child of parent is created.
+			assert.NoError(t, checkChild(t, parent, span))
+			require.Len(t, handler.errs, 1)
+			assert.Equal(t, otel.SeverityWarning, otel.SeverityOf(handler.errs[0]))
+		})
+	}
+}
+
 func TestRecordError(t *testing.T) {
 	scenarios := []struct {
 		err error
@@ -1396,3 +1693,35 @@ func TestReadWriteSpan(t *testing.T) {
 	// available via ReadWriteSpan as doing so would mean creating a lot of
 	// duplication.
 }
+
+type fixedIDGenerator struct{}
+
+func (fixedIDGenerator) NewIDs(context.Context) (trace.TraceID, trace.SpanID) {
+	return tid, sid
+}
+
+func (fixedIDGenerator) NewSpanID(context.Context, trace.TraceID) trace.SpanID {
+	return sid
+}
+
+func TestRandomTraceFlag(t *testing.T) {
+	tr := NewTracerProvider().Tracer("RandomTraceFlag")
+	ctx, root := tr.Start(context.Background(), "root")
+	assert.True(t, root.SpanContext().IsRandom(), "root span of a random trace ID")
+	_, child := tr.Start(ctx, "child")
+	assert.True(t, child.SpanContext().IsRandom(), "child span inherits the random flag")
+
+	// The flag of a remote parent is kept as is.
+	remote := trace.ContextWithRemoteSpanContext(context.Background(), trace.SpanContext{
+		TraceID:    tid,
+		SpanID:     sid,
+		TraceFlags: trace.FlagsSampled,
+	})
+	_, span := tr.Start(remote, "remote child")
+	assert.False(t, span.SpanContext().IsRandom(), "child of a remote parent without the random flag")
+
+	// IDGenerators do not produce random trace IDs unless they say so.
+	tr = NewTracerProvider(WithIDGenerator(fixedIDGenerator{})).Tracer("RandomTraceFlag")
+	_, span = tr.Start(context.Background(), "root")
+	assert.False(t, span.SpanContext().IsRandom(), "root span of a custom IDGenerator")
+}
@@ -16,6 +16,7 @@ package trace // import "go.opentelemetry.io/otel/sdk/trace"
 
 import (
 	"context"
+	"sync"
 
 	"go.opentelemetry.io/otel/internal/trace/parent"
 	"go.opentelemetry.io/otel/trace"
@@ -30,24 +31,67 @@ type tracer struct {
 
 var _ trace.Tracer = &tracer{}
 
+// spanConfigPool holds the SpanConfigs of the Start calls. They are only
+// used during the call, the span keeps copies of the attributes and links.
+var spanConfigPool = sync.Pool{
+	New: func() interface{} {
+		return new(trace.SpanConfig)
+	},
+}
+
 // Start starts a Span and returns it along with a context containing it.
 //
 // The Span is created with the provided name and as a child of any existing
 // span context found in the passed context. The created Span will be
 // configured appropriately by any SpanOption passed. Any Timestamp option
-// passed will be used as the start time of the Span's life-cycle.
+// passed will be used as the start time of the Span's life-cycle. A valid
+// SpanContext passed with trace.WithSpanContext is used as the identity and
+// sampling decision of the Span instead of generating new ones. Links stored
+// in the passed context with trace.ContextWithPendingLinks are added to the
+// Span and removed from the returned context.
+//
+// The Spans dropped by the sampler, unless started with trace.WithRecord,
+// are non-recording Spans that only carry their SpanContext.
 func (tr *tracer) Start(ctx context.Context, name string, options ...trace.SpanOption) (context.Context, trace.Span) {
-	config := trace.NewSpanConfig(options...)
+	config := spanConfigPool.Get().(*trace.SpanConfig)
+	defer func() {
+		*config = trace.SpanConfig{}
+		spanConfigPool.Put(config)
+	}()
+	for _, option := range options {
+		option.ApplySpan(config)
+	}
 
 	parentSpanContext, remoteParent, links := parent.GetSpanContextAndLinks(ctx, config.NewRoot)
 
+	// Pending links are added to the span being started, and are made
+	// visible to the sampler, but are not inherited by its children.
+	if pending := trace.PendingLinksFromContext(ctx); len(pending) > 0 {
+		config.Links = append(pending[:len(pending):len(pending)], config.Links...)
+		ctx = trace.ContextWithPendingLinks(ctx)
+	}
+
 	if p := trace.SpanFromContext(ctx); p != nil {
 		if sdkSpan, ok := p.(*span); ok {
 			sdkSpan.addChild()
 		}
 	}
 
-	span := startSpanInternal(ctx, tr, name, parentSpanContext, remoteParent, config)
+	cfg := tr.provider.config.Load().(*Config)
+	sc, sampled := newSpanContext(ctx, cfg, name, parentSpanContext, remoteParent, links, config)
+	if !sc.IsSampled() && !config.Record {
+		// Nothing is recorded for the spans dropped by the sampler, they
+		// only carry their span context.
+		span := &nonRecordingSpan{
+			spanContext: sc,
+			spanKind:    trace.ValidateSpanKind(config.SpanKind),
+			tracer:      tr,
+		}
+		ctx, span.executionTracerTaskEnd = startExecutionTracerTask(ctx, name)
+		return trace.ContextWithSpan(ctx, span), span
+	}
+
+	span := startSpanInternal(tr, cfg, name, sc, parentSpanContext, remoteParent, sampled, config)
 	for _, l := range links {
 		span.addLink(l)
 	}
@@ -56,13 +100,9 @@ func (tr *tracer) Start(ctx context.Context, name string, options ...trace.SpanO
 	}
 	span.SetAttributes(config.Attributes...)
 
-	span.tracer = tr
-
-	if span.IsRecording() {
-		sps, _ := tr.provider.spanProcessors.Load().(spanProcessorStates)
-		for _, sp := range sps {
-			sp.sp.OnStart(ctx, span)
-		}
+	sps, _ := tr.provider.spanProcessors.Load().(spanProcessorStates)
+	for _, sp := range sps {
+		sp.sp.OnStart(ctx, span)
 	}
 
 	ctx, end := startExecutionTracerTask(ctx, name)
@@ -0,0 +1,34 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package zpages serves zPages, HTML pages describing the telemetry
+// pipelines of a process from within, for debugging.
+//
+// The handler of a metric Controller serves two pages:
+//
+//   - statsz lists the instruments of the Controller and the number of
+//     label sets each of them currently holds, their cardinality.
+//   - pipelinez shows the activity of the collections and exports of the
+//     Controller, including the most recent export errors.
+//
+// The pages are served from the last element of the request path, so the
+// handler can be mounted under any prefix:
+//
+//	http.Handle("/debug/", zpages.NewMetricsHandler(cont))
+//
+// This package is currently in a pre-GA phase. Backwards incompatible
+// changes may be introduced in subsequent minor version releases as we
+// work to track the evolving OpenTelemetry specification and user
+// feedback.
+package zpages // import "go.opentelemetry.io/otel/sdk/zpages"
@@ -0,0 +1,114 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package zpages // import "go.opentelemetry.io/otel/sdk/zpages"
+
+import (
+	"bytes"
+	"html/template"
+	"net/http"
+	"path"
+	"time"
+
+	"go.opentelemetry.io/otel"
+	controller "go.opentelemetry.io/otel/sdk/metric/controller/basic"
+)
+
+var templates = template.Must(template.New("").Funcs(template.FuncMap{
+	"time": formatTime,
+}).Parse(`
+{{define "header"}}<!DOCTYPE html>
+<html>
+<head><meta charset="utf-8"><title>{{.}}</title></head>
+<body>
+<h1>{{.}}</h1>
+{{end}}
+
+{{define "footer"}}</body>
+</html>
+{{end}}
+
+{{define "statsz"}}{{template "header" "Instruments"}}<table>
+<tr><th>Name</th><th>Kind</th><th>Number</th><th>Unit</th><th>Library</th><th>Label sets</th></tr>
+{{range .}}<tr><td>{{.Descriptor.Name}}</td><td>{{.Descriptor.InstrumentKind}}</td><td>{{.Descriptor.NumberKind}}</td><td>{{.Descriptor.Unit}}</td><td>{{.Descriptor.InstrumentationName}}</td><td>{{.LabelSets}}</td></tr>
+{{end}}</table>
+{{template "footer"}}{{end}}
+
+{{define "pipelinez"}}{{template "header" "Pipeline"}}<h2>Collections</h2>
+<table>
+<tr><th>Count</th><td>{{.Collections}}</td></tr>
+<tr><th>Last</th><td>{{time .LastCollection}}</td></tr>
+<tr><th>Duration</th><td>{{.LastCollectionDuration}}</td></tr>
+<tr><th>Records</th><td>{{.LastCollectionRecords}}</td></tr>
+</table>
+<h2>Exports</h2>
+<table>
+<tr><th>Count</th><td>{{.Exports}}</td></tr>
+<tr><th>Last</th><td>{{time .LastExport}}</td></tr>
+<tr><th>Duration</th><td>{{.LastExportDuration}}</td></tr>
+<tr><th>Last success</th><td>{{time .LastSuccessfulExport}}</td></tr>
+</table>
+<h2>Export errors</h2>
+<table>
+<tr><th>Time</th><th>Error</th></tr>
+{{range .ExportErrors}}<tr><td>{{time .Time}}</td><td>{{.Err}}</td></tr>
+{{end}}</table>
+{{template "footer"}}{{end}}
+`))
+
+// formatTime formats t for the pages, or returns "never" if it is zero.
+func formatTime(t time.Time) string {
+	if t.IsZero() {
+		return "never"
+	}
+	return t.UTC().Format(time.RFC3339Nano)
+}
+
+// MetricsHandler is an http.Handler serving the statsz and pipelinez
+// pages of a metric Controller.
+type MetricsHandler struct {
+	c *controller.Controller
+}
+
+var _ http.Handler = (*MetricsHandler)(nil)
+
+// NewMetricsHandler returns a new MetricsHandler of c.
+func NewMetricsHandler(c *controller.Controller) *MetricsHandler {
+	return &MetricsHandler{c: c}
+}
+
+// ServeHTTP serves the page named by the last element of the path of r,
+// statsz or pipelinez.  Other paths are not found.
+func (h *MetricsHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	var data interface{}
+	page := path.Base(r.URL.Path)
+	switch page {
+	case "statsz":
+		data = h.c.Instruments()
+	case "pipelinez":
+		data = h.c.Stats()
+	default:
+		http.NotFound(w, r)
+		return
+	}
+
+	var b bytes.Buffer
+	if err := templates.ExecuteTemplate(&b, page, data); err != nil {
+		otel.Handle(err)
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", "text/html; charset=utf-8")
+	_, _ = w.Write(b.Bytes())
+}
@@ -0,0 +1,79 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package zpages_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.opentelemetry.io/otel/label"
+	"go.opentelemetry.io/otel/metric"
+	export "go.opentelemetry.io/otel/sdk/export/metric"
+	controller "go.opentelemetry.io/otel/sdk/metric/controller/basic"
+	processor "go.opentelemetry.io/otel/sdk/metric/processor/basic"
+	"go.opentelemetry.io/otel/sdk/metric/selector/simple"
+	"go.opentelemetry.io/otel/sdk/zpages"
+)
+
+type failingExporter struct {
+	export.ExportKindSelector
+}
+
+func (failingExporter) Export(context.Context, export.CheckpointSet) error {
+	return errors.New("connection <refused>")
+}
+
+func get(t *testing.T, h http.Handler, path string) *httptest.ResponseRecorder {
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, path, nil))
+	return rec
+}
+
+func TestMetricsHandler(t *testing.T) {
+	cont := controller.New(
+		processor.New(simple.NewWithInexpensiveDistribution(), export.CumulativeExportKindSelector()),
+		controller.WithPusher(failingExporter{export.CumulativeExportKindSelector()}),
+	)
+	meter := metric.Must(cont.MeterProvider().Meter("test.library"))
+	counter := meter.NewInt64Counter("requests")
+	counter.Add(context.Background(), 1, label.String("path", "/a"))
+	counter.Add(context.Background(), 1, label.String("path", "/b"))
+
+	ctx := context.Background()
+	require.NoError(t, cont.Start(ctx))
+	require.Error(t, cont.Stop(ctx))
+
+	h := zpages.NewMetricsHandler(cont)
+
+	rec := get(t, h, "/debug/statsz")
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.Equal(t, "text/html; charset=utf-8", rec.Header().Get("Content-Type"))
+	assert.Contains(t, rec.Body.String(), "<tr><td>requests</td><td>CounterInstrumentKind</td><td>Int64Kind</td><td></td><td>test.library</td><td>2</td></tr>")
+
+	rec = get(t, h, "/debug/pipelinez")
+	assert.Equal(t, http.StatusOK, rec.Code)
+	body := rec.Body.String()
+	assert.Contains(t, body, "<tr><th>Count</th><td>1</td></tr>")
+	assert.Contains(t, body, "<tr><th>Last success</th><td>never</td></tr>")
+	assert.Contains(t, body, "<td>connection &lt;refused&gt;</td>")
+
+	assert.Equal(t, http.StatusNotFound, get(t, h, "/debug/tracez").Code)
+}
@@ -701,6 +701,24 @@ func TestSpanStatusFromHTTPStatusCode(t *testing.T) {
 	}
 }
 
+func TestSpanStatusFromHTTPServerStatusCode(t *testing.T) {
+	tests := []struct {
+		code     int
+		wantCode codes.Code
+		wantMsg  string
+	}{
+		{http.StatusOK, codes.Unset, "HTTP status code: 200"},
+		{http.StatusNotFound, codes.Unset, "HTTP status code: 404"},
+		{http.StatusInternalServerError, codes.Error, "HTTP status code: 500"},
+		{299, codes.Error, "Invalid HTTP status code 299"},
+	}
+	for _, test := range tests {
+		code, msg := SpanStatusFromHTTPServerStatusCode(test.code)
+		assert.Equal(t, test.wantCode, code, "code %d", test.code)
+		assert.Equal(t, test.wantMsg, msg, "code %d", test.code)
+	}
+}
+
 func getExpectedCodeForHTTPCode(code int) codes.Code {
 	if http.StatusText(code) == "" {
 		return codes.Error
@@ -937,6 +955,21 @@ func TestHTTPClientAttributesFromHTTPRequest(t *testing.T) {
 				label.Int64("http.request_content_length", 100),
 			},
 		},
+		{
+			name:   "with credentials in URL",
+			method: "GET",
+			url: &url.URL{
+				Scheme: "https",
+				User:   url.UserPassword("user", "secret"),
+				Host:   "example.com",
+				Path:   "/user/123",
+			},
+			expected: []label.KeyValue{
+				label.String("http.method", "GET"),
+				label.String("http.url", "https://example.com/user/123"),
+				label.String("http.scheme", "http"),
+			},
+		},
 		{
 			name:   "with empty method (fallback to GET)",
 			method: "",
@@ -960,3 +993,60 @@ func TestHTTPClientAttributesFromHTTPRequest(t *testing.T) {
 		})
 	}
 }
+
+func TestHTTPClientAttributes(t *testing.T) {
+	u := &url.URL{
+		Scheme: "https",
+		User:   url.User("token"),
+		Host:   "example.com:8443",
+		Path:   "/user/123",
+	}
+	r := testRequest("POST", "", "HTTP/1.1", "", "example.com:8443", u, http.Header{"User-Agent": []string{"foodownloader"}}, withTLS)
+	resp := &http.Response{StatusCode: http.StatusCreated, ContentLength: 42}
+
+	expected := []label.KeyValue{
+		label.String("http.method", "POST"),
+		label.String("http.url", "https://example.com:8443/user/123"),
+		label.String("http.scheme", "https"),
+		label.String("http.host", "example.com:8443"),
+		label.String("http.flavor", "1.1"),
+		label.String("http.user_agent", "foodownloader"),
+		label.String("net.peer.name", "example.com"),
+		label.Int("net.peer.port", 8443),
+		label.Int("http.status_code", 201),
+		label.Int64("http.response_content_length", 42),
+	}
+	assertElementsMatch(t, expected, HTTPClientAttributes(r, resp), "with response")
+
+	u = &url.URL{Scheme: "http", Host: "10.0.0.1", Path: "/"}
+	r = testRequest("GET", "", "HTTP/1.1", "", "", u, nil, noTLS)
+	expected = []label.KeyValue{
+		label.String("http.method", "GET"),
+		label.String("http.url", "http://10.0.0.1/"),
+		label.String("http.scheme", "http"),
+		label.String("http.flavor", "1.1"),
+		label.String("net.peer.ip", "10.0.0.1"),
+	}
+	assertElementsMatch(t, expected, HTTPClientAttributes(r, nil), "without response")
+}
+
+func TestHTTPServerAttributes(t *testing.T) {
+	u := &url.URL{Path: "/user/123"}
+	r := testRequest("GET", "/user/123", "HTTP/1.1", "1.2.3.4:5678", "example.com", u, nil, noTLS)
+
+	expected := []label.KeyValue{
+		label.String("http.method", "GET"),
+		label.String("http.target", "/user/123"),
+		label.String("http.server_name", "my-server"),
+		label.String("http.route", "/user/:id"),
+		label.String("http.scheme", "http"),
+		label.String("http.host", "example.com"),
+		label.String("http.flavor", "1.1"),
+		label.String("net.transport", "IP.TCP"),
+		label.String("net.peer.ip", "1.2.3.4"),
+		label.Int("net.peer.port", 5678),
+		label.String("net.host.name", "example.com"),
+		label.Int("http.status_code", 404),
+	}
+	assertElementsMatch(t, expected, HTTPServerAttributes("my-server", "/user/:id", r, http.StatusNotFound), "with status")
+}
@@ -0,0 +1,339 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package internal // import "go.opentelemetry.io/otel/semconv/internal"
+
+import (
+	"fmt"
+	"net"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/label"
+)
+
+// SemanticConventions are the semantic convention values defined for a
+// version of the OpenTelemetry specification.
+type SemanticConventions struct {
+	EnduserIDKey                 label.Key
+	HTTPClientIPKey              label.Key
+	HTTPFlavorKey                label.Key
+	HTTPHostKey                  label.Key
+	HTTPMethodKey                label.Key
+	HTTPRequestContentLengthKey  label.Key
+	HTTPResponseContentLengthKey label.Key
+	HTTPRouteKey                 label.Key
+	HTTPSchemeHTTP               label.KeyValue
+	HTTPSchemeHTTPS              label.KeyValue
+	HTTPServerNameKey            label.Key
+	HTTPStatusCodeKey            label.Key
+	HTTPTargetKey                label.Key
+	HTTPURLKey                   label.Key
+	HTTPUserAgentKey             label.Key
+	NetHostIPKey                 label.Key
+	NetHostNameKey               label.Key
+	NetHostPortKey               label.Key
+	NetPeerIPKey                 label.Key
+	NetPeerNameKey               label.Key
+	NetPeerPortKey               label.Key
+	NetTransportIP               label.KeyValue
+	NetTransportOther            label.KeyValue
+	NetTransportTCP              label.KeyValue
+	NetTransportUDP              label.KeyValue
+	NetTransportUnix             label.KeyValue
+	RPCGRPCStatusCodeKey         label.Key
+}
+
+// NetAttributesFromHTTPRequest generates attributes of the net
+// namespace as specified by the OpenTelemetry specification for a
+// span.  The network parameter is a string that net.Dial function
+// from standard library can understand.
+func (sc *SemanticConventions) NetAttributesFromHTTPRequest(network string, request *http.Request) []label.KeyValue {
+	attrs := []label.KeyValue{}
+
+	switch network {
+	case "tcp", "tcp4", "tcp6":
+		attrs = append(attrs, sc.NetTransportTCP)
+	case "udp", "udp4", "udp6":
+		attrs = append(attrs, sc.NetTransportUDP)
+	case "ip", "ip4", "ip6":
+		attrs = append(attrs, sc.NetTransportIP)
+	case "unix", "unixgram", "unixpacket":
+		attrs = append(attrs, sc.NetTransportUnix)
+	default:
+		attrs = append(attrs, sc.NetTransportOther)
+	}
+
+	peerName, peerIP, peerPort := "", "", 0
+	{
+		hostPart := request.RemoteAddr
+		portPart := ""
+		if idx := strings.LastIndex(hostPart, ":"); idx >= 0 {
+			hostPart = request.RemoteAddr[:idx]
+			portPart = request.RemoteAddr[idx+1:]
+		}
+		if hostPart != "" {
+			if ip := net.ParseIP(hostPart); ip != nil {
+				peerIP = ip.String()
+			} else {
+				peerName = hostPart
+			}
+
+			if portPart != "" {
+				numPort, err := strconv.ParseUint(portPart, 10, 16)
+				if err == nil {
+					peerPort = (int)(numPort)
+				} else {
+					peerName, peerIP = "", ""
+				}
+			}
+		}
+	}
+	if peerName != "" {
+		attrs = append(attrs, sc.NetPeerNameKey.String(peerName))
+	}
+	if peerIP != "" {
+		attrs = append(attrs, sc.NetPeerIPKey.String(peerIP))
+	}
+	if peerPort != 0 {
+		attrs = append(attrs, sc.NetPeerPortKey.Int(peerPort))
+	}
+
+	hostIP, hostName, hostPort := "", "", 0
+	for _, someHost := range []string{request.Host, request.Header.Get("Host"), request.URL.Host} {
+		hostPart := ""
+		if idx := strings.LastIndex(someHost, ":"); idx >= 0 {
+			strPort := someHost[idx+1:]
+			numPort, err := strconv.ParseUint(strPort, 10, 16)
+			if err == nil {
+				hostPort = (int)(numPort)
+			}
+			hostPart = someHost[:idx]
+		} else {
+			hostPart = someHost
+		}
+		if hostPart != "" {
+			ip := net.ParseIP(hostPart)
+			if ip != nil {
+				hostIP = ip.String()
+			} else {
+				hostName = hostPart
+			}
+			break
+		} else {
+			hostPort = 0
+		}
+	}
+	if hostIP != "" {
+		attrs = append(attrs, sc.NetHostIPKey.String(hostIP))
+	}
+	if hostName != "" {
+		attrs = append(attrs, sc.NetHostNameKey.String(hostName))
+	}
+	if hostPort != 0 {
+		attrs = append(attrs, sc.NetHostPortKey.Int(hostPort))
+	}
+
+	return attrs
+}
+
+// EndUserAttributesFromHTTPRequest generates attributes of the
+// enduser namespace as specified by the OpenTelemetry specification
+// for a span.
+func (sc *SemanticConventions) EndUserAttributesFromHTTPRequest(request *http.Request) []label.KeyValue {
+	if username, _, ok := request.BasicAuth(); ok {
+		return []label.KeyValue{sc.EnduserIDKey.String(username)}
+	}
+	return nil
+}
+
+// HTTPClientAttributesFromHTTPRequest generates attributes of the
+// http namespace as specified by the OpenTelemetry specification for
+// a span on the client side. Credentials in the request URL are not
+// included in the http.url attribute.
+func (sc *SemanticConventions) HTTPClientAttributesFromHTTPRequest(request *http.Request) []label.KeyValue {
+	attrs := []label.KeyValue{}
+
+	if request.Method != "" {
+		attrs = append(attrs, sc.HTTPMethodKey.String(request.Method))
+	} else {
+		attrs = append(attrs, sc.HTTPMethodKey.String(http.MethodGet))
+	}
+
+	attrs = append(attrs, sc.HTTPURLKey.String(redactedURL(request.URL)))
+
+	return append(attrs, sc.httpCommonAttributesFromHTTPRequest(request)...)
+}
+
+func (sc *SemanticConventions) httpCommonAttributesFromHTTPRequest(request *http.Request) []label.KeyValue {
+	attrs := []label.KeyValue{}
+	if ua := request.UserAgent(); ua != "" {
+		attrs = append(attrs, sc.HTTPUserAgentKey.String(ua))
+	}
+	if request.ContentLength > 0 {
+		attrs = append(attrs, sc.HTTPRequestContentLengthKey.Int64(request.ContentLength))
+	}
+
+	return append(attrs, sc.httpBasicAttributesFromHTTPRequest(request)...)
+}
+
+func (sc *SemanticConventions) httpBasicAttributesFromHTTPRequest(request *http.Request) []label.KeyValue {
+	// as these attributes are used by HTTPServerMetricAttributesFromHTTPRequest, they should be low-cardinality
+	attrs := []label.KeyValue{}
+
+	if request.TLS != nil {
+		attrs = append(attrs, sc.HTTPSchemeHTTPS)
+	} else {
+		attrs = append(attrs, sc.HTTPSchemeHTTP)
+	}
+
+	if request.Host != "" {
+		attrs = append(attrs, sc.HTTPHostKey.String(request.Host))
+	}
+
+	flavor := ""
+	if request.ProtoMajor == 1 {
+		flavor = fmt.Sprintf("1.%d", request.ProtoMinor)
+	} else if request.ProtoMajor == 2 {
+		flavor = "2"
+	}
+	if flavor != "" {
+		attrs = append(attrs, sc.HTTPFlavorKey.String(flavor))
+	}
+
+	return attrs
+}
+
+// HTTPServerMetricAttributesFromHTTPRequest generates low-cardinality attributes
+// to be used with server-side HTTP metrics.
+func (sc *SemanticConventions) HTTPServerMetricAttributesFromHTTPRequest(serverName string, request *http.Request) []label.KeyValue {
+	attrs := []label.KeyValue{}
+	if serverName != "" {
+		attrs = append(attrs, sc.HTTPServerNameKey.String(serverName))
+	}
+	return append(attrs, sc.httpBasicAttributesFromHTTPRequest(request)...)
+}
+
+// HTTPServerAttributesFromHTTPRequest generates attributes of the
+// http namespace as specified by the OpenTelemetry specification for
+// a span on the server side. Currently, only basic authentication is
+// supported.
+func (sc *SemanticConventions) HTTPServerAttributesFromHTTPRequest(serverName, route string, request *http.Request) []label.KeyValue {
+	attrs := []label.KeyValue{
+		sc.HTTPMethodKey.String(request.Method),
+		sc.HTTPTargetKey.String(request.RequestURI),
+	}
+
+	if serverName != "" {
+		attrs = append(attrs, sc.HTTPServerNameKey.String(serverName))
+	}
+	if route != "" {
+		attrs = append(attrs, sc.HTTPRouteKey.String(route))
+	}
+	if values, ok := request.Header["X-Forwarded-For"]; ok && len(values) > 0 {
+		attrs = append(attrs, sc.HTTPClientIPKey.String(values[0]))
+	}
+
+	return append(attrs, sc.httpCommonAttributesFromHTTPRequest(request)...)
+}
+
+// HTTPClientAttributes generates the attributes of the http and net
+// namespaces as specified by the OpenTelemetry specification for a span on
+// the client side. The response may be nil if the request failed, otherwise
+// its status code and content length are included. Credentials in the
+// request URL are not included.
+func (sc *SemanticConventions) HTTPClientAttributes(request *http.Request, response *http.Response) []label.KeyValue {
+	attrs := sc.HTTPClientAttributesFromHTTPRequest(request)
+	attrs = append(attrs, sc.netPeerAttributesFromURL(request.URL)...)
+	if response != nil {
+		attrs = append(attrs, sc.HTTPAttributesFromHTTPStatusCode(response.StatusCode)...)
+		if response.ContentLength > 0 {
+			attrs = append(attrs, sc.HTTPResponseContentLengthKey.Int64(response.ContentLength))
+		}
+	}
+	return attrs
+}
+
+// HTTPServerAttributes generates the attributes of the http and net
+// namespaces as specified by the OpenTelemetry specification for a span on
+// the server side. The statusCode is the status code the server responded
+// with, or 0 if it is not known yet.
+func (sc *SemanticConventions) HTTPServerAttributes(serverName, route string, request *http.Request, statusCode int) []label.KeyValue {
+	attrs := sc.HTTPServerAttributesFromHTTPRequest(serverName, route, request)
+	attrs = append(attrs, sc.NetAttributesFromHTTPRequest("tcp", request)...)
+	if statusCode != 0 {
+		attrs = append(attrs, sc.HTTPAttributesFromHTTPStatusCode(statusCode)...)
+	}
+	return attrs
+}
+
+// redactedURL returns u as a string without any user credentials.
+func redactedURL(u *url.URL) string {
+	if u.User == nil {
+		return u.String()
+	}
+	redacted := *u
+	redacted.User = nil
+	return redacted.String()
+}
+
+// netPeerAttributesFromURL generates the net.peer attributes of the
+// server a client sends a request to.
+func (sc *SemanticConventions) netPeerAttributesFromURL(u *url.URL) []label.KeyValue {
+	var attrs []label.KeyValue
+	if host := u.Hostname(); host != "" {
+		if ip := net.ParseIP(host); ip != nil {
+			attrs = append(attrs, sc.NetPeerIPKey.String(ip.String()))
+		} else {
+			attrs = append(attrs, sc.NetPeerNameKey.String(host))
+		}
+	}
+	if port, err := strconv.ParseUint(u.Port(), 10, 16); err == nil && port != 0 {
+		attrs = append(attrs, sc.NetPeerPortKey.Int(int(port)))
+	}
+	return attrs
+}
+
+// HTTPAttributesFromHTTPStatusCode generates attributes of the http
+// namespace as specified by the OpenTelemetry specification for a
+// span.
+func (sc *SemanticConventions) HTTPAttributesFromHTTPStatusCode(code int) []label.KeyValue {
+	attrs := []label.KeyValue{
+		sc.HTTPStatusCodeKey.Int(code),
+	}
+	return attrs
+}
+
+// SpanStatusFromHTTPStatusCode generates a status code and a message
+// as specified by the OpenTelemetry specification for a span.
+func SpanStatusFromHTTPStatusCode(code int) (codes.Code, string) {
+	return codes.FromHTTPStatus(code), httpStatusDescription(code)
+}
+
+// SpanStatusFromHTTPServerStatusCode generates a status code and a message
+// as specified by the OpenTelemetry specification for a server span. Unlike
+// for client spans, 4xx status codes leave the span status unset.
+func SpanStatusFromHTTPServerStatusCode(code int) (codes.Code, string) {
+	return codes.FromHTTPServerStatus(code), httpStatusDescription(code)
+}
+
+func httpStatusDescription(code int) string {
+	if http.StatusText(code) == "" {
+		return fmt.Sprintf("Invalid HTTP status code %d", code)
+	}
+	return fmt.Sprintf("HTTP status code: %d", code)
+}
@@ -0,0 +1,62 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package internal // import "go.opentelemetry.io/otel/semconv/internal"
+
+import (
+	"fmt"
+
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/label"
+)
+
+// grpcCodeNames are the names of the gRPC status codes, indexed by code.
+var grpcCodeNames = [...]string{
+	"OK",
+	"CANCELLED",
+	"UNKNOWN",
+	"INVALID_ARGUMENT",
+	"DEADLINE_EXCEEDED",
+	"NOT_FOUND",
+	"ALREADY_EXISTS",
+	"PERMISSION_DENIED",
+	"RESOURCE_EXHAUSTED",
+	"FAILED_PRECONDITION",
+	"ABORTED",
+	"OUT_OF_RANGE",
+	"UNIMPLEMENTED",
+	"INTERNAL",
+	"UNAVAILABLE",
+	"DATA_LOSS",
+	"UNAUTHENTICATED",
+}
+
+// SpanStatusFromGRPCCode generates a status code and a message as
+// specified by the OpenTelemetry specification for a span of a gRPC call
+// that completed with the gRPC status code code.
+func SpanStatusFromGRPCCode(code uint32) (codes.Code, string) {
+	if int(code) >= len(grpcCodeNames) {
+		return codes.FromGRPCCode(code), fmt.Sprintf("Invalid gRPC status code %d", code)
+	}
+	return codes.FromGRPCCode(code), fmt.Sprintf("gRPC status code: %s", grpcCodeNames[code])
+}
+
+// RPCAttributesFromGRPCCode generates attributes of the rpc namespace as
+// specified by the OpenTelemetry specification for a span of a gRPC call
+// that completed with the gRPC status code code.
+func (sc *SemanticConventions) RPCAttributesFromGRPCCode(code uint32) []label.KeyValue {
+	return []label.KeyValue{
+		sc.RPCGRPCStatusCodeKey.Int64(int64(code)),
+	}
+}
@@ -0,0 +1,23 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package migration translates attributes between versions of the
+// OpenTelemetry semantic conventions.
+//
+// Each version of the semantic conventions has a schema URL, e.g.
+// https://opentelemetry.io/schemas/1.13.0, that is reported by the
+// resources and instrumentation libraries using it. Processors and
+// exporters can use Translate to convert attributes reported with one
+// schema URL to the attribute keys of another.
+package migration // import "go.opentelemetry.io/otel/semconv/migration"
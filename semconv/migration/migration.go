@@ -0,0 +1,216 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package migration // import "go.opentelemetry.io/otel/semconv/migration"
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"go.opentelemetry.io/otel/label"
+)
+
+// ErrUnknownVersion is returned when a schema URL does not refer to a known
+// version of the semantic conventions.
+var ErrUnknownVersion = errors.New("unknown semantic conventions version")
+
+// Version is a version of the semantic conventions and the attributes
+// renamed in it.
+type Version struct {
+	// Version is the version number, e.g. "1.13.0".
+	Version string
+	// Renames maps the attribute keys of the previous version to the
+	// keys that replace them in this version.
+	Renames map[label.Key]label.Key
+}
+
+// versions are the known versions of the semantic conventions, oldest
+// first. Only versions that rename attributes are listed after the
+// first.
+var versions = []Version{
+	{Version: "1.0.0"},
+	{
+		Version: "1.8.0",
+		Renames: map[label.Key]label.Key{
+			"db.cassandra.keyspace": "db.name",
+			"db.hbase.namespace":    "db.name",
+		},
+	},
+	{
+		Version: "1.13.0",
+		Renames: map[label.Key]label.Key{
+			"net.host.ip": "net.sock.host.addr",
+			"net.peer.ip": "net.sock.peer.addr",
+		},
+	},
+}
+
+// Versions returns the known versions of the semantic conventions, oldest
+// first.
+func Versions() []Version {
+	out := make([]Version, len(versions))
+	for i, v := range versions {
+		out[i] = Version{Version: v.Version}
+		if v.Renames != nil {
+			out[i].Renames = make(map[label.Key]label.Key, len(v.Renames))
+			for from, to := range v.Renames {
+				out[i].Renames[from] = to
+			}
+		}
+	}
+	return out
+}
+
+// versionIndex returns the index in versions of the version that
+// schemaURL refers to. Both schema URLs and bare version numbers are
+// accepted.
+func versionIndex(schemaURL string) (int, error) {
+	v := schemaURL[strings.LastIndex(schemaURL, "/")+1:]
+	for i := len(versions) - 1; i >= 0; i-- {
+		if versions[i].Version == v {
+			return i, nil
+		}
+	}
+	// Versions without renames that are older than the latest known
+	// version translate as the version before them.
+	if compareVersions(v, versions[len(versions)-1].Version) < 0 {
+		for i := len(versions) - 1; i >= 0; i-- {
+			if compareVersions(versions[i].Version, v) < 0 {
+				return i, nil
+			}
+		}
+	}
+	return 0, fmt.Errorf("%w: %q", ErrUnknownVersion, schemaURL)
+}
+
+// compareVersions compares the dotted version numbers a and b. It returns
+// 0 if either is not a valid version.
+func compareVersions(a, b string) int {
+	pa, pb := strings.Split(a, "."), strings.Split(b, ".")
+	if len(pa) != 3 || len(pb) != 3 {
+		return 0
+	}
+	for i := range pa {
+		var na, nb int
+		if _, err := fmt.Sscanf(pa[i], "%d", &na); err != nil {
+			return 0
+		}
+		if _, err := fmt.Sscanf(pb[i], "%d", &nb); err != nil {
+			return 0
+		}
+		switch {
+		case na < nb:
+			return -1
+		case na > nb:
+			return 1
+		}
+	}
+	return 0
+}
+
+// Mapping returns the attribute keys that are renamed when translating
+// from the semantic conventions of the schema URL from to those of the
+// schema URL to. Translating to an older version only undoes renames of a
+// single key: if several keys were renamed to the same key, that key is
+// kept.
+func Mapping(from, to string) (map[label.Key]label.Key, error) {
+	i, err := versionIndex(from)
+	if err != nil {
+		return nil, err
+	}
+	j, err := versionIndex(to)
+	if err != nil {
+		return nil, err
+	}
+
+	m := make(map[label.Key]label.Key)
+	add := func(renames map[label.Key]label.Key) {
+		for old, renamed := range renames {
+			for k, v := range m {
+				if v == old {
+					m[k] = renamed
+				}
+			}
+			if _, ok := m[old]; !ok {
+				m[old] = renamed
+			}
+		}
+	}
+	if i <= j {
+		for k := i + 1; k <= j; k++ {
+			add(versions[k].Renames)
+		}
+	} else {
+		for k := i; k > j; k-- {
+			add(invert(versions[k].Renames))
+		}
+	}
+	for k, v := range m {
+		if k == v {
+			delete(m, k)
+		}
+	}
+	return m, nil
+}
+
+// invert returns the inverse of the one-to-one renames in renames.
+func invert(renames map[label.Key]label.Key) map[label.Key]label.Key {
+	count := make(map[label.Key]int, len(renames))
+	for _, to := range renames {
+		count[to]++
+	}
+	inv := make(map[label.Key]label.Key, len(renames))
+	for from, to := range renames {
+		if count[to] == 1 {
+			inv[to] = from
+		}
+	}
+	return inv
+}
+
+// Translate returns attrs, reported with the schema URL from, with their
+// keys translated to the semantic conventions of the schema URL to. If an
+// attribute is renamed to a key that attrs already contains, the existing
+// attribute is kept and the renamed one dropped. The attrs are returned
+// unchanged if there is nothing to translate.
+func Translate(from, to string, attrs []label.KeyValue) ([]label.KeyValue, error) {
+	if from == to {
+		return attrs, nil
+	}
+	m, err := Mapping(from, to)
+	if err != nil {
+		return nil, err
+	}
+	if len(m) == 0 {
+		return attrs, nil
+	}
+
+	present := make(map[label.Key]bool, len(attrs))
+	for _, kv := range attrs {
+		present[kv.Key] = true
+	}
+	out := make([]label.KeyValue, 0, len(attrs))
+	for _, kv := range attrs {
+		if renamed, ok := m[kv.Key]; ok {
+			if present[renamed] {
+				continue
+			}
+			present[renamed] = true
+			kv.Key = renamed
+		}
+		out = append(out, kv)
+	}
+	return out, nil
+}
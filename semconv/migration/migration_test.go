@@ -0,0 +1,115 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package migration
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.opentelemetry.io/otel/label"
+	semconv100 "go.opentelemetry.io/otel/semconv/v1.0.0"
+	semconv1130 "go.opentelemetry.io/otel/semconv/v1.13.0"
+)
+
+func TestMapping(t *testing.T) {
+	tests := []struct {
+		from, to string
+		want     map[label.Key]label.Key
+	}{
+		{
+			from: semconv100.SchemaURL,
+			to:   semconv1130.SchemaURL,
+			want: map[label.Key]label.Key{
+				"db.cassandra.keyspace": "db.name",
+				"db.hbase.namespace":    "db.name",
+				"net.host.ip":           "net.sock.host.addr",
+				"net.peer.ip":           "net.sock.peer.addr",
+			},
+		},
+		{
+			// Bare versions and versions without renames are accepted.
+			from: "1.9.0",
+			to:   "1.13.0",
+			want: map[label.Key]label.Key{
+				"net.host.ip": "net.sock.host.addr",
+				"net.peer.ip": "net.sock.peer.addr",
+			},
+		},
+		{
+			// Renames of several keys to one are not undone.
+			from: semconv1130.SchemaURL,
+			to:   semconv100.SchemaURL,
+			want: map[label.Key]label.Key{
+				"net.sock.host.addr": "net.host.ip",
+				"net.sock.peer.addr": "net.peer.ip",
+			},
+		},
+		{
+			from: "1.5.0",
+			to:   "1.0.0",
+			want: map[label.Key]label.Key{},
+		},
+	}
+	for _, test := range tests {
+		got, err := Mapping(test.from, test.to)
+		require.NoError(t, err)
+		assert.Equal(t, test.want, got, "%s -> %s", test.from, test.to)
+	}
+}
+
+func TestMappingUnknownVersion(t *testing.T) {
+	for _, v := range []string{"", "https://opentelemetry.io/schemas/99.0.0", "1.0"} {
+		_, err := Mapping(v, semconv1130.SchemaURL)
+		assert.True(t, errors.Is(err, ErrUnknownVersion), "version %q: %v", v, err)
+	}
+}
+
+func TestTranslate(t *testing.T) {
+	attrs := []label.KeyValue{
+		semconv100.NetPeerIPKey.String("10.0.0.1"),
+		semconv100.DBCassandraKeyspaceKey.String("ks"),
+		semconv100.DBNameKey.String("db"),
+		semconv100.HTTPMethodKey.String("GET"),
+	}
+	got, err := Translate(semconv100.SchemaURL, semconv1130.SchemaURL, attrs)
+	require.NoError(t, err)
+	assert.Equal(t, []label.KeyValue{
+		semconv1130.NetSockPeerAddrKey.String("10.0.0.1"),
+		// The existing db.name attribute is kept.
+		semconv1130.DBNameKey.String("db"),
+		semconv1130.HTTPMethodKey.String("GET"),
+	}, got)
+
+	back, err := Translate(semconv1130.SchemaURL, semconv100.SchemaURL, got)
+	require.NoError(t, err)
+	assert.Equal(t, []label.KeyValue{
+		semconv100.NetPeerIPKey.String("10.0.0.1"),
+		semconv100.DBNameKey.String("db"),
+		semconv100.HTTPMethodKey.String("GET"),
+	}, back)
+
+	same, err := Translate(semconv1130.SchemaURL, semconv1130.SchemaURL, attrs)
+	require.NoError(t, err)
+	assert.Equal(t, attrs, same)
+}
+
+func TestVersionsIsACopy(t *testing.T) {
+	vs := Versions()
+	vs[1].Renames["db.name"] = "changed"
+	assert.NotContains(t, Versions()[1].Renames, label.Key("db.name"))
+}
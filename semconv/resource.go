@@ -54,6 +54,14 @@ const (
 
 	// The version string of the telemetry SDK.
 	TelemetrySDKVersionKey = label.Key("telemetry.sdk.version")
+
+	// The name of the auto instrumentation agent or distribution, if
+	// used.
+	TelemetryDistroNameKey = label.Key("telemetry.distro.name")
+
+	// The version string of the auto instrumentation agent or
+	// distribution, if used.
+	TelemetryDistroVersionKey = label.Key("telemetry.distro.version")
 )
 
 // Semantic conventions for telemetry SDK resource attributes.
@@ -75,6 +83,9 @@ const (
 
 	// Container image tag.
 	ContainerImageTagKey = label.Key("container.image.tag")
+
+	// The container runtime managing this container.
+	ContainerRuntimeKey = label.Key("container.runtime")
 )
 
 // Semantic conventions for Function-as-a-Service resource attribute keys.
@@ -116,8 +127,22 @@ const (
 	// `proc/[pid]/cmdline`. On Windows, can be set to the result of
 	// `GetCommandLineW`.
 	ProcessCommandLineKey = label.Key("process.command_line")
+	// All the command arguments (including the command/executable itself)
+	// as received by the process. On Linux-based systems (and some other
+	// Unixoid systems supporting procfs), can be set according to the
+	// list of null-delimited strings extracted from `proc/[pid]/cmdline`.
+	ProcessCommandArgsKey = label.Key("process.command_args")
 	// The username of the user that owns the process.
 	ProcessOwnerKey = label.Key("process.owner")
+	// The name of the runtime of this process. For compiled native
+	// binaries, this SHOULD be the name of the compiler.
+	ProcessRuntimeNameKey = label.Key("process.runtime.name")
+	// The version of the runtime of this process, as returned by the
+	// runtime without modification.
+	ProcessRuntimeVersionKey = label.Key("process.runtime.version")
+	// An additional description about the runtime of the process, for
+	// example a specific vendor customization of the runtime environment.
+	ProcessRuntimeDescriptionKey = label.Key("process.runtime.description")
 )
 
 // Semantic conventions for Kubernetes resource attribute keys.
@@ -196,6 +221,45 @@ const (
 
 	// Version of the image the host is running.
 	HostImageVersionKey = label.Key("host.image.version")
+
+	// The CPU architecture the host system is running on.
+	HostArchKey = label.Key("host.arch")
+)
+
+// Semantic conventions for common host architectures.
+var (
+	HostArchAMD64 = HostArchKey.String("amd64")
+	HostArchARM32 = HostArchKey.String("arm32")
+	HostArchARM64 = HostArchKey.String("arm64")
+	HostArchIA64  = HostArchKey.String("ia64")
+	HostArchPPC32 = HostArchKey.String("ppc32")
+	HostArchPPC64 = HostArchKey.String("ppc64")
+	HostArchX86   = HostArchKey.String("x86")
+)
+
+// Semantic conventions for operating system resource attribute keys.
+const (
+	// The operating system type.
+	OSTypeKey = label.Key("os.type")
+
+	// Human readable (not intended to be parsed) OS version information,
+	// like e.g. reported by `ver` or `lsb_release -a` commands.
+	OSDescriptionKey = label.Key("os.description")
+)
+
+// Semantic conventions for common operating system types.
+var (
+	OSTypeWindows      = OSTypeKey.String("windows")
+	OSTypeLinux        = OSTypeKey.String("linux")
+	OSTypeDarwin       = OSTypeKey.String("darwin")
+	OSTypeFreeBSD      = OSTypeKey.String("freebsd")
+	OSTypeNetBSD       = OSTypeKey.String("netbsd")
+	OSTypeOpenBSD      = OSTypeKey.String("openbsd")
+	OSTypeDragonflyBSD = OSTypeKey.String("dragonflybsd")
+	OSTypeHPUX         = OSTypeKey.String("hpux")
+	OSTypeAIX          = OSTypeKey.String("aix")
+	OSTypeSolaris      = OSTypeKey.String("solaris")
+	OSTypeZOS          = OSTypeKey.String("z_os")
 )
 
 // Semantic conventions for cloud environment resource attribute keys.
@@ -211,6 +275,14 @@ const (
 
 	// Zone of the region where this resource is.
 	CloudZoneKey = label.Key("cloud.zone")
+
+	// Cloud regions often have multiple, isolated locations known as
+	// zones to increase availability. Availability zone represents the
+	// zone where the resource is running.
+	CloudAvailabilityZoneKey = label.Key("cloud.availability_zone")
+
+	// The cloud platform in use.
+	CloudPlatformKey = label.Key("cloud.platform")
 )
 
 // Semantic conventions for common cloud provider resource attributes.
@@ -220,6 +292,13 @@ var (
 	CloudProviderGCP   = CloudProviderKey.String("gcp")
 )
 
+// Semantic conventions for common cloud platform resource attributes.
+var (
+	CloudPlatformAWSEC2           = CloudPlatformKey.String("aws_ec2")
+	CloudPlatformAzureVM          = CloudPlatformKey.String("azure_vm")
+	CloudPlatformGCPComputeEngine = CloudPlatformKey.String("gcp_compute_engine")
+)
+
 // Semantic conventions for deployment attributes.
 const (
 	// Name of the deployment environment (aka deployment tier); e.g. (staging, production).
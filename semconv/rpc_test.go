@@ -0,0 +1,46 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package semconv
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/label"
+)
+
+func TestSpanStatusFromGRPCCode(t *testing.T) {
+	tests := []struct {
+		code     uint32
+		wantCode codes.Code
+		wantMsg  string
+	}{
+		{0, codes.Unset, "gRPC status code: OK"},
+		{5, codes.Error, "gRPC status code: NOT_FOUND"},
+		{16, codes.Error, "gRPC status code: UNAUTHENTICATED"},
+		{17, codes.Error, "Invalid gRPC status code 17"},
+	}
+	for _, test := range tests {
+		code, msg := SpanStatusFromGRPCCode(test.code)
+		assert.Equal(t, test.wantCode, code, "code %d", test.code)
+		assert.Equal(t, test.wantMsg, msg, "code %d", test.code)
+	}
+}
+
+func TestRPCAttributesFromGRPCCode(t *testing.T) {
+	assert.Equal(t, []label.KeyValue{label.Int64("rpc.grpc.status_code", 14)}, RPCAttributesFromGRPCCode(14))
+}
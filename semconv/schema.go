@@ -0,0 +1,21 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package semconv // import "go.opentelemetry.io/otel/semconv"
+
+// SchemaURL is the schema URL that matches the version of the semantic
+// conventions that this package defines. This package defines the semantic
+// conventions of version 1.0.0, the same as
+// go.opentelemetry.io/otel/semconv/v1.0.0.
+const SchemaURL = "https://opentelemetry.io/schemas/1.0.0"
@@ -230,6 +230,9 @@ const (
 	// The uncompressed size of the message transmitted or received in
 	// bytes.
 	RPCMessageUncompressedSizeKey = label.Key("message.uncompressed_size")
+
+	// The numeric status code of the gRPC request.
+	RPCGRPCStatusCodeKey = label.Key("rpc.grpc.status_code")
 )
 
 // Semantic conventions for common RPC attributes.
@@ -0,0 +1,129 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package semconv // import "go.opentelemetry.io/otel/semconv/v1.0.0"
+
+import (
+	"net/http"
+
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/label"
+	"go.opentelemetry.io/otel/semconv/internal"
+)
+
+// sc are the semantic conventions of this package used by the HTTP and
+// RPC helpers.
+var sc = &internal.SemanticConventions{
+	EnduserIDKey:                 EnduserIDKey,
+	HTTPClientIPKey:              HTTPClientIPKey,
+	HTTPFlavorKey:                HTTPFlavorKey,
+	HTTPHostKey:                  HTTPHostKey,
+	HTTPMethodKey:                HTTPMethodKey,
+	HTTPRequestContentLengthKey:  HTTPRequestContentLengthKey,
+	HTTPResponseContentLengthKey: HTTPResponseContentLengthKey,
+	HTTPRouteKey:                 HTTPRouteKey,
+	HTTPSchemeHTTP:               HTTPSchemeHTTP,
+	HTTPSchemeHTTPS:              HTTPSchemeHTTPS,
+	HTTPServerNameKey:            HTTPServerNameKey,
+	HTTPStatusCodeKey:            HTTPStatusCodeKey,
+	HTTPTargetKey:                HTTPTargetKey,
+	HTTPURLKey:                   HTTPURLKey,
+	HTTPUserAgentKey:             HTTPUserAgentKey,
+	NetHostIPKey:                 NetHostIPKey,
+	NetHostNameKey:               NetHostNameKey,
+	NetHostPortKey:               NetHostPortKey,
+	NetPeerIPKey:                 NetPeerIPKey,
+	NetPeerNameKey:               NetPeerNameKey,
+	NetPeerPortKey:               NetPeerPortKey,
+	NetTransportIP:               NetTransportIP,
+	NetTransportOther:            NetTransportOther,
+	NetTransportTCP:              NetTransportTCP,
+	NetTransportUDP:              NetTransportUDP,
+	NetTransportUnix:             NetTransportUnix,
+	RPCGRPCStatusCodeKey:         RPCGRPCStatusCodeKey,
+}
+
+// NetAttributesFromHTTPRequest generates attributes of the net
+// namespace as specified by the OpenTelemetry specification for a
+// span.  The network parameter is a string that net.Dial function
+// from standard library can understand.
+func NetAttributesFromHTTPRequest(network string, request *http.Request) []label.KeyValue {
+	return sc.NetAttributesFromHTTPRequest(network, request)
+}
+
+// EndUserAttributesFromHTTPRequest generates attributes of the
+// enduser namespace as specified by the OpenTelemetry specification
+// for a span.
+func EndUserAttributesFromHTTPRequest(request *http.Request) []label.KeyValue {
+	return sc.EndUserAttributesFromHTTPRequest(request)
+}
+
+// HTTPClientAttributesFromHTTPRequest generates attributes of the
+// http namespace as specified by the OpenTelemetry specification for
+// a span on the client side. Credentials in the request URL are not
+// included in the http.url attribute.
+func HTTPClientAttributesFromHTTPRequest(request *http.Request) []label.KeyValue {
+	return sc.HTTPClientAttributesFromHTTPRequest(request)
+}
+
+// HTTPServerMetricAttributesFromHTTPRequest generates low-cardinality attributes
+// to be used with server-side HTTP metrics.
+func HTTPServerMetricAttributesFromHTTPRequest(serverName string, request *http.Request) []label.KeyValue {
+	return sc.HTTPServerMetricAttributesFromHTTPRequest(serverName, request)
+}
+
+// HTTPServerAttributesFromHTTPRequest generates attributes of the
+// http namespace as specified by the OpenTelemetry specification for
+// a span on the server side. Currently, only basic authentication is
+// supported.
+func HTTPServerAttributesFromHTTPRequest(serverName, route string, request *http.Request) []label.KeyValue {
+	return sc.HTTPServerAttributesFromHTTPRequest(serverName, route, request)
+}
+
+// HTTPClientAttributes generates the attributes of the http and net
+// namespaces as specified by the OpenTelemetry specification for a span on
+// the client side. The response may be nil if the request failed, otherwise
+// its status code and content length are included. Credentials in the
+// request URL are not included.
+func HTTPClientAttributes(request *http.Request, response *http.Response) []label.KeyValue {
+	return sc.HTTPClientAttributes(request, response)
+}
+
+// HTTPServerAttributes generates the attributes of the http and net
+// namespaces as specified by the OpenTelemetry specification for a span on
+// the server side. The statusCode is the status code the server responded
+// with, or 0 if it is not known yet.
+func HTTPServerAttributes(serverName, route string, request *http.Request, statusCode int) []label.KeyValue {
+	return sc.HTTPServerAttributes(serverName, route, request, statusCode)
+}
+
+// HTTPAttributesFromHTTPStatusCode generates attributes of the http
+// namespace as specified by the OpenTelemetry specification for a
+// span.
+func HTTPAttributesFromHTTPStatusCode(code int) []label.KeyValue {
+	return sc.HTTPAttributesFromHTTPStatusCode(code)
+}
+
+// SpanStatusFromHTTPStatusCode generates a status code and a message
+// as specified by the OpenTelemetry specification for a span.
+func SpanStatusFromHTTPStatusCode(code int) (codes.Code, string) {
+	return internal.SpanStatusFromHTTPStatusCode(code)
+}
+
+// SpanStatusFromHTTPServerStatusCode generates a status code and a message
+// as specified by the OpenTelemetry specification for a server span. Unlike
+// for client spans, 4xx status codes leave the span status unset.
+func SpanStatusFromHTTPServerStatusCode(code int) (codes.Code, string) {
+	return internal.SpanStatusFromHTTPServerStatusCode(code)
+}
@@ -0,0 +1,46 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package semconv
+
+import (
+	"net/http"
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"go.opentelemetry.io/otel/label"
+)
+
+func TestNetAttributesFromHTTPRequest(t *testing.T) {
+	req := &http.Request{
+		Host:       "example.com:8080",
+		RemoteAddr: "1.2.3.4:5678",
+		Header:     http.Header{},
+		URL:        &url.URL{},
+	}
+	got := NetAttributesFromHTTPRequest("tcp", req)
+	assert.ElementsMatch(t, []label.KeyValue{
+		NetTransportTCP,
+		NetSockPeerAddrKey.String("1.2.3.4"),
+		NetPeerPortKey.Int(5678),
+		NetHostNameKey.String("example.com"),
+		NetHostPortKey.Int(8080),
+	}, got)
+}
+
+func TestSchemaURL(t *testing.T) {
+	assert.Equal(t, "https://opentelemetry.io/schemas/1.13.0", SchemaURL)
+}
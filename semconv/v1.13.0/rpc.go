@@ -0,0 +1,35 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package semconv // import "go.opentelemetry.io/otel/semconv/v1.13.0"
+
+import (
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/label"
+	"go.opentelemetry.io/otel/semconv/internal"
+)
+
+// SpanStatusFromGRPCCode generates a status code and a message as
+// specified by the OpenTelemetry specification for a span of a gRPC call
+// that completed with the gRPC status code code.
+func SpanStatusFromGRPCCode(code uint32) (codes.Code, string) {
+	return internal.SpanStatusFromGRPCCode(code)
+}
+
+// RPCAttributesFromGRPCCode generates attributes of the rpc namespace as
+// specified by the OpenTelemetry specification for a span of a gRPC call
+// that completed with the gRPC status code code.
+func RPCAttributesFromGRPCCode(code uint32) []label.KeyValue {
+	return sc.RPCAttributesFromGRPCCode(code)
+}
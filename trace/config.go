@@ -25,6 +25,8 @@ type TracerConfig struct {
 	// InstrumentationVersion is the version of the library providing
 	// instrumentation.
 	InstrumentationVersion string
+	// SchemaURL is the schema URL of the telemetry emitted by the Tracer.
+	SchemaURL string
 }
 
 // NewTracerConfig applies all the options to a returned TracerConfig.
@@ -57,6 +59,14 @@ type SpanConfig struct {
 	NewRoot bool
 	// SpanKind is the role a Span has in a trace.
 	SpanKind SpanKind
+	// AttributesFuncs produce additional attributes of an event. They
+	// are only called by implementations that record the event.
+	AttributesFuncs []func() []label.KeyValue
+	// SpanContext is the identity of a Span that was recorded elsewhere
+	// and is being re-created, e.g. by a service that ingests spans from
+	// a queue or replays them from logs. If valid, it is used instead of
+	// generating new identifiers.
+	SpanContext SpanContext
 }
 
 // NewSpanConfig applies all the options to a returned SpanConfig.
@@ -125,6 +135,23 @@ func WithAttributes(attributes ...label.KeyValue) LifeCycleOption {
 	return attributeSpanOption(attributes)
 }
 
+type attributesFuncEventOption func() []label.KeyValue
+
+func (o attributesFuncEventOption) ApplyEvent(c *SpanConfig) {
+	c.AttributesFuncs = append(c.AttributesFuncs, o)
+}
+
+// WithAttributesFunc adds the attributes returned by f to an event. f is
+// only called if the event is recorded, so attributes that are expensive
+// to build (e.g. summaries of a serialized payload) are not built for
+// spans that are not recording.
+//
+// The attributes extend those of other options instead of overwriting
+// them.
+func WithAttributesFunc(f func() []label.KeyValue) EventOption {
+	return attributesFuncEventOption(f)
+}
+
 type timestampSpanOption time.Time
 
 func (o timestampSpanOption) ApplySpan(c *SpanConfig)  { o.apply(c) }
@@ -178,6 +205,21 @@ func WithSpanKind(kind SpanKind) SpanOption {
 	return spanKindSpanOption(kind)
 }
 
+type spanContextSpanOption SpanContext
+
+func (o spanContextSpanOption) ApplySpan(c *SpanConfig) { c.SpanContext = SpanContext(o) }
+
+// WithSpanContext specifies the complete SpanContext of a Span being
+// re-created from data recorded elsewhere. The Span uses the trace ID, span
+// ID, trace flags, and trace state of sc instead of generating them or
+// making a new sampling decision. The parent span context, if any, is still
+// taken from the context passed to Start and must belong to the same trace.
+//
+// Combine this option with WithTimestamp to set the original start time.
+func WithSpanContext(sc SpanContext) SpanOption {
+	return spanContextSpanOption(sc)
+}
+
 // InstrumentationOption is an interface for applying instrumentation specific
 // options.
 type InstrumentationOption interface {
@@ -194,3 +236,14 @@ type instrumentationVersionOption string
 func (i instrumentationVersionOption) ApplyTracer(config *TracerConfig) {
 	config.InstrumentationVersion = string(i)
 }
+
+// WithSchemaURL sets the schema URL of the telemetry emitted by a Tracer.
+func WithSchemaURL(schemaURL string) TracerOption {
+	return schemaURLOption(schemaURL)
+}
+
+type schemaURLOption string
+
+func (s schemaURLOption) ApplyTracer(config *TracerConfig) {
+	config.SchemaURL = string(s)
+}
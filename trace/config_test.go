@@ -169,6 +169,14 @@ func TestNewSpanConfig(t *testing.T) {
 				SpanKind: SpanKindConsumer,
 			},
 		},
+		{
+			[]SpanOption{
+				WithSpanContext(link1.SpanContext),
+			},
+			&SpanConfig{
+				SpanContext: link1.SpanContext,
+			},
+		},
 		{
 			// Everything should work together.
 			[]SpanOption{
@@ -194,6 +202,20 @@ func TestNewSpanConfig(t *testing.T) {
 	}
 }
 
+func TestWithAttributesFunc(t *testing.T) {
+	called := 0
+	f := func() []label.KeyValue {
+		called++
+		return []label.KeyValue{label.String("key", "value")}
+	}
+
+	c := NewEventConfig(WithAttributes(label.Int("a", 1)), WithAttributesFunc(f), WithAttributesFunc(f))
+	assert.Len(t, c.AttributesFuncs, 2)
+	assert.Equal(t, []label.KeyValue{label.Int("a", 1)}, c.Attributes)
+	// Implementations call the functions only if they record the event.
+	assert.Equal(t, 0, called)
+}
+
 func TestTracerConfig(t *testing.T) {
 	v1 := "semver:0.0.1"
 	v2 := "semver:1.0.0"
@@ -224,6 +246,16 @@ func TestTracerConfig(t *testing.T) {
 				InstrumentationVersion: v2,
 			},
 		},
+		{
+			[]TracerOption{
+				WithInstrumentationVersion(v1),
+				WithSchemaURL("https://opentelemetry.io/schemas/1.13.0"),
+			},
+			&TracerConfig{
+				InstrumentationVersion: v1,
+				SchemaURL:              "https://opentelemetry.io/schemas/1.13.0",
+			},
+		},
 	}
 	for _, test := range tests {
 		config := NewTracerConfig(test.options...)
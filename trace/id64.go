@@ -0,0 +1,85 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package trace // import "go.opentelemetry.io/otel/trace"
+
+import "encoding/binary"
+
+// Some tracing systems, e.g. Datadog, Jaeger clients, B3 and OpenTracing
+// basictracer, use 64-bit trace IDs. The helpers below convert between those
+// and the 128-bit TraceID with one rule: a 64-bit trace ID is the low-order
+// (right-most) 8 bytes of a TraceID, and the high-order 8 bytes are zero
+// when a 64-bit trace ID is padded.
+
+// TraceIDFromUint64 returns the TraceID of the 64-bit trace ID id, padded
+// with zeros in the high-order bytes.
+func TraceIDFromUint64(id uint64) TraceID {
+	return TraceIDFromUint64s(0, id)
+}
+
+// TraceIDFromUint64s returns the TraceID with the high-order 8 bytes high and
+// the low-order 8 bytes low, e.g. as the two halves are sent by Jaeger.
+func TraceIDFromUint64s(high, low uint64) TraceID {
+	var t TraceID
+	binary.BigEndian.PutUint64(t[:8], high)
+	binary.BigEndian.PutUint64(t[8:], low)
+	return t
+}
+
+// TraceIDFromHex64 returns a TraceID from a hex string of either 32 or 16
+// characters. A 16 character (64-bit) trace ID is padded with zeros in the
+// high-order bytes. The same rules as TraceIDFromHex apply otherwise.
+func TraceIDFromHex64(h string) (TraceID, error) {
+	switch len(h) {
+	case 16:
+		return TraceIDFromHex("0000000000000000" + h)
+	case 32:
+		return TraceIDFromHex(h)
+	}
+	return TraceID{}, errInvalidTraceID64Length
+}
+
+// High64 returns the high-order 8 bytes of t.
+func (t TraceID) High64() uint64 {
+	return binary.BigEndian.Uint64(t[:8])
+}
+
+// Low64 returns the low-order 8 bytes of t. This is the 64-bit trace ID that
+// t is truncated to for systems that only support 64-bit trace IDs.
+func (t TraceID) Low64() uint64 {
+	return binary.BigEndian.Uint64(t[8:])
+}
+
+// Is64Bit returns if t has no high-order bytes set, i.e. it can be truncated
+// to 64 bits without losing information.
+func (t TraceID) Is64Bit() bool {
+	return t.High64() == 0
+}
+
+// Hex64 returns the hex string of the low-order 8 bytes of t.
+func (t TraceID) Hex64() string {
+	return t.String()[16:]
+}
+
+// SpanIDFromUint64 returns the SpanID of id.
+func SpanIDFromUint64(id uint64) SpanID {
+	var s SpanID
+	binary.BigEndian.PutUint64(s[:], id)
+	return s
+}
+
+// Uint64 returns s as an unsigned integer.
+func (s SpanID) Uint64() uint64 {
+	return binary.BigEndian.Uint64(s[:])
+}
@@ -0,0 +1,71 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package trace
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTraceID64(t *testing.T) {
+	id := TraceIDFromUint64(0x0102030405060708)
+	assert.Equal(t, "00000000000000000102030405060708", id.String())
+	assert.True(t, id.Is64Bit())
+	assert.Equal(t, uint64(0), id.High64())
+	assert.Equal(t, uint64(0x0102030405060708), id.Low64())
+	assert.Equal(t, "0102030405060708", id.Hex64())
+
+	id = TraceIDFromUint64s(0x1112131415161718, 0x0102030405060708)
+	assert.Equal(t, "11121314151617180102030405060708", id.String())
+	assert.False(t, id.Is64Bit())
+	assert.Equal(t, uint64(0x1112131415161718), id.High64())
+	assert.Equal(t, uint64(0x0102030405060708), id.Low64())
+	// Truncation keeps the low-order bytes, padding restores them.
+	assert.Equal(t, "0102030405060708", id.Hex64())
+	assert.Equal(t, TraceIDFromUint64(0x0102030405060708), TraceIDFromUint64(id.Low64()))
+}
+
+func TestTraceIDFromHex64(t *testing.T) {
+	tests := []struct {
+		hex     string
+		want    string
+		wantErr error
+	}{
+		{hex: "0102030405060708", want: "00000000000000000102030405060708"},
+		{hex: "11121314151617180102030405060708", want: "11121314151617180102030405060708"},
+		{hex: "0000000000000000", wantErr: errNilTraceID},
+		{hex: "010203040506070", wantErr: errInvalidTraceID64Length},
+		{hex: "010203040506070X", wantErr: errInvalidHexID},
+	}
+	for _, test := range tests {
+		t.Run(test.hex, func(t *testing.T) {
+			got, err := TraceIDFromHex64(test.hex)
+			if test.wantErr != nil {
+				assert.Equal(t, test.wantErr, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, test.want, got.String())
+		})
+	}
+}
+
+func TestSpanIDUint64(t *testing.T) {
+	s := SpanIDFromUint64(0x0102030405060708)
+	assert.Equal(t, "0102030405060708", s.String())
+	assert.Equal(t, uint64(0x0102030405060708), s.Uint64())
+}
@@ -0,0 +1,108 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package noop provides an implementation of the tracing API that
+// performs no operations. It is intended to be used as a test double or
+// as a stand-in when telemetry is disabled.
+//
+// Starting a span with a Tracer from this package does not allocate
+// unless the span needs to carry the span context of its parent.
+package noop // import "go.opentelemetry.io/otel/trace/noop"
+
+import (
+	"context"
+
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/label"
+	"go.opentelemetry.io/otel/trace"
+)
+
+var (
+	_ trace.TracerProvider = TracerProvider{}
+	_ trace.Tracer         = Tracer{}
+	_ trace.Span           = Span{}
+)
+
+// TracerProvider is a TracerProvider that creates Tracers that perform
+// no operations.
+type TracerProvider struct{}
+
+// NewTracerProvider returns a TracerProvider that performs no operations.
+func NewTracerProvider() TracerProvider {
+	return TracerProvider{}
+}
+
+// Tracer returns a Tracer that performs no operations.
+func (TracerProvider) Tracer(string, ...trace.TracerOption) trace.Tracer {
+	return Tracer{}
+}
+
+// Tracer is a Tracer that creates Spans that perform no operations.
+type Tracer struct{}
+
+// Start returns a Span that performs no operations. The Span carries the
+// span context of the current or remote span in ctx, if any, so that it
+// is still propagated. The returned context is ctx itself when it
+// already holds an equivalent Span.
+func (Tracer) Start(ctx context.Context, _ string, _ ...trace.SpanOption) (context.Context, trace.Span) {
+	parent := trace.SpanFromContext(ctx)
+	if _, ok := parent.(Span); ok {
+		return ctx, parent
+	}
+
+	sc := parent.SpanContext()
+	if !sc.IsValid() {
+		sc = trace.RemoteSpanContextFromContext(ctx)
+	}
+	if !sc.IsValid() && !parent.IsRecording() {
+		// There is nothing to propagate and no span to hide.
+		return ctx, Span{}
+	}
+
+	span := Span{sc: sc}
+	return trace.ContextWithSpan(ctx, span), span
+}
+
+// Span is a Span that performs no operations.
+type Span struct {
+	sc trace.SpanContext
+}
+
+// SpanContext returns the span context of the parent the Span was
+// started with, or an empty span context.
+func (s Span) SpanContext() trace.SpanContext { return s.sc }
+
+// IsRecording always returns false.
+func (Span) IsRecording() bool { return false }
+
+// SetStatus does nothing.
+func (Span) SetStatus(codes.Code, string) {}
+
+// SetAttributes does nothing.
+func (Span) SetAttributes(...label.KeyValue) {}
+
+// End does nothing.
+func (Span) End(...trace.SpanOption) {}
+
+// RecordError does nothing.
+func (Span) RecordError(error, ...trace.EventOption) {}
+
+// Tracer returns a Tracer that performs no operations.
+func (Span) Tracer() trace.Tracer { return Tracer{} }
+
+// AddEvent does nothing.
+func (Span) AddEvent(string, ...trace.EventOption) {}
+
+// SetName does nothing.
+func (Span) SetName(string) {}
@@ -0,0 +1,86 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package noop
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"go.opentelemetry.io/otel/trace"
+)
+
+var (
+	traceID = trace.TraceID{0x01}
+	spanID  = trace.SpanID{0x02}
+)
+
+func TestStartWithoutParent(t *testing.T) {
+	ctx := context.Background()
+	got, span := NewTracerProvider().Tracer("test").Start(ctx, "span")
+
+	assert.Equal(t, ctx, got, "context should not be changed")
+	assert.Equal(t, Span{}, span)
+	assert.False(t, span.IsRecording())
+	assert.Equal(t, Tracer{}, span.Tracer())
+}
+
+func TestStartPropagatesParent(t *testing.T) {
+	sc := trace.SpanContext{TraceID: traceID, SpanID: spanID}
+	tracer := NewTracerProvider().Tracer("test")
+
+	ctx, span := tracer.Start(trace.ContextWithRemoteSpanContext(context.Background(), sc), "span")
+	assert.Equal(t, sc, span.SpanContext())
+	assert.Equal(t, span, trace.SpanFromContext(ctx))
+
+	child, childSpan := tracer.Start(ctx, "child")
+	assert.Equal(t, ctx, child, "context should not be changed")
+	assert.Equal(t, sc, childSpan.SpanContext())
+}
+
+func TestStartHidesRecordingParent(t *testing.T) {
+	ctx := trace.ContextWithSpan(context.Background(), recordingSpan{})
+	ctx, span := Tracer{}.Start(ctx, "span")
+	assert.Equal(t, Span{}, span)
+	assert.Equal(t, span, trace.SpanFromContext(ctx))
+}
+
+func TestStartAllocations(t *testing.T) {
+	tracer := NewTracerProvider().Tracer("test")
+	ctx := context.Background()
+	parent, _ := tracer.Start(
+		trace.ContextWithRemoteSpanContext(ctx, trace.SpanContext{TraceID: traceID, SpanID: spanID}),
+		"parent",
+	)
+
+	for name, ctx := range map[string]context.Context{
+		"no parent":   ctx,
+		"noop parent": parent,
+	} {
+		allocs := testing.AllocsPerRun(100, func() {
+			_, span := tracer.Start(ctx, "span")
+			span.SetAttributes()
+			span.End()
+		})
+		assert.Zero(t, allocs, name)
+	}
+}
+
+type recordingSpan struct {
+	Span
+}
+
+func (recordingSpan) IsRecording() bool { return true }
@@ -37,11 +37,17 @@ const (
 	FlagsDeferred = byte(0x02)
 	// FlagsDebug is a bitmask with the debug bit set.
 	FlagsDebug = byte(0x04)
+	// FlagsRandom is a bitmask with the random bit set. A SpanContext
+	// with the random bit set has a trace ID whose rightmost 7 bytes are
+	// random, as needed for consistent probability sampling. It is
+	// propagated as the 0x02 trace-flag of W3C Trace Context level 2.
+	FlagsRandom = byte(0x08)
 
 	errInvalidHexID errorConst = "trace-id and span-id can only contain [0-9a-f] characters, all lowercase"
 
-	errInvalidTraceIDLength errorConst = "hex encoded trace-id must have length equals to 32"
-	errNilTraceID           errorConst = "trace-id can't be all zero"
+	errInvalidTraceIDLength   errorConst = "hex encoded trace-id must have length equals to 32"
+	errInvalidTraceID64Length errorConst = "hex encoded trace-id must have length equals to 16 or 32"
+	errNilTraceID             errorConst = "trace-id can't be all zero"
 
 	errInvalidSpanIDLength errorConst = "hex encoded span-id must have length equals to 16"
 	errNilSpanID           errorConst = "span-id can't be all zero"
@@ -236,6 +242,9 @@ func (ts TraceState) Get(key label.Key) label.Value {
 // Insert adds a new key/value, if one doesn't exists; otherwise updates the existing entry.
 // The new or updated entry is always inserted at the beginning of the TraceState, i.e.
 // on the left side, as per the W3C Trace Context specification requirement.
+//
+// If adding a new entry would exceed the maximum of 32 entries, the
+// right-most entry is removed, as per the W3C Trace Context specification.
 func (ts TraceState) Insert(entry label.KeyValue) (TraceState, error) {
 	if !isTraceStateKeyValueValid(entry) {
 		return ts, errInvalidTraceStateKeyValue
@@ -243,7 +252,7 @@ func (ts TraceState) Insert(entry label.KeyValue) (TraceState, error) {
 
 	ckvs := ts.copyKVsAndDeleteEntry(entry.Key)
 	if len(ckvs)+1 > traceStateMaxListMembers {
-		return ts, errInvalidTraceStateMembersNumber
+		ckvs = ckvs[:traceStateMaxListMembers-1]
 	}
 
 	ckvs = append(ckvs, label.KeyValue{})
@@ -267,6 +276,11 @@ func (ts TraceState) IsEmpty() bool {
 	return len(ts.kvs) == 0
 }
 
+// Len returns the number of entries in the TraceState.
+func (ts TraceState) Len() int {
+	return len(ts.kvs)
+}
+
 func (ts TraceState) copyKVsAndDeleteEntry(key label.Key) []label.KeyValue {
 	ckvs := make([]label.KeyValue, len(ts.kvs))
 	copy(ckvs, ts.kvs)
@@ -308,6 +322,27 @@ func TraceStateFromKeyValues(kvs ...label.KeyValue) (TraceState, error) { //noli
 	return TraceState{ckvs}, nil
 }
 
+// ParseTraceState decodes a TraceState from its W3C Trace Context
+// tracestate header encoding, a comma-separated list of key=value entries.
+// Optional whitespace around entries and empty entries are ignored. An
+// error is returned if any entry is invalid or duplicated, or if there are
+// more than 32 entries.
+func ParseTraceState(s string) (TraceState, error) {
+	var kvs []label.KeyValue
+	for _, entry := range strings.Split(s, ",") {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+		parts := strings.SplitN(entry, "=", 2)
+		if len(parts) != 2 {
+			return TraceState{}, errInvalidTraceStateKeyValue
+		}
+		kvs = append(kvs, label.String(parts[0], parts[1]))
+	}
+	return TraceStateFromKeyValues(kvs...)
+}
+
 func isTraceStateKeyValid(key label.Key) bool {
 	return keyFormatRegExp.MatchString(string(key))
 }
@@ -351,6 +386,11 @@ func (sc SpanContext) IsDebug() bool {
 	return sc.TraceFlags&FlagsDebug == FlagsDebug
 }
 
+// IsRandom returns if the random bit is set in the trace flags.
+func (sc SpanContext) IsRandom() bool {
+	return sc.TraceFlags&FlagsRandom == FlagsRandom
+}
+
 // IsSampled returns if the sampling bit is set in the trace flags.
 func (sc SpanContext) IsSampled() bool {
 	return sc.TraceFlags&FlagsSampled == FlagsSampled
@@ -361,6 +401,7 @@ type traceContextKeyType int
 const (
 	currentSpanKey traceContextKeyType = iota
 	remoteContextKey
+	pendingLinksKey
 )
 
 // ContextWithSpan returns a copy of parent with span set to current.
@@ -384,6 +425,32 @@ func SpanContextFromContext(ctx context.Context) SpanContext {
 	return SpanContext{}
 }
 
+// IsRecording returns whether the current span in ctx is recording. It
+// returns false if ctx has no current span. Instrumentation can use it to
+// avoid building attributes that would be dropped.
+func IsRecording(ctx context.Context) bool {
+	if span, ok := ctx.Value(currentSpanKey).(Span); ok {
+		return span.IsRecording()
+	}
+	return false
+}
+
+// spanKinder is implemented by spans that report their kind, like the
+// spans of the SDK.
+type spanKinder interface {
+	SpanKind() SpanKind
+}
+
+// SpanKindFromContext returns the kind of the current span in ctx. It
+// returns SpanKindUnspecified if ctx has no current span or if the span
+// does not report its kind with a SpanKind() SpanKind method.
+func SpanKindFromContext(ctx context.Context) SpanKind {
+	if span, ok := ctx.Value(currentSpanKey).(spanKinder); ok {
+		return span.SpanKind()
+	}
+	return SpanKindUnspecified
+}
+
 // ContextWithRemoteSpanContext returns a copy of parent with a remote set as
 // the remote span context.
 func ContextWithRemoteSpanContext(parent context.Context, remote SpanContext) context.Context {
@@ -398,6 +465,38 @@ func RemoteSpanContextFromContext(ctx context.Context) SpanContext {
 	return SpanContext{}
 }
 
+// ContextWithPendingLinks returns a copy of parent with links appended to
+// any pending links already stored in it. Pending links are meant to be
+// attached to the next span started from the returned context, for example
+// to relate a span processing a batch of messages to the span contexts
+// extracted from each message. SDKs that honor pending links remove them
+// from the context they return from Start so they are only added once.
+//
+// Calling ContextWithPendingLinks without any links clears the pending
+// links of parent.
+func ContextWithPendingLinks(parent context.Context, links ...Link) context.Context {
+	if len(links) == 0 {
+		if len(PendingLinksFromContext(parent)) == 0 {
+			return parent
+		}
+		return context.WithValue(parent, pendingLinksKey, []Link(nil))
+	}
+	existing := PendingLinksFromContext(parent)
+	pending := make([]Link, 0, len(existing)+len(links))
+	pending = append(pending, existing...)
+	pending = append(pending, links...)
+	return context.WithValue(parent, pendingLinksKey, pending)
+}
+
+// PendingLinksFromContext returns the pending links stored in ctx, or nil if
+// there are none. The returned slice must not be modified.
+func PendingLinksFromContext(ctx context.Context) []Link {
+	if links, ok := ctx.Value(pendingLinksKey).([]Link); ok {
+		return links
+	}
+	return nil
+}
+
 // Span is the individual component of a trace. It represents a single named
 // and timed operation of a workflow that is traced. A Tracer is used to
 // create a Span and it is then up to the operation the Span represents to
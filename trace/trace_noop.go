@@ -42,16 +42,20 @@ type noopTracer struct{}
 
 var _ Tracer = noopTracer{}
 
-// Start starts a noop span.
+// Start starts a noop span. The context is returned unchanged if its
+// current span is already a noop span.
 func (t noopTracer) Start(ctx context.Context, name string, _ ...SpanOption) (context.Context, Span) {
 	span := noopSpan{}
+	if _, ok := SpanFromContext(ctx).(noopSpan); ok {
+		return ctx, span
+	}
 	return ContextWithSpan(ctx, span), span
 }
 
 // noopSpan is an implementation of Span that preforms no operations.
 type noopSpan struct{}
 
-var _ noopSpan = noopSpan{}
+var _ Span = noopSpan{}
 
 // SpanContext returns an empty span context.
 func (noopSpan) SpanContext() SpanContext { return SpanContext{} }
@@ -74,3 +74,15 @@ func TestNoopSpan(t *testing.T) {
 		t.Errorf("span.Tracer() returned %#v, want %#v", got, want)
 	}
 }
+
+func TestNoopTracerStartAllocations(t *testing.T) {
+	ctx := context.Background()
+	tracer := NewNoopTracerProvider().Tracer("test instrumentation")
+	allocs := testing.AllocsPerRun(100, func() {
+		_, span := tracer.Start(ctx, "span name")
+		span.End()
+	})
+	if allocs != 0 {
+		t.Errorf("noopTracer.Start() allocated %v times, want 0", allocs)
+	}
+}
@@ -70,6 +70,30 @@ func TestContextSpan(t *testing.T) {
 	}
 }
 
+type recordingSpan struct {
+	noopSpan
+
+	kind SpanKind
+}
+
+func (recordingSpan) IsRecording() bool    { return true }
+func (s recordingSpan) SpanKind() SpanKind { return s.kind }
+
+func TestContextIsRecording(t *testing.T) {
+	assert.False(t, IsRecording(context.Background()))
+	assert.False(t, IsRecording(ContextWithSpan(context.Background(), noopSpan{})))
+	assert.True(t, IsRecording(ContextWithSpan(context.Background(), recordingSpan{})))
+}
+
+func TestContextSpanKind(t *testing.T) {
+	assert.Equal(t, SpanKindUnspecified, SpanKindFromContext(context.Background()))
+	// Spans that do not report their kind.
+	assert.Equal(t, SpanKindUnspecified, SpanKindFromContext(ContextWithSpan(context.Background(), testSpan{})))
+
+	ctx := ContextWithSpan(context.Background(), recordingSpan{kind: SpanKindServer})
+	assert.Equal(t, SpanKindServer, SpanKindFromContext(ctx))
+}
+
 func TestContextRemoteSpanContext(t *testing.T) {
 	ctx := context.Background()
 	got, empty := RemoteSpanContextFromContext(ctx), SpanContext{}
@@ -103,6 +127,30 @@ func TestContextRemoteSpanContext(t *testing.T) {
 	}
 }
 
+func TestContextPendingLinks(t *testing.T) {
+	ctx := context.Background()
+	assert.Nil(t, PendingLinksFromContext(ctx))
+	assert.Equal(t, ctx, ContextWithPendingLinks(ctx))
+
+	l1 := Link{SpanContext: SpanContext{TraceID: [16]byte{1}, SpanID: [8]byte{1}}}
+	l2 := Link{
+		SpanContext: SpanContext{TraceID: [16]byte{2}, SpanID: [8]byte{2}},
+		Attributes:  []label.KeyValue{label.String("k", "v")},
+	}
+
+	first := ContextWithPendingLinks(ctx, l1)
+	assert.Equal(t, []Link{l1}, PendingLinksFromContext(first))
+
+	second := ContextWithPendingLinks(first, l2)
+	assert.Equal(t, []Link{l1, l2}, PendingLinksFromContext(second))
+	// Appending must not modify the links stored in the parent context.
+	assert.Equal(t, []Link{l1}, PendingLinksFromContext(first))
+
+	cleared := ContextWithPendingLinks(second)
+	assert.Nil(t, PendingLinksFromContext(cleared))
+	assert.Equal(t, []Link{l1, l2}, PendingLinksFromContext(second))
+}
+
 func TestIsValid(t *testing.T) {
 	for _, testcase := range []struct {
 		name string
@@ -649,11 +697,22 @@ func TestTraceStateInsert(t *testing.T) {
 			expectedErr: errInvalidTraceStateKeyValue,
 		},
 		{
-			name:               "Too many entries",
-			traceState:         TraceState{kvsWithMaxMembers},
-			keyValue:           label.String("keyx", "valx"),
-			expectedTraceState: TraceState{kvsWithMaxMembers},
-			expectedErr:        errInvalidTraceStateMembersNumber,
+			name:       "Too many entries evicts right-most",
+			traceState: TraceState{kvsWithMaxMembers},
+			keyValue:   label.String("keyx", "valx"),
+			expectedTraceState: TraceState{append(
+				[]label.KeyValue{label.String("keyx", "valx")},
+				kvsWithMaxMembers[:len(kvsWithMaxMembers)-1]...,
+			)},
+		},
+		{
+			name:       "Replace when full",
+			traceState: TraceState{kvsWithMaxMembers},
+			keyValue:   label.String("key32", "valx"),
+			expectedTraceState: TraceState{append(
+				[]label.KeyValue{label.String("key32", "valx")},
+				kvsWithMaxMembers[:len(kvsWithMaxMembers)-1]...,
+			)},
 		},
 	}
 
@@ -763,3 +822,75 @@ var kvsWithMaxMembers = func() []label.KeyValue {
 	}
 	return kvs
 }()
+
+func TestTraceStateLen(t *testing.T) {
+	assert.Equal(t, 0, TraceState{}.Len())
+	assert.Equal(t, traceStateMaxListMembers, TraceState{kvsWithMaxMembers}.Len())
+}
+
+func TestParseTraceState(t *testing.T) {
+	testCases := []struct {
+		name               string
+		in                 string
+		expectedTraceState TraceState
+		expectedErr        error
+	}{
+		{
+			name: "OK case",
+			in:   "key1=val1,key2@vendor=val2",
+			expectedTraceState: TraceState{
+				kvs: []label.KeyValue{
+					label.String("key1", "val1"),
+					label.String("key2@vendor", "val2"),
+				},
+			},
+		},
+		{
+			name: "OK case with whitespace and empty entries",
+			in:   " key1=val1 ,, key2=val2\t",
+			expectedTraceState: TraceState{
+				kvs: []label.KeyValue{
+					label.String("key1", "val1"),
+					label.String("key2", "val2"),
+				},
+			},
+		},
+		{
+			name:               "OK case (empty)",
+			expectedTraceState: TraceState{},
+		},
+		{
+			name:        "Missing value",
+			in:          "key1=val1,key2",
+			expectedErr: errInvalidTraceStateKeyValue,
+		},
+		{
+			name:        "Invalid key",
+			in:          "Key1=val1",
+			expectedErr: errInvalidTraceStateKeyValue,
+		},
+		{
+			name:        "Duplicate",
+			in:          "key1=val1,key1=val2",
+			expectedErr: errInvalidTraceStateDuplicate,
+		},
+		{
+			name:        "Too many entries",
+			in:          TraceState{kvsWithMaxMembers}.String() + ",keyx=valx",
+			expectedErr: errInvalidTraceStateMembersNumber,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			result, err := ParseTraceState(tc.in)
+			if tc.expectedErr != nil {
+				assert.Equal(t, tc.expectedErr, err)
+				assert.Equal(t, TraceState{}, result)
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, tc.expectedTraceState, result)
+			}
+		})
+	}
+}